@@ -155,7 +155,7 @@ func (ctrl *V1Controller) HandleBase(ready ReadyFunc, build Build) errchain.Hand
 			Latest:            ctrl.svc.BackgroundService.GetLatestVersion(),
 			Demo:              ctrl.isDemo,
 			AllowRegistration: ctrl.allowRegistration,
-			LabelPrinting:     ctrl.config.LabelMaker.PrintCommand != nil,
+			LabelPrinting:     ctrl.config.LabelMaker.PrintCommand != nil || ctrl.config.LabelMaker.PrintAddress != nil,
 			OIDC: OIDCStatus{
 				Enabled:      ctrl.config.OIDC.Enabled,
 				ButtonText:   ctrl.config.OIDC.ButtonText,
@@ -185,6 +185,12 @@ func (ctrl *V1Controller) HandleCurrency() errchain.HandlerFunc {
 func (ctrl *V1Controller) HandleCacheWS() errchain.HandlerFunc {
 	type eventMsg struct {
 		Event string `json:"event"`
+		Data  any    `json:"data,omitempty"`
+	}
+
+	type printJobData struct {
+		ID     uuid.UUID `json:"id"`
+		Status string    `json:"status"`
 	}
 
 	m := melody.New()
@@ -196,14 +202,21 @@ func (ctrl *V1Controller) HandleCacheWS() errchain.HandlerFunc {
 
 	factory := func(e string) func(data any) {
 		return func(data any) {
-			eventData, ok := data.(eventbus.GroupMutationEvent)
-			if !ok {
+			msg := &eventMsg{Event: e}
+
+			var gid uuid.UUID
+			switch eventData := data.(type) {
+			case eventbus.GroupMutationEvent:
+				gid = eventData.GID
+			case eventbus.PrintJobEvent:
+				// Jobs change status in the background, so clients are told which and how
+				gid = eventData.GID
+				msg.Data = printJobData{ID: eventData.ID, Status: eventData.Status}
+			default:
 				log.Log().Msgf("invalid event data: %v", data)
 				return
 			}
 
-			msg := &eventMsg{Event: e}
-
 			jsonBytes, err := json.Marshal(msg)
 			if err != nil {
 				log.Log().Msgf("error marshling event data %v: %v", data, err)
@@ -217,7 +230,7 @@ func (ctrl *V1Controller) HandleCacheWS() errchain.HandlerFunc {
 				}
 
 				GID := groupIDStr.(uuid.UUID)
-				return GID == eventData.GID
+				return GID == gid
 			})
 		}
 	}
@@ -225,6 +238,7 @@ func (ctrl *V1Controller) HandleCacheWS() errchain.HandlerFunc {
 	ctrl.bus.Subscribe(eventbus.EventLabelMutation, factory("label.mutation"))
 	ctrl.bus.Subscribe(eventbus.EventLocationMutation, factory("location.mutation"))
 	ctrl.bus.Subscribe(eventbus.EventItemMutation, factory("item.mutation"))
+	ctrl.bus.Subscribe(eventbus.EventPrintJobMutation, factory("printjob.mutation"))
 
 	// Persistent asynchronous ticker that keeps all websocket connections alive with periodic pings.
 	go func() {
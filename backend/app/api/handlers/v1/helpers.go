@@ -1,7 +1,9 @@
 package v1
 
 import (
+	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 )
@@ -28,3 +30,23 @@ func stripPathFromURL(rawURL string) string {
 
 	return strippedURL.String()
 }
+
+// baseURL returns the URL of the Homebox instance from the configured hostname, falling
+// back to the address the server listens on. Links that are sent to someone else, like
+// email confirmation links, are built from it as the Referer is up to the client.
+func (ctrl *V1Controller) baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || (ctrl.config.Options.TrustProxy && r.Header.Get("X-Forwarded-Proto") == "https") {
+		scheme = "https"
+	}
+
+	host := ctrl.config.Options.Hostname
+	if host == "" {
+		host = ctrl.url
+	}
+	if strings.HasPrefix(host, ":") {
+		host = "localhost" + host
+	}
+
+	return scheme + "://" + host
+}
@@ -1,9 +1,10 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
+	"slices"
 	"strconv"
-	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/hay-kot/httpkit/errchain"
@@ -27,13 +28,12 @@ import (
 func (ctrl *V1Controller) HandleAssetGet() errchain.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		ctx := services.NewContext(r.Context())
-		assetIDParam := chi.URLParam(r, "id")
-		assetIDParam = strings.ReplaceAll(assetIDParam, "-", "") // Remove dashes
-		// Convert the asset ID to an int64
-		assetID, err := strconv.ParseInt(assetIDParam, 10, 64)
+
+		_, ref, err := ctrl.routeAssetID(ctx, r)
 		if err != nil {
 			return err
 		}
+
 		pageParam := r.URL.Query().Get("page")
 		var page int64 = -1
 		if pageParam != "" {
@@ -52,11 +52,43 @@ func (ctrl *V1Controller) HandleAssetGet() errchain.HandlerFunc {
 			}
 		}
 
-		items, err := ctrl.repo.Items.QueryByAssetID(r.Context(), ctx.GID, repo.AssetID(assetID), int(page), int(pageSize))
+		items, err := ctrl.repo.Items.QueryByAssetID(r.Context(), ctx.GID, ref, int(page), int(pageSize))
 		if err != nil {
 			log.Err(err).Msg("failed to get item")
 			return validate.NewRequestError(err, http.StatusInternalServerError)
 		}
+
+		scope, err := ctrl.svc.Kiosk.LocationScope(ctx)
+		if err != nil {
+			log.Err(err).Msg("failed to resolve kiosk location")
+			return validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+
+		if len(scope) > 0 {
+			items.Items = slices.DeleteFunc(items.Items, func(itm repo.ItemSummary) bool {
+				return !inKioskScope(scope, itm.Location)
+			})
+			items.Total = len(items.Items)
+		}
 		return server.JSON(w, http.StatusOK, items)
 	}
 }
+
+// routeAssetID reads the asset ID of the route in the group's format. Asset IDs whose
+// check digit doesn't match, usually mis-scans, are rejected.
+func (ctrl *V1Controller) routeAssetID(ctx services.Context, r *http.Request) (repo.AssetIDFormats, repo.AssetRef, error) {
+	formats, err := ctrl.repo.Items.AssetIDFormats(ctx, ctx.GID)
+	if err != nil {
+		return repo.AssetIDFormats{}, repo.AssetRef{}, err
+	}
+
+	ref, err := formats.Parse(chi.URLParam(r, "id"))
+	switch {
+	case errors.Is(err, repo.ErrAssetIDCheckDigit):
+		return formats, ref, validate.NewRequestError(err, http.StatusUnprocessableEntity)
+	case err != nil:
+		return formats, ref, validate.NewRequestError(err, http.StatusBadRequest)
+	}
+
+	return formats, ref, nil
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleItemHistory godoc
+//
+//	@Summary	Get Item History
+//	@Tags		Items
+//	@Produce	json
+//	@Param		id	path		string	true	"Item ID"
+//	@Success	200	{object}	[]repo.AuditEntryOut
+//	@Router		/v1/items/{id}/history [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleItemHistory() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) ([]repo.AuditEntryOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Audit.GetByEntity(auth, auth.GID, repo.AuditEntityItem, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleAuditGetAll godoc
+//
+//	@Summary		Query Audit Log
+//	@Description	Changes to items, locations, labels, borrowers, loans and maintenance in the group, newest first.
+//	@Tags			Audit
+//	@Produce		json
+//	@Param			query	query		repo.AuditQuery	false	"filters"
+//	@Success		200		{object}	repo.PaginationResult[repo.AuditEntryOut]{}
+//	@Router			/v1/audit [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleAuditGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request, q repo.AuditQuery) (repo.PaginationResult[repo.AuditEntryOut], error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Audit.GetByGroup(auth, auth.GID, q)
+	}
+
+	return adapters.Query(fn, http.StatusOK)
+}
@@ -1,15 +1,24 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/errchain"
+	"github.com/hay-kot/httpkit/server"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
 )
 
+// BorrowerVerifyResponse is returned to a borrower after confirming their email address
+type BorrowerVerifyResponse struct {
+	VerificationStatus string `json:"verificationStatus"`
+	Message            string `json:"message"`
+}
+
 // HandleBorrowersGetAll godoc
 //
 //	@Summary	Get All Borrowers
@@ -44,6 +53,23 @@ func (ctrl *V1Controller) HandleBorrowersGetActive() errchain.HandlerFunc {
 	return adapters.Command(fn, http.StatusOK)
 }
 
+// HandleBorrowersGetPending godoc
+//
+//	@Summary	Get Borrowers Pending Verification
+//	@Tags		Borrowers
+//	@Produce	json
+//	@Success	200	{object}	[]repo.BorrowerSummary
+//	@Router		/v1/borrowers/pending [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleBorrowersGetPending() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.BorrowerSummary, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Borrowers.GetPending(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
 // HandleBorrowersCreate godoc
 //
 //	@Summary	Create Borrower
@@ -56,7 +82,7 @@ func (ctrl *V1Controller) HandleBorrowersGetActive() errchain.HandlerFunc {
 func (ctrl *V1Controller) HandleBorrowersCreate() errchain.HandlerFunc {
 	fn := func(r *http.Request, data repo.BorrowerCreate) (repo.BorrowerOut, error) {
 		auth := services.NewContext(r.Context())
-		return ctrl.repo.Borrowers.Create(auth, auth.GID, data)
+		return ctrl.svc.Borrowers.Create(auth, data, ctrl.baseURL(r))
 	}
 
 	return adapters.Action(fn, http.StatusCreated)
@@ -136,3 +162,84 @@ func (ctrl *V1Controller) HandleBorrowerLoans() errchain.HandlerFunc {
 
 	return adapters.CommandID("id", fn, http.StatusOK)
 }
+
+// HandleBorrowerApprove godoc
+//
+//	@Summary	Approve Pending Borrower
+//	@Tags		Borrowers
+//	@Produce	json
+//	@Param		id	path		string	true	"Borrower ID"
+//	@Success	200	{object}	repo.BorrowerOut
+//	@Failure	409	{object}	validate.ErrorResponse
+//	@Router		/v1/borrowers/{id}/approve [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleBorrowerApprove() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.BorrowerOut, error) {
+		auth := services.NewContext(r.Context())
+		b, err := ctrl.repo.Borrowers.Approve(auth, auth.GID, ID)
+		if errors.Is(err, repo.ErrBorrowerNotPending) {
+			return repo.BorrowerOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		return b, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleBorrowerReject godoc
+//
+//	@Summary	Reject Pending Borrower
+//	@Tags		Borrowers
+//	@Produce	json
+//	@Param		id	path		string	true	"Borrower ID"
+//	@Success	200	{object}	repo.BorrowerOut
+//	@Failure	409	{object}	validate.ErrorResponse
+//	@Router		/v1/borrowers/{id}/reject [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleBorrowerReject() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.BorrowerOut, error) {
+		auth := services.NewContext(r.Context())
+		b, err := ctrl.repo.Borrowers.Reject(auth, auth.GID, ID)
+		if errors.Is(err, repo.ErrBorrowerNotPending) {
+			return repo.BorrowerOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		return b, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleBorrowerVerifyEmail godoc
+//
+//	@Summary	Confirm Borrower Email
+//	@Tags		Borrowers
+//	@Produce	json
+//	@Param		token	query		string	true	"Verification Token"
+//	@Success	200		{object}	BorrowerVerifyResponse
+//	@Router		/v1/borrowers/verify [GET]
+func (ctrl *V1Controller) HandleBorrowerVerifyEmail() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		token := r.URL.Query().Get("token")
+		if token == "" {
+			return validate.NewRequestError(errors.New("token is required"), http.StatusBadRequest)
+		}
+
+		b, err := ctrl.svc.Borrowers.VerifyEmail(services.Context{Context: r.Context()}, token)
+		if err != nil {
+			if errors.Is(err, repo.ErrVerificationTokenInvalid) {
+				return validate.NewRequestError(err, http.StatusBadRequest)
+			}
+			return err
+		}
+
+		msg := "Your email address has been confirmed. You can now check out equipment."
+		if b.VerificationStatus != "verified" {
+			msg = "Your email address has been confirmed. A staff member will review your registration shortly."
+		}
+
+		return server.JSON(w, http.StatusOK, BorrowerVerifyResponse{
+			VerificationStatus: b.VerificationStatus,
+			Message:            msg,
+		})
+	}
+}
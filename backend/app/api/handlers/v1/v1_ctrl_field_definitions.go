@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// fieldError maps the errors of invalid custom fields and field definitions to 422.
+func fieldError(err error) error {
+	switch {
+	case errors.Is(err, repo.ErrInvalidFieldValue),
+		errors.Is(err, repo.ErrFieldDefinitionOptions),
+		errors.Is(err, repo.ErrFieldDefinitionInUse):
+		return validate.NewRequestError(err, http.StatusUnprocessableEntity)
+	}
+	return err
+}
+
+// HandleFieldDefinitionsGetAll godoc
+//
+//	@Summary	Get All Field Definitions
+//	@Tags		Field Definitions
+//	@Produce	json
+//	@Success	200	{object}	[]repo.FieldDefinitionOut
+//	@Router		/v1/field-definitions [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleFieldDefinitionsGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.FieldDefinitionOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.FieldDefinitions.GetAll(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleFieldDefinitionsGet godoc
+//
+//	@Summary	Get Field Definition
+//	@Tags		Field Definitions
+//	@Produce	json
+//	@Param		id	path		string	true	"Field Definition ID"
+//	@Success	200	{object}	repo.FieldDefinitionOut
+//	@Router		/v1/field-definitions/{id} [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleFieldDefinitionsGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.FieldDefinitionOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.FieldDefinitions.GetOne(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleFieldDefinitionsCreate godoc
+//
+//	@Summary		Create Field Definition
+//	@Description	Item and template fields with the definition's name take its type. Select and
+//	@Description	multiselect definitions list the allowed options.
+//	@Tags			Field Definitions
+//	@Produce		json
+//	@Param			payload	body		repo.FieldDefinitionCreate	true	"Field Definition Data"
+//	@Success		201		{object}	repo.FieldDefinitionOut
+//	@Router			/v1/field-definitions [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleFieldDefinitionsCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, body repo.FieldDefinitionCreate) (repo.FieldDefinitionOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.FieldDefinitions.Create(auth, auth.GID, body)
+		return out, fieldError(err)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleFieldDefinitionsUpdate godoc
+//
+//	@Summary		Update Field Definition
+//	@Description	Renaming a definition renames the fields that use it. The type can only be changed
+//	@Description	while no item or template has the field.
+//	@Tags			Field Definitions
+//	@Produce		json
+//	@Param			id		path		string						true	"Field Definition ID"
+//	@Param			payload	body		repo.FieldDefinitionUpdate	true	"Field Definition Data"
+//	@Success		200		{object}	repo.FieldDefinitionOut
+//	@Router			/v1/field-definitions/{id} [PUT]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleFieldDefinitionsUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, body repo.FieldDefinitionUpdate) (repo.FieldDefinitionOut, error) {
+		auth := services.NewContext(r.Context())
+		body.ID = ID
+		out, err := ctrl.repo.FieldDefinitions.Update(auth, auth.GID, body)
+		return out, fieldError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleFieldDefinitionsDelete godoc
+//
+//	@Summary		Delete Field Definition
+//	@Description	Fields that used a select or multiselect definition are kept as text fields.
+//	@Tags			Field Definitions
+//	@Produce		json
+//	@Param			id	path	string	true	"Field Definition ID"
+//	@Success		204
+//	@Router			/v1/field-definitions/{id} [DELETE]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleFieldDefinitionsDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		err := ctrl.repo.FieldDefinitions.Delete(auth, auth.GID, ID)
+		return nil, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
@@ -67,6 +67,27 @@ func (ctrl *V1Controller) HandleGroupUpdate() errchain.HandlerFunc {
 	return adapters.Action(fn, http.StatusOK)
 }
 
+// HandleGroupAssetIDFormatUpdate godoc
+//
+//	@Summary		Update Group Asset ID Format
+//	@Description	Changes how asset IDs are written, e.g. HB-0042 for the prefix HB- and a width of 4.
+//	@Description	A width of 0 writes them as 000-000. Labels with an asset ID prefix number their items in
+//	@Description	their own series with the same width and check digit.
+//	@Tags			Group
+//	@Produce		json
+//	@Param			payload	body		repo.AssetIDFormat	true	"Asset ID Format"
+//	@Success		200		{object}	repo.Group
+//	@Router			/v1/groups/asset-id-format [Put]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleGroupAssetIDFormatUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, body repo.AssetIDFormat) (repo.Group, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Groups.UpdateAssetIDFormat(auth, auth.GID, body)
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
+
 // HandleGroupInvitationsCreate godoc
 //
 //	@Summary	Create Group Invitation
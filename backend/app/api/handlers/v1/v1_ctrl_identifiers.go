@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleItemIdentifiersGetAll godoc
+//
+//	@Summary	Get Item Identifiers
+//	@Tags		Items
+//	@Produce	json
+//	@Param		id	path		string	true	"Item ID"
+//	@Success	200	{object}	[]repo.ItemIdentifierOut
+//	@Router		/v1/items/{id}/identifiers [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleItemIdentifiersGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) ([]repo.ItemIdentifierOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Identifiers.GetByItem(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleItemIdentifiersCreate godoc
+//
+//	@Summary		Create Item Identifier
+//	@Description	Adds a scannable code to the item, like the manufacturer's barcode or the UID of an
+//	@Description	NFC sticker. Type and value are unique within the group, ignoring case.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id		path		string						true	"Item ID"
+//	@Param			payload	body		repo.ItemIdentifierCreate	true	"Identifier Data"
+//	@Success		201		{object}	repo.ItemIdentifierOut
+//	@Router			/v1/items/{id}/identifiers [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemIdentifiersCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data repo.ItemIdentifierCreate) (repo.ItemIdentifierOut, error) {
+		auth := services.NewContext(r.Context())
+
+		id, err := ctrl.repo.Identifiers.Create(auth, auth.GID, ID, data)
+		if errors.Is(err, repo.ErrIdentifierExists) {
+			return repo.ItemIdentifierOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		return id, err
+	}
+
+	return adapters.ActionID("id", fn, http.StatusCreated)
+}
+
+// HandleItemIdentifiersDelete godoc
+//
+//	@Summary	Delete Item Identifier
+//	@Tags		Items
+//	@Param		id				path	string	true	"Item ID"
+//	@Param		identifier_id	path	string	true	"Identifier ID"
+//	@Success	204
+//	@Router		/v1/items/{id}/identifiers/{identifier_id} [DELETE]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleItemIdentifiersDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+
+		identifierID, err := ctrl.routeUUID(r, "identifier_id")
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ctrl.repo.Identifiers.Delete(auth, auth.GID, ID, identifierID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleIdentifierLookup godoc
+//
+//	@Summary		Lookup Identifier
+//	@Description	Resolves a scanned value to the items it identifies, by asset ID in the group's format
+//	@Description	or by any of their identifiers. Values are compared ignoring case.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			value	query		string	true	"scanned value"
+//	@Success		200		{object}	[]repo.IdentifierMatch
+//	@Router			/v1/identifiers/lookup [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleIdentifierLookup() errchain.HandlerFunc {
+	fn := func(r *http.Request, q repo.IdentifierLookup) ([]repo.IdentifierMatch, error) {
+		auth := services.NewContext(r.Context())
+
+		matches, err := ctrl.repo.Identifiers.Lookup(auth, auth.GID, q.Value)
+		switch {
+		case errors.Is(err, repo.ErrAssetIDCheckDigit):
+			return nil, validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		case err != nil:
+			return nil, err
+		}
+
+		scope, err := ctrl.svc.Kiosk.LocationScope(auth)
+		if err != nil {
+			return nil, err
+		}
+
+		matches = slices.DeleteFunc(matches, func(m repo.IdentifierMatch) bool {
+			return !inKioskScope(scope, m.Item.Location)
+		})
+		if len(matches) == 0 {
+			return nil, validate.NewRequestError(errors.New("no item found for the identifier"), http.StatusNotFound)
+		}
+
+		return matches, nil
+	}
+
+	return adapters.Query(fn, http.StatusOK)
+}
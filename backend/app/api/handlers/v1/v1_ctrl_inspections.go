@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// InspectionSignOff is the request body for releasing an item from post-return quarantine
+type InspectionSignOff struct {
+	Notes string `json:"notes" validate:"max=1000"`
+}
+
+// HandleInspectionQueue godoc
+//
+//	@Summary	Get Inspection Queue
+//	@Tags		Items
+//	@Produce	json
+//	@Success	200	{object}	[]repo.ItemSummary
+//	@Router		/v1/inspections [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleInspectionQueue() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.ItemSummary, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Items.GetInspectionQueue(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleInspectionSignOff godoc
+//
+//	@Summary		Sign Off Item Inspection
+//	@Description	Releases an item from post-return quarantine and records the inspection in its maintenance log.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id		path		string				true	"Item ID"
+//	@Param			payload	body		InspectionSignOff	true	"Inspection Data"
+//	@Success		200		{object}	repo.ItemOut
+//	@Router			/v1/items/{id}/inspection [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleInspectionSignOff() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data InspectionSignOff) (repo.ItemOut, error) {
+		auth := services.NewContext(r.Context())
+
+		item, err := ctrl.repo.Items.SignOffInspection(auth, auth.GID, ID)
+		if errors.Is(err, repo.ErrItemNotQuarantined) {
+			return repo.ItemOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		if err != nil {
+			return repo.ItemOut{}, err
+		}
+
+		_, err = ctrl.repo.MaintEntry.Create(auth, ID, repo.MaintenanceEntryCreate{
+			CompletedDate: types.DateFromTime(time.Now()),
+			Name:          "Post-return inspection",
+			Description:   data.Notes,
+		})
+		if err != nil {
+			return repo.ItemOut{}, err
+		}
+
+		return item, nil
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
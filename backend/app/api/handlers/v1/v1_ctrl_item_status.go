@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleItemStatusTransition godoc
+//
+//	@Summary		Change Item Status
+//	@Description	Moves the item to another lifecycle status. Only the transitions in the allowed-transition
+//	@Description	table are accepted, others are rejected with 409. Only items in service can be lent.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id		path		string						true	"Item ID"
+//	@Param			payload	body		repo.ItemStatusTransition	true	"Status and reason"
+//	@Success		200		{object}	repo.ItemOut
+//	@Router			/v1/items/{id}/status [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemStatusTransition() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data repo.ItemStatusTransition) (repo.ItemOut, error) {
+		auth := services.NewContext(r.Context())
+
+		item, err := ctrl.repo.Items.TransitionStatus(auth, auth.GID, ID, data)
+		if errors.Is(err, repo.ErrInvalidStatusTransition) {
+			return repo.ItemOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		return item, err
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleItemStatusHistory godoc
+//
+//	@Summary		Get Item Status History
+//	@Description	Every lifecycle status change of the item with its reason, newest first.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path		string	true	"Item ID"
+//	@Success		200	{object}	[]repo.ItemStatusChangeOut
+//	@Router			/v1/items/{id}/status-history [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemStatusHistory() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) ([]repo.ItemStatusChangeOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Items.GetStatusHistory(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleItemStatusTransitions godoc
+//
+//	@Summary	Get Item Status Transitions
+//	@Tags		Items
+//	@Produce	json
+//	@Success	200	{object}	map[string][]string
+//	@Router		/v1/items/status-transitions [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleItemStatusTransitions() errchain.HandlerFunc {
+	fn := func(r *http.Request) (map[repo.ItemStatus][]repo.ItemStatus, error) {
+		return repo.ItemStatusTransitions, nil
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
@@ -1,12 +1,17 @@
 package v1
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/errchain"
+	"github.com/hay-kot/httpkit/server"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
 )
 
@@ -57,7 +62,8 @@ func (ctrl *V1Controller) HandleItemTemplatesGet() errchain.HandlerFunc {
 func (ctrl *V1Controller) HandleItemTemplatesCreate() errchain.HandlerFunc {
 	fn := func(r *http.Request, body repo.ItemTemplateCreate) (repo.ItemTemplateOut, error) {
 		auth := services.NewContext(r.Context())
-		return ctrl.repo.ItemTemplates.Create(r.Context(), auth.GID, body)
+		out, err := ctrl.repo.ItemTemplates.Create(r.Context(), auth.GID, body)
+		return out, fieldError(err)
 	}
 
 	return adapters.Action(fn, http.StatusCreated)
@@ -77,7 +83,8 @@ func (ctrl *V1Controller) HandleItemTemplatesUpdate() errchain.HandlerFunc {
 	fn := func(r *http.Request, ID uuid.UUID, body repo.ItemTemplateUpdate) (repo.ItemTemplateOut, error) {
 		auth := services.NewContext(r.Context())
 		body.ID = ID
-		return ctrl.repo.ItemTemplates.Update(r.Context(), auth.GID, body)
+		out, err := ctrl.repo.ItemTemplates.Update(r.Context(), auth.GID, body)
+		return out, fieldError(err)
 	}
 
 	return adapters.ActionID("id", fn, http.StatusOK)
@@ -102,6 +109,38 @@ func (ctrl *V1Controller) HandleItemTemplatesDelete() errchain.HandlerFunc {
 	return adapters.CommandID("id", fn, http.StatusNoContent)
 }
 
+// templateItemData fills in the item's data from the template's defaults.
+func templateItemData(template repo.ItemTemplateOut, body ItemTemplateCreateItemRequest) repo.ItemCreateFromTemplate {
+	quantity := template.DefaultQuantity
+	if body.Quantity != nil {
+		quantity = *body.Quantity
+	}
+
+	// Build custom fields from template
+	fields := make([]repo.ItemField, len(template.Fields))
+	for i, f := range template.Fields {
+		fields[i] = repo.ItemField{
+			Type:      f.Type,
+			Name:      f.Name,
+			TextValue: f.TextValue,
+		}
+	}
+
+	return repo.ItemCreateFromTemplate{
+		Name:             body.Name,
+		Description:      body.Description,
+		Quantity:         quantity,
+		LocationID:       body.LocationID,
+		LabelIDs:         body.LabelIDs,
+		Insured:          template.DefaultInsured,
+		Manufacturer:     template.DefaultManufacturer,
+		ModelNumber:      template.DefaultModelNumber,
+		LifetimeWarranty: template.DefaultLifetimeWarranty,
+		WarrantyDetails:  template.DefaultWarrantyDetails,
+		Fields:           fields,
+	}
+}
+
 type ItemTemplateCreateItemRequest struct {
 	Name        string      `json:"name"        validate:"required,min=1,max=255"`
 	Description string      `json:"description" validate:"max=1000"`
@@ -129,36 +168,153 @@ func (ctrl *V1Controller) HandleItemTemplatesCreateItem() errchain.HandlerFunc {
 			return repo.ItemOut{}, err
 		}
 
-		quantity := template.DefaultQuantity
-		if body.Quantity != nil {
-			quantity = *body.Quantity
+		out, err := ctrl.repo.Items.CreateFromTemplate(r.Context(), auth.GID, templateItemData(template, body))
+		return out, fieldError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusCreated)
+}
+
+type ItemTemplateCreateItemsRequest struct {
+	ItemTemplateCreateItemRequest
+	// Count defaults to the number of serial numbers
+	Count int `json:"count" validate:"omitempty,min=1,max=500"`
+	// SerialNumbers is a pasted list of one serial number per unit, separated by new lines,
+	// commas or semicolons
+	SerialNumbers string `json:"serialNumbers"`
+}
+
+type ItemTemplateCreateItemsResponse struct {
+	// ItemIDs are the new items in the order of the serial numbers, their labels are
+	// printed with POST /v1/labelmaker/sheet
+	ItemIDs []uuid.UUID `json:"itemIds"`
+}
+
+// parseSerialNumbers reads a pasted list of serial numbers, ignoring blank lines.
+func parseSerialNumbers(s string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '\n' || r == '\r' || r == ',' || r == ';'
+	})
+
+	serials := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f != "" {
+			serials = append(serials, f)
+		}
+	}
+
+	return serials
+}
+
+// HandleItemTemplatesCreateItems godoc
+//
+//	@Summary		Create Items from Template
+//	@Description	Creates a batch of identical items from the template with sequential asset IDs. Each unit
+//	@Description	gets the serial number at its position in the pasted list. The count defaults to the
+//	@Description	number of serial numbers, a list of another length is rejected. The IDs of the new items
+//	@Description	are returned, pass them to `POST /v1/labelmaker/sheet` to print their labels.
+//	@Tags			Item Templates
+//	@Produce		json
+//	@Param			id		path		string							true	"Template ID"
+//	@Param			payload	body		ItemTemplateCreateItemsRequest	true	"Item Data"
+//	@Success		201		{object}	ItemTemplateCreateItemsResponse
+//	@Router			/v1/templates/{id}/create-items [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemTemplatesCreateItems() errchain.HandlerFunc {
+	fn := func(r *http.Request, templateID uuid.UUID, body ItemTemplateCreateItemsRequest) (ItemTemplateCreateItemsResponse, error) {
+		auth := services.NewContext(r.Context())
+
+		serials := parseSerialNumbers(body.SerialNumbers)
+
+		count := body.Count
+		switch {
+		case count == 0 && len(serials) == 0:
+			return ItemTemplateCreateItemsResponse{}, validate.NewRequestError(errors.New("count or serial numbers are required"), http.StatusUnprocessableEntity)
+		case count == 0:
+			count = len(serials)
+		case len(serials) > 0 && len(serials) != count:
+			return ItemTemplateCreateItemsResponse{}, validate.NewRequestError(
+				fmt.Errorf("got %d serial numbers for %d items", len(serials), count), http.StatusUnprocessableEntity)
+		}
+
+		if count > 500 {
+			return ItemTemplateCreateItemsResponse{}, validate.NewRequestError(errors.New("at most 500 items can be created at once"), http.StatusUnprocessableEntity)
 		}
 
-		// Build custom fields from template
-		fields := make([]repo.ItemField, len(template.Fields))
-		for i, f := range template.Fields {
-			fields[i] = repo.ItemField{
-				Type:      f.Type,
-				Name:      f.Name,
-				TextValue: f.TextValue,
+		seen := make(map[string]bool, len(serials))
+		for _, s := range serials {
+			if seen[strings.ToLower(s)] {
+				return ItemTemplateCreateItemsResponse{}, validate.NewRequestError(fmt.Errorf("serial number %q is listed twice", s), http.StatusUnprocessableEntity)
 			}
+			seen[strings.ToLower(s)] = true
+		}
+
+		// Units without a serial number are created with an empty one
+		serials = append(serials, make([]string, count-len(serials))...)
+
+		template, err := ctrl.repo.ItemTemplates.GetOne(r.Context(), auth.GID, templateID)
+		if err != nil {
+			return ItemTemplateCreateItemsResponse{}, err
 		}
 
-		// Create item with all template data in a single transaction
-		return ctrl.repo.Items.CreateFromTemplate(r.Context(), auth.GID, repo.ItemCreateFromTemplate{
-			Name:             body.Name,
-			Description:      body.Description,
-			Quantity:         quantity,
-			LocationID:       body.LocationID,
-			LabelIDs:         body.LabelIDs,
-			Insured:          template.DefaultInsured,
-			Manufacturer:     template.DefaultManufacturer,
-			ModelNumber:      template.DefaultModelNumber,
-			LifetimeWarranty: template.DefaultLifetimeWarranty,
-			WarrantyDetails:  template.DefaultWarrantyDetails,
-			Fields:           fields,
-		})
+		ids, err := ctrl.repo.Items.CreateManyFromTemplate(r.Context(), auth.GID, templateItemData(template, body.ItemTemplateCreateItemRequest), serials)
+		if err != nil {
+			return ItemTemplateCreateItemsResponse{}, fieldError(err)
+		}
+
+		return ItemTemplateCreateItemsResponse{ItemIDs: ids}, nil
 	}
 
 	return adapters.ActionID("id", fn, http.StatusCreated)
 }
+
+// HandleItemTemplatesExport godoc
+//
+//	@Summary		Export Item Templates
+//	@Description	Exports the templates in a portable format that other groups and instances can import.
+//	@Description	Default locations and labels are written by name. Exports all templates without ids.
+//	@Tags			Item Templates
+//	@Produce		json
+//	@Param			ids	query		[]string	false	"template IDs"
+//	@Success		200	{object}	repo.ItemTemplateExport
+//	@Router			/v1/templates/export [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemTemplatesExport() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		auth := services.NewContext(r.Context())
+
+		out, err := ctrl.repo.ItemTemplates.Export(auth, auth.GID, queryUUIDList(r.URL.Query(), "ids"))
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Content-Disposition", "attachment; filename=homebox-templates.json")
+		return server.JSON(w, http.StatusOK, out)
+	}
+}
+
+// HandleItemTemplatesImport godoc
+//
+//	@Summary		Import Item Templates
+//	@Description	Imports templates of an export. Default locations and labels are resolved by name, missing
+//	@Description	ones are reported as warnings. Templates named like an existing one are skipped, renamed
+//	@Description	or overwrite it depending on the conflict strategy, skip by default.
+//	@Tags			Item Templates
+//	@Produce		json
+//	@Param			payload	body		repo.ItemTemplateImport	true	"Exported templates"
+//	@Success		200		{object}	repo.ItemTemplateImportResult
+//	@Router			/v1/templates/import [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemTemplatesImport() errchain.HandlerFunc {
+	fn := func(r *http.Request, data repo.ItemTemplateImport) (repo.ItemTemplateImportResult, error) {
+		auth := services.NewContext(r.Context())
+
+		result, err := ctrl.repo.ItemTemplates.Import(auth, auth.GID, data)
+		if errors.Is(err, repo.ErrTemplateExportVersion) {
+			return result, validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		}
+		return result, fieldError(err)
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/hay-kot/httpkit/server"
 	"github.com/rs/zerolog/log"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
@@ -22,42 +24,61 @@ import (
 
 // HandleItemsGetAll godoc
 //
-//	@Summary	Query All Items
-//	@Tags		Items
-//	@Produce	json
-//	@Param		q			query		string		false	"search string"
-//	@Param		page		query		int			false	"page number"
-//	@Param		pageSize	query		int			false	"items per page"
-//	@Param		labels		query		[]string	false	"label Ids"		collectionFormat(multi)
-//	@Param		locations	query		[]string	false	"location Ids"	collectionFormat(multi)
-//	@Param		parentIds	query		[]string	false	"parent Ids"	collectionFormat(multi)
-//	@Success	200			{object}	repo.PaginationResult[repo.ItemSummary]{}
-//	@Router		/v1/items [GET]
-//	@Security	Bearer
+//	@Summary		Query All Items
+//	@Description	The search string accepts filters such as `label:camera loc:"Studio A" qty>2 -archived serial:AB* field.Color=red`.
+//	@Description	Malformed queries are rejected with 422 and the position of the error.
+//	@Description	Custom field filters compare numbers with number and decimal fields and dates with time fields.
+//	@Description	Filters on the same field match when any of them does, filters on different fields must all match.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			q			query		string		false	"search string, matched as word prefixes and ranked by relevance"
+//	@Param			page		query		int			false	"page number"
+//	@Param			pageSize	query		int			false	"items per page"
+//	@Param			labels		query		[]string	false	"label Ids"		collectionFormat(multi)
+//	@Param			locations	query		[]string	false	"location Ids"	collectionFormat(multi)
+//	@Param			parentIds	query		[]string	false	"parent Ids"	collectionFormat(multi)
+//	@Param			fields		query		[]string	false	"custom field filters: Name=value, Name[op]=value with op eq, ne, lt, gt or contains, Name[between]=from..to or Name[exists]"	collectionFormat(multi)
+//	@Param			statuses	query		[]string	false	"lifecycle statuses"	collectionFormat(multi)
+//	@Param			orderBy		query		string		false	"relevance (default when searching), name, createdAt, updatedAt or assetId"
+//	@Success		200			{object}	repo.PaginationResult[repo.ItemSummary]{}
+//	@Router			/v1/items [GET]
+//	@Security		Bearer
 func (ctrl *V1Controller) HandleItemsGetAll() errchain.HandlerFunc {
-	extractQuery := func(r *http.Request) repo.ItemQuery {
+	extractQuery := func(r *http.Request) (repo.ItemQuery, error) {
 		params := r.URL.Query()
 
-		filterFieldItems := func(raw []string) []repo.FieldQuery {
+		filterFieldItems := func(raw []string) ([]repo.FieldQuery, error) {
 			var items []repo.FieldQuery
 
 			for _, v := range raw {
-				parts := strings.SplitN(v, "=", 2)
-				if len(parts) == 2 {
-					items = append(items, repo.FieldQuery{
-						Name:  parts[0],
-						Value: parts[1],
-					})
+				f, err := repo.ParseFieldQuery(v)
+				if err != nil {
+					return nil, err
 				}
+				items = append(items, f)
 			}
 
-			return items
+			return items, nil
+		}
+
+		fields, err := filterFieldItems(params["fields"])
+		if err != nil {
+			return repo.ItemQuery{}, err
+		}
+
+		statuses := make([]repo.ItemStatus, 0, len(params["statuses"]))
+		for _, s := range params["statuses"] {
+			// Matched ignoring case, like the status: term of the search
+			status := repo.ItemStatus(strings.ToLower(s))
+			if !slices.Contains(repo.ItemStatuses, status) {
+				return repo.ItemQuery{}, fmt.Errorf("unknown status %q", s)
+			}
+			statuses = append(statuses, status)
 		}
 
 		v := repo.ItemQuery{
 			Page:             queryIntOrNegativeOne(params.Get("page")),
 			PageSize:         queryIntOrNegativeOne(params.Get("pageSize")),
-			Search:           params.Get("q"),
 			LocationIDs:      queryUUIDList(params, "locations"),
 			LabelIDs:         queryUUIDList(params, "labels"),
 			NegateLabels:     queryBool(params.Get("negateLabels")),
@@ -65,27 +86,43 @@ func (ctrl *V1Controller) HandleItemsGetAll() errchain.HandlerFunc {
 			OnlyWithPhoto:    queryBool(params.Get("onlyWithPhoto")),
 			ParentItemIDs:    queryUUIDList(params, "parentIds"),
 			IncludeArchived:  queryBool(params.Get("includeArchived")),
-			Fields:           filterFieldItems(params["fields"]),
+			Fields:           fields,
 			OrderBy:          params.Get("orderBy"),
+			Statuses:         statuses,
 		}
 
-		if strings.HasPrefix(v.Search, "#") {
-			aidStr := strings.TrimPrefix(v.Search, "#")
+		parsed, err := repo.ParseItemQuery(params.Get("q"))
+		if err != nil {
+			return repo.ItemQuery{}, err
+		}
+		parsed.Apply(&v)
 
-			aid, ok := repo.ParseAssetID(aidStr)
-			if ok {
-				v.Search = ""
-				v.AssetID = aid
-			}
+		if aidStr, ok := strings.CutPrefix(v.Search, "#"); ok && strings.ContainsAny(aidStr, "0123456789") {
+			// Searched like an asset: term, so the ID is read in the group's format
+			v.Search = ""
+			v.Terms = append(v.Terms, repo.QueryTerm{Key: repo.QueryKeyAssetID, Op: repo.QueryOpEq, Value: aidStr})
 		}
 
-		return v
+		return v, nil
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) error {
 		ctx := services.NewContext(r.Context())
 
-		items, err := ctrl.repo.Items.QueryByGroup(ctx, ctx.GID, extractQuery(r))
+		query, err := extractQuery(r)
+		if err != nil {
+			return validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		}
+
+		scope, err := ctrl.svc.Kiosk.LocationScope(ctx)
+		if err != nil {
+			log.Err(err).Msg("failed to resolve kiosk location")
+			return validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+
+		query.LocationScope = scope
+
+		items, err := ctrl.repo.Items.QueryByGroup(ctx, ctx.GID, query)
 		totalPrice := new(big.Int)
 		for _, item := range items.Items {
 			if !item.SoldTime.IsZero() { // Skip items with a non-null SoldDate
@@ -190,13 +227,14 @@ func (ctrl *V1Controller) HandleItemGet() errchain.HandlerFunc {
 
 // HandleItemDelete godocs
 //
-//	@Summary	Delete Item
-//	@Tags		Items
-//	@Produce	json
-//	@Param		id	path	string	true	"Item ID"
-//	@Success	204
-//	@Router		/v1/items/{id} [DELETE]
-//	@Security	Bearer
+//	@Summary		Delete Item
+//	@Description	Moves the item to the trash, where it can be restored until it is purged.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path	string	true	"Item ID"
+//	@Success		204
+//	@Router			/v1/items/{id} [DELETE]
+//	@Security		Bearer
 func (ctrl *V1Controller) HandleItemDelete() errchain.HandlerFunc {
 	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
 		auth := services.NewContext(r.Context())
@@ -222,7 +260,8 @@ func (ctrl *V1Controller) HandleItemUpdate() errchain.HandlerFunc {
 		auth := services.NewContext(r.Context())
 
 		body.ID = ID
-		return ctrl.repo.Items.UpdateByGroup(auth, auth.GID, body)
+		out, err := ctrl.repo.Items.UpdateByGroup(auth, auth.GID, body)
+		return out, fieldError(err)
 	}
 
 	return adapters.ActionID("id", fn, http.StatusOK)
@@ -313,16 +352,61 @@ func (ctrl *V1Controller) HandleGetAllCustomFieldValues() errchain.HandlerFunc {
 	return adapters.Query(fn, http.StatusOK)
 }
 
+// HandleItemsBulk godocs
+//
+//	@Summary		Bulk Update Items
+//	@Description	Selects items by ID or with a query and sets their location, adds or removes labels, sets
+//	@Description	archived, sets a custom field, or moves them to the trash. All changes are made in one transaction: if any
+//	@Description	item fails nothing is changed and `committed` is false. Items not found are reported and skipped.
+//	@Description	Queries need at least one filter, so that a blank query can't select every item.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			payload	body		repo.ItemBulkRequest	true	"Items and operations"
+//	@Success		200		{object}	repo.ItemBulkOut
+//	@Router			/v1/items/bulk [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemsBulk() errchain.HandlerFunc {
+	fn := func(r *http.Request, body repo.ItemBulkRequest) (repo.ItemBulkOut, error) {
+		auth := services.NewContext(r.Context())
+
+		out, err := ctrl.repo.Items.Bulk(auth, auth.GID, body)
+		if err != nil {
+			var perr *repo.QueryParseError
+			switch {
+			case errors.As(err, &perr),
+				errors.Is(err, repo.ErrBulkTarget),
+				errors.Is(err, repo.ErrBulkEmptyQuery),
+				errors.Is(err, repo.ErrBulkTooManyItems),
+				errors.Is(err, repo.ErrBulkNoOperations),
+				errors.Is(err, repo.ErrBulkDeleteExclusive),
+				errors.Is(err, repo.ErrBulkInvalidReference),
+				errors.Is(err, repo.ErrInvalidFieldQuery),
+				errors.Is(err, repo.ErrInvalidFieldValue):
+				return out, validate.NewRequestError(err, http.StatusUnprocessableEntity)
+			}
+
+			log.Err(err).Msg("failed to run bulk item operation")
+			return out, validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+
+		return out, nil
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
+
 // HandleItemsImport godocs
 //
-//	@Summary	Import Items
-//	@Tags		Items
-//	@Accept		multipart/form-data
-//	@Produce	json
-//	@Success	204
-//	@Param		csv	formData	file	true	"Image to upload"
-//	@Router		/v1/items/import [Post]
-//	@Security	Bearer
+//	@Summary		Import Items
+//	@Description	Rows whose identifiers are already used by another item are imported without those identifiers, the rows are listed in the 409 response.
+//	@Tags			Items
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Success		204
+//	@Failure		409	{object}	validate.ErrorResponse
+//	@Param			csv	formData	file	true	"Image to upload"
+//	@Router			/v1/items/import [Post]
+//	@Security		Bearer
 func (ctrl *V1Controller) HandleItemsImport() errchain.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		err := r.ParseMultipartForm(ctrl.maxUploadSize << 20)
@@ -340,6 +424,9 @@ func (ctrl *V1Controller) HandleItemsImport() errchain.HandlerFunc {
 		user := services.UseUserCtx(r.Context())
 
 		_, err = ctrl.svc.Items.CsvImport(r.Context(), user.GroupID, file)
+		if errors.Is(err, repo.ErrIdentifierExists) {
+			return validate.NewRequestError(err, http.StatusConflict)
+		}
 		if err != nil {
 			log.Err(err).Msg("failed to import items")
 			return validate.NewRequestError(err, http.StatusInternalServerError)
@@ -353,15 +440,24 @@ func (ctrl *V1Controller) HandleItemsImport() errchain.HandlerFunc {
 //
 //	@Summary	Export Items
 //	@Tags		Items
-//	@Success	200	{string}	string	"text/csv"
+//	@Param		savedSearch	query		string	false	"only export the items of this group-shared saved search"
+//	@Success	200			{string}	string	"text/csv"
 //	@Router		/v1/items/export [GET]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleItemsExport() errchain.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		ctx := services.NewContext(r.Context())
 
-		csvData, err := ctrl.svc.Items.ExportCSV(r.Context(), ctx.GID, GetHBURL(r.Header.Get("Referer"), ctrl.url))
+		savedSearch, err := querySavedSearch(r)
 		if err != nil {
+			return err
+		}
+
+		csvData, err := ctrl.svc.Items.ExportCSV(r.Context(), ctx.GID, GetHBURL(r.Header.Get("Referer"), ctrl.url), savedSearch)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return err
+			}
 			log.Err(err).Msg("failed to export items")
 			return validate.NewRequestError(err, http.StatusInternalServerError)
 		}
@@ -3,10 +3,14 @@ package v1
 import (
 	"errors"
 	"net/http"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/errchain"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/hasher"
 )
@@ -16,6 +20,20 @@ type KioskStatusResponse struct {
 	IsActive      bool       `json:"isActive"`
 	IsUnlocked    bool       `json:"isUnlocked"`
 	UnlockedUntil *time.Time `json:"unlockedUntil,omitempty"`
+	LocationID    *uuid.UUID `json:"locationId,omitempty"`
+	LocationName  string     `json:"locationName,omitempty"`
+}
+
+// KioskDevice represents a kiosk and the health it last reported
+type KioskDevice struct {
+	repo.KioskSessionOut
+	IsOnline   bool `json:"isOnline"`
+	IsUnlocked bool `json:"isUnlocked"`
+}
+
+// KioskLocationRequest binds a kiosk to a location (uuid.Nil unbinds it)
+type KioskLocationRequest struct {
+	LocationID uuid.UUID `json:"locationId"`
 }
 
 // KioskUnlockRequest represents the request to unlock kiosk mode
@@ -41,11 +59,7 @@ func (ctrl *V1Controller) HandleKioskActivate() errchain.HandlerFunc {
 			return KioskStatusResponse{}, err
 		}
 
-		return KioskStatusResponse{
-			IsActive:      session.IsActive,
-			IsUnlocked:    session.IsUnlocked(),
-			UnlockedUntil: session.UnlockedUntil,
-		}, nil
+		return kioskStatus(session), nil
 	}
 
 	return adapters.Command(fn, http.StatusOK)
@@ -101,16 +115,53 @@ func (ctrl *V1Controller) HandleKioskStatus() errchain.HandlerFunc {
 			}, nil
 		}
 
-		return KioskStatusResponse{
-			IsActive:      session.IsActive,
-			IsUnlocked:    session.IsUnlocked(),
-			UnlockedUntil: session.UnlockedUntil,
-		}, nil
+		return kioskStatus(session), nil
 	}
 
 	return adapters.Command(fn, http.StatusOK)
 }
 
+// HandleKioskSetLocation godoc
+//
+//	@Summary		Bind Kiosk to Location
+//	@Description	Limits browsing, checkout and return on the kiosk to items within the location and its children.
+//	@Description	Returns of items stored elsewhere are accepted but flagged as transfers.
+//	@Tags			Kiosk
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		KioskLocationRequest	true	"Location"
+//	@Success		200		{object}	KioskStatusResponse
+//	@Router			/v1/kiosk/location [PUT]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleKioskSetLocation() errchain.HandlerFunc {
+	fn := func(r *http.Request, data KioskLocationRequest) (KioskStatusResponse, error) {
+		auth := services.NewContext(r.Context())
+
+		session, err := ctrl.repo.KioskSessions.SetLocation(auth, auth.GID, auth.UID, data.LocationID)
+		if err != nil {
+			return KioskStatusResponse{}, err
+		}
+
+		if session == nil {
+			return KioskStatusResponse{}, validate.NewRequestError(errors.New("no kiosk session"), http.StatusConflict)
+		}
+
+		return kioskStatus(session), nil
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
+
+func kioskStatus(session *repo.KioskSessionOut) KioskStatusResponse {
+	return KioskStatusResponse{
+		IsActive:      session.IsActive,
+		IsUnlocked:    session.IsUnlocked(),
+		UnlockedUntil: session.UnlockedUntil,
+		LocationID:    session.LocationID,
+		LocationName:  session.LocationName,
+	}
+}
+
 // HandleKioskUnlock godoc
 //
 //	@Summary	Unlock Kiosk Mode (Temporary Admin Access)
@@ -210,3 +261,134 @@ func (ctrl *V1Controller) HandleKioskLock() errchain.HandlerFunc {
 
 	return adapters.Command(fn, http.StatusOK)
 }
+
+// HandleKioskHeartbeat godoc
+//
+//	@Summary	Kiosk Heartbeat
+//	@Tags		Kiosk
+//	@Accept		json
+//	@Produce	json
+//	@Param		payload	body		repo.KioskHeartbeat	true	"Device State"
+//	@Success	200		{object}	KioskStatusResponse
+//	@Router		/v1/kiosk/heartbeat [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleKioskHeartbeat() errchain.HandlerFunc {
+	fn := func(r *http.Request, data repo.KioskHeartbeat) (KioskStatusResponse, error) {
+		auth := services.NewContext(r.Context())
+
+		session, err := ctrl.repo.KioskSessions.Heartbeat(auth, auth.UID, data)
+		if err != nil {
+			return KioskStatusResponse{}, err
+		}
+
+		if session == nil {
+			return KioskStatusResponse{}, validate.NewRequestError(errors.New("no active kiosk session"), http.StatusConflict)
+		}
+
+		// Revoke a temporary unlock as soon as the device reports it has been left idle,
+		// rather than waiting for the background task to catch it.
+		if session.IsUnlocked() && session.IsIdleSince(time.Now().Add(-ctrl.config.Kiosk.IdleTimeout)) {
+			err = ctrl.repo.KioskSessions.Lock(auth, auth.UID)
+			if err != nil {
+				return KioskStatusResponse{}, err
+			}
+
+			return KioskStatusResponse{
+				IsActive:   session.IsActive,
+				IsUnlocked: false,
+			}, nil
+		}
+
+		return KioskStatusResponse{
+			IsActive:      session.IsActive,
+			IsUnlocked:    session.IsUnlocked(),
+			UnlockedUntil: session.UnlockedUntil,
+		}, nil
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
+
+// HandleKioskDevices godoc
+//
+//	@Summary	Get Kiosk Device Health
+//	@Tags		Kiosk
+//	@Produce	json
+//	@Success	200	{object}	[]KioskDevice
+//	@Router		/v1/kiosk/devices [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleKioskDevices() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]KioskDevice, error) {
+		auth := services.NewContext(r.Context())
+
+		sessions, err := ctrl.repo.KioskSessions.GetAllByGroup(auth, auth.GID)
+		if err != nil {
+			return nil, err
+		}
+
+		onlineSince := time.Now().Add(-ctrl.config.Kiosk.OfflineAfter)
+
+		devices := make([]KioskDevice, len(sessions))
+		for i := range sessions {
+			devices[i] = KioskDevice{
+				KioskSessionOut: sessions[i],
+				IsOnline:        sessions[i].IsOnline(onlineSince),
+				IsUnlocked:      sessions[i].IsUnlocked(),
+			}
+		}
+
+		return devices, nil
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleKioskSync godoc
+//
+//	@Summary		Sync Offline Kiosk Actions
+//	@Description	Applies checkouts, returns and borrower registrations a kiosk queued while offline.
+//	@Description	Every action is applied at most once per idempotency key.
+//	@Tags			Kiosk
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		services.KioskSyncRequest	true	"Queued Actions"
+//	@Success		200		{object}	[]services.KioskSyncResult
+//	@Router			/v1/kiosk/sync [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleKioskSync() errchain.HandlerFunc {
+	fn := func(r *http.Request, data services.KioskSyncRequest) ([]services.KioskSyncResult, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Kiosk.Sync(auth, data.Actions, ctrl.baseURL(r)), nil
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
+
+// inKioskScope reports whether an item stored at the location is visible to a kiosk
+// with the location scope. An empty scope makes every item visible, see
+// KioskService.LocationScope. Item routes are checked by the kiosk item middleware,
+// this is for the endpoints that find items by other means.
+func inKioskScope(scope []uuid.UUID, loc *repo.LocationSummary) bool {
+	if len(scope) == 0 {
+		return true
+	}
+	return loc != nil && slices.Contains(scope, loc.ID)
+}
+
+// kioskSubtree cuts a location tree down to the branch of the kiosk's location.
+func kioskSubtree(tree []repo.TreeItem, id uuid.UUID) []repo.TreeItem {
+	for i := range tree {
+		if tree[i].ID == id {
+			return []repo.TreeItem{tree[i]}
+		}
+
+		children := make([]repo.TreeItem, 0, len(tree[i].Children))
+		for _, c := range tree[i].Children {
+			children = append(children, *c)
+		}
+		if sub := kioskSubtree(children, id); len(sub) > 0 {
+			return sub
+		}
+	}
+	return []repo.TreeItem{}
+}
@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+func labelTemplateError(err error) error {
+	if errors.Is(err, repo.ErrLabelTemplateField) {
+		return validate.NewRequestError(err, http.StatusUnprocessableEntity)
+	}
+	return err
+}
+
+// HandleLabelTemplatesGetAll godoc
+//
+//	@Summary	Get All Label Templates
+//	@Tags		Label Templates
+//	@Produce	json
+//	@Success	200	{object}	[]repo.LabelTemplateOut
+//	@Router		/v1/label-templates [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleLabelTemplatesGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.LabelTemplateOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.LabelTemplates.GetAll(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleLabelTemplatesGet godoc
+//
+//	@Summary	Get Label Template
+//	@Tags		Label Templates
+//	@Produce	json
+//	@Param		id	path		string	true	"Label Template ID"
+//	@Success	200	{object}	repo.LabelTemplateOut
+//	@Router		/v1/label-templates/{id} [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleLabelTemplatesGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.LabelTemplateOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.LabelTemplates.GetOne(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleLabelTemplatesCreate godoc
+//
+//	@Summary		Create Label Template
+//	@Description	Label templates list the fields printed on a label in order, each with its font size,
+//	@Description	and the code printed with them: qr, datamatrix, code128 or none.
+//	@Tags			Label Templates
+//	@Produce		json
+//	@Param			payload	body		repo.LabelTemplateCreate	true	"Label Template Data"
+//	@Success		201		{object}	repo.LabelTemplateOut
+//	@Router			/v1/label-templates [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleLabelTemplatesCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, body repo.LabelTemplateCreate) (repo.LabelTemplateOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.LabelTemplates.Create(auth, auth.GID, body)
+		return out, labelTemplateError(err)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleLabelTemplatesUpdate godoc
+//
+//	@Summary	Update Label Template
+//	@Tags		Label Templates
+//	@Produce	json
+//	@Param		id		path		string						true	"Label Template ID"
+//	@Param		payload	body		repo.LabelTemplateUpdate	true	"Label Template Data"
+//	@Success	200		{object}	repo.LabelTemplateOut
+//	@Router		/v1/label-templates/{id} [PUT]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleLabelTemplatesUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, body repo.LabelTemplateUpdate) (repo.LabelTemplateOut, error) {
+		auth := services.NewContext(r.Context())
+		body.ID = ID
+		out, err := ctrl.repo.LabelTemplates.Update(auth, auth.GID, body)
+		return out, labelTemplateError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleLabelTemplatesDelete godoc
+//
+//	@Summary	Delete Label Template
+//	@Tags		Label Templates
+//	@Produce	json
+//	@Param		id	path	string	true	"Label Template ID"
+//	@Success	204
+//	@Router		/v1/label-templates/{id} [DELETE]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleLabelTemplatesDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		err := ctrl.repo.LabelTemplates.Delete(auth, auth.GID, ID)
+		return nil, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
@@ -1,13 +1,16 @@
 package v1
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
+	"slices"
 	"strings"
 
-	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/errchain"
+	"github.com/hay-kot/httpkit/server"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
@@ -15,8 +18,23 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/pkgs/labelmaker"
 )
 
-func generateOrPrint(ctrl *V1Controller, w http.ResponseWriter, r *http.Request, title string, description string, url string) error {
-	params := labelmaker.NewGenerateParams(int(ctrl.config.LabelMaker.Width), int(ctrl.config.LabelMaker.Height), int(ctrl.config.LabelMaker.Margin), int(ctrl.config.LabelMaker.Padding), ctrl.config.LabelMaker.FontSize, title, description, url, ctrl.config.LabelMaker.DynamicLength, ctrl.config.LabelMaker.AdditionalInformation)
+func generateOrPrint(ctrl *V1Controller, w http.ResponseWriter, r *http.Request, params labelmaker.GenerateParameters) error {
+	printerID, err := queryUUID(r, "printer")
+	if err != nil {
+		return err
+	}
+
+	if printerID != uuid.Nil {
+		auth := services.NewContext(r.Context())
+		job, err := ctrl.svc.PrintQueue.Submit(auth, printerID, params.TitleText, labelmaker.PrintJob{
+			Labels: []labelmaker.GenerateParameters{params},
+		})
+		if err != nil {
+			return err
+		}
+
+		return server.JSON(w, http.StatusAccepted, job)
+	}
 
 	print := queryBool(r.URL.Query().Get("print"))
 
@@ -28,9 +46,193 @@ func generateOrPrint(ctrl *V1Controller, w http.ResponseWriter, r *http.Request,
 
 		_, err = w.Write([]byte("Printed!"))
 		return err
-	} else {
+	}
+
+	format := labelmaker.Format(r.URL.Query().Get("format"))
+	switch format {
+	case "", labelmaker.FormatPNG:
 		return labelmaker.GenerateLabel(w, &params, ctrl.config)
+	case labelmaker.FormatZPL, labelmaker.FormatEPL:
+		buf := &bytes.Buffer{}
+		if err := labelmaker.GenerateFormat(buf, format, &params, ctrl.config); err != nil {
+			return err
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, err := w.Write(buf.Bytes())
+		return err
+	default:
+		return validate.NewRequestError(fmt.Errorf("unknown format %q, expected png, zpl or epl", format), http.StatusBadRequest)
+	}
+}
+
+// labeler builds the labels of a request, laid out by the label template it selects.
+type labeler struct {
+	ctrl    *V1Controller
+	ctx     services.Context
+	hbURL   string
+	formats repo.AssetIDFormats
+	tmpl    *repo.LabelTemplateOut
+	paths   map[uuid.UUID]string
+}
+
+func (ctrl *V1Controller) newLabeler(ctx services.Context, r *http.Request, templateID uuid.UUID) (*labeler, error) {
+	formats, err := ctrl.repo.Items.AssetIDFormats(ctx, ctx.GID)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &labeler{
+		ctrl:    ctrl,
+		ctx:     ctx,
+		hbURL:   GetHBURL(r.Header.Get("Referer"), ctrl.url),
+		formats: formats,
+		paths:   map[uuid.UUID]string{},
+	}
+
+	if templateID != uuid.Nil {
+		tmpl, err := ctrl.repo.LabelTemplates.GetOne(ctx, ctx.GID, templateID)
+		if err != nil {
+			return nil, err
+		}
+		l.tmpl = &tmpl
+	}
+
+	return l, nil
+}
+
+// queryLabelTemplate reads the ID of the label template from the template query
+// parameter, the zero UUID for the default layout.
+func queryLabelTemplate(r *http.Request) (uuid.UUID, error) {
+	return queryUUID(r, "template")
+}
+
+// queryUUID reads the ID in the query parameter, the zero UUID when it is missing.
+func queryUUID(r *http.Request, key string) (uuid.UUID, error) {
+	v := r.URL.Query().Get(key)
+	if v == "" {
+		return uuid.Nil, nil
+	}
+
+	id, err := uuid.Parse(v)
+	if err != nil {
+		return uuid.Nil, validate.NewRequestError(fmt.Errorf("invalid %s: %w", key, err), http.StatusBadRequest)
+	}
+	return id, nil
+}
+
+// params returns the parameters of a label with the configured sizes. With a label
+// template its lines replace the title and description, and its code is printed.
+func (l *labeler) params(title, description, url, code string, values repo.LabelValues) labelmaker.GenerateParameters {
+	lm := l.ctrl.config.LabelMaker
+	params := labelmaker.NewGenerateParams(int(lm.Width), int(lm.Height), int(lm.Margin), int(lm.Padding), lm.FontSize,
+		title, description, url, lm.DynamicLength, lm.AdditionalInformation)
+
+	if l.tmpl == nil {
+		return params
+	}
+
+	params.Symbology = labelmaker.Symbology(l.tmpl.Symbology)
+	if params.Symbology == labelmaker.SymbologyCode128 {
+		params.CodeText = code
+	}
+
+	for _, line := range l.tmpl.Lines(values) {
+		size := line.FontSize
+		if size == 0 {
+			size = params.DescriptionFontSize
+			if line.Bold {
+				size = params.TitleFontSize
+			}
+		}
+		params.Lines = append(params.Lines, labelmaker.TextLine{Text: line.Text, FontSize: size, Bold: line.Bold})
+	}
+
+	return params
+}
+
+// locationPath returns the names of the location and its parents, from the root down.
+func (l *labeler) locationPath(id uuid.UUID) (string, error) {
+	if path, ok := l.paths[id]; ok {
+		return path, nil
+	}
+
+	locations, err := l.ctrl.repo.Locations.PathForLoc(l.ctx, l.ctx.GID, id)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, len(locations))
+	for i, loc := range locations {
+		names[i] = loc.Name
+	}
+
+	l.paths[id] = strings.Join(names, " / ")
+	return l.paths[id], nil
+}
+
+// item returns the label of the item. Asset labels are titled with the item's asset ID
+// and link to it, so that they survive renaming the item, when it has one.
+func (l *labeler) item(item repo.ItemOut, asset bool) (labelmaker.GenerateParameters, error) {
+	tag := l.formats.Format(repo.AssetRef{Prefix: item.AssetIDPrefix, ID: item.AssetID})
+
+	values := repo.LabelValues{
+		AssetID:      tag,
+		Name:         item.Name,
+		Description:  item.Description,
+		SerialNumber: item.SerialNumber,
+		ModelNumber:  item.ModelNumber,
+		Manufacturer: item.Manufacturer,
+		Fields:       make(map[string]string, len(item.Fields)),
+	}
+
+	for _, f := range item.Fields {
+		values.Fields[f.Name] = f.ValueText()
+	}
+
+	description := ""
+	if item.Location != nil {
+		description += fmt.Sprintf("\nLocation: %s", item.Location.Name)
+		values.Location = item.Location.Name
+
+		if l.tmpl != nil {
+			path, err := l.locationPath(item.Location.ID)
+			if err != nil {
+				return labelmaker.GenerateParameters{}, err
+			}
+			values.LocationPath = path
+		}
+	}
+
+	code := tag
+	if code == "" {
+		code = item.ID.String()
+	}
+
+	if asset && tag != "" {
+		return l.params(tag, item.Name+description, fmt.Sprintf("%s/a/%s", l.hbURL, tag), code, values), nil
+	}
+
+	return l.params(item.Name, description, fmt.Sprintf("%s/item/%s", l.hbURL, item.ID), code, values), nil
+}
+
+// location returns the label of the location.
+func (l *labeler) location(loc repo.LocationOut) (labelmaker.GenerateParameters, error) {
+	values := repo.LabelValues{
+		Name:        loc.Name,
+		Description: loc.Description,
+		Location:    loc.Name,
+	}
+
+	if l.tmpl != nil {
+		path, err := l.locationPath(loc.ID)
+		if err != nil {
+			return labelmaker.GenerateParameters{}, err
+		}
+		values.LocationPath = path
 	}
+
+	return l.params(loc.Name, "Homebox Location", fmt.Sprintf("%s/location/%s", l.hbURL, loc.ID), loc.ID.String(), values), nil
 }
 
 // HandleGetLocationLabel godoc
@@ -38,9 +240,13 @@ func generateOrPrint(ctrl *V1Controller, w http.ResponseWriter, r *http.Request,
 //	@Summary	Get Location label
 //	@Tags		Locations
 //	@Produce	json
-//	@Param		id		path		string	true	"Location ID"
-//	@Param		print	query		bool	false	"Print this label, defaults to false"
-//	@Success	200		{string}	string	"image/png"
+//	@Param		id			path		string	true	"Location ID"
+//	@Param		print		query		bool	false	"Print this label, defaults to false"
+//	@Param		template	query		string	false	"Label template ID"
+//	@Param		format		query		string	false	"png, zpl or epl, defaults to png"
+//	@Param		printer		query		string	false	"Printer ID, queues the label for the printer"
+//	@Success	200			{string}	string	"image/png"
+//	@Success	202			{object}	repo.PrintJobOut
 //	@Router		/v1/labelmaker/location/{id} [GET]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleGetLocationLabel() errchain.HandlerFunc {
@@ -50,14 +256,28 @@ func (ctrl *V1Controller) HandleGetLocationLabel() errchain.HandlerFunc {
 			return err
 		}
 
+		templateID, err := queryLabelTemplate(r)
+		if err != nil {
+			return err
+		}
+
 		auth := services.NewContext(r.Context())
 		location, err := ctrl.repo.Locations.GetOneByGroup(auth, auth.GID, ID)
 		if err != nil {
 			return err
 		}
 
-		hbURL := GetHBURL(r.Header.Get("Referer"), ctrl.url)
-		return generateOrPrint(ctrl, w, r, location.Name, "Homebox Location", fmt.Sprintf("%s/location/%s", hbURL, location.ID))
+		l, err := ctrl.newLabeler(auth, r, templateID)
+		if err != nil {
+			return err
+		}
+
+		params, err := l.location(location)
+		if err != nil {
+			return err
+		}
+
+		return generateOrPrint(ctrl, w, r, params)
 	}
 }
 
@@ -66,9 +286,13 @@ func (ctrl *V1Controller) HandleGetLocationLabel() errchain.HandlerFunc {
 //	@Summary	Get Item label
 //	@Tags		Items
 //	@Produce	json
-//	@Param		id		path		string	true	"Item ID"
-//	@Param		print	query		bool	false	"Print this label, defaults to false"
-//	@Success	200		{string}	string	"image/png"
+//	@Param		id			path		string	true	"Item ID"
+//	@Param		print		query		bool	false	"Print this label, defaults to false"
+//	@Param		template	query		string	false	"Label template ID"
+//	@Param		format		query		string	false	"png, zpl or epl, defaults to png"
+//	@Param		printer		query		string	false	"Printer ID, queues the label for the printer"
+//	@Success	200			{string}	string	"image/png"
+//	@Success	202			{object}	repo.PrintJobOut
 //	@Router		/v1/labelmaker/item/{id} [GET]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleGetItemLabel() errchain.HandlerFunc {
@@ -78,20 +302,28 @@ func (ctrl *V1Controller) HandleGetItemLabel() errchain.HandlerFunc {
 			return err
 		}
 
+		templateID, err := queryLabelTemplate(r)
+		if err != nil {
+			return err
+		}
+
 		auth := services.NewContext(r.Context())
 		item, err := ctrl.repo.Items.GetOneByGroup(auth, auth.GID, ID)
 		if err != nil {
 			return err
 		}
 
-		description := ""
+		l, err := ctrl.newLabeler(auth, r, templateID)
+		if err != nil {
+			return err
+		}
 
-		if item.Location != nil {
-			description += fmt.Sprintf("\nLocation: %s", item.Location.Name)
+		params, err := l.item(item, false)
+		if err != nil {
+			return err
 		}
 
-		hbURL := GetHBURL(r.Header.Get("Referer"), ctrl.url)
-		return generateOrPrint(ctrl, w, r, item.Name, description, fmt.Sprintf("%s/item/%s", hbURL, item.ID))
+		return generateOrPrint(ctrl, w, r, params)
 	}
 }
 
@@ -100,37 +332,260 @@ func (ctrl *V1Controller) HandleGetItemLabel() errchain.HandlerFunc {
 //	@Summary	Get Asset label
 //	@Tags		Items
 //	@Produce	json
-//	@Param		id		path		string	true	"Asset ID"
-//	@Param		print	query		bool	false	"Print this label, defaults to false"
-//	@Success	200		{string}	string	"image/png"
+//	@Param		id			path		string	true	"Asset ID"
+//	@Param		print		query		bool	false	"Print this label, defaults to false"
+//	@Param		template	query		string	false	"Label template ID"
+//	@Param		format		query		string	false	"png, zpl or epl, defaults to png"
+//	@Param		printer		query		string	false	"Printer ID, queues the label for the printer"
+//	@Success	200			{string}	string	"image/png"
+//	@Success	202			{object}	repo.PrintJobOut
 //	@Router		/v1/labelmaker/assets/{id} [GET]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleGetAssetLabel() errchain.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) error {
-		assetIDParam := chi.URLParam(r, "id")
-		assetIDParam = strings.ReplaceAll(assetIDParam, "-", "")
-		assetID, err := strconv.ParseInt(assetIDParam, 10, 64)
+		templateID, err := queryLabelTemplate(r)
 		if err != nil {
 			return err
 		}
 
 		auth := services.NewContext(r.Context())
-		item, err := ctrl.repo.Items.QueryByAssetID(auth, auth.GID, repo.AssetID(assetID), 0, 1)
+
+		_, ref, err := ctrl.routeAssetID(auth, r)
+		if err != nil {
+			return err
+		}
+
+		found, err := ctrl.repo.Items.QueryByAssetID(auth, auth.GID, ref, 0, 1)
 		if err != nil {
 			return err
 		}
 
-		if len(item.Items) == 0 {
+		if len(found.Items) == 0 {
 			return validate.NewRequestError(fmt.Errorf("failed to find asset id"), http.StatusNotFound)
 		}
 
-		description := item.Items[0].Name
+		item, err := ctrl.repo.Items.GetOneByGroup(auth, auth.GID, found.Items[0].ID)
+		if err != nil {
+			return err
+		}
+
+		l, err := ctrl.newLabeler(auth, r, templateID)
+		if err != nil {
+			return err
+		}
+
+		params, err := l.item(item, true)
+		if err != nil {
+			return err
+		}
+
+		return generateOrPrint(ctrl, w, r, params)
+	}
+}
+
+// maxSheetLabels is the most labels a sheet can be printed with at once.
+const maxSheetLabels = 1000
+
+type LabelSheetCustomLayout struct {
+	PageSize string  `json:"pageSize" validate:"required,oneof=letter a4"`
+	Rows     int     `json:"rows"     validate:"required,min=1,max=50"`
+	Columns  int     `json:"columns"  validate:"required,min=1,max=20"`
+	MarginMM float64 `json:"marginMm" validate:"min=0"`
+	GapMM    float64 `json:"gapMm"    validate:"min=0"`
+}
+
+type LabelSheetRequest struct {
+	// ItemIDs prints the labels of these items
+	ItemIDs []uuid.UUID `json:"itemIds"`
+	// SavedSearchID prints the labels of the items the saved search finds
+	SavedSearchID uuid.UUID `json:"savedSearchId" extensions:"x-nullable"`
+	// LocationID prints the labels of the location and the locations nested below it
+	LocationID uuid.UUID `json:"locationId" extensions:"x-nullable"`
+	// IncludeItems also prints the labels of the items in those locations
+	IncludeItems bool `json:"includeItems"`
+	// TemplateID lays the labels out with the label template
+	TemplateID uuid.UUID `json:"templateId" extensions:"x-nullable"`
+	// PrinterID queues the labels for the printer instead of returning the PDF. Raw
+	// printers print them one after the other, the others on sheets of the layout.
+	PrinterID uuid.UUID `json:"printerId" extensions:"x-nullable"`
+
+	// Layout is the name of a sheet layout, or custom for the Custom layout
+	Layout string                  `json:"layout" validate:"required"`
+	Custom *LabelSheetCustomLayout `json:"custom,omitempty"`
+	// Skip leaves the first positions of the first sheet empty, for partly used sheets
+	Skip int `json:"skip" validate:"min=0"`
+}
+
+// sheetLayout returns the layout the request asks for.
+func (req LabelSheetRequest) sheetLayout() (labelmaker.SheetLayout, error) {
+	if req.Layout != "custom" {
+		layout, ok := labelmaker.SheetLayouts[req.Layout]
+		if !ok {
+			return layout, fmt.Errorf("unknown layout %q, expected one of %s or custom", req.Layout, strings.Join(labelmaker.SheetLayoutNames(), ", "))
+		}
+		return layout, nil
+	}
+
+	if req.Custom == nil {
+		return labelmaker.SheetLayout{}, errors.New("the custom layout needs its rows and columns")
+	}
+
+	page := labelmaker.PageLetter
+	if req.Custom.PageSize == "a4" {
+		page = labelmaker.PageA4
+	}
+
+	const mm = 72 / 25.4
+	layout := labelmaker.CustomSheetLayout(page[0], page[1], req.Custom.Rows, req.Custom.Columns, req.Custom.MarginMM*mm, req.Custom.GapMM*mm)
+	return layout, layout.Validate()
+}
+
+// sheetLabels collects the labels of the items and locations the request selects.
+func (ctrl *V1Controller) sheetLabels(ctx services.Context, r *http.Request, req LabelSheetRequest) ([]labelmaker.GenerateParameters, error) {
+	var locationIDs []uuid.UUID
+	itemIDs := append([]uuid.UUID{}, req.ItemIDs...)
+
+	var queries []repo.ItemQuery
+
+	if req.SavedSearchID != uuid.Nil {
+		search, err := ctrl.repo.SavedSearches.GetOne(ctx, ctx.GID, ctx.UID, req.SavedSearchID)
+		if err != nil {
+			return nil, err
+		}
+
+		q := search.Query
+		if err := q.ParseSearch(); err != nil {
+			return nil, validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		}
+		queries = append(queries, q)
+	}
+
+	if req.LocationID != uuid.Nil {
+		subtree, err := ctrl.repo.Locations.SubtreeIDs(ctx, ctx.GID, req.LocationID)
+		if err != nil {
+			return nil, err
+		}
+		locationIDs = subtree
 
-		if item.Items[0].Location != nil {
-			description += fmt.Sprintf("\nLocation: %s", item.Items[0].Location.Name)
+		if req.IncludeItems && len(subtree) > 0 {
+			queries = append(queries, repo.ItemQuery{LocationIDs: subtree})
 		}
+	}
 
-		hbURL := GetHBURL(r.Header.Get("Referer"), ctrl.url)
-		return generateOrPrint(ctrl, w, r, item.Items[0].AssetID.String(), description, fmt.Sprintf("%s/a/%s", hbURL, item.Items[0].AssetID.String()))
+	for _, q := range queries {
+		items, err := ctrl.repo.Items.GetAllByQuery(ctx, ctx.GID, q)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			itemIDs = append(itemIDs, item.ID)
+		}
+	}
+
+	seen := map[uuid.UUID]bool{}
+	itemIDs = slices.DeleteFunc(itemIDs, func(id uuid.UUID) bool {
+		dup := seen[id]
+		seen[id] = true
+		return dup
+	})
+
+	switch n := len(locationIDs) + len(itemIDs); {
+	case n == 0:
+		return nil, validate.NewRequestError(errors.New("no labels selected"), http.StatusUnprocessableEntity)
+	case n > maxSheetLabels:
+		return nil, validate.NewRequestError(fmt.Errorf("at most %d labels can be printed at once", maxSheetLabels), http.StatusUnprocessableEntity)
+	}
+
+	l, err := ctrl.newLabeler(ctx, r, req.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]labelmaker.GenerateParameters, 0, len(locationIDs)+len(itemIDs))
+
+	for _, id := range locationIDs {
+		loc, err := ctrl.repo.Locations.GetOneByGroup(ctx, ctx.GID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := l.location(loc)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, p)
+	}
+
+	for _, id := range itemIDs {
+		item, err := ctrl.repo.Items.GetOneByGroup(ctx, ctx.GID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := l.item(item, true)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, p)
+	}
+
+	return params, nil
+}
+
+// HandleGetLabelSheet godoc
+//
+//	@Summary		Get Label Sheet
+//	@Description	Lays out the labels of many items and locations on label sheets and returns them as a PDF.
+//	@Description	The labels are those of the listed items, of the items a saved search finds and of a
+//	@Description	location with the locations nested below it, optionally with their items.
+//	@Description	With a printer the labels are queued for it and the print job is returned.
+//	@Tags			Items
+//	@Produce		application/pdf
+//	@Param			payload	body		LabelSheetRequest	true	"Labels and layout"
+//	@Success		200		{string}	string				"application/pdf"
+//	@Success		202		{object}	repo.PrintJobOut
+//	@Router			/v1/labelmaker/sheet [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleGetLabelSheet() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		req, err := adapters.DecodeBody[LabelSheetRequest](r)
+		if err != nil {
+			return err
+		}
+
+		layout, err := req.sheetLayout()
+		if err != nil {
+			return validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		}
+
+		auth := services.NewContext(r.Context())
+		params, err := ctrl.sheetLabels(auth, r, req)
+		if err != nil {
+			return err
+		}
+
+		if req.PrinterID != uuid.Nil {
+			job, err := ctrl.svc.PrintQueue.Submit(auth, req.PrinterID, fmt.Sprintf("Label sheet of %d labels", len(params)), labelmaker.PrintJob{
+				Labels: params,
+				Sheet:  &layout,
+				Skip:   req.Skip,
+			})
+			if err != nil {
+				return err
+			}
+
+			return server.JSON(w, http.StatusAccepted, job)
+		}
+
+		buf := &bytes.Buffer{}
+		if err := labelmaker.GenerateSheetPDF(buf, params, layout, req.Skip, ctrl.config); err != nil {
+			return err
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", "attachment; filename=labels.pdf")
+		_, err = w.Write(buf.Bytes())
+		return err
 	}
 }
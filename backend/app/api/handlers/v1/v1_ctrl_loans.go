@@ -1,12 +1,14 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/errchain"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
 )
 
@@ -56,7 +58,21 @@ func (ctrl *V1Controller) HandleLoansGetOverdue() errchain.HandlerFunc {
 func (ctrl *V1Controller) HandleLoanCreate() errchain.HandlerFunc {
 	fn := func(r *http.Request, data repo.LoanCreate) (repo.LoanOut, error) {
 		auth := services.NewContext(r.Context())
-		return ctrl.repo.Loans.Create(auth, auth.GID, auth.UID, data)
+
+		scope, err := ctrl.svc.Kiosk.LocationScope(auth)
+		if err != nil {
+			return repo.LoanOut{}, err
+		}
+		data.LocationScope = scope
+
+		loan, err := ctrl.repo.Loans.Create(auth, auth.GID, auth.UID, data)
+		if errors.Is(err, repo.ErrBorrowerNotVerified) || errors.Is(err, repo.ErrItemOutsideLocation) {
+			return repo.LoanOut{}, validate.NewRequestError(err, http.StatusForbidden)
+		}
+		if errors.Is(err, repo.ErrItemQuarantined) || errors.Is(err, repo.ErrItemNotLendable) {
+			return repo.LoanOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		return loan, err
 	}
 
 	return adapters.Action(fn, http.StatusCreated)
@@ -114,7 +130,21 @@ func (ctrl *V1Controller) HandleLoanReturn() errchain.HandlerFunc {
 	fn := func(r *http.Request, ID uuid.UUID, data repo.LoanReturn) (repo.LoanOut, error) {
 		auth := services.NewContext(r.Context())
 		data.ID = ID
-		return ctrl.repo.Loans.Return(auth, auth.GID, auth.UID, data)
+
+		scope, err := ctrl.svc.Kiosk.LocationScope(auth)
+		if err != nil {
+			return repo.LoanOut{}, err
+		}
+		if len(scope) > 0 {
+			data.ReturnLocationID = auth.KioskLocationID
+			data.LocationScope = scope
+		}
+
+		loan, err := ctrl.repo.Loans.Return(auth, auth.GID, auth.UID, data)
+		if errors.Is(err, repo.ErrLoanAlreadyReturned) {
+			return repo.LoanOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		return loan, err
 	}
 
 	return adapters.ActionID("id", fn, http.StatusOK)
@@ -24,7 +24,18 @@ import (
 func (ctrl *V1Controller) HandleLocationTreeQuery() errchain.HandlerFunc {
 	fn := func(r *http.Request, query repo.TreeQuery) ([]repo.TreeItem, error) {
 		auth := services.NewContext(r.Context())
-		return ctrl.repo.Locations.Tree(auth, auth.GID, query)
+
+		tree, err := ctrl.repo.Locations.Tree(auth, auth.GID, query)
+		if err != nil {
+			return nil, err
+		}
+
+		// Kiosks bound to a location only see its branch
+		if auth.IsKiosk && auth.KioskLocationID != uuid.Nil {
+			tree = kioskSubtree(tree, auth.KioskLocationID)
+		}
+
+		return tree, nil
 	}
 
 	return adapters.Query(fn, http.StatusOK)
@@ -68,13 +79,14 @@ func (ctrl *V1Controller) HandleLocationCreate() errchain.HandlerFunc {
 
 // HandleLocationDelete godoc
 //
-//	@Summary	Delete Location
-//	@Tags		Locations
-//	@Produce	json
-//	@Param		id	path	string	true	"Location ID"
-//	@Success	204
-//	@Router		/v1/locations/{id} [DELETE]
-//	@Security	Bearer
+//	@Summary		Delete Location
+//	@Description	Moves the location, the locations nested below it and all of their items to the trash.
+//	@Tags			Locations
+//	@Produce		json
+//	@Param			id	path	string	true	"Location ID"
+//	@Success		204
+//	@Router			/v1/locations/{id} [DELETE]
+//	@Security		Bearer
 func (ctrl *V1Controller) HandleLocationDelete() errchain.HandlerFunc {
 	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
 		auth := services.NewContext(r.Context())
@@ -0,0 +1,204 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+func printerError(err error) error {
+	switch {
+	case errors.Is(err, repo.ErrPrinterAddress):
+		return validate.NewRequestError(err, http.StatusUnprocessableEntity)
+	case errors.Is(err, repo.ErrPrintJobNotCancellable), errors.Is(err, repo.ErrPrintJobNotRetryable):
+		return validate.NewRequestError(err, http.StatusConflict)
+	}
+	return err
+}
+
+// HandlePrintersGetAll godoc
+//
+//	@Summary	Get All Printers
+//	@Tags		Printers
+//	@Produce	json
+//	@Success	200	{object}	[]repo.PrinterOut
+//	@Router		/v1/printers [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandlePrintersGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.PrinterOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Printers.GetAll(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandlePrintersGet godoc
+//
+//	@Summary	Get Printer
+//	@Tags		Printers
+//	@Produce	json
+//	@Param		id	path		string	true	"Printer ID"
+//	@Success	200	{object}	repo.PrinterOut
+//	@Router		/v1/printers/{id} [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandlePrintersGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.PrinterOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Printers.GetOne(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandlePrintersCreate godoc
+//
+//	@Summary		Create Printer
+//	@Description	Printers are reached by kind: raw sends ZPL or EPL to port 9100 of the host in the address,
+//	@Description	ipp sends PDFs to the ipp:// URL in the address, and command runs the server's print command
+//	@Description	with the address as {{.Printer}}.
+//	@Tags			Printers
+//	@Produce		json
+//	@Param			payload	body		repo.PrinterCreate	true	"Printer Data"
+//	@Success		201		{object}	repo.PrinterOut
+//	@Router			/v1/printers [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandlePrintersCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, body repo.PrinterCreate) (repo.PrinterOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.Printers.Create(auth, auth.GID, body)
+		return out, printerError(err)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandlePrintersUpdate godoc
+//
+//	@Summary	Update Printer
+//	@Tags		Printers
+//	@Produce	json
+//	@Param		id		path		string				true	"Printer ID"
+//	@Param		payload	body		repo.PrinterUpdate	true	"Printer Data"
+//	@Success	200		{object}	repo.PrinterOut
+//	@Router		/v1/printers/{id} [PUT]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandlePrintersUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, body repo.PrinterUpdate) (repo.PrinterOut, error) {
+		auth := services.NewContext(r.Context())
+		body.ID = ID
+		out, err := ctrl.repo.Printers.Update(auth, auth.GID, body)
+		return out, printerError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandlePrintersDelete godoc
+//
+//	@Summary		Delete Printer
+//	@Description	Deletes the printer with its print jobs.
+//	@Tags			Printers
+//	@Produce		json
+//	@Param			id	path	string	true	"Printer ID"
+//	@Success		204
+//	@Router			/v1/printers/{id} [DELETE]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandlePrintersDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		err := ctrl.repo.Printers.Delete(auth, auth.GID, ID)
+		return nil, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandlePrintJobsGetAll godoc
+//
+//	@Summary		Query Print Jobs
+//	@Description	The group's print jobs, newest first. Status changes are also sent over the websocket as
+//	@Description	printjob.mutation events with the job's ID and status.
+//	@Tags			Printers
+//	@Produce		json
+//	@Param			query	query		repo.PrintJobQuery	false	"filters"
+//	@Success		200		{object}	repo.PaginationResult[repo.PrintJobOut]{}
+//	@Router			/v1/print-jobs [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandlePrintJobsGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request, q repo.PrintJobQuery) (repo.PaginationResult[repo.PrintJobOut], error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.PrintJobs.GetAll(auth, auth.GID, q)
+	}
+
+	return adapters.Query(fn, http.StatusOK)
+}
+
+// HandlePrintJobsGet godoc
+//
+//	@Summary	Get Print Job
+//	@Tags		Printers
+//	@Produce	json
+//	@Param		id	path		string	true	"Print Job ID"
+//	@Success	200	{object}	repo.PrintJobOut
+//	@Router		/v1/print-jobs/{id} [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandlePrintJobsGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.PrintJobOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.PrintJobs.GetOne(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandlePrintJobsCancel godoc
+//
+//	@Summary		Cancel Print Job
+//	@Description	Cancels a queued or failed job, jobs that are printing can't be cancelled.
+//	@Tags			Printers
+//	@Produce		json
+//	@Param			id	path		string	true	"Print Job ID"
+//	@Success		200	{object}	repo.PrintJobOut
+//	@Router			/v1/print-jobs/{id}/cancel [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandlePrintJobsCancel() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.PrintJobOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.PrintJobs.Cancel(auth, auth.GID, ID)
+		return out, printerError(err)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandlePrintJobsRetry godoc
+//
+//	@Summary		Retry Print Job
+//	@Description	Queues a failed or cancelled job again, with all its attempts.
+//	@Tags			Printers
+//	@Produce		json
+//	@Param			id	path		string	true	"Print Job ID"
+//	@Success		200	{object}	repo.PrintJobOut
+//	@Router			/v1/print-jobs/{id}/retry [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandlePrintJobsRetry() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.PrintJobOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.PrintJobs.Retry(auth, auth.GID, ID)
+		if err != nil {
+			return out, printerError(err)
+		}
+
+		ctrl.svc.PrintQueue.Notify()
+		return out, nil
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
@@ -11,14 +11,20 @@ import (
 //	@Summary	Export Bill of Materials
 //	@Tags		Reporting
 //	@Produce	json
-//	@Success	200	{string}	string	"text/csv"
+//	@Param		savedSearch	query		string	false	"only report on the items of this group-shared saved search"
+//	@Success	200			{string}	string	"text/csv"
 //	@Router		/v1/reporting/bill-of-materials [GET]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleBillOfMaterialsExport() errchain.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		actor := services.UseUserCtx(r.Context())
 
-		csv, err := ctrl.svc.Items.ExportBillOfMaterialsCSV(r.Context(), actor.GroupID)
+		savedSearch, err := querySavedSearch(r)
+		if err != nil {
+			return err
+		}
+
+		csv, err := ctrl.svc.Items.ExportBillOfMaterialsCSV(r.Context(), actor.GroupID, savedSearch)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,187 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/hay-kot/httpkit/server"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleSavedSearchesGetAll godoc
+//
+//	@Summary	Get Saved Searches
+//	@Tags		Saved Searches
+//	@Produce	json
+//	@Success	200	{object}	[]repo.SavedSearchOut
+//	@Router		/v1/saved-searches [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleSavedSearchesGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.SavedSearches.GetAll(auth, auth.GID, auth.UID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleSavedSearchCreate godoc
+//
+//	@Summary		Create Saved Search
+//	@Description	The query's search string and field filters are validated like those of `GET /v1/items`.
+//	@Tags			Saved Searches
+//	@Produce		json
+//	@Param			payload	body		repo.SavedSearchCreate	true	"Saved Search Data"
+//	@Success		201		{object}	repo.SavedSearchOut
+//	@Router			/v1/saved-searches [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleSavedSearchCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, in repo.SavedSearchCreate) (repo.SavedSearchOut, error) {
+		// ParseSearch applies the search to the query, so a copy is checked and the
+		// search is stored as it was written
+		q := in.Query
+		if err := q.ParseSearch(); err != nil {
+			return repo.SavedSearchOut{}, validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		}
+
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.SavedSearches.Create(auth, auth.GID, auth.UID, in)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleSavedSearchGet godoc
+//
+//	@Summary	Get Saved Search
+//	@Tags		Saved Searches
+//	@Produce	json
+//	@Param		id	path		string	true	"Saved Search ID"
+//	@Success	200	{object}	repo.SavedSearchOut
+//	@Router		/v1/saved-searches/{id} [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleSavedSearchGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.SavedSearches.GetOne(auth, auth.GID, auth.UID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleSavedSearchUpdate godoc
+//
+//	@Summary		Update Saved Search
+//	@Description	Only the owner of a saved search can update it.
+//	@Tags			Saved Searches
+//	@Produce		json
+//	@Param			id		path		string					true	"Saved Search ID"
+//	@Param			payload	body		repo.SavedSearchUpdate	true	"Saved Search Data"
+//	@Success		200		{object}	repo.SavedSearchOut
+//	@Router			/v1/saved-searches/{id} [PUT]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleSavedSearchUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, in repo.SavedSearchUpdate) (repo.SavedSearchOut, error) {
+		// ParseSearch applies the search to the query, so a copy is checked and the
+		// search is stored as it was written
+		q := in.Query
+		if err := q.ParseSearch(); err != nil {
+			return repo.SavedSearchOut{}, validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		}
+
+		auth := services.NewContext(r.Context())
+		in.ID = ID
+		return ctrl.repo.SavedSearches.Update(auth, auth.GID, auth.UID, in)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleSavedSearchDelete godoc
+//
+//	@Summary		Delete Saved Search
+//	@Description	Only the owner of a saved search can delete it.
+//	@Tags			Saved Searches
+//	@Param			id	path	string	true	"Saved Search ID"
+//	@Success		204
+//	@Router			/v1/saved-searches/{id} [DELETE]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleSavedSearchDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.repo.SavedSearches.Delete(auth, auth.GID, auth.UID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleSavedSearchItems godoc
+//
+//	@Summary	Run Saved Search
+//	@Tags		Saved Searches
+//	@Produce	json
+//	@Param		id			path		string	true	"Saved Search ID"
+//	@Param		page		query		int		false	"page number"
+//	@Param		pageSize	query		int		false	"items per page"
+//	@Success	200			{object}	repo.PaginationResult[repo.ItemSummary]{}
+//	@Router		/v1/saved-searches/{id}/items [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleSavedSearchItems() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ID, err := ctrl.routeID(r)
+		if err != nil {
+			return err
+		}
+
+		ctx := services.NewContext(r.Context())
+
+		search, err := ctrl.repo.SavedSearches.GetOne(ctx, ctx.GID, ctx.UID, ID)
+		if err != nil {
+			return err
+		}
+
+		query := search.Query
+		if err := query.ParseSearch(); err != nil {
+			return validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		}
+
+		params := r.URL.Query()
+		query.Page = queryIntOrNegativeOne(params.Get("page"))
+		query.PageSize = queryIntOrNegativeOne(params.Get("pageSize"))
+
+		query.LocationScope, err = ctrl.svc.Kiosk.LocationScope(ctx)
+		if err != nil {
+			log.Err(err).Msg("failed to resolve kiosk location")
+			return validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+
+		items, err := ctrl.repo.Items.QueryByGroup(ctx, ctx.GID, query)
+		if err != nil {
+			log.Err(err).Msg("failed to run saved search")
+			return validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+
+		return server.JSON(w, http.StatusOK, items)
+	}
+}
+
+// querySavedSearch returns the saved search selected by the savedSearch query parameter
+// of exports and reports, or uuid.Nil when none is given.
+func querySavedSearch(r *http.Request) (uuid.UUID, error) {
+	v := r.URL.Query().Get("savedSearch")
+	if v == "" {
+		return uuid.Nil, nil
+	}
+
+	id, err := uuid.Parse(v)
+	if err != nil {
+		return uuid.Nil, validate.NewRequestError(err, http.StatusBadRequest)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleItemsLowStock godoc
+//
+//	@Summary		Get Low Stock Items
+//	@Description	Unarchived items whose quantity has dropped below their minimum stock, by name.
+//	@Tags			Items
+//	@Produce		json
+//	@Success		200	{object}	[]repo.ItemSummary
+//	@Router			/v1/items/low-stock [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemsLowStock() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.ItemSummary, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Items.GetLowStock(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleItemIssue godoc
+//
+//	@Summary		Issue Item
+//	@Description	Takes consumables out of stock by decrementing the item's quantity. Unlike a loan,
+//	@Description	nothing is expected back.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id		path		string			true	"Item ID"
+//	@Param			payload	body		repo.ItemIssue	true	"Issue Data"
+//	@Success		200		{object}	repo.ItemOut
+//	@Router			/v1/items/{id}/issue [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemIssue() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data repo.ItemIssue) (repo.ItemOut, error) {
+		auth := services.NewContext(r.Context())
+
+		scope, err := ctrl.svc.Kiosk.LocationScope(auth)
+		if err != nil {
+			return repo.ItemOut{}, err
+		}
+		data.LocationScope = scope
+
+		item, err := ctrl.repo.Items.Issue(auth, auth.GID, ID, data)
+		if errors.Is(err, repo.ErrItemOutsideLocation) {
+			return repo.ItemOut{}, validate.NewRequestError(err, http.StatusForbidden)
+		}
+		if errors.Is(err, repo.ErrInsufficientStock) {
+			return repo.ItemOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		return item, err
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleItemStockMovements godoc
+//
+//	@Summary		Get Item Stock Movements
+//	@Description	Every change to the item's quantity with its reason, newest first.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path		string	true	"Item ID"
+//	@Success		200	{object}	[]repo.StockMovementOut
+//	@Router			/v1/items/{id}/stock-movements [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemStockMovements() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) ([]repo.StockMovementOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.StockMovements.GetByItem(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleItemStockLevel godoc
+//
+//	@Summary		Get Item Stock Level
+//	@Description	The item's quantity at a past point in time. A date gives the quantity at the end of
+//	@Description	that day, the current quantity is returned when no time is given.
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id	path		string	true	"Item ID"
+//	@Param			at	query		string	false	"date (2006-01-02) or RFC 3339 time"
+//	@Success		200	{object}	repo.StockLevel
+//	@Router			/v1/items/{id}/stock-level [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleItemStockLevel() errchain.HandlerFunc {
+	parseTime := func(s string) (time.Time, error) {
+		if s == "" {
+			return time.Now(), nil
+		}
+		if d, err := time.Parse("2006-01-02", s); err == nil {
+			return d.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+		}
+		return time.Parse(time.RFC3339, s)
+	}
+
+	fn := func(r *http.Request, ID uuid.UUID) (repo.StockLevel, error) {
+		auth := services.NewContext(r.Context())
+
+		at, err := parseTime(r.URL.Query().Get("at"))
+		if err != nil {
+			return repo.StockLevel{}, validate.NewRequestError(err, http.StatusBadRequest)
+		}
+
+		return ctrl.repo.StockMovements.LevelAt(auth, auth.GID, ID, at)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleStocktakesGetAll godoc
+//
+//	@Summary	Get All Stocktakes
+//	@Tags		Stocktakes
+//	@Produce	json
+//	@Success	200	{object}	[]repo.StocktakeSummary
+//	@Router		/v1/stocktakes [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleStocktakesGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.StocktakeSummary, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Stocktakes.GetAll(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleStocktakesCreate godoc
+//
+//	@Summary		Start Stocktake
+//	@Description	Starts a stocktake of the location and every location nested below it. The unarchived
+//	@Description	items in service in them are expected to be found.
+//	@Tags			Stocktakes
+//	@Produce		json
+//	@Param			payload	body		repo.StocktakeCreate	true	"Stocktake Data"
+//	@Success		201		{object}	repo.StocktakeSummary
+//	@Router			/v1/stocktakes [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleStocktakesCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, data repo.StocktakeCreate) (repo.StocktakeSummary, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Stocktakes.Create(auth, auth.GID, auth.UID, data)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleStocktakesGet godoc
+//
+//	@Summary	Get Stocktake
+//	@Tags		Stocktakes
+//	@Produce	json
+//	@Param		id	path		string	true	"Stocktake ID"
+//	@Success	200	{object}	repo.StocktakeSummary
+//	@Router		/v1/stocktakes/{id} [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleStocktakesGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.StocktakeSummary, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Stocktakes.GetOne(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleStocktakesDelete godoc
+//
+//	@Summary	Delete Stocktake
+//	@Tags		Stocktakes
+//	@Param		id	path	string	true	"Stocktake ID"
+//	@Success	204
+//	@Router		/v1/stocktakes/{id} [DELETE]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleStocktakesDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.repo.Stocktakes.Delete(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleStocktakesScan godoc
+//
+//	@Summary		Scan Into Stocktake
+//	@Description	Records a scanned asset ID or identifier. Values that match no item are kept for the
+//	@Description	report, values that match several items are rejected with 409.
+//	@Tags			Stocktakes
+//	@Produce		json
+//	@Param			id		path		string				true	"Stocktake ID"
+//	@Param			payload	body		repo.StocktakeScan	true	"Scanned value"
+//	@Success		200		{object}	repo.StocktakeScanOut
+//	@Router			/v1/stocktakes/{id}/scans [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleStocktakesScan() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data repo.StocktakeScan) (repo.StocktakeScanOut, error) {
+		auth := services.NewContext(r.Context())
+
+		// Kiosks bound to a location scan where they stand and only within their location
+		if auth.IsKiosk && auth.KioskLocationID != uuid.Nil {
+			scope, err := ctrl.svc.Kiosk.LocationScope(auth)
+			if err != nil {
+				return repo.StocktakeScanOut{}, err
+			}
+
+			if data.LocationID == uuid.Nil {
+				data.LocationID = auth.KioskLocationID
+			}
+			if !slices.Contains(scope, data.LocationID) {
+				return repo.StocktakeScanOut{}, validate.NewRequestError(errors.New("location is outside the kiosk's location"), http.StatusUnprocessableEntity)
+			}
+		}
+
+		out, err := ctrl.repo.Stocktakes.Scan(auth, auth.GID, ID, auth.UID, data)
+		switch {
+		case errors.Is(err, repo.ErrStocktakeClosed), errors.Is(err, repo.ErrAmbiguousScan):
+			return repo.StocktakeScanOut{}, validate.NewRequestError(err, http.StatusConflict)
+		case errors.Is(err, repo.ErrLocationOutsideStocktake), errors.Is(err, repo.ErrAssetIDCheckDigit):
+			return repo.StocktakeScanOut{}, validate.NewRequestError(err, http.StatusUnprocessableEntity)
+		}
+		return out, err
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleStocktakesReport godoc
+//
+//	@Summary		Get Stocktake Report
+//	@Description	The items of the stocktake sorted into found, missing, misplaced, excused because they
+//	@Description	are on loan and scanned values that match no item.
+//	@Tags			Stocktakes
+//	@Produce		json
+//	@Param			id	path		string	true	"Stocktake ID"
+//	@Success		200	{object}	repo.StocktakeReport
+//	@Router			/v1/stocktakes/{id}/report [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleStocktakesReport() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.StocktakeReport, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Stocktakes.Report(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleStocktakesClose godoc
+//
+//	@Summary		Close Stocktake
+//	@Description	Closes the stocktake and returns its discrepancy report. Optionally moves the misplaced
+//	@Description	items to where they were scanned and marks the missing ones lost.
+//	@Tags			Stocktakes
+//	@Produce		json
+//	@Param			id		path		string				true	"Stocktake ID"
+//	@Param			payload	body		repo.StocktakeClose	true	"Close options"
+//	@Success		200		{object}	repo.StocktakeReport
+//	@Router			/v1/stocktakes/{id}/close [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleStocktakesClose() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data repo.StocktakeClose) (repo.StocktakeReport, error) {
+		auth := services.NewContext(r.Context())
+
+		report, err := ctrl.repo.Stocktakes.Close(auth, auth.GID, ID, auth.UID, data)
+		if errors.Is(err, repo.ErrStocktakeClosed) {
+			return repo.StocktakeReport{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+		return report, err
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
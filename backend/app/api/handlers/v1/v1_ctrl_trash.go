@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleTrashGetAll godoc
+//
+//	@Summary		Get Trash
+//	@Description	Deleted items and locations that have not been purged yet, most recently deleted first.
+//	@Tags			Trash
+//	@Produce		json
+//	@Success		200	{object}	[]repo.TrashEntry
+//	@Router			/v1/trash [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleTrashGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.TrashEntry, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Trash.GetAll(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleTrashRestoreItem godoc
+//
+//	@Summary		Restore Item
+//	@Description	Takes an item out of the trash. Fails when its location is still in the trash.
+//	@Tags			Trash
+//	@Produce		json
+//	@Param			id	path	string	true	"Item ID"
+//	@Success		204
+//	@Router			/v1/trash/items/{id}/restore [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleTrashRestoreItem() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, trashRestoreError(ctrl.repo.Trash.RestoreItem(auth, auth.GID, ID))
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleTrashRestoreLocation godoc
+//
+//	@Summary		Restore Location
+//	@Description	Takes a location out of the trash along with the locations and items deleted with it.
+//	@Tags			Trash
+//	@Produce		json
+//	@Param			id	path	string	true	"Location ID"
+//	@Success		204
+//	@Router			/v1/trash/locations/{id}/restore [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleTrashRestoreLocation() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, trashRestoreError(ctrl.repo.Trash.RestoreLocation(auth, auth.GID, ID))
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+func trashRestoreError(err error) error {
+	if errors.Is(err, repo.ErrTrashParentDeleted) {
+		return validate.NewRequestError(err, http.StatusConflict)
+	}
+	return err
+}
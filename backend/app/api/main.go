@@ -176,6 +176,9 @@ func run(cfg *config.Config) error {
 		app.repos,
 		services.WithAutoIncrementAssetID(cfg.Options.AutoIncrementAssetID),
 		services.WithCurrencies(currencies),
+		services.WithMailer(&app.mailer),
+		services.WithBorrowerVerification(cfg.Borrowers),
+		services.WithLabelMaker(cfg),
 	)
 
 	// =========================================================================
@@ -7,6 +7,8 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/errchain"
 	v1 "github.com/sysadminsmedia/homebox/backend/app/api/handlers/v1"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
@@ -173,6 +175,10 @@ func (a *app) mwKioskContext(next errchain.Handler) errchain.Handler {
 			// User is in kiosk mode
 			isUnlocked := session.IsUnlocked()
 			r = r.WithContext(services.SetKioskCtx(r.Context(), true, isUnlocked))
+
+			if session.LocationID != nil {
+				r = r.WithContext(services.SetKioskLocationCtx(r.Context(), *session.LocationID))
+			}
 		}
 
 		return next.ServeHTTP(w, r)
@@ -197,3 +203,24 @@ func (a *app) mwKioskRestrict(next errchain.Handler) errchain.Handler {
 		return next.ServeHTTP(w, r)
 	})
 }
+
+// mwKioskItemScope hides the item of the route from kiosks bound to a location when it
+// is stored outside that location. This should be called after mwKioskContext.
+func (a *app) mwKioskItemScope(next errchain.Handler) errchain.Handler {
+	return errchain.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			return validate.NewRequestError(err, http.StatusBadRequest)
+		}
+
+		ok, err := a.services.Kiosk.ItemVisible(services.NewContext(r.Context()), id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return validate.NewRequestError(errors.New("item not found"), http.StatusNotFound)
+		}
+
+		return next.ServeHTTP(w, r)
+	})
+}
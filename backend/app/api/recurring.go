@@ -43,6 +43,18 @@ func registerRecurringTasks(app *app, cfg *config.Config, runner *graceful.Runne
 		}
 	}))
 
+	runner.AddPlugin(NewTask("purge-trash", 24*time.Hour, func(ctx context.Context) {
+		before := time.Now().AddDate(0, 0, -cfg.Trash.RetentionDays)
+		n, err := app.repos.Trash.Purge(ctx, before)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to purge trash")
+			return
+		}
+		if n > 0 {
+			log.Info().Int("count", n).Msg("purged deleted items and locations from the trash")
+		}
+	}))
+
 	runner.AddPlugin(NewTask("send-notifications", time.Hour, func(ctx context.Context) {
 		now := time.Now()
 		if now.Hour() == 8 {
@@ -54,6 +66,40 @@ func registerRecurringTasks(app *app, cfg *config.Config, runner *graceful.Runne
 		}
 	}))
 
+	runner.AddPlugin(NewTask("send-low-stock-alerts", time.Hour, func(ctx context.Context) {
+		if time.Now().Hour() != 8 {
+			return
+		}
+
+		err := app.services.BackgroundService.SendLowStockAlerts(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to send low stock alerts")
+		}
+	}))
+
+	runner.AddPlugin(NewTask("lock-idle-kiosks", time.Minute, func(ctx context.Context) {
+		err := app.services.BackgroundService.LockIdleKiosks(ctx, cfg.Kiosk.IdleTimeout)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to lock idle kiosks")
+		}
+	}))
+
+	runner.AddPlugin(NewTask("send-kiosk-offline-alerts", time.Minute, func(ctx context.Context) {
+		err := app.services.BackgroundService.SendKioskOfflineAlerts(ctx, cfg.Kiosk.OfflineAfter)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to send kiosk offline alerts")
+		}
+	}))
+
+	runner.AddFunc("print-queue", app.services.PrintQueue.Run)
+
+	runner.AddPlugin(NewTask("purge-print-jobs", 24*time.Hour, func(ctx context.Context) {
+		_, err := app.repos.PrintJobs.Purge(ctx, time.Now().AddDate(0, 0, -30))
+		if err != nil {
+			log.Error().Err(err).Msg("failed to purge print jobs")
+		}
+	}))
+
 	if cfg.Thumbnail.Enabled {
 		runner.AddFunc("create-thumbnails-subscription", func(ctx context.Context) error {
 			pubsubString, err := utils.GenerateSubPubConn(cfg.Database.PubSubConnString, "thumbnails")
@@ -74,6 +74,7 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 
 		r.Post("/users/register", chain.ToHandlerFunc(v1Ctrl.HandleUserRegistration()))
 		r.Post("/users/login", chain.ToHandlerFunc(v1Ctrl.HandleAuthLogin(providers...)))
+		r.Get("/borrowers/verify", chain.ToHandlerFunc(v1Ctrl.HandleBorrowerVerifyEmail()))
 
 		if a.conf.OIDC.Enabled {
 			r.Get("/users/login/oidc", chain.ToHandlerFunc(v1Ctrl.HandleOIDCLogin()))
@@ -89,6 +90,9 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		// Middleware that blocks operations when in kiosk mode (unless unlocked)
 		kioskRestrictMW := append(userMW, a.mwKioskRestrict)
 
+		// Middleware that hides items outside the location of a bound kiosk
+		kioskItemMW := append(userMW, a.mwKioskItemScope)
+
 		r.Get("/ws/events", chain.ToHandlerFunc(v1Ctrl.HandleCacheWS(), userMW...))
 		r.Get("/users/self", chain.ToHandlerFunc(v1Ctrl.HandleUserSelf(), userMW...))
 		r.Put("/users/self", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfUpdate(), kioskRestrictMW...))
@@ -106,6 +110,7 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		// TODO: I don't like /groups being the URL for users
 		r.Get("/groups", chain.ToHandlerFunc(v1Ctrl.HandleGroupGet(), userMW...))
 		r.Put("/groups", chain.ToHandlerFunc(v1Ctrl.HandleGroupUpdate(), kioskRestrictMW...))
+		r.Put("/groups/asset-id-format", chain.ToHandlerFunc(v1Ctrl.HandleGroupAssetIDFormatUpdate(), kioskRestrictMW...))
 
 		r.Post("/actions/ensure-asset-ids", chain.ToHandlerFunc(v1Ctrl.HandleEnsureAssetID(), kioskRestrictMW...))
 		r.Post("/actions/zero-item-time-fields", chain.ToHandlerFunc(v1Ctrl.HandleItemDateZeroOut(), kioskRestrictMW...))
@@ -132,12 +137,15 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		r.Get("/items", chain.ToHandlerFunc(v1Ctrl.HandleItemsGetAll(), userMW...))
 		r.Post("/items", chain.ToHandlerFunc(v1Ctrl.HandleItemsCreate(), kioskRestrictMW...))
 		r.Post("/items/import", chain.ToHandlerFunc(v1Ctrl.HandleItemsImport(), kioskRestrictMW...))
+		r.Post("/items/bulk", chain.ToHandlerFunc(v1Ctrl.HandleItemsBulk(), kioskRestrictMW...))
 		r.Get("/items/export", chain.ToHandlerFunc(v1Ctrl.HandleItemsExport(), userMW...))
 		r.Get("/items/fields", chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldNames(), userMW...))
 		r.Get("/items/fields/values", chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldValues(), userMW...))
+		r.Get("/items/low-stock", chain.ToHandlerFunc(v1Ctrl.HandleItemsLowStock(), userMW...))
+		r.Get("/items/status-transitions", chain.ToHandlerFunc(v1Ctrl.HandleItemStatusTransitions(), userMW...))
 
-		r.Get("/items/{id}", chain.ToHandlerFunc(v1Ctrl.HandleItemGet(), userMW...))
-		r.Get("/items/{id}/path", chain.ToHandlerFunc(v1Ctrl.HandleItemFullPath(), userMW...))
+		r.Get("/items/{id}", chain.ToHandlerFunc(v1Ctrl.HandleItemGet(), kioskItemMW...))
+		r.Get("/items/{id}/path", chain.ToHandlerFunc(v1Ctrl.HandleItemFullPath(), kioskItemMW...))
 		r.Put("/items/{id}", chain.ToHandlerFunc(v1Ctrl.HandleItemUpdate(), kioskRestrictMW...))
 		r.Patch("/items/{id}", chain.ToHandlerFunc(v1Ctrl.HandleItemPatch(), kioskRestrictMW...))
 		r.Delete("/items/{id}", chain.ToHandlerFunc(v1Ctrl.HandleItemDelete(), kioskRestrictMW...))
@@ -147,18 +155,51 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		r.Put("/items/{id}/attachments/{attachment_id}", chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentUpdate(), kioskRestrictMW...))
 		r.Delete("/items/{id}/attachments/{attachment_id}", chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentDelete(), kioskRestrictMW...))
 
-		r.Get("/items/{id}/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceLogGet(), userMW...))
+		r.Get("/items/{id}/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceLogGet(), kioskItemMW...))
 		r.Post("/items/{id}/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryCreate(), kioskRestrictMW...))
+		r.Post("/items/{id}/inspection", chain.ToHandlerFunc(v1Ctrl.HandleInspectionSignOff(), kioskRestrictMW...))
+		r.Get("/items/{id}/history", chain.ToHandlerFunc(v1Ctrl.HandleItemHistory(), kioskItemMW...))
+		r.Post("/items/{id}/issue", chain.ToHandlerFunc(v1Ctrl.HandleItemIssue(), kioskItemMW...)) // ALLOWED in kiosk
+		r.Get("/items/{id}/stock-movements", chain.ToHandlerFunc(v1Ctrl.HandleItemStockMovements(), kioskItemMW...))
+		r.Get("/items/{id}/stock-level", chain.ToHandlerFunc(v1Ctrl.HandleItemStockLevel(), kioskItemMW...))
+		r.Post("/items/{id}/status", chain.ToHandlerFunc(v1Ctrl.HandleItemStatusTransition(), kioskRestrictMW...))
+		r.Get("/items/{id}/status-history", chain.ToHandlerFunc(v1Ctrl.HandleItemStatusHistory(), kioskItemMW...))
+		r.Get("/items/{id}/identifiers", chain.ToHandlerFunc(v1Ctrl.HandleItemIdentifiersGetAll(), kioskItemMW...))
+		r.Post("/items/{id}/identifiers", chain.ToHandlerFunc(v1Ctrl.HandleItemIdentifiersCreate(), kioskRestrictMW...))
+		r.Delete("/items/{id}/identifiers/{identifier_id}", chain.ToHandlerFunc(v1Ctrl.HandleItemIdentifiersDelete(), kioskRestrictMW...))
+
+		// Post-return inspection queue - restricted in kiosk mode
+		r.Get("/inspections", chain.ToHandlerFunc(v1Ctrl.HandleInspectionQueue(), kioskRestrictMW...))
 
 		r.Get("/assets/{id}", chain.ToHandlerFunc(v1Ctrl.HandleAssetGet(), userMW...))
+		r.Get("/identifiers/lookup", chain.ToHandlerFunc(v1Ctrl.HandleIdentifierLookup(), userMW...)) // ALLOWED in kiosk
+
+		// Stocktakes - kiosks can scan into a running stocktake
+		r.Get("/stocktakes", chain.ToHandlerFunc(v1Ctrl.HandleStocktakesGetAll(), userMW...))
+		r.Post("/stocktakes", chain.ToHandlerFunc(v1Ctrl.HandleStocktakesCreate(), kioskRestrictMW...))
+		r.Get("/stocktakes/{id}", chain.ToHandlerFunc(v1Ctrl.HandleStocktakesGet(), userMW...))
+		r.Delete("/stocktakes/{id}", chain.ToHandlerFunc(v1Ctrl.HandleStocktakesDelete(), kioskRestrictMW...))
+		r.Post("/stocktakes/{id}/scans", chain.ToHandlerFunc(v1Ctrl.HandleStocktakesScan(), userMW...)) // ALLOWED in kiosk
+		r.Get("/stocktakes/{id}/report", chain.ToHandlerFunc(v1Ctrl.HandleStocktakesReport(), kioskRestrictMW...))
+		r.Post("/stocktakes/{id}/close", chain.ToHandlerFunc(v1Ctrl.HandleStocktakesClose(), kioskRestrictMW...))
+
+		// Field Definitions - readable in kiosk mode so kiosks can show typed fields
+		r.Get("/field-definitions", chain.ToHandlerFunc(v1Ctrl.HandleFieldDefinitionsGetAll(), userMW...))
+		r.Post("/field-definitions", chain.ToHandlerFunc(v1Ctrl.HandleFieldDefinitionsCreate(), kioskRestrictMW...))
+		r.Get("/field-definitions/{id}", chain.ToHandlerFunc(v1Ctrl.HandleFieldDefinitionsGet(), userMW...))
+		r.Put("/field-definitions/{id}", chain.ToHandlerFunc(v1Ctrl.HandleFieldDefinitionsUpdate(), kioskRestrictMW...))
+		r.Delete("/field-definitions/{id}", chain.ToHandlerFunc(v1Ctrl.HandleFieldDefinitionsDelete(), kioskRestrictMW...))
 
 		// Item Templates - all restricted in kiosk mode
 		r.Get("/templates", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesGetAll(), userMW...))
 		r.Post("/templates", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesCreate(), kioskRestrictMW...))
+		r.Get("/templates/export", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesExport(), userMW...))
+		r.Post("/templates/import", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesImport(), kioskRestrictMW...))
 		r.Get("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesGet(), userMW...))
 		r.Put("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesUpdate(), kioskRestrictMW...))
 		r.Delete("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesDelete(), kioskRestrictMW...))
 		r.Post("/templates/{id}/create-item", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesCreateItem(), kioskRestrictMW...))
+		r.Post("/templates/{id}/create-items", chain.ToHandlerFunc(v1Ctrl.HandleItemTemplatesCreateItems(), kioskRestrictMW...))
 
 		// Maintenance - read allowed, write restricted in kiosk mode
 		r.Get("/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceGetAll(), userMW...))
@@ -172,14 +213,33 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		r.Delete("/notifiers/{id}", chain.ToHandlerFunc(v1Ctrl.HandleDeleteNotifier(), kioskRestrictMW...))
 		r.Post("/notifiers/test", chain.ToHandlerFunc(v1Ctrl.HandlerNotifierTest(), kioskRestrictMW...))
 
+		// Audit log of the whole group - restricted in kiosk mode
+		r.Get("/audit", chain.ToHandlerFunc(v1Ctrl.HandleAuditGetAll(), kioskRestrictMW...))
+
+		// Trash of deleted items and locations - restricted in kiosk mode
+		r.Get("/trash", chain.ToHandlerFunc(v1Ctrl.HandleTrashGetAll(), kioskRestrictMW...))
+		r.Post("/trash/items/{id}/restore", chain.ToHandlerFunc(v1Ctrl.HandleTrashRestoreItem(), kioskRestrictMW...))
+		r.Post("/trash/locations/{id}/restore", chain.ToHandlerFunc(v1Ctrl.HandleTrashRestoreLocation(), kioskRestrictMW...))
+
+		// Saved Searches - running allowed, managing restricted in kiosk mode
+		r.Get("/saved-searches", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchesGetAll(), userMW...))
+		r.Post("/saved-searches", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchCreate(), kioskRestrictMW...))
+		r.Get("/saved-searches/{id}", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchGet(), userMW...))
+		r.Put("/saved-searches/{id}", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchUpdate(), kioskRestrictMW...))
+		r.Delete("/saved-searches/{id}", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchDelete(), kioskRestrictMW...))
+		r.Get("/saved-searches/{id}/items", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchItems(), userMW...))
+
 		// Borrowers - read allowed, create allowed (for self-registration), update/delete restricted
 		r.Get("/borrowers", chain.ToHandlerFunc(v1Ctrl.HandleBorrowersGetAll(), userMW...))
 		r.Get("/borrowers/active", chain.ToHandlerFunc(v1Ctrl.HandleBorrowersGetActive(), userMW...))
+		r.Get("/borrowers/pending", chain.ToHandlerFunc(v1Ctrl.HandleBorrowersGetPending(), kioskRestrictMW...))
 		r.Post("/borrowers", chain.ToHandlerFunc(v1Ctrl.HandleBorrowersCreate(), userMW...)) // ALLOWED in kiosk
 		r.Get("/borrowers/{id}", chain.ToHandlerFunc(v1Ctrl.HandleBorrowerGet(), userMW...))
 		r.Put("/borrowers/{id}", chain.ToHandlerFunc(v1Ctrl.HandleBorrowerUpdate(), kioskRestrictMW...))
 		r.Delete("/borrowers/{id}", chain.ToHandlerFunc(v1Ctrl.HandleBorrowerDelete(), kioskRestrictMW...))
 		r.Get("/borrowers/{id}/loans", chain.ToHandlerFunc(v1Ctrl.HandleBorrowerLoans(), userMW...))
+		r.Post("/borrowers/{id}/approve", chain.ToHandlerFunc(v1Ctrl.HandleBorrowerApprove(), kioskRestrictMW...))
+		r.Post("/borrowers/{id}/reject", chain.ToHandlerFunc(v1Ctrl.HandleBorrowerReject(), kioskRestrictMW...))
 
 		// Loans - read allowed, create/return allowed (for kiosk checkout/return), update/delete restricted
 		r.Get("/loans", chain.ToHandlerFunc(v1Ctrl.HandleLoansGetActive(), userMW...))
@@ -191,8 +251,8 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		r.Post("/loans/{id}/return", chain.ToHandlerFunc(v1Ctrl.HandleLoanReturn(), userMW...)) // ALLOWED in kiosk
 
 		// Item Loan History
-		r.Get("/items/{id}/loans", chain.ToHandlerFunc(v1Ctrl.HandleItemLoans(), userMW...))
-		r.Get("/items/{id}/current-loan", chain.ToHandlerFunc(v1Ctrl.HandleItemCurrentLoan(), userMW...))
+		r.Get("/items/{id}/loans", chain.ToHandlerFunc(v1Ctrl.HandleItemLoans(), kioskItemMW...))
+		r.Get("/items/{id}/current-loan", chain.ToHandlerFunc(v1Ctrl.HandleItemCurrentLoan(), kioskItemMW...))
 
 		// Kiosk Mode endpoints
 		r.Post("/kiosk/activate", chain.ToHandlerFunc(v1Ctrl.HandleKioskActivate(), userMW...))
@@ -200,6 +260,10 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		r.Get("/kiosk/status", chain.ToHandlerFunc(v1Ctrl.HandleKioskStatus(), userMW...))
 		r.Post("/kiosk/unlock", chain.ToHandlerFunc(v1Ctrl.HandleKioskUnlock(), userMW...))
 		r.Post("/kiosk/lock", chain.ToHandlerFunc(v1Ctrl.HandleKioskLock(), userMW...))
+		r.Post("/kiosk/heartbeat", chain.ToHandlerFunc(v1Ctrl.HandleKioskHeartbeat(), userMW...))
+		r.Post("/kiosk/sync", chain.ToHandlerFunc(v1Ctrl.HandleKioskSync(), userMW...)) // ALLOWED in kiosk
+		r.Get("/kiosk/devices", chain.ToHandlerFunc(v1Ctrl.HandleKioskDevices(), kioskRestrictMW...))
+		r.Put("/kiosk/location", chain.ToHandlerFunc(v1Ctrl.HandleKioskSetLocation(), kioskRestrictMW...))
 
 		// Asset-Like endpoints
 		assetMW := []errchain.Middleware{
@@ -215,10 +279,29 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 			chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentGet(), assetMW...),
 		)
 
+		// Label Templates - readable in kiosk mode so kiosks can print with them
+		r.Get("/label-templates", chain.ToHandlerFunc(v1Ctrl.HandleLabelTemplatesGetAll(), userMW...))
+		r.Post("/label-templates", chain.ToHandlerFunc(v1Ctrl.HandleLabelTemplatesCreate(), kioskRestrictMW...))
+		r.Get("/label-templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleLabelTemplatesGet(), userMW...))
+		r.Put("/label-templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleLabelTemplatesUpdate(), kioskRestrictMW...))
+		r.Delete("/label-templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleLabelTemplatesDelete(), kioskRestrictMW...))
+
+		// Printers - queueing labels is allowed in kiosk mode, managing printers and jobs is not
+		r.Get("/printers", chain.ToHandlerFunc(v1Ctrl.HandlePrintersGetAll(), userMW...))
+		r.Post("/printers", chain.ToHandlerFunc(v1Ctrl.HandlePrintersCreate(), kioskRestrictMW...))
+		r.Get("/printers/{id}", chain.ToHandlerFunc(v1Ctrl.HandlePrintersGet(), userMW...))
+		r.Put("/printers/{id}", chain.ToHandlerFunc(v1Ctrl.HandlePrintersUpdate(), kioskRestrictMW...))
+		r.Delete("/printers/{id}", chain.ToHandlerFunc(v1Ctrl.HandlePrintersDelete(), kioskRestrictMW...))
+		r.Get("/print-jobs", chain.ToHandlerFunc(v1Ctrl.HandlePrintJobsGetAll(), userMW...))
+		r.Get("/print-jobs/{id}", chain.ToHandlerFunc(v1Ctrl.HandlePrintJobsGet(), userMW...))
+		r.Post("/print-jobs/{id}/cancel", chain.ToHandlerFunc(v1Ctrl.HandlePrintJobsCancel(), kioskRestrictMW...))
+		r.Post("/print-jobs/{id}/retry", chain.ToHandlerFunc(v1Ctrl.HandlePrintJobsRetry(), kioskRestrictMW...))
+
 		// Labelmaker
 		r.Get("/labelmaker/location/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetLocationLabel(), userMW...))
-		r.Get("/labelmaker/item/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetItemLabel(), userMW...))
+		r.Get("/labelmaker/item/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetItemLabel(), kioskItemMW...))
 		r.Get("/labelmaker/asset/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetAssetLabel(), userMW...))
+		r.Post("/labelmaker/sheet", chain.ToHandlerFunc(v1Ctrl.HandleGetLabelSheet(), kioskRestrictMW...))
 
 		// Reporting Services
 		r.Get("/reporting/bill-of-materials", chain.ToHandlerFunc(v1Ctrl.HandleBillOfMaterialsExport(), userMW...))
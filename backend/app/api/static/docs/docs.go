@@ -176,49 +176,71 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/currency": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Base"
-                ],
-                "summary": "Currency",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/currencies.Currency"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/groups": {
+        "/v1/audit": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Changes to items, locations, labels, borrowers, loans and maintenance in the group, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Audit"
+                ],
+                "summary": "Query Audit Log",
+                "parameters": [
+                    {
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete"
+                        ],
+                        "type": "string",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "actorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "entityId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "entityType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get Group",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.Group"
+                            "$ref": "#/definitions/repo.PaginationResult-repo_AuditEntryOut"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/borrowers": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -228,31 +250,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
-                ],
-                "summary": "Update Group",
-                "parameters": [
-                    {
-                        "description": "User Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.GroupUpdate"
-                        }
-                    }
+                    "Borrowers"
                 ],
+                "summary": "Get All Borrowers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.Group"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.BorrowerSummary"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/v1/groups/invitations": {
+            },
             "post": {
                 "security": [
                     {
@@ -263,31 +275,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Borrowers"
                 ],
-                "summary": "Create Group Invitation",
+                "summary": "Create Borrower",
                 "parameters": [
                     {
-                        "description": "User Data",
+                        "description": "Borrower Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupInvitationCreate"
+                            "$ref": "#/definitions/repo.BorrowerCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupInvitation"
+                            "$ref": "#/definitions/repo.BorrowerOut"
                         }
                     }
                 }
             }
         },
-        "/v1/groups/statistics": {
+        "/v1/borrowers/active": {
             "get": {
                 "security": [
                     {
@@ -298,20 +310,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Borrowers"
                 ],
-                "summary": "Get Group Statistics",
+                "summary": "Get Active Borrowers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.GroupStatistics"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.BorrowerSummary"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/groups/statistics/labels": {
+        "/v1/borrowers/pending": {
             "get": {
                 "security": [
                     {
@@ -322,50 +337,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Borrowers"
                 ],
-                "summary": "Get Label Statistics",
+                "summary": "Get Borrowers Pending Verification",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.TotalsByOrganizer"
+                                "$ref": "#/definitions/repo.BorrowerSummary"
                             }
                         }
                     }
                 }
             }
         },
-        "/v1/groups/statistics/locations": {
+        "/v1/borrowers/verify": {
             "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Borrowers"
+                ],
+                "summary": "Confirm Borrower Email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Verification Token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Location Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.TotalsByOrganizer"
-                            }
+                            "$ref": "#/definitions/v1.BorrowerVerifyResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/groups/statistics/purchase-price": {
+        "/v1/borrowers/{id}": {
             "get": {
                 "security": [
                     {
@@ -376,35 +392,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Borrowers"
                 ],
-                "summary": "Get Purchase Price Statistics",
+                "summary": "Get Borrower",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "start date",
-                        "name": "start",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "end date",
-                        "name": "end",
-                        "in": "query"
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ValueOverTime"
+                            "$ref": "#/definitions/repo.BorrowerOut"
                         }
                     }
                 }
-            }
-        },
-        "/v1/items": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
@@ -414,69 +423,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Borrowers"
                 ],
-                "summary": "Query All Items",
+                "summary": "Update Borrower",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "search string",
-                        "name": "q",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "items per page",
-                        "name": "pageSize",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "label Ids",
-                        "name": "labels",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "location Ids",
-                        "name": "locations",
-                        "in": "query"
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "parent Ids",
-                        "name": "parentIds",
-                        "in": "query"
+                        "description": "Borrower Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.BorrowerUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.PaginationResult-repo_ItemSummary"
+                            "$ref": "#/definitions/repo.BorrowerOut"
                         }
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
@@ -486,52 +463,65 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Borrowers"
                 ],
-                "summary": "Create Item",
+                "summary": "Delete Borrower",
                 "parameters": [
                     {
-                        "description": "Item Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.ItemCreate"
-                        }
+                        "type": "string",
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/repo.ItemSummary"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/items/export": {
-            "get": {
+        "/v1/borrowers/{id}/approve": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Items"
+                    "Borrowers"
+                ],
+                "summary": "Approve Pending Borrower",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Export Items",
                 "responses": {
                     "200": {
-                        "description": "text/csv",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/repo.BorrowerOut"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/validate.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/items/fields": {
+        "/v1/borrowers/{id}/loans": {
             "get": {
                 "security": [
                     {
@@ -542,24 +532,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Borrowers"
+                ],
+                "summary": "Get Borrower's Loans",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get All Custom Field Names",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/repo.LoanSummary"
                             }
                         }
                     }
                 }
             }
         },
-        "/v1/items/fields/values": {
-            "get": {
+        "/v1/borrowers/{id}/reject": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -569,56 +568,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Borrowers"
                 ],
-                "summary": "Get All Custom Field Values",
-                "responses": {
+                "summary": "Reject Pending Borrower",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Borrower ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/repo.BorrowerOut"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/validate.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/items/import": {
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/v1/currency": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
-                ],
-                "summary": "Import Items",
-                "parameters": [
-                    {
-                        "type": "file",
-                        "description": "Image to upload",
-                        "name": "csv",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "Base"
                 ],
+                "summary": "Currency",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/currencies.Currency"
+                        }
                     }
                 }
             }
         },
-        "/v1/items/{id}": {
+        "/v1/field-definitions": {
             "get": {
                 "security": [
                     {
@@ -629,68 +626,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
-                ],
-                "summary": "Get Item",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Field Definitions"
                 ],
+                "summary": "Get All Field Definitions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.FieldDefinitionOut"
+                            }
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Item and template fields with the definition's name take its type. Select and\nmultiselect definitions list the allowed options.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Field Definitions"
                 ],
-                "summary": "Update Item",
+                "summary": "Create Field Definition",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Item Data",
+                        "description": "Field Definition Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemUpdate"
+                            "$ref": "#/definitions/repo.FieldDefinitionCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "$ref": "#/definitions/repo.FieldDefinitionOut"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/field-definitions/{id}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -700,52 +687,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Field Definitions"
                 ],
-                "summary": "Delete Item",
+                "summary": "Get Field Definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Field Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.FieldDefinitionOut"
+                        }
                     }
                 }
             },
-            "patch": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Renaming a definition renames the fields that use it. The type can only be changed\nwhile no item or template has the field.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Field Definitions"
                 ],
-                "summary": "Update Item",
+                "summary": "Update Field Definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Field Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Item Data",
+                        "description": "Field Definition Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemPatch"
+                            "$ref": "#/definitions/repo.FieldDefinitionUpdate"
                         }
                     }
                 ],
@@ -753,81 +744,42 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "$ref": "#/definitions/repo.FieldDefinitionOut"
                         }
                     }
                 }
-            }
-        },
-        "/v1/items/{id}/attachments": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Fields that used a select or multiselect definition are kept as text fields.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items Attachments"
+                    "Field Definitions"
                 ],
-                "summary": "Create Item Attachment",
+                "summary": "Delete Field Definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Field Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "File attachment",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Type of file",
-                        "name": "type",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Is this the primary attachment",
-                        "name": "primary",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "name of the file including extension",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/validate.ErrorResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/items/{id}/attachments/{attachment_id}": {
+        "/v1/groups": {
             "get": {
                 "security": [
                     {
@@ -835,33 +787,17 @@ const docTemplate = `{
                     }
                 ],
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "Items Attachments"
-                ],
-                "summary": "Get Item Attachment",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Attachment ID",
-                        "name": "attachment_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Group"
                 ],
+                "summary": "Get Group",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.ItemAttachmentToken"
+                            "$ref": "#/definitions/repo.Group"
                         }
                     }
                 }
@@ -872,32 +808,21 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Items Attachments"
+                    "Group"
                 ],
-                "summary": "Update Item Attachment",
+                "summary": "Update Group",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Attachment ID",
-                        "name": "attachment_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Attachment Update",
+                        "description": "User Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemAttachmentUpdate"
+                            "$ref": "#/definitions/repo.GroupUpdate"
                         }
                     }
                 ],
@@ -905,45 +830,49 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "$ref": "#/definitions/repo.Group"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/groups/asset-id-format": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Changes how asset IDs are written, e.g. HB-0042 for the prefix HB- and a width of 4.\nA width of 0 writes them as 000-000. Labels with an asset ID prefix number their items in\ntheir own series with the same width and check digit.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Items Attachments"
+                    "Group"
                 ],
-                "summary": "Delete Item Attachment",
+                "summary": "Update Group Asset ID Format",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Attachment ID",
-                        "name": "attachment_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Asset ID Format",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.AssetIDFormat"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.Group"
+                        }
                     }
                 }
             }
         },
-        "/v1/items/{id}/duplicate": {
+        "/v1/groups/invitations": {
             "post": {
                 "security": [
                     {
@@ -954,38 +883,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Duplicate Item",
+                "summary": "Create Group Invitation",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Duplicate Options",
+                        "description": "User Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.DuplicateOptions"
+                            "$ref": "#/definitions/v1.GroupInvitationCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "$ref": "#/definitions/v1.GroupInvitation"
                         }
                     }
                 }
             }
         },
-        "/v1/items/{id}/maintenance": {
+        "/v1/groups/statistics": {
             "get": {
                 "security": [
                     {
@@ -996,46 +918,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item Maintenance"
-                ],
-                "summary": "Get Maintenance Log",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "scheduled",
-                            "completed",
-                            "both"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "MaintenanceFilterStatusScheduled",
-                            "MaintenanceFilterStatusCompleted",
-                            "MaintenanceFilterStatusBoth"
-                        ],
-                        "name": "status",
-                        "in": "query"
-                    }
+                    "Statistics"
                 ],
+                "summary": "Get Group Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.MaintenanceEntryWithDetails"
-                            }
+                            "$ref": "#/definitions/repo.GroupStatistics"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/groups/statistics/labels": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -1045,38 +942,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item Maintenance"
-                ],
-                "summary": "Create Maintenance Entry",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Entry Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntryCreate"
-                        }
-                    }
+                    "Statistics"
                 ],
+                "summary": "Get Label Statistics",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntry"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TotalsByOrganizer"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/items/{id}/path": {
+        "/v1/groups/statistics/locations": {
             "get": {
                 "security": [
                     {
@@ -1087,32 +969,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
-                ],
-                "summary": "Get the full path of an item",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Statistics"
                 ],
+                "summary": "Get Location Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.ItemPath"
+                                "$ref": "#/definitions/repo.TotalsByOrganizer"
                             }
                         }
                     }
                 }
             }
         },
-        "/v1/labelmaker/assets/{id}": {
+        "/v1/groups/statistics/purchase-price": {
             "get": {
                 "security": [
                     {
@@ -1123,74 +996,71 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Statistics"
                 ],
-                "summary": "Get Asset label",
+                "summary": "Get Purchase Price Statistics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Asset ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "start date",
+                        "name": "start",
+                        "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Print this label, defaults to false",
-                        "name": "print",
+                        "type": "string",
+                        "description": "end date",
+                        "name": "end",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "image/png",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/repo.ValueOverTime"
                         }
                     }
                 }
             }
         },
-        "/v1/labelmaker/item/{id}": {
+        "/v1/identifiers/lookup": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Resolves a scanned value to the items it identifies, by asset ID in the group's format\nor by any of their identifiers. Values are compared ignoring case.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Items"
                 ],
-                "summary": "Get Item label",
+                "summary": "Lookup Identifier",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "scanned value",
+                        "name": "value",
+                        "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Print this label, defaults to false",
-                        "name": "print",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "image/png",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.IdentifierMatch"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/labelmaker/location/{id}": {
+        "/v1/inspections": {
             "get": {
                 "security": [
                     {
@@ -1201,56 +1071,118 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
-                ],
-                "summary": "Get Location label",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Location ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Print this label, defaults to false",
-                        "name": "print",
-                        "in": "query"
-                    }
+                    "Items"
                 ],
+                "summary": "Get Inspection Queue",
                 "responses": {
                     "200": {
-                        "description": "image/png",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.ItemSummary"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/labels": {
+        "/v1/items": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "The search string accepts filters such as ` + "`" + `label:camera loc:\"Studio A\" qty\u003e2 -archived serial:AB* field.Color=red` + "`" + `.\nMalformed queries are rejected with 422 and the position of the error.\nCustom field filters compare numbers with number and decimal fields and dates with time fields.\nFilters on the same field match when any of them does, filters on different fields must all match.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Items"
+                ],
+                "summary": "Query All Items",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search string, matched as word prefixes and ranked by relevance",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "label Ids",
+                        "name": "labels",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "location Ids",
+                        "name": "locations",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "parent Ids",
+                        "name": "parentIds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "custom field filters: Name=value, Name[op]=value with op eq, ne, lt, gt or contains, Name[between]=from..to or Name[exists]",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "lifecycle statuses",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "relevance (default when searching), name, createdAt, updatedAt or assetId",
+                        "name": "orderBy",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get All Labels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.LabelOut"
-                            }
+                            "$ref": "#/definitions/repo.PaginationResult-repo_ItemSummary"
                         }
                     }
                 }
@@ -1265,94 +1197,97 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Items"
                 ],
-                "summary": "Create Label",
+                "summary": "Create Item",
                 "parameters": [
                     {
-                        "description": "Label Data",
+                        "description": "Item Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.LabelCreate"
+                            "$ref": "#/definitions/repo.ItemCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.LabelSummary"
+                            "$ref": "#/definitions/repo.ItemSummary"
                         }
                     }
                 }
             }
         },
-        "/v1/labels/{id}": {
-            "get": {
+        "/v1/items/bulk": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Selects items by ID or with a query and sets their location, adds or removes labels, sets\narchived, sets a custom field, or moves them to the trash. All changes are made in one transaction: if any\nitem fails nothing is changed and ` + "`" + `committed` + "`" + ` is false. Items not found are reported and skipped.\nQueries need at least one filter, so that a blank query can't select every item.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Items"
                 ],
-                "summary": "Get Label",
+                "summary": "Bulk Update Items",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Label ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Items and operations",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemBulkRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.LabelOut"
+                            "$ref": "#/definitions/repo.ItemBulkOut"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/items/export": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Labels"
+                    "Items"
                 ],
-                "summary": "Update Label",
+                "summary": "Export Items",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Label ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "only export the items of this group-shared saved search",
+                        "name": "savedSearch",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "text/csv",
                         "schema": {
-                            "$ref": "#/definitions/repo.LabelOut"
+                            "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/items/fields": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -1362,26 +1297,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
-                ],
-                "summary": "Delete Label",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Label ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Items"
                 ],
+                "summary": "Get All Custom Field Names",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/v1/locations": {
+        "/v1/items/fields/values": {
             "get": {
                 "security": [
                     {
@@ -1392,64 +1324,91 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
-                ],
-                "summary": "Get All Locations",
-                "parameters": [
-                    {
-                        "type": "boolean",
-                        "description": "Filter locations with parents",
-                        "name": "filterChildren",
-                        "in": "query"
-                    }
+                    "Items"
                 ],
+                "summary": "Get All Custom Field Values",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.LocationOutCount"
+                                "type": "string"
                             }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/items/import": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Rows whose identifiers are already used by another item are imported without those identifiers, the rows are listed in the 409 response.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
+                    "Items"
                 ],
-                "summary": "Create Location",
+                "summary": "Import Items",
                 "parameters": [
                     {
-                        "description": "Location Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
+                        "type": "file",
+                        "description": "Image to upload",
+                        "name": "csv",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationCreate"
+                            "$ref": "#/definitions/validate.ErrorResponse"
                         }
                     }
+                }
+            }
+        },
+        "/v1/items/low-stock": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Unarchived items whose quantity has dropped below their minimum stock, by name.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
                 ],
+                "summary": "Get Low Stock Items",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationSummary"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.ItemSummary"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/locations/tree": {
+        "/v1/items/status-transitions": {
             "get": {
                 "security": [
                     {
@@ -1460,31 +1419,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
-                ],
-                "summary": "Get Locations Tree",
-                "parameters": [
-                    {
-                        "type": "boolean",
-                        "description": "include items in response tree",
-                        "name": "withItems",
-                        "in": "query"
-                    }
+                    "Items"
                 ],
+                "summary": "Get Item Status Transitions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.TreeItem"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
                             }
                         }
                     }
                 }
             }
         },
-        "/v1/locations/{id}": {
+        "/v1/items/{id}": {
             "get": {
                 "security": [
                     {
@@ -1495,13 +1449,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
+                    "Items"
                 ],
-                "summary": "Get Location",
+                "summary": "Get Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1511,7 +1465,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationOut"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
@@ -1526,24 +1480,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
+                    "Items"
                 ],
-                "summary": "Update Location",
+                "summary": "Update Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Location Data",
+                        "description": "Item Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationUpdate"
+                            "$ref": "#/definitions/repo.ItemUpdate"
                         }
                     }
                 ],
@@ -1551,7 +1505,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationOut"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
@@ -1562,17 +1516,18 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
+                "description": "Moves the item to the trash, where it can be restored until it is purged.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
+                    "Items"
                 ],
-                "summary": "Delete Location",
+                "summary": "Delete Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1583,54 +1538,8 @@ const docTemplate = `{
                         "description": "No Content"
                     }
                 }
-            }
-        },
-        "/v1/maintenance": {
-            "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Maintenance"
-                ],
-                "summary": "Query All Maintenance",
-                "parameters": [
-                    {
-                        "enum": [
-                            "scheduled",
-                            "completed",
-                            "both"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "MaintenanceFilterStatusScheduled",
-                            "MaintenanceFilterStatusCompleted",
-                            "MaintenanceFilterStatusBoth"
-                        ],
-                        "name": "status",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.MaintenanceEntryWithDetails"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/maintenance/{id}": {
-            "put": {
+            },
+            "patch": {
                 "security": [
                     {
                         "Bearer": []
@@ -1640,24 +1549,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Maintenance"
+                    "Items"
                 ],
-                "summary": "Update Maintenance Entry",
+                "summary": "Update Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Entry Data",
+                        "description": "Item Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntryUpdate"
+                            "$ref": "#/definitions/repo.ItemPatch"
                         }
                     }
                 ],
@@ -1665,131 +1574,119 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntry"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/items/{id}/attachments": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Maintenance"
+                    "Items Attachments"
                 ],
-                "summary": "Delete Maintenance Entry",
+                "summary": "Create Item Attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Maintenance ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
-            }
-        },
-        "/v1/notifiers": {
-            "get": {
-                "security": [
+                    },
                     {
-                        "Bearer": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Notifiers"
-                ],
-                "summary": "Get Notifiers",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.NotifierOut"
-                            }
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
+                        "type": "file",
+                        "description": "File attachment",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
-                        "Bearer": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Notifiers"
-                ],
-                "summary": "Create Notifier",
-                "parameters": [
+                        "type": "string",
+                        "description": "Type of file",
+                        "name": "type",
+                        "in": "formData"
+                    },
                     {
-                        "description": "Notifier Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.NotifierCreate"
-                        }
+                        "type": "boolean",
+                        "description": "Is this the primary attachment",
+                        "name": "primary",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name of the file including extension",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierOut"
+                            "$ref": "#/definitions/repo.ItemOut"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/validate.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/notifiers/test": {
-            "post": {
+        "/v1/items/{id}/attachments/{attachment_id}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Items Attachments"
                 ],
-                "summary": "Test Notifier",
+                "summary": "Get Item Attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "URL",
-                        "name": "url",
-                        "in": "query",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ItemAttachmentToken"
+                        }
                     }
                 }
-            }
-        },
-        "/v1/notifiers/{id}": {
+            },
             "put": {
                 "security": [
                     {
@@ -1797,24 +1694,31 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Items Attachments"
                 ],
-                "summary": "Update Notifier",
+                "summary": "Update Item Attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notifier ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Notifier Data",
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Attachment Update",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierUpdate"
+                            "$ref": "#/definitions/repo.ItemAttachmentUpdate"
                         }
                     }
                 ],
@@ -1822,7 +1726,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierOut"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
@@ -1834,16 +1738,23 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Items Attachments"
                 ],
-                "summary": "Delete a Notifier",
+                "summary": "Delete Item Attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notifier ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1853,7 +1764,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/products/search-from-barcode": {
+        "/v1/items/{id}/current-loan": {
             "get": {
                 "security": [
                     {
@@ -1866,30 +1777,28 @@ const docTemplate = `{
                 "tags": [
                     "Items"
                 ],
-                "summary": "Search EAN from Barcode",
+                "summary": "Get Item's Current Loan",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "barcode to be searched",
-                        "name": "data",
-                        "in": "query"
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.BarcodeProduct"
-                            }
+                            "$ref": "#/definitions/repo.LoanOut"
                         }
                     }
                 }
             }
         },
-        "/v1/qrcode": {
-            "get": {
+        "/v1/items/{id}/duplicate": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -1901,26 +1810,36 @@ const docTemplate = `{
                 "tags": [
                     "Items"
                 ],
-                "summary": "Create QR Code",
+                "summary": "Duplicate Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "data to be encoded into qrcode",
-                        "name": "data",
-                        "in": "query"
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Duplicate Options",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.DuplicateOptions"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "image/jpeg",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
             }
         },
-        "/v1/reporting/bill-of-materials": {
+        "/v1/items/{id}/history": {
             "get": {
                 "security": [
                     {
@@ -1931,39 +1850,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reporting"
+                    "Items"
                 ],
-                "summary": "Export Bill of Materials",
-                "responses": {
-                    "200": {
-                        "description": "text/csv",
-                        "schema": {
-                            "type": "string"
-                        }
+                "summary": "Get Item History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
-                }
-            }
-        },
-        "/v1/status": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Base"
                 ],
-                "summary": "Application Info",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.APISummary"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.AuditEntryOut"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/templates": {
+        "/v1/items/{id}/identifiers": {
             "get": {
                 "security": [
                     {
@@ -1974,16 +1886,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item Templates"
+                    "Items"
+                ],
+                "summary": "Get Item Identifiers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get All Item Templates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.ItemTemplateSummary"
+                                "$ref": "#/definitions/repo.ItemIdentifierOut"
                             }
                         }
                     }
@@ -1995,21 +1916,29 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
+                "description": "Adds a scannable code to the item, like the manufacturer's barcode or the UID of an\nNFC sticker. Type and value are unique within the group, ignoring case.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Item Templates"
+                    "Items"
                 ],
-                "summary": "Create Item Template",
+                "summary": "Create Item Identifier",
                 "parameters": [
                     {
-                        "description": "Template Data",
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Identifier Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemTemplateCreate"
+                            "$ref": "#/definitions/repo.ItemIdentifierCreate"
                         }
                     }
                 ],
@@ -2017,72 +1946,119 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemTemplateOut"
+                            "$ref": "#/definitions/repo.ItemIdentifierOut"
                         }
                     }
                 }
             }
         },
-        "/v1/templates/{id}": {
-            "get": {
+        "/v1/items/{id}/identifiers/{identifier_id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Item Templates"
+                    "Items"
                 ],
-                "summary": "Get Item Template",
+                "summary": "Delete Item Identifier",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Template ID",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Identifier ID",
+                        "name": "identifier_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/items/{id}/inspection": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Releases an item from post-return quarantine and records the inspection in its maintenance log.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Sign Off Item Inspection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Inspection Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.InspectionSignOff"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemTemplateOut"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/items/{id}/issue": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Takes consumables out of stock by decrementing the item's quantity. Unlike a loan,\nnothing is expected back.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Item Templates"
+                    "Items"
                 ],
-                "summary": "Update Item Template",
+                "summary": "Issue Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Template ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Template Data",
+                        "description": "Issue Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemTemplateUpdate"
+                            "$ref": "#/definitions/repo.ItemIssue"
                         }
                     }
                 ],
@@ -2090,12 +2066,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemTemplateOut"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/items/{id}/loans": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -2105,26 +2083,81 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item Templates"
+                    "Items"
                 ],
-                "summary": "Delete Item Template",
+                "summary": "Get Item's Loan History",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Template ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LoanSummary"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/v1/templates/{id}/create-item": {
+        "/v1/items/{id}/maintenance": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Maintenance"
+                ],
+                "summary": "Get Maintenance Log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "scheduled",
+                            "completed",
+                            "both"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "MaintenanceFilterStatusScheduled",
+                            "MaintenanceFilterStatusCompleted",
+                            "MaintenanceFilterStatusBoth"
+                        ],
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.MaintenanceEntryWithDetails"
+                            }
+                        }
+                    }
+                }
+            },
             "post": {
                 "security": [
                     {
@@ -2135,24 +2168,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item Templates"
+                    "Item Maintenance"
                 ],
-                "summary": "Create Item from Template",
+                "summary": "Create Maintenance Entry",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Template ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Item Data",
+                        "description": "Entry Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.ItemTemplateCreateItemRequest"
+                            "$ref": "#/definitions/repo.MaintenanceEntryCreate"
                         }
                     }
                 ],
@@ -2160,197 +2193,254 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "$ref": "#/definitions/repo.MaintenanceEntry"
                         }
                     }
                 }
             }
         },
-        "/v1/users/change-password": {
-            "put": {
+        "/v1/items/{id}/path": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "User"
+                    "Items"
                 ],
-                "summary": "Change Password",
+                "summary": "Get the full path of an item",
                 "parameters": [
                     {
-                        "description": "Password Payload",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.ChangePassword"
-                        }
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.ItemPath"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/v1/users/login": {
+        "/v1/items/{id}/status": {
             "post": {
-                "consumes": [
-                    "application/x-www-form-urlencoded",
-                    "application/json"
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
+                "description": "Moves the item to another lifecycle status. Only the transitions in the allowed-transition\ntable are accepted, others are rejected with 409. Only items in service can be lent.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Items"
                 ],
-                "summary": "User Login",
+                "summary": "Change Item Status",
                 "parameters": [
                     {
-                        "description": "Login Data",
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status and reason",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.LoginForm"
+                            "$ref": "#/definitions/repo.ItemStatusTransition"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "auth provider",
-                        "name": "provider",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.TokenResponse"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
             }
         },
-        "/v1/users/login/oidc": {
+        "/v1/items/{id}/status-history": {
             "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Every lifecycle status change of the item with its reason, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
-                ],
-                "summary": "OIDC Login Initiation",
-                "responses": {
-                    "302": {
-                        "description": "Found"
-                    }
-                }
-            }
-        },
-        "/v1/users/login/oidc/callback": {
-            "get": {
-                "tags": [
-                    "Authentication"
+                    "Items"
                 ],
-                "summary": "OIDC Callback Handler",
+                "summary": "Get Item Status History",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Authorization code",
-                        "name": "code",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "State parameter",
-                        "name": "state",
-                        "in": "query",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "302": {
-                        "description": "Found"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.ItemStatusChangeOut"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/v1/users/logout": {
-            "post": {
+        "/v1/items/{id}/stock-level": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "The item's quantity at a past point in time. A date gives the quantity at the end of\nthat day, the current quantity is returned when no time is given.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Authentication"
+                    "Items"
+                ],
+                "summary": "Get Item Stock Level",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "date (2006-01-02) or RFC 3339 time",
+                        "name": "at",
+                        "in": "query"
+                    }
                 ],
-                "summary": "User Logout",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.StockLevel"
+                        }
                     }
                 }
             }
         },
-        "/v1/users/refresh": {
+        "/v1/items/{id}/stock-movements": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "handleAuthRefresh returns a handler that will issue a new token from an existing token.\nThis does not validate that the user still exists within the database.",
+                "description": "Every change to the item's quantity with its reason, newest first.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Authentication"
+                    "Items"
+                ],
+                "summary": "Get Item Stock Movements",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "User Token Refresh",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.StockMovementOut"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/v1/users/register": {
+        "/v1/kiosk/activate": {
             "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Kiosk"
                 ],
-                "summary": "Register New User",
-                "parameters": [
-                    {
-                        "description": "User Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
+                "summary": "Activate Kiosk Mode",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/services.UserRegistration"
+                            "$ref": "#/definitions/v1.KioskStatusResponse"
                         }
                     }
+                }
+            }
+        },
+        "/v1/kiosk/deactivate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Kiosk"
                 ],
+                "summary": "Deactivate Kiosk Mode",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "403": {
-                        "description": "Local login is not enabled",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/v1.KioskStatusResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/users/self": {
+        "/v1/kiosk/devices": {
             "get": {
                 "security": [
                     {
@@ -2361,51 +2451,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Kiosk"
                 ],
-                "summary": "Get User Self",
+                "summary": "Get Kiosk Device Health",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/v1.Wrapped"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "item": {
-                                            "$ref": "#/definitions/repo.UserOut"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.KioskDevice"
+                            }
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/kiosk/heartbeat": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Kiosk"
                 ],
-                "summary": "Update Account",
+                "summary": "Kiosk Heartbeat",
                 "parameters": [
                     {
-                        "description": "User Data",
+                        "description": "Device State",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.UserUpdate"
+                            "$ref": "#/definitions/repo.KioskHeartbeat"
                         }
                     }
                 ],
@@ -2413,1692 +2499,8339 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/v1.Wrapped"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "item": {
-                                            "$ref": "#/definitions/repo.UserUpdate"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/v1.KioskStatusResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/kiosk/location": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Limits browsing, checkout and return on the kiosk to items within the location and its children.\nReturns of items stored elsewhere are accepted but flagged as transfers.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Kiosk"
+                ],
+                "summary": "Bind Kiosk to Location",
+                "parameters": [
+                    {
+                        "description": "Location",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.KioskLocationRequest"
+                        }
+                    }
                 ],
-                "summary": "Delete Account",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.KioskStatusResponse"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "attachment.Type": {
-            "type": "string",
-            "enum": [
-                "attachment",
-                "photo",
-                "manual",
-                "warranty",
-                "attachment",
-                "receipt",
-                "thumbnail"
-            ],
-            "x-enum-varnames": [
-                "DefaultType",
-                "TypePhoto",
-                "TypeManual",
-                "TypeWarranty",
-                "TypeAttachment",
-                "TypeReceipt",
-                "TypeThumbnail"
-            ]
-        },
-        "authroles.Role": {
-            "type": "string",
-            "enum": [
-                "user",
-                "admin",
-                "user",
-                "attachments"
-            ],
-            "x-enum-varnames": [
-                "DefaultRole",
-                "RoleAdmin",
-                "RoleUser",
-                "RoleAttachments"
-            ]
         },
-        "currencies.Currency": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "decimals": {
-                    "type": "integer"
-                },
-                "local": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "symbol": {
-                    "type": "string"
+        "/v1/kiosk/lock": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Kiosk"
+                ],
+                "summary": "Lock Kiosk Mode (Revoke Temporary Admin Access)",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.KioskStatusResponse"
+                        }
+                    }
                 }
             }
         },
-        "ent.Attachment": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
-                },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AttachmentQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.AttachmentEdges"
+        "/v1/kiosk/status": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Kiosk"
+                ],
+                "summary": "Get Kiosk Status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.KioskStatusResponse"
                         }
-                    ]
-                },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                    }
+                }
+            }
+        },
+        "/v1/kiosk/sync": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Applies checkouts, returns and borrower registrations a kiosk queued while offline.\nEvery action is applied at most once per idempotency key.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Kiosk"
+                ],
+                "summary": "Sync Offline Kiosk Actions",
+                "parameters": [
+                    {
+                        "description": "Queued Actions",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/services.KioskSyncRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/services.KioskSyncResult"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/kiosk/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Kiosk"
+                ],
+                "summary": "Unlock Kiosk Mode (Temporary Admin Access)",
+                "parameters": [
+                    {
+                        "description": "Unlock Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.KioskUnlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.KioskStatusResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/label-templates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Label Templates"
+                ],
+                "summary": "Get All Label Templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LabelTemplateOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Label templates list the fields printed on a label in order, each with its font size,\nand the code printed with them: qr, datamatrix, code128 or none.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Label Templates"
+                ],
+                "summary": "Create Label Template",
+                "parameters": [
+                    {
+                        "description": "Label Template Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelTemplateCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelTemplateOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/label-templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Label Templates"
+                ],
+                "summary": "Get Label Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelTemplateOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Label Templates"
+                ],
+                "summary": "Update Label Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label Template Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelTemplateUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelTemplateOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Label Templates"
+                ],
+                "summary": "Delete Label Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/labelmaker/assets/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Get Asset label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Print this label, defaults to false",
+                        "name": "print",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label template ID",
+                        "name": "template",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "png, zpl or epl, defaults to png",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Printer ID, queues the label for the printer",
+                        "name": "printer",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image/png",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrintJobOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/labelmaker/item/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Get Item label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Print this label, defaults to false",
+                        "name": "print",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label template ID",
+                        "name": "template",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "png, zpl or epl, defaults to png",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Printer ID, queues the label for the printer",
+                        "name": "printer",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image/png",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrintJobOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/labelmaker/location/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get Location label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Print this label, defaults to false",
+                        "name": "print",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label template ID",
+                        "name": "template",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "png, zpl or epl, defaults to png",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Printer ID, queues the label for the printer",
+                        "name": "printer",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image/png",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrintJobOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/labelmaker/sheet": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lays out the labels of many items and locations on label sheets and returns them as a PDF.\nThe labels are those of the listed items, of the items a saved search finds and of a\nlocation with the locations nested below it, optionally with their items.\nWith a printer the labels are queued for it and the print job is returned.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Get Label Sheet",
+                "parameters": [
+                    {
+                        "description": "Labels and layout",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LabelSheetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "application/pdf",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrintJobOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/labels": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Get All Labels",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LabelOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Create Label",
+                "parameters": [
+                    {
+                        "description": "Label Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/labels/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Get Label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Update Label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Delete Label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/loans": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Get Active Loans",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LoanSummary"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Create Loan (Check Out Item)",
+                "parameters": [
+                    {
+                        "description": "Loan Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LoanCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LoanOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/loans/overdue": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Get Overdue Loans",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LoanSummary"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/loans/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Get Loan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LoanOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Update Loan (Extend Due Date)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Loan Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LoanUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LoanOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Delete Loan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/loans/{id}/return": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loans"
+                ],
+                "summary": "Return Loan (Check In Item)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Return Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LoanReturn"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LoanOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/locations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get All Locations",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter locations with parents",
+                        "name": "filterChildren",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LocationOutCount"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Create Location",
+                "parameters": [
+                    {
+                        "description": "Location Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/locations/tree": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get Locations Tree",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "include items in response tree",
+                        "name": "withItems",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TreeItem"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/locations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Update Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Location Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Moves the location, the locations nested below it and all of their items to the trash.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Delete Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/maintenance": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenance"
+                ],
+                "summary": "Query All Maintenance",
+                "parameters": [
+                    {
+                        "enum": [
+                            "scheduled",
+                            "completed",
+                            "both"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "MaintenanceFilterStatusScheduled",
+                            "MaintenanceFilterStatusCompleted",
+                            "MaintenanceFilterStatusBoth"
+                        ],
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.MaintenanceEntryWithDetails"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/maintenance/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenance"
+                ],
+                "summary": "Update Maintenance Entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entry Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.MaintenanceEntryUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.MaintenanceEntry"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenance"
+                ],
+                "summary": "Delete Maintenance Entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Maintenance ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/notifiers": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Get Notifiers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.NotifierOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Create Notifier",
+                "parameters": [
+                    {
+                        "description": "Notifier Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifiers/test": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Test Notifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "URL",
+                        "name": "url",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/notifiers/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Update Notifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Notifier Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Delete a Notifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/print-jobs": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "The group's print jobs, newest first. Status changes are also sent over the websocket as\nprintjob.mutation events with the job's ID and status.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Query Print Jobs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "printerId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "queued",
+                            "printing",
+                            "done",
+                            "failed",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_PrintJobOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/print-jobs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Get Print Job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Print Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrintJobOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/print-jobs/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cancels a queued or failed job, jobs that are printing can't be cancelled.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Cancel Print Job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Print Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrintJobOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/print-jobs/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Queues a failed or cancelled job again, with all its attempts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Retry Print Job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Print Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrintJobOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/printers": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Get All Printers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.PrinterOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Printers are reached by kind: raw sends ZPL or EPL to port 9100 of the host in the address,\nipp sends PDFs to the ipp:// URL in the address, and command runs the server's print command\nwith the address as {{.Printer}}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Create Printer",
+                "parameters": [
+                    {
+                        "description": "Printer Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrinterCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrinterOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/printers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Get Printer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Printer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrinterOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Update Printer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Printer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Printer Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrinterUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PrinterOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deletes the printer with its print jobs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Delete Printer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Printer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/products/search-from-barcode": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Search EAN from Barcode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "barcode to be searched",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.BarcodeProduct"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/qrcode": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Create QR Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "data to be encoded into qrcode",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image/jpeg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reporting/bill-of-materials": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reporting"
+                ],
+                "summary": "Export Bill of Materials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only report on the items of this group-shared saved search",
+                        "name": "savedSearch",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "text/csv",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Get Saved Searches",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.SavedSearchOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "The query's search string and field filters are validated like those of ` + "`" + `GET /v1/items` + "`" + `.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Create Saved Search",
+                "parameters": [
+                    {
+                        "description": "Saved Search Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Get Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Only the owner of a saved search can update it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Update Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Saved Search Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Only the owner of a saved search can delete it.",
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Delete Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches/{id}/items": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Run Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_ItemSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/status": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Base"
+                ],
+                "summary": "Application Info",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.APISummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktakes": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktakes"
+                ],
+                "summary": "Get All Stocktakes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.StocktakeSummary"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Starts a stocktake of the location and every location nested below it. The unarchived\nitems in service in them are expected to be found.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktakes"
+                ],
+                "summary": "Start Stocktake",
+                "parameters": [
+                    {
+                        "description": "Stocktake Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.StocktakeCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.StocktakeSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktakes/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktakes"
+                ],
+                "summary": "Get Stocktake",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stocktake ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.StocktakeSummary"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Stocktakes"
+                ],
+                "summary": "Delete Stocktake",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stocktake ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/stocktakes/{id}/close": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Closes the stocktake and returns its discrepancy report. Optionally moves the misplaced\nitems to where they were scanned and marks the missing ones lost.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktakes"
+                ],
+                "summary": "Close Stocktake",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stocktake ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Close options",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.StocktakeClose"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.StocktakeReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktakes/{id}/report": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "The items of the stocktake sorted into found, missing, misplaced, excused because they\nare on loan and scanned values that match no item.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktakes"
+                ],
+                "summary": "Get Stocktake Report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stocktake ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.StocktakeReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktakes/{id}/scans": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Records a scanned asset ID or identifier. Values that match no item are kept for the\nreport, values that match several items are rejected with 409.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktakes"
+                ],
+                "summary": "Scan Into Stocktake",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stocktake ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Scanned value",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.StocktakeScan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.StocktakeScanOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/templates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Get All Item Templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.ItemTemplateSummary"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Create Item Template",
+                "parameters": [
+                    {
+                        "description": "Template Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemTemplateCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemTemplateOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/templates/export": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Exports the templates in a portable format that other groups and instances can import.\nDefault locations and labels are written by name. Exports all templates without ids.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Export Item Templates",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "template IDs",
+                        "name": "ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemTemplateExport"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/templates/import": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Imports templates of an export. Default locations and labels are resolved by name, missing\nones are reported as warnings. Templates named like an existing one are skipped, renamed\nor overwrite it depending on the conflict strategy, skip by default.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Import Item Templates",
+                "parameters": [
+                    {
+                        "description": "Exported templates",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemTemplateImport"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemTemplateImportResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Get Item Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemTemplateOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Update Item Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemTemplateUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemTemplateOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Delete Item Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/templates/{id}/create-item": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Create Item from Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Item Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ItemTemplateCreateItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/templates/{id}/create-items": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a batch of identical items from the template with sequential asset IDs. Each unit\ngets the serial number at its position in the pasted list. The count defaults to the\nnumber of serial numbers, a list of another length is rejected. The IDs of the new items\nare returned, pass them to ` + "`" + `POST /v1/labelmaker/sheet` + "`" + ` to print their labels.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Item Templates"
+                ],
+                "summary": "Create Items from Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Item Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ItemTemplateCreateItemsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ItemTemplateCreateItemsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/trash": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deleted items and locations that have not been purged yet, most recently deleted first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trash"
+                ],
+                "summary": "Get Trash",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TrashEntry"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/trash/items/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Takes an item out of the trash. Fails when its location is still in the trash.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trash"
+                ],
+                "summary": "Restore Item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/trash/locations/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Takes a location out of the trash along with the locations and items deleted with it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trash"
+                ],
+                "summary": "Restore Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/change-password": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Change Password",
+                "parameters": [
+                    {
+                        "description": "Password Payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChangePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/login": {
+            "post": {
+                "consumes": [
+                    "application/x-www-form-urlencoded",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Login",
+                "parameters": [
+                    {
+                        "description": "Login Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LoginForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "auth provider",
+                        "name": "provider",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TokenResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/login/oidc": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "OIDC Login Initiation",
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/v1/users/login/oidc/callback": {
+            "get": {
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "OIDC Callback Handler",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State parameter",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/v1/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Logout",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/refresh": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "handleAuthRefresh returns a handler that will issue a new token from an existing token.\nThis does not validate that the user still exists within the database.",
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Token Refresh",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/v1/users/register": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Register New User",
+                "parameters": [
+                    {
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/services.UserRegistration"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "Local login is not enabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get User Self",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "$ref": "#/definitions/repo.UserOut"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update Account",
+                "parameters": [
+                    {
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.UserUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "$ref": "#/definitions/repo.UserUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Delete Account",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "attachment.Type": {
+            "type": "string",
+            "enum": [
+                "attachment",
+                "photo",
+                "manual",
+                "warranty",
+                "attachment",
+                "receipt",
+                "thumbnail"
+            ],
+            "x-enum-varnames": [
+                "DefaultType",
+                "TypePhoto",
+                "TypeManual",
+                "TypeWarranty",
+                "TypeAttachment",
+                "TypeReceipt",
+                "TypeThumbnail"
+            ]
+        },
+        "auditentry.Action": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "ActionCreate",
+                "ActionUpdate",
+                "ActionDelete"
+            ]
+        },
+        "auditentry.Source": {
+            "type": "string",
+            "enum": [
+                "system",
+                "api",
+                "kiosk",
+                "import",
+                "system"
+            ],
+            "x-enum-varnames": [
+                "DefaultSource",
+                "SourceAPI",
+                "SourceKiosk",
+                "SourceImport",
+                "SourceSystem"
+            ]
+        },
+        "authroles.Role": {
+            "type": "string",
+            "enum": [
+                "user",
+                "admin",
+                "user",
+                "kiosk",
+                "attachments"
+            ],
+            "x-enum-varnames": [
+                "DefaultRole",
+                "RoleAdmin",
+                "RoleUser",
+                "RoleKiosk",
+                "RoleAttachments"
+            ]
+        },
+        "borrower.VerificationStatus": {
+            "type": "string",
+            "enum": [
+                "verified",
+                "verified",
+                "pending_email",
+                "pending_approval",
+                "rejected"
+            ],
+            "x-enum-varnames": [
+                "DefaultVerificationStatus",
+                "VerificationStatusVerified",
+                "VerificationStatusPendingEmail",
+                "VerificationStatusPendingApproval",
+                "VerificationStatusRejected"
+            ]
+        },
+        "currencies.Currency": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "decimals": {
+                    "type": "integer"
+                },
+                "local": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                }
+            }
+        },
+        "ent.Attachment": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AttachmentQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AttachmentEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "mime_type": {
+                    "description": "MimeType holds the value of the \"mime_type\" field.",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path holds the value of the \"path\" field.",
+                    "type": "string"
+                },
+                "primary": {
+                    "description": "Primary holds the value of the \"primary\" field.",
+                    "type": "boolean"
+                },
+                "title": {
+                    "description": "Title holds the value of the \"title\" field.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/attachment.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.AttachmentEdges": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "description": "Item holds the value of the item edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                },
+                "thumbnail": {
+                    "description": "Thumbnail holds the value of the thumbnail edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Attachment"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action holds the value of the \"action\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auditentry.Action"
+                        }
+                    ]
+                },
+                "actor_id": {
+                    "description": "ActorID holds the value of the \"actor_id\" field.",
+                    "type": "string"
+                },
+                "changes": {
+                    "description": "JSON encoded map of field name to old and new value",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuditEntryQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuditEntryEdges"
+                        }
+                    ]
+                },
+                "entity_id": {
+                    "description": "EntityID holds the value of the \"entity_id\" field.",
+                    "type": "string"
+                },
+                "entity_type": {
+                    "description": "EntityType holds the value of the \"entity_type\" field.",
+                    "type": "string"
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Source holds the value of the \"source\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auditentry.Source"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.AuditEntryEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AuthRoles": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuthRolesQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuthRolesEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "role": {
+                    "description": "Role holds the value of the \"role\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/authroles.Role"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AuthRolesEdges": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "description": "Token holds the value of the token edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuthTokens"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AuthTokens": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuthTokensQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuthTokensEdges"
+                        }
+                    ]
+                },
+                "expires_at": {
+                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "token": {
+                    "description": "Token holds the value of the \"token\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.AuthTokensEdges": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "description": "Roles holds the value of the roles edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuthRoles"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Borrower": {
+            "type": "object",
+            "properties": {
+                "approved_at": {
+                    "description": "When a staff member approved the borrower",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the BorrowerQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.BorrowerEdges"
+                        }
+                    ]
+                },
+                "email": {
+                    "description": "Contact email address",
+                    "type": "string"
+                },
+                "email_verified_at": {
+                    "description": "When the borrower confirmed their email address",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "is_active": {
+                    "description": "Whether borrower can currently check out equipment",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Full name of the borrower",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Additional notes about the borrower",
+                    "type": "string"
+                },
+                "organization": {
+                    "description": "Company or startup name",
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "Contact phone number",
+                    "type": "string"
+                },
+                "self_registered": {
+                    "description": "Whether borrower registered themselves via kiosk self-service",
+                    "type": "boolean"
+                },
+                "student_id": {
+                    "description": "University student ID",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "verification_expires_at": {
+                    "description": "When the email confirmation token expires",
+                    "type": "string"
+                },
+                "verification_status": {
+                    "description": "Verification state of self-registered borrowers (only verified borrowers can check out)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/borrower.VerificationStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.BorrowerEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "loans": {
+                    "description": "Loans holds the value of the loans edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Loan"
+                    }
+                }
+            }
+        },
+        "ent.FieldDefinition": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the FieldDefinitionQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.FieldDefinitionEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "options": {
+                    "description": "Allowed values of select and multiselect fields",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/fielddefinition.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.FieldDefinitionEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Group": {
+            "type": "object",
+            "properties": {
+                "asset_id_check_digit": {
+                    "description": "Check digit appended to asset IDs so that mis-scans are rejected",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/group.AssetIDCheckDigit"
+                        }
+                    ]
+                },
+                "asset_id_prefix": {
+                    "description": "Prefix of the asset IDs, e.g. HB-",
+                    "type": "string"
+                },
+                "asset_id_unchecked_max": {
+                    "description": "Highest asset ID when check digits were turned on, labels of those may lack one",
+                    "type": "integer"
+                },
+                "asset_id_width": {
+                    "description": "Digits the asset IDs are zero padded to (0 = 000-000)",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency holds the value of the \"currency\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the GroupQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.GroupEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.GroupEdges": {
+            "type": "object",
+            "properties": {
+                "audit_entries": {
+                    "description": "AuditEntries holds the value of the audit_entries edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.AuditEntry"
+                    }
+                },
+                "borrowers": {
+                    "description": "Borrowers holds the value of the borrowers edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Borrower"
+                    }
+                },
+                "field_definitions": {
+                    "description": "FieldDefinitions holds the value of the field_definitions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.FieldDefinition"
+                    }
+                },
+                "invitation_tokens": {
+                    "description": "InvitationTokens holds the value of the invitation_tokens edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.GroupInvitationToken"
+                    }
+                },
+                "item_identifiers": {
+                    "description": "ItemIdentifiers holds the value of the item_identifiers edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ItemIdentifier"
+                    }
+                },
+                "item_status_changes": {
+                    "description": "ItemStatusChanges holds the value of the item_status_changes edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ItemStatusChange"
+                    }
+                },
+                "item_templates": {
+                    "description": "ItemTemplates holds the value of the item_templates edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ItemTemplate"
+                    }
+                },
+                "items": {
+                    "description": "Items holds the value of the items edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Item"
+                    }
+                },
+                "kiosk_sync_actions": {
+                    "description": "KioskSyncActions holds the value of the kiosk_sync_actions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.KioskSyncAction"
+                    }
+                },
+                "label_templates": {
+                    "description": "LabelTemplates holds the value of the label_templates edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.LabelTemplate"
+                    }
+                },
+                "labels": {
+                    "description": "Labels holds the value of the labels edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Label"
+                    }
+                },
+                "loans": {
+                    "description": "Loans holds the value of the loans edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Loan"
+                    }
+                },
+                "locations": {
+                    "description": "Locations holds the value of the locations edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Location"
+                    }
+                },
+                "notifiers": {
+                    "description": "Notifiers holds the value of the notifiers edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Notifier"
+                    }
+                },
+                "print_jobs": {
+                    "description": "PrintJobs holds the value of the print_jobs edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.PrintJob"
+                    }
+                },
+                "printers": {
+                    "description": "Printers holds the value of the printers edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Printer"
+                    }
+                },
+                "saved_searches": {
+                    "description": "SavedSearches holds the value of the saved_searches edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.SavedSearch"
+                    }
+                },
+                "stock_movements": {
+                    "description": "StockMovements holds the value of the stock_movements edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.StockMovement"
+                    }
+                },
+                "stocktake_sessions": {
+                    "description": "StocktakeSessions holds the value of the stocktake_sessions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.StocktakeSession"
+                    }
+                },
+                "users": {
+                    "description": "Users holds the value of the users edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.User"
+                    }
+                }
+            }
+        },
+        "ent.GroupInvitationToken": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the GroupInvitationTokenQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.GroupInvitationTokenEdges"
+                        }
+                    ]
+                },
+                "expires_at": {
+                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "token": {
+                    "description": "Token holds the value of the \"token\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "uses": {
+                    "description": "Uses holds the value of the \"uses\" field.",
+                    "type": "integer"
+                }
+            }
+        },
+        "ent.GroupInvitationTokenEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Item": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "description": "Archived holds the value of the \"archived\" field.",
+                    "type": "boolean"
+                },
+                "asset_id": {
+                    "description": "AssetID holds the value of the \"asset_id\" field.",
+                    "type": "integer"
+                },
+                "asset_id_prefix": {
+                    "description": "Prefix of the series the asset ID is numbered in, empty for the group's",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt holds the value of the \"deleted_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ItemQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ItemEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "import_ref": {
+                    "description": "ImportRef holds the value of the \"import_ref\" field.",
+                    "type": "string"
+                },
+                "insured": {
+                    "description": "Insured holds the value of the \"insured\" field.",
+                    "type": "boolean"
+                },
+                "lifetime_warranty": {
+                    "description": "LifetimeWarranty holds the value of the \"lifetime_warranty\" field.",
+                    "type": "boolean"
+                },
+                "manufacturer": {
+                    "description": "Manufacturer holds the value of the \"manufacturer\" field.",
+                    "type": "string"
+                },
+                "min_stock": {
+                    "description": "Quantity below which the item is low on stock (0 = not tracked)",
+                    "type": "integer"
+                },
+                "model_number": {
+                    "description": "ModelNumber holds the value of the \"model_number\" field.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes holds the value of the \"notes\" field.",
+                    "type": "string"
+                },
+                "purchase_from": {
+                    "description": "PurchaseFrom holds the value of the \"purchase_from\" field.",
+                    "type": "string"
+                },
+                "purchase_price": {
+                    "description": "PurchasePrice holds the value of the \"purchase_price\" field.",
+                    "type": "number"
+                },
+                "purchase_time": {
+                    "description": "PurchaseTime holds the value of the \"purchase_time\" field.",
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "Quantity holds the value of the \"quantity\" field.",
+                    "type": "integer"
+                },
+                "quarantine_until": {
+                    "description": "When the quarantine ends on its own (null = until staff sign off)",
+                    "type": "string"
+                },
+                "quarantined_at": {
+                    "description": "When the item was returned into quarantine (null = not quarantined)",
+                    "type": "string"
+                },
+                "reorder_quantity": {
+                    "description": "Quantity to order when the item is low on stock",
+                    "type": "integer"
+                },
+                "serial_number": {
+                    "description": "SerialNumber holds the value of the \"serial_number\" field.",
+                    "type": "string"
+                },
+                "sold_notes": {
+                    "description": "SoldNotes holds the value of the \"sold_notes\" field.",
+                    "type": "string"
+                },
+                "sold_price": {
+                    "description": "SoldPrice holds the value of the \"sold_price\" field.",
+                    "type": "number"
+                },
+                "sold_time": {
+                    "description": "SoldTime holds the value of the \"sold_time\" field.",
+                    "type": "string"
+                },
+                "sold_to": {
+                    "description": "SoldTo holds the value of the \"sold_to\" field.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Lifecycle status, only changed through the allowed transitions",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/item.Status"
+                        }
+                    ]
+                },
+                "sync_child_items_locations": {
+                    "description": "SyncChildItemsLocations holds the value of the \"sync_child_items_locations\" field.",
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "warranty_details": {
+                    "description": "WarrantyDetails holds the value of the \"warranty_details\" field.",
+                    "type": "string"
+                },
+                "warranty_expires": {
+                    "description": "WarrantyExpires holds the value of the \"warranty_expires\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ItemEdges": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "description": "Attachments holds the value of the attachments edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Attachment"
+                    }
+                },
+                "children": {
+                    "description": "Children holds the value of the children edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Item"
+                    }
+                },
+                "fields": {
+                    "description": "Fields holds the value of the fields edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ItemField"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "identifiers": {
+                    "description": "Identifiers holds the value of the identifiers edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ItemIdentifier"
+                    }
+                },
+                "label": {
+                    "description": "Label holds the value of the label edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Label"
+                    }
+                },
+                "loans": {
+                    "description": "Loans holds the value of the loans edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Loan"
+                    }
+                },
+                "location": {
+                    "description": "Location holds the value of the location edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Location"
+                        }
+                    ]
+                },
+                "maintenance_entries": {
+                    "description": "MaintenanceEntries holds the value of the maintenance_entries edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.MaintenanceEntry"
+                    }
+                },
+                "parent": {
+                    "description": "Parent holds the value of the parent edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                },
+                "status_changes": {
+                    "description": "StatusChanges holds the value of the status_changes edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ItemStatusChange"
+                    }
+                },
+                "stock_movements": {
+                    "description": "StockMovements holds the value of the stock_movements edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.StockMovement"
+                    }
+                },
+                "stocktake_entries": {
+                    "description": "StocktakeEntries holds the value of the stocktake_entries edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.StocktakeEntry"
+                    }
+                }
+            }
+        },
+        "ent.ItemField": {
+            "type": "object",
+            "properties": {
+                "boolean_value": {
+                    "description": "BooleanValue holds the value of the \"boolean_value\" field.",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ItemFieldQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ItemFieldEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "number_value": {
+                    "description": "Value of number and decimal fields",
+                    "type": "number"
+                },
+                "text_value": {
+                    "description": "Value of text, select and url fields, and the semicolon separated values of multiselect fields",
+                    "type": "string"
+                },
+                "time_value": {
+                    "description": "TimeValue holds the value of the \"time_value\" field.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/itemfield.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ItemFieldEdges": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "description": "Item holds the value of the item edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.ItemIdentifier": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ItemIdentifierQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ItemIdentifierEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "item_id": {
+                    "description": "ItemID holds the value of the \"item_id\" field.",
+                    "type": "string"
+                },
+                "normalized_value": {
+                    "description": "Value in lower case, identifiers are unique ignoring case",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/itemidentifier.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value holds the value of the \"value\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ItemIdentifierEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "item": {
+                    "description": "Item holds the value of the item edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.ItemStatusChange": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "description": "ActorID holds the value of the \"actor_id\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ItemStatusChangeQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ItemStatusChangeEdges"
+                        }
+                    ]
+                },
+                "from_status": {
+                    "description": "FromStatus holds the value of the \"from_status\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/itemstatuschange.FromStatus"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "item_id": {
+                    "description": "ItemID holds the value of the \"item_id\" field.",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Reason holds the value of the \"reason\" field.",
+                    "type": "string"
+                },
+                "to_status": {
+                    "description": "ToStatus holds the value of the \"to_status\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/itemstatuschange.ToStatus"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ItemStatusChangeEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "item": {
+                    "description": "Item holds the value of the item edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.ItemTemplate": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "default_description": {
+                    "description": "Default description for items created from this template",
+                    "type": "string"
+                },
+                "default_insured": {
+                    "description": "DefaultInsured holds the value of the \"default_insured\" field.",
+                    "type": "boolean"
+                },
+                "default_label_ids": {
+                    "description": "Default label IDs for items created from this template",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "default_lifetime_warranty": {
+                    "description": "DefaultLifetimeWarranty holds the value of the \"default_lifetime_warranty\" field.",
+                    "type": "boolean"
+                },
+                "default_manufacturer": {
+                    "description": "DefaultManufacturer holds the value of the \"default_manufacturer\" field.",
+                    "type": "string"
+                },
+                "default_model_number": {
+                    "description": "Default model number for items created from this template",
+                    "type": "string"
+                },
+                "default_name": {
+                    "description": "Default name template for items (can use placeholders)",
+                    "type": "string"
+                },
+                "default_quantity": {
+                    "description": "DefaultQuantity holds the value of the \"default_quantity\" field.",
+                    "type": "integer"
+                },
+                "default_warranty_details": {
+                    "description": "DefaultWarrantyDetails holds the value of the \"default_warranty_details\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ItemTemplateQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ItemTemplateEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "include_purchase_fields": {
+                    "description": "Whether to include purchase fields in items created from this template",
+                    "type": "boolean"
+                },
+                "include_sold_fields": {
+                    "description": "Whether to include sold fields in items created from this template",
+                    "type": "boolean"
+                },
+                "include_warranty_fields": {
+                    "description": "Whether to include warranty fields in items created from this template",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes holds the value of the \"notes\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ItemTemplateEdges": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "description": "Fields holds the value of the fields edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.TemplateField"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "location": {
+                    "description": "Location holds the value of the location edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Location"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.KioskSession": {
+            "type": "object",
+            "properties": {
+                "app_version": {
+                    "description": "AppVersion holds the value of the \"app_version\" field.",
+                    "type": "string"
+                },
+                "battery_charging": {
+                    "description": "BatteryCharging holds the value of the \"battery_charging\" field.",
+                    "type": "boolean"
+                },
+                "battery_level": {
+                    "description": "Battery charge in percent (null = unknown or no battery)",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the KioskSessionQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.KioskSessionEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "is_active": {
+                    "description": "Whether kiosk mode is currently active",
+                    "type": "boolean"
+                },
+                "last_activity_at": {
+                    "description": "When someone last interacted with the kiosk device, as reported by its heartbeat",
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "description": "When the kiosk device last sent a heartbeat",
+                    "type": "string"
+                },
+                "network_type": {
+                    "description": "Network connection reported by the device, e.g. wifi, ethernet or cellular",
+                    "type": "string"
+                },
+                "offline_alerted_at": {
+                    "description": "When staff were alerted that the kiosk went silent (cleared on the next heartbeat)",
+                    "type": "string"
+                },
+                "unlocked_until": {
+                    "description": "When the temporary admin unlock expires (null = locked)",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.KioskSessionEdges": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location holds the value of the location edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Location"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.KioskSyncAction": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action holds the value of the \"action\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/kiosksyncaction.Action"
+                        }
+                    ]
+                },
+                "client_timestamp": {
+                    "description": "When the action happened on the kiosk",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the KioskSyncActionQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.KioskSyncActionEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "idempotency_key": {
+                    "description": "Client generated key identifying the queued action",
+                    "type": "string"
+                },
+                "result_id": {
+                    "description": "ID of the loan or borrower the action created or updated",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending while the action is being applied, applied once it succeeded",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/kiosksyncaction.Status"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.KioskSyncActionEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Label": {
+            "type": "object",
+            "properties": {
+                "asset_id_prefix": {
+                    "description": "Items created with the label are numbered in their own series under this prefix",
+                    "type": "string"
+                },
+                "color": {
+                    "description": "Color holds the value of the \"color\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LabelQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.LabelEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "quarantine_minutes": {
+                    "description": "How long returned items stay in quarantine (0 = until staff sign off)",
+                    "type": "integer"
+                },
+                "quarantine_on_return": {
+                    "description": "Whether returned items with this label must be inspected before they can be lent again",
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.LabelEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "items": {
+                    "description": "Items holds the value of the items edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Item"
+                    }
+                }
+            }
+        },
+        "ent.LabelTemplate": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LabelTemplateQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.LabelTemplateEdges"
+                        }
+                    ]
+                },
+                "fields": {
+                    "description": "JSON encoded list of the printed fields",
+                    "type": "string"
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "symbology": {
+                    "description": "Symbology holds the value of the \"symbology\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/labeltemplate.Symbology"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.LabelTemplateEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Loan": {
+            "type": "object",
+            "properties": {
+                "checked_out_at": {
+                    "description": "When the item was checked out",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "due_at": {
+                    "description": "When the item is expected to be returned",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LoanQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.LoanEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "kiosk_action": {
+                    "description": "Whether this loan was created/returned via kiosk self-service",
+                    "type": "boolean"
+                },
+                "notes": {
+                    "description": "Notes made at checkout",
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "Number of items borrowed (for items with quantity \u003e 1)",
+                    "type": "integer"
+                },
+                "return_notes": {
+                    "description": "Notes made at return (e.g., condition)",
+                    "type": "string"
+                },
+                "returned_at": {
+                    "description": "When the item was actually returned (null = still on loan)",
+                    "type": "string"
+                },
+                "transfer": {
+                    "description": "Whether the item was returned at a kiosk outside its home location",
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.LoanEdges": {
+            "type": "object",
+            "properties": {
+                "borrower": {
+                    "description": "Borrower holds the value of the borrower edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Borrower"
+                        }
+                    ]
+                },
+                "checked_out_by": {
+                    "description": "CheckedOutBy holds the value of the checked_out_by edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "item": {
+                    "description": "Item holds the value of the item edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                },
+                "return_location": {
+                    "description": "ReturnLocation holds the value of the return_location edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Location"
+                        }
+                    ]
+                },
+                "returned_by": {
+                    "description": "ReturnedBy holds the value of the returned_by edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Location": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt holds the value of the \"deleted_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LocationQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.LocationEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.LocationEdges": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "description": "Children holds the value of the children edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Location"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "items": {
+                    "description": "Items holds the value of the items edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Item"
+                    }
+                },
+                "kiosk_sessions": {
+                    "description": "KioskSessions holds the value of the kiosk_sessions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.KioskSession"
+                    }
+                },
+                "parent": {
+                    "description": "Parent holds the value of the parent edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Location"
+                        }
+                    ]
+                },
+                "returned_loans": {
+                    "description": "ReturnedLoans holds the value of the returned_loans edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Loan"
+                    }
+                },
+                "stocktake_sessions": {
+                    "description": "StocktakeSessions holds the value of the stocktake_sessions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.StocktakeSession"
+                    }
+                }
+            }
+        },
+        "ent.MaintenanceEntry": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "description": "Cost holds the value of the \"cost\" field.",
+                    "type": "number"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date holds the value of the \"date\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the MaintenanceEntryQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.MaintenanceEntryEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "item_id": {
+                    "description": "ItemID holds the value of the \"item_id\" field.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "scheduled_date": {
+                    "description": "ScheduledDate holds the value of the \"scheduled_date\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.MaintenanceEntryEdges": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "description": "Item holds the value of the item edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Notifier": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the NotifierQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.NotifierEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "is_active": {
+                    "description": "IsActive holds the value of the \"is_active\" field.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID holds the value of the \"user_id\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.NotifierEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.PrintJob": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Attempts holds the value of the \"attempts\" field.",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy holds the value of the \"created_by\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the PrintJobQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.PrintJobEdges"
+                        }
+                    ]
+                },
+                "error": {
+                    "description": "Error holds the value of the \"error\" field.",
+                    "type": "string"
+                },
+                "finished_at": {
+                    "description": "FinishedAt holds the value of the \"finished_at\" field.",
+                    "type": "string"
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "labels": {
+                    "description": "Labels holds the value of the \"labels\" field.",
+                    "type": "integer"
+                },
+                "max_attempts": {
+                    "description": "MaxAttempts holds the value of the \"max_attempts\" field.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "JSON encoded labels and sheet layout",
+                    "type": "string"
+                },
+                "printer_id": {
+                    "description": "PrinterID holds the value of the \"printer_id\" field.",
+                    "type": "string"
+                },
+                "run_at": {
+                    "description": "Time of the next attempt",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status holds the value of the \"status\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/printjob.Status"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.PrintJobEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "printer": {
+                    "description": "Printer holds the value of the printer edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Printer"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Printer": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Host and port of raw printers, URL of IPP printers, printer name for the print command",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "dpi": {
+                    "description": "Dpi holds the value of the \"dpi\" field.",
+                    "type": "integer"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the PrinterQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.PrinterEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Kind holds the value of the \"kind\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/printer.Kind"
+                        }
+                    ]
+                },
+                "language": {
+                    "description": "Printer language of raw printers",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/printer.Language"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.PrinterEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "jobs": {
+                    "description": "Jobs holds the value of the jobs edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.PrintJob"
+                    }
+                }
+            }
+        },
+        "ent.SavedSearch": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the SavedSearchQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.SavedSearchEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "query": {
+                    "description": "JSON encoded item query",
+                    "type": "string"
+                },
+                "shared": {
+                    "description": "Shared holds the value of the \"shared\" field.",
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID holds the value of the \"user_id\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.SavedSearchEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.StockMovement": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "description": "ActorID holds the value of the \"actor_id\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "delta": {
+                    "description": "Delta holds the value of the \"delta\" field.",
+                    "type": "integer"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the StockMovementQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.StockMovementEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "item_id": {
+                    "description": "ItemID holds the value of the \"item_id\" field.",
+                    "type": "string"
+                },
+                "note": {
+                    "description": "Note holds the value of the \"note\" field.",
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "Quantity of the item after the movement",
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "Reason holds the value of the \"reason\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/stockmovement.Reason"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.StockMovementEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "item": {
+                    "description": "Item holds the value of the item edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.StocktakeEntry": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the StocktakeEntryQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.StocktakeEntryEdges"
+                        }
+                    ]
+                },
+                "expected": {
+                    "description": "Whether the item was in the session's locations when it started",
+                    "type": "boolean"
+                },
+                "expected_location_id": {
+                    "description": "Where the item was recorded to be",
+                    "type": "string"
+                },
+                "found_at": {
+                    "description": "FoundAt holds the value of the \"found_at\" field.",
+                    "type": "string"
+                },
+                "found_by": {
+                    "description": "FoundBy holds the value of the \"found_by\" field.",
+                    "type": "string"
+                },
+                "found_location_id": {
+                    "description": "Where the item was scanned",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "item_id": {
+                    "description": "ItemID holds the value of the \"item_id\" field.",
+                    "type": "string"
+                },
+                "on_loan": {
+                    "description": "Whether the item was missing because it was on loan, set when the session closes",
+                    "type": "boolean"
+                },
+                "session_id": {
+                    "description": "SessionID holds the value of the \"session_id\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "The scanned asset ID or identifier, empty for items not found yet",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.StocktakeEntryEdges": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "description": "Item holds the value of the item edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Item"
+                        }
+                    ]
+                },
+                "session": {
+                    "description": "Session holds the value of the session edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.StocktakeSession"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.StocktakeSession": {
+            "type": "object",
+            "properties": {
+                "closed_at": {
+                    "description": "ClosedAt holds the value of the \"closed_at\" field.",
+                    "type": "string"
+                },
+                "closed_by": {
+                    "description": "ClosedBy holds the value of the \"closed_by\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the StocktakeSessionQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.StocktakeSessionEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "location_id": {
+                    "description": "LocationID holds the value of the \"location_id\" field.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes holds the value of the \"notes\" field.",
+                    "type": "string"
+                },
+                "started_by": {
+                    "description": "StartedBy holds the value of the \"started_by\" field.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status holds the value of the \"status\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/stocktakesession.Status"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.StocktakeSessionEdges": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "description": "Entries holds the value of the entries edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.StocktakeEntry"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "location": {
+                    "description": "Location holds the value of the location edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Location"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.TemplateField": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the TemplateFieldQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.TemplateFieldEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "text_value": {
+                    "description": "Default value in the text form used by CSV imports, converted to the field's type",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/templatefield.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.TemplateFieldEdges": {
+            "type": "object",
+            "properties": {
+                "item_template": {
+                    "description": "ItemTemplate holds the value of the item_template edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ItemTemplate"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.User": {
+            "type": "object",
+            "properties": {
+                "activated_on": {
+                    "description": "ActivatedOn holds the value of the \"activated_on\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.UserEdges"
+                        }
+                    ]
+                },
+                "email": {
+                    "description": "Email holds the value of the \"email\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "is_superuser": {
+                    "description": "IsSuperuser holds the value of the \"is_superuser\" field.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "oidc_issuer": {
+                    "description": "OidcIssuer holds the value of the \"oidc_issuer\" field.",
+                    "type": "string"
+                },
+                "oidc_subject": {
+                    "description": "OidcSubject holds the value of the \"oidc_subject\" field.",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "Role holds the value of the \"role\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/user.Role"
+                        }
+                    ]
+                },
+                "superuser": {
+                    "description": "Superuser holds the value of the \"superuser\" field.",
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.UserEdges": {
+            "type": "object",
+            "properties": {
+                "auth_tokens": {
+                    "description": "AuthTokens holds the value of the auth_tokens edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.AuthTokens"
+                    }
+                },
+                "checkouts": {
+                    "description": "Checkouts holds the value of the checkouts edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Loan"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "kiosk_session": {
+                    "description": "KioskSession holds the value of the kiosk_session edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.KioskSession"
+                        }
+                    ]
+                },
+                "notifiers": {
+                    "description": "Notifiers holds the value of the notifiers edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Notifier"
+                    }
+                },
+                "returns": {
+                    "description": "Returns holds the value of the returns edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Loan"
+                    }
+                },
+                "saved_searches": {
+                    "description": "SavedSearches holds the value of the saved_searches edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.SavedSearch"
+                    }
+                }
+            }
+        },
+        "fielddefinition.Type": {
+            "type": "string",
+            "enum": [
+                "text",
+                "number",
+                "decimal",
+                "boolean",
+                "time",
+                "select",
+                "multiselect",
+                "url"
+            ],
+            "x-enum-varnames": [
+                "TypeText",
+                "TypeNumber",
+                "TypeDecimal",
+                "TypeBoolean",
+                "TypeTime",
+                "TypeSelect",
+                "TypeMultiselect",
+                "TypeURL"
+            ]
+        },
+        "group.AssetIDCheckDigit": {
+            "type": "string",
+            "enum": [
+                "none",
+                "none",
+                "luhn",
+                "mod11"
+            ],
+            "x-enum-varnames": [
+                "DefaultAssetIDCheckDigit",
+                "AssetIDCheckDigitNone",
+                "AssetIDCheckDigitLuhn",
+                "AssetIDCheckDigitMod11"
+            ]
+        },
+        "item.Status": {
+            "type": "string",
+            "enum": [
+                "in_service",
+                "in_service",
+                "in_repair",
+                "lost",
+                "stolen",
+                "retired",
+                "disposed"
+            ],
+            "x-enum-varnames": [
+                "DefaultStatus",
+                "StatusInService",
+                "StatusInRepair",
+                "StatusLost",
+                "StatusStolen",
+                "StatusRetired",
+                "StatusDisposed"
+            ]
+        },
+        "itemfield.Type": {
+            "type": "string",
+            "enum": [
+                "text",
+                "number",
+                "decimal",
+                "boolean",
+                "time",
+                "select",
+                "multiselect",
+                "url"
+            ],
+            "x-enum-varnames": [
+                "TypeText",
+                "TypeNumber",
+                "TypeDecimal",
+                "TypeBoolean",
+                "TypeTime",
+                "TypeSelect",
+                "TypeMultiselect",
+                "TypeURL"
+            ]
+        },
+        "itemidentifier.Type": {
+            "type": "string",
+            "enum": [
+                "other",
+                "upc",
+                "ean",
+                "isbn",
+                "legacy_tag",
+                "nfc",
+                "rfid",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "DefaultType",
+                "TypeUpc",
+                "TypeEan",
+                "TypeIsbn",
+                "TypeLegacyTag",
+                "TypeNfc",
+                "TypeRfid",
+                "TypeOther"
+            ]
+        },
+        "itemstatuschange.FromStatus": {
+            "type": "string",
+            "enum": [
+                "in_service",
+                "in_repair",
+                "lost",
+                "stolen",
+                "retired",
+                "disposed"
+            ],
+            "x-enum-varnames": [
+                "FromStatusInService",
+                "FromStatusInRepair",
+                "FromStatusLost",
+                "FromStatusStolen",
+                "FromStatusRetired",
+                "FromStatusDisposed"
+            ]
+        },
+        "itemstatuschange.ToStatus": {
+            "type": "string",
+            "enum": [
+                "in_service",
+                "in_repair",
+                "lost",
+                "stolen",
+                "retired",
+                "disposed"
+            ],
+            "x-enum-varnames": [
+                "ToStatusInService",
+                "ToStatusInRepair",
+                "ToStatusLost",
+                "ToStatusStolen",
+                "ToStatusRetired",
+                "ToStatusDisposed"
+            ]
+        },
+        "kiosksyncaction.Action": {
+            "type": "string",
+            "enum": [
+                "checkout",
+                "return",
+                "register_borrower"
+            ],
+            "x-enum-varnames": [
+                "ActionCheckout",
+                "ActionReturn",
+                "ActionRegisterBorrower"
+            ]
+        },
+        "kiosksyncaction.Status": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "pending",
+                "applied"
+            ],
+            "x-enum-varnames": [
+                "DefaultStatus",
+                "StatusPending",
+                "StatusApplied"
+            ]
+        },
+        "labeltemplate.Symbology": {
+            "type": "string",
+            "enum": [
+                "qr",
+                "qr",
+                "datamatrix",
+                "code128",
+                "none"
+            ],
+            "x-enum-varnames": [
+                "DefaultSymbology",
+                "SymbologyQr",
+                "SymbologyDatamatrix",
+                "SymbologyCode128",
+                "SymbologyNone"
+            ]
+        },
+        "printer.Kind": {
+            "type": "string",
+            "enum": [
+                "raw",
+                "command",
+                "raw",
+                "ipp"
+            ],
+            "x-enum-varnames": [
+                "DefaultKind",
+                "KindCommand",
+                "KindRaw",
+                "KindIpp"
+            ]
+        },
+        "printer.Language": {
+            "type": "string",
+            "enum": [
+                "zpl",
+                "zpl",
+                "epl"
+            ],
+            "x-enum-varnames": [
+                "DefaultLanguage",
+                "LanguageZpl",
+                "LanguageEpl"
+            ]
+        },
+        "printjob.Status": {
+            "type": "string",
+            "enum": [
+                "queued",
+                "queued",
+                "printing",
+                "done",
+                "failed",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "DefaultStatus",
+                "StatusQueued",
+                "StatusPrinting",
+                "StatusDone",
+                "StatusFailed",
+                "StatusCancelled"
+            ]
+        },
+        "repo.AssetIDFormat": {
+            "type": "object",
+            "properties": {
+                "checkDigit": {
+                    "enum": [
+                        "none",
+                        "luhn",
+                        "mod11"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.CheckDigit"
+                        }
+                    ]
+                },
+                "prefix": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "width": {
+                    "type": "integer",
+                    "maximum": 12,
+                    "minimum": 0
+                }
+            }
+        },
+        "repo.AuditChange": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "new": {},
+                "old": {},
+                "removed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "repo.AuditEntryOut": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actorId": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "actorName": {
+                    "type": "string"
+                },
+                "changes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/repo.AuditChange"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "entityId": {
+                    "type": "string"
+                },
+                "entityType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.BarcodeProduct": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "type": "string"
+                },
+                "imageBase64": {
+                    "type": "string"
+                },
+                "imageURL": {
+                    "type": "string"
+                },
+                "item": {
+                    "$ref": "#/definitions/repo.ItemCreate"
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "modelNumber": {
+                    "description": "Identifications",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Extras",
+                    "type": "string"
+                },
+                "search_engine_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.BorrowerCreate": {
+            "type": "object",
+            "required": [
+                "email",
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "organization": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "studentId": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "repo.BorrowerOut": {
+            "type": "object",
+            "properties": {
+                "activeLoans": {
+                    "type": "integer"
+                },
+                "approvedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "emailVerifiedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "organization": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "selfRegistered": {
+                    "type": "boolean"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "totalLoans": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "verificationStatus": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.BorrowerSummary": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "organization": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "selfRegistered": {
+                    "type": "boolean"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "verificationStatus": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.BorrowerUpdate": {
+            "type": "object",
+            "required": [
+                "email",
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "organization": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "studentId": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "repo.CheckDigit": {
+            "type": "string",
+            "enum": [
+                "none",
+                "luhn",
+                "mod11"
+            ],
+            "x-enum-varnames": [
+                "CheckDigitNone",
+                "CheckDigitLuhn",
+                "CheckDigitMod11"
+            ]
+        },
+        "repo.DuplicateOptions": {
+            "type": "object",
+            "properties": {
+                "copyAttachments": {
+                    "type": "boolean"
+                },
+                "copyCustomFields": {
+                    "type": "boolean"
+                },
+                "copyMaintenance": {
+                    "type": "boolean"
+                },
+                "copyPrefix": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.FieldDefinitionCreate": {
+            "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "number",
+                        "decimal",
+                        "boolean",
+                        "time",
+                        "select",
+                        "multiselect",
+                        "url"
+                    ]
+                }
+            }
+        },
+        "repo.FieldDefinitionOut": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.FieldDefinitionUpdate": {
+            "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "number",
+                        "decimal",
+                        "boolean",
+                        "time",
+                        "select",
+                        "multiselect",
+                        "url"
+                    ]
+                }
+            }
+        },
+        "repo.FieldOp": {
+            "type": "string",
+            "enum": [
+                "eq",
+                "ne",
+                "lt",
+                "gt",
+                "between",
+                "contains",
+                "exists"
+            ],
+            "x-enum-varnames": [
+                "FieldOpEq",
+                "FieldOpNe",
+                "FieldOpLt",
+                "FieldOpGt",
+                "FieldOpBetween",
+                "FieldOpContains",
+                "FieldOpExists"
+            ]
+        },
+        "repo.FieldQuery": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "op": {
+                    "$ref": "#/definitions/repo.FieldOp"
+                },
+                "to": {
+                    "description": "To is the upper bound of FieldOpBetween filters, Value the lower one",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.Group": {
+            "type": "object",
+            "properties": {
+                "assetIdFormat": {
+                    "$ref": "#/definitions/repo.AssetIDFormat"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.GroupStatistics": {
+            "type": "object",
+            "properties": {
+                "itemsByStatus": {
+                    "description": "ItemsByStatus counts the unarchived items in each lifecycle status",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemStatusCount"
+                    }
+                },
+                "totalItemPrice": {
+                    "type": "number"
+                },
+                "totalItems": {
+                    "type": "integer"
+                },
+                "totalLabels": {
+                    "type": "integer"
                 },
-                "mime_type": {
-                    "description": "MimeType holds the value of the \"mime_type\" field.",
+                "totalLocations": {
+                    "type": "integer"
+                },
+                "totalUsers": {
+                    "type": "integer"
+                },
+                "totalWithWarranty": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.GroupUpdate": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.IdentifierMatch": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/repo.ItemSummary"
+                },
+                "type": {
+                    "$ref": "#/definitions/repo.IdentifierType"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.IdentifierType": {
+            "type": "string",
+            "enum": [
+                "upc",
+                "ean",
+                "isbn",
+                "legacy_tag",
+                "nfc",
+                "rfid",
+                "other",
+                "asset_id"
+            ],
+            "x-enum-varnames": [
+                "IdentifierTypeUPC",
+                "IdentifierTypeEAN",
+                "IdentifierTypeISBN",
+                "IdentifierTypeLegacyTag",
+                "IdentifierTypeNFC",
+                "IdentifierTypeRFID",
+                "IdentifierTypeOther",
+                "IdentifierTypeAssetID"
+            ]
+        },
+        "repo.ItemAttachment": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mimeType": {
                     "type": "string"
                 },
                 "path": {
-                    "description": "Path holds the value of the \"path\" field.",
                     "type": "string"
                 },
                 "primary": {
-                    "description": "Primary holds the value of the \"primary\" field.",
                     "type": "boolean"
                 },
+                "thumbnail": {
+                    "$ref": "#/definitions/ent.Attachment"
+                },
                 "title": {
-                    "description": "Title holds the value of the \"title\" field.",
                     "type": "string"
                 },
                 "type": {
-                    "description": "Type holds the value of the \"type\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/attachment.Type"
-                        }
-                    ]
+                    "type": "string"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "ent.AttachmentEdges": {
+        "repo.ItemAttachmentUpdate": {
             "type": "object",
             "properties": {
-                "item": {
-                    "description": "Item holds the value of the item edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Item"
-                        }
-                    ]
+                "primary": {
+                    "type": "boolean"
                 },
-                "thumbnail": {
-                    "description": "Thumbnail holds the value of the thumbnail edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Attachment"
-                        }
-                    ]
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "ent.AuthRoles": {
+        "repo.ItemBulkField": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuthRolesQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.AuthRolesEdges"
-                        }
-                    ]
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "integer"
+                "value": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "repo.ItemBulkOperations": {
+            "type": "object",
+            "properties": {
+                "addLabelIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "role": {
-                    "description": "Role holds the value of the \"role\" field.",
+                "archived": {
+                    "type": "boolean",
+                    "x-nullable": true
+                },
+                "delete": {
+                    "type": "boolean"
+                },
+                "field": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/authroles.Role"
+                            "$ref": "#/definitions/repo.ItemBulkField"
                         }
-                    ]
+                    ],
+                    "x-nullable": true
+                },
+                "locationId": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "removeLabelIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "ent.AuthRolesEdges": {
+        "repo.ItemBulkOut": {
             "type": "object",
             "properties": {
-                "token": {
-                    "description": "Token holds the value of the token edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.AuthTokens"
-                        }
-                    ]
+                "committed": {
+                    "description": "Committed is false when any item failed, in which case nothing was changed",
+                    "type": "boolean"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemBulkResult"
+                    }
                 }
             }
         },
-        "ent.AuthTokens": {
+        "repo.ItemBulkRequest": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuthTokensQuery when eager-loading is set.",
+                "operations": {
+                    "$ref": "#/definitions/repo.ItemBulkOperations"
+                },
+                "query": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.AuthTokensEdges"
+                            "$ref": "#/definitions/repo.ItemQuery"
                         }
-                    ]
-                },
-                "expires_at": {
-                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                    ],
+                    "x-nullable": true
+                }
+            }
+        },
+        "repo.ItemBulkResult": {
+            "type": "object",
+            "properties": {
+                "error": {
                     "type": "string"
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "token": {
-                    "description": "Token holds the value of the \"token\" field.",
+                "status": {
+                    "$ref": "#/definitions/repo.ItemBulkStatus"
+                }
+            }
+        },
+        "repo.ItemBulkStatus": {
+            "type": "string",
+            "enum": [
+                "updated",
+                "deleted",
+                "not_found",
+                "failed",
+                "rolled_back"
+            ],
+            "x-enum-varnames": [
+                "ItemBulkStatusUpdated",
+                "ItemBulkStatusDeleted",
+                "ItemBulkStatusNotFound",
+                "ItemBulkStatusFailed",
+                "ItemBulkStatusRolledBack"
+            ]
+        },
+        "repo.ItemCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "labelIds": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "type": "string"
                     }
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "locationId": {
+                    "description": "Edges",
                     "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "quantity": {
+                    "type": "integer"
                 }
             }
         },
-        "ent.AuthTokensEdges": {
+        "repo.ItemField": {
             "type": "object",
             "properties": {
-                "roles": {
-                    "description": "Roles holds the value of the roles edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.AuthRoles"
-                        }
-                    ]
+                "booleanValue": {
+                    "type": "boolean"
                 },
-                "user": {
-                    "description": "User holds the value of the user edge.",
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "numberValue": {
+                    "type": "number"
+                },
+                "selectValues": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "textValue": {
+                    "type": "string"
+                },
+                "timeValue": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ItemIdentifierCreate": {
+            "type": "object",
+            "required": [
+                "type",
+                "value"
+            ],
+            "properties": {
+                "type": {
+                    "enum": [
+                        "upc",
+                        "ean",
+                        "isbn",
+                        "legacy_tag",
+                        "nfc",
+                        "rfid",
+                        "other"
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/repo.IdentifierType"
                         }
                     ]
+                },
+                "value": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "ent.Group": {
+        "repo.ItemIdentifierOut": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
-                },
-                "currency": {
-                    "description": "Currency holds the value of the \"currency\" field.",
+                "createdAt": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the GroupQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.GroupEdges"
-                        }
-                    ]
-                },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
-                    "type": "string"
+                "type": {
+                    "$ref": "#/definitions/repo.IdentifierType"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "ent.GroupEdges": {
+        "repo.ItemIssue": {
             "type": "object",
+            "required": [
+                "quantity"
+            ],
             "properties": {
-                "invitation_tokens": {
-                    "description": "InvitationTokens holds the value of the invitation_tokens edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.GroupInvitationToken"
-                    }
+                "note": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "item_templates": {
-                    "description": "ItemTemplates holds the value of the item_templates edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.ItemTemplate"
-                    }
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "repo.ItemOut": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
                 },
-                "items": {
-                    "description": "Items holds the value of the items edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Item"
-                    }
+                "assetId": {
+                    "type": "string",
+                    "example": "0"
                 },
-                "labels": {
-                    "description": "Labels holds the value of the labels edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Label"
-                    }
+                "assetIdPrefix": {
+                    "description": "AssetIDPrefix is the prefix of the label series the item is numbered in, empty\nfor the group's series",
+                    "type": "string"
                 },
-                "locations": {
-                    "description": "Locations holds the value of the locations edge.",
+                "assetTag": {
+                    "description": "AssetTag is the asset ID written in the group's format, e.g. HB-LAP-0042",
+                    "type": "string"
+                },
+                "attachments": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Location"
+                        "$ref": "#/definitions/repo.ItemAttachment"
                     }
                 },
-                "notifiers": {
-                    "description": "Notifiers holds the value of the notifiers edge.",
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fields": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Notifier"
+                        "$ref": "#/definitions/repo.ItemField"
                     }
                 },
-                "users": {
-                    "description": "Users holds the value of the users edge.",
+                "id": {
+                    "type": "string"
+                },
+                "identifiers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.User"
+                        "$ref": "#/definitions/repo.ItemIdentifierOut"
                     }
-                }
-            }
-        },
-        "ent.GroupInvitationToken": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
-                },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the GroupInvitationTokenQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.GroupInvitationTokenEdges"
-                        }
-                    ]
                 },
-                "expires_at": {
-                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
-                    "type": "string"
+                "imageId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                "insured": {
+                    "type": "boolean"
                 },
-                "token": {
-                    "description": "Token holds the value of the \"token\" field.",
+                "labels": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/repo.LabelSummary"
                     }
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
-                    "type": "string"
+                "lifetimeWarranty": {
+                    "description": "Warranty",
+                    "type": "boolean"
                 },
-                "uses": {
-                    "description": "Uses holds the value of the \"uses\" field.",
-                    "type": "integer"
-                }
-            }
-        },
-        "ent.GroupInvitationTokenEdges": {
-            "type": "object",
-            "properties": {
-                "group": {
-                    "description": "Group holds the value of the group edge.",
+                "location": {
+                    "description": "Edges",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.Group"
+                            "$ref": "#/definitions/repo.LocationSummary"
                         }
-                    ]
-                }
-            }
-        },
-        "ent.Item": {
-            "type": "object",
-            "properties": {
-                "archived": {
-                    "description": "Archived holds the value of the \"archived\" field.",
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "lowStock": {
+                    "description": "LowStock is set when the quantity has dropped below MinStock",
                     "type": "boolean"
                 },
-                "asset_id": {
-                    "description": "AssetID holds the value of the \"asset_id\" field.",
+                "manufacturer": {
+                    "type": "string"
+                },
+                "minStock": {
+                    "description": "Consumable stock",
                     "type": "integer"
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "modelNumber": {
                     "type": "string"
                 },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
+                "name": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ItemQuery when eager-loading is set.",
+                "notes": {
+                    "description": "Extras",
+                    "type": "string"
+                },
+                "parent": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.ItemEdges"
+                            "$ref": "#/definitions/repo.ItemSummary"
                         }
-                    ]
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "id": {
-                    "description": "ID of the ent.",
+                "purchaseFrom": {
                     "type": "string"
                 },
-                "import_ref": {
-                    "description": "ImportRef holds the value of the \"import_ref\" field.",
+                "purchasePrice": {
+                    "type": "number"
+                },
+                "purchaseTime": {
+                    "description": "Purchase",
                     "type": "string"
                 },
-                "insured": {
-                    "description": "Insured holds the value of the \"insured\" field.",
-                    "type": "boolean"
+                "quantity": {
+                    "type": "integer"
                 },
-                "lifetime_warranty": {
-                    "description": "LifetimeWarranty holds the value of the \"lifetime_warranty\" field.",
+                "quarantineUntil": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "quarantined": {
+                    "description": "Post-return quarantine",
                     "type": "boolean"
                 },
-                "manufacturer": {
-                    "description": "Manufacturer holds the value of the \"manufacturer\" field.",
-                    "type": "string"
+                "quarantinedAt": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "model_number": {
-                    "description": "ModelNumber holds the value of the \"model_number\" field.",
+                "reorderQuantity": {
+                    "type": "integer"
+                },
+                "serialNumber": {
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
+                "soldNotes": {
                     "type": "string"
                 },
-                "notes": {
-                    "description": "Notes holds the value of the \"notes\" field.",
+                "soldPrice": {
+                    "type": "number"
+                },
+                "soldTime": {
+                    "description": "Sold",
                     "type": "string"
                 },
-                "purchase_from": {
-                    "description": "PurchaseFrom holds the value of the \"purchase_from\" field.",
+                "soldTo": {
                     "type": "string"
                 },
-                "purchase_price": {
-                    "description": "PurchasePrice holds the value of the \"purchase_price\" field.",
-                    "type": "number"
+                "status": {
+                    "description": "Status is the lifecycle status, see ItemStatusTransitions",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.ItemStatus"
+                        }
+                    ]
                 },
-                "purchase_time": {
-                    "description": "PurchaseTime holds the value of the \"purchase_time\" field.",
+                "syncChildItemsLocations": {
+                    "type": "boolean"
+                },
+                "thumbnailId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "updatedAt": {
                     "type": "string"
                 },
-                "quantity": {
-                    "description": "Quantity holds the value of the \"quantity\" field.",
-                    "type": "integer"
-                },
-                "serial_number": {
-                    "description": "SerialNumber holds the value of the \"serial_number\" field.",
+                "warrantyDetails": {
                     "type": "string"
                 },
-                "sold_notes": {
-                    "description": "SoldNotes holds the value of the \"sold_notes\" field.",
+                "warrantyExpires": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ItemPatch": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "sold_price": {
-                    "description": "SoldPrice holds the value of the \"sold_price\" field.",
-                    "type": "number"
+                "labelIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "sold_time": {
-                    "description": "SoldTime holds the value of the \"sold_time\" field.",
-                    "type": "string"
+                "locationId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "sold_to": {
-                    "description": "SoldTo holds the value of the \"sold_to\" field.",
-                    "type": "string"
+                "quantity": {
+                    "type": "integer",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "sync_child_items_locations": {
-                    "description": "SyncChildItemsLocations holds the value of the \"sync_child_items_locations\" field.",
-                    "type": "boolean"
+                "quantityNote": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "quantityReason": {
+                    "description": "Why the quantity changed, recorded in the stock ledger",
+                    "enum": [
+                        "purchase",
+                        "issue",
+                        "loss",
+                        "adjustment",
+                        "count_correction"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.StockReason"
+                        }
+                    ]
+                }
+            }
+        },
+        "repo.ItemPath": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "warranty_details": {
-                    "description": "WarrantyDetails holds the value of the \"warranty_details\" field.",
+                "name": {
                     "type": "string"
                 },
-                "warranty_expires": {
-                    "description": "WarrantyExpires holds the value of the \"warranty_expires\" field.",
-                    "type": "string"
+                "type": {
+                    "$ref": "#/definitions/repo.ItemType"
                 }
             }
         },
-        "ent.ItemEdges": {
+        "repo.ItemQuery": {
             "type": "object",
             "properties": {
-                "attachments": {
-                    "description": "Attachments holds the value of the attachments edge.",
+                "assetId": {
+                    "type": "integer"
+                },
+                "fields": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Attachment"
+                        "$ref": "#/definitions/repo.FieldQuery"
                     }
                 },
-                "children": {
-                    "description": "Children holds the value of the children edge.",
+                "includeArchived": {
+                    "type": "boolean"
+                },
+                "labelIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Item"
+                        "type": "string"
                     }
                 },
-                "fields": {
-                    "description": "Fields holds the value of the fields edge.",
+                "locationIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.ItemField"
+                        "type": "string"
                     }
                 },
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
+                "negateLabels": {
+                    "type": "boolean"
                 },
-                "label": {
-                    "description": "Label holds the value of the label edge.",
+                "onlyWithPhoto": {
+                    "type": "boolean"
+                },
+                "onlyWithoutPhoto": {
+                    "type": "boolean"
+                },
+                "orderBy": {
+                    "type": "string"
+                },
+                "parentIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Label"
+                        "type": "string"
                     }
                 },
-                "location": {
-                    "description": "Location holds the value of the location edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Location"
-                        }
-                    ]
+                "search": {
+                    "type": "string"
                 },
-                "maintenance_entries": {
-                    "description": "MaintenanceEntries holds the value of the maintenance_entries edge.",
+                "sortBy": {
+                    "type": "string"
+                },
+                "statuses": {
+                    "description": "Statuses limits the items to these lifecycle statuses, any status when empty",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.MaintenanceEntry"
+                        "$ref": "#/definitions/repo.ItemStatus"
                     }
+                }
+            }
+        },
+        "repo.ItemStatus": {
+            "type": "string",
+            "enum": [
+                "in_service",
+                "in_repair",
+                "lost",
+                "stolen",
+                "retired",
+                "disposed"
+            ],
+            "x-enum-varnames": [
+                "ItemStatusInService",
+                "ItemStatusInRepair",
+                "ItemStatusLost",
+                "ItemStatusStolen",
+                "ItemStatusRetired",
+                "ItemStatusDisposed"
+            ]
+        },
+        "repo.ItemStatusChangeOut": {
+            "type": "object",
+            "properties": {
+                "actorId": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "parent": {
-                    "description": "Parent holds the value of the parent edge.",
+                "actorName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "from": {
+                    "$ref": "#/definitions/repo.ItemStatus"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to": {
+                    "$ref": "#/definitions/repo.ItemStatus"
+                }
+            }
+        },
+        "repo.ItemStatusCount": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/repo.ItemStatus"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.ItemStatusTransition": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "status": {
+                    "enum": [
+                        "in_service",
+                        "in_repair",
+                        "lost",
+                        "stolen",
+                        "retired",
+                        "disposed"
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.Item"
+                            "$ref": "#/definitions/repo.ItemStatus"
                         }
                     ]
                 }
             }
         },
-        "ent.ItemField": {
+        "repo.ItemSummary": {
             "type": "object",
             "properties": {
-                "boolean_value": {
-                    "description": "BooleanValue holds the value of the \"boolean_value\" field.",
+                "archived": {
                     "type": "boolean"
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "assetId": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "createdAt": {
                     "type": "string"
                 },
                 "description": {
-                    "description": "Description holds the value of the \"description\" field.",
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ItemFieldQuery when eager-loading is set.",
+                "id": {
+                    "type": "string"
+                },
+                "imageId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "insured": {
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.LabelSummary"
+                    }
+                },
+                "location": {
+                    "description": "Edges",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.ItemFieldEdges"
+                            "$ref": "#/definitions/repo.LocationSummary"
                         }
-                    ]
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                "lowStock": {
+                    "description": "LowStock is set when the quantity has dropped below MinStock",
+                    "type": "boolean"
+                },
+                "minStock": {
+                    "description": "Consumable stock",
+                    "type": "integer"
                 },
                 "name": {
-                    "description": "Name holds the value of the \"name\" field.",
                     "type": "string"
                 },
-                "number_value": {
-                    "description": "NumberValue holds the value of the \"number_value\" field.",
+                "purchasePrice": {
+                    "type": "number"
+                },
+                "quantity": {
                     "type": "integer"
                 },
-                "text_value": {
-                    "description": "TextValue holds the value of the \"text_value\" field.",
-                    "type": "string"
+                "quarantineUntil": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "time_value": {
-                    "description": "TimeValue holds the value of the \"time_value\" field.",
-                    "type": "string"
+                "quarantined": {
+                    "description": "Post-return quarantine",
+                    "type": "boolean"
                 },
-                "type": {
-                    "description": "Type holds the value of the \"type\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/itemfield.Type"
-                        }
-                    ]
+                "quarantinedAt": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "reorderQuantity": {
+                    "type": "integer"
+                },
+                "soldTime": {
+                    "description": "Sale details",
                     "type": "string"
-                }
-            }
-        },
-        "ent.ItemFieldEdges": {
-            "type": "object",
-            "properties": {
-                "item": {
-                    "description": "Item holds the value of the item edge.",
+                },
+                "status": {
+                    "description": "Status is the lifecycle status, see ItemStatusTransitions",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.Item"
+                            "$ref": "#/definitions/repo.ItemStatus"
                         }
                     ]
+                },
+                "thumbnailId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "ent.ItemTemplate": {
+        "repo.ItemTemplateCreate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
-                },
-                "default_description": {
-                    "description": "Default description for items created from this template",
-                    "type": "string"
+                "defaultDescription": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "x-nullable": true
                 },
-                "default_insured": {
-                    "description": "DefaultInsured holds the value of the \"default_insured\" field.",
+                "defaultInsured": {
                     "type": "boolean"
                 },
-                "default_label_ids": {
-                    "description": "Default label IDs for items created from this template",
+                "defaultLabelIds": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    }
+                    },
+                    "x-nullable": true
                 },
-                "default_lifetime_warranty": {
-                    "description": "DefaultLifetimeWarranty holds the value of the \"default_lifetime_warranty\" field.",
+                "defaultLifetimeWarranty": {
                     "type": "boolean"
                 },
-                "default_manufacturer": {
-                    "description": "DefaultManufacturer holds the value of the \"default_manufacturer\" field.",
-                    "type": "string"
+                "defaultLocationId": {
+                    "description": "Default location and labels",
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "default_model_number": {
-                    "description": "Default model number for items created from this template",
-                    "type": "string"
+                "defaultManufacturer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
                 },
-                "default_name": {
-                    "description": "Default name template for items (can use placeholders)",
-                    "type": "string"
+                "defaultModelNumber": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
                 },
-                "default_quantity": {
-                    "description": "DefaultQuantity holds the value of the \"default_quantity\" field.",
-                    "type": "integer"
+                "defaultName": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
                 },
-                "default_warranty_details": {
-                    "description": "DefaultWarrantyDetails holds the value of the \"default_warranty_details\" field.",
-                    "type": "string"
+                "defaultQuantity": {
+                    "description": "Default values for items",
+                    "type": "integer",
+                    "x-nullable": true
                 },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
-                    "type": "string"
+                "defaultWarrantyDetails": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "x-nullable": true
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ItemTemplateQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.ItemTemplateEdges"
-                        }
-                    ]
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                "fields": {
+                    "description": "Custom fields",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TemplateField"
+                    }
                 },
-                "include_purchase_fields": {
-                    "description": "Whether to include purchase fields in items created from this template",
+                "includePurchaseFields": {
                     "type": "boolean"
                 },
-                "include_sold_fields": {
-                    "description": "Whether to include sold fields in items created from this template",
+                "includeSoldFields": {
                     "type": "boolean"
                 },
-                "include_warranty_fields": {
-                    "description": "Whether to include warranty fields in items created from this template",
+                "includeWarrantyFields": {
+                    "description": "Metadata flags",
                     "type": "boolean"
                 },
                 "name": {
-                    "description": "Name holds the value of the \"name\" field.",
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
                 "notes": {
-                    "description": "Notes holds the value of the \"notes\" field.",
-                    "type": "string"
-                },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 1000
                 }
             }
         },
-        "ent.ItemTemplateEdges": {
+        "repo.ItemTemplateExport": {
             "type": "object",
             "properties": {
-                "fields": {
-                    "description": "Fields holds the value of the fields edge.",
+                "templates": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.TemplateField"
+                        "$ref": "#/definitions/repo.PortableItemTemplate"
                     }
                 },
-                "group": {
-                    "description": "Group holds the value of the group edge.",
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.ItemTemplateImport": {
+            "type": "object",
+            "properties": {
+                "conflict": {
+                    "enum": [
+                        "skip",
+                        "rename",
+                        "overwrite"
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.Group"
+                            "$ref": "#/definitions/repo.TemplateConflict"
                         }
                     ]
                 },
-                "location": {
-                    "description": "Location holds the value of the location edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Location"
-                        }
-                    ]
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.PortableItemTemplate"
+                    }
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "ent.Label": {
+        "repo.ItemTemplateImportResult": {
             "type": "object",
             "properties": {
-                "color": {
-                    "description": "Color holds the value of the \"color\" field.",
-                    "type": "string"
+                "created": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemTemplateSummary"
+                    }
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemTemplateSummary"
+                    }
+                },
+                "warnings": {
+                    "description": "Warnings name the locations and labels that don't exist in the group, the\ntemplates are imported without them",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "repo.ItemTemplateOut": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
+                "defaultDescription": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LabelQuery when eager-loading is set.",
+                "defaultInsured": {
+                    "type": "boolean"
+                },
+                "defaultLabels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TemplateLabelSummary"
+                    }
+                },
+                "defaultLifetimeWarranty": {
+                    "type": "boolean"
+                },
+                "defaultLocation": {
+                    "description": "Default location and labels",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.LabelEdges"
+                            "$ref": "#/definitions/repo.TemplateLocationSummary"
                         }
                     ]
                 },
-                "id": {
-                    "description": "ID of the ent.",
+                "defaultManufacturer": {
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
+                "defaultModelNumber": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "defaultName": {
                     "type": "string"
-                }
-            }
-        },
-        "ent.LabelEdges": {
-            "type": "object",
-            "properties": {
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
                 },
-                "items": {
-                    "description": "Items holds the value of the items edge.",
+                "defaultQuantity": {
+                    "description": "Default values for items",
+                    "type": "integer"
+                },
+                "defaultWarrantyDetails": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "Custom fields",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Item"
+                        "$ref": "#/definitions/repo.TemplateField"
                     }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "includePurchaseFields": {
+                    "type": "boolean"
+                },
+                "includeSoldFields": {
+                    "type": "boolean"
+                },
+                "includeWarrantyFields": {
+                    "description": "Metadata flags",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "ent.Location": {
+        "repo.ItemTemplateSummary": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "createdAt": {
                     "type": "string"
                 },
                 "description": {
-                    "description": "Description holds the value of the \"description\" field.",
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LocationQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.LocationEdges"
-                        }
-                    ]
-                },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
                 "name": {
-                    "description": "Name holds the value of the \"name\" field.",
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "ent.LocationEdges": {
+        "repo.ItemTemplateUpdate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "children": {
-                    "description": "Children holds the value of the children edge.",
+                "defaultDescription": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "x-nullable": true
+                },
+                "defaultInsured": {
+                    "type": "boolean"
+                },
+                "defaultLabelIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Location"
-                    }
+                        "type": "string"
+                    },
+                    "x-nullable": true
                 },
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
+                "defaultLifetimeWarranty": {
+                    "type": "boolean"
                 },
-                "items": {
-                    "description": "Items holds the value of the items edge.",
+                "defaultLocationId": {
+                    "description": "Default location and labels",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "defaultManufacturer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
+                },
+                "defaultModelNumber": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
+                },
+                "defaultName": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
+                },
+                "defaultQuantity": {
+                    "description": "Default values for items",
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "defaultWarrantyDetails": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "x-nullable": true
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "fields": {
+                    "description": "Custom fields",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Item"
+                        "$ref": "#/definitions/repo.TemplateField"
                     }
                 },
-                "parent": {
-                    "description": "Parent holds the value of the parent edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Location"
-                        }
-                    ]
+                "id": {
+                    "type": "string"
+                },
+                "includePurchaseFields": {
+                    "type": "boolean"
+                },
+                "includeSoldFields": {
+                    "type": "boolean"
+                },
+                "includeWarrantyFields": {
+                    "description": "Metadata flags",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
                 }
             }
         },
-        "ent.MaintenanceEntry": {
+        "repo.ItemType": {
+            "type": "string",
+            "enum": [
+                "location",
+                "item"
+            ],
+            "x-enum-varnames": [
+                "ItemTypeLocation",
+                "ItemTypeItem"
+            ]
+        },
+        "repo.ItemUpdate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "cost": {
-                    "description": "Cost holds the value of the \"cost\" field.",
-                    "type": "number"
-                },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
+                "archived": {
+                    "type": "boolean"
                 },
-                "date": {
-                    "description": "Date holds the value of the \"date\" field.",
+                "assetId": {
                     "type": "string"
                 },
                 "description": {
-                    "description": "Description holds the value of the \"description\" field.",
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the MaintenanceEntryQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.MaintenanceEntryEdges"
-                        }
-                    ]
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemField"
+                    }
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "item_id": {
-                    "description": "ItemID holds the value of the \"item_id\" field.",
+                "insured": {
+                    "type": "boolean"
+                },
+                "labelIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "lifetimeWarranty": {
+                    "description": "Warranty",
+                    "type": "boolean"
+                },
+                "locationId": {
+                    "description": "Edges",
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
+                "manufacturer": {
                     "type": "string"
                 },
-                "scheduled_date": {
-                    "description": "ScheduledDate holds the value of the \"scheduled_date\" field.",
+                "minStock": {
+                    "description": "Consumable stock, see ItemSummary.LowStock",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "modelNumber": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "notes": {
+                    "description": "Extras",
                     "type": "string"
-                }
-            }
-        },
-        "ent.MaintenanceEntryEdges": {
-            "type": "object",
-            "properties": {
-                "item": {
-                    "description": "Item holds the value of the item edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Item"
-                        }
-                    ]
-                }
-            }
-        },
-        "ent.Notifier": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                },
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "purchaseFrom": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "purchasePrice": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "purchaseTime": {
+                    "description": "Purchase",
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the NotifierQuery when eager-loading is set.",
+                "quantity": {
+                    "type": "integer"
+                },
+                "quantityNote": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "quantityReason": {
+                    "description": "Why the quantity changed, recorded in the stock ledger",
+                    "enum": [
+                        "purchase",
+                        "issue",
+                        "loss",
+                        "adjustment",
+                        "count_correction"
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.NotifierEdges"
+                            "$ref": "#/definitions/repo.StockReason"
                         }
                     ]
                 },
-                "group_id": {
-                    "description": "GroupID holds the value of the \"group_id\" field.",
+                "reorderQuantity": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "serialNumber": {
+                    "description": "Identifications",
+                    "type": "string"
+                },
+                "soldNotes": {
+                    "type": "string"
+                },
+                "soldPrice": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "soldTime": {
+                    "description": "Sold",
                     "type": "string"
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                "soldTo": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "is_active": {
-                    "description": "IsActive holds the value of the \"is_active\" field.",
+                "syncChildItemsLocations": {
                     "type": "boolean"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
-                    "type": "string"
-                },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "warrantyDetails": {
                     "type": "string"
                 },
-                "user_id": {
-                    "description": "UserID holds the value of the \"user_id\" field.",
+                "warrantyExpires": {
                     "type": "string"
                 }
             }
         },
-        "ent.NotifierEdges": {
+        "repo.KioskHeartbeat": {
             "type": "object",
             "properties": {
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
+                "appVersion": {
+                    "type": "string",
+                    "maxLength": 64
                 },
-                "user": {
-                    "description": "User holds the value of the user edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.User"
-                        }
-                    ]
+                "batteryCharging": {
+                    "type": "boolean"
+                },
+                "batteryLevel": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "idleSeconds": {
+                    "description": "IdleSeconds is how long ago someone last interacted with the device",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "networkType": {
+                    "type": "string",
+                    "maxLength": 32
                 }
             }
         },
-        "ent.TemplateField": {
+        "repo.LabelCreate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "assetIdPrefix": {
+                    "description": "AssetIDPrefix numbers the items created with the label in their own series",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "color": {
                     "type": "string"
                 },
                 "description": {
-                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "quarantineMinutes": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "quarantineOnReturn": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "repo.LabelOut": {
+            "type": "object",
+            "properties": {
+                "assetIdPrefix": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the TemplateFieldQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.TemplateFieldEdges"
-                        }
-                    ]
+                "color": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
                 "name": {
-                    "description": "Name holds the value of the \"name\" field.",
                     "type": "string"
                 },
-                "text_value": {
-                    "description": "TextValue holds the value of the \"text_value\" field.",
-                    "type": "string"
+                "quarantineMinutes": {
+                    "type": "integer"
                 },
-                "type": {
-                    "description": "Type holds the value of the \"type\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/templatefield.Type"
-                        }
-                    ]
+                "quarantineOnReturn": {
+                    "type": "boolean"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "ent.TemplateFieldEdges": {
-            "type": "object",
-            "properties": {
-                "item_template": {
-                    "description": "ItemTemplate holds the value of the item_template edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.ItemTemplate"
-                        }
-                    ]
-                }
-            }
-        },
-        "ent.User": {
+        "repo.LabelSummary": {
             "type": "object",
             "properties": {
-                "activated_on": {
-                    "description": "ActivatedOn holds the value of the \"activated_on\" field.",
+                "assetIdPrefix": {
                     "type": "string"
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "color": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.UserEdges"
-                        }
-                    ]
+                "createdAt": {
+                    "type": "string"
                 },
-                "email": {
-                    "description": "Email holds the value of the \"email\" field.",
+                "description": {
                     "type": "string"
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "is_superuser": {
-                    "description": "IsSuperuser holds the value of the \"is_superuser\" field.",
-                    "type": "boolean"
-                },
                 "name": {
-                    "description": "Name holds the value of the \"name\" field.",
                     "type": "string"
                 },
-                "oidc_issuer": {
-                    "description": "OidcIssuer holds the value of the \"oidc_issuer\" field.",
-                    "type": "string"
-                },
-                "oidc_subject": {
-                    "description": "OidcSubject holds the value of the \"oidc_subject\" field.",
-                    "type": "string"
-                },
-                "role": {
-                    "description": "Role holds the value of the \"role\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/user.Role"
-                        }
-                    ]
+                "quarantineMinutes": {
+                    "type": "integer"
                 },
-                "superuser": {
-                    "description": "Superuser holds the value of the \"superuser\" field.",
+                "quarantineOnReturn": {
                     "type": "boolean"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "ent.UserEdges": {
+        "repo.LabelTemplateCreate": {
             "type": "object",
+            "required": [
+                "fields",
+                "name",
+                "symbology"
+            ],
             "properties": {
-                "auth_tokens": {
-                    "description": "AuthTokens holds the value of the auth_tokens edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.AuthTokens"
-                    }
-                },
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "notifiers": {
-                    "description": "Notifiers holds the value of the notifiers edge.",
+                "fields": {
                     "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/ent.Notifier"
+                        "$ref": "#/definitions/repo.LabelTemplateField"
                     }
-                }
-            }
-        },
-        "itemfield.Type": {
-            "type": "string",
-            "enum": [
-                "text",
-                "number",
-                "boolean",
-                "time"
-            ],
-            "x-enum-varnames": [
-                "TypeText",
-                "TypeNumber",
-                "TypeBoolean",
-                "TypeTime"
-            ]
-        },
-        "repo.BarcodeProduct": {
-            "type": "object",
-            "properties": {
-                "barcode": {
-                    "type": "string"
-                },
-                "imageBase64": {
-                    "type": "string"
-                },
-                "imageURL": {
-                    "type": "string"
-                },
-                "item": {
-                    "$ref": "#/definitions/repo.ItemCreate"
-                },
-                "manufacturer": {
-                    "type": "string"
-                },
-                "modelNumber": {
-                    "description": "Identifications",
-                    "type": "string"
                 },
-                "notes": {
-                    "description": "Extras",
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "search_engine_name": {
-                    "type": "string"
+                "symbology": {
+                    "type": "string",
+                    "enum": [
+                        "qr",
+                        "datamatrix",
+                        "code128",
+                        "none"
+                    ]
                 }
             }
         },
-        "repo.DuplicateOptions": {
+        "repo.LabelTemplateField": {
             "type": "object",
+            "required": [
+                "field"
+            ],
             "properties": {
-                "copyAttachments": {
+                "bold": {
                     "type": "boolean"
                 },
-                "copyCustomFields": {
-                    "type": "boolean"
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "asset_id",
+                        "name",
+                        "description",
+                        "serial_number",
+                        "model_number",
+                        "manufacturer",
+                        "location",
+                        "location_path",
+                        "custom_field",
+                        "text"
+                    ]
                 },
-                "copyMaintenance": {
-                    "type": "boolean"
+                "fieldName": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "copyPrefix": {
-                    "type": "string"
+                "fontSize": {
+                    "description": "FontSize is the size of the line, 0 for the configured size",
+                    "type": "number",
+                    "maximum": 200,
+                    "minimum": 0
+                },
+                "prefix": {
+                    "description": "Prefix is printed before the value, e.g. S/N:",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "repo.Group": {
+        "repo.LabelTemplateOut": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "currency": {
+                "description": {
                     "type": "string"
                 },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.LabelTemplateField"
+                    }
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
+                "symbology": {
+                    "type": "string"
+                },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.GroupStatistics": {
+        "repo.LabelTemplateUpdate": {
             "type": "object",
+            "required": [
+                "fields",
+                "name",
+                "symbology"
+            ],
             "properties": {
-                "totalItemPrice": {
-                    "type": "number"
-                },
-                "totalItems": {
-                    "type": "integer"
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "totalLabels": {
-                    "type": "integer"
+                "fields": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/repo.LabelTemplateField"
+                    }
                 },
-                "totalLocations": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "totalUsers": {
-                    "type": "integer"
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "totalWithWarranty": {
-                    "type": "integer"
+                "symbology": {
+                    "type": "string",
+                    "enum": [
+                        "qr",
+                        "datamatrix",
+                        "code128",
+                        "none"
+                    ]
                 }
             }
         },
-        "repo.GroupUpdate": {
+        "repo.LoanCreate": {
             "type": "object",
+            "required": [
+                "borrowerId",
+                "dueAt",
+                "itemId"
+            ],
             "properties": {
-                "currency": {
+                "borrowerId": {
                     "type": "string"
                 },
-                "name": {
+                "dueAt": {
+                    "type": "string"
+                },
+                "itemId": {
                     "type": "string"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
                 }
             }
         },
-        "repo.ItemAttachment": {
+        "repo.LoanOut": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "borrowerEmail": {
                     "type": "string"
                 },
-                "id": {
+                "borrowerId": {
                     "type": "string"
                 },
-                "mimeType": {
+                "borrowerName": {
                     "type": "string"
                 },
-                "path": {
+                "borrowerPhone": {
                     "type": "string"
                 },
-                "primary": {
-                    "type": "boolean"
+                "checkedOutAt": {
+                    "type": "string"
                 },
-                "thumbnail": {
-                    "$ref": "#/definitions/ent.Attachment"
+                "checkedOutBy": {
+                    "type": "string"
                 },
-                "title": {
+                "createdAt": {
                     "type": "string"
                 },
-                "type": {
+                "dueAt": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "repo.ItemAttachmentUpdate": {
-            "type": "object",
-            "properties": {
-                "primary": {
+                },
+                "isOverdue": {
                     "type": "boolean"
                 },
-                "title": {
-                    "type": "string"
+                "isTransfer": {
+                    "type": "boolean"
                 },
-                "type": {
-                    "type": "string"
-                }
-            }
-        },
-        "repo.ItemCreate": {
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                "itemAssetId": {
+                    "type": "integer"
                 },
-                "labelIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "itemId": {
+                    "type": "string"
                 },
-                "locationId": {
-                    "description": "Edges",
+                "itemName": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "kioskAction": {
+                    "type": "boolean"
                 },
-                "parentId": {
-                    "type": "string",
-                    "x-nullable": true
+                "notes": {
+                    "type": "string"
                 },
                 "quantity": {
                     "type": "integer"
-                }
-            }
-        },
-        "repo.ItemField": {
-            "type": "object",
-            "properties": {
-                "booleanValue": {
-                    "type": "boolean"
                 },
-                "id": {
+                "returnLocationId": {
                     "type": "string"
                 },
-                "name": {
+                "returnLocationName": {
                     "type": "string"
                 },
-                "numberValue": {
-                    "type": "integer"
+                "returnNotes": {
+                    "type": "string"
                 },
-                "textValue": {
+                "returnedAt": {
                     "type": "string"
                 },
-                "type": {
+                "returnedBy": {
+                    "type": "string"
+                },
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.ItemOut": {
+        "repo.LoanReturn": {
             "type": "object",
             "properties": {
-                "archived": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
-                "assetId": {
+                "returnNotes": {
                     "type": "string",
-                    "example": "0"
+                    "maxLength": 1000
+                }
+            }
+        },
+        "repo.LoanSummary": {
+            "type": "object",
+            "properties": {
+                "borrowerId": {
+                    "type": "string"
                 },
-                "attachments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.ItemAttachment"
-                    }
+                "borrowerName": {
+                    "type": "string"
                 },
-                "createdAt": {
+                "checkedOutAt": {
                     "type": "string"
                 },
-                "description": {
+                "createdAt": {
                     "type": "string"
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.ItemField"
-                    }
+                "dueAt": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "imageId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "isOverdue": {
+                    "type": "boolean"
                 },
-                "insured": {
+                "isTransfer": {
                     "type": "boolean"
                 },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.LabelSummary"
-                    }
+                "itemId": {
+                    "type": "string"
                 },
-                "lifetimeWarranty": {
-                    "description": "Warranty",
-                    "type": "boolean"
+                "itemName": {
+                    "type": "string"
                 },
-                "location": {
-                    "description": "Edges",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.LocationSummary"
-                        }
-                    ],
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "quantity": {
+                    "type": "integer"
                 },
-                "manufacturer": {
+                "returnedAt": {
                     "type": "string"
                 },
-                "modelNumber": {
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.LoanUpdate": {
+            "type": "object",
+            "properties": {
+                "dueAt": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
                     "type": "string"
                 },
                 "notes": {
-                    "description": "Extras",
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "repo.LocationCreate": {
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string"
                 },
-                "parent": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.ItemSummary"
-                        }
-                    ],
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "purchaseFrom": {
+                "name": {
                     "type": "string"
                 },
-                "purchasePrice": {
-                    "type": "number"
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true
+                }
+            }
+        },
+        "repo.LocationOut": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.LocationSummary"
+                    }
                 },
-                "purchaseTime": {
-                    "description": "Purchase",
+                "createdAt": {
                     "type": "string"
                 },
-                "quantity": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "serialNumber": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 },
-                "soldNotes": {
-                    "type": "string"
+                "parent": {
+                    "$ref": "#/definitions/repo.LocationSummary"
                 },
-                "soldPrice": {
+                "totalPrice": {
                     "type": "number"
                 },
-                "soldTime": {
-                    "description": "Sold",
+                "updatedAt": {
                     "type": "string"
-                },
-                "soldTo": {
+                }
+            }
+        },
+        "repo.LocationOutCount": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "syncChildItemsLocations": {
-                    "type": "boolean"
-                },
-                "thumbnailId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "description": {
+                    "type": "string"
                 },
-                "updatedAt": {
+                "id": {
                     "type": "string"
                 },
-                "warrantyDetails": {
+                "itemCount": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "warrantyExpires": {
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.ItemPatch": {
+        "repo.LocationSummary": {
             "type": "object",
             "properties": {
-                "id": {
+                "createdAt": {
                     "type": "string"
                 },
-                "labelIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "description": {
+                    "type": "string"
                 },
-                "locationId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "id": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "repo.ItemPath": {
+        "repo.LocationUpdate": {
             "type": "object",
             "properties": {
+                "description": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/repo.ItemType"
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true
                 }
             }
         },
-        "repo.ItemSummary": {
+        "repo.MaintenanceEntry": {
             "type": "object",
             "properties": {
-                "archived": {
-                    "type": "boolean"
+                "completedDate": {
+                    "type": "string"
                 },
-                "assetId": {
+                "cost": {
                     "type": "string",
                     "example": "0"
                 },
-                "createdAt": {
-                    "type": "string"
-                },
                 "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "imageId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "insured": {
-                    "type": "boolean"
-                },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.LabelSummary"
-                    }
-                },
-                "location": {
-                    "description": "Edges",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.LocationSummary"
-                        }
-                    ],
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
                 "name": {
                     "type": "string"
                 },
-                "purchasePrice": {
-                    "type": "number"
-                },
-                "quantity": {
-                    "type": "integer"
-                },
-                "soldTime": {
-                    "description": "Sale details",
-                    "type": "string"
-                },
-                "thumbnailId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "updatedAt": {
+                "scheduledDate": {
                     "type": "string"
                 }
             }
         },
-        "repo.ItemTemplateCreate": {
+        "repo.MaintenanceEntryCreate": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "defaultDescription": {
-                    "type": "string",
-                    "maxLength": 1000,
-                    "x-nullable": true
+                "completedDate": {
+                    "type": "string"
                 },
-                "defaultInsured": {
-                    "type": "boolean"
+                "cost": {
+                    "type": "string",
+                    "example": "0"
                 },
-                "defaultLabelIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "x-nullable": true
+                "description": {
+                    "type": "string"
                 },
-                "defaultLifetimeWarranty": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
                 },
-                "defaultLocationId": {
-                    "description": "Default location and labels",
-                    "type": "string",
-                    "x-nullable": true
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceEntryUpdate": {
+            "type": "object",
+            "properties": {
+                "completedDate": {
+                    "type": "string"
                 },
-                "defaultManufacturer": {
+                "cost": {
                     "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                    "example": "0"
                 },
-                "defaultModelNumber": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                "description": {
+                    "type": "string"
                 },
-                "defaultName": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                "name": {
+                    "type": "string"
                 },
-                "defaultQuantity": {
-                    "description": "Default values for items",
-                    "type": "integer",
-                    "x-nullable": true
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceEntryWithDetails": {
+            "type": "object",
+            "properties": {
+                "completedDate": {
+                    "type": "string"
                 },
-                "defaultWarrantyDetails": {
+                "cost": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "x-nullable": true
+                    "example": "0"
                 },
                 "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                    "type": "string"
                 },
-                "fields": {
-                    "description": "Custom fields",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.TemplateField"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "includePurchaseFields": {
-                    "type": "boolean"
+                "itemID": {
+                    "type": "string"
                 },
-                "includeSoldFields": {
-                    "type": "boolean"
+                "itemName": {
+                    "type": "string"
                 },
-                "includeWarrantyFields": {
-                    "description": "Metadata flags",
+                "name": {
+                    "type": "string"
+                },
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceFilterStatus": {
+            "type": "string",
+            "enum": [
+                "scheduled",
+                "completed",
+                "both"
+            ],
+            "x-enum-varnames": [
+                "MaintenanceFilterStatusScheduled",
+                "MaintenanceFilterStatusCompleted",
+                "MaintenanceFilterStatusBoth"
+            ]
+        },
+        "repo.NotifierCreate": {
+            "type": "object",
+            "required": [
+                "name",
+                "url"
+            ],
+            "properties": {
+                "isActive": {
                     "type": "boolean"
                 },
                 "name": {
@@ -4106,112 +10839,121 @@ const docTemplate = `{
                     "maxLength": 255,
                     "minLength": 1
                 },
-                "notes": {
-                    "type": "string",
-                    "maxLength": 1000
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "repo.ItemTemplateOut": {
+        "repo.NotifierOut": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "defaultDescription": {
+                "groupId": {
                     "type": "string"
                 },
-                "defaultInsured": {
-                    "type": "boolean"
-                },
-                "defaultLabels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.TemplateLabelSummary"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "defaultLifetimeWarranty": {
+                "isActive": {
                     "type": "boolean"
                 },
-                "defaultLocation": {
-                    "description": "Default location and labels",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.TemplateLocationSummary"
-                        }
-                    ]
-                },
-                "defaultManufacturer": {
+                "name": {
                     "type": "string"
                 },
-                "defaultModelNumber": {
+                "updatedAt": {
                     "type": "string"
                 },
-                "defaultName": {
+                "url": {
                     "type": "string"
                 },
-                "defaultQuantity": {
-                    "description": "Default values for items",
-                    "type": "integer"
-                },
-                "defaultWarrantyDetails": {
+                "userId": {
                     "type": "string"
+                }
+            }
+        },
+        "repo.NotifierUpdate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "isActive": {
+                    "type": "boolean"
                 },
-                "description": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "fields": {
-                    "description": "Custom fields",
+                "url": {
+                    "type": "string",
+                    "x-nullable": true
+                }
+            }
+        },
+        "repo.PaginationResult-repo_AuditEntryOut": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.TemplateField"
+                        "$ref": "#/definitions/repo.AuditEntryOut"
                     }
                 },
-                "id": {
-                    "type": "string"
-                },
-                "includePurchaseFields": {
-                    "type": "boolean"
+                "page": {
+                    "type": "integer"
                 },
-                "includeSoldFields": {
-                    "type": "boolean"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "includeWarrantyFields": {
-                    "description": "Metadata flags",
-                    "type": "boolean"
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.PaginationResult-repo_ItemSummary": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemSummary"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "notes": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "repo.ItemTemplateSummary": {
+        "repo.PaginationResult-repo_PrintJobOut": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.PrintJobOut"
+                    }
                 },
-                "id": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "repo.ItemTemplateUpdate": {
+        "repo.PortableItemTemplate": {
             "type": "object",
             "required": [
                 "name"
@@ -4219,66 +10961,52 @@ const docTemplate = `{
             "properties": {
                 "defaultDescription": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "x-nullable": true
+                    "maxLength": 1000
                 },
                 "defaultInsured": {
                     "type": "boolean"
                 },
-                "defaultLabelIds": {
+                "defaultLabels": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    },
-                    "x-nullable": true
+                    }
                 },
                 "defaultLifetimeWarranty": {
                     "type": "boolean"
                 },
-                "defaultLocationId": {
-                    "description": "Default location and labels",
-                    "type": "string",
-                    "x-nullable": true
+                "defaultLocation": {
+                    "type": "string"
                 },
                 "defaultManufacturer": {
                     "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                    "maxLength": 255
                 },
                 "defaultModelNumber": {
                     "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                    "maxLength": 255
                 },
                 "defaultName": {
                     "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                    "maxLength": 255
                 },
                 "defaultQuantity": {
-                    "description": "Default values for items",
-                    "type": "integer",
-                    "x-nullable": true
+                    "type": "integer"
                 },
                 "defaultWarrantyDetails": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "x-nullable": true
+                    "maxLength": 1000
                 },
                 "description": {
                     "type": "string",
                     "maxLength": 1000
                 },
                 "fields": {
-                    "description": "Custom fields",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.TemplateField"
+                        "$ref": "#/definitions/repo.PortableTemplateField"
                     }
                 },
-                "id": {
-                    "type": "string"
-                },
                 "includePurchaseFields": {
                     "type": "boolean"
                 },
@@ -4286,7 +11014,6 @@ const docTemplate = `{
                     "type": "boolean"
                 },
                 "includeWarrantyFields": {
-                    "description": "Metadata flags",
                     "type": "boolean"
                 },
                 "name": {
@@ -4300,139 +11027,118 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.ItemType": {
-            "type": "string",
-            "enum": [
-                "location",
-                "item"
-            ],
-            "x-enum-varnames": [
-                "ItemTypeLocation",
-                "ItemTypeItem"
-            ]
-        },
-        "repo.ItemUpdate": {
+        "repo.PortableTemplateField": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "archived": {
-                    "type": "boolean"
-                },
-                "assetId": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
-                },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.ItemField"
-                    }
-                },
-                "id": {
+                "name": {
                     "type": "string"
                 },
-                "insured": {
-                    "type": "boolean"
-                },
-                "labelIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "lifetimeWarranty": {
-                    "description": "Warranty",
-                    "type": "boolean"
-                },
-                "locationId": {
-                    "description": "Edges",
+                "textValue": {
                     "type": "string"
                 },
-                "manufacturer": {
+                "type": {
                     "type": "string"
+                }
+            }
+        },
+        "repo.PrintJobOut": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
                 },
-                "modelNumber": {
+                "createdAt": {
                     "type": "string"
                 },
-                "name": {
+                "createdBy": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                    "x-nullable": true
                 },
-                "notes": {
-                    "description": "Extras",
+                "error": {
                     "type": "string"
                 },
-                "parentId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "purchaseFrom": {
+                "finishedAt": {
                     "type": "string",
-                    "maxLength": 255
-                },
-                "purchasePrice": {
-                    "type": "number",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                    "x-nullable": true
                 },
-                "purchaseTime": {
-                    "description": "Purchase",
+                "id": {
                     "type": "string"
                 },
-                "quantity": {
+                "labels": {
                     "type": "integer"
                 },
-                "serialNumber": {
-                    "description": "Identifications",
-                    "type": "string"
-                },
-                "soldNotes": {
-                    "type": "string"
-                },
-                "soldPrice": {
-                    "type": "number",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "maxAttempts": {
+                    "type": "integer"
                 },
-                "soldTime": {
-                    "description": "Sold",
+                "name": {
                     "type": "string"
                 },
-                "soldTo": {
-                    "type": "string",
-                    "maxLength": 255
+                "printer": {
+                    "$ref": "#/definitions/repo.PrintJobPrinter"
                 },
-                "syncChildItemsLocations": {
-                    "type": "boolean"
+                "runAt": {
+                    "description": "RunAt is when the job is printed next while it is queued",
+                    "type": "string"
                 },
-                "warrantyDetails": {
+                "status": {
                     "type": "string"
                 },
-                "warrantyExpires": {
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.LabelCreate": {
+        "repo.PrintJobPrinter": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.PrinterCreate": {
             "type": "object",
             "required": [
+                "kind",
                 "name"
             ],
             "properties": {
-                "color": {
-                    "type": "string"
+                "address": {
+                    "type": "string",
+                    "maxLength": 1024
                 },
                 "description": {
                     "type": "string",
                     "maxLength": 1000
                 },
+                "dpi": {
+                    "description": "DPI is the resolution of raw printers, 203 when 0",
+                    "type": "integer",
+                    "maximum": 1200,
+                    "minimum": 0
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "command",
+                        "raw",
+                        "ipp"
+                    ]
+                },
+                "language": {
+                    "description": "Language is the printer language of raw printers",
+                    "type": "string",
+                    "enum": [
+                        "zpl",
+                        "epl"
+                    ]
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 255,
@@ -4440,10 +11146,10 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.LabelOut": {
+        "repo.PrinterOut": {
             "type": "object",
             "properties": {
-                "color": {
+                "address": {
                     "type": "string"
                 },
                 "createdAt": {
@@ -4452,9 +11158,18 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "dpi": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
+                "kind": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -4463,53 +11178,77 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.LabelSummary": {
+        "repo.PrinterUpdate": {
             "type": "object",
+            "required": [
+                "kind",
+                "name"
+            ],
             "properties": {
-                "color": {
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string"
+                "address": {
+                    "type": "string",
+                    "maxLength": 1024
                 },
                 "description": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "dpi": {
+                    "type": "integer",
+                    "maximum": 1200,
+                    "minimum": 0
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "command",
+                        "raw",
+                        "ipp"
+                    ]
                 },
-                "updatedAt": {
-                    "type": "string"
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "zpl",
+                        "epl"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 }
             }
         },
-        "repo.LocationCreate": {
+        "repo.SavedSearchCreate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
                 "description": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 1000
                 },
                 "name": {
-                    "type": "string"
-                },
-                "parentId": {
                     "type": "string",
-                    "x-nullable": true
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "query": {
+                    "$ref": "#/definitions/repo.ItemQuery"
+                },
+                "shared": {
+                    "type": "boolean"
                 }
             }
         },
-        "repo.LocationOut": {
+        "repo.SavedSearchOut": {
             "type": "object",
             "properties": {
-                "children": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.LocationSummary"
-                    }
-                },
                 "createdAt": {
                     "type": "string"
                 },
@@ -4522,279 +11261,372 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "parent": {
-                    "$ref": "#/definitions/repo.LocationSummary"
+                "query": {
+                    "$ref": "#/definitions/repo.ItemQuery"
                 },
-                "totalPrice": {
-                    "type": "number"
+                "shared": {
+                    "type": "boolean"
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "userName": {
+                    "type": "string"
                 }
             }
         },
-        "repo.LocationOutCount": {
+        "repo.SavedSearchUpdate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
                 "description": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 1000
                 },
                 "id": {
                     "type": "string"
                 },
-                "itemCount": {
-                    "type": "integer"
-                },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "updatedAt": {
+                "query": {
+                    "$ref": "#/definitions/repo.ItemQuery"
+                },
+                "shared": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "repo.StockLevel": {
+            "type": "object",
+            "properties": {
+                "at": {
                     "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
                 }
             }
         },
-        "repo.LocationSummary": {
+        "repo.StockMovementOut": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "actorId": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "actorName": {
                     "type": "string"
                 },
-                "description": {
+                "createdAt": {
                     "type": "string"
                 },
+                "delta": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "itemId": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "note": {
                     "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "$ref": "#/definitions/repo.StockReason"
                 }
             }
         },
-        "repo.LocationUpdate": {
+        "repo.StockReason": {
+            "type": "string",
+            "enum": [
+                "purchase",
+                "issue",
+                "loss",
+                "adjustment",
+                "count_correction"
+            ],
+            "x-enum-varnames": [
+                "StockReasonPurchase",
+                "StockReasonIssue",
+                "StockReasonLoss",
+                "StockReasonAdjustment",
+                "StockReasonCountCorrection"
+            ]
+        },
+        "repo.StocktakeClose": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "markMissingLost": {
+                    "description": "MarkMissingLost marks the missing items lost, items on loan are excused",
+                    "type": "boolean"
                 },
-                "id": {
+                "moveMisplaced": {
+                    "description": "MoveMisplaced moves the misplaced items to the location they were scanned in",
+                    "type": "boolean"
+                }
+            }
+        },
+        "repo.StocktakeCreate": {
+            "type": "object",
+            "required": [
+                "locationId",
+                "name"
+            ],
+            "properties": {
+                "locationId": {
                     "type": "string"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "parentId": {
+                "notes": {
                     "type": "string",
-                    "x-nullable": true
+                    "maxLength": 1000
                 }
             }
         },
-        "repo.MaintenanceEntry": {
+        "repo.StocktakeEntryOut": {
             "type": "object",
             "properties": {
-                "completedDate": {
-                    "type": "string"
+                "expected": {
+                    "type": "boolean"
                 },
-                "cost": {
+                "expectedLocation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.LocationSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "foundAt": {
                     "type": "string",
-                    "example": "0"
+                    "x-nullable": true
                 },
-                "description": {
-                    "type": "string"
+                "foundLocation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.LocationSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "item": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.ItemSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "scheduledDate": {
+                "itemId": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "onLoan": {
+                    "type": "boolean"
+                },
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "repo.MaintenanceEntryCreate": {
+        "repo.StocktakeProgress": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "completedDate": {
-                    "type": "string"
+                "expected": {
+                    "type": "integer"
                 },
-                "cost": {
-                    "type": "string",
-                    "example": "0"
+                "found": {
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "unexpected": {
+                    "type": "integer"
+                },
+                "unknown": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.StocktakeReport": {
+            "type": "object",
+            "properties": {
+                "found": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.StocktakeEntryOut"
+                    }
+                },
+                "markedLost": {
+                    "type": "integer"
+                },
+                "misplaced": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.StocktakeEntryOut"
+                    }
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.StocktakeEntryOut"
+                    }
+                },
+                "moved": {
+                    "description": "Set when closing with StocktakeClose",
+                    "type": "integer"
+                },
+                "onLoan": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.StocktakeEntryOut"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "stocktake": {
+                    "$ref": "#/definitions/repo.StocktakeSummary"
                 },
-                "scheduledDate": {
-                    "type": "string"
+                "unknown": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.StocktakeEntryOut"
+                    }
                 }
             }
         },
-        "repo.MaintenanceEntryUpdate": {
+        "repo.StocktakeScan": {
             "type": "object",
+            "required": [
+                "value"
+            ],
             "properties": {
-                "completedDate": {
-                    "type": "string"
-                },
-                "cost": {
+                "locationId": {
+                    "description": "LocationID is the room being walked, items found without one are taken to be\nwhere they are recorded",
                     "type": "string",
-                    "example": "0"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+                    "x-nullable": true
                 },
-                "scheduledDate": {
-                    "type": "string"
+                "value": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "repo.MaintenanceEntryWithDetails": {
+        "repo.StocktakeScanOut": {
             "type": "object",
             "properties": {
-                "completedDate": {
-                    "type": "string"
+                "entry": {
+                    "$ref": "#/definitions/repo.StocktakeEntryOut"
                 },
-                "cost": {
-                    "type": "string",
-                    "example": "0"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "itemID": {
-                    "type": "string"
-                },
-                "itemName": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+                "progress": {
+                    "$ref": "#/definitions/repo.StocktakeProgress"
                 },
-                "scheduledDate": {
-                    "type": "string"
+                "result": {
+                    "$ref": "#/definitions/repo.StocktakeScanResult"
                 }
             }
         },
-        "repo.MaintenanceFilterStatus": {
+        "repo.StocktakeScanResult": {
             "type": "string",
             "enum": [
-                "scheduled",
-                "completed",
-                "both"
+                "found",
+                "misplaced",
+                "unexpected",
+                "unknown"
             ],
             "x-enum-varnames": [
-                "MaintenanceFilterStatusScheduled",
-                "MaintenanceFilterStatusCompleted",
-                "MaintenanceFilterStatusBoth"
+                "StocktakeScanFound",
+                "StocktakeScanMisplaced",
+                "StocktakeScanUnexpected",
+                "StocktakeScanUnknown"
             ]
         },
-        "repo.NotifierCreate": {
-            "type": "object",
-            "required": [
-                "name",
-                "url"
+        "repo.StocktakeStatus": {
+            "type": "string",
+            "enum": [
+                "open",
+                "closed"
             ],
+            "x-enum-varnames": [
+                "StocktakeStatusOpen",
+                "StocktakeStatusClosed"
+            ]
+        },
+        "repo.StocktakeSummary": {
+            "type": "object",
             "properties": {
-                "isActive": {
-                    "type": "boolean"
+                "closedAt": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "name": {
+                "closedBy": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                    "x-nullable": true
                 },
-                "url": {
-                    "type": "string"
-                }
-            }
-        },
-        "repo.NotifierOut": {
-            "type": "object",
-            "properties": {
-                "createdAt": {
+                "closedByName": {
                     "type": "string"
                 },
-                "groupId": {
+                "createdAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "isActive": {
-                    "type": "boolean"
+                "location": {
+                    "$ref": "#/definitions/repo.LocationSummary"
                 },
                 "name": {
                     "type": "string"
                 },
-                "updatedAt": {
-                    "type": "string"
-                },
-                "url": {
-                    "type": "string"
-                },
-                "userId": {
+                "notes": {
                     "type": "string"
-                }
-            }
-        },
-        "repo.NotifierUpdate": {
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "isActive": {
-                    "type": "boolean"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "progress": {
+                    "$ref": "#/definitions/repo.StocktakeProgress"
                 },
-                "url": {
+                "startedBy": {
                     "type": "string",
                     "x-nullable": true
-                }
-            }
-        },
-        "repo.PaginationResult-repo_ItemSummary": {
-            "type": "object",
-            "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.ItemSummary"
-                    }
                 },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                "startedByName": {
+                    "type": "string"
                 },
-                "total": {
-                    "type": "integer"
+                "status": {
+                    "$ref": "#/definitions/repo.StocktakeStatus"
                 }
             }
         },
+        "repo.TemplateConflict": {
+            "type": "string",
+            "enum": [
+                "skip",
+                "rename",
+                "overwrite"
+            ],
+            "x-enum-varnames": [
+                "TemplateConflictSkip",
+                "TemplateConflictRename",
+                "TemplateConflictOverwrite"
+            ]
+        },
         "repo.TemplateField": {
             "type": "object",
             "properties": {
@@ -4848,6 +11680,26 @@ const docTemplate = `{
                 }
             }
         },
+        "repo.TrashEntry": {
+            "type": "object",
+            "properties": {
+                "deletedAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/repo.ItemType"
+                }
+            }
+        },
         "repo.TreeItem": {
             "type": "object",
             "properties": {
@@ -4900,54 +11752,209 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.UserUpdate": {
+        "repo.UserUpdate": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ValueOverTime": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ValueOverTimeEntry"
+                    }
+                },
+                "start": {
+                    "type": "string"
+                },
+                "valueAtEnd": {
+                    "type": "number"
+                },
+                "valueAtStart": {
+                    "type": "number"
+                }
+            }
+        },
+        "repo.ValueOverTimeEntry": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "services.KioskSyncAction": {
+            "type": "object",
+            "required": [
+                "clientTimestamp",
+                "key",
+                "type"
+            ],
+            "properties": {
+                "borrower": {
+                    "$ref": "#/definitions/repo.BorrowerCreate"
+                },
+                "checkout": {
+                    "$ref": "#/definitions/services.KioskSyncCheckout"
+                },
+                "clientTimestamp": {
+                    "type": "string"
+                },
+                "key": {
+                    "description": "Key is generated by the kiosk and identifies the action across retries",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "return": {
+                    "$ref": "#/definitions/services.KioskSyncReturn"
+                },
+                "type": {
+                    "enum": [
+                        "checkout",
+                        "return",
+                        "register_borrower"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/services.KioskSyncActionType"
+                        }
+                    ]
+                }
+            }
+        },
+        "services.KioskSyncActionType": {
+            "type": "string",
+            "enum": [
+                "checkout",
+                "return",
+                "register_borrower"
+            ],
+            "x-enum-varnames": [
+                "KioskSyncActionCheckout",
+                "KioskSyncActionReturn",
+                "KioskSyncActionRegisterBorrower"
+            ]
+        },
+        "services.KioskSyncCheckout": {
+            "type": "object",
+            "required": [
+                "dueAt",
+                "itemId"
+            ],
+            "properties": {
+                "borrowerId": {
+                    "description": "Either BorrowerID or BorrowerKey, the key of an earlier register_borrower action",
+                    "type": "string"
+                },
+                "borrowerKey": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "dueAt": {
+                    "type": "string"
+                },
+                "itemId": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "services.KioskSyncRequest": {
             "type": "object",
+            "required": [
+                "actions"
+            ],
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+                "actions": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "items": {
+                        "$ref": "#/definitions/services.KioskSyncAction"
+                    }
                 }
             }
         },
-        "repo.ValueOverTime": {
+        "services.KioskSyncResult": {
             "type": "object",
             "properties": {
-                "end": {
+                "key": {
                     "type": "string"
                 },
-                "entries": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.ValueOverTimeEntry"
-                    }
+                "message": {
+                    "type": "string"
                 },
-                "start": {
+                "reason": {
                     "type": "string"
                 },
-                "valueAtEnd": {
-                    "type": "number"
+                "resultId": {
+                    "type": "string"
                 },
-                "valueAtStart": {
-                    "type": "number"
+                "status": {
+                    "$ref": "#/definitions/services.KioskSyncStatus"
                 }
             }
         },
-        "repo.ValueOverTimeEntry": {
+        "services.KioskSyncReturn": {
             "type": "object",
             "properties": {
-                "date": {
+                "checkoutKey": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "itemId": {
                     "type": "string"
                 },
-                "name": {
+                "loanId": {
+                    "description": "One of LoanID, CheckoutKey (the key of an earlier checkout action) or ItemID",
                     "type": "string"
                 },
-                "value": {
-                    "type": "number"
+                "returnNotes": {
+                    "type": "string",
+                    "maxLength": 1000
                 }
             }
         },
+        "services.KioskSyncStatus": {
+            "type": "string",
+            "enum": [
+                "applied",
+                "duplicate",
+                "conflict",
+                "rejected",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "KioskSyncApplied",
+                "KioskSyncDuplicate",
+                "KioskSyncConflict",
+                "KioskSyncRejected",
+                "KioskSyncFailed"
+            ]
+        },
         "services.Latest": {
             "type": "object",
             "properties": {
@@ -4976,13 +11983,59 @@ const docTemplate = `{
                 }
             }
         },
+        "stockmovement.Reason": {
+            "type": "string",
+            "enum": [
+                "adjustment",
+                "purchase",
+                "issue",
+                "loss",
+                "adjustment",
+                "count_correction"
+            ],
+            "x-enum-varnames": [
+                "DefaultReason",
+                "ReasonPurchase",
+                "ReasonIssue",
+                "ReasonLoss",
+                "ReasonAdjustment",
+                "ReasonCountCorrection"
+            ]
+        },
+        "stocktakesession.Status": {
+            "type": "string",
+            "enum": [
+                "open",
+                "open",
+                "closed"
+            ],
+            "x-enum-varnames": [
+                "DefaultStatus",
+                "StatusOpen",
+                "StatusClosed"
+            ]
+        },
         "templatefield.Type": {
             "type": "string",
             "enum": [
-                "text"
+                "text",
+                "number",
+                "decimal",
+                "boolean",
+                "time",
+                "select",
+                "multiselect",
+                "url"
             ],
             "x-enum-varnames": [
-                "TypeText"
+                "TypeText",
+                "TypeNumber",
+                "TypeDecimal",
+                "TypeBoolean",
+                "TypeTime",
+                "TypeSelect",
+                "TypeMultiselect",
+                "TypeURL"
             ]
         },
         "user.Role": {
@@ -5044,6 +12097,17 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.BorrowerVerifyResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "verificationStatus": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.Build": {
             "type": "object",
             "properties": {
@@ -5099,6 +12163,15 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.InspectionSignOff": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
         "v1.ItemAttachmentToken": {
             "type": "object",
             "properties": {
@@ -5137,6 +12210,250 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.ItemTemplateCreateItemsRequest": {
+            "type": "object",
+            "required": [
+                "locationId",
+                "name"
+            ],
+            "properties": {
+                "count": {
+                    "description": "Count defaults to the number of serial numbers",
+                    "type": "integer",
+                    "maximum": 500,
+                    "minimum": 1
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "labelIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "serialNumbers": {
+                    "description": "SerialNumbers is a pasted list of one serial number per unit, separated by new lines,\ncommas or semicolons",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ItemTemplateCreateItemsResponse": {
+            "type": "object",
+            "properties": {
+                "itemIds": {
+                    "description": "ItemIDs are the new items in the order of the serial numbers, their labels are\nprinted with POST /v1/labelmaker/sheet",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.KioskDevice": {
+            "type": "object",
+            "properties": {
+                "appVersion": {
+                    "type": "string"
+                },
+                "batteryCharging": {
+                    "type": "boolean"
+                },
+                "batteryLevel": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isOnline": {
+                    "type": "boolean"
+                },
+                "isUnlocked": {
+                    "type": "boolean"
+                },
+                "lastActivityAt": {
+                    "type": "string"
+                },
+                "lastSeenAt": {
+                    "description": "Device health, as reported by the most recent heartbeat",
+                    "type": "string"
+                },
+                "locationId": {
+                    "description": "Location the kiosk is bound to (nil = whole group)",
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "networkType": {
+                    "type": "string"
+                },
+                "offlineAlertedAt": {
+                    "type": "string"
+                },
+                "unlockedUntil": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.KioskLocationRequest": {
+            "type": "object",
+            "properties": {
+                "locationId": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.KioskStatusResponse": {
+            "type": "object",
+            "properties": {
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isUnlocked": {
+                    "type": "boolean"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "unlockedUntil": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.KioskUnlockRequest": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LabelSheetCustomLayout": {
+            "type": "object",
+            "required": [
+                "columns",
+                "pageSize",
+                "rows"
+            ],
+            "properties": {
+                "columns": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "gapMm": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "marginMm": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "pageSize": {
+                    "type": "string",
+                    "enum": [
+                        "letter",
+                        "a4"
+                    ]
+                },
+                "rows": {
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 1
+                }
+            }
+        },
+        "v1.LabelSheetRequest": {
+            "type": "object",
+            "required": [
+                "layout"
+            ],
+            "properties": {
+                "custom": {
+                    "$ref": "#/definitions/v1.LabelSheetCustomLayout"
+                },
+                "includeItems": {
+                    "description": "IncludeItems also prints the labels of the items in those locations",
+                    "type": "boolean"
+                },
+                "itemIds": {
+                    "description": "ItemIDs prints the labels of these items",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "layout": {
+                    "description": "Layout is the name of a sheet layout, or custom for the Custom layout",
+                    "type": "string"
+                },
+                "locationId": {
+                    "description": "LocationID prints the labels of the location and the locations nested below it",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "printerId": {
+                    "description": "PrinterID queues the labels for the printer instead of returning the PDF. Raw\nprinters print them one after the other, the others on sheets of the layout.",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "savedSearchId": {
+                    "description": "SavedSearchID prints the labels of the items the saved search finds",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "skip": {
+                    "description": "Skip leaves the first positions of the first sheet empty, for partly used sheets",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "templateId": {
+                    "description": "TemplateID lays the labels out with the label template",
+                    "type": "string",
+                    "x-nullable": true
+                }
+            }
+        },
         "v1.LoginForm": {
             "type": "object",
             "properties": {
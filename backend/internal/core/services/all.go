@@ -4,13 +4,18 @@ package services
 import (
 	"github.com/sysadminsmedia/homebox/backend/internal/core/currencies"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/mailer"
 )
 
 type AllServices struct {
 	User              *UserService
 	Group             *GroupService
 	Items             *ItemService
+	Borrowers         *BorrowerService
+	Kiosk             *KioskService
 	BackgroundService *BackgroundService
+	PrintQueue        *PrintQueueService
 	Currencies        *currencies.CurrencyRegistry
 }
 
@@ -19,6 +24,10 @@ type OptionsFunc func(*options)
 type options struct {
 	autoIncrementAssetID bool
 	currencies           []currencies.Currency
+	mailer               *mailer.Mailer
+	borrowers            config.BorrowerConf
+	// labelMaker is the whole configuration, which the label maker takes
+	labelMaker *config.Config
 }
 
 func WithAutoIncrementAssetID(v bool) func(*options) {
@@ -33,6 +42,24 @@ func WithCurrencies(v []currencies.Currency) func(*options) {
 	}
 }
 
+func WithMailer(v *mailer.Mailer) func(*options) {
+	return func(o *options) {
+		o.mailer = v
+	}
+}
+
+func WithBorrowerVerification(v config.BorrowerConf) func(*options) {
+	return func(o *options) {
+		o.borrowers = v
+	}
+}
+
+func WithLabelMaker(v *config.Config) func(*options) {
+	return func(o *options) {
+		o.labelMaker = v
+	}
+}
+
 func New(repos *repo.AllRepos, opts ...OptionsFunc) *AllServices {
 	if repos == nil {
 		panic("repos cannot be nil")
@@ -54,6 +81,12 @@ func New(repos *repo.AllRepos, opts ...OptionsFunc) *AllServices {
 		opt(options)
 	}
 
+	borrowers := &BorrowerService{
+		repos:  repos,
+		mailer: options.mailer,
+		conf:   options.borrowers,
+	}
+
 	return &AllServices{
 		User:  &UserService{repos},
 		Group: &GroupService{repos},
@@ -61,7 +94,17 @@ func New(repos *repo.AllRepos, opts ...OptionsFunc) *AllServices {
 			repo:                 repos,
 			autoIncrementAssetID: options.autoIncrementAssetID,
 		},
+		Borrowers: borrowers,
+		Kiosk: &KioskService{
+			repos:     repos,
+			borrowers: borrowers,
+		},
 		BackgroundService: &BackgroundService{repos, Latest{}},
 		Currencies:        currencies.NewCurrencyService(options.currencies),
+		PrintQueue: &PrintQueueService{
+			repos: repos,
+			cfg:   options.labelMaker,
+			wake:  make(chan struct{}, 1),
+		},
 	}
 }
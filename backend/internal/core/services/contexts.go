@@ -16,6 +16,7 @@ var (
 	ContextUserToken     = &contextKeys{name: "UserToken"}
 	ContextKioskMode     = &contextKeys{name: "KioskMode"}
 	ContextKioskUnlocked = &contextKeys{name: "KioskUnlocked"}
+	ContextKioskLocation = &contextKeys{name: "KioskLocation"}
 )
 
 type Context struct {
@@ -35,6 +36,9 @@ type Context struct {
 
 	// IsKioskUnlocked indicates whether the kiosk has temporary admin access.
 	IsKioskUnlocked bool
+
+	// KioskLocationID is the location the kiosk is bound to (uuid.Nil = whole group).
+	KioskLocationID uuid.UUID
 }
 
 // NewContext is a helper function that returns the service context from the context.
@@ -48,14 +52,23 @@ func NewContext(ctx context.Context) Context {
 		User:            user,
 		IsKiosk:         UseKioskModeCtx(ctx),
 		IsKioskUnlocked: UseKioskUnlockedCtx(ctx),
+		KioskLocationID: UseKioskLocationCtx(ctx),
 	}
 }
 
 // SetUserCtx is a helper function that sets the ContextUser and ContextUserToken
-// values within the context of a web request (or any context).
+// values within the context of a web request (or any context), and attributes the
+// changes made with it to the user in the audit log.
 func SetUserCtx(ctx context.Context, user *repo.UserOut, token string) context.Context {
 	ctx = context.WithValue(ctx, ContextUser, user)
 	ctx = context.WithValue(ctx, ContextUserToken, token)
+	if user != nil {
+		ctx = repo.WithAuditActor(ctx, repo.AuditActor{
+			GroupID: user.GroupID,
+			UserID:  user.ID,
+			Source:  repo.AuditSourceAPI,
+		})
+	}
 	return ctx
 }
 
@@ -63,9 +76,17 @@ func SetUserCtx(ctx context.Context, user *repo.UserOut, token string) context.C
 func SetKioskCtx(ctx context.Context, isKiosk bool, isUnlocked bool) context.Context {
 	ctx = context.WithValue(ctx, ContextKioskMode, isKiosk)
 	ctx = context.WithValue(ctx, ContextKioskUnlocked, isUnlocked)
+	if isKiosk {
+		ctx = repo.WithAuditSource(ctx, repo.AuditSourceKiosk)
+	}
 	return ctx
 }
 
+// SetKioskLocationCtx sets the location the kiosk is bound to in the context.
+func SetKioskLocationCtx(ctx context.Context, locationID uuid.UUID) context.Context {
+	return context.WithValue(ctx, ContextKioskLocation, locationID)
+}
+
 // UseUserCtx is a helper function that returns the user from the context.
 func UseUserCtx(ctx context.Context) *repo.UserOut {
 	if val := ctx.Value(ContextUser); val != nil {
@@ -97,3 +118,11 @@ func UseKioskUnlockedCtx(ctx context.Context) bool {
 	}
 	return false
 }
+
+// UseKioskLocationCtx returns the location the kiosk is bound to, or uuid.Nil.
+func UseKioskLocationCtx(ctx context.Context) uuid.UUID {
+	if val := ctx.Value(ContextKioskLocation); val != nil {
+		return val.(uuid.UUID)
+	}
+	return uuid.Nil
+}
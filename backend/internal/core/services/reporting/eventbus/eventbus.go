@@ -16,12 +16,20 @@ const (
 	EventItemMutation     Event = "item.mutation"
 	EventBorrowerMutation Event = "borrower.mutation"
 	EventLoanMutation     Event = "loan.mutation"
+	EventPrintJobMutation Event = "printjob.mutation"
 )
 
 type GroupMutationEvent struct {
 	GID uuid.UUID
 }
 
+// PrintJobEvent is published when a print job is queued or its status changes.
+type PrintJobEvent struct {
+	GID    uuid.UUID
+	ID     uuid.UUID
+	Status string
+}
+
 type eventData struct {
 	event Event
 	data  any
@@ -44,6 +52,7 @@ func New() *EventBus {
 			EventItemMutation:     {},
 			EventBorrowerMutation: {},
 			EventLoanMutation:     {},
+			EventPrintJobMutation: {},
 		},
 	}
 }
@@ -16,16 +16,21 @@ type ExportCSVRow struct {
 	ImportRef string         `csv:"HB.import_ref"`
 	Location  LocationString `csv:"HB.location"`
 	LabelStr  LabelString    `csv:"HB.labels"`
-	AssetID   repo.AssetID   `csv:"HB.asset_id"`
+	AssetID   string         `csv:"HB.asset_id"`
 	Archived  bool           `csv:"HB.archived"`
 	URL       string         `csv:"HB.url"`
 
+	Identifiers IdentifierString `csv:"HB.identifiers"`
+
 	Name        string `csv:"HB.name"`
 	Quantity    int    `csv:"HB.quantity"`
 	Description string `csv:"HB.description"`
 	Insured     bool   `csv:"HB.insured"`
 	Notes       string `csv:"HB.notes"`
 
+	MinStock        int `csv:"HB.min_stock"`
+	ReorderQuantity int `csv:"HB.reorder_quantity"`
+
 	PurchasePrice float64    `csv:"HB.purchase_price"`
 	PurchaseFrom  string     `csv:"HB.purchase_from"`
 	PurchaseTime  types.Date `csv:"HB.purchase_time"`
@@ -67,6 +72,45 @@ func (ls LabelString) String() string {
 
 // ============================================================================
 
+// IdentifierString is a list of item identifiers written as type:value pairs.
+//
+// For example, the identifiers "upc:012345678905; nfc:04:A2:2B:1A" would be
+// represented as an IdentifierString with the following values:
+//
+//	IdentifierString{{Type: "upc", Value: "012345678905"}, {Type: "nfc", Value: "04:A2:2B:1A"}}
+//
+// Values that don't start with a known type are identifiers of the type other.
+type IdentifierString []repo.ItemIdentifierCreate
+
+func parseIdentifierString(s string) IdentifierString {
+	list, _ := parseSeparatedString(s, ";")
+
+	v := make(IdentifierString, len(list))
+	for i, id := range list {
+		typ, value, ok := strings.Cut(id, ":")
+
+		t, known := repo.ParseIdentifierType(typ)
+		if !ok || !known {
+			t, value = repo.IdentifierTypeOther, id
+		}
+
+		v[i] = repo.ItemIdentifierCreate{Type: t, Value: strings.TrimSpace(value)}
+	}
+
+	return v
+}
+
+func (is IdentifierString) String() string {
+	list := make([]string, len(is))
+	for i, id := range is {
+		list[i] = string(id.Type) + ":" + id.Value
+	}
+
+	return strings.Join(list, "; ")
+}
+
+// ============================================================================
+
 // LocationString is a string slice that is used to represent a location
 // hierarchy.
 //
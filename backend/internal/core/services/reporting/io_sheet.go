@@ -53,7 +53,8 @@ func (s *IOSheet) GetColumn(str string) (col int, ok bool) {
 // Read reads a CSV/TSV and populates the "Rows" field with the data from the sheet
 // Custom Fields are supported via the `HB.field.*` headers. The `HB.field.*` the "Name"
 // of the field is the part after the `HB.field.` prefix. Additionally, Custom Fields with
-// no value are excluded from the row.Fields slice, this includes empty strings.
+// no value are excluded from the row.Fields slice, this includes empty strings. Values
+// are read as text and converted to the type of the group's field definition on import.
 //
 // Note That
 //   - the first row is assumed to be the header
@@ -110,12 +111,12 @@ func (s *IOSheet) Read(data io.Reader) error {
 			// Custom Types
 			case reflect.TypeOf(types.Date{}):
 				v = types.DateFromString(val)
-			case reflect.TypeOf(repo.AssetID(0)):
-				v, _ = repo.ParseAssetID(val)
 			case reflect.TypeOf(LocationString{}):
 				v = parseLocationString(val)
 			case reflect.TypeOf(LabelString{}):
 				v = parseLabelString(val)
+			case reflect.TypeOf(IdentifierString{}):
+				v = parseIdentifierString(val)
 			}
 
 			log.Debug().
@@ -158,6 +159,12 @@ func (s *IOSheet) ReadItems(ctx context.Context, items []repo.ItemOut, gid uuid.
 
 	extraHeaders := map[string]struct{}{}
 
+	// Asset IDs are written in the group's format so that they can be read back on import
+	formats, err := repos.Items.AssetIDFormats(ctx, gid)
+	if err != nil {
+		return err
+	}
+
 	for i := range items {
 		item := items[i]
 
@@ -180,6 +187,11 @@ func (s *IOSheet) ReadItems(ctx context.Context, items []repo.ItemOut, gid uuid.
 
 		url := generateItemURL(item, hbURL)
 
+		identifiers := make(IdentifierString, len(item.Identifiers))
+		for i, id := range item.Identifiers {
+			identifiers[i] = repo.ItemIdentifierCreate{Type: id.Type, Value: id.Value}
+		}
+
 		customFields := make([]ExportItemFields, len(item.Fields))
 
 		for i, f := range item.Fields {
@@ -187,7 +199,7 @@ func (s *IOSheet) ReadItems(ctx context.Context, items []repo.ItemOut, gid uuid.
 
 			customFields[i] = ExportItemFields{
 				Name:  f.Name,
-				Value: f.TextValue,
+				Value: f.ValueText(),
 			}
 		}
 
@@ -197,7 +209,7 @@ func (s *IOSheet) ReadItems(ctx context.Context, items []repo.ItemOut, gid uuid.
 			LabelStr: labelString,
 
 			ImportRef:   item.ImportRef,
-			AssetID:     item.AssetID,
+			AssetID:     formats.Format(repo.AssetRef{Prefix: item.AssetIDPrefix, ID: item.AssetID}),
 			Name:        item.Name,
 			Quantity:    item.Quantity,
 			Description: item.Description,
@@ -205,6 +217,11 @@ func (s *IOSheet) ReadItems(ctx context.Context, items []repo.ItemOut, gid uuid.
 			Archived:    item.Archived,
 			URL:         url,
 
+			Identifiers: identifiers,
+
+			MinStock:        item.MinStock,
+			ReorderQuantity: item.ReorderQuantity,
+
 			PurchasePrice: item.PurchasePrice,
 			PurchaseFrom:  item.PurchaseFrom,
 			PurchaseTime:  item.PurchaseTime,
@@ -307,12 +324,12 @@ func (s *IOSheet) CSV() ([][]string, error) {
 			// Custom Types
 			case reflect.TypeOf(types.Date{}):
 				v = val.Interface().(types.Date).String()
-			case reflect.TypeOf(repo.AssetID(0)):
-				v = val.Interface().(repo.AssetID).String()
 			case reflect.TypeOf(LocationString{}):
 				v = val.Interface().(LocationString).String()
 			case reflect.TypeOf(LabelString{}):
 				v = val.Interface().(LabelString).String()
+			case reflect.TypeOf(IdentifierString{}):
+				v = val.Interface().(IdentifierString).String()
 			default:
 				log.Debug().Str("type", field.Type.String()).Msg("unknown type")
 			}
@@ -21,6 +21,9 @@ var (
 
 	//go:embed .testdata/import/types.csv
 	customTypesImportCSV []byte
+
+	//go:embed .testdata/import/identifiers.csv
+	identifiersImportCSV []byte
 )
 
 func TestSheet_Read(t *testing.T) {
@@ -75,24 +78,51 @@ func TestSheet_Read(t *testing.T) {
 			want: []ExportCSVRow{
 				{
 					Name:     "Item 1",
-					AssetID:  repo.AssetID(1),
+					AssetID:  "1",
 					Location: LocationString{"Path", "To", "Location 1"},
 					LabelStr: LabelString{"L1", "L2", "L3"},
 				},
 				{
 					Name:     "Item 2",
-					AssetID:  repo.AssetID(2),
+					AssetID:  "000-002",
 					Location: LocationString{"Path", "To", "Location 2"},
 					LabelStr: LabelString{"L1", "L2", "L3"},
 				},
 				{
 					Name:     "Item 3",
-					AssetID:  repo.AssetID(1000003),
+					AssetID:  "1000-003",
 					Location: LocationString{"Path", "To", "Location 3"},
 					LabelStr: LabelString{"L1", "L2", "L3"},
 				},
 			},
 		},
+		{
+			name: "identifiers import",
+			data: identifiersImportCSV,
+			want: []ExportCSVRow{
+				{
+					Name:     "Item 1",
+					Location: LocationString{"loc"},
+					Identifiers: IdentifierString{
+						{Type: repo.IdentifierTypeUPC, Value: "012345678905"},
+						{Type: repo.IdentifierTypeNFC, Value: "04:A2:2B:1A"},
+					},
+				},
+				{
+					Name:     "Item 2",
+					Location: LocationString{"loc"},
+					Identifiers: IdentifierString{
+						{Type: repo.IdentifierTypeUPC, Value: "036000291452"},
+						{Type: repo.IdentifierTypeOther, Value: "T-1042"},
+					},
+				},
+				{
+					Name:        "Item 3",
+					Location:    LocationString{"loc"},
+					Identifiers: IdentifierString{},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -88,6 +88,120 @@ func (svc *BackgroundService) SendNotifiersToday(ctx context.Context) error {
 	return nil
 }
 
+// SendLowStockAlerts sends each group's active notifiers the list of items that are low
+// on stock, if there are any.
+func (svc *BackgroundService) SendLowStockAlerts(ctx context.Context) error {
+	groups, err := svc.repos.Groups.GetAllGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	today := types.DateFromTime(time.Now())
+
+	var sendErrs []error
+	for i := range groups {
+		group := groups[i]
+
+		items, err := svc.repos.Items.GetLowStock(ctx, group.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			continue
+		}
+
+		notifiers, err := svc.repos.Notifiers.GetActiveByGroup(ctx, group.ID)
+		if err != nil {
+			return err
+		}
+
+		bldr := strings.Builder{}
+
+		bldr.WriteString("Homebox Low Stock for (")
+		bldr.WriteString(today.String())
+		bldr.WriteString("):\n")
+
+		for _, itm := range items {
+			fmt.Fprintf(&bldr, " - %s: %d of %d", itm.Name, itm.Quantity, itm.MinStock)
+			if itm.ReorderQuantity > 0 {
+				fmt.Fprintf(&bldr, ", reorder %d", itm.ReorderQuantity)
+			}
+			bldr.WriteString("\n")
+		}
+
+		for j := range notifiers {
+			err := shoutrrr.Send(notifiers[j].URL, bldr.String())
+			if err != nil {
+				sendErrs = append(sendErrs, err)
+			}
+		}
+	}
+
+	if len(sendErrs) > 0 {
+		return sendErrs[0]
+	}
+
+	return nil
+}
+
+// LockIdleKiosks revokes the temporary admin unlock of every kiosk that has been idle
+// for longer than idleTimeout.
+func (svc *BackgroundService) LockIdleKiosks(ctx context.Context, idleTimeout time.Duration) error {
+	n, err := svc.repos.KioskSessions.LockIdle(ctx, time.Now().Add(-idleTimeout))
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		log.Info().Int("count", n).Msg("locked idle kiosks")
+	}
+
+	return nil
+}
+
+// SendKioskOfflineAlerts notifies each group about kiosks that have not sent a heartbeat
+// for longer than offlineAfter. Every kiosk is only reported once until it comes back online.
+func (svc *BackgroundService) SendKioskOfflineAlerts(ctx context.Context, offlineAfter time.Duration) error {
+	silent, err := svc.repos.KioskSessions.GetSilent(ctx, time.Now().Add(-offlineAfter))
+	if err != nil {
+		return err
+	}
+
+	var sendErrs []error
+	for i := range silent {
+		kiosk := silent[i]
+
+		notifiers, err := svc.repos.Notifiers.GetActiveByGroup(ctx, kiosk.GroupID)
+		if err != nil {
+			return err
+		}
+
+		msg := fmt.Sprintf("Homebox kiosk for %s has not checked in since %s",
+			kiosk.UserName,
+			kiosk.LastSeenAt.Format(time.RFC1123),
+		)
+
+		for j := range notifiers {
+			err := shoutrrr.Send(notifiers[j].URL, msg)
+			if err != nil {
+				sendErrs = append(sendErrs, err)
+			}
+		}
+
+		err = svc.repos.KioskSessions.MarkOfflineAlerted(ctx, kiosk.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(sendErrs) > 0 {
+		return sendErrs[0]
+	}
+
+	return nil
+}
+
 func (svc *BackgroundService) GetLatestGithubRelease(ctx context.Context) error {
 	url := "https://api.github.com/repos/sysadminsmedia/homebox/releases/latest"
 
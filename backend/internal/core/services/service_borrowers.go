@@ -0,0 +1,85 @@
+package services
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/hasher"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/mailer"
+)
+
+type BorrowerService struct {
+	repos  *repo.AllRepos
+	mailer *mailer.Mailer
+	conf   config.BorrowerConf
+}
+
+// Create creates a borrower. Borrowers registered from an active kiosk are self-registered
+// and, when verification is enabled, start out pending until they confirm their email
+// address and/or a staff member approves them.
+//
+// hbURL is the base URL of the Homebox instance and is used to build the confirmation link.
+func (svc *BorrowerService) Create(ctx Context, data repo.BorrowerCreate, hbURL string) (repo.BorrowerOut, error) {
+	data.SelfRegistered = ctx.IsKiosk && !ctx.IsKioskUnlocked
+
+	if !data.SelfRegistered {
+		return svc.repos.Borrowers.Create(ctx, ctx.GID, data)
+	}
+
+	var token hasher.Token
+	switch {
+	case svc.conf.RequireEmailVerification && svc.mailer != nil && svc.mailer.Ready():
+		token = hasher.GenerateToken()
+		data.VerificationStatus = borrower.VerificationStatusPendingEmail
+		data.VerificationToken = token.Hash
+		data.VerificationExpiry = time.Now().Add(svc.conf.VerificationExpiry)
+	case svc.conf.RequireEmailVerification:
+		// Without a configured mailer the confirmation email can never arrive, so fall back
+		// to staff approval rather than leaving the borrower stuck.
+		log.Warn().Msg("borrower email verification is enabled but the mailer is not configured, requiring approval instead")
+		data.VerificationStatus = borrower.VerificationStatusPendingApproval
+	case svc.conf.RequireApproval:
+		data.VerificationStatus = borrower.VerificationStatusPendingApproval
+	}
+
+	out, err := svc.repos.Borrowers.Create(ctx, ctx.GID, data)
+	if err != nil {
+		return repo.BorrowerOut{}, err
+	}
+
+	if data.VerificationStatus == borrower.VerificationStatusPendingEmail {
+		err = svc.sendVerificationEmail(out, token.Raw, hbURL)
+		if err != nil {
+			log.Err(err).Str("borrower_id", out.ID.String()).Msg("failed to send borrower verification email")
+		}
+	}
+
+	return out, nil
+}
+
+// VerifyEmail confirms the email address of the borrower that was sent the raw token.
+func (svc *BorrowerService) VerifyEmail(ctx Context, rawToken string) (repo.BorrowerOut, error) {
+	return svc.repos.Borrowers.VerifyEmail(ctx, hasher.HashToken(rawToken), svc.conf.RequireApproval)
+}
+
+func (svc *BorrowerService) sendVerificationEmail(b repo.BorrowerOut, rawToken, hbURL string) error {
+	verifyURL := hbURL + "/api/v1/borrowers/verify?token=" + url.QueryEscape(rawToken)
+
+	body, err := mailer.RenderBorrowerVerification(b.Name, verifyURL)
+	if err != nil {
+		return err
+	}
+
+	msg := mailer.NewMessageBuilder().
+		SetSubject("Confirm your email address").
+		SetTo(b.Name, b.Email).
+		SetFrom("Homebox", svc.mailer.From).
+		SetBody(body).
+		Build()
+
+	return svc.mailer.Send(msg)
+}
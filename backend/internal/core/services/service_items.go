@@ -27,12 +27,12 @@ type ItemService struct {
 
 func (svc *ItemService) Create(ctx Context, item repo.ItemCreate) (repo.ItemOut, error) {
 	if svc.autoIncrementAssetID {
-		highest, err := svc.repo.Items.GetHighestAssetID(ctx, ctx.GID)
+		next, err := svc.repo.Items.NextAssetID(ctx, ctx.GID, item.LabelIDs)
 		if err != nil {
 			return repo.ItemOut{}, err
 		}
 
-		item.AssetID = highest + 1
+		item.AssetID, item.AssetIDPrefix = next.ID, next.Prefix
 	}
 
 	return svc.repo.Items.Create(ctx, ctx.GID, item)
@@ -48,16 +48,19 @@ func (svc *ItemService) EnsureAssetID(ctx context.Context, gid uuid.UUID) (int,
 		return 0, err
 	}
 
-	highest, err := svc.repo.Items.GetHighestAssetID(ctx, gid)
-	if err != nil {
-		return 0, err
-	}
-
 	finished := 0
 	for _, item := range items {
-		highest++
+		labelIDs := make([]uuid.UUID, len(item.Labels))
+		for i, l := range item.Labels {
+			labelIDs[i] = l.ID
+		}
 
-		err = svc.repo.Items.SetAssetID(ctx, gid, item.ID, highest)
+		next, err := svc.repo.Items.NextAssetID(ctx, gid, labelIDs)
+		if err != nil {
+			return 0, err
+		}
+
+		err = svc.repo.Items.SetAssetID(ctx, gid, item.ID, next)
 		if err != nil {
 			return 0, err
 		}
@@ -100,7 +103,11 @@ func serializeLocation[T ~[]string](location T) string {
 //  1. If the item does not exist, it is created.
 //  2. If the item has a ImportRef and it exists it is skipped
 //  3. Locations and Labels are created if they do not exist.
+//  4. Identifiers already used by another item are skipped. The rows they are on are
+//     still imported and reported together in an error wrapping repo.ErrIdentifierExists.
 func (svc *ItemService) CsvImport(ctx context.Context, gid uuid.UUID, data io.Reader) (int, error) {
+	ctx = repo.WithAuditSource(ctx, repo.AuditSourceImport)
+
 	sheet := reporting.IOSheet{}
 
 	err := sheet.Read(data)
@@ -154,16 +161,14 @@ func (svc *ItemService) CsvImport(ctx context.Context, gid uuid.UUID, data io.Re
 	// ========================================
 	// Import items
 
-	// Asset ID Pre-Check
-	highestAID := repo.AssetID(-1)
-	if svc.autoIncrementAssetID {
-		highestAID, err = svc.repo.Items.GetHighestAssetID(ctx, gid)
-		if err != nil {
-			return 0, err
-		}
+	// Asset IDs are written in the group's format
+	formats, err := svc.repo.Items.AssetIDFormats(ctx, gid)
+	if err != nil {
+		return 0, err
 	}
 
 	finished := 0
+	var conflicts []error
 
 	for i := range sheet.Rows {
 		row := sheet.Rows[i]
@@ -243,12 +248,17 @@ func (svc *ItemService) CsvImport(ctx context.Context, gid uuid.UUID, data io.Re
 			}
 		}
 
-		var effAID repo.AssetID
-		if svc.autoIncrementAssetID && row.AssetID.Nil() {
-			effAID = highestAID + 1
-			highestAID++
-		} else {
-			effAID = row.AssetID
+		var effAID repo.AssetRef
+		if row.AssetID != "" {
+			effAID, err = formats.Parse(row.AssetID)
+			if err != nil {
+				return 0, fmt.Errorf("item %q: %w", row.Name, err)
+			}
+		} else if svc.autoIncrementAssetID {
+			effAID, err = svc.repo.Items.NextAssetID(ctx, gid, labelIds)
+			if err != nil {
+				return 0, err
+			}
 		}
 
 		// ========================================
@@ -260,9 +270,11 @@ func (svc *ItemService) CsvImport(ctx context.Context, gid uuid.UUID, data io.Re
 				ImportRef:   row.ImportRef,
 				Name:        row.Name,
 				Description: row.Description,
-				AssetID:     effAID,
+				AssetID:     effAID.ID,
 				LocationID:  locationID,
 				LabelIDs:    labelIds,
+
+				AssetIDPrefix: effAID.Prefix,
 			}
 
 			item, err = svc.repo.Items.Create(ctx, gid, newItem)
@@ -296,11 +308,14 @@ func (svc *ItemService) CsvImport(ctx context.Context, gid uuid.UUID, data io.Re
 
 			Name:        row.Name,
 			Description: row.Description,
-			AssetID:     effAID,
+			AssetID:     effAID.ID,
 			Insured:     row.Insured,
 			Quantity:    row.Quantity,
 			Archived:    row.Archived,
 
+			MinStock:        row.MinStock,
+			ReorderQuantity: row.ReorderQuantity,
+
 			PurchasePrice: row.PurchasePrice,
 			PurchaseFrom:  row.PurchaseFrom,
 			PurchaseTime:  row.PurchaseTime,
@@ -320,6 +335,8 @@ func (svc *ItemService) CsvImport(ctx context.Context, gid uuid.UUID, data io.Re
 
 			Notes:  row.Notes,
 			Fields: fields,
+
+			AssetIDPrefix: &effAID.Prefix,
 		}
 
 		item, err = svc.repo.Items.UpdateByGroup(ctx, gid, updateItem)
@@ -327,14 +344,45 @@ func (svc *ItemService) CsvImport(ctx context.Context, gid uuid.UUID, data io.Re
 			return 0, err
 		}
 
+		// Sheets without the column leave the identifiers of existing items alone
+		if _, ok := sheet.GetColumn("HB.identifiers"); ok {
+			err = svc.repo.Identifiers.SetForItem(ctx, gid, item.ID, row.Identifiers)
+			switch {
+			case errors.Is(err, repo.ErrIdentifierExists):
+				conflicts = append(conflicts, fmt.Errorf("row %d, item %q: %w", i+1, row.Name, err))
+			case err != nil:
+				return 0, fmt.Errorf("item %q: %w", row.Name, err)
+			}
+		}
+
 		finished++
 	}
 
-	return finished, nil
+	return finished, errors.Join(conflicts...)
+}
+
+// exportItems returns the items to export, either all of the group's items or, when
+// savedSearch is set, the items matched by that group-shared saved search.
+func (svc *ItemService) exportItems(ctx context.Context, gid, savedSearch uuid.UUID) ([]repo.ItemOut, error) {
+	if savedSearch == uuid.Nil {
+		return svc.repo.Items.GetAll(ctx, gid)
+	}
+
+	search, err := svc.repo.SavedSearches.GetShared(ctx, gid, savedSearch)
+	if err != nil {
+		return nil, err
+	}
+
+	q := search.Query
+	if err := q.ParseSearch(); err != nil {
+		return nil, err
+	}
+
+	return svc.repo.Items.GetAllByQuery(ctx, gid, q)
 }
 
-func (svc *ItemService) ExportCSV(ctx context.Context, gid uuid.UUID, hbURL string) ([][]string, error) {
-	items, err := svc.repo.Items.GetAll(ctx, gid)
+func (svc *ItemService) ExportCSV(ctx context.Context, gid uuid.UUID, hbURL string, savedSearch uuid.UUID) ([][]string, error) {
+	items, err := svc.exportItems(ctx, gid, savedSearch)
 	if err != nil {
 		return nil, err
 	}
@@ -349,8 +397,8 @@ func (svc *ItemService) ExportCSV(ctx context.Context, gid uuid.UUID, hbURL stri
 	return sheet.CSV()
 }
 
-func (svc *ItemService) ExportBillOfMaterialsCSV(ctx context.Context, gid uuid.UUID) ([]byte, error) {
-	items, err := svc.repo.Items.GetAll(ctx, gid)
+func (svc *ItemService) ExportBillOfMaterialsCSV(ctx context.Context, gid uuid.UUID, savedSearch uuid.UUID) ([]byte, error) {
+	items, err := svc.exportItems(ctx, gid, savedSearch)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+)
+
+func TestItemService_CsvImportIdentifierConflicts(t *testing.T) {
+	ctx := context.Background()
+	svc := &ItemService{repo: tRepos}
+
+	upc, spare := fk.Str(12), fk.Str(12)
+	csv := fmt.Sprintf("HB.name,HB.location,HB.identifiers\nFirst,loc,upc:%s\nSecond,loc,upc:%s; other:%s\n", upc, strings.ToUpper(upc), spare)
+
+	n, err := svc.CsvImport(ctx, tGroup.ID, strings.NewReader(csv))
+	require.ErrorIs(t, err, repo.ErrIdentifierExists)
+	assert.Contains(t, err.Error(), `row 2, item "Second"`)
+	assert.Equal(t, 2, n, "the row with the conflict is still imported")
+
+	matches, err := tRepos.Identifiers.Lookup(ctx, tGroup.ID, spare)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "Second", matches[0].Item.Name)
+
+	matches, err = tRepos.Identifiers.Lookup(ctx, tGroup.ID, upc)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "First", matches[0].Item.Name)
+}
@@ -0,0 +1,371 @@
+package services
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+)
+
+type KioskSyncActionType string
+
+const (
+	KioskSyncActionCheckout         KioskSyncActionType = "checkout"
+	KioskSyncActionReturn           KioskSyncActionType = "return"
+	KioskSyncActionRegisterBorrower KioskSyncActionType = "register_borrower"
+)
+
+type KioskSyncStatus string
+
+const (
+	// KioskSyncApplied means the action was applied by this request.
+	KioskSyncApplied KioskSyncStatus = "applied"
+	// KioskSyncDuplicate means the action was applied by an earlier request.
+	KioskSyncDuplicate KioskSyncStatus = "duplicate"
+	// KioskSyncConflict means the action no longer applies to the current state,
+	// e.g. the item was already returned or is out with someone else.
+	KioskSyncConflict KioskSyncStatus = "conflict"
+	// KioskSyncRejected means the action is invalid and will never be applied.
+	KioskSyncRejected KioskSyncStatus = "rejected"
+	// KioskSyncFailed means the action could not be applied right now and should be retried.
+	KioskSyncFailed KioskSyncStatus = "failed"
+)
+
+// Reasons reported with conflicting or rejected actions
+const (
+	KioskSyncReasonAlreadyReturned     = "already_returned"
+	KioskSyncReasonItemCheckedOut      = "item_checked_out"
+	KioskSyncReasonItemQuarantined     = "item_quarantined"
+	KioskSyncReasonItemNotLendable     = "item_not_lendable"
+	KioskSyncReasonOutsideLocation     = "outside_location"
+	KioskSyncReasonInProgress          = "in_progress"
+	KioskSyncReasonBorrowerNotVerified = "borrower_not_verified"
+	KioskSyncReasonUnknownBorrower     = "unknown_borrower"
+	KioskSyncReasonUnknownLoan         = "unknown_loan"
+	KioskSyncReasonNotFound            = "not_found"
+	KioskSyncReasonInvalid             = "invalid"
+)
+
+var errKioskSyncInvalid = errors.New("action is missing its payload")
+
+type (
+	// KioskSyncRequest is a batch of actions a kiosk queued while it was offline.
+	KioskSyncRequest struct {
+		Actions []KioskSyncAction `json:"actions" validate:"required,max=500,dive"`
+	}
+
+	KioskSyncAction struct {
+		// Key is generated by the kiosk and identifies the action across retries
+		Key             string              `json:"key"             validate:"required,max=255"`
+		Type            KioskSyncActionType `json:"type"            validate:"required,oneof=checkout return register_borrower"`
+		ClientTimestamp time.Time           `json:"clientTimestamp" validate:"required"`
+
+		Checkout *KioskSyncCheckout   `json:"checkout,omitempty"`
+		Return   *KioskSyncReturn     `json:"return,omitempty"`
+		Borrower *repo.BorrowerCreate `json:"borrower,omitempty"`
+	}
+
+	KioskSyncCheckout struct {
+		ItemID uuid.UUID `json:"itemId" validate:"required"`
+		// Either BorrowerID or BorrowerKey, the key of an earlier register_borrower action
+		BorrowerID  uuid.UUID `json:"borrowerId"`
+		BorrowerKey string    `json:"borrowerKey" validate:"max=255"`
+		DueAt       time.Time `json:"dueAt"       validate:"required"`
+		Notes       string    `json:"notes"       validate:"max=1000"`
+		Quantity    int       `json:"quantity"    validate:"min=0"`
+	}
+
+	KioskSyncReturn struct {
+		// One of LoanID, CheckoutKey (the key of an earlier checkout action) or ItemID
+		LoanID      uuid.UUID `json:"loanId"`
+		CheckoutKey string    `json:"checkoutKey" validate:"max=255"`
+		ItemID      uuid.UUID `json:"itemId"`
+		ReturnNotes string    `json:"returnNotes" validate:"max=1000"`
+	}
+
+	// KioskSyncResult is the outcome of a single action. Results are returned in the
+	// same order as the actions in the request.
+	KioskSyncResult struct {
+		Key      string          `json:"key"`
+		Status   KioskSyncStatus `json:"status"`
+		Reason   string          `json:"reason,omitempty"`
+		Message  string          `json:"message,omitempty"`
+		ResultID *uuid.UUID      `json:"resultId,omitempty"`
+	}
+)
+
+type KioskService struct {
+	repos     *repo.AllRepos
+	borrowers *BorrowerService
+}
+
+// LocationScope returns the IDs of the locations a kiosk bound to a location may work
+// with: the location itself and everything nested below it. Returns nil when the
+// request is not from a kiosk or the kiosk is not bound to a location.
+func (svc *KioskService) LocationScope(ctx Context) ([]uuid.UUID, error) {
+	if !ctx.IsKiosk || ctx.KioskLocationID == uuid.Nil {
+		return nil, nil
+	}
+
+	return svc.repos.Locations.SubtreeIDs(ctx, ctx.GID, ctx.KioskLocationID)
+}
+
+// ItemVisible reports whether the item is within the location scope of the kiosk, so
+// that endpoints allowed in kiosk mode can hide the items stored elsewhere. Every item of
+// the group is visible outside kiosk mode and to kiosks that are not bound to a location.
+func (svc *KioskService) ItemVisible(ctx Context, id uuid.UUID) (bool, error) {
+	scope, err := svc.LocationScope(ctx)
+	if err != nil || len(scope) == 0 {
+		return err == nil, err
+	}
+
+	return svc.repos.Items.InLocations(ctx, ctx.GID, id, scope)
+}
+
+// Sync applies a batch of actions queued by an offline kiosk. Actions are applied in
+// the order they happened on the kiosk and every idempotency key is applied at most once,
+// so a kiosk can safely resend its whole queue after a dropped connection.
+func (svc *KioskService) Sync(ctx Context, actions []KioskSyncAction, hbURL string) []KioskSyncResult {
+	order := make([]int, len(actions))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return actions[order[a]].ClientTimestamp.Before(actions[order[b]].ClientTimestamp)
+	})
+
+	results := make([]KioskSyncResult, len(actions))
+
+	scope, err := svc.LocationScope(ctx)
+	if err != nil {
+		for i := range actions {
+			results[i] = KioskSyncResult{Key: actions[i].Key}.failed(err)
+		}
+		return results
+	}
+
+	for _, i := range order {
+		results[i] = svc.apply(ctx, actions[i], scope, hbURL)
+	}
+
+	return results
+}
+
+func (svc *KioskService) apply(ctx Context, action KioskSyncAction, scope []uuid.UUID, hbURL string) KioskSyncResult {
+	result := KioskSyncResult{Key: action.Key}
+
+	reservation, reserved, err := svc.repos.KioskSync.Reserve(ctx, ctx.GID, repo.KioskSyncActionCreate{
+		Key:             action.Key,
+		Action:          kiosksyncaction.Action(action.Type),
+		ClientTimestamp: action.ClientTimestamp,
+	})
+	if err != nil {
+		return result.failed(err)
+	}
+
+	if !reserved {
+		if reservation.IsApplied() {
+			result.Status = KioskSyncDuplicate
+			result.ResultID = reservation.ResultID
+			return result
+		}
+
+		result.Status = KioskSyncConflict
+		result.Reason = KioskSyncReasonInProgress
+		return result
+	}
+
+	var id uuid.UUID
+	switch action.Type {
+	case KioskSyncActionCheckout:
+		id, result = svc.checkout(ctx, action, scope, result)
+	case KioskSyncActionReturn:
+		id, result = svc.checkin(ctx, action, scope, result)
+	case KioskSyncActionRegisterBorrower:
+		id, result = svc.registerBorrower(ctx, action, hbURL, result)
+	}
+
+	if result.Status != KioskSyncApplied {
+		err = svc.repos.KioskSync.Release(ctx, reservation.ID)
+		if err != nil {
+			log.Err(err).Str("key", action.Key).Msg("failed to release kiosk sync action")
+		}
+		return result
+	}
+
+	err = svc.repos.KioskSync.Complete(ctx, reservation.ID, id)
+	if err != nil {
+		// The action was applied, so report it as such; retrying it would apply it twice
+		log.Err(err).Str("key", action.Key).Msg("failed to record applied kiosk sync action")
+	}
+
+	result.ResultID = &id
+	return result
+}
+
+func (svc *KioskService) checkout(ctx Context, action KioskSyncAction, scope []uuid.UUID, result KioskSyncResult) (uuid.UUID, KioskSyncResult) {
+	data := action.Checkout
+	if data == nil {
+		return uuid.Nil, result.rejected(KioskSyncReasonInvalid, errKioskSyncInvalid)
+	}
+
+	borrowerID := data.BorrowerID
+	if data.BorrowerKey != "" {
+		registered, err := svc.repos.KioskSync.GetByKey(ctx, ctx.GID, data.BorrowerKey)
+		switch {
+		case ent.IsNotFound(err) || (err == nil && (!registered.IsApplied() || registered.ResultID == nil)):
+			return uuid.Nil, result.rejected(KioskSyncReasonUnknownBorrower, nil)
+		case err != nil:
+			return uuid.Nil, result.failed(err)
+		}
+		borrowerID = *registered.ResultID
+	}
+
+	if borrowerID == uuid.Nil {
+		return uuid.Nil, result.rejected(KioskSyncReasonUnknownBorrower, nil)
+	}
+
+	active, err := svc.repos.Loans.GetActiveLoanForItem(ctx, ctx.GID, data.ItemID)
+	if err != nil && !ent.IsNotSingular(err) {
+		return uuid.Nil, result.failed(err)
+	}
+	if active != nil || ent.IsNotSingular(err) {
+		result.Status = KioskSyncConflict
+		result.Reason = KioskSyncReasonItemCheckedOut
+		if active != nil {
+			result.ResultID = &active.ID
+		}
+		return uuid.Nil, result
+	}
+
+	loan, err := svc.repos.Loans.Create(ctx, ctx.GID, ctx.UID, repo.LoanCreate{
+		ItemID:        data.ItemID,
+		BorrowerID:    borrowerID,
+		DueAt:         data.DueAt,
+		Notes:         data.Notes,
+		Quantity:      data.Quantity,
+		CheckedOutAt:  action.ClientTimestamp,
+		KioskAction:   true,
+		LocationScope: scope,
+	})
+	switch {
+	case errors.Is(err, repo.ErrItemOutsideLocation):
+		return uuid.Nil, result.rejected(KioskSyncReasonOutsideLocation, err)
+	case errors.Is(err, repo.ErrItemQuarantined):
+		result.Status = KioskSyncConflict
+		result.Reason = KioskSyncReasonItemQuarantined
+		return uuid.Nil, result
+	case errors.Is(err, repo.ErrItemNotLendable):
+		result.Status = KioskSyncConflict
+		result.Reason = KioskSyncReasonItemNotLendable
+		return uuid.Nil, result
+	case errors.Is(err, repo.ErrBorrowerNotVerified):
+		return uuid.Nil, result.rejected(KioskSyncReasonBorrowerNotVerified, err)
+	case ent.IsNotFound(err) || ent.IsConstraintError(err):
+		return uuid.Nil, result.rejected(KioskSyncReasonNotFound, err)
+	case ent.IsValidationError(err):
+		return uuid.Nil, result.rejected(KioskSyncReasonInvalid, err)
+	case err != nil:
+		return uuid.Nil, result.failed(err)
+	}
+
+	result.Status = KioskSyncApplied
+	return loan.ID, result
+}
+
+func (svc *KioskService) checkin(ctx Context, action KioskSyncAction, scope []uuid.UUID, result KioskSyncResult) (uuid.UUID, KioskSyncResult) {
+	data := action.Return
+	if data == nil {
+		return uuid.Nil, result.rejected(KioskSyncReasonInvalid, errKioskSyncInvalid)
+	}
+
+	loanID := data.LoanID
+	switch {
+	case data.CheckoutKey != "":
+		checkout, err := svc.repos.KioskSync.GetByKey(ctx, ctx.GID, data.CheckoutKey)
+		switch {
+		case ent.IsNotFound(err) || (err == nil && (!checkout.IsApplied() || checkout.ResultID == nil)):
+			return uuid.Nil, result.rejected(KioskSyncReasonUnknownLoan, nil)
+		case err != nil:
+			return uuid.Nil, result.failed(err)
+		}
+		loanID = *checkout.ResultID
+	case loanID == uuid.Nil && data.ItemID != uuid.Nil:
+		active, err := svc.repos.Loans.GetActiveLoanForItem(ctx, ctx.GID, data.ItemID)
+		if err != nil {
+			return uuid.Nil, result.failed(err)
+		}
+		if active == nil {
+			result.Status = KioskSyncConflict
+			result.Reason = KioskSyncReasonAlreadyReturned
+			return uuid.Nil, result
+		}
+		loanID = active.ID
+	}
+
+	if loanID == uuid.Nil {
+		return uuid.Nil, result.rejected(KioskSyncReasonUnknownLoan, nil)
+	}
+
+	loan, err := svc.repos.Loans.Return(ctx, ctx.GID, ctx.UID, repo.LoanReturn{
+		ID:          loanID,
+		ReturnNotes: data.ReturnNotes,
+		ReturnedAt:  action.ClientTimestamp,
+		KioskAction: true,
+
+		ReturnLocationID: ctx.KioskLocationID,
+		LocationScope:    scope,
+	})
+	switch {
+	case errors.Is(err, repo.ErrLoanAlreadyReturned):
+		result.Status = KioskSyncConflict
+		result.Reason = KioskSyncReasonAlreadyReturned
+		result.ResultID = &loanID
+		return uuid.Nil, result
+	case ent.IsNotFound(err):
+		return uuid.Nil, result.rejected(KioskSyncReasonUnknownLoan, err)
+	case err != nil:
+		return uuid.Nil, result.failed(err)
+	}
+
+	result.Status = KioskSyncApplied
+	return loan.ID, result
+}
+
+func (svc *KioskService) registerBorrower(ctx Context, action KioskSyncAction, hbURL string, result KioskSyncResult) (uuid.UUID, KioskSyncResult) {
+	if action.Borrower == nil {
+		return uuid.Nil, result.rejected(KioskSyncReasonInvalid, errKioskSyncInvalid)
+	}
+
+	b, err := svc.borrowers.Create(ctx, *action.Borrower, hbURL)
+	switch {
+	case ent.IsValidationError(err) || ent.IsConstraintError(err):
+		return uuid.Nil, result.rejected(KioskSyncReasonInvalid, err)
+	case err != nil:
+		return uuid.Nil, result.failed(err)
+	}
+
+	result.Status = KioskSyncApplied
+	return b.ID, result
+}
+
+func (r KioskSyncResult) rejected(reason string, err error) KioskSyncResult {
+	r.Status = KioskSyncRejected
+	r.Reason = reason
+	if err != nil {
+		r.Message = err.Error()
+	}
+	return r
+}
+
+func (r KioskSyncResult) failed(err error) KioskSyncResult {
+	log.Err(err).Str("key", r.Key).Msg("failed to apply kiosk sync action")
+	r.Status = KioskSyncFailed
+	r.Message = "internal error"
+	return r
+}
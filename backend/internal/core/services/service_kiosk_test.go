@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+)
+
+func useSyncItem(t *testing.T) repo.ItemOut {
+	t.Helper()
+
+	loc, err := tRepos.Locations.Create(context.Background(), tGroup.ID, repo.LocationCreate{
+		Name: fk.Str(10),
+	})
+	require.NoError(t, err)
+
+	itm, err := tRepos.Items.Create(context.Background(), tGroup.ID, repo.ItemCreate{
+		Name:       fk.Str(10),
+		LocationID: loc.ID,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		_ = tRepos.Locations.DeleteByGroup(context.Background(), tGroup.ID, loc.ID)
+	})
+
+	return itm
+}
+
+func TestKioskService_Sync(t *testing.T) {
+	itm := useSyncItem(t)
+	now := time.Now()
+
+	actions := []KioskSyncAction{
+		{
+			// Sent first but happened last, so it must be applied after the checkout
+			Key:             fk.Str(16),
+			Type:            KioskSyncActionReturn,
+			ClientTimestamp: now.Add(-time.Minute),
+		},
+		{
+			Key:             fk.Str(16),
+			Type:            KioskSyncActionRegisterBorrower,
+			ClientTimestamp: now.Add(-3 * time.Minute),
+			Borrower: &repo.BorrowerCreate{
+				Name:  fk.Str(10),
+				Email: fk.Email(),
+			},
+		},
+	}
+	actions = append(actions, KioskSyncAction{
+		Key:             fk.Str(16),
+		Type:            KioskSyncActionCheckout,
+		ClientTimestamp: now.Add(-2 * time.Minute),
+		Checkout: &KioskSyncCheckout{
+			ItemID:      itm.ID,
+			BorrowerKey: actions[1].Key,
+			DueAt:       now.Add(24 * time.Hour),
+		},
+	})
+	actions[0].Return = &KioskSyncReturn{CheckoutKey: actions[2].Key}
+
+	results := tSvc.Kiosk.Sync(tCtx, actions, "")
+	require.Len(t, results, 3)
+
+	for i, r := range results {
+		assert.Equal(t, actions[i].Key, r.Key)
+		assert.Equal(t, KioskSyncApplied, r.Status, r.Message)
+		require.NotNil(t, r.ResultID)
+	}
+
+	loan, err := tRepos.Loans.GetOneByGroup(tCtx, tGroup.ID, *results[2].ResultID)
+	require.NoError(t, err)
+	assert.Equal(t, *results[1].ResultID, loan.BorrowerID)
+	assert.True(t, loan.KioskAction)
+	assert.WithinDuration(t, actions[2].ClientTimestamp, loan.CheckedOutAt, time.Second)
+	require.NotNil(t, loan.ReturnedAt)
+	assert.WithinDuration(t, actions[0].ClientTimestamp, *loan.ReturnedAt, time.Second)
+
+	// Replaying the whole queue applies nothing twice
+	replayed := tSvc.Kiosk.Sync(tCtx, actions, "")
+	for i, r := range replayed {
+		assert.Equal(t, KioskSyncDuplicate, r.Status)
+		assert.Equal(t, results[i].ResultID, r.ResultID)
+	}
+}
+
+func TestKioskService_SyncConflicts(t *testing.T) {
+	itm := useSyncItem(t)
+	now := time.Now()
+
+	first, err := tRepos.Borrowers.Create(tCtx, tGroup.ID, repo.BorrowerCreate{Name: fk.Str(10), Email: fk.Email()})
+	require.NoError(t, err)
+	second, err := tRepos.Borrowers.Create(tCtx, tGroup.ID, repo.BorrowerCreate{Name: fk.Str(10), Email: fk.Email()})
+	require.NoError(t, err)
+
+	// Checked out online to the first borrower while the kiosk was offline
+	loan, err := tRepos.Loans.Create(tCtx, tGroup.ID, tUser.ID, repo.LoanCreate{
+		ItemID:     itm.ID,
+		BorrowerID: first.ID,
+		DueAt:      now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	results := tSvc.Kiosk.Sync(tCtx, []KioskSyncAction{
+		{
+			Key:             fk.Str(16),
+			Type:            KioskSyncActionCheckout,
+			ClientTimestamp: now,
+			Checkout: &KioskSyncCheckout{
+				ItemID:     itm.ID,
+				BorrowerID: second.ID,
+				DueAt:      now.Add(time.Hour),
+			},
+		},
+		{
+			Key:             fk.Str(16),
+			Type:            KioskSyncActionCheckout,
+			ClientTimestamp: now,
+		},
+	}, "")
+
+	assert.Equal(t, KioskSyncConflict, results[0].Status)
+	assert.Equal(t, KioskSyncReasonItemCheckedOut, results[0].Reason)
+	assert.Equal(t, &loan.ID, results[0].ResultID)
+	assert.Equal(t, KioskSyncRejected, results[1].Status)
+	assert.Equal(t, KioskSyncReasonInvalid, results[1].Reason)
+
+	_, err = tRepos.Loans.Return(tCtx, tGroup.ID, tUser.ID, repo.LoanReturn{ID: loan.ID})
+	require.NoError(t, err)
+
+	ret := KioskSyncAction{
+		Key:             fk.Str(16),
+		Type:            KioskSyncActionReturn,
+		ClientTimestamp: now,
+		Return:          &KioskSyncReturn{LoanID: loan.ID},
+	}
+	results = tSvc.Kiosk.Sync(tCtx, []KioskSyncAction{ret}, "")
+	assert.Equal(t, KioskSyncConflict, results[0].Status)
+	assert.Equal(t, KioskSyncReasonAlreadyReturned, results[0].Reason)
+
+	// Conflicts are not recorded, so the key is still free
+	_, err = tRepos.KioskSync.GetByKey(tCtx, tGroup.ID, ret.Key)
+	require.Error(t, err)
+}
+
+func TestKioskService_SyncLocationScope(t *testing.T) {
+	itm := useSyncItem(t)
+	now := time.Now()
+
+	site, err := tRepos.Locations.Create(tCtx, tGroup.ID, repo.LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Locations.DeleteByGroup(context.Background(), tGroup.ID, site.ID)
+	})
+
+	b, err := tRepos.Borrowers.Create(tCtx, tGroup.ID, repo.BorrowerCreate{Name: fk.Str(10), Email: fk.Email()})
+	require.NoError(t, err)
+
+	kiosk := tCtx
+	kiosk.IsKiosk = true
+	kiosk.KioskLocationID = site.ID
+
+	scope, err := tSvc.Kiosk.LocationScope(kiosk)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{site.ID}, scope)
+
+	checkout := KioskSyncAction{
+		Key:             fk.Str(16),
+		Type:            KioskSyncActionCheckout,
+		ClientTimestamp: now,
+		Checkout: &KioskSyncCheckout{
+			ItemID:     itm.ID,
+			BorrowerID: b.ID,
+			DueAt:      now.Add(time.Hour),
+		},
+	}
+
+	results := tSvc.Kiosk.Sync(kiosk, []KioskSyncAction{checkout}, "")
+	assert.Equal(t, KioskSyncRejected, results[0].Status)
+	assert.Equal(t, KioskSyncReasonOutsideLocation, results[0].Reason)
+
+	// Checked out at the item's own site, returned at the bound kiosk
+	checkout.Key = fk.Str(16)
+	results = tSvc.Kiosk.Sync(tCtx, []KioskSyncAction{checkout}, "")
+	require.Equal(t, KioskSyncApplied, results[0].Status, results[0].Message)
+
+	results = tSvc.Kiosk.Sync(kiosk, []KioskSyncAction{{
+		Key:             fk.Str(16),
+		Type:            KioskSyncActionReturn,
+		ClientTimestamp: now.Add(time.Minute),
+		Return:          &KioskSyncReturn{ItemID: itm.ID},
+	}}, "")
+	require.Equal(t, KioskSyncApplied, results[0].Status, results[0].Message)
+
+	loan, err := tRepos.Loans.GetOneByGroup(tCtx, tGroup.ID, *results[0].ResultID)
+	require.NoError(t, err)
+	assert.True(t, loan.IsTransfer)
+	require.NotNil(t, loan.ReturnLocationID)
+	assert.Equal(t, site.ID, *loan.ReturnLocationID)
+}
+
+func TestKioskService_ItemVisible(t *testing.T) {
+	elsewhere := useSyncItem(t)
+
+	site, err := tRepos.Locations.Create(tCtx, tGroup.ID, repo.LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Locations.DeleteByGroup(context.Background(), tGroup.ID, site.ID)
+	})
+
+	here, err := tRepos.Items.Create(tCtx, tGroup.ID, repo.ItemCreate{Name: fk.Str(10), LocationID: site.ID})
+	require.NoError(t, err)
+
+	kiosk := tCtx
+	kiosk.IsKiosk = true
+	kiosk.KioskLocationID = site.ID
+
+	for _, tc := range []struct {
+		name string
+		ctx  Context
+		id   uuid.UUID
+		want bool
+	}{
+		{"outside kiosk mode", tCtx, elsewhere.ID, true},
+		{"at the kiosk's location", kiosk, here.ID, true},
+		{"stored elsewhere", kiosk, elsewhere.ID, false},
+		{"unknown item", kiosk, uuid.New(), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, err := tSvc.Kiosk.ItemVisible(tc.ctx, tc.id)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ok)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/labelmaker"
+)
+
+const (
+	// printJobAttempts is how often a job is tried before it fails
+	printJobAttempts = 3
+	// printRetryDelay is the wait before the second attempt, doubled for every further one
+	printRetryDelay = 30 * time.Second
+	// printPollInterval is how often the queue looks for jobs due for a retry
+	printPollInterval = 5 * time.Second
+	// printTimeout is how long a job may print before it is given up on, well short of
+	// printStaleAfter so that the job isn't requeued while it is still printing
+	printTimeout = 5 * time.Minute
+	// printStaleAfter is how long a job prints at most before it counts as interrupted
+	printStaleAfter = 10 * time.Minute
+)
+
+// PrintQueueService queues labels for the group's printers and prints them in the
+// background, so that a slow or offline printer doesn't hold up the request. Every
+// printer prints its jobs one after the other, next to the other printers. Failed
+// jobs are retried with a growing delay.
+type PrintQueueService struct {
+	repos   *repo.AllRepos
+	cfg     *config.Config
+	wake    chan struct{}
+	workers sync.WaitGroup
+}
+
+// Submit queues the labels for the printer.
+func (svc *PrintQueueService) Submit(ctx Context, printerID uuid.UUID, name string, job labelmaker.PrintJob) (repo.PrintJobOut, error) {
+	if len(job.Labels) == 0 {
+		return repo.PrintJobOut{}, fmt.Errorf("no labels to print")
+	}
+
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return repo.PrintJobOut{}, err
+	}
+
+	var createdBy *uuid.UUID
+	if ctx.UID != uuid.Nil {
+		createdBy = &ctx.UID
+	}
+
+	out, err := svc.repos.PrintJobs.Create(ctx, ctx.GID, repo.PrintJobCreate{
+		PrinterID:   printerID,
+		Name:        name,
+		Labels:      len(job.Labels),
+		Payload:     string(payload),
+		MaxAttempts: printJobAttempts,
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		return repo.PrintJobOut{}, err
+	}
+
+	svc.Notify()
+	return out, nil
+}
+
+// Notify wakes the queue up to look for jobs, e.g. after one was retried.
+func (svc *PrintQueueService) Notify() {
+	select {
+	case svc.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run prints the queued jobs until the context is done, and waits for the jobs that
+// are printing then.
+func (svc *PrintQueueService) Run(ctx context.Context) error {
+	ticker := time.NewTicker(printPollInterval)
+	defer ticker.Stop()
+	defer svc.workers.Wait()
+
+	for {
+		n, err := svc.repos.PrintJobs.RequeueStale(ctx, time.Now().Add(-printStaleAfter))
+		if err != nil {
+			log.Error().Err(err).Msg("failed to recover interrupted print jobs")
+		} else if n > 0 {
+			log.Info().Int("count", n).Msg("recovered interrupted print jobs")
+		}
+
+		svc.printDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-svc.wake:
+		}
+	}
+}
+
+// printDue starts printing the due jobs of the printers that are idle. The queue is
+// woken up when a job is printed, for the next job of its printer.
+func (svc *PrintQueueService) printDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := svc.repos.PrintJobs.Claim(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to claim print job")
+			return
+		}
+		if job == nil {
+			return
+		}
+
+		svc.workers.Add(1)
+		go func() {
+			defer svc.workers.Done()
+			svc.print(ctx, job)
+			svc.Notify()
+		}()
+	}
+}
+
+func (svc *PrintQueueService) print(ctx context.Context, job *repo.PrintJobWork) {
+	// The outcome is recorded even when the server is stopping
+	saveCtx := context.WithoutCancel(ctx)
+
+	var payload labelmaker.PrintJob
+	if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+		if err := svc.repos.PrintJobs.Fail(saveCtx, job, "invalid print job: "+err.Error(), nil); err != nil {
+			log.Error().Err(err).Msg("failed to update print job")
+		}
+		return
+	}
+
+	printer := labelmaker.Printer{
+		Name:     job.Printer.Name,
+		Kind:     labelmaker.PrinterKind(job.Printer.Kind),
+		Address:  job.Printer.Address,
+		Language: labelmaker.Format(job.Printer.Language),
+		DPI:      job.Printer.DPI,
+	}
+
+	printCtx, cancel := context.WithTimeout(ctx, printTimeout)
+	defer cancel()
+
+	err := printer.Print(printCtx, payload, svc.cfg)
+	if err == nil {
+		if err := svc.repos.PrintJobs.Done(saveCtx, job); err != nil {
+			log.Error().Err(err).Msg("failed to update print job")
+		}
+		return
+	}
+
+	var retryAt *time.Time
+	if job.Attempts < job.MaxAttempts {
+		t := time.Now().Add(printRetryDelay << (job.Attempts - 1))
+		retryAt = &t
+	}
+
+	log.Warn().
+		Err(err).
+		Str("printer", job.Printer.Name).
+		Int("attempt", job.Attempts).
+		Bool("retry", retryAt != nil).
+		Msg("failed to print job")
+
+	if err := svc.repos.PrintJobs.Fail(saveCtx, job, err.Error(), retryAt); err != nil {
+		log.Error().Err(err).Msg("failed to update print job")
+	}
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/labelmaker"
+)
+
+func TestPrintQueueService(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		b, _ := io.ReadAll(conn)
+		_ = conn.Close()
+		received <- string(b)
+	}()
+
+	printer, err := tRepos.Printers.Create(context.Background(), tGroup.ID, repo.PrinterCreate{
+		Name:    fk.Str(10),
+		Kind:    repo.PrinterKindRaw,
+		Address: ln.Addr().String(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Printers.Delete(context.Background(), tGroup.ID, printer.ID)
+	})
+
+	label := labelmaker.NewGenerateParams(526, 200, 32, 32, 32, "HB-0001", "Drill", "https://example.com/a/HB-0001", true, nil)
+	job := labelmaker.PrintJob{Labels: []labelmaker.GenerateParameters{label}}
+
+	queued, err := tSvc.PrintQueue.Submit(tCtx, printer.ID, "Drill", job)
+	require.NoError(t, err)
+	assert.Equal(t, "queued", queued.Status)
+	assert.Equal(t, tUser.ID, *queued.CreatedBy)
+
+	tSvc.PrintQueue.printDue(context.Background())
+	tSvc.PrintQueue.workers.Wait()
+
+	assert.True(t, strings.HasPrefix(<-received, "^XA"))
+
+	done, err := tRepos.PrintJobs.GetOne(context.Background(), tGroup.ID, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "done", done.Status)
+
+	// With the printer gone the job is retried later
+	require.NoError(t, ln.Close())
+
+	queued, err = tSvc.PrintQueue.Submit(tCtx, printer.ID, "Drill", job)
+	require.NoError(t, err)
+
+	tSvc.PrintQueue.printDue(context.Background())
+	tSvc.PrintQueue.workers.Wait()
+
+	failed, err := tRepos.PrintJobs.GetOne(context.Background(), tGroup.ID, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "queued", failed.Status)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Contains(t, failed.Error, "failed to connect")
+	assert.True(t, failed.RunAt.After(time.Now()))
+}
+
+func TestPrintQueueService_SlowPrinter(t *testing.T) {
+	// The IPP printer takes the job only once it is released
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/ipp")
+		_, _ = w.Write([]byte{0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x03})
+	}))
+	t.Cleanup(srv.Close)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		b, _ := io.ReadAll(conn)
+		_ = conn.Close()
+		received <- string(b)
+	}()
+
+	create := func(kind, address string) repo.PrinterOut {
+		p, err := tRepos.Printers.Create(context.Background(), tGroup.ID, repo.PrinterCreate{
+			Name:    fk.Str(10),
+			Kind:    kind,
+			Address: address,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = tRepos.Printers.Delete(context.Background(), tGroup.ID, p.ID)
+		})
+		return p
+	}
+
+	slow := create(repo.PrinterKindIPP, "ipp://"+srv.Listener.Addr().String()+"/printers/labels")
+	fast := create(repo.PrinterKindRaw, ln.Addr().String())
+
+	label := labelmaker.NewGenerateParams(526, 200, 32, 32, 32, "HB-0001", "Drill", "https://example.com/a/HB-0001", true, nil)
+	job := labelmaker.PrintJob{Labels: []labelmaker.GenerateParameters{label}}
+
+	first, err := tSvc.PrintQueue.Submit(tCtx, slow.ID, "Drill", job)
+	require.NoError(t, err)
+	second, err := tSvc.PrintQueue.Submit(tCtx, slow.ID, "Drill", job)
+	require.NoError(t, err)
+	other, err := tSvc.PrintQueue.Submit(tCtx, fast.ID, "Drill", job)
+	require.NoError(t, err)
+
+	tSvc.PrintQueue.printDue(context.Background())
+
+	// The other printer prints while the slow one is still busy with its first job
+	select {
+	case b := <-received:
+		assert.True(t, strings.HasPrefix(b, "^XA"))
+	case <-time.After(10 * time.Second):
+		t.Fatal("the job of the other printer wasn't printed")
+	}
+
+	status := func(id uuid.UUID) string {
+		out, err := tRepos.PrintJobs.GetOne(context.Background(), tGroup.ID, id)
+		require.NoError(t, err)
+		return out.Status
+	}
+
+	assert.Eventually(t, func() bool { return status(other.ID) == "done" }, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "printing", status(first.ID))
+	assert.Equal(t, "queued", status(second.ID), "a printer prints one job at a time")
+
+	close(release)
+	tSvc.PrintQueue.workers.Wait()
+	assert.Equal(t, "done", status(first.ID))
+
+	tSvc.PrintQueue.printDue(context.Background())
+	tSvc.PrintQueue.workers.Wait()
+	assert.Equal(t, "done", status(second.ID))
+}
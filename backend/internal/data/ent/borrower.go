@@ -39,6 +39,16 @@ type Borrower struct {
 	IsActive bool `json:"is_active,omitempty"`
 	// Whether borrower registered themselves via kiosk self-service
 	SelfRegistered bool `json:"self_registered,omitempty"`
+	// Verification state of self-registered borrowers (only verified borrowers can check out)
+	VerificationStatus borrower.VerificationStatus `json:"verification_status,omitempty"`
+	// Hash of the email confirmation token
+	VerificationToken *[]byte `json:"-"`
+	// When the email confirmation token expires
+	VerificationExpiresAt *time.Time `json:"verification_expires_at,omitempty"`
+	// When the borrower confirmed their email address
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// When a staff member approved the borrower
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BorrowerQuery when eager-loading is set.
 	Edges           BorrowerEdges `json:"edges"`
@@ -82,11 +92,13 @@ func (*Borrower) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case borrower.FieldVerificationToken:
+			values[i] = new([]byte)
 		case borrower.FieldIsActive, borrower.FieldSelfRegistered:
 			values[i] = new(sql.NullBool)
-		case borrower.FieldName, borrower.FieldEmail, borrower.FieldPhone, borrower.FieldOrganization, borrower.FieldStudentID, borrower.FieldNotes:
+		case borrower.FieldName, borrower.FieldEmail, borrower.FieldPhone, borrower.FieldOrganization, borrower.FieldStudentID, borrower.FieldNotes, borrower.FieldVerificationStatus:
 			values[i] = new(sql.NullString)
-		case borrower.FieldCreatedAt, borrower.FieldUpdatedAt:
+		case borrower.FieldCreatedAt, borrower.FieldUpdatedAt, borrower.FieldVerificationExpiresAt, borrower.FieldEmailVerifiedAt, borrower.FieldApprovedAt:
 			values[i] = new(sql.NullTime)
 		case borrower.FieldID:
 			values[i] = new(uuid.UUID)
@@ -173,6 +185,39 @@ func (_m *Borrower) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SelfRegistered = value.Bool
 			}
+		case borrower.FieldVerificationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_status", values[i])
+			} else if value.Valid {
+				_m.VerificationStatus = borrower.VerificationStatus(value.String)
+			}
+		case borrower.FieldVerificationToken:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_token", values[i])
+			} else if value != nil {
+				_m.VerificationToken = value
+			}
+		case borrower.FieldVerificationExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_expires_at", values[i])
+			} else if value.Valid {
+				_m.VerificationExpiresAt = new(time.Time)
+				*_m.VerificationExpiresAt = value.Time
+			}
+		case borrower.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerifiedAt = new(time.Time)
+				*_m.EmailVerifiedAt = value.Time
+			}
+		case borrower.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				_m.ApprovedAt = new(time.Time)
+				*_m.ApprovedAt = value.Time
+			}
 		case borrower.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field group_borrowers", values[i])
@@ -255,6 +300,26 @@ func (_m *Borrower) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("self_registered=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SelfRegistered))
+	builder.WriteString(", ")
+	builder.WriteString("verification_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VerificationStatus))
+	builder.WriteString(", ")
+	builder.WriteString("verification_token=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.VerificationExpiresAt; v != nil {
+		builder.WriteString("verification_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ApprovedAt; v != nil {
+		builder.WriteString("approved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package borrower
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -35,6 +36,16 @@ const (
 	FieldIsActive = "is_active"
 	// FieldSelfRegistered holds the string denoting the self_registered field in the database.
 	FieldSelfRegistered = "self_registered"
+	// FieldVerificationStatus holds the string denoting the verification_status field in the database.
+	FieldVerificationStatus = "verification_status"
+	// FieldVerificationToken holds the string denoting the verification_token field in the database.
+	FieldVerificationToken = "verification_token"
+	// FieldVerificationExpiresAt holds the string denoting the verification_expires_at field in the database.
+	FieldVerificationExpiresAt = "verification_expires_at"
+	// FieldEmailVerifiedAt holds the string denoting the email_verified_at field in the database.
+	FieldEmailVerifiedAt = "email_verified_at"
+	// FieldApprovedAt holds the string denoting the approved_at field in the database.
+	FieldApprovedAt = "approved_at"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeLoans holds the string denoting the loans edge name in mutations.
@@ -70,6 +81,11 @@ var Columns = []string{
 	FieldNotes,
 	FieldIsActive,
 	FieldSelfRegistered,
+	FieldVerificationStatus,
+	FieldVerificationToken,
+	FieldVerificationExpiresAt,
+	FieldEmailVerifiedAt,
+	FieldApprovedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "borrowers"
@@ -120,6 +136,34 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// VerificationStatus defines the type for the "verification_status" enum field.
+type VerificationStatus string
+
+// VerificationStatusVerified is the default value of the VerificationStatus enum.
+const DefaultVerificationStatus = VerificationStatusVerified
+
+// VerificationStatus values.
+const (
+	VerificationStatusVerified        VerificationStatus = "verified"
+	VerificationStatusPendingEmail    VerificationStatus = "pending_email"
+	VerificationStatusPendingApproval VerificationStatus = "pending_approval"
+	VerificationStatusRejected        VerificationStatus = "rejected"
+)
+
+func (vs VerificationStatus) String() string {
+	return string(vs)
+}
+
+// VerificationStatusValidator is a validator for the "verification_status" field enum values. It is called by the builders before save.
+func VerificationStatusValidator(vs VerificationStatus) error {
+	switch vs {
+	case VerificationStatusVerified, VerificationStatusPendingEmail, VerificationStatusPendingApproval, VerificationStatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("borrower: invalid enum value for verification_status field: %q", vs)
+	}
+}
+
 // OrderOption defines the ordering options for the Borrower queries.
 type OrderOption func(*sql.Selector)
 
@@ -178,6 +222,26 @@ func BySelfRegistered(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSelfRegistered, opts...).ToFunc()
 }
 
+// ByVerificationStatus orders the results by the verification_status field.
+func ByVerificationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationStatus, opts...).ToFunc()
+}
+
+// ByVerificationExpiresAt orders the results by the verification_expires_at field.
+func ByVerificationExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationExpiresAt, opts...).ToFunc()
+}
+
+// ByEmailVerifiedAt orders the results by the email_verified_at field.
+func ByEmailVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifiedAt, opts...).ToFunc()
+}
+
+// ByApprovedAt orders the results by the approved_at field.
+func ByApprovedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedAt, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
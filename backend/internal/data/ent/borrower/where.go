@@ -106,6 +106,26 @@ func SelfRegistered(v bool) predicate.Borrower {
 	return predicate.Borrower(sql.FieldEQ(FieldSelfRegistered, v))
 }
 
+// VerificationToken applies equality check predicate on the "verification_token" field. It's identical to VerificationTokenEQ.
+func VerificationToken(v []byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldVerificationToken, v))
+}
+
+// VerificationExpiresAt applies equality check predicate on the "verification_expires_at" field. It's identical to VerificationExpiresAtEQ.
+func VerificationExpiresAt(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldVerificationExpiresAt, v))
+}
+
+// EmailVerifiedAt applies equality check predicate on the "email_verified_at" field. It's identical to EmailVerifiedAtEQ.
+func EmailVerifiedAt(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldEmailVerifiedAt, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldApprovedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Borrower {
 	return predicate.Borrower(sql.FieldEQ(FieldCreatedAt, v))
@@ -636,6 +656,226 @@ func SelfRegisteredNEQ(v bool) predicate.Borrower {
 	return predicate.Borrower(sql.FieldNEQ(FieldSelfRegistered, v))
 }
 
+// VerificationStatusEQ applies the EQ predicate on the "verification_status" field.
+func VerificationStatusEQ(v VerificationStatus) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldVerificationStatus, v))
+}
+
+// VerificationStatusNEQ applies the NEQ predicate on the "verification_status" field.
+func VerificationStatusNEQ(v VerificationStatus) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNEQ(FieldVerificationStatus, v))
+}
+
+// VerificationStatusIn applies the In predicate on the "verification_status" field.
+func VerificationStatusIn(vs ...VerificationStatus) predicate.Borrower {
+	return predicate.Borrower(sql.FieldIn(FieldVerificationStatus, vs...))
+}
+
+// VerificationStatusNotIn applies the NotIn predicate on the "verification_status" field.
+func VerificationStatusNotIn(vs ...VerificationStatus) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotIn(FieldVerificationStatus, vs...))
+}
+
+// VerificationTokenEQ applies the EQ predicate on the "verification_token" field.
+func VerificationTokenEQ(v []byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldVerificationToken, v))
+}
+
+// VerificationTokenNEQ applies the NEQ predicate on the "verification_token" field.
+func VerificationTokenNEQ(v []byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNEQ(FieldVerificationToken, v))
+}
+
+// VerificationTokenIn applies the In predicate on the "verification_token" field.
+func VerificationTokenIn(vs ...[]byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldIn(FieldVerificationToken, vs...))
+}
+
+// VerificationTokenNotIn applies the NotIn predicate on the "verification_token" field.
+func VerificationTokenNotIn(vs ...[]byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotIn(FieldVerificationToken, vs...))
+}
+
+// VerificationTokenGT applies the GT predicate on the "verification_token" field.
+func VerificationTokenGT(v []byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldGT(FieldVerificationToken, v))
+}
+
+// VerificationTokenGTE applies the GTE predicate on the "verification_token" field.
+func VerificationTokenGTE(v []byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldGTE(FieldVerificationToken, v))
+}
+
+// VerificationTokenLT applies the LT predicate on the "verification_token" field.
+func VerificationTokenLT(v []byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldLT(FieldVerificationToken, v))
+}
+
+// VerificationTokenLTE applies the LTE predicate on the "verification_token" field.
+func VerificationTokenLTE(v []byte) predicate.Borrower {
+	return predicate.Borrower(sql.FieldLTE(FieldVerificationToken, v))
+}
+
+// VerificationTokenIsNil applies the IsNil predicate on the "verification_token" field.
+func VerificationTokenIsNil() predicate.Borrower {
+	return predicate.Borrower(sql.FieldIsNull(FieldVerificationToken))
+}
+
+// VerificationTokenNotNil applies the NotNil predicate on the "verification_token" field.
+func VerificationTokenNotNil() predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotNull(FieldVerificationToken))
+}
+
+// VerificationExpiresAtEQ applies the EQ predicate on the "verification_expires_at" field.
+func VerificationExpiresAtEQ(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtNEQ applies the NEQ predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNEQ(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtIn applies the In predicate on the "verification_expires_at" field.
+func VerificationExpiresAtIn(vs ...time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldIn(FieldVerificationExpiresAt, vs...))
+}
+
+// VerificationExpiresAtNotIn applies the NotIn predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNotIn(vs ...time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotIn(FieldVerificationExpiresAt, vs...))
+}
+
+// VerificationExpiresAtGT applies the GT predicate on the "verification_expires_at" field.
+func VerificationExpiresAtGT(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldGT(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtGTE applies the GTE predicate on the "verification_expires_at" field.
+func VerificationExpiresAtGTE(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldGTE(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtLT applies the LT predicate on the "verification_expires_at" field.
+func VerificationExpiresAtLT(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldLT(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtLTE applies the LTE predicate on the "verification_expires_at" field.
+func VerificationExpiresAtLTE(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldLTE(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtIsNil applies the IsNil predicate on the "verification_expires_at" field.
+func VerificationExpiresAtIsNil() predicate.Borrower {
+	return predicate.Borrower(sql.FieldIsNull(FieldVerificationExpiresAt))
+}
+
+// VerificationExpiresAtNotNil applies the NotNil predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNotNil() predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotNull(FieldVerificationExpiresAt))
+}
+
+// EmailVerifiedAtEQ applies the EQ predicate on the "email_verified_at" field.
+func EmailVerifiedAtEQ(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtNEQ applies the NEQ predicate on the "email_verified_at" field.
+func EmailVerifiedAtNEQ(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNEQ(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtIn applies the In predicate on the "email_verified_at" field.
+func EmailVerifiedAtIn(vs ...time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldIn(FieldEmailVerifiedAt, vs...))
+}
+
+// EmailVerifiedAtNotIn applies the NotIn predicate on the "email_verified_at" field.
+func EmailVerifiedAtNotIn(vs ...time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotIn(FieldEmailVerifiedAt, vs...))
+}
+
+// EmailVerifiedAtGT applies the GT predicate on the "email_verified_at" field.
+func EmailVerifiedAtGT(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldGT(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtGTE applies the GTE predicate on the "email_verified_at" field.
+func EmailVerifiedAtGTE(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldGTE(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtLT applies the LT predicate on the "email_verified_at" field.
+func EmailVerifiedAtLT(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldLT(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtLTE applies the LTE predicate on the "email_verified_at" field.
+func EmailVerifiedAtLTE(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldLTE(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtIsNil applies the IsNil predicate on the "email_verified_at" field.
+func EmailVerifiedAtIsNil() predicate.Borrower {
+	return predicate.Borrower(sql.FieldIsNull(FieldEmailVerifiedAt))
+}
+
+// EmailVerifiedAtNotNil applies the NotNil predicate on the "email_verified_at" field.
+func EmailVerifiedAtNotNil() predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotNull(FieldEmailVerifiedAt))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.Borrower {
+	return predicate.Borrower(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.Borrower {
+	return predicate.Borrower(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.Borrower {
+	return predicate.Borrower(sql.FieldNotNull(FieldApprovedAt))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.Borrower {
 	return predicate.Borrower(func(s *sql.Selector) {
@@ -147,6 +147,68 @@ func (_c *BorrowerCreate) SetNillableSelfRegistered(v *bool) *BorrowerCreate {
 	return _c
 }
 
+// SetVerificationStatus sets the "verification_status" field.
+func (_c *BorrowerCreate) SetVerificationStatus(v borrower.VerificationStatus) *BorrowerCreate {
+	_c.mutation.SetVerificationStatus(v)
+	return _c
+}
+
+// SetNillableVerificationStatus sets the "verification_status" field if the given value is not nil.
+func (_c *BorrowerCreate) SetNillableVerificationStatus(v *borrower.VerificationStatus) *BorrowerCreate {
+	if v != nil {
+		_c.SetVerificationStatus(*v)
+	}
+	return _c
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_c *BorrowerCreate) SetVerificationToken(v []byte) *BorrowerCreate {
+	_c.mutation.SetVerificationToken(v)
+	return _c
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (_c *BorrowerCreate) SetVerificationExpiresAt(v time.Time) *BorrowerCreate {
+	_c.mutation.SetVerificationExpiresAt(v)
+	return _c
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (_c *BorrowerCreate) SetNillableVerificationExpiresAt(v *time.Time) *BorrowerCreate {
+	if v != nil {
+		_c.SetVerificationExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_c *BorrowerCreate) SetEmailVerifiedAt(v time.Time) *BorrowerCreate {
+	_c.mutation.SetEmailVerifiedAt(v)
+	return _c
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_c *BorrowerCreate) SetNillableEmailVerifiedAt(v *time.Time) *BorrowerCreate {
+	if v != nil {
+		_c.SetEmailVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *BorrowerCreate) SetApprovedAt(v time.Time) *BorrowerCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *BorrowerCreate) SetNillableApprovedAt(v *time.Time) *BorrowerCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *BorrowerCreate) SetID(v uuid.UUID) *BorrowerCreate {
 	_c.mutation.SetID(v)
@@ -238,6 +300,10 @@ func (_c *BorrowerCreate) defaults() {
 		v := borrower.DefaultSelfRegistered
 		_c.mutation.SetSelfRegistered(v)
 	}
+	if _, ok := _c.mutation.VerificationStatus(); !ok {
+		v := borrower.DefaultVerificationStatus
+		_c.mutation.SetVerificationStatus(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := borrower.DefaultID()
 		_c.mutation.SetID(v)
@@ -294,6 +360,14 @@ func (_c *BorrowerCreate) check() error {
 	if _, ok := _c.mutation.SelfRegistered(); !ok {
 		return &ValidationError{Name: "self_registered", err: errors.New(`ent: missing required field "Borrower.self_registered"`)}
 	}
+	if _, ok := _c.mutation.VerificationStatus(); !ok {
+		return &ValidationError{Name: "verification_status", err: errors.New(`ent: missing required field "Borrower.verification_status"`)}
+	}
+	if v, ok := _c.mutation.VerificationStatus(); ok {
+		if err := borrower.VerificationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "verification_status", err: fmt.Errorf(`ent: validator failed for field "Borrower.verification_status": %w`, err)}
+		}
+	}
 	if len(_c.mutation.GroupIDs()) == 0 {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "Borrower.group"`)}
 	}
@@ -372,6 +446,26 @@ func (_c *BorrowerCreate) createSpec() (*Borrower, *sqlgraph.CreateSpec) {
 		_spec.SetField(borrower.FieldSelfRegistered, field.TypeBool, value)
 		_node.SelfRegistered = value
 	}
+	if value, ok := _c.mutation.VerificationStatus(); ok {
+		_spec.SetField(borrower.FieldVerificationStatus, field.TypeEnum, value)
+		_node.VerificationStatus = value
+	}
+	if value, ok := _c.mutation.VerificationToken(); ok {
+		_spec.SetField(borrower.FieldVerificationToken, field.TypeBytes, value)
+		_node.VerificationToken = &value
+	}
+	if value, ok := _c.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(borrower.FieldVerificationExpiresAt, field.TypeTime, value)
+		_node.VerificationExpiresAt = &value
+	}
+	if value, ok := _c.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(borrower.FieldEmailVerifiedAt, field.TypeTime, value)
+		_node.EmailVerifiedAt = &value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(borrower.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = &value
+	}
 	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -173,6 +173,92 @@ func (_u *BorrowerUpdate) SetNillableSelfRegistered(v *bool) *BorrowerUpdate {
 	return _u
 }
 
+// SetVerificationStatus sets the "verification_status" field.
+func (_u *BorrowerUpdate) SetVerificationStatus(v borrower.VerificationStatus) *BorrowerUpdate {
+	_u.mutation.SetVerificationStatus(v)
+	return _u
+}
+
+// SetNillableVerificationStatus sets the "verification_status" field if the given value is not nil.
+func (_u *BorrowerUpdate) SetNillableVerificationStatus(v *borrower.VerificationStatus) *BorrowerUpdate {
+	if v != nil {
+		_u.SetVerificationStatus(*v)
+	}
+	return _u
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_u *BorrowerUpdate) SetVerificationToken(v []byte) *BorrowerUpdate {
+	_u.mutation.SetVerificationToken(v)
+	return _u
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (_u *BorrowerUpdate) ClearVerificationToken() *BorrowerUpdate {
+	_u.mutation.ClearVerificationToken()
+	return _u
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (_u *BorrowerUpdate) SetVerificationExpiresAt(v time.Time) *BorrowerUpdate {
+	_u.mutation.SetVerificationExpiresAt(v)
+	return _u
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (_u *BorrowerUpdate) SetNillableVerificationExpiresAt(v *time.Time) *BorrowerUpdate {
+	if v != nil {
+		_u.SetVerificationExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (_u *BorrowerUpdate) ClearVerificationExpiresAt() *BorrowerUpdate {
+	_u.mutation.ClearVerificationExpiresAt()
+	return _u
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_u *BorrowerUpdate) SetEmailVerifiedAt(v time.Time) *BorrowerUpdate {
+	_u.mutation.SetEmailVerifiedAt(v)
+	return _u
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_u *BorrowerUpdate) SetNillableEmailVerifiedAt(v *time.Time) *BorrowerUpdate {
+	if v != nil {
+		_u.SetEmailVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (_u *BorrowerUpdate) ClearEmailVerifiedAt() *BorrowerUpdate {
+	_u.mutation.ClearEmailVerifiedAt()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *BorrowerUpdate) SetApprovedAt(v time.Time) *BorrowerUpdate {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *BorrowerUpdate) SetNillableApprovedAt(v *time.Time) *BorrowerUpdate {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *BorrowerUpdate) ClearApprovedAt() *BorrowerUpdate {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *BorrowerUpdate) SetGroupID(id uuid.UUID) *BorrowerUpdate {
 	_u.mutation.SetGroupID(id)
@@ -299,6 +385,11 @@ func (_u *BorrowerUpdate) check() error {
 			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "Borrower.notes": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.VerificationStatus(); ok {
+		if err := borrower.VerificationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "verification_status", err: fmt.Errorf(`ent: validator failed for field "Borrower.verification_status": %w`, err)}
+		}
+	}
 	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Borrower.group"`)
 	}
@@ -356,6 +447,33 @@ func (_u *BorrowerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.SelfRegistered(); ok {
 		_spec.SetField(borrower.FieldSelfRegistered, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.VerificationStatus(); ok {
+		_spec.SetField(borrower.FieldVerificationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VerificationToken(); ok {
+		_spec.SetField(borrower.FieldVerificationToken, field.TypeBytes, value)
+	}
+	if _u.mutation.VerificationTokenCleared() {
+		_spec.ClearField(borrower.FieldVerificationToken, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(borrower.FieldVerificationExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationExpiresAtCleared() {
+		_spec.ClearField(borrower.FieldVerificationExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(borrower.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(borrower.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(borrower.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(borrower.FieldApprovedAt, field.TypeTime)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -592,6 +710,92 @@ func (_u *BorrowerUpdateOne) SetNillableSelfRegistered(v *bool) *BorrowerUpdateO
 	return _u
 }
 
+// SetVerificationStatus sets the "verification_status" field.
+func (_u *BorrowerUpdateOne) SetVerificationStatus(v borrower.VerificationStatus) *BorrowerUpdateOne {
+	_u.mutation.SetVerificationStatus(v)
+	return _u
+}
+
+// SetNillableVerificationStatus sets the "verification_status" field if the given value is not nil.
+func (_u *BorrowerUpdateOne) SetNillableVerificationStatus(v *borrower.VerificationStatus) *BorrowerUpdateOne {
+	if v != nil {
+		_u.SetVerificationStatus(*v)
+	}
+	return _u
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_u *BorrowerUpdateOne) SetVerificationToken(v []byte) *BorrowerUpdateOne {
+	_u.mutation.SetVerificationToken(v)
+	return _u
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (_u *BorrowerUpdateOne) ClearVerificationToken() *BorrowerUpdateOne {
+	_u.mutation.ClearVerificationToken()
+	return _u
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (_u *BorrowerUpdateOne) SetVerificationExpiresAt(v time.Time) *BorrowerUpdateOne {
+	_u.mutation.SetVerificationExpiresAt(v)
+	return _u
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (_u *BorrowerUpdateOne) SetNillableVerificationExpiresAt(v *time.Time) *BorrowerUpdateOne {
+	if v != nil {
+		_u.SetVerificationExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (_u *BorrowerUpdateOne) ClearVerificationExpiresAt() *BorrowerUpdateOne {
+	_u.mutation.ClearVerificationExpiresAt()
+	return _u
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_u *BorrowerUpdateOne) SetEmailVerifiedAt(v time.Time) *BorrowerUpdateOne {
+	_u.mutation.SetEmailVerifiedAt(v)
+	return _u
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_u *BorrowerUpdateOne) SetNillableEmailVerifiedAt(v *time.Time) *BorrowerUpdateOne {
+	if v != nil {
+		_u.SetEmailVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (_u *BorrowerUpdateOne) ClearEmailVerifiedAt() *BorrowerUpdateOne {
+	_u.mutation.ClearEmailVerifiedAt()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *BorrowerUpdateOne) SetApprovedAt(v time.Time) *BorrowerUpdateOne {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *BorrowerUpdateOne) SetNillableApprovedAt(v *time.Time) *BorrowerUpdateOne {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *BorrowerUpdateOne) ClearApprovedAt() *BorrowerUpdateOne {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *BorrowerUpdateOne) SetGroupID(id uuid.UUID) *BorrowerUpdateOne {
 	_u.mutation.SetGroupID(id)
@@ -731,6 +935,11 @@ func (_u *BorrowerUpdateOne) check() error {
 			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "Borrower.notes": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.VerificationStatus(); ok {
+		if err := borrower.VerificationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "verification_status", err: fmt.Errorf(`ent: validator failed for field "Borrower.verification_status": %w`, err)}
+		}
+	}
 	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Borrower.group"`)
 	}
@@ -805,6 +1014,33 @@ func (_u *BorrowerUpdateOne) sqlSave(ctx context.Context) (_node *Borrower, err
 	if value, ok := _u.mutation.SelfRegistered(); ok {
 		_spec.SetField(borrower.FieldSelfRegistered, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.VerificationStatus(); ok {
+		_spec.SetField(borrower.FieldVerificationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VerificationToken(); ok {
+		_spec.SetField(borrower.FieldVerificationToken, field.TypeBytes, value)
+	}
+	if _u.mutation.VerificationTokenCleared() {
+		_spec.ClearField(borrower.FieldVerificationToken, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(borrower.FieldVerificationExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationExpiresAtCleared() {
+		_spec.ClearField(borrower.FieldVerificationExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(borrower.FieldEmailVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailVerifiedAtCleared() {
+		_spec.ClearField(borrower.FieldEmailVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(borrower.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(borrower.FieldApprovedAt, field.TypeTime)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
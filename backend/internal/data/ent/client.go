@@ -17,20 +17,32 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/authroles"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/authtokens"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/fielddefinition"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemtemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/templatefield"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
@@ -42,12 +54,16 @@ type Client struct {
 	Schema *migrate.Schema
 	// Attachment is the client for interacting with the Attachment builders.
 	Attachment *AttachmentClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
 	// AuthRoles is the client for interacting with the AuthRoles builders.
 	AuthRoles *AuthRolesClient
 	// AuthTokens is the client for interacting with the AuthTokens builders.
 	AuthTokens *AuthTokensClient
 	// Borrower is the client for interacting with the Borrower builders.
 	Borrower *BorrowerClient
+	// FieldDefinition is the client for interacting with the FieldDefinition builders.
+	FieldDefinition *FieldDefinitionClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
 	// GroupInvitationToken is the client for interacting with the GroupInvitationToken builders.
@@ -56,12 +72,20 @@ type Client struct {
 	Item *ItemClient
 	// ItemField is the client for interacting with the ItemField builders.
 	ItemField *ItemFieldClient
+	// ItemIdentifier is the client for interacting with the ItemIdentifier builders.
+	ItemIdentifier *ItemIdentifierClient
+	// ItemStatusChange is the client for interacting with the ItemStatusChange builders.
+	ItemStatusChange *ItemStatusChangeClient
 	// ItemTemplate is the client for interacting with the ItemTemplate builders.
 	ItemTemplate *ItemTemplateClient
 	// KioskSession is the client for interacting with the KioskSession builders.
 	KioskSession *KioskSessionClient
+	// KioskSyncAction is the client for interacting with the KioskSyncAction builders.
+	KioskSyncAction *KioskSyncActionClient
 	// Label is the client for interacting with the Label builders.
 	Label *LabelClient
+	// LabelTemplate is the client for interacting with the LabelTemplate builders.
+	LabelTemplate *LabelTemplateClient
 	// Loan is the client for interacting with the Loan builders.
 	Loan *LoanClient
 	// Location is the client for interacting with the Location builders.
@@ -70,6 +94,18 @@ type Client struct {
 	MaintenanceEntry *MaintenanceEntryClient
 	// Notifier is the client for interacting with the Notifier builders.
 	Notifier *NotifierClient
+	// PrintJob is the client for interacting with the PrintJob builders.
+	PrintJob *PrintJobClient
+	// Printer is the client for interacting with the Printer builders.
+	Printer *PrinterClient
+	// SavedSearch is the client for interacting with the SavedSearch builders.
+	SavedSearch *SavedSearchClient
+	// StockMovement is the client for interacting with the StockMovement builders.
+	StockMovement *StockMovementClient
+	// StocktakeEntry is the client for interacting with the StocktakeEntry builders.
+	StocktakeEntry *StocktakeEntryClient
+	// StocktakeSession is the client for interacting with the StocktakeSession builders.
+	StocktakeSession *StocktakeSessionClient
 	// TemplateField is the client for interacting with the TemplateField builders.
 	TemplateField *TemplateFieldClient
 	// User is the client for interacting with the User builders.
@@ -86,20 +122,32 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Attachment = NewAttachmentClient(c.config)
+	c.AuditEntry = NewAuditEntryClient(c.config)
 	c.AuthRoles = NewAuthRolesClient(c.config)
 	c.AuthTokens = NewAuthTokensClient(c.config)
 	c.Borrower = NewBorrowerClient(c.config)
+	c.FieldDefinition = NewFieldDefinitionClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.GroupInvitationToken = NewGroupInvitationTokenClient(c.config)
 	c.Item = NewItemClient(c.config)
 	c.ItemField = NewItemFieldClient(c.config)
+	c.ItemIdentifier = NewItemIdentifierClient(c.config)
+	c.ItemStatusChange = NewItemStatusChangeClient(c.config)
 	c.ItemTemplate = NewItemTemplateClient(c.config)
 	c.KioskSession = NewKioskSessionClient(c.config)
+	c.KioskSyncAction = NewKioskSyncActionClient(c.config)
 	c.Label = NewLabelClient(c.config)
+	c.LabelTemplate = NewLabelTemplateClient(c.config)
 	c.Loan = NewLoanClient(c.config)
 	c.Location = NewLocationClient(c.config)
 	c.MaintenanceEntry = NewMaintenanceEntryClient(c.config)
 	c.Notifier = NewNotifierClient(c.config)
+	c.PrintJob = NewPrintJobClient(c.config)
+	c.Printer = NewPrinterClient(c.config)
+	c.SavedSearch = NewSavedSearchClient(c.config)
+	c.StockMovement = NewStockMovementClient(c.config)
+	c.StocktakeEntry = NewStocktakeEntryClient(c.config)
+	c.StocktakeSession = NewStocktakeSessionClient(c.config)
 	c.TemplateField = NewTemplateFieldClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -195,20 +243,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                  ctx,
 		config:               cfg,
 		Attachment:           NewAttachmentClient(cfg),
+		AuditEntry:           NewAuditEntryClient(cfg),
 		AuthRoles:            NewAuthRolesClient(cfg),
 		AuthTokens:           NewAuthTokensClient(cfg),
 		Borrower:             NewBorrowerClient(cfg),
+		FieldDefinition:      NewFieldDefinitionClient(cfg),
 		Group:                NewGroupClient(cfg),
 		GroupInvitationToken: NewGroupInvitationTokenClient(cfg),
 		Item:                 NewItemClient(cfg),
 		ItemField:            NewItemFieldClient(cfg),
+		ItemIdentifier:       NewItemIdentifierClient(cfg),
+		ItemStatusChange:     NewItemStatusChangeClient(cfg),
 		ItemTemplate:         NewItemTemplateClient(cfg),
 		KioskSession:         NewKioskSessionClient(cfg),
+		KioskSyncAction:      NewKioskSyncActionClient(cfg),
 		Label:                NewLabelClient(cfg),
+		LabelTemplate:        NewLabelTemplateClient(cfg),
 		Loan:                 NewLoanClient(cfg),
 		Location:             NewLocationClient(cfg),
 		MaintenanceEntry:     NewMaintenanceEntryClient(cfg),
 		Notifier:             NewNotifierClient(cfg),
+		PrintJob:             NewPrintJobClient(cfg),
+		Printer:              NewPrinterClient(cfg),
+		SavedSearch:          NewSavedSearchClient(cfg),
+		StockMovement:        NewStockMovementClient(cfg),
+		StocktakeEntry:       NewStocktakeEntryClient(cfg),
+		StocktakeSession:     NewStocktakeSessionClient(cfg),
 		TemplateField:        NewTemplateFieldClient(cfg),
 		User:                 NewUserClient(cfg),
 	}, nil
@@ -231,20 +291,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                  ctx,
 		config:               cfg,
 		Attachment:           NewAttachmentClient(cfg),
+		AuditEntry:           NewAuditEntryClient(cfg),
 		AuthRoles:            NewAuthRolesClient(cfg),
 		AuthTokens:           NewAuthTokensClient(cfg),
 		Borrower:             NewBorrowerClient(cfg),
+		FieldDefinition:      NewFieldDefinitionClient(cfg),
 		Group:                NewGroupClient(cfg),
 		GroupInvitationToken: NewGroupInvitationTokenClient(cfg),
 		Item:                 NewItemClient(cfg),
 		ItemField:            NewItemFieldClient(cfg),
+		ItemIdentifier:       NewItemIdentifierClient(cfg),
+		ItemStatusChange:     NewItemStatusChangeClient(cfg),
 		ItemTemplate:         NewItemTemplateClient(cfg),
 		KioskSession:         NewKioskSessionClient(cfg),
+		KioskSyncAction:      NewKioskSyncActionClient(cfg),
 		Label:                NewLabelClient(cfg),
+		LabelTemplate:        NewLabelTemplateClient(cfg),
 		Loan:                 NewLoanClient(cfg),
 		Location:             NewLocationClient(cfg),
 		MaintenanceEntry:     NewMaintenanceEntryClient(cfg),
 		Notifier:             NewNotifierClient(cfg),
+		PrintJob:             NewPrintJobClient(cfg),
+		Printer:              NewPrinterClient(cfg),
+		SavedSearch:          NewSavedSearchClient(cfg),
+		StockMovement:        NewStockMovementClient(cfg),
+		StocktakeEntry:       NewStocktakeEntryClient(cfg),
+		StocktakeSession:     NewStocktakeSessionClient(cfg),
 		TemplateField:        NewTemplateFieldClient(cfg),
 		User:                 NewUserClient(cfg),
 	}, nil
@@ -276,10 +348,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Attachment, c.AuthRoles, c.AuthTokens, c.Borrower, c.Group,
-		c.GroupInvitationToken, c.Item, c.ItemField, c.ItemTemplate, c.KioskSession,
-		c.Label, c.Loan, c.Location, c.MaintenanceEntry, c.Notifier, c.TemplateField,
-		c.User,
+		c.Attachment, c.AuditEntry, c.AuthRoles, c.AuthTokens, c.Borrower,
+		c.FieldDefinition, c.Group, c.GroupInvitationToken, c.Item, c.ItemField,
+		c.ItemIdentifier, c.ItemStatusChange, c.ItemTemplate, c.KioskSession,
+		c.KioskSyncAction, c.Label, c.LabelTemplate, c.Loan, c.Location,
+		c.MaintenanceEntry, c.Notifier, c.PrintJob, c.Printer, c.SavedSearch,
+		c.StockMovement, c.StocktakeEntry, c.StocktakeSession, c.TemplateField, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -289,10 +363,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Attachment, c.AuthRoles, c.AuthTokens, c.Borrower, c.Group,
-		c.GroupInvitationToken, c.Item, c.ItemField, c.ItemTemplate, c.KioskSession,
-		c.Label, c.Loan, c.Location, c.MaintenanceEntry, c.Notifier, c.TemplateField,
-		c.User,
+		c.Attachment, c.AuditEntry, c.AuthRoles, c.AuthTokens, c.Borrower,
+		c.FieldDefinition, c.Group, c.GroupInvitationToken, c.Item, c.ItemField,
+		c.ItemIdentifier, c.ItemStatusChange, c.ItemTemplate, c.KioskSession,
+		c.KioskSyncAction, c.Label, c.LabelTemplate, c.Loan, c.Location,
+		c.MaintenanceEntry, c.Notifier, c.PrintJob, c.Printer, c.SavedSearch,
+		c.StockMovement, c.StocktakeEntry, c.StocktakeSession, c.TemplateField, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -303,12 +379,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AttachmentMutation:
 		return c.Attachment.mutate(ctx, m)
+	case *AuditEntryMutation:
+		return c.AuditEntry.mutate(ctx, m)
 	case *AuthRolesMutation:
 		return c.AuthRoles.mutate(ctx, m)
 	case *AuthTokensMutation:
 		return c.AuthTokens.mutate(ctx, m)
 	case *BorrowerMutation:
 		return c.Borrower.mutate(ctx, m)
+	case *FieldDefinitionMutation:
+		return c.FieldDefinition.mutate(ctx, m)
 	case *GroupMutation:
 		return c.Group.mutate(ctx, m)
 	case *GroupInvitationTokenMutation:
@@ -317,12 +397,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Item.mutate(ctx, m)
 	case *ItemFieldMutation:
 		return c.ItemField.mutate(ctx, m)
+	case *ItemIdentifierMutation:
+		return c.ItemIdentifier.mutate(ctx, m)
+	case *ItemStatusChangeMutation:
+		return c.ItemStatusChange.mutate(ctx, m)
 	case *ItemTemplateMutation:
 		return c.ItemTemplate.mutate(ctx, m)
 	case *KioskSessionMutation:
 		return c.KioskSession.mutate(ctx, m)
+	case *KioskSyncActionMutation:
+		return c.KioskSyncAction.mutate(ctx, m)
 	case *LabelMutation:
 		return c.Label.mutate(ctx, m)
+	case *LabelTemplateMutation:
+		return c.LabelTemplate.mutate(ctx, m)
 	case *LoanMutation:
 		return c.Loan.mutate(ctx, m)
 	case *LocationMutation:
@@ -331,6 +419,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.MaintenanceEntry.mutate(ctx, m)
 	case *NotifierMutation:
 		return c.Notifier.mutate(ctx, m)
+	case *PrintJobMutation:
+		return c.PrintJob.mutate(ctx, m)
+	case *PrinterMutation:
+		return c.Printer.mutate(ctx, m)
+	case *SavedSearchMutation:
+		return c.SavedSearch.mutate(ctx, m)
+	case *StockMovementMutation:
+		return c.StockMovement.mutate(ctx, m)
+	case *StocktakeEntryMutation:
+		return c.StocktakeEntry.mutate(ctx, m)
+	case *StocktakeSessionMutation:
+		return c.StocktakeSession.mutate(ctx, m)
 	case *TemplateFieldMutation:
 		return c.TemplateField.mutate(ctx, m)
 	case *UserMutation:
@@ -505,6 +605,155 @@ func (c *AttachmentClient) mutate(ctx context.Context, m *AttachmentMutation) (V
 	}
 }
 
+// AuditEntryClient is a client for the AuditEntry schema.
+type AuditEntryClient struct {
+	config
+}
+
+// NewAuditEntryClient returns a client for the AuditEntry from the given config.
+func NewAuditEntryClient(c config) *AuditEntryClient {
+	return &AuditEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditentry.Hooks(f(g(h())))`.
+func (c *AuditEntryClient) Use(hooks ...Hook) {
+	c.hooks.AuditEntry = append(c.hooks.AuditEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditentry.Intercept(f(g(h())))`.
+func (c *AuditEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEntry = append(c.inters.AuditEntry, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEntry entity.
+func (c *AuditEntryClient) Create() *AuditEntryCreate {
+	mutation := newAuditEntryMutation(c.config, OpCreate)
+	return &AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEntry entities.
+func (c *AuditEntryClient) CreateBulk(builders ...*AuditEntryCreate) *AuditEntryCreateBulk {
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEntryClient) MapCreateBulk(slice any, setFunc func(*AuditEntryCreate, int)) *AuditEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEntryCreateBulk{err: fmt.Errorf("calling to AuditEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEntry.
+func (c *AuditEntryClient) Update() *AuditEntryUpdate {
+	mutation := newAuditEntryMutation(c.config, OpUpdate)
+	return &AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEntryClient) UpdateOne(_m *AuditEntry) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntry(_m))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEntryClient) UpdateOneID(id uuid.UUID) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntryID(id))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEntry.
+func (c *AuditEntryClient) Delete() *AuditEntryDelete {
+	mutation := newAuditEntryMutation(c.config, OpDelete)
+	return &AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEntryClient) DeleteOne(_m *AuditEntry) *AuditEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEntryClient) DeleteOneID(id uuid.UUID) *AuditEntryDeleteOne {
+	builder := c.Delete().Where(auditentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEntry.
+func (c *AuditEntryClient) Query() *AuditEntryQuery {
+	return &AuditEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEntry entity by its id.
+func (c *AuditEntryClient) Get(ctx context.Context, id uuid.UUID) (*AuditEntry, error) {
+	return c.Query().Where(auditentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEntryClient) GetX(ctx context.Context, id uuid.UUID) *AuditEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a AuditEntry.
+func (c *AuditEntryClient) QueryGroup(_m *AuditEntry) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(auditentry.Table, auditentry.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, auditentry.GroupTable, auditentry.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEntryClient) Hooks() []Hook {
+	return c.hooks.AuditEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEntryClient) Interceptors() []Interceptor {
+	return c.inters.AuditEntry
+}
+
+func (c *AuditEntryClient) mutate(ctx context.Context, m *AuditEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEntry mutation op: %q", m.Op())
+	}
+}
+
 // AuthRolesClient is a client for the AuthRoles schema.
 type AuthRolesClient struct {
 	config
@@ -984,6 +1233,155 @@ func (c *BorrowerClient) mutate(ctx context.Context, m *BorrowerMutation) (Value
 	}
 }
 
+// FieldDefinitionClient is a client for the FieldDefinition schema.
+type FieldDefinitionClient struct {
+	config
+}
+
+// NewFieldDefinitionClient returns a client for the FieldDefinition from the given config.
+func NewFieldDefinitionClient(c config) *FieldDefinitionClient {
+	return &FieldDefinitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fielddefinition.Hooks(f(g(h())))`.
+func (c *FieldDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.FieldDefinition = append(c.hooks.FieldDefinition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fielddefinition.Intercept(f(g(h())))`.
+func (c *FieldDefinitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FieldDefinition = append(c.inters.FieldDefinition, interceptors...)
+}
+
+// Create returns a builder for creating a FieldDefinition entity.
+func (c *FieldDefinitionClient) Create() *FieldDefinitionCreate {
+	mutation := newFieldDefinitionMutation(c.config, OpCreate)
+	return &FieldDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FieldDefinition entities.
+func (c *FieldDefinitionClient) CreateBulk(builders ...*FieldDefinitionCreate) *FieldDefinitionCreateBulk {
+	return &FieldDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FieldDefinitionClient) MapCreateBulk(slice any, setFunc func(*FieldDefinitionCreate, int)) *FieldDefinitionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FieldDefinitionCreateBulk{err: fmt.Errorf("calling to FieldDefinitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FieldDefinitionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FieldDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FieldDefinition.
+func (c *FieldDefinitionClient) Update() *FieldDefinitionUpdate {
+	mutation := newFieldDefinitionMutation(c.config, OpUpdate)
+	return &FieldDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FieldDefinitionClient) UpdateOne(_m *FieldDefinition) *FieldDefinitionUpdateOne {
+	mutation := newFieldDefinitionMutation(c.config, OpUpdateOne, withFieldDefinition(_m))
+	return &FieldDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FieldDefinitionClient) UpdateOneID(id uuid.UUID) *FieldDefinitionUpdateOne {
+	mutation := newFieldDefinitionMutation(c.config, OpUpdateOne, withFieldDefinitionID(id))
+	return &FieldDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FieldDefinition.
+func (c *FieldDefinitionClient) Delete() *FieldDefinitionDelete {
+	mutation := newFieldDefinitionMutation(c.config, OpDelete)
+	return &FieldDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FieldDefinitionClient) DeleteOne(_m *FieldDefinition) *FieldDefinitionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FieldDefinitionClient) DeleteOneID(id uuid.UUID) *FieldDefinitionDeleteOne {
+	builder := c.Delete().Where(fielddefinition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FieldDefinitionDeleteOne{builder}
+}
+
+// Query returns a query builder for FieldDefinition.
+func (c *FieldDefinitionClient) Query() *FieldDefinitionQuery {
+	return &FieldDefinitionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFieldDefinition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FieldDefinition entity by its id.
+func (c *FieldDefinitionClient) Get(ctx context.Context, id uuid.UUID) (*FieldDefinition, error) {
+	return c.Query().Where(fielddefinition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FieldDefinitionClient) GetX(ctx context.Context, id uuid.UUID) *FieldDefinition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a FieldDefinition.
+func (c *FieldDefinitionClient) QueryGroup(_m *FieldDefinition) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fielddefinition.Table, fielddefinition.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fielddefinition.GroupTable, fielddefinition.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FieldDefinitionClient) Hooks() []Hook {
+	return c.hooks.FieldDefinition
+}
+
+// Interceptors returns the client interceptors.
+func (c *FieldDefinitionClient) Interceptors() []Interceptor {
+	return c.inters.FieldDefinition
+}
+
+func (c *FieldDefinitionClient) mutate(ctx context.Context, m *FieldDefinitionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FieldDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FieldDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FieldDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FieldDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FieldDefinition mutation op: %q", m.Op())
+	}
+}
+
 // GroupClient is a client for the Group schema.
 type GroupClient struct {
 	config
@@ -1236,48 +1634,224 @@ func (c *GroupClient) QueryLoans(_m *Group) *LoanQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *GroupClient) Hooks() []Hook {
-	return c.hooks.Group
+// QueryKioskSyncActions queries the kiosk_sync_actions edge of a Group.
+func (c *GroupClient) QueryKioskSyncActions(_m *Group) *KioskSyncActionQuery {
+	query := (&KioskSyncActionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(kiosksyncaction.Table, kiosksyncaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.KioskSyncActionsTable, group.KioskSyncActionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *GroupClient) Interceptors() []Interceptor {
-	return c.inters.Group
+// QuerySavedSearches queries the saved_searches edge of a Group.
+func (c *GroupClient) QuerySavedSearches(_m *Group) *SavedSearchQuery {
+	query := (&SavedSearchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.SavedSearchesTable, group.SavedSearchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
+// QueryAuditEntries queries the audit_entries edge of a Group.
+func (c *GroupClient) QueryAuditEntries(_m *Group) *AuditEntryQuery {
+	query := (&AuditEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(auditentry.Table, auditentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.AuditEntriesTable, group.AuditEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
+	return query
 }
 
-// GroupInvitationTokenClient is a client for the GroupInvitationToken schema.
-type GroupInvitationTokenClient struct {
-	config
+// QueryFieldDefinitions queries the field_definitions edge of a Group.
+func (c *GroupClient) QueryFieldDefinitions(_m *Group) *FieldDefinitionQuery {
+	query := (&FieldDefinitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(fielddefinition.Table, fielddefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.FieldDefinitionsTable, group.FieldDefinitionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// NewGroupInvitationTokenClient returns a client for the GroupInvitationToken from the given config.
-func NewGroupInvitationTokenClient(c config) *GroupInvitationTokenClient {
-	return &GroupInvitationTokenClient{config: c}
+// QueryStockMovements queries the stock_movements edge of a Group.
+func (c *GroupClient) QueryStockMovements(_m *Group) *StockMovementQuery {
+	query := (&StockMovementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.StockMovementsTable, group.StockMovementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `groupinvitationtoken.Hooks(f(g(h())))`.
-func (c *GroupInvitationTokenClient) Use(hooks ...Hook) {
-	c.hooks.GroupInvitationToken = append(c.hooks.GroupInvitationToken, hooks...)
+// QueryItemIdentifiers queries the item_identifiers edge of a Group.
+func (c *GroupClient) QueryItemIdentifiers(_m *Group) *ItemIdentifierQuery {
+	query := (&ItemIdentifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(itemidentifier.Table, itemidentifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ItemIdentifiersTable, group.ItemIdentifiersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Intercept adds a list of query interceptors to the interceptors stack.
+// QueryItemStatusChanges queries the item_status_changes edge of a Group.
+func (c *GroupClient) QueryItemStatusChanges(_m *Group) *ItemStatusChangeQuery {
+	query := (&ItemStatusChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(itemstatuschange.Table, itemstatuschange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ItemStatusChangesTable, group.ItemStatusChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStocktakeSessions queries the stocktake_sessions edge of a Group.
+func (c *GroupClient) QueryStocktakeSessions(_m *Group) *StocktakeSessionQuery {
+	query := (&StocktakeSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(stocktakesession.Table, stocktakesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.StocktakeSessionsTable, group.StocktakeSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLabelTemplates queries the label_templates edge of a Group.
+func (c *GroupClient) QueryLabelTemplates(_m *Group) *LabelTemplateQuery {
+	query := (&LabelTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(labeltemplate.Table, labeltemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.LabelTemplatesTable, group.LabelTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrinters queries the printers edge of a Group.
+func (c *GroupClient) QueryPrinters(_m *Group) *PrinterQuery {
+	query := (&PrinterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(printer.Table, printer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.PrintersTable, group.PrintersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrintJobs queries the print_jobs edge of a Group.
+func (c *GroupClient) QueryPrintJobs(_m *Group) *PrintJobQuery {
+	query := (&PrintJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(printjob.Table, printjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.PrintJobsTable, group.PrintJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupClient) Hooks() []Hook {
+	return c.hooks.Group
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
+func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
+	}
+}
+
+// GroupInvitationTokenClient is a client for the GroupInvitationToken schema.
+type GroupInvitationTokenClient struct {
+	config
+}
+
+// NewGroupInvitationTokenClient returns a client for the GroupInvitationToken from the given config.
+func NewGroupInvitationTokenClient(c config) *GroupInvitationTokenClient {
+	return &GroupInvitationTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupinvitationtoken.Hooks(f(g(h())))`.
+func (c *GroupInvitationTokenClient) Use(hooks ...Hook) {
+	c.hooks.GroupInvitationToken = append(c.hooks.GroupInvitationToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
 // A call to `Intercept(f, g, h)` equals to `groupinvitationtoken.Intercept(f(g(h())))`.
 func (c *GroupInvitationTokenClient) Intercept(interceptors ...Interceptor) {
 	c.inters.GroupInvitationToken = append(c.inters.GroupInvitationToken, interceptors...)
@@ -1646,6 +2220,70 @@ func (c *ItemClient) QueryAttachments(_m *Item) *AttachmentQuery {
 	return query
 }
 
+// QueryStockMovements queries the stock_movements edge of a Item.
+func (c *ItemClient) QueryStockMovements(_m *Item) *StockMovementQuery {
+	query := (&StockMovementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.StockMovementsTable, item.StockMovementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentifiers queries the identifiers edge of a Item.
+func (c *ItemClient) QueryIdentifiers(_m *Item) *ItemIdentifierQuery {
+	query := (&ItemIdentifierClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(itemidentifier.Table, itemidentifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.IdentifiersTable, item.IdentifiersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatusChanges queries the status_changes edge of a Item.
+func (c *ItemClient) QueryStatusChanges(_m *Item) *ItemStatusChangeQuery {
+	query := (&ItemStatusChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(itemstatuschange.Table, itemstatuschange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.StatusChangesTable, item.StatusChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStocktakeEntries queries the stocktake_entries edge of a Item.
+func (c *ItemClient) QueryStocktakeEntries(_m *Item) *StocktakeEntryQuery {
+	query := (&StocktakeEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(stocktakeentry.Table, stocktakeentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.StocktakeEntriesTable, item.StocktakeEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryLoans queries the loans edge of a Item.
 func (c *ItemClient) QueryLoans(_m *Item) *LoanQuery {
 	query := (&LoanClient{config: c.config}).Query()
@@ -1836,107 +2474,107 @@ func (c *ItemFieldClient) mutate(ctx context.Context, m *ItemFieldMutation) (Val
 	}
 }
 
-// ItemTemplateClient is a client for the ItemTemplate schema.
-type ItemTemplateClient struct {
+// ItemIdentifierClient is a client for the ItemIdentifier schema.
+type ItemIdentifierClient struct {
 	config
 }
 
-// NewItemTemplateClient returns a client for the ItemTemplate from the given config.
-func NewItemTemplateClient(c config) *ItemTemplateClient {
-	return &ItemTemplateClient{config: c}
+// NewItemIdentifierClient returns a client for the ItemIdentifier from the given config.
+func NewItemIdentifierClient(c config) *ItemIdentifierClient {
+	return &ItemIdentifierClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `itemtemplate.Hooks(f(g(h())))`.
-func (c *ItemTemplateClient) Use(hooks ...Hook) {
-	c.hooks.ItemTemplate = append(c.hooks.ItemTemplate, hooks...)
+// A call to `Use(f, g, h)` equals to `itemidentifier.Hooks(f(g(h())))`.
+func (c *ItemIdentifierClient) Use(hooks ...Hook) {
+	c.hooks.ItemIdentifier = append(c.hooks.ItemIdentifier, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `itemtemplate.Intercept(f(g(h())))`.
-func (c *ItemTemplateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ItemTemplate = append(c.inters.ItemTemplate, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `itemidentifier.Intercept(f(g(h())))`.
+func (c *ItemIdentifierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemIdentifier = append(c.inters.ItemIdentifier, interceptors...)
 }
 
-// Create returns a builder for creating a ItemTemplate entity.
-func (c *ItemTemplateClient) Create() *ItemTemplateCreate {
-	mutation := newItemTemplateMutation(c.config, OpCreate)
-	return &ItemTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ItemIdentifier entity.
+func (c *ItemIdentifierClient) Create() *ItemIdentifierCreate {
+	mutation := newItemIdentifierMutation(c.config, OpCreate)
+	return &ItemIdentifierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ItemTemplate entities.
-func (c *ItemTemplateClient) CreateBulk(builders ...*ItemTemplateCreate) *ItemTemplateCreateBulk {
-	return &ItemTemplateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ItemIdentifier entities.
+func (c *ItemIdentifierClient) CreateBulk(builders ...*ItemIdentifierCreate) *ItemIdentifierCreateBulk {
+	return &ItemIdentifierCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ItemTemplateClient) MapCreateBulk(slice any, setFunc func(*ItemTemplateCreate, int)) *ItemTemplateCreateBulk {
+func (c *ItemIdentifierClient) MapCreateBulk(slice any, setFunc func(*ItemIdentifierCreate, int)) *ItemIdentifierCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ItemTemplateCreateBulk{err: fmt.Errorf("calling to ItemTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ItemIdentifierCreateBulk{err: fmt.Errorf("calling to ItemIdentifierClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ItemTemplateCreate, rv.Len())
+	builders := make([]*ItemIdentifierCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ItemTemplateCreateBulk{config: c.config, builders: builders}
+	return &ItemIdentifierCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ItemTemplate.
-func (c *ItemTemplateClient) Update() *ItemTemplateUpdate {
-	mutation := newItemTemplateMutation(c.config, OpUpdate)
-	return &ItemTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ItemIdentifier.
+func (c *ItemIdentifierClient) Update() *ItemIdentifierUpdate {
+	mutation := newItemIdentifierMutation(c.config, OpUpdate)
+	return &ItemIdentifierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ItemTemplateClient) UpdateOne(_m *ItemTemplate) *ItemTemplateUpdateOne {
-	mutation := newItemTemplateMutation(c.config, OpUpdateOne, withItemTemplate(_m))
-	return &ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ItemIdentifierClient) UpdateOne(_m *ItemIdentifier) *ItemIdentifierUpdateOne {
+	mutation := newItemIdentifierMutation(c.config, OpUpdateOne, withItemIdentifier(_m))
+	return &ItemIdentifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ItemTemplateClient) UpdateOneID(id uuid.UUID) *ItemTemplateUpdateOne {
-	mutation := newItemTemplateMutation(c.config, OpUpdateOne, withItemTemplateID(id))
-	return &ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ItemIdentifierClient) UpdateOneID(id uuid.UUID) *ItemIdentifierUpdateOne {
+	mutation := newItemIdentifierMutation(c.config, OpUpdateOne, withItemIdentifierID(id))
+	return &ItemIdentifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ItemTemplate.
-func (c *ItemTemplateClient) Delete() *ItemTemplateDelete {
-	mutation := newItemTemplateMutation(c.config, OpDelete)
-	return &ItemTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ItemIdentifier.
+func (c *ItemIdentifierClient) Delete() *ItemIdentifierDelete {
+	mutation := newItemIdentifierMutation(c.config, OpDelete)
+	return &ItemIdentifierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ItemTemplateClient) DeleteOne(_m *ItemTemplate) *ItemTemplateDeleteOne {
+func (c *ItemIdentifierClient) DeleteOne(_m *ItemIdentifier) *ItemIdentifierDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ItemTemplateClient) DeleteOneID(id uuid.UUID) *ItemTemplateDeleteOne {
-	builder := c.Delete().Where(itemtemplate.ID(id))
+func (c *ItemIdentifierClient) DeleteOneID(id uuid.UUID) *ItemIdentifierDeleteOne {
+	builder := c.Delete().Where(itemidentifier.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ItemTemplateDeleteOne{builder}
+	return &ItemIdentifierDeleteOne{builder}
 }
 
-// Query returns a query builder for ItemTemplate.
-func (c *ItemTemplateClient) Query() *ItemTemplateQuery {
-	return &ItemTemplateQuery{
+// Query returns a query builder for ItemIdentifier.
+func (c *ItemIdentifierClient) Query() *ItemIdentifierQuery {
+	return &ItemIdentifierQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeItemTemplate},
+		ctx:    &QueryContext{Type: TypeItemIdentifier},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ItemTemplate entity by its id.
-func (c *ItemTemplateClient) Get(ctx context.Context, id uuid.UUID) (*ItemTemplate, error) {
-	return c.Query().Where(itemtemplate.ID(id)).Only(ctx)
+// Get returns a ItemIdentifier entity by its id.
+func (c *ItemIdentifierClient) Get(ctx context.Context, id uuid.UUID) (*ItemIdentifier, error) {
+	return c.Query().Where(itemidentifier.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ItemTemplateClient) GetX(ctx context.Context, id uuid.UUID) *ItemTemplate {
+func (c *ItemIdentifierClient) GetX(ctx context.Context, id uuid.UUID) *ItemIdentifier {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1944,31 +2582,15 @@ func (c *ItemTemplateClient) GetX(ctx context.Context, id uuid.UUID) *ItemTempla
 	return obj
 }
 
-// QueryGroup queries the group edge of a ItemTemplate.
-func (c *ItemTemplateClient) QueryGroup(_m *ItemTemplate) *GroupQuery {
+// QueryGroup queries the group edge of a ItemIdentifier.
+func (c *ItemIdentifierClient) QueryGroup(_m *ItemIdentifier) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(itemtemplate.Table, itemtemplate.FieldID, id),
+			sqlgraph.From(itemidentifier.Table, itemidentifier.FieldID, id),
 			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, itemtemplate.GroupTable, itemtemplate.GroupColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFields queries the fields edge of a ItemTemplate.
-func (c *ItemTemplateClient) QueryFields(_m *ItemTemplate) *TemplateFieldQuery {
-	query := (&TemplateFieldClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(itemtemplate.Table, itemtemplate.FieldID, id),
-			sqlgraph.To(templatefield.Table, templatefield.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, itemtemplate.FieldsTable, itemtemplate.FieldsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemidentifier.GroupTable, itemidentifier.GroupColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1976,15 +2598,15 @@ func (c *ItemTemplateClient) QueryFields(_m *ItemTemplate) *TemplateFieldQuery {
 	return query
 }
 
-// QueryLocation queries the location edge of a ItemTemplate.
-func (c *ItemTemplateClient) QueryLocation(_m *ItemTemplate) *LocationQuery {
-	query := (&LocationClient{config: c.config}).Query()
+// QueryItem queries the item edge of a ItemIdentifier.
+func (c *ItemIdentifierClient) QueryItem(_m *ItemIdentifier) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(itemtemplate.Table, itemtemplate.FieldID, id),
-			sqlgraph.To(location.Table, location.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, itemtemplate.LocationTable, itemtemplate.LocationColumn),
+			sqlgraph.From(itemidentifier.Table, itemidentifier.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemidentifier.ItemTable, itemidentifier.ItemColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1993,88 +2615,434 @@ func (c *ItemTemplateClient) QueryLocation(_m *ItemTemplate) *LocationQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ItemTemplateClient) Hooks() []Hook {
-	return c.hooks.ItemTemplate
+func (c *ItemIdentifierClient) Hooks() []Hook {
+	return c.hooks.ItemIdentifier
 }
 
 // Interceptors returns the client interceptors.
-func (c *ItemTemplateClient) Interceptors() []Interceptor {
-	return c.inters.ItemTemplate
+func (c *ItemIdentifierClient) Interceptors() []Interceptor {
+	return c.inters.ItemIdentifier
 }
 
-func (c *ItemTemplateClient) mutate(ctx context.Context, m *ItemTemplateMutation) (Value, error) {
+func (c *ItemIdentifierClient) mutate(ctx context.Context, m *ItemIdentifierMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ItemTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemIdentifierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ItemTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemIdentifierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemIdentifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ItemTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ItemIdentifierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ItemTemplate mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ItemIdentifier mutation op: %q", m.Op())
 	}
 }
 
-// KioskSessionClient is a client for the KioskSession schema.
-type KioskSessionClient struct {
+// ItemStatusChangeClient is a client for the ItemStatusChange schema.
+type ItemStatusChangeClient struct {
 	config
 }
 
-// NewKioskSessionClient returns a client for the KioskSession from the given config.
-func NewKioskSessionClient(c config) *KioskSessionClient {
-	return &KioskSessionClient{config: c}
+// NewItemStatusChangeClient returns a client for the ItemStatusChange from the given config.
+func NewItemStatusChangeClient(c config) *ItemStatusChangeClient {
+	return &ItemStatusChangeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `kiosksession.Hooks(f(g(h())))`.
-func (c *KioskSessionClient) Use(hooks ...Hook) {
-	c.hooks.KioskSession = append(c.hooks.KioskSession, hooks...)
+// A call to `Use(f, g, h)` equals to `itemstatuschange.Hooks(f(g(h())))`.
+func (c *ItemStatusChangeClient) Use(hooks ...Hook) {
+	c.hooks.ItemStatusChange = append(c.hooks.ItemStatusChange, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `kiosksession.Intercept(f(g(h())))`.
-func (c *KioskSessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.KioskSession = append(c.inters.KioskSession, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `itemstatuschange.Intercept(f(g(h())))`.
+func (c *ItemStatusChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemStatusChange = append(c.inters.ItemStatusChange, interceptors...)
 }
 
-// Create returns a builder for creating a KioskSession entity.
-func (c *KioskSessionClient) Create() *KioskSessionCreate {
-	mutation := newKioskSessionMutation(c.config, OpCreate)
-	return &KioskSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ItemStatusChange entity.
+func (c *ItemStatusChangeClient) Create() *ItemStatusChangeCreate {
+	mutation := newItemStatusChangeMutation(c.config, OpCreate)
+	return &ItemStatusChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of KioskSession entities.
-func (c *KioskSessionClient) CreateBulk(builders ...*KioskSessionCreate) *KioskSessionCreateBulk {
-	return &KioskSessionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ItemStatusChange entities.
+func (c *ItemStatusChangeClient) CreateBulk(builders ...*ItemStatusChangeCreate) *ItemStatusChangeCreateBulk {
+	return &ItemStatusChangeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *KioskSessionClient) MapCreateBulk(slice any, setFunc func(*KioskSessionCreate, int)) *KioskSessionCreateBulk {
+func (c *ItemStatusChangeClient) MapCreateBulk(slice any, setFunc func(*ItemStatusChangeCreate, int)) *ItemStatusChangeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &KioskSessionCreateBulk{err: fmt.Errorf("calling to KioskSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ItemStatusChangeCreateBulk{err: fmt.Errorf("calling to ItemStatusChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*KioskSessionCreate, rv.Len())
+	builders := make([]*ItemStatusChangeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &KioskSessionCreateBulk{config: c.config, builders: builders}
+	return &ItemStatusChangeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for KioskSession.
-func (c *KioskSessionClient) Update() *KioskSessionUpdate {
-	mutation := newKioskSessionMutation(c.config, OpUpdate)
-	return &KioskSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ItemStatusChange.
+func (c *ItemStatusChangeClient) Update() *ItemStatusChangeUpdate {
+	mutation := newItemStatusChangeMutation(c.config, OpUpdate)
+	return &ItemStatusChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *KioskSessionClient) UpdateOne(_m *KioskSession) *KioskSessionUpdateOne {
-	mutation := newKioskSessionMutation(c.config, OpUpdateOne, withKioskSession(_m))
-	return &KioskSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ItemStatusChangeClient) UpdateOne(_m *ItemStatusChange) *ItemStatusChangeUpdateOne {
+	mutation := newItemStatusChangeMutation(c.config, OpUpdateOne, withItemStatusChange(_m))
+	return &ItemStatusChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemStatusChangeClient) UpdateOneID(id uuid.UUID) *ItemStatusChangeUpdateOne {
+	mutation := newItemStatusChangeMutation(c.config, OpUpdateOne, withItemStatusChangeID(id))
+	return &ItemStatusChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemStatusChange.
+func (c *ItemStatusChangeClient) Delete() *ItemStatusChangeDelete {
+	mutation := newItemStatusChangeMutation(c.config, OpDelete)
+	return &ItemStatusChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemStatusChangeClient) DeleteOne(_m *ItemStatusChange) *ItemStatusChangeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemStatusChangeClient) DeleteOneID(id uuid.UUID) *ItemStatusChangeDeleteOne {
+	builder := c.Delete().Where(itemstatuschange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemStatusChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemStatusChange.
+func (c *ItemStatusChangeClient) Query() *ItemStatusChangeQuery {
+	return &ItemStatusChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemStatusChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemStatusChange entity by its id.
+func (c *ItemStatusChangeClient) Get(ctx context.Context, id uuid.UUID) (*ItemStatusChange, error) {
+	return c.Query().Where(itemstatuschange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemStatusChangeClient) GetX(ctx context.Context, id uuid.UUID) *ItemStatusChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a ItemStatusChange.
+func (c *ItemStatusChangeClient) QueryGroup(_m *ItemStatusChange) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemstatuschange.Table, itemstatuschange.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemstatuschange.GroupTable, itemstatuschange.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItem queries the item edge of a ItemStatusChange.
+func (c *ItemStatusChangeClient) QueryItem(_m *ItemStatusChange) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemstatuschange.Table, itemstatuschange.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemstatuschange.ItemTable, itemstatuschange.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemStatusChangeClient) Hooks() []Hook {
+	return c.hooks.ItemStatusChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemStatusChangeClient) Interceptors() []Interceptor {
+	return c.inters.ItemStatusChange
+}
+
+func (c *ItemStatusChangeClient) mutate(ctx context.Context, m *ItemStatusChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemStatusChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemStatusChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemStatusChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemStatusChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemStatusChange mutation op: %q", m.Op())
+	}
+}
+
+// ItemTemplateClient is a client for the ItemTemplate schema.
+type ItemTemplateClient struct {
+	config
+}
+
+// NewItemTemplateClient returns a client for the ItemTemplate from the given config.
+func NewItemTemplateClient(c config) *ItemTemplateClient {
+	return &ItemTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemtemplate.Hooks(f(g(h())))`.
+func (c *ItemTemplateClient) Use(hooks ...Hook) {
+	c.hooks.ItemTemplate = append(c.hooks.ItemTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemtemplate.Intercept(f(g(h())))`.
+func (c *ItemTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemTemplate = append(c.inters.ItemTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a ItemTemplate entity.
+func (c *ItemTemplateClient) Create() *ItemTemplateCreate {
+	mutation := newItemTemplateMutation(c.config, OpCreate)
+	return &ItemTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemTemplate entities.
+func (c *ItemTemplateClient) CreateBulk(builders ...*ItemTemplateCreate) *ItemTemplateCreateBulk {
+	return &ItemTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemTemplateClient) MapCreateBulk(slice any, setFunc func(*ItemTemplateCreate, int)) *ItemTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemTemplateCreateBulk{err: fmt.Errorf("calling to ItemTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemTemplate.
+func (c *ItemTemplateClient) Update() *ItemTemplateUpdate {
+	mutation := newItemTemplateMutation(c.config, OpUpdate)
+	return &ItemTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemTemplateClient) UpdateOne(_m *ItemTemplate) *ItemTemplateUpdateOne {
+	mutation := newItemTemplateMutation(c.config, OpUpdateOne, withItemTemplate(_m))
+	return &ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemTemplateClient) UpdateOneID(id uuid.UUID) *ItemTemplateUpdateOne {
+	mutation := newItemTemplateMutation(c.config, OpUpdateOne, withItemTemplateID(id))
+	return &ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemTemplate.
+func (c *ItemTemplateClient) Delete() *ItemTemplateDelete {
+	mutation := newItemTemplateMutation(c.config, OpDelete)
+	return &ItemTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemTemplateClient) DeleteOne(_m *ItemTemplate) *ItemTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemTemplateClient) DeleteOneID(id uuid.UUID) *ItemTemplateDeleteOne {
+	builder := c.Delete().Where(itemtemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemTemplate.
+func (c *ItemTemplateClient) Query() *ItemTemplateQuery {
+	return &ItemTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemTemplate entity by its id.
+func (c *ItemTemplateClient) Get(ctx context.Context, id uuid.UUID) (*ItemTemplate, error) {
+	return c.Query().Where(itemtemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemTemplateClient) GetX(ctx context.Context, id uuid.UUID) *ItemTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a ItemTemplate.
+func (c *ItemTemplateClient) QueryGroup(_m *ItemTemplate) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtemplate.Table, itemtemplate.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtemplate.GroupTable, itemtemplate.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFields queries the fields edge of a ItemTemplate.
+func (c *ItemTemplateClient) QueryFields(_m *ItemTemplate) *TemplateFieldQuery {
+	query := (&TemplateFieldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtemplate.Table, itemtemplate.FieldID, id),
+			sqlgraph.To(templatefield.Table, templatefield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, itemtemplate.FieldsTable, itemtemplate.FieldsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a ItemTemplate.
+func (c *ItemTemplateClient) QueryLocation(_m *ItemTemplate) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtemplate.Table, itemtemplate.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, itemtemplate.LocationTable, itemtemplate.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemTemplateClient) Hooks() []Hook {
+	return c.hooks.ItemTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemTemplateClient) Interceptors() []Interceptor {
+	return c.inters.ItemTemplate
+}
+
+func (c *ItemTemplateClient) mutate(ctx context.Context, m *ItemTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemTemplate mutation op: %q", m.Op())
+	}
+}
+
+// KioskSessionClient is a client for the KioskSession schema.
+type KioskSessionClient struct {
+	config
+}
+
+// NewKioskSessionClient returns a client for the KioskSession from the given config.
+func NewKioskSessionClient(c config) *KioskSessionClient {
+	return &KioskSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `kiosksession.Hooks(f(g(h())))`.
+func (c *KioskSessionClient) Use(hooks ...Hook) {
+	c.hooks.KioskSession = append(c.hooks.KioskSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `kiosksession.Intercept(f(g(h())))`.
+func (c *KioskSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KioskSession = append(c.inters.KioskSession, interceptors...)
+}
+
+// Create returns a builder for creating a KioskSession entity.
+func (c *KioskSessionClient) Create() *KioskSessionCreate {
+	mutation := newKioskSessionMutation(c.config, OpCreate)
+	return &KioskSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KioskSession entities.
+func (c *KioskSessionClient) CreateBulk(builders ...*KioskSessionCreate) *KioskSessionCreateBulk {
+	return &KioskSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KioskSessionClient) MapCreateBulk(slice any, setFunc func(*KioskSessionCreate, int)) *KioskSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KioskSessionCreateBulk{err: fmt.Errorf("calling to KioskSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KioskSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KioskSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KioskSession.
+func (c *KioskSessionClient) Update() *KioskSessionUpdate {
+	mutation := newKioskSessionMutation(c.config, OpUpdate)
+	return &KioskSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KioskSessionClient) UpdateOne(_m *KioskSession) *KioskSessionUpdateOne {
+	mutation := newKioskSessionMutation(c.config, OpUpdateOne, withKioskSession(_m))
+	return &KioskSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
@@ -2083,41 +3051,1457 @@ func (c *KioskSessionClient) UpdateOneID(id uuid.UUID) *KioskSessionUpdateOne {
 	return &KioskSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for KioskSession.
-func (c *KioskSessionClient) Delete() *KioskSessionDelete {
-	mutation := newKioskSessionMutation(c.config, OpDelete)
-	return &KioskSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for KioskSession.
+func (c *KioskSessionClient) Delete() *KioskSessionDelete {
+	mutation := newKioskSessionMutation(c.config, OpDelete)
+	return &KioskSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KioskSessionClient) DeleteOne(_m *KioskSession) *KioskSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KioskSessionClient) DeleteOneID(id uuid.UUID) *KioskSessionDeleteOne {
+	builder := c.Delete().Where(kiosksession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KioskSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for KioskSession.
+func (c *KioskSessionClient) Query() *KioskSessionQuery {
+	return &KioskSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKioskSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KioskSession entity by its id.
+func (c *KioskSessionClient) Get(ctx context.Context, id uuid.UUID) (*KioskSession, error) {
+	return c.Query().Where(kiosksession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KioskSessionClient) GetX(ctx context.Context, id uuid.UUID) *KioskSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a KioskSession.
+func (c *KioskSessionClient) QueryUser(_m *KioskSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosksession.Table, kiosksession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, kiosksession.UserTable, kiosksession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a KioskSession.
+func (c *KioskSessionClient) QueryLocation(_m *KioskSession) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosksession.Table, kiosksession.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, kiosksession.LocationTable, kiosksession.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KioskSessionClient) Hooks() []Hook {
+	return c.hooks.KioskSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *KioskSessionClient) Interceptors() []Interceptor {
+	return c.inters.KioskSession
+}
+
+func (c *KioskSessionClient) mutate(ctx context.Context, m *KioskSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KioskSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KioskSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KioskSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KioskSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KioskSession mutation op: %q", m.Op())
+	}
+}
+
+// KioskSyncActionClient is a client for the KioskSyncAction schema.
+type KioskSyncActionClient struct {
+	config
+}
+
+// NewKioskSyncActionClient returns a client for the KioskSyncAction from the given config.
+func NewKioskSyncActionClient(c config) *KioskSyncActionClient {
+	return &KioskSyncActionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `kiosksyncaction.Hooks(f(g(h())))`.
+func (c *KioskSyncActionClient) Use(hooks ...Hook) {
+	c.hooks.KioskSyncAction = append(c.hooks.KioskSyncAction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `kiosksyncaction.Intercept(f(g(h())))`.
+func (c *KioskSyncActionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KioskSyncAction = append(c.inters.KioskSyncAction, interceptors...)
+}
+
+// Create returns a builder for creating a KioskSyncAction entity.
+func (c *KioskSyncActionClient) Create() *KioskSyncActionCreate {
+	mutation := newKioskSyncActionMutation(c.config, OpCreate)
+	return &KioskSyncActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KioskSyncAction entities.
+func (c *KioskSyncActionClient) CreateBulk(builders ...*KioskSyncActionCreate) *KioskSyncActionCreateBulk {
+	return &KioskSyncActionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KioskSyncActionClient) MapCreateBulk(slice any, setFunc func(*KioskSyncActionCreate, int)) *KioskSyncActionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KioskSyncActionCreateBulk{err: fmt.Errorf("calling to KioskSyncActionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KioskSyncActionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KioskSyncActionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KioskSyncAction.
+func (c *KioskSyncActionClient) Update() *KioskSyncActionUpdate {
+	mutation := newKioskSyncActionMutation(c.config, OpUpdate)
+	return &KioskSyncActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KioskSyncActionClient) UpdateOne(_m *KioskSyncAction) *KioskSyncActionUpdateOne {
+	mutation := newKioskSyncActionMutation(c.config, OpUpdateOne, withKioskSyncAction(_m))
+	return &KioskSyncActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KioskSyncActionClient) UpdateOneID(id uuid.UUID) *KioskSyncActionUpdateOne {
+	mutation := newKioskSyncActionMutation(c.config, OpUpdateOne, withKioskSyncActionID(id))
+	return &KioskSyncActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KioskSyncAction.
+func (c *KioskSyncActionClient) Delete() *KioskSyncActionDelete {
+	mutation := newKioskSyncActionMutation(c.config, OpDelete)
+	return &KioskSyncActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KioskSyncActionClient) DeleteOne(_m *KioskSyncAction) *KioskSyncActionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KioskSyncActionClient) DeleteOneID(id uuid.UUID) *KioskSyncActionDeleteOne {
+	builder := c.Delete().Where(kiosksyncaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KioskSyncActionDeleteOne{builder}
+}
+
+// Query returns a query builder for KioskSyncAction.
+func (c *KioskSyncActionClient) Query() *KioskSyncActionQuery {
+	return &KioskSyncActionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKioskSyncAction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KioskSyncAction entity by its id.
+func (c *KioskSyncActionClient) Get(ctx context.Context, id uuid.UUID) (*KioskSyncAction, error) {
+	return c.Query().Where(kiosksyncaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KioskSyncActionClient) GetX(ctx context.Context, id uuid.UUID) *KioskSyncAction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a KioskSyncAction.
+func (c *KioskSyncActionClient) QueryGroup(_m *KioskSyncAction) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosksyncaction.Table, kiosksyncaction.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, kiosksyncaction.GroupTable, kiosksyncaction.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KioskSyncActionClient) Hooks() []Hook {
+	return c.hooks.KioskSyncAction
+}
+
+// Interceptors returns the client interceptors.
+func (c *KioskSyncActionClient) Interceptors() []Interceptor {
+	return c.inters.KioskSyncAction
+}
+
+func (c *KioskSyncActionClient) mutate(ctx context.Context, m *KioskSyncActionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KioskSyncActionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KioskSyncActionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KioskSyncActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KioskSyncActionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KioskSyncAction mutation op: %q", m.Op())
+	}
+}
+
+// LabelClient is a client for the Label schema.
+type LabelClient struct {
+	config
+}
+
+// NewLabelClient returns a client for the Label from the given config.
+func NewLabelClient(c config) *LabelClient {
+	return &LabelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `label.Hooks(f(g(h())))`.
+func (c *LabelClient) Use(hooks ...Hook) {
+	c.hooks.Label = append(c.hooks.Label, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `label.Intercept(f(g(h())))`.
+func (c *LabelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Label = append(c.inters.Label, interceptors...)
+}
+
+// Create returns a builder for creating a Label entity.
+func (c *LabelClient) Create() *LabelCreate {
+	mutation := newLabelMutation(c.config, OpCreate)
+	return &LabelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Label entities.
+func (c *LabelClient) CreateBulk(builders ...*LabelCreate) *LabelCreateBulk {
+	return &LabelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LabelClient) MapCreateBulk(slice any, setFunc func(*LabelCreate, int)) *LabelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LabelCreateBulk{err: fmt.Errorf("calling to LabelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LabelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LabelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Label.
+func (c *LabelClient) Update() *LabelUpdate {
+	mutation := newLabelMutation(c.config, OpUpdate)
+	return &LabelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LabelClient) UpdateOne(_m *Label) *LabelUpdateOne {
+	mutation := newLabelMutation(c.config, OpUpdateOne, withLabel(_m))
+	return &LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LabelClient) UpdateOneID(id uuid.UUID) *LabelUpdateOne {
+	mutation := newLabelMutation(c.config, OpUpdateOne, withLabelID(id))
+	return &LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Label.
+func (c *LabelClient) Delete() *LabelDelete {
+	mutation := newLabelMutation(c.config, OpDelete)
+	return &LabelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LabelClient) DeleteOne(_m *Label) *LabelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LabelClient) DeleteOneID(id uuid.UUID) *LabelDeleteOne {
+	builder := c.Delete().Where(label.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LabelDeleteOne{builder}
+}
+
+// Query returns a query builder for Label.
+func (c *LabelClient) Query() *LabelQuery {
+	return &LabelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLabel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Label entity by its id.
+func (c *LabelClient) Get(ctx context.Context, id uuid.UUID) (*Label, error) {
+	return c.Query().Where(label.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LabelClient) GetX(ctx context.Context, id uuid.UUID) *Label {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a Label.
+func (c *LabelClient) QueryGroup(_m *Label) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(label.Table, label.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, label.GroupTable, label.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a Label.
+func (c *LabelClient) QueryItems(_m *Label) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(label.Table, label.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, label.ItemsTable, label.ItemsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LabelClient) Hooks() []Hook {
+	return c.hooks.Label
+}
+
+// Interceptors returns the client interceptors.
+func (c *LabelClient) Interceptors() []Interceptor {
+	return c.inters.Label
+}
+
+func (c *LabelClient) mutate(ctx context.Context, m *LabelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LabelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LabelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LabelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Label mutation op: %q", m.Op())
+	}
+}
+
+// LabelTemplateClient is a client for the LabelTemplate schema.
+type LabelTemplateClient struct {
+	config
+}
+
+// NewLabelTemplateClient returns a client for the LabelTemplate from the given config.
+func NewLabelTemplateClient(c config) *LabelTemplateClient {
+	return &LabelTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `labeltemplate.Hooks(f(g(h())))`.
+func (c *LabelTemplateClient) Use(hooks ...Hook) {
+	c.hooks.LabelTemplate = append(c.hooks.LabelTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `labeltemplate.Intercept(f(g(h())))`.
+func (c *LabelTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LabelTemplate = append(c.inters.LabelTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a LabelTemplate entity.
+func (c *LabelTemplateClient) Create() *LabelTemplateCreate {
+	mutation := newLabelTemplateMutation(c.config, OpCreate)
+	return &LabelTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LabelTemplate entities.
+func (c *LabelTemplateClient) CreateBulk(builders ...*LabelTemplateCreate) *LabelTemplateCreateBulk {
+	return &LabelTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LabelTemplateClient) MapCreateBulk(slice any, setFunc func(*LabelTemplateCreate, int)) *LabelTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LabelTemplateCreateBulk{err: fmt.Errorf("calling to LabelTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LabelTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LabelTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LabelTemplate.
+func (c *LabelTemplateClient) Update() *LabelTemplateUpdate {
+	mutation := newLabelTemplateMutation(c.config, OpUpdate)
+	return &LabelTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LabelTemplateClient) UpdateOne(_m *LabelTemplate) *LabelTemplateUpdateOne {
+	mutation := newLabelTemplateMutation(c.config, OpUpdateOne, withLabelTemplate(_m))
+	return &LabelTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LabelTemplateClient) UpdateOneID(id uuid.UUID) *LabelTemplateUpdateOne {
+	mutation := newLabelTemplateMutation(c.config, OpUpdateOne, withLabelTemplateID(id))
+	return &LabelTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LabelTemplate.
+func (c *LabelTemplateClient) Delete() *LabelTemplateDelete {
+	mutation := newLabelTemplateMutation(c.config, OpDelete)
+	return &LabelTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LabelTemplateClient) DeleteOne(_m *LabelTemplate) *LabelTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LabelTemplateClient) DeleteOneID(id uuid.UUID) *LabelTemplateDeleteOne {
+	builder := c.Delete().Where(labeltemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LabelTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for LabelTemplate.
+func (c *LabelTemplateClient) Query() *LabelTemplateQuery {
+	return &LabelTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLabelTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LabelTemplate entity by its id.
+func (c *LabelTemplateClient) Get(ctx context.Context, id uuid.UUID) (*LabelTemplate, error) {
+	return c.Query().Where(labeltemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LabelTemplateClient) GetX(ctx context.Context, id uuid.UUID) *LabelTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a LabelTemplate.
+func (c *LabelTemplateClient) QueryGroup(_m *LabelTemplate) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(labeltemplate.Table, labeltemplate.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, labeltemplate.GroupTable, labeltemplate.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LabelTemplateClient) Hooks() []Hook {
+	return c.hooks.LabelTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *LabelTemplateClient) Interceptors() []Interceptor {
+	return c.inters.LabelTemplate
+}
+
+func (c *LabelTemplateClient) mutate(ctx context.Context, m *LabelTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LabelTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LabelTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LabelTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LabelTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LabelTemplate mutation op: %q", m.Op())
+	}
+}
+
+// LoanClient is a client for the Loan schema.
+type LoanClient struct {
+	config
+}
+
+// NewLoanClient returns a client for the Loan from the given config.
+func NewLoanClient(c config) *LoanClient {
+	return &LoanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loan.Hooks(f(g(h())))`.
+func (c *LoanClient) Use(hooks ...Hook) {
+	c.hooks.Loan = append(c.hooks.Loan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loan.Intercept(f(g(h())))`.
+func (c *LoanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Loan = append(c.inters.Loan, interceptors...)
+}
+
+// Create returns a builder for creating a Loan entity.
+func (c *LoanClient) Create() *LoanCreate {
+	mutation := newLoanMutation(c.config, OpCreate)
+	return &LoanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Loan entities.
+func (c *LoanClient) CreateBulk(builders ...*LoanCreate) *LoanCreateBulk {
+	return &LoanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoanClient) MapCreateBulk(slice any, setFunc func(*LoanCreate, int)) *LoanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoanCreateBulk{err: fmt.Errorf("calling to LoanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Loan.
+func (c *LoanClient) Update() *LoanUpdate {
+	mutation := newLoanMutation(c.config, OpUpdate)
+	return &LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoanClient) UpdateOne(_m *Loan) *LoanUpdateOne {
+	mutation := newLoanMutation(c.config, OpUpdateOne, withLoan(_m))
+	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoanClient) UpdateOneID(id uuid.UUID) *LoanUpdateOne {
+	mutation := newLoanMutation(c.config, OpUpdateOne, withLoanID(id))
+	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Loan.
+func (c *LoanClient) Delete() *LoanDelete {
+	mutation := newLoanMutation(c.config, OpDelete)
+	return &LoanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoanClient) DeleteOne(_m *Loan) *LoanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoanClient) DeleteOneID(id uuid.UUID) *LoanDeleteOne {
+	builder := c.Delete().Where(loan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoanDeleteOne{builder}
+}
+
+// Query returns a query builder for Loan.
+func (c *LoanClient) Query() *LoanQuery {
+	return &LoanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Loan entity by its id.
+func (c *LoanClient) Get(ctx context.Context, id uuid.UUID) (*Loan, error) {
+	return c.Query().Where(loan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoanClient) GetX(ctx context.Context, id uuid.UUID) *Loan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a Loan.
+func (c *LoanClient) QueryGroup(_m *Loan) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.GroupTable, loan.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItem queries the item edge of a Loan.
+func (c *LoanClient) QueryItem(_m *Loan) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.ItemTable, loan.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBorrower queries the borrower edge of a Loan.
+func (c *LoanClient) QueryBorrower(_m *Loan) *BorrowerQuery {
+	query := (&BorrowerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(borrower.Table, borrower.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.BorrowerTable, loan.BorrowerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCheckedOutBy queries the checked_out_by edge of a Loan.
+func (c *LoanClient) QueryCheckedOutBy(_m *Loan) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.CheckedOutByTable, loan.CheckedOutByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReturnedBy queries the returned_by edge of a Loan.
+func (c *LoanClient) QueryReturnedBy(_m *Loan) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.ReturnedByTable, loan.ReturnedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReturnLocation queries the return_location edge of a Loan.
+func (c *LoanClient) QueryReturnLocation(_m *Loan) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.ReturnLocationTable, loan.ReturnLocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoanClient) Hooks() []Hook {
+	return c.hooks.Loan
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoanClient) Interceptors() []Interceptor {
+	return c.inters.Loan
+}
+
+func (c *LoanClient) mutate(ctx context.Context, m *LoanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Loan mutation op: %q", m.Op())
+	}
+}
+
+// LocationClient is a client for the Location schema.
+type LocationClient struct {
+	config
+}
+
+// NewLocationClient returns a client for the Location from the given config.
+func NewLocationClient(c config) *LocationClient {
+	return &LocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `location.Hooks(f(g(h())))`.
+func (c *LocationClient) Use(hooks ...Hook) {
+	c.hooks.Location = append(c.hooks.Location, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `location.Intercept(f(g(h())))`.
+func (c *LocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Location = append(c.inters.Location, interceptors...)
+}
+
+// Create returns a builder for creating a Location entity.
+func (c *LocationClient) Create() *LocationCreate {
+	mutation := newLocationMutation(c.config, OpCreate)
+	return &LocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Location entities.
+func (c *LocationClient) CreateBulk(builders ...*LocationCreate) *LocationCreateBulk {
+	return &LocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocationClient) MapCreateBulk(slice any, setFunc func(*LocationCreate, int)) *LocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LocationCreateBulk{err: fmt.Errorf("calling to LocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Location.
+func (c *LocationClient) Update() *LocationUpdate {
+	mutation := newLocationMutation(c.config, OpUpdate)
+	return &LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LocationClient) UpdateOne(_m *Location) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocation(_m))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LocationClient) UpdateOneID(id uuid.UUID) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocationID(id))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Location.
+func (c *LocationClient) Delete() *LocationDelete {
+	mutation := newLocationMutation(c.config, OpDelete)
+	return &LocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocationClient) DeleteOne(_m *Location) *LocationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocationClient) DeleteOneID(id uuid.UUID) *LocationDeleteOne {
+	builder := c.Delete().Where(location.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocationDeleteOne{builder}
+}
+
+// Query returns a query builder for Location.
+func (c *LocationClient) Query() *LocationQuery {
+	return &LocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Location entity by its id.
+func (c *LocationClient) Get(ctx context.Context, id uuid.UUID) (*Location, error) {
+	return c.Query().Where(location.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocationClient) GetX(ctx context.Context, id uuid.UUID) *Location {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a Location.
+func (c *LocationClient) QueryGroup(_m *Location) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, location.GroupTable, location.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Location.
+func (c *LocationClient) QueryParent(_m *Location) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, location.ParentTable, location.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Location.
+func (c *LocationClient) QueryChildren(_m *Location) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ChildrenTable, location.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a Location.
+func (c *LocationClient) QueryItems(_m *Location) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ItemsTable, location.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKioskSessions queries the kiosk_sessions edge of a Location.
+func (c *LocationClient) QueryKioskSessions(_m *Location) *KioskSessionQuery {
+	query := (&KioskSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(kiosksession.Table, kiosksession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.KioskSessionsTable, location.KioskSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReturnedLoans queries the returned_loans edge of a Location.
+func (c *LocationClient) QueryReturnedLoans(_m *Location) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ReturnedLoansTable, location.ReturnedLoansColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStocktakeSessions queries the stocktake_sessions edge of a Location.
+func (c *LocationClient) QueryStocktakeSessions(_m *Location) *StocktakeSessionQuery {
+	query := (&StocktakeSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(stocktakesession.Table, stocktakesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.StocktakeSessionsTable, location.StocktakeSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LocationClient) Hooks() []Hook {
+	return c.hooks.Location
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocationClient) Interceptors() []Interceptor {
+	return c.inters.Location
+}
+
+func (c *LocationClient) mutate(ctx context.Context, m *LocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Location mutation op: %q", m.Op())
+	}
+}
+
+// MaintenanceEntryClient is a client for the MaintenanceEntry schema.
+type MaintenanceEntryClient struct {
+	config
+}
+
+// NewMaintenanceEntryClient returns a client for the MaintenanceEntry from the given config.
+func NewMaintenanceEntryClient(c config) *MaintenanceEntryClient {
+	return &MaintenanceEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `maintenanceentry.Hooks(f(g(h())))`.
+func (c *MaintenanceEntryClient) Use(hooks ...Hook) {
+	c.hooks.MaintenanceEntry = append(c.hooks.MaintenanceEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `maintenanceentry.Intercept(f(g(h())))`.
+func (c *MaintenanceEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MaintenanceEntry = append(c.inters.MaintenanceEntry, interceptors...)
+}
+
+// Create returns a builder for creating a MaintenanceEntry entity.
+func (c *MaintenanceEntryClient) Create() *MaintenanceEntryCreate {
+	mutation := newMaintenanceEntryMutation(c.config, OpCreate)
+	return &MaintenanceEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MaintenanceEntry entities.
+func (c *MaintenanceEntryClient) CreateBulk(builders ...*MaintenanceEntryCreate) *MaintenanceEntryCreateBulk {
+	return &MaintenanceEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MaintenanceEntryClient) MapCreateBulk(slice any, setFunc func(*MaintenanceEntryCreate, int)) *MaintenanceEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MaintenanceEntryCreateBulk{err: fmt.Errorf("calling to MaintenanceEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MaintenanceEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MaintenanceEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MaintenanceEntry.
+func (c *MaintenanceEntryClient) Update() *MaintenanceEntryUpdate {
+	mutation := newMaintenanceEntryMutation(c.config, OpUpdate)
+	return &MaintenanceEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MaintenanceEntryClient) UpdateOne(_m *MaintenanceEntry) *MaintenanceEntryUpdateOne {
+	mutation := newMaintenanceEntryMutation(c.config, OpUpdateOne, withMaintenanceEntry(_m))
+	return &MaintenanceEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MaintenanceEntryClient) UpdateOneID(id uuid.UUID) *MaintenanceEntryUpdateOne {
+	mutation := newMaintenanceEntryMutation(c.config, OpUpdateOne, withMaintenanceEntryID(id))
+	return &MaintenanceEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MaintenanceEntry.
+func (c *MaintenanceEntryClient) Delete() *MaintenanceEntryDelete {
+	mutation := newMaintenanceEntryMutation(c.config, OpDelete)
+	return &MaintenanceEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MaintenanceEntryClient) DeleteOne(_m *MaintenanceEntry) *MaintenanceEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MaintenanceEntryClient) DeleteOneID(id uuid.UUID) *MaintenanceEntryDeleteOne {
+	builder := c.Delete().Where(maintenanceentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MaintenanceEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for MaintenanceEntry.
+func (c *MaintenanceEntryClient) Query() *MaintenanceEntryQuery {
+	return &MaintenanceEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMaintenanceEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MaintenanceEntry entity by its id.
+func (c *MaintenanceEntryClient) Get(ctx context.Context, id uuid.UUID) (*MaintenanceEntry, error) {
+	return c.Query().Where(maintenanceentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MaintenanceEntryClient) GetX(ctx context.Context, id uuid.UUID) *MaintenanceEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a MaintenanceEntry.
+func (c *MaintenanceEntryClient) QueryItem(_m *MaintenanceEntry) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenanceentry.Table, maintenanceentry.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, maintenanceentry.ItemTable, maintenanceentry.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MaintenanceEntryClient) Hooks() []Hook {
+	return c.hooks.MaintenanceEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *MaintenanceEntryClient) Interceptors() []Interceptor {
+	return c.inters.MaintenanceEntry
+}
+
+func (c *MaintenanceEntryClient) mutate(ctx context.Context, m *MaintenanceEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MaintenanceEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MaintenanceEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MaintenanceEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MaintenanceEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MaintenanceEntry mutation op: %q", m.Op())
+	}
+}
+
+// NotifierClient is a client for the Notifier schema.
+type NotifierClient struct {
+	config
+}
+
+// NewNotifierClient returns a client for the Notifier from the given config.
+func NewNotifierClient(c config) *NotifierClient {
+	return &NotifierClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notifier.Hooks(f(g(h())))`.
+func (c *NotifierClient) Use(hooks ...Hook) {
+	c.hooks.Notifier = append(c.hooks.Notifier, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notifier.Intercept(f(g(h())))`.
+func (c *NotifierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notifier = append(c.inters.Notifier, interceptors...)
+}
+
+// Create returns a builder for creating a Notifier entity.
+func (c *NotifierClient) Create() *NotifierCreate {
+	mutation := newNotifierMutation(c.config, OpCreate)
+	return &NotifierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notifier entities.
+func (c *NotifierClient) CreateBulk(builders ...*NotifierCreate) *NotifierCreateBulk {
+	return &NotifierCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotifierClient) MapCreateBulk(slice any, setFunc func(*NotifierCreate, int)) *NotifierCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotifierCreateBulk{err: fmt.Errorf("calling to NotifierClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotifierCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotifierCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notifier.
+func (c *NotifierClient) Update() *NotifierUpdate {
+	mutation := newNotifierMutation(c.config, OpUpdate)
+	return &NotifierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotifierClient) UpdateOne(_m *Notifier) *NotifierUpdateOne {
+	mutation := newNotifierMutation(c.config, OpUpdateOne, withNotifier(_m))
+	return &NotifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotifierClient) UpdateOneID(id uuid.UUID) *NotifierUpdateOne {
+	mutation := newNotifierMutation(c.config, OpUpdateOne, withNotifierID(id))
+	return &NotifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notifier.
+func (c *NotifierClient) Delete() *NotifierDelete {
+	mutation := newNotifierMutation(c.config, OpDelete)
+	return &NotifierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotifierClient) DeleteOne(_m *Notifier) *NotifierDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotifierClient) DeleteOneID(id uuid.UUID) *NotifierDeleteOne {
+	builder := c.Delete().Where(notifier.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotifierDeleteOne{builder}
+}
+
+// Query returns a query builder for Notifier.
+func (c *NotifierClient) Query() *NotifierQuery {
+	return &NotifierQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotifier},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notifier entity by its id.
+func (c *NotifierClient) Get(ctx context.Context, id uuid.UUID) (*Notifier, error) {
+	return c.Query().Where(notifier.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotifierClient) GetX(ctx context.Context, id uuid.UUID) *Notifier {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a Notifier.
+func (c *NotifierClient) QueryGroup(_m *Notifier) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notifier.Table, notifier.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notifier.GroupTable, notifier.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Notifier.
+func (c *NotifierClient) QueryUser(_m *Notifier) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notifier.Table, notifier.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notifier.UserTable, notifier.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotifierClient) Hooks() []Hook {
+	return c.hooks.Notifier
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotifierClient) Interceptors() []Interceptor {
+	return c.inters.Notifier
+}
+
+func (c *NotifierClient) mutate(ctx context.Context, m *NotifierMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotifierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotifierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotifierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notifier mutation op: %q", m.Op())
+	}
+}
+
+// PrintJobClient is a client for the PrintJob schema.
+type PrintJobClient struct {
+	config
+}
+
+// NewPrintJobClient returns a client for the PrintJob from the given config.
+func NewPrintJobClient(c config) *PrintJobClient {
+	return &PrintJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `printjob.Hooks(f(g(h())))`.
+func (c *PrintJobClient) Use(hooks ...Hook) {
+	c.hooks.PrintJob = append(c.hooks.PrintJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `printjob.Intercept(f(g(h())))`.
+func (c *PrintJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PrintJob = append(c.inters.PrintJob, interceptors...)
+}
+
+// Create returns a builder for creating a PrintJob entity.
+func (c *PrintJobClient) Create() *PrintJobCreate {
+	mutation := newPrintJobMutation(c.config, OpCreate)
+	return &PrintJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PrintJob entities.
+func (c *PrintJobClient) CreateBulk(builders ...*PrintJobCreate) *PrintJobCreateBulk {
+	return &PrintJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PrintJobClient) MapCreateBulk(slice any, setFunc func(*PrintJobCreate, int)) *PrintJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PrintJobCreateBulk{err: fmt.Errorf("calling to PrintJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PrintJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PrintJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PrintJob.
+func (c *PrintJobClient) Update() *PrintJobUpdate {
+	mutation := newPrintJobMutation(c.config, OpUpdate)
+	return &PrintJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PrintJobClient) UpdateOne(_m *PrintJob) *PrintJobUpdateOne {
+	mutation := newPrintJobMutation(c.config, OpUpdateOne, withPrintJob(_m))
+	return &PrintJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PrintJobClient) UpdateOneID(id uuid.UUID) *PrintJobUpdateOne {
+	mutation := newPrintJobMutation(c.config, OpUpdateOne, withPrintJobID(id))
+	return &PrintJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PrintJob.
+func (c *PrintJobClient) Delete() *PrintJobDelete {
+	mutation := newPrintJobMutation(c.config, OpDelete)
+	return &PrintJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *KioskSessionClient) DeleteOne(_m *KioskSession) *KioskSessionDeleteOne {
+func (c *PrintJobClient) DeleteOne(_m *PrintJob) *PrintJobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *KioskSessionClient) DeleteOneID(id uuid.UUID) *KioskSessionDeleteOne {
-	builder := c.Delete().Where(kiosksession.ID(id))
+func (c *PrintJobClient) DeleteOneID(id uuid.UUID) *PrintJobDeleteOne {
+	builder := c.Delete().Where(printjob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &KioskSessionDeleteOne{builder}
+	return &PrintJobDeleteOne{builder}
 }
 
-// Query returns a query builder for KioskSession.
-func (c *KioskSessionClient) Query() *KioskSessionQuery {
-	return &KioskSessionQuery{
+// Query returns a query builder for PrintJob.
+func (c *PrintJobClient) Query() *PrintJobQuery {
+	return &PrintJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeKioskSession},
+		ctx:    &QueryContext{Type: TypePrintJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a KioskSession entity by its id.
-func (c *KioskSessionClient) Get(ctx context.Context, id uuid.UUID) (*KioskSession, error) {
-	return c.Query().Where(kiosksession.ID(id)).Only(ctx)
+// Get returns a PrintJob entity by its id.
+func (c *PrintJobClient) Get(ctx context.Context, id uuid.UUID) (*PrintJob, error) {
+	return c.Query().Where(printjob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *KioskSessionClient) GetX(ctx context.Context, id uuid.UUID) *KioskSession {
+func (c *PrintJobClient) GetX(ctx context.Context, id uuid.UUID) *PrintJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2125,15 +4509,31 @@ func (c *KioskSessionClient) GetX(ctx context.Context, id uuid.UUID) *KioskSessi
 	return obj
 }
 
-// QueryUser queries the user edge of a KioskSession.
-func (c *KioskSessionClient) QueryUser(_m *KioskSession) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryGroup queries the group edge of a PrintJob.
+func (c *PrintJobClient) QueryGroup(_m *PrintJob) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(kiosksession.Table, kiosksession.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, kiosksession.UserTable, kiosksession.UserColumn),
+			sqlgraph.From(printjob.Table, printjob.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, printjob.GroupTable, printjob.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrinter queries the printer edge of a PrintJob.
+func (c *PrintJobClient) QueryPrinter(_m *PrintJob) *PrinterQuery {
+	query := (&PrinterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(printjob.Table, printjob.FieldID, id),
+			sqlgraph.To(printer.Table, printer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, printjob.PrinterTable, printjob.PrinterColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2142,131 +4542,131 @@ func (c *KioskSessionClient) QueryUser(_m *KioskSession) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *KioskSessionClient) Hooks() []Hook {
-	return c.hooks.KioskSession
+func (c *PrintJobClient) Hooks() []Hook {
+	return c.hooks.PrintJob
 }
 
 // Interceptors returns the client interceptors.
-func (c *KioskSessionClient) Interceptors() []Interceptor {
-	return c.inters.KioskSession
+func (c *PrintJobClient) Interceptors() []Interceptor {
+	return c.inters.PrintJob
 }
 
-func (c *KioskSessionClient) mutate(ctx context.Context, m *KioskSessionMutation) (Value, error) {
+func (c *PrintJobClient) mutate(ctx context.Context, m *PrintJobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&KioskSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrintJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&KioskSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrintJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&KioskSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrintJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&KioskSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PrintJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown KioskSession mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PrintJob mutation op: %q", m.Op())
 	}
 }
 
-// LabelClient is a client for the Label schema.
-type LabelClient struct {
+// PrinterClient is a client for the Printer schema.
+type PrinterClient struct {
 	config
 }
 
-// NewLabelClient returns a client for the Label from the given config.
-func NewLabelClient(c config) *LabelClient {
-	return &LabelClient{config: c}
+// NewPrinterClient returns a client for the Printer from the given config.
+func NewPrinterClient(c config) *PrinterClient {
+	return &PrinterClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `label.Hooks(f(g(h())))`.
-func (c *LabelClient) Use(hooks ...Hook) {
-	c.hooks.Label = append(c.hooks.Label, hooks...)
+// A call to `Use(f, g, h)` equals to `printer.Hooks(f(g(h())))`.
+func (c *PrinterClient) Use(hooks ...Hook) {
+	c.hooks.Printer = append(c.hooks.Printer, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `label.Intercept(f(g(h())))`.
-func (c *LabelClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Label = append(c.inters.Label, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `printer.Intercept(f(g(h())))`.
+func (c *PrinterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Printer = append(c.inters.Printer, interceptors...)
 }
 
-// Create returns a builder for creating a Label entity.
-func (c *LabelClient) Create() *LabelCreate {
-	mutation := newLabelMutation(c.config, OpCreate)
-	return &LabelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Printer entity.
+func (c *PrinterClient) Create() *PrinterCreate {
+	mutation := newPrinterMutation(c.config, OpCreate)
+	return &PrinterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Label entities.
-func (c *LabelClient) CreateBulk(builders ...*LabelCreate) *LabelCreateBulk {
-	return &LabelCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Printer entities.
+func (c *PrinterClient) CreateBulk(builders ...*PrinterCreate) *PrinterCreateBulk {
+	return &PrinterCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LabelClient) MapCreateBulk(slice any, setFunc func(*LabelCreate, int)) *LabelCreateBulk {
+func (c *PrinterClient) MapCreateBulk(slice any, setFunc func(*PrinterCreate, int)) *PrinterCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LabelCreateBulk{err: fmt.Errorf("calling to LabelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PrinterCreateBulk{err: fmt.Errorf("calling to PrinterClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LabelCreate, rv.Len())
+	builders := make([]*PrinterCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LabelCreateBulk{config: c.config, builders: builders}
+	return &PrinterCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Label.
-func (c *LabelClient) Update() *LabelUpdate {
-	mutation := newLabelMutation(c.config, OpUpdate)
-	return &LabelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Printer.
+func (c *PrinterClient) Update() *PrinterUpdate {
+	mutation := newPrinterMutation(c.config, OpUpdate)
+	return &PrinterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LabelClient) UpdateOne(_m *Label) *LabelUpdateOne {
-	mutation := newLabelMutation(c.config, OpUpdateOne, withLabel(_m))
-	return &LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PrinterClient) UpdateOne(_m *Printer) *PrinterUpdateOne {
+	mutation := newPrinterMutation(c.config, OpUpdateOne, withPrinter(_m))
+	return &PrinterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LabelClient) UpdateOneID(id uuid.UUID) *LabelUpdateOne {
-	mutation := newLabelMutation(c.config, OpUpdateOne, withLabelID(id))
-	return &LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PrinterClient) UpdateOneID(id uuid.UUID) *PrinterUpdateOne {
+	mutation := newPrinterMutation(c.config, OpUpdateOne, withPrinterID(id))
+	return &PrinterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Label.
-func (c *LabelClient) Delete() *LabelDelete {
-	mutation := newLabelMutation(c.config, OpDelete)
-	return &LabelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Printer.
+func (c *PrinterClient) Delete() *PrinterDelete {
+	mutation := newPrinterMutation(c.config, OpDelete)
+	return &PrinterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LabelClient) DeleteOne(_m *Label) *LabelDeleteOne {
+func (c *PrinterClient) DeleteOne(_m *Printer) *PrinterDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LabelClient) DeleteOneID(id uuid.UUID) *LabelDeleteOne {
-	builder := c.Delete().Where(label.ID(id))
+func (c *PrinterClient) DeleteOneID(id uuid.UUID) *PrinterDeleteOne {
+	builder := c.Delete().Where(printer.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LabelDeleteOne{builder}
+	return &PrinterDeleteOne{builder}
 }
 
-// Query returns a query builder for Label.
-func (c *LabelClient) Query() *LabelQuery {
-	return &LabelQuery{
+// Query returns a query builder for Printer.
+func (c *PrinterClient) Query() *PrinterQuery {
+	return &PrinterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLabel},
+		ctx:    &QueryContext{Type: TypePrinter},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Label entity by its id.
-func (c *LabelClient) Get(ctx context.Context, id uuid.UUID) (*Label, error) {
-	return c.Query().Where(label.ID(id)).Only(ctx)
+// Get returns a Printer entity by its id.
+func (c *PrinterClient) Get(ctx context.Context, id uuid.UUID) (*Printer, error) {
+	return c.Query().Where(printer.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LabelClient) GetX(ctx context.Context, id uuid.UUID) *Label {
+func (c *PrinterClient) GetX(ctx context.Context, id uuid.UUID) *Printer {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2274,15 +4674,15 @@ func (c *LabelClient) GetX(ctx context.Context, id uuid.UUID) *Label {
 	return obj
 }
 
-// QueryGroup queries the group edge of a Label.
-func (c *LabelClient) QueryGroup(_m *Label) *GroupQuery {
+// QueryGroup queries the group edge of a Printer.
+func (c *PrinterClient) QueryGroup(_m *Printer) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(label.Table, label.FieldID, id),
+			sqlgraph.From(printer.Table, printer.FieldID, id),
 			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, label.GroupTable, label.GroupColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, printer.GroupTable, printer.GroupColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2290,15 +4690,15 @@ func (c *LabelClient) QueryGroup(_m *Label) *GroupQuery {
 	return query
 }
 
-// QueryItems queries the items edge of a Label.
-func (c *LabelClient) QueryItems(_m *Label) *ItemQuery {
-	query := (&ItemClient{config: c.config}).Query()
+// QueryJobs queries the jobs edge of a Printer.
+func (c *PrinterClient) QueryJobs(_m *Printer) *PrintJobQuery {
+	query := (&PrintJobClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(label.Table, label.FieldID, id),
-			sqlgraph.To(item.Table, item.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, label.ItemsTable, label.ItemsPrimaryKey...),
+			sqlgraph.From(printer.Table, printer.FieldID, id),
+			sqlgraph.To(printjob.Table, printjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, printer.JobsTable, printer.JobsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2307,131 +4707,131 @@ func (c *LabelClient) QueryItems(_m *Label) *ItemQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LabelClient) Hooks() []Hook {
-	return c.hooks.Label
+func (c *PrinterClient) Hooks() []Hook {
+	return c.hooks.Printer
 }
 
 // Interceptors returns the client interceptors.
-func (c *LabelClient) Interceptors() []Interceptor {
-	return c.inters.Label
+func (c *PrinterClient) Interceptors() []Interceptor {
+	return c.inters.Printer
 }
 
-func (c *LabelClient) mutate(ctx context.Context, m *LabelMutation) (Value, error) {
+func (c *PrinterClient) mutate(ctx context.Context, m *PrinterMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LabelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrinterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LabelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrinterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PrinterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LabelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PrinterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Label mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Printer mutation op: %q", m.Op())
 	}
 }
 
-// LoanClient is a client for the Loan schema.
-type LoanClient struct {
+// SavedSearchClient is a client for the SavedSearch schema.
+type SavedSearchClient struct {
 	config
 }
 
-// NewLoanClient returns a client for the Loan from the given config.
-func NewLoanClient(c config) *LoanClient {
-	return &LoanClient{config: c}
+// NewSavedSearchClient returns a client for the SavedSearch from the given config.
+func NewSavedSearchClient(c config) *SavedSearchClient {
+	return &SavedSearchClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `loan.Hooks(f(g(h())))`.
-func (c *LoanClient) Use(hooks ...Hook) {
-	c.hooks.Loan = append(c.hooks.Loan, hooks...)
+// A call to `Use(f, g, h)` equals to `savedsearch.Hooks(f(g(h())))`.
+func (c *SavedSearchClient) Use(hooks ...Hook) {
+	c.hooks.SavedSearch = append(c.hooks.SavedSearch, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `loan.Intercept(f(g(h())))`.
-func (c *LoanClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Loan = append(c.inters.Loan, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `savedsearch.Intercept(f(g(h())))`.
+func (c *SavedSearchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedSearch = append(c.inters.SavedSearch, interceptors...)
 }
 
-// Create returns a builder for creating a Loan entity.
-func (c *LoanClient) Create() *LoanCreate {
-	mutation := newLoanMutation(c.config, OpCreate)
-	return &LoanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SavedSearch entity.
+func (c *SavedSearchClient) Create() *SavedSearchCreate {
+	mutation := newSavedSearchMutation(c.config, OpCreate)
+	return &SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Loan entities.
-func (c *LoanClient) CreateBulk(builders ...*LoanCreate) *LoanCreateBulk {
-	return &LoanCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SavedSearch entities.
+func (c *SavedSearchClient) CreateBulk(builders ...*SavedSearchCreate) *SavedSearchCreateBulk {
+	return &SavedSearchCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LoanClient) MapCreateBulk(slice any, setFunc func(*LoanCreate, int)) *LoanCreateBulk {
+func (c *SavedSearchClient) MapCreateBulk(slice any, setFunc func(*SavedSearchCreate, int)) *SavedSearchCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LoanCreateBulk{err: fmt.Errorf("calling to LoanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SavedSearchCreateBulk{err: fmt.Errorf("calling to SavedSearchClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LoanCreate, rv.Len())
+	builders := make([]*SavedSearchCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LoanCreateBulk{config: c.config, builders: builders}
+	return &SavedSearchCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Loan.
-func (c *LoanClient) Update() *LoanUpdate {
-	mutation := newLoanMutation(c.config, OpUpdate)
-	return &LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SavedSearch.
+func (c *SavedSearchClient) Update() *SavedSearchUpdate {
+	mutation := newSavedSearchMutation(c.config, OpUpdate)
+	return &SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LoanClient) UpdateOne(_m *Loan) *LoanUpdateOne {
-	mutation := newLoanMutation(c.config, OpUpdateOne, withLoan(_m))
-	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SavedSearchClient) UpdateOne(_m *SavedSearch) *SavedSearchUpdateOne {
+	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearch(_m))
+	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LoanClient) UpdateOneID(id uuid.UUID) *LoanUpdateOne {
-	mutation := newLoanMutation(c.config, OpUpdateOne, withLoanID(id))
-	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SavedSearchClient) UpdateOneID(id uuid.UUID) *SavedSearchUpdateOne {
+	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearchID(id))
+	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Loan.
-func (c *LoanClient) Delete() *LoanDelete {
-	mutation := newLoanMutation(c.config, OpDelete)
-	return &LoanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SavedSearch.
+func (c *SavedSearchClient) Delete() *SavedSearchDelete {
+	mutation := newSavedSearchMutation(c.config, OpDelete)
+	return &SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LoanClient) DeleteOne(_m *Loan) *LoanDeleteOne {
+func (c *SavedSearchClient) DeleteOne(_m *SavedSearch) *SavedSearchDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LoanClient) DeleteOneID(id uuid.UUID) *LoanDeleteOne {
-	builder := c.Delete().Where(loan.ID(id))
+func (c *SavedSearchClient) DeleteOneID(id uuid.UUID) *SavedSearchDeleteOne {
+	builder := c.Delete().Where(savedsearch.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LoanDeleteOne{builder}
+	return &SavedSearchDeleteOne{builder}
 }
 
-// Query returns a query builder for Loan.
-func (c *LoanClient) Query() *LoanQuery {
-	return &LoanQuery{
+// Query returns a query builder for SavedSearch.
+func (c *SavedSearchClient) Query() *SavedSearchQuery {
+	return &SavedSearchQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLoan},
+		ctx:    &QueryContext{Type: TypeSavedSearch},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Loan entity by its id.
-func (c *LoanClient) Get(ctx context.Context, id uuid.UUID) (*Loan, error) {
-	return c.Query().Where(loan.ID(id)).Only(ctx)
+// Get returns a SavedSearch entity by its id.
+func (c *SavedSearchClient) Get(ctx context.Context, id uuid.UUID) (*SavedSearch, error) {
+	return c.Query().Where(savedsearch.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LoanClient) GetX(ctx context.Context, id uuid.UUID) *Loan {
+func (c *SavedSearchClient) GetX(ctx context.Context, id uuid.UUID) *SavedSearch {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2439,63 +4839,15 @@ func (c *LoanClient) GetX(ctx context.Context, id uuid.UUID) *Loan {
 	return obj
 }
 
-// QueryGroup queries the group edge of a Loan.
-func (c *LoanClient) QueryGroup(_m *Loan) *GroupQuery {
+// QueryGroup queries the group edge of a SavedSearch.
+func (c *SavedSearchClient) QueryGroup(_m *SavedSearch) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
 			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, loan.GroupTable, loan.GroupColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryItem queries the item edge of a Loan.
-func (c *LoanClient) QueryItem(_m *Loan) *ItemQuery {
-	query := (&ItemClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(loan.Table, loan.FieldID, id),
-			sqlgraph.To(item.Table, item.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, loan.ItemTable, loan.ItemColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryBorrower queries the borrower edge of a Loan.
-func (c *LoanClient) QueryBorrower(_m *Loan) *BorrowerQuery {
-	query := (&BorrowerClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(loan.Table, loan.FieldID, id),
-			sqlgraph.To(borrower.Table, borrower.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, loan.BorrowerTable, loan.BorrowerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCheckedOutBy queries the checked_out_by edge of a Loan.
-func (c *LoanClient) QueryCheckedOutBy(_m *Loan) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(loan.Table, loan.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, loan.CheckedOutByTable, loan.CheckedOutByColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedsearch.GroupTable, savedsearch.GroupColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2503,15 +4855,15 @@ func (c *LoanClient) QueryCheckedOutBy(_m *Loan) *UserQuery {
 	return query
 }
 
-// QueryReturnedBy queries the returned_by edge of a Loan.
-func (c *LoanClient) QueryReturnedBy(_m *Loan) *UserQuery {
+// QueryUser queries the user edge of a SavedSearch.
+func (c *SavedSearchClient) QueryUser(_m *SavedSearch) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, loan.ReturnedByTable, loan.ReturnedByColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedsearch.UserTable, savedsearch.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2520,131 +4872,131 @@ func (c *LoanClient) QueryReturnedBy(_m *Loan) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LoanClient) Hooks() []Hook {
-	return c.hooks.Loan
+func (c *SavedSearchClient) Hooks() []Hook {
+	return c.hooks.SavedSearch
 }
 
 // Interceptors returns the client interceptors.
-func (c *LoanClient) Interceptors() []Interceptor {
-	return c.inters.Loan
+func (c *SavedSearchClient) Interceptors() []Interceptor {
+	return c.inters.SavedSearch
 }
 
-func (c *LoanClient) mutate(ctx context.Context, m *LoanMutation) (Value, error) {
+func (c *SavedSearchClient) mutate(ctx context.Context, m *SavedSearchMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LoanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LoanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Loan mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SavedSearch mutation op: %q", m.Op())
 	}
 }
 
-// LocationClient is a client for the Location schema.
-type LocationClient struct {
+// StockMovementClient is a client for the StockMovement schema.
+type StockMovementClient struct {
 	config
 }
 
-// NewLocationClient returns a client for the Location from the given config.
-func NewLocationClient(c config) *LocationClient {
-	return &LocationClient{config: c}
+// NewStockMovementClient returns a client for the StockMovement from the given config.
+func NewStockMovementClient(c config) *StockMovementClient {
+	return &StockMovementClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `location.Hooks(f(g(h())))`.
-func (c *LocationClient) Use(hooks ...Hook) {
-	c.hooks.Location = append(c.hooks.Location, hooks...)
+// A call to `Use(f, g, h)` equals to `stockmovement.Hooks(f(g(h())))`.
+func (c *StockMovementClient) Use(hooks ...Hook) {
+	c.hooks.StockMovement = append(c.hooks.StockMovement, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `location.Intercept(f(g(h())))`.
-func (c *LocationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Location = append(c.inters.Location, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `stockmovement.Intercept(f(g(h())))`.
+func (c *StockMovementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StockMovement = append(c.inters.StockMovement, interceptors...)
 }
 
-// Create returns a builder for creating a Location entity.
-func (c *LocationClient) Create() *LocationCreate {
-	mutation := newLocationMutation(c.config, OpCreate)
-	return &LocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a StockMovement entity.
+func (c *StockMovementClient) Create() *StockMovementCreate {
+	mutation := newStockMovementMutation(c.config, OpCreate)
+	return &StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Location entities.
-func (c *LocationClient) CreateBulk(builders ...*LocationCreate) *LocationCreateBulk {
-	return &LocationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of StockMovement entities.
+func (c *StockMovementClient) CreateBulk(builders ...*StockMovementCreate) *StockMovementCreateBulk {
+	return &StockMovementCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LocationClient) MapCreateBulk(slice any, setFunc func(*LocationCreate, int)) *LocationCreateBulk {
+func (c *StockMovementClient) MapCreateBulk(slice any, setFunc func(*StockMovementCreate, int)) *StockMovementCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LocationCreateBulk{err: fmt.Errorf("calling to LocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StockMovementCreateBulk{err: fmt.Errorf("calling to StockMovementClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LocationCreate, rv.Len())
+	builders := make([]*StockMovementCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LocationCreateBulk{config: c.config, builders: builders}
+	return &StockMovementCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Location.
-func (c *LocationClient) Update() *LocationUpdate {
-	mutation := newLocationMutation(c.config, OpUpdate)
-	return &LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for StockMovement.
+func (c *StockMovementClient) Update() *StockMovementUpdate {
+	mutation := newStockMovementMutation(c.config, OpUpdate)
+	return &StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LocationClient) UpdateOne(_m *Location) *LocationUpdateOne {
-	mutation := newLocationMutation(c.config, OpUpdateOne, withLocation(_m))
-	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StockMovementClient) UpdateOne(_m *StockMovement) *StockMovementUpdateOne {
+	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovement(_m))
+	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LocationClient) UpdateOneID(id uuid.UUID) *LocationUpdateOne {
-	mutation := newLocationMutation(c.config, OpUpdateOne, withLocationID(id))
-	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StockMovementClient) UpdateOneID(id uuid.UUID) *StockMovementUpdateOne {
+	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovementID(id))
+	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Location.
-func (c *LocationClient) Delete() *LocationDelete {
-	mutation := newLocationMutation(c.config, OpDelete)
-	return &LocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StockMovement.
+func (c *StockMovementClient) Delete() *StockMovementDelete {
+	mutation := newStockMovementMutation(c.config, OpDelete)
+	return &StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LocationClient) DeleteOne(_m *Location) *LocationDeleteOne {
+func (c *StockMovementClient) DeleteOne(_m *StockMovement) *StockMovementDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LocationClient) DeleteOneID(id uuid.UUID) *LocationDeleteOne {
-	builder := c.Delete().Where(location.ID(id))
+func (c *StockMovementClient) DeleteOneID(id uuid.UUID) *StockMovementDeleteOne {
+	builder := c.Delete().Where(stockmovement.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LocationDeleteOne{builder}
+	return &StockMovementDeleteOne{builder}
 }
 
-// Query returns a query builder for Location.
-func (c *LocationClient) Query() *LocationQuery {
-	return &LocationQuery{
+// Query returns a query builder for StockMovement.
+func (c *StockMovementClient) Query() *StockMovementQuery {
+	return &StockMovementQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLocation},
+		ctx:    &QueryContext{Type: TypeStockMovement},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Location entity by its id.
-func (c *LocationClient) Get(ctx context.Context, id uuid.UUID) (*Location, error) {
-	return c.Query().Where(location.ID(id)).Only(ctx)
+// Get returns a StockMovement entity by its id.
+func (c *StockMovementClient) Get(ctx context.Context, id uuid.UUID) (*StockMovement, error) {
+	return c.Query().Where(stockmovement.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LocationClient) GetX(ctx context.Context, id uuid.UUID) *Location {
+func (c *StockMovementClient) GetX(ctx context.Context, id uuid.UUID) *StockMovement {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2652,47 +5004,15 @@ func (c *LocationClient) GetX(ctx context.Context, id uuid.UUID) *Location {
 	return obj
 }
 
-// QueryGroup queries the group edge of a Location.
-func (c *LocationClient) QueryGroup(_m *Location) *GroupQuery {
+// QueryGroup queries the group edge of a StockMovement.
+func (c *StockMovementClient) QueryGroup(_m *StockMovement) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
 			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, location.GroupTable, location.GroupColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryParent queries the parent edge of a Location.
-func (c *LocationClient) QueryParent(_m *Location) *LocationQuery {
-	query := (&LocationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
-			sqlgraph.To(location.Table, location.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, location.ParentTable, location.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChildren queries the children edge of a Location.
-func (c *LocationClient) QueryChildren(_m *Location) *LocationQuery {
-	query := (&LocationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
-			sqlgraph.To(location.Table, location.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, location.ChildrenTable, location.ChildrenColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.GroupTable, stockmovement.GroupColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2700,15 +5020,15 @@ func (c *LocationClient) QueryChildren(_m *Location) *LocationQuery {
 	return query
 }
 
-// QueryItems queries the items edge of a Location.
-func (c *LocationClient) QueryItems(_m *Location) *ItemQuery {
+// QueryItem queries the item edge of a StockMovement.
+func (c *StockMovementClient) QueryItem(_m *StockMovement) *ItemQuery {
 	query := (&ItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
 			sqlgraph.To(item.Table, item.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, location.ItemsTable, location.ItemsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.ItemTable, stockmovement.ItemColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2717,131 +5037,131 @@ func (c *LocationClient) QueryItems(_m *Location) *ItemQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LocationClient) Hooks() []Hook {
-	return c.hooks.Location
+func (c *StockMovementClient) Hooks() []Hook {
+	return c.hooks.StockMovement
 }
 
 // Interceptors returns the client interceptors.
-func (c *LocationClient) Interceptors() []Interceptor {
-	return c.inters.Location
+func (c *StockMovementClient) Interceptors() []Interceptor {
+	return c.inters.StockMovement
 }
 
-func (c *LocationClient) mutate(ctx context.Context, m *LocationMutation) (Value, error) {
+func (c *StockMovementClient) mutate(ctx context.Context, m *StockMovementMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Location mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StockMovement mutation op: %q", m.Op())
 	}
 }
 
-// MaintenanceEntryClient is a client for the MaintenanceEntry schema.
-type MaintenanceEntryClient struct {
+// StocktakeEntryClient is a client for the StocktakeEntry schema.
+type StocktakeEntryClient struct {
 	config
 }
 
-// NewMaintenanceEntryClient returns a client for the MaintenanceEntry from the given config.
-func NewMaintenanceEntryClient(c config) *MaintenanceEntryClient {
-	return &MaintenanceEntryClient{config: c}
+// NewStocktakeEntryClient returns a client for the StocktakeEntry from the given config.
+func NewStocktakeEntryClient(c config) *StocktakeEntryClient {
+	return &StocktakeEntryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `maintenanceentry.Hooks(f(g(h())))`.
-func (c *MaintenanceEntryClient) Use(hooks ...Hook) {
-	c.hooks.MaintenanceEntry = append(c.hooks.MaintenanceEntry, hooks...)
+// A call to `Use(f, g, h)` equals to `stocktakeentry.Hooks(f(g(h())))`.
+func (c *StocktakeEntryClient) Use(hooks ...Hook) {
+	c.hooks.StocktakeEntry = append(c.hooks.StocktakeEntry, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `maintenanceentry.Intercept(f(g(h())))`.
-func (c *MaintenanceEntryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MaintenanceEntry = append(c.inters.MaintenanceEntry, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `stocktakeentry.Intercept(f(g(h())))`.
+func (c *StocktakeEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StocktakeEntry = append(c.inters.StocktakeEntry, interceptors...)
 }
 
-// Create returns a builder for creating a MaintenanceEntry entity.
-func (c *MaintenanceEntryClient) Create() *MaintenanceEntryCreate {
-	mutation := newMaintenanceEntryMutation(c.config, OpCreate)
-	return &MaintenanceEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a StocktakeEntry entity.
+func (c *StocktakeEntryClient) Create() *StocktakeEntryCreate {
+	mutation := newStocktakeEntryMutation(c.config, OpCreate)
+	return &StocktakeEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MaintenanceEntry entities.
-func (c *MaintenanceEntryClient) CreateBulk(builders ...*MaintenanceEntryCreate) *MaintenanceEntryCreateBulk {
-	return &MaintenanceEntryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of StocktakeEntry entities.
+func (c *StocktakeEntryClient) CreateBulk(builders ...*StocktakeEntryCreate) *StocktakeEntryCreateBulk {
+	return &StocktakeEntryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MaintenanceEntryClient) MapCreateBulk(slice any, setFunc func(*MaintenanceEntryCreate, int)) *MaintenanceEntryCreateBulk {
+func (c *StocktakeEntryClient) MapCreateBulk(slice any, setFunc func(*StocktakeEntryCreate, int)) *StocktakeEntryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MaintenanceEntryCreateBulk{err: fmt.Errorf("calling to MaintenanceEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StocktakeEntryCreateBulk{err: fmt.Errorf("calling to StocktakeEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MaintenanceEntryCreate, rv.Len())
+	builders := make([]*StocktakeEntryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MaintenanceEntryCreateBulk{config: c.config, builders: builders}
+	return &StocktakeEntryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MaintenanceEntry.
-func (c *MaintenanceEntryClient) Update() *MaintenanceEntryUpdate {
-	mutation := newMaintenanceEntryMutation(c.config, OpUpdate)
-	return &MaintenanceEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for StocktakeEntry.
+func (c *StocktakeEntryClient) Update() *StocktakeEntryUpdate {
+	mutation := newStocktakeEntryMutation(c.config, OpUpdate)
+	return &StocktakeEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MaintenanceEntryClient) UpdateOne(_m *MaintenanceEntry) *MaintenanceEntryUpdateOne {
-	mutation := newMaintenanceEntryMutation(c.config, OpUpdateOne, withMaintenanceEntry(_m))
-	return &MaintenanceEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StocktakeEntryClient) UpdateOne(_m *StocktakeEntry) *StocktakeEntryUpdateOne {
+	mutation := newStocktakeEntryMutation(c.config, OpUpdateOne, withStocktakeEntry(_m))
+	return &StocktakeEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MaintenanceEntryClient) UpdateOneID(id uuid.UUID) *MaintenanceEntryUpdateOne {
-	mutation := newMaintenanceEntryMutation(c.config, OpUpdateOne, withMaintenanceEntryID(id))
-	return &MaintenanceEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StocktakeEntryClient) UpdateOneID(id uuid.UUID) *StocktakeEntryUpdateOne {
+	mutation := newStocktakeEntryMutation(c.config, OpUpdateOne, withStocktakeEntryID(id))
+	return &StocktakeEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MaintenanceEntry.
-func (c *MaintenanceEntryClient) Delete() *MaintenanceEntryDelete {
-	mutation := newMaintenanceEntryMutation(c.config, OpDelete)
-	return &MaintenanceEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StocktakeEntry.
+func (c *StocktakeEntryClient) Delete() *StocktakeEntryDelete {
+	mutation := newStocktakeEntryMutation(c.config, OpDelete)
+	return &StocktakeEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MaintenanceEntryClient) DeleteOne(_m *MaintenanceEntry) *MaintenanceEntryDeleteOne {
+func (c *StocktakeEntryClient) DeleteOne(_m *StocktakeEntry) *StocktakeEntryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MaintenanceEntryClient) DeleteOneID(id uuid.UUID) *MaintenanceEntryDeleteOne {
-	builder := c.Delete().Where(maintenanceentry.ID(id))
+func (c *StocktakeEntryClient) DeleteOneID(id uuid.UUID) *StocktakeEntryDeleteOne {
+	builder := c.Delete().Where(stocktakeentry.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MaintenanceEntryDeleteOne{builder}
+	return &StocktakeEntryDeleteOne{builder}
 }
 
-// Query returns a query builder for MaintenanceEntry.
-func (c *MaintenanceEntryClient) Query() *MaintenanceEntryQuery {
-	return &MaintenanceEntryQuery{
+// Query returns a query builder for StocktakeEntry.
+func (c *StocktakeEntryClient) Query() *StocktakeEntryQuery {
+	return &StocktakeEntryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMaintenanceEntry},
+		ctx:    &QueryContext{Type: TypeStocktakeEntry},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MaintenanceEntry entity by its id.
-func (c *MaintenanceEntryClient) Get(ctx context.Context, id uuid.UUID) (*MaintenanceEntry, error) {
-	return c.Query().Where(maintenanceentry.ID(id)).Only(ctx)
+// Get returns a StocktakeEntry entity by its id.
+func (c *StocktakeEntryClient) Get(ctx context.Context, id uuid.UUID) (*StocktakeEntry, error) {
+	return c.Query().Where(stocktakeentry.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MaintenanceEntryClient) GetX(ctx context.Context, id uuid.UUID) *MaintenanceEntry {
+func (c *StocktakeEntryClient) GetX(ctx context.Context, id uuid.UUID) *StocktakeEntry {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2849,15 +5169,31 @@ func (c *MaintenanceEntryClient) GetX(ctx context.Context, id uuid.UUID) *Mainte
 	return obj
 }
 
-// QueryItem queries the item edge of a MaintenanceEntry.
-func (c *MaintenanceEntryClient) QueryItem(_m *MaintenanceEntry) *ItemQuery {
+// QuerySession queries the session edge of a StocktakeEntry.
+func (c *StocktakeEntryClient) QuerySession(_m *StocktakeEntry) *StocktakeSessionQuery {
+	query := (&StocktakeSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stocktakeentry.Table, stocktakeentry.FieldID, id),
+			sqlgraph.To(stocktakesession.Table, stocktakesession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stocktakeentry.SessionTable, stocktakeentry.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItem queries the item edge of a StocktakeEntry.
+func (c *StocktakeEntryClient) QueryItem(_m *StocktakeEntry) *ItemQuery {
 	query := (&ItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(maintenanceentry.Table, maintenanceentry.FieldID, id),
+			sqlgraph.From(stocktakeentry.Table, stocktakeentry.FieldID, id),
 			sqlgraph.To(item.Table, item.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, maintenanceentry.ItemTable, maintenanceentry.ItemColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, stocktakeentry.ItemTable, stocktakeentry.ItemColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2866,131 +5202,131 @@ func (c *MaintenanceEntryClient) QueryItem(_m *MaintenanceEntry) *ItemQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *MaintenanceEntryClient) Hooks() []Hook {
-	return c.hooks.MaintenanceEntry
+func (c *StocktakeEntryClient) Hooks() []Hook {
+	return c.hooks.StocktakeEntry
 }
 
 // Interceptors returns the client interceptors.
-func (c *MaintenanceEntryClient) Interceptors() []Interceptor {
-	return c.inters.MaintenanceEntry
+func (c *StocktakeEntryClient) Interceptors() []Interceptor {
+	return c.inters.StocktakeEntry
 }
 
-func (c *MaintenanceEntryClient) mutate(ctx context.Context, m *MaintenanceEntryMutation) (Value, error) {
+func (c *StocktakeEntryClient) mutate(ctx context.Context, m *StocktakeEntryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MaintenanceEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StocktakeEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MaintenanceEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StocktakeEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MaintenanceEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StocktakeEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MaintenanceEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StocktakeEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MaintenanceEntry mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StocktakeEntry mutation op: %q", m.Op())
 	}
 }
 
-// NotifierClient is a client for the Notifier schema.
-type NotifierClient struct {
+// StocktakeSessionClient is a client for the StocktakeSession schema.
+type StocktakeSessionClient struct {
 	config
 }
 
-// NewNotifierClient returns a client for the Notifier from the given config.
-func NewNotifierClient(c config) *NotifierClient {
-	return &NotifierClient{config: c}
+// NewStocktakeSessionClient returns a client for the StocktakeSession from the given config.
+func NewStocktakeSessionClient(c config) *StocktakeSessionClient {
+	return &StocktakeSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notifier.Hooks(f(g(h())))`.
-func (c *NotifierClient) Use(hooks ...Hook) {
-	c.hooks.Notifier = append(c.hooks.Notifier, hooks...)
+// A call to `Use(f, g, h)` equals to `stocktakesession.Hooks(f(g(h())))`.
+func (c *StocktakeSessionClient) Use(hooks ...Hook) {
+	c.hooks.StocktakeSession = append(c.hooks.StocktakeSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notifier.Intercept(f(g(h())))`.
-func (c *NotifierClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Notifier = append(c.inters.Notifier, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `stocktakesession.Intercept(f(g(h())))`.
+func (c *StocktakeSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StocktakeSession = append(c.inters.StocktakeSession, interceptors...)
 }
 
-// Create returns a builder for creating a Notifier entity.
-func (c *NotifierClient) Create() *NotifierCreate {
-	mutation := newNotifierMutation(c.config, OpCreate)
-	return &NotifierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a StocktakeSession entity.
+func (c *StocktakeSessionClient) Create() *StocktakeSessionCreate {
+	mutation := newStocktakeSessionMutation(c.config, OpCreate)
+	return &StocktakeSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Notifier entities.
-func (c *NotifierClient) CreateBulk(builders ...*NotifierCreate) *NotifierCreateBulk {
-	return &NotifierCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of StocktakeSession entities.
+func (c *StocktakeSessionClient) CreateBulk(builders ...*StocktakeSessionCreate) *StocktakeSessionCreateBulk {
+	return &StocktakeSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotifierClient) MapCreateBulk(slice any, setFunc func(*NotifierCreate, int)) *NotifierCreateBulk {
+func (c *StocktakeSessionClient) MapCreateBulk(slice any, setFunc func(*StocktakeSessionCreate, int)) *StocktakeSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotifierCreateBulk{err: fmt.Errorf("calling to NotifierClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StocktakeSessionCreateBulk{err: fmt.Errorf("calling to StocktakeSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotifierCreate, rv.Len())
+	builders := make([]*StocktakeSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotifierCreateBulk{config: c.config, builders: builders}
+	return &StocktakeSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Notifier.
-func (c *NotifierClient) Update() *NotifierUpdate {
-	mutation := newNotifierMutation(c.config, OpUpdate)
-	return &NotifierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for StocktakeSession.
+func (c *StocktakeSessionClient) Update() *StocktakeSessionUpdate {
+	mutation := newStocktakeSessionMutation(c.config, OpUpdate)
+	return &StocktakeSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotifierClient) UpdateOne(_m *Notifier) *NotifierUpdateOne {
-	mutation := newNotifierMutation(c.config, OpUpdateOne, withNotifier(_m))
-	return &NotifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StocktakeSessionClient) UpdateOne(_m *StocktakeSession) *StocktakeSessionUpdateOne {
+	mutation := newStocktakeSessionMutation(c.config, OpUpdateOne, withStocktakeSession(_m))
+	return &StocktakeSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotifierClient) UpdateOneID(id uuid.UUID) *NotifierUpdateOne {
-	mutation := newNotifierMutation(c.config, OpUpdateOne, withNotifierID(id))
-	return &NotifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StocktakeSessionClient) UpdateOneID(id uuid.UUID) *StocktakeSessionUpdateOne {
+	mutation := newStocktakeSessionMutation(c.config, OpUpdateOne, withStocktakeSessionID(id))
+	return &StocktakeSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Notifier.
-func (c *NotifierClient) Delete() *NotifierDelete {
-	mutation := newNotifierMutation(c.config, OpDelete)
-	return &NotifierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StocktakeSession.
+func (c *StocktakeSessionClient) Delete() *StocktakeSessionDelete {
+	mutation := newStocktakeSessionMutation(c.config, OpDelete)
+	return &StocktakeSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotifierClient) DeleteOne(_m *Notifier) *NotifierDeleteOne {
+func (c *StocktakeSessionClient) DeleteOne(_m *StocktakeSession) *StocktakeSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotifierClient) DeleteOneID(id uuid.UUID) *NotifierDeleteOne {
-	builder := c.Delete().Where(notifier.ID(id))
+func (c *StocktakeSessionClient) DeleteOneID(id uuid.UUID) *StocktakeSessionDeleteOne {
+	builder := c.Delete().Where(stocktakesession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotifierDeleteOne{builder}
+	return &StocktakeSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Notifier.
-func (c *NotifierClient) Query() *NotifierQuery {
-	return &NotifierQuery{
+// Query returns a query builder for StocktakeSession.
+func (c *StocktakeSessionClient) Query() *StocktakeSessionQuery {
+	return &StocktakeSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotifier},
+		ctx:    &QueryContext{Type: TypeStocktakeSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Notifier entity by its id.
-func (c *NotifierClient) Get(ctx context.Context, id uuid.UUID) (*Notifier, error) {
-	return c.Query().Where(notifier.ID(id)).Only(ctx)
+// Get returns a StocktakeSession entity by its id.
+func (c *StocktakeSessionClient) Get(ctx context.Context, id uuid.UUID) (*StocktakeSession, error) {
+	return c.Query().Where(stocktakesession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotifierClient) GetX(ctx context.Context, id uuid.UUID) *Notifier {
+func (c *StocktakeSessionClient) GetX(ctx context.Context, id uuid.UUID) *StocktakeSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2998,15 +5334,15 @@ func (c *NotifierClient) GetX(ctx context.Context, id uuid.UUID) *Notifier {
 	return obj
 }
 
-// QueryGroup queries the group edge of a Notifier.
-func (c *NotifierClient) QueryGroup(_m *Notifier) *GroupQuery {
+// QueryGroup queries the group edge of a StocktakeSession.
+func (c *StocktakeSessionClient) QueryGroup(_m *StocktakeSession) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notifier.Table, notifier.FieldID, id),
+			sqlgraph.From(stocktakesession.Table, stocktakesession.FieldID, id),
 			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notifier.GroupTable, notifier.GroupColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, stocktakesession.GroupTable, stocktakesession.GroupColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3014,15 +5350,31 @@ func (c *NotifierClient) QueryGroup(_m *Notifier) *GroupQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a Notifier.
-func (c *NotifierClient) QueryUser(_m *Notifier) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryLocation queries the location edge of a StocktakeSession.
+func (c *StocktakeSessionClient) QueryLocation(_m *StocktakeSession) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notifier.Table, notifier.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notifier.UserTable, notifier.UserColumn),
+			sqlgraph.From(stocktakesession.Table, stocktakesession.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stocktakesession.LocationTable, stocktakesession.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntries queries the entries edge of a StocktakeSession.
+func (c *StocktakeSessionClient) QueryEntries(_m *StocktakeSession) *StocktakeEntryQuery {
+	query := (&StocktakeEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stocktakesession.Table, stocktakesession.FieldID, id),
+			sqlgraph.To(stocktakeentry.Table, stocktakeentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, stocktakesession.EntriesTable, stocktakesession.EntriesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3031,27 +5383,27 @@ func (c *NotifierClient) QueryUser(_m *Notifier) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *NotifierClient) Hooks() []Hook {
-	return c.hooks.Notifier
+func (c *StocktakeSessionClient) Hooks() []Hook {
+	return c.hooks.StocktakeSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotifierClient) Interceptors() []Interceptor {
-	return c.inters.Notifier
+func (c *StocktakeSessionClient) Interceptors() []Interceptor {
+	return c.inters.StocktakeSession
 }
 
-func (c *NotifierClient) mutate(ctx context.Context, m *NotifierMutation) (Value, error) {
+func (c *StocktakeSessionClient) mutate(ctx context.Context, m *StocktakeSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotifierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StocktakeSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotifierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StocktakeSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StocktakeSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotifierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StocktakeSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Notifier mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StocktakeSession mutation op: %q", m.Op())
 	}
 }
 
@@ -3360,6 +5712,22 @@ func (c *UserClient) QueryNotifiers(_m *User) *NotifierQuery {
 	return query
 }
 
+// QuerySavedSearches queries the saved_searches edge of a User.
+func (c *UserClient) QuerySavedSearches(_m *User) *SavedSearchQuery {
+	query := (&SavedSearchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SavedSearchesTable, user.SavedSearchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryCheckouts queries the checkouts edge of a User.
 func (c *UserClient) QueryCheckouts(_m *User) *LoanQuery {
 	query := (&LoanClient{config: c.config}).Query()
@@ -3436,13 +5804,18 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Attachment, AuthRoles, AuthTokens, Borrower, Group, GroupInvitationToken, Item,
-		ItemField, ItemTemplate, KioskSession, Label, Loan, Location, MaintenanceEntry,
-		Notifier, TemplateField, User []ent.Hook
+		Attachment, AuditEntry, AuthRoles, AuthTokens, Borrower, FieldDefinition, Group,
+		GroupInvitationToken, Item, ItemField, ItemIdentifier, ItemStatusChange,
+		ItemTemplate, KioskSession, KioskSyncAction, Label, LabelTemplate, Loan,
+		Location, MaintenanceEntry, Notifier, PrintJob, Printer, SavedSearch,
+		StockMovement, StocktakeEntry, StocktakeSession, TemplateField, User []ent.Hook
 	}
 	inters struct {
-		Attachment, AuthRoles, AuthTokens, Borrower, Group, GroupInvitationToken, Item,
-		ItemField, ItemTemplate, KioskSession, Label, Loan, Location, MaintenanceEntry,
-		Notifier, TemplateField, User []ent.Interceptor
+		Attachment, AuditEntry, AuthRoles, AuthTokens, Borrower, FieldDefinition, Group,
+		GroupInvitationToken, Item, ItemField, ItemIdentifier, ItemStatusChange,
+		ItemTemplate, KioskSession, KioskSyncAction, Label, LabelTemplate, Loan,
+		Location, MaintenanceEntry, Notifier, PrintJob, Printer, SavedSearch,
+		StockMovement, StocktakeEntry, StocktakeSession, TemplateField,
+		User []ent.Interceptor
 	}
 )
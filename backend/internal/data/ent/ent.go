@@ -13,20 +13,32 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/authroles"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/authtokens"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/fielddefinition"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemtemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/templatefield"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
@@ -90,20 +102,32 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			attachment.Table:           attachment.ValidColumn,
+			auditentry.Table:           auditentry.ValidColumn,
 			authroles.Table:            authroles.ValidColumn,
 			authtokens.Table:           authtokens.ValidColumn,
 			borrower.Table:             borrower.ValidColumn,
+			fielddefinition.Table:      fielddefinition.ValidColumn,
 			group.Table:                group.ValidColumn,
 			groupinvitationtoken.Table: groupinvitationtoken.ValidColumn,
 			item.Table:                 item.ValidColumn,
 			itemfield.Table:            itemfield.ValidColumn,
+			itemidentifier.Table:       itemidentifier.ValidColumn,
+			itemstatuschange.Table:     itemstatuschange.ValidColumn,
 			itemtemplate.Table:         itemtemplate.ValidColumn,
 			kiosksession.Table:         kiosksession.ValidColumn,
+			kiosksyncaction.Table:      kiosksyncaction.ValidColumn,
 			label.Table:                label.ValidColumn,
+			labeltemplate.Table:        labeltemplate.ValidColumn,
 			loan.Table:                 loan.ValidColumn,
 			location.Table:             location.ValidColumn,
 			maintenanceentry.Table:     maintenanceentry.ValidColumn,
 			notifier.Table:             notifier.ValidColumn,
+			printjob.Table:             printjob.ValidColumn,
+			printer.Table:              printer.ValidColumn,
+			savedsearch.Table:          savedsearch.ValidColumn,
+			stockmovement.Table:        stockmovement.ValidColumn,
+			stocktakeentry.Table:       stocktakeentry.ValidColumn,
+			stocktakesession.Table:     stocktakesession.ValidColumn,
 			templatefield.Table:        templatefield.ValidColumn,
 			user.Table:                 user.ValidColumn,
 		})
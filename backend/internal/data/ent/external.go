@@ -1,6 +1,7 @@
 package ent
 
 import (
+	"context"
 	"database/sql"
 
 	entsql "entgo.io/ent/dialect/sql"
@@ -11,3 +12,18 @@ import (
 func (c *Client) Sql() *sql.DB {
 	return c.driver.(*entsql.Driver).DB()
 }
+
+// Dialect returns the SQL dialect of the client's driver.
+func (c *Client) Dialect() string {
+	return c.driver.Dialect()
+}
+
+// QueryContext runs a raw query through the client's driver. Unlike Sql, it also works
+// for clients bound to a transaction, such as the client of a mutation inside a hook.
+func (c *Client) QueryContext(ctx context.Context, query string, args ...any) (*entsql.Rows, error) {
+	rows := &entsql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -26,6 +26,14 @@ type Group struct {
 	Name string `json:"name,omitempty"`
 	// Currency holds the value of the "currency" field.
 	Currency string `json:"currency,omitempty"`
+	// Prefix of the asset IDs, e.g. HB-
+	AssetIDPrefix string `json:"asset_id_prefix,omitempty"`
+	// Digits the asset IDs are zero padded to (0 = 000-000)
+	AssetIDWidth int `json:"asset_id_width,omitempty"`
+	// Check digit appended to asset IDs so that mis-scans are rejected
+	AssetIDCheckDigit group.AssetIDCheckDigit `json:"asset_id_check_digit,omitempty"`
+	// Highest asset ID when check digits were turned on, labels of those may lack one
+	AssetIDUncheckedMax int `json:"asset_id_unchecked_max,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges        GroupEdges `json:"edges"`
@@ -52,9 +60,31 @@ type GroupEdges struct {
 	Borrowers []*Borrower `json:"borrowers,omitempty"`
 	// Loans holds the value of the loans edge.
 	Loans []*Loan `json:"loans,omitempty"`
+	// KioskSyncActions holds the value of the kiosk_sync_actions edge.
+	KioskSyncActions []*KioskSyncAction `json:"kiosk_sync_actions,omitempty"`
+	// SavedSearches holds the value of the saved_searches edge.
+	SavedSearches []*SavedSearch `json:"saved_searches,omitempty"`
+	// AuditEntries holds the value of the audit_entries edge.
+	AuditEntries []*AuditEntry `json:"audit_entries,omitempty"`
+	// FieldDefinitions holds the value of the field_definitions edge.
+	FieldDefinitions []*FieldDefinition `json:"field_definitions,omitempty"`
+	// StockMovements holds the value of the stock_movements edge.
+	StockMovements []*StockMovement `json:"stock_movements,omitempty"`
+	// ItemIdentifiers holds the value of the item_identifiers edge.
+	ItemIdentifiers []*ItemIdentifier `json:"item_identifiers,omitempty"`
+	// ItemStatusChanges holds the value of the item_status_changes edge.
+	ItemStatusChanges []*ItemStatusChange `json:"item_status_changes,omitempty"`
+	// StocktakeSessions holds the value of the stocktake_sessions edge.
+	StocktakeSessions []*StocktakeSession `json:"stocktake_sessions,omitempty"`
+	// LabelTemplates holds the value of the label_templates edge.
+	LabelTemplates []*LabelTemplate `json:"label_templates,omitempty"`
+	// Printers holds the value of the printers edge.
+	Printers []*Printer `json:"printers,omitempty"`
+	// PrintJobs holds the value of the print_jobs edge.
+	PrintJobs []*PrintJob `json:"print_jobs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [9]bool
+	loadedTypes [20]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -138,12 +168,113 @@ func (e GroupEdges) LoansOrErr() ([]*Loan, error) {
 	return nil, &NotLoadedError{edge: "loans"}
 }
 
+// KioskSyncActionsOrErr returns the KioskSyncActions value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) KioskSyncActionsOrErr() ([]*KioskSyncAction, error) {
+	if e.loadedTypes[9] {
+		return e.KioskSyncActions, nil
+	}
+	return nil, &NotLoadedError{edge: "kiosk_sync_actions"}
+}
+
+// SavedSearchesOrErr returns the SavedSearches value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) SavedSearchesOrErr() ([]*SavedSearch, error) {
+	if e.loadedTypes[10] {
+		return e.SavedSearches, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_searches"}
+}
+
+// AuditEntriesOrErr returns the AuditEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) AuditEntriesOrErr() ([]*AuditEntry, error) {
+	if e.loadedTypes[11] {
+		return e.AuditEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_entries"}
+}
+
+// FieldDefinitionsOrErr returns the FieldDefinitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) FieldDefinitionsOrErr() ([]*FieldDefinition, error) {
+	if e.loadedTypes[12] {
+		return e.FieldDefinitions, nil
+	}
+	return nil, &NotLoadedError{edge: "field_definitions"}
+}
+
+// StockMovementsOrErr returns the StockMovements value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) StockMovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[13] {
+		return e.StockMovements, nil
+	}
+	return nil, &NotLoadedError{edge: "stock_movements"}
+}
+
+// ItemIdentifiersOrErr returns the ItemIdentifiers value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) ItemIdentifiersOrErr() ([]*ItemIdentifier, error) {
+	if e.loadedTypes[14] {
+		return e.ItemIdentifiers, nil
+	}
+	return nil, &NotLoadedError{edge: "item_identifiers"}
+}
+
+// ItemStatusChangesOrErr returns the ItemStatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) ItemStatusChangesOrErr() ([]*ItemStatusChange, error) {
+	if e.loadedTypes[15] {
+		return e.ItemStatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "item_status_changes"}
+}
+
+// StocktakeSessionsOrErr returns the StocktakeSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) StocktakeSessionsOrErr() ([]*StocktakeSession, error) {
+	if e.loadedTypes[16] {
+		return e.StocktakeSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "stocktake_sessions"}
+}
+
+// LabelTemplatesOrErr returns the LabelTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) LabelTemplatesOrErr() ([]*LabelTemplate, error) {
+	if e.loadedTypes[17] {
+		return e.LabelTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "label_templates"}
+}
+
+// PrintersOrErr returns the Printers value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) PrintersOrErr() ([]*Printer, error) {
+	if e.loadedTypes[18] {
+		return e.Printers, nil
+	}
+	return nil, &NotLoadedError{edge: "printers"}
+}
+
+// PrintJobsOrErr returns the PrintJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) PrintJobsOrErr() ([]*PrintJob, error) {
+	if e.loadedTypes[19] {
+		return e.PrintJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "print_jobs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case group.FieldName, group.FieldCurrency:
+		case group.FieldAssetIDWidth, group.FieldAssetIDUncheckedMax:
+			values[i] = new(sql.NullInt64)
+		case group.FieldName, group.FieldCurrency, group.FieldAssetIDPrefix, group.FieldAssetIDCheckDigit:
 			values[i] = new(sql.NullString)
 		case group.FieldCreatedAt, group.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -194,6 +325,30 @@ func (_m *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Currency = value.String
 			}
+		case group.FieldAssetIDPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id_prefix", values[i])
+			} else if value.Valid {
+				_m.AssetIDPrefix = value.String
+			}
+		case group.FieldAssetIDWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id_width", values[i])
+			} else if value.Valid {
+				_m.AssetIDWidth = int(value.Int64)
+			}
+		case group.FieldAssetIDCheckDigit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id_check_digit", values[i])
+			} else if value.Valid {
+				_m.AssetIDCheckDigit = group.AssetIDCheckDigit(value.String)
+			}
+		case group.FieldAssetIDUncheckedMax:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id_unchecked_max", values[i])
+			} else if value.Valid {
+				_m.AssetIDUncheckedMax = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -252,6 +407,61 @@ func (_m *Group) QueryLoans() *LoanQuery {
 	return NewGroupClient(_m.config).QueryLoans(_m)
 }
 
+// QueryKioskSyncActions queries the "kiosk_sync_actions" edge of the Group entity.
+func (_m *Group) QueryKioskSyncActions() *KioskSyncActionQuery {
+	return NewGroupClient(_m.config).QueryKioskSyncActions(_m)
+}
+
+// QuerySavedSearches queries the "saved_searches" edge of the Group entity.
+func (_m *Group) QuerySavedSearches() *SavedSearchQuery {
+	return NewGroupClient(_m.config).QuerySavedSearches(_m)
+}
+
+// QueryAuditEntries queries the "audit_entries" edge of the Group entity.
+func (_m *Group) QueryAuditEntries() *AuditEntryQuery {
+	return NewGroupClient(_m.config).QueryAuditEntries(_m)
+}
+
+// QueryFieldDefinitions queries the "field_definitions" edge of the Group entity.
+func (_m *Group) QueryFieldDefinitions() *FieldDefinitionQuery {
+	return NewGroupClient(_m.config).QueryFieldDefinitions(_m)
+}
+
+// QueryStockMovements queries the "stock_movements" edge of the Group entity.
+func (_m *Group) QueryStockMovements() *StockMovementQuery {
+	return NewGroupClient(_m.config).QueryStockMovements(_m)
+}
+
+// QueryItemIdentifiers queries the "item_identifiers" edge of the Group entity.
+func (_m *Group) QueryItemIdentifiers() *ItemIdentifierQuery {
+	return NewGroupClient(_m.config).QueryItemIdentifiers(_m)
+}
+
+// QueryItemStatusChanges queries the "item_status_changes" edge of the Group entity.
+func (_m *Group) QueryItemStatusChanges() *ItemStatusChangeQuery {
+	return NewGroupClient(_m.config).QueryItemStatusChanges(_m)
+}
+
+// QueryStocktakeSessions queries the "stocktake_sessions" edge of the Group entity.
+func (_m *Group) QueryStocktakeSessions() *StocktakeSessionQuery {
+	return NewGroupClient(_m.config).QueryStocktakeSessions(_m)
+}
+
+// QueryLabelTemplates queries the "label_templates" edge of the Group entity.
+func (_m *Group) QueryLabelTemplates() *LabelTemplateQuery {
+	return NewGroupClient(_m.config).QueryLabelTemplates(_m)
+}
+
+// QueryPrinters queries the "printers" edge of the Group entity.
+func (_m *Group) QueryPrinters() *PrinterQuery {
+	return NewGroupClient(_m.config).QueryPrinters(_m)
+}
+
+// QueryPrintJobs queries the "print_jobs" edge of the Group entity.
+func (_m *Group) QueryPrintJobs() *PrintJobQuery {
+	return NewGroupClient(_m.config).QueryPrintJobs(_m)
+}
+
 // Update returns a builder for updating this Group.
 // Note that you need to call Group.Unwrap() before calling this method if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -286,6 +496,18 @@ func (_m *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("currency=")
 	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("asset_id_prefix=")
+	builder.WriteString(_m.AssetIDPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("asset_id_width=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssetIDWidth))
+	builder.WriteString(", ")
+	builder.WriteString("asset_id_check_digit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssetIDCheckDigit))
+	builder.WriteString(", ")
+	builder.WriteString("asset_id_unchecked_max=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssetIDUncheckedMax))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package group
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -23,6 +24,14 @@ const (
 	FieldName = "name"
 	// FieldCurrency holds the string denoting the currency field in the database.
 	FieldCurrency = "currency"
+	// FieldAssetIDPrefix holds the string denoting the asset_id_prefix field in the database.
+	FieldAssetIDPrefix = "asset_id_prefix"
+	// FieldAssetIDWidth holds the string denoting the asset_id_width field in the database.
+	FieldAssetIDWidth = "asset_id_width"
+	// FieldAssetIDCheckDigit holds the string denoting the asset_id_check_digit field in the database.
+	FieldAssetIDCheckDigit = "asset_id_check_digit"
+	// FieldAssetIDUncheckedMax holds the string denoting the asset_id_unchecked_max field in the database.
+	FieldAssetIDUncheckedMax = "asset_id_unchecked_max"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
 	// EdgeLocations holds the string denoting the locations edge name in mutations.
@@ -41,6 +50,28 @@ const (
 	EdgeBorrowers = "borrowers"
 	// EdgeLoans holds the string denoting the loans edge name in mutations.
 	EdgeLoans = "loans"
+	// EdgeKioskSyncActions holds the string denoting the kiosk_sync_actions edge name in mutations.
+	EdgeKioskSyncActions = "kiosk_sync_actions"
+	// EdgeSavedSearches holds the string denoting the saved_searches edge name in mutations.
+	EdgeSavedSearches = "saved_searches"
+	// EdgeAuditEntries holds the string denoting the audit_entries edge name in mutations.
+	EdgeAuditEntries = "audit_entries"
+	// EdgeFieldDefinitions holds the string denoting the field_definitions edge name in mutations.
+	EdgeFieldDefinitions = "field_definitions"
+	// EdgeStockMovements holds the string denoting the stock_movements edge name in mutations.
+	EdgeStockMovements = "stock_movements"
+	// EdgeItemIdentifiers holds the string denoting the item_identifiers edge name in mutations.
+	EdgeItemIdentifiers = "item_identifiers"
+	// EdgeItemStatusChanges holds the string denoting the item_status_changes edge name in mutations.
+	EdgeItemStatusChanges = "item_status_changes"
+	// EdgeStocktakeSessions holds the string denoting the stocktake_sessions edge name in mutations.
+	EdgeStocktakeSessions = "stocktake_sessions"
+	// EdgeLabelTemplates holds the string denoting the label_templates edge name in mutations.
+	EdgeLabelTemplates = "label_templates"
+	// EdgePrinters holds the string denoting the printers edge name in mutations.
+	EdgePrinters = "printers"
+	// EdgePrintJobs holds the string denoting the print_jobs edge name in mutations.
+	EdgePrintJobs = "print_jobs"
 	// Table holds the table name of the group in the database.
 	Table = "groups"
 	// UsersTable is the table that holds the users relation/edge.
@@ -106,6 +137,83 @@ const (
 	LoansInverseTable = "loans"
 	// LoansColumn is the table column denoting the loans relation/edge.
 	LoansColumn = "group_loans"
+	// KioskSyncActionsTable is the table that holds the kiosk_sync_actions relation/edge.
+	KioskSyncActionsTable = "kiosk_sync_actions"
+	// KioskSyncActionsInverseTable is the table name for the KioskSyncAction entity.
+	// It exists in this package in order to avoid circular dependency with the "kiosksyncaction" package.
+	KioskSyncActionsInverseTable = "kiosk_sync_actions"
+	// KioskSyncActionsColumn is the table column denoting the kiosk_sync_actions relation/edge.
+	KioskSyncActionsColumn = "group_kiosk_sync_actions"
+	// SavedSearchesTable is the table that holds the saved_searches relation/edge.
+	SavedSearchesTable = "saved_searches"
+	// SavedSearchesInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchesInverseTable = "saved_searches"
+	// SavedSearchesColumn is the table column denoting the saved_searches relation/edge.
+	SavedSearchesColumn = "group_id"
+	// AuditEntriesTable is the table that holds the audit_entries relation/edge.
+	AuditEntriesTable = "audit_entries"
+	// AuditEntriesInverseTable is the table name for the AuditEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "auditentry" package.
+	AuditEntriesInverseTable = "audit_entries"
+	// AuditEntriesColumn is the table column denoting the audit_entries relation/edge.
+	AuditEntriesColumn = "group_id"
+	// FieldDefinitionsTable is the table that holds the field_definitions relation/edge.
+	FieldDefinitionsTable = "field_definitions"
+	// FieldDefinitionsInverseTable is the table name for the FieldDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "fielddefinition" package.
+	FieldDefinitionsInverseTable = "field_definitions"
+	// FieldDefinitionsColumn is the table column denoting the field_definitions relation/edge.
+	FieldDefinitionsColumn = "group_id"
+	// StockMovementsTable is the table that holds the stock_movements relation/edge.
+	StockMovementsTable = "stock_movements"
+	// StockMovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	StockMovementsInverseTable = "stock_movements"
+	// StockMovementsColumn is the table column denoting the stock_movements relation/edge.
+	StockMovementsColumn = "group_id"
+	// ItemIdentifiersTable is the table that holds the item_identifiers relation/edge.
+	ItemIdentifiersTable = "item_identifiers"
+	// ItemIdentifiersInverseTable is the table name for the ItemIdentifier entity.
+	// It exists in this package in order to avoid circular dependency with the "itemidentifier" package.
+	ItemIdentifiersInverseTable = "item_identifiers"
+	// ItemIdentifiersColumn is the table column denoting the item_identifiers relation/edge.
+	ItemIdentifiersColumn = "group_id"
+	// ItemStatusChangesTable is the table that holds the item_status_changes relation/edge.
+	ItemStatusChangesTable = "item_status_changes"
+	// ItemStatusChangesInverseTable is the table name for the ItemStatusChange entity.
+	// It exists in this package in order to avoid circular dependency with the "itemstatuschange" package.
+	ItemStatusChangesInverseTable = "item_status_changes"
+	// ItemStatusChangesColumn is the table column denoting the item_status_changes relation/edge.
+	ItemStatusChangesColumn = "group_id"
+	// StocktakeSessionsTable is the table that holds the stocktake_sessions relation/edge.
+	StocktakeSessionsTable = "stocktake_sessions"
+	// StocktakeSessionsInverseTable is the table name for the StocktakeSession entity.
+	// It exists in this package in order to avoid circular dependency with the "stocktakesession" package.
+	StocktakeSessionsInverseTable = "stocktake_sessions"
+	// StocktakeSessionsColumn is the table column denoting the stocktake_sessions relation/edge.
+	StocktakeSessionsColumn = "group_id"
+	// LabelTemplatesTable is the table that holds the label_templates relation/edge.
+	LabelTemplatesTable = "label_templates"
+	// LabelTemplatesInverseTable is the table name for the LabelTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "labeltemplate" package.
+	LabelTemplatesInverseTable = "label_templates"
+	// LabelTemplatesColumn is the table column denoting the label_templates relation/edge.
+	LabelTemplatesColumn = "group_id"
+	// PrintersTable is the table that holds the printers relation/edge.
+	PrintersTable = "printers"
+	// PrintersInverseTable is the table name for the Printer entity.
+	// It exists in this package in order to avoid circular dependency with the "printer" package.
+	PrintersInverseTable = "printers"
+	// PrintersColumn is the table column denoting the printers relation/edge.
+	PrintersColumn = "group_id"
+	// PrintJobsTable is the table that holds the print_jobs relation/edge.
+	PrintJobsTable = "print_jobs"
+	// PrintJobsInverseTable is the table name for the PrintJob entity.
+	// It exists in this package in order to avoid circular dependency with the "printjob" package.
+	PrintJobsInverseTable = "print_jobs"
+	// PrintJobsColumn is the table column denoting the print_jobs relation/edge.
+	PrintJobsColumn = "group_id"
 )
 
 // Columns holds all SQL columns for group fields.
@@ -115,6 +223,10 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldName,
 	FieldCurrency,
+	FieldAssetIDPrefix,
+	FieldAssetIDWidth,
+	FieldAssetIDCheckDigit,
+	FieldAssetIDUncheckedMax,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -138,10 +250,49 @@ var (
 	NameValidator func(string) error
 	// DefaultCurrency holds the default value on creation for the "currency" field.
 	DefaultCurrency string
+	// DefaultAssetIDPrefix holds the default value on creation for the "asset_id_prefix" field.
+	DefaultAssetIDPrefix string
+	// AssetIDPrefixValidator is a validator for the "asset_id_prefix" field. It is called by the builders before save.
+	AssetIDPrefixValidator func(string) error
+	// DefaultAssetIDWidth holds the default value on creation for the "asset_id_width" field.
+	DefaultAssetIDWidth int
+	// AssetIDWidthValidator is a validator for the "asset_id_width" field. It is called by the builders before save.
+	AssetIDWidthValidator func(int) error
+	// DefaultAssetIDUncheckedMax holds the default value on creation for the "asset_id_unchecked_max" field.
+	DefaultAssetIDUncheckedMax int
+	// AssetIDUncheckedMaxValidator is a validator for the "asset_id_unchecked_max" field. It is called by the builders before save.
+	AssetIDUncheckedMaxValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// AssetIDCheckDigit defines the type for the "asset_id_check_digit" enum field.
+type AssetIDCheckDigit string
+
+// AssetIDCheckDigitNone is the default value of the AssetIDCheckDigit enum.
+const DefaultAssetIDCheckDigit = AssetIDCheckDigitNone
+
+// AssetIDCheckDigit values.
+const (
+	AssetIDCheckDigitNone  AssetIDCheckDigit = "none"
+	AssetIDCheckDigitLuhn  AssetIDCheckDigit = "luhn"
+	AssetIDCheckDigitMod11 AssetIDCheckDigit = "mod11"
+)
+
+func (aicd AssetIDCheckDigit) String() string {
+	return string(aicd)
+}
+
+// AssetIDCheckDigitValidator is a validator for the "asset_id_check_digit" field enum values. It is called by the builders before save.
+func AssetIDCheckDigitValidator(aicd AssetIDCheckDigit) error {
+	switch aicd {
+	case AssetIDCheckDigitNone, AssetIDCheckDigitLuhn, AssetIDCheckDigitMod11:
+		return nil
+	default:
+		return fmt.Errorf("group: invalid enum value for asset_id_check_digit field: %q", aicd)
+	}
+}
+
 // OrderOption defines the ordering options for the Group queries.
 type OrderOption func(*sql.Selector)
 
@@ -170,6 +321,26 @@ func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
 }
 
+// ByAssetIDPrefix orders the results by the asset_id_prefix field.
+func ByAssetIDPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetIDPrefix, opts...).ToFunc()
+}
+
+// ByAssetIDWidth orders the results by the asset_id_width field.
+func ByAssetIDWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetIDWidth, opts...).ToFunc()
+}
+
+// ByAssetIDCheckDigit orders the results by the asset_id_check_digit field.
+func ByAssetIDCheckDigit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetIDCheckDigit, opts...).ToFunc()
+}
+
+// ByAssetIDUncheckedMax orders the results by the asset_id_unchecked_max field.
+func ByAssetIDUncheckedMax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetIDUncheckedMax, opts...).ToFunc()
+}
+
 // ByUsersCount orders the results by users count.
 func ByUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -295,6 +466,160 @@ func ByLoans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLoansStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByKioskSyncActionsCount orders the results by kiosk_sync_actions count.
+func ByKioskSyncActionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKioskSyncActionsStep(), opts...)
+	}
+}
+
+// ByKioskSyncActions orders the results by kiosk_sync_actions terms.
+func ByKioskSyncActions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKioskSyncActionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedSearchesCount orders the results by saved_searches count.
+func BySavedSearchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedSearchesStep(), opts...)
+	}
+}
+
+// BySavedSearches orders the results by saved_searches terms.
+func BySavedSearches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAuditEntriesCount orders the results by audit_entries count.
+func ByAuditEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuditEntriesStep(), opts...)
+	}
+}
+
+// ByAuditEntries orders the results by audit_entries terms.
+func ByAuditEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuditEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFieldDefinitionsCount orders the results by field_definitions count.
+func ByFieldDefinitionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFieldDefinitionsStep(), opts...)
+	}
+}
+
+// ByFieldDefinitions orders the results by field_definitions terms.
+func ByFieldDefinitions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFieldDefinitionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStockMovementsCount orders the results by stock_movements count.
+func ByStockMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStockMovementsStep(), opts...)
+	}
+}
+
+// ByStockMovements orders the results by stock_movements terms.
+func ByStockMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemIdentifiersCount orders the results by item_identifiers count.
+func ByItemIdentifiersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemIdentifiersStep(), opts...)
+	}
+}
+
+// ByItemIdentifiers orders the results by item_identifiers terms.
+func ByItemIdentifiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemIdentifiersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemStatusChangesCount orders the results by item_status_changes count.
+func ByItemStatusChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemStatusChangesStep(), opts...)
+	}
+}
+
+// ByItemStatusChanges orders the results by item_status_changes terms.
+func ByItemStatusChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStatusChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStocktakeSessionsCount orders the results by stocktake_sessions count.
+func ByStocktakeSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStocktakeSessionsStep(), opts...)
+	}
+}
+
+// ByStocktakeSessions orders the results by stocktake_sessions terms.
+func ByStocktakeSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStocktakeSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLabelTemplatesCount orders the results by label_templates count.
+func ByLabelTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLabelTemplatesStep(), opts...)
+	}
+}
+
+// ByLabelTemplates orders the results by label_templates terms.
+func ByLabelTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLabelTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPrintersCount orders the results by printers count.
+func ByPrintersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPrintersStep(), opts...)
+	}
+}
+
+// ByPrinters orders the results by printers terms.
+func ByPrinters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrintersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPrintJobsCount orders the results by print_jobs count.
+func ByPrintJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPrintJobsStep(), opts...)
+	}
+}
+
+// ByPrintJobs orders the results by print_jobs terms.
+func ByPrintJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrintJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -358,3 +683,80 @@ func newLoansStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LoansTable, LoansColumn),
 	)
 }
+func newKioskSyncActionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KioskSyncActionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, KioskSyncActionsTable, KioskSyncActionsColumn),
+	)
+}
+func newSavedSearchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+	)
+}
+func newAuditEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuditEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuditEntriesTable, AuditEntriesColumn),
+	)
+}
+func newFieldDefinitionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FieldDefinitionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FieldDefinitionsTable, FieldDefinitionsColumn),
+	)
+}
+func newStockMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockMovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+	)
+}
+func newItemIdentifiersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemIdentifiersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemIdentifiersTable, ItemIdentifiersColumn),
+	)
+}
+func newItemStatusChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemStatusChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemStatusChangesTable, ItemStatusChangesColumn),
+	)
+}
+func newStocktakeSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StocktakeSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StocktakeSessionsTable, StocktakeSessionsColumn),
+	)
+}
+func newLabelTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LabelTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LabelTemplatesTable, LabelTemplatesColumn),
+	)
+}
+func newPrintersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrintersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PrintersTable, PrintersColumn),
+	)
+}
+func newPrintJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrintJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PrintJobsTable, PrintJobsColumn),
+	)
+}
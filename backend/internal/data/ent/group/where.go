@@ -76,6 +76,21 @@ func Currency(v string) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCurrency, v))
 }
 
+// AssetIDPrefix applies equality check predicate on the "asset_id_prefix" field. It's identical to AssetIDPrefixEQ.
+func AssetIDPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAssetIDPrefix, v))
+}
+
+// AssetIDWidth applies equality check predicate on the "asset_id_width" field. It's identical to AssetIDWidthEQ.
+func AssetIDWidth(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAssetIDWidth, v))
+}
+
+// AssetIDUncheckedMax applies equality check predicate on the "asset_id_unchecked_max" field. It's identical to AssetIDUncheckedMaxEQ.
+func AssetIDUncheckedMax(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAssetIDUncheckedMax, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -286,6 +301,171 @@ func CurrencyContainsFold(v string) predicate.Group {
 	return predicate.Group(sql.FieldContainsFold(FieldCurrency, v))
 }
 
+// AssetIDPrefixEQ applies the EQ predicate on the "asset_id_prefix" field.
+func AssetIDPrefixEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixNEQ applies the NEQ predicate on the "asset_id_prefix" field.
+func AssetIDPrefixNEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixIn applies the In predicate on the "asset_id_prefix" field.
+func AssetIDPrefixIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldAssetIDPrefix, vs...))
+}
+
+// AssetIDPrefixNotIn applies the NotIn predicate on the "asset_id_prefix" field.
+func AssetIDPrefixNotIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldAssetIDPrefix, vs...))
+}
+
+// AssetIDPrefixGT applies the GT predicate on the "asset_id_prefix" field.
+func AssetIDPrefixGT(v string) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixGTE applies the GTE predicate on the "asset_id_prefix" field.
+func AssetIDPrefixGTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixLT applies the LT predicate on the "asset_id_prefix" field.
+func AssetIDPrefixLT(v string) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixLTE applies the LTE predicate on the "asset_id_prefix" field.
+func AssetIDPrefixLTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixContains applies the Contains predicate on the "asset_id_prefix" field.
+func AssetIDPrefixContains(v string) predicate.Group {
+	return predicate.Group(sql.FieldContains(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixHasPrefix applies the HasPrefix predicate on the "asset_id_prefix" field.
+func AssetIDPrefixHasPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefix(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixHasSuffix applies the HasSuffix predicate on the "asset_id_prefix" field.
+func AssetIDPrefixHasSuffix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasSuffix(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixEqualFold applies the EqualFold predicate on the "asset_id_prefix" field.
+func AssetIDPrefixEqualFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldEqualFold(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixContainsFold applies the ContainsFold predicate on the "asset_id_prefix" field.
+func AssetIDPrefixContainsFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldContainsFold(FieldAssetIDPrefix, v))
+}
+
+// AssetIDWidthEQ applies the EQ predicate on the "asset_id_width" field.
+func AssetIDWidthEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAssetIDWidth, v))
+}
+
+// AssetIDWidthNEQ applies the NEQ predicate on the "asset_id_width" field.
+func AssetIDWidthNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldAssetIDWidth, v))
+}
+
+// AssetIDWidthIn applies the In predicate on the "asset_id_width" field.
+func AssetIDWidthIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldAssetIDWidth, vs...))
+}
+
+// AssetIDWidthNotIn applies the NotIn predicate on the "asset_id_width" field.
+func AssetIDWidthNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldAssetIDWidth, vs...))
+}
+
+// AssetIDWidthGT applies the GT predicate on the "asset_id_width" field.
+func AssetIDWidthGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldAssetIDWidth, v))
+}
+
+// AssetIDWidthGTE applies the GTE predicate on the "asset_id_width" field.
+func AssetIDWidthGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldAssetIDWidth, v))
+}
+
+// AssetIDWidthLT applies the LT predicate on the "asset_id_width" field.
+func AssetIDWidthLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldAssetIDWidth, v))
+}
+
+// AssetIDWidthLTE applies the LTE predicate on the "asset_id_width" field.
+func AssetIDWidthLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldAssetIDWidth, v))
+}
+
+// AssetIDCheckDigitEQ applies the EQ predicate on the "asset_id_check_digit" field.
+func AssetIDCheckDigitEQ(v AssetIDCheckDigit) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAssetIDCheckDigit, v))
+}
+
+// AssetIDCheckDigitNEQ applies the NEQ predicate on the "asset_id_check_digit" field.
+func AssetIDCheckDigitNEQ(v AssetIDCheckDigit) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldAssetIDCheckDigit, v))
+}
+
+// AssetIDCheckDigitIn applies the In predicate on the "asset_id_check_digit" field.
+func AssetIDCheckDigitIn(vs ...AssetIDCheckDigit) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldAssetIDCheckDigit, vs...))
+}
+
+// AssetIDCheckDigitNotIn applies the NotIn predicate on the "asset_id_check_digit" field.
+func AssetIDCheckDigitNotIn(vs ...AssetIDCheckDigit) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldAssetIDCheckDigit, vs...))
+}
+
+// AssetIDUncheckedMaxEQ applies the EQ predicate on the "asset_id_unchecked_max" field.
+func AssetIDUncheckedMaxEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAssetIDUncheckedMax, v))
+}
+
+// AssetIDUncheckedMaxNEQ applies the NEQ predicate on the "asset_id_unchecked_max" field.
+func AssetIDUncheckedMaxNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldAssetIDUncheckedMax, v))
+}
+
+// AssetIDUncheckedMaxIn applies the In predicate on the "asset_id_unchecked_max" field.
+func AssetIDUncheckedMaxIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldAssetIDUncheckedMax, vs...))
+}
+
+// AssetIDUncheckedMaxNotIn applies the NotIn predicate on the "asset_id_unchecked_max" field.
+func AssetIDUncheckedMaxNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldAssetIDUncheckedMax, vs...))
+}
+
+// AssetIDUncheckedMaxGT applies the GT predicate on the "asset_id_unchecked_max" field.
+func AssetIDUncheckedMaxGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldAssetIDUncheckedMax, v))
+}
+
+// AssetIDUncheckedMaxGTE applies the GTE predicate on the "asset_id_unchecked_max" field.
+func AssetIDUncheckedMaxGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldAssetIDUncheckedMax, v))
+}
+
+// AssetIDUncheckedMaxLT applies the LT predicate on the "asset_id_unchecked_max" field.
+func AssetIDUncheckedMaxLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldAssetIDUncheckedMax, v))
+}
+
+// AssetIDUncheckedMaxLTE applies the LTE predicate on the "asset_id_unchecked_max" field.
+func AssetIDUncheckedMaxLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldAssetIDUncheckedMax, v))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -493,6 +673,259 @@ func HasLoansWith(preds ...predicate.Loan) predicate.Group {
 	})
 }
 
+// HasKioskSyncActions applies the HasEdge predicate on the "kiosk_sync_actions" edge.
+func HasKioskSyncActions() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, KioskSyncActionsTable, KioskSyncActionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKioskSyncActionsWith applies the HasEdge predicate on the "kiosk_sync_actions" edge with a given conditions (other predicates).
+func HasKioskSyncActionsWith(preds ...predicate.KioskSyncAction) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newKioskSyncActionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearches applies the HasEdge predicate on the "saved_searches" edge.
+func HasSavedSearches() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchesWith applies the HasEdge predicate on the "saved_searches" edge with a given conditions (other predicates).
+func HasSavedSearchesWith(preds ...predicate.SavedSearch) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newSavedSearchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuditEntries applies the HasEdge predicate on the "audit_entries" edge.
+func HasAuditEntries() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuditEntriesTable, AuditEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuditEntriesWith applies the HasEdge predicate on the "audit_entries" edge with a given conditions (other predicates).
+func HasAuditEntriesWith(preds ...predicate.AuditEntry) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newAuditEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFieldDefinitions applies the HasEdge predicate on the "field_definitions" edge.
+func HasFieldDefinitions() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FieldDefinitionsTable, FieldDefinitionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFieldDefinitionsWith applies the HasEdge predicate on the "field_definitions" edge with a given conditions (other predicates).
+func HasFieldDefinitionsWith(preds ...predicate.FieldDefinition) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newFieldDefinitionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStockMovements applies the HasEdge predicate on the "stock_movements" edge.
+func HasStockMovements() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStockMovementsWith applies the HasEdge predicate on the "stock_movements" edge with a given conditions (other predicates).
+func HasStockMovementsWith(preds ...predicate.StockMovement) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newStockMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemIdentifiers applies the HasEdge predicate on the "item_identifiers" edge.
+func HasItemIdentifiers() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemIdentifiersTable, ItemIdentifiersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemIdentifiersWith applies the HasEdge predicate on the "item_identifiers" edge with a given conditions (other predicates).
+func HasItemIdentifiersWith(preds ...predicate.ItemIdentifier) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newItemIdentifiersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemStatusChanges applies the HasEdge predicate on the "item_status_changes" edge.
+func HasItemStatusChanges() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemStatusChangesTable, ItemStatusChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemStatusChangesWith applies the HasEdge predicate on the "item_status_changes" edge with a given conditions (other predicates).
+func HasItemStatusChangesWith(preds ...predicate.ItemStatusChange) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newItemStatusChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStocktakeSessions applies the HasEdge predicate on the "stocktake_sessions" edge.
+func HasStocktakeSessions() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StocktakeSessionsTable, StocktakeSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStocktakeSessionsWith applies the HasEdge predicate on the "stocktake_sessions" edge with a given conditions (other predicates).
+func HasStocktakeSessionsWith(preds ...predicate.StocktakeSession) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newStocktakeSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLabelTemplates applies the HasEdge predicate on the "label_templates" edge.
+func HasLabelTemplates() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LabelTemplatesTable, LabelTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLabelTemplatesWith applies the HasEdge predicate on the "label_templates" edge with a given conditions (other predicates).
+func HasLabelTemplatesWith(preds ...predicate.LabelTemplate) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newLabelTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrinters applies the HasEdge predicate on the "printers" edge.
+func HasPrinters() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PrintersTable, PrintersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrintersWith applies the HasEdge predicate on the "printers" edge with a given conditions (other predicates).
+func HasPrintersWith(preds ...predicate.Printer) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newPrintersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrintJobs applies the HasEdge predicate on the "print_jobs" edge.
+func HasPrintJobs() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PrintJobsTable, PrintJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrintJobsWith applies the HasEdge predicate on the "print_jobs" edge with a given conditions (other predicates).
+func HasPrintJobsWith(preds ...predicate.PrintJob) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newPrintJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Group) predicate.Group {
 	return predicate.Group(sql.AndPredicates(predicates...))
@@ -11,15 +11,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/fielddefinition"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -78,6 +89,62 @@ func (_c *GroupCreate) SetNillableCurrency(v *string) *GroupCreate {
 	return _c
 }
 
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_c *GroupCreate) SetAssetIDPrefix(v string) *GroupCreate {
+	_c.mutation.SetAssetIDPrefix(v)
+	return _c
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableAssetIDPrefix(v *string) *GroupCreate {
+	if v != nil {
+		_c.SetAssetIDPrefix(*v)
+	}
+	return _c
+}
+
+// SetAssetIDWidth sets the "asset_id_width" field.
+func (_c *GroupCreate) SetAssetIDWidth(v int) *GroupCreate {
+	_c.mutation.SetAssetIDWidth(v)
+	return _c
+}
+
+// SetNillableAssetIDWidth sets the "asset_id_width" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableAssetIDWidth(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetAssetIDWidth(*v)
+	}
+	return _c
+}
+
+// SetAssetIDCheckDigit sets the "asset_id_check_digit" field.
+func (_c *GroupCreate) SetAssetIDCheckDigit(v group.AssetIDCheckDigit) *GroupCreate {
+	_c.mutation.SetAssetIDCheckDigit(v)
+	return _c
+}
+
+// SetNillableAssetIDCheckDigit sets the "asset_id_check_digit" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableAssetIDCheckDigit(v *group.AssetIDCheckDigit) *GroupCreate {
+	if v != nil {
+		_c.SetAssetIDCheckDigit(*v)
+	}
+	return _c
+}
+
+// SetAssetIDUncheckedMax sets the "asset_id_unchecked_max" field.
+func (_c *GroupCreate) SetAssetIDUncheckedMax(v int) *GroupCreate {
+	_c.mutation.SetAssetIDUncheckedMax(v)
+	return _c
+}
+
+// SetNillableAssetIDUncheckedMax sets the "asset_id_unchecked_max" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableAssetIDUncheckedMax(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetAssetIDUncheckedMax(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *GroupCreate) SetID(v uuid.UUID) *GroupCreate {
 	_c.mutation.SetID(v)
@@ -227,6 +294,171 @@ func (_c *GroupCreate) AddLoans(v ...*Loan) *GroupCreate {
 	return _c.AddLoanIDs(ids...)
 }
 
+// AddKioskSyncActionIDs adds the "kiosk_sync_actions" edge to the KioskSyncAction entity by IDs.
+func (_c *GroupCreate) AddKioskSyncActionIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddKioskSyncActionIDs(ids...)
+	return _c
+}
+
+// AddKioskSyncActions adds the "kiosk_sync_actions" edges to the KioskSyncAction entity.
+func (_c *GroupCreate) AddKioskSyncActions(v ...*KioskSyncAction) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddKioskSyncActionIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_c *GroupCreate) AddSavedSearchIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddSavedSearchIDs(ids...)
+	return _c
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_c *GroupCreate) AddSavedSearches(v ...*SavedSearch) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSavedSearchIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (_c *GroupCreate) AddAuditEntryIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddAuditEntryIDs(ids...)
+	return _c
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (_c *GroupCreate) AddAuditEntries(v ...*AuditEntry) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAuditEntryIDs(ids...)
+}
+
+// AddFieldDefinitionIDs adds the "field_definitions" edge to the FieldDefinition entity by IDs.
+func (_c *GroupCreate) AddFieldDefinitionIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddFieldDefinitionIDs(ids...)
+	return _c
+}
+
+// AddFieldDefinitions adds the "field_definitions" edges to the FieldDefinition entity.
+func (_c *GroupCreate) AddFieldDefinitions(v ...*FieldDefinition) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFieldDefinitionIDs(ids...)
+}
+
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_c *GroupCreate) AddStockMovementIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddStockMovementIDs(ids...)
+	return _c
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_c *GroupCreate) AddStockMovements(v ...*StockMovement) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStockMovementIDs(ids...)
+}
+
+// AddItemIdentifierIDs adds the "item_identifiers" edge to the ItemIdentifier entity by IDs.
+func (_c *GroupCreate) AddItemIdentifierIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddItemIdentifierIDs(ids...)
+	return _c
+}
+
+// AddItemIdentifiers adds the "item_identifiers" edges to the ItemIdentifier entity.
+func (_c *GroupCreate) AddItemIdentifiers(v ...*ItemIdentifier) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIdentifierIDs(ids...)
+}
+
+// AddItemStatusChangeIDs adds the "item_status_changes" edge to the ItemStatusChange entity by IDs.
+func (_c *GroupCreate) AddItemStatusChangeIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddItemStatusChangeIDs(ids...)
+	return _c
+}
+
+// AddItemStatusChanges adds the "item_status_changes" edges to the ItemStatusChange entity.
+func (_c *GroupCreate) AddItemStatusChanges(v ...*ItemStatusChange) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemStatusChangeIDs(ids...)
+}
+
+// AddStocktakeSessionIDs adds the "stocktake_sessions" edge to the StocktakeSession entity by IDs.
+func (_c *GroupCreate) AddStocktakeSessionIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddStocktakeSessionIDs(ids...)
+	return _c
+}
+
+// AddStocktakeSessions adds the "stocktake_sessions" edges to the StocktakeSession entity.
+func (_c *GroupCreate) AddStocktakeSessions(v ...*StocktakeSession) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStocktakeSessionIDs(ids...)
+}
+
+// AddLabelTemplateIDs adds the "label_templates" edge to the LabelTemplate entity by IDs.
+func (_c *GroupCreate) AddLabelTemplateIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddLabelTemplateIDs(ids...)
+	return _c
+}
+
+// AddLabelTemplates adds the "label_templates" edges to the LabelTemplate entity.
+func (_c *GroupCreate) AddLabelTemplates(v ...*LabelTemplate) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLabelTemplateIDs(ids...)
+}
+
+// AddPrinterIDs adds the "printers" edge to the Printer entity by IDs.
+func (_c *GroupCreate) AddPrinterIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddPrinterIDs(ids...)
+	return _c
+}
+
+// AddPrinters adds the "printers" edges to the Printer entity.
+func (_c *GroupCreate) AddPrinters(v ...*Printer) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPrinterIDs(ids...)
+}
+
+// AddPrintJobIDs adds the "print_jobs" edge to the PrintJob entity by IDs.
+func (_c *GroupCreate) AddPrintJobIDs(ids ...uuid.UUID) *GroupCreate {
+	_c.mutation.AddPrintJobIDs(ids...)
+	return _c
+}
+
+// AddPrintJobs adds the "print_jobs" edges to the PrintJob entity.
+func (_c *GroupCreate) AddPrintJobs(v ...*PrintJob) *GroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPrintJobIDs(ids...)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (_c *GroupCreate) Mutation() *GroupMutation {
 	return _c.mutation
@@ -274,6 +506,22 @@ func (_c *GroupCreate) defaults() {
 		v := group.DefaultCurrency
 		_c.mutation.SetCurrency(v)
 	}
+	if _, ok := _c.mutation.AssetIDPrefix(); !ok {
+		v := group.DefaultAssetIDPrefix
+		_c.mutation.SetAssetIDPrefix(v)
+	}
+	if _, ok := _c.mutation.AssetIDWidth(); !ok {
+		v := group.DefaultAssetIDWidth
+		_c.mutation.SetAssetIDWidth(v)
+	}
+	if _, ok := _c.mutation.AssetIDCheckDigit(); !ok {
+		v := group.DefaultAssetIDCheckDigit
+		_c.mutation.SetAssetIDCheckDigit(v)
+	}
+	if _, ok := _c.mutation.AssetIDUncheckedMax(); !ok {
+		v := group.DefaultAssetIDUncheckedMax
+		_c.mutation.SetAssetIDUncheckedMax(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := group.DefaultID()
 		_c.mutation.SetID(v)
@@ -299,6 +547,38 @@ func (_c *GroupCreate) check() error {
 	if _, ok := _c.mutation.Currency(); !ok {
 		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Group.currency"`)}
 	}
+	if _, ok := _c.mutation.AssetIDPrefix(); !ok {
+		return &ValidationError{Name: "asset_id_prefix", err: errors.New(`ent: missing required field "Group.asset_id_prefix"`)}
+	}
+	if v, ok := _c.mutation.AssetIDPrefix(); ok {
+		if err := group.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_prefix": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AssetIDWidth(); !ok {
+		return &ValidationError{Name: "asset_id_width", err: errors.New(`ent: missing required field "Group.asset_id_width"`)}
+	}
+	if v, ok := _c.mutation.AssetIDWidth(); ok {
+		if err := group.AssetIDWidthValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_width", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_width": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AssetIDCheckDigit(); !ok {
+		return &ValidationError{Name: "asset_id_check_digit", err: errors.New(`ent: missing required field "Group.asset_id_check_digit"`)}
+	}
+	if v, ok := _c.mutation.AssetIDCheckDigit(); ok {
+		if err := group.AssetIDCheckDigitValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_check_digit", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_check_digit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AssetIDUncheckedMax(); !ok {
+		return &ValidationError{Name: "asset_id_unchecked_max", err: errors.New(`ent: missing required field "Group.asset_id_unchecked_max"`)}
+	}
+	if v, ok := _c.mutation.AssetIDUncheckedMax(); ok {
+		if err := group.AssetIDUncheckedMaxValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_unchecked_max", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_unchecked_max": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -350,6 +630,22 @@ func (_c *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldCurrency, field.TypeString, value)
 		_node.Currency = value
 	}
+	if value, ok := _c.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(group.FieldAssetIDPrefix, field.TypeString, value)
+		_node.AssetIDPrefix = value
+	}
+	if value, ok := _c.mutation.AssetIDWidth(); ok {
+		_spec.SetField(group.FieldAssetIDWidth, field.TypeInt, value)
+		_node.AssetIDWidth = value
+	}
+	if value, ok := _c.mutation.AssetIDCheckDigit(); ok {
+		_spec.SetField(group.FieldAssetIDCheckDigit, field.TypeEnum, value)
+		_node.AssetIDCheckDigit = value
+	}
+	if value, ok := _c.mutation.AssetIDUncheckedMax(); ok {
+		_spec.SetField(group.FieldAssetIDUncheckedMax, field.TypeInt, value)
+		_node.AssetIDUncheckedMax = value
+	}
 	if nodes := _c.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -494,6 +790,182 @@ func (_c *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.KioskSyncActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.KioskSyncActionsTable,
+			Columns: []string{group.KioskSyncActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FieldDefinitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.FieldDefinitionsTable,
+			Columns: []string{group.FieldDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fielddefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.StockMovementsTable,
+			Columns: []string{group.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIdentifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemIdentifiersTable,
+			Columns: []string{group.ItemIdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemStatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemStatusChangesTable,
+			Columns: []string{group.ItemStatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StocktakeSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.StocktakeSessionsTable,
+			Columns: []string{group.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LabelTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LabelTemplatesTable,
+			Columns: []string{group.LabelTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PrintersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.PrintersTable,
+			Columns: []string{group.PrintersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PrintJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.PrintJobsTable,
+			Columns: []string{group.PrintJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
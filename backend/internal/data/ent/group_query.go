@@ -13,35 +13,57 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/fielddefinition"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
 // GroupQuery is the builder for querying Group entities.
 type GroupQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []group.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.Group
-	withUsers            *UserQuery
-	withLocations        *LocationQuery
-	withItems            *ItemQuery
-	withLabels           *LabelQuery
-	withInvitationTokens *GroupInvitationTokenQuery
-	withNotifiers        *NotifierQuery
-	withItemTemplates    *ItemTemplateQuery
-	withBorrowers        *BorrowerQuery
-	withLoans            *LoanQuery
+	ctx                   *QueryContext
+	order                 []group.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Group
+	withUsers             *UserQuery
+	withLocations         *LocationQuery
+	withItems             *ItemQuery
+	withLabels            *LabelQuery
+	withInvitationTokens  *GroupInvitationTokenQuery
+	withNotifiers         *NotifierQuery
+	withItemTemplates     *ItemTemplateQuery
+	withBorrowers         *BorrowerQuery
+	withLoans             *LoanQuery
+	withKioskSyncActions  *KioskSyncActionQuery
+	withSavedSearches     *SavedSearchQuery
+	withAuditEntries      *AuditEntryQuery
+	withFieldDefinitions  *FieldDefinitionQuery
+	withStockMovements    *StockMovementQuery
+	withItemIdentifiers   *ItemIdentifierQuery
+	withItemStatusChanges *ItemStatusChangeQuery
+	withStocktakeSessions *StocktakeSessionQuery
+	withLabelTemplates    *LabelTemplateQuery
+	withPrinters          *PrinterQuery
+	withPrintJobs         *PrintJobQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -276,6 +298,248 @@ func (_q *GroupQuery) QueryLoans() *LoanQuery {
 	return query
 }
 
+// QueryKioskSyncActions chains the current query on the "kiosk_sync_actions" edge.
+func (_q *GroupQuery) QueryKioskSyncActions() *KioskSyncActionQuery {
+	query := (&KioskSyncActionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(kiosksyncaction.Table, kiosksyncaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.KioskSyncActionsTable, group.KioskSyncActionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedSearches chains the current query on the "saved_searches" edge.
+func (_q *GroupQuery) QuerySavedSearches() *SavedSearchQuery {
+	query := (&SavedSearchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.SavedSearchesTable, group.SavedSearchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuditEntries chains the current query on the "audit_entries" edge.
+func (_q *GroupQuery) QueryAuditEntries() *AuditEntryQuery {
+	query := (&AuditEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(auditentry.Table, auditentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.AuditEntriesTable, group.AuditEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFieldDefinitions chains the current query on the "field_definitions" edge.
+func (_q *GroupQuery) QueryFieldDefinitions() *FieldDefinitionQuery {
+	query := (&FieldDefinitionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(fielddefinition.Table, fielddefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.FieldDefinitionsTable, group.FieldDefinitionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStockMovements chains the current query on the "stock_movements" edge.
+func (_q *GroupQuery) QueryStockMovements() *StockMovementQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.StockMovementsTable, group.StockMovementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemIdentifiers chains the current query on the "item_identifiers" edge.
+func (_q *GroupQuery) QueryItemIdentifiers() *ItemIdentifierQuery {
+	query := (&ItemIdentifierClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(itemidentifier.Table, itemidentifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ItemIdentifiersTable, group.ItemIdentifiersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemStatusChanges chains the current query on the "item_status_changes" edge.
+func (_q *GroupQuery) QueryItemStatusChanges() *ItemStatusChangeQuery {
+	query := (&ItemStatusChangeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(itemstatuschange.Table, itemstatuschange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ItemStatusChangesTable, group.ItemStatusChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStocktakeSessions chains the current query on the "stocktake_sessions" edge.
+func (_q *GroupQuery) QueryStocktakeSessions() *StocktakeSessionQuery {
+	query := (&StocktakeSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(stocktakesession.Table, stocktakesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.StocktakeSessionsTable, group.StocktakeSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLabelTemplates chains the current query on the "label_templates" edge.
+func (_q *GroupQuery) QueryLabelTemplates() *LabelTemplateQuery {
+	query := (&LabelTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(labeltemplate.Table, labeltemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.LabelTemplatesTable, group.LabelTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrinters chains the current query on the "printers" edge.
+func (_q *GroupQuery) QueryPrinters() *PrinterQuery {
+	query := (&PrinterClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(printer.Table, printer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.PrintersTable, group.PrintersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrintJobs chains the current query on the "print_jobs" edge.
+func (_q *GroupQuery) QueryPrintJobs() *PrintJobQuery {
+	query := (&PrintJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(printjob.Table, printjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.PrintJobsTable, group.PrintJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Group entity from the query.
 // Returns a *NotFoundError when no Group was found.
 func (_q *GroupQuery) First(ctx context.Context) (*Group, error) {
@@ -463,20 +727,31 @@ func (_q *GroupQuery) Clone() *GroupQuery {
 		return nil
 	}
 	return &GroupQuery{
-		config:               _q.config,
-		ctx:                  _q.ctx.Clone(),
-		order:                append([]group.OrderOption{}, _q.order...),
-		inters:               append([]Interceptor{}, _q.inters...),
-		predicates:           append([]predicate.Group{}, _q.predicates...),
-		withUsers:            _q.withUsers.Clone(),
-		withLocations:        _q.withLocations.Clone(),
-		withItems:            _q.withItems.Clone(),
-		withLabels:           _q.withLabels.Clone(),
-		withInvitationTokens: _q.withInvitationTokens.Clone(),
-		withNotifiers:        _q.withNotifiers.Clone(),
-		withItemTemplates:    _q.withItemTemplates.Clone(),
-		withBorrowers:        _q.withBorrowers.Clone(),
-		withLoans:            _q.withLoans.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]group.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.Group{}, _q.predicates...),
+		withUsers:             _q.withUsers.Clone(),
+		withLocations:         _q.withLocations.Clone(),
+		withItems:             _q.withItems.Clone(),
+		withLabels:            _q.withLabels.Clone(),
+		withInvitationTokens:  _q.withInvitationTokens.Clone(),
+		withNotifiers:         _q.withNotifiers.Clone(),
+		withItemTemplates:     _q.withItemTemplates.Clone(),
+		withBorrowers:         _q.withBorrowers.Clone(),
+		withLoans:             _q.withLoans.Clone(),
+		withKioskSyncActions:  _q.withKioskSyncActions.Clone(),
+		withSavedSearches:     _q.withSavedSearches.Clone(),
+		withAuditEntries:      _q.withAuditEntries.Clone(),
+		withFieldDefinitions:  _q.withFieldDefinitions.Clone(),
+		withStockMovements:    _q.withStockMovements.Clone(),
+		withItemIdentifiers:   _q.withItemIdentifiers.Clone(),
+		withItemStatusChanges: _q.withItemStatusChanges.Clone(),
+		withStocktakeSessions: _q.withStocktakeSessions.Clone(),
+		withLabelTemplates:    _q.withLabelTemplates.Clone(),
+		withPrinters:          _q.withPrinters.Clone(),
+		withPrintJobs:         _q.withPrintJobs.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -582,6 +857,127 @@ func (_q *GroupQuery) WithLoans(opts ...func(*LoanQuery)) *GroupQuery {
 	return _q
 }
 
+// WithKioskSyncActions tells the query-builder to eager-load the nodes that are connected to
+// the "kiosk_sync_actions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithKioskSyncActions(opts ...func(*KioskSyncActionQuery)) *GroupQuery {
+	query := (&KioskSyncActionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withKioskSyncActions = query
+	return _q
+}
+
+// WithSavedSearches tells the query-builder to eager-load the nodes that are connected to
+// the "saved_searches" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithSavedSearches(opts ...func(*SavedSearchQuery)) *GroupQuery {
+	query := (&SavedSearchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSavedSearches = query
+	return _q
+}
+
+// WithAuditEntries tells the query-builder to eager-load the nodes that are connected to
+// the "audit_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithAuditEntries(opts ...func(*AuditEntryQuery)) *GroupQuery {
+	query := (&AuditEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuditEntries = query
+	return _q
+}
+
+// WithFieldDefinitions tells the query-builder to eager-load the nodes that are connected to
+// the "field_definitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithFieldDefinitions(opts ...func(*FieldDefinitionQuery)) *GroupQuery {
+	query := (&FieldDefinitionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFieldDefinitions = query
+	return _q
+}
+
+// WithStockMovements tells the query-builder to eager-load the nodes that are connected to
+// the "stock_movements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithStockMovements(opts ...func(*StockMovementQuery)) *GroupQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStockMovements = query
+	return _q
+}
+
+// WithItemIdentifiers tells the query-builder to eager-load the nodes that are connected to
+// the "item_identifiers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithItemIdentifiers(opts ...func(*ItemIdentifierQuery)) *GroupQuery {
+	query := (&ItemIdentifierClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItemIdentifiers = query
+	return _q
+}
+
+// WithItemStatusChanges tells the query-builder to eager-load the nodes that are connected to
+// the "item_status_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithItemStatusChanges(opts ...func(*ItemStatusChangeQuery)) *GroupQuery {
+	query := (&ItemStatusChangeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItemStatusChanges = query
+	return _q
+}
+
+// WithStocktakeSessions tells the query-builder to eager-load the nodes that are connected to
+// the "stocktake_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithStocktakeSessions(opts ...func(*StocktakeSessionQuery)) *GroupQuery {
+	query := (&StocktakeSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStocktakeSessions = query
+	return _q
+}
+
+// WithLabelTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "label_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithLabelTemplates(opts ...func(*LabelTemplateQuery)) *GroupQuery {
+	query := (&LabelTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLabelTemplates = query
+	return _q
+}
+
+// WithPrinters tells the query-builder to eager-load the nodes that are connected to
+// the "printers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithPrinters(opts ...func(*PrinterQuery)) *GroupQuery {
+	query := (&PrinterClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPrinters = query
+	return _q
+}
+
+// WithPrintJobs tells the query-builder to eager-load the nodes that are connected to
+// the "print_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupQuery) WithPrintJobs(opts ...func(*PrintJobQuery)) *GroupQuery {
+	query := (&PrintJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPrintJobs = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -660,7 +1056,7 @@ func (_q *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 	var (
 		nodes       = []*Group{}
 		_spec       = _q.querySpec()
-		loadedTypes = [9]bool{
+		loadedTypes = [20]bool{
 			_q.withUsers != nil,
 			_q.withLocations != nil,
 			_q.withItems != nil,
@@ -670,6 +1066,17 @@ func (_q *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 			_q.withItemTemplates != nil,
 			_q.withBorrowers != nil,
 			_q.withLoans != nil,
+			_q.withKioskSyncActions != nil,
+			_q.withSavedSearches != nil,
+			_q.withAuditEntries != nil,
+			_q.withFieldDefinitions != nil,
+			_q.withStockMovements != nil,
+			_q.withItemIdentifiers != nil,
+			_q.withItemStatusChanges != nil,
+			_q.withStocktakeSessions != nil,
+			_q.withLabelTemplates != nil,
+			_q.withPrinters != nil,
+			_q.withPrintJobs != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -755,9 +1162,86 @@ func (_q *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 			return nil, err
 		}
 	}
-	return nodes, nil
-}
-
+	if query := _q.withKioskSyncActions; query != nil {
+		if err := _q.loadKioskSyncActions(ctx, query, nodes,
+			func(n *Group) { n.Edges.KioskSyncActions = []*KioskSyncAction{} },
+			func(n *Group, e *KioskSyncAction) { n.Edges.KioskSyncActions = append(n.Edges.KioskSyncActions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSavedSearches; query != nil {
+		if err := _q.loadSavedSearches(ctx, query, nodes,
+			func(n *Group) { n.Edges.SavedSearches = []*SavedSearch{} },
+			func(n *Group, e *SavedSearch) { n.Edges.SavedSearches = append(n.Edges.SavedSearches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAuditEntries; query != nil {
+		if err := _q.loadAuditEntries(ctx, query, nodes,
+			func(n *Group) { n.Edges.AuditEntries = []*AuditEntry{} },
+			func(n *Group, e *AuditEntry) { n.Edges.AuditEntries = append(n.Edges.AuditEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFieldDefinitions; query != nil {
+		if err := _q.loadFieldDefinitions(ctx, query, nodes,
+			func(n *Group) { n.Edges.FieldDefinitions = []*FieldDefinition{} },
+			func(n *Group, e *FieldDefinition) { n.Edges.FieldDefinitions = append(n.Edges.FieldDefinitions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStockMovements; query != nil {
+		if err := _q.loadStockMovements(ctx, query, nodes,
+			func(n *Group) { n.Edges.StockMovements = []*StockMovement{} },
+			func(n *Group, e *StockMovement) { n.Edges.StockMovements = append(n.Edges.StockMovements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withItemIdentifiers; query != nil {
+		if err := _q.loadItemIdentifiers(ctx, query, nodes,
+			func(n *Group) { n.Edges.ItemIdentifiers = []*ItemIdentifier{} },
+			func(n *Group, e *ItemIdentifier) { n.Edges.ItemIdentifiers = append(n.Edges.ItemIdentifiers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withItemStatusChanges; query != nil {
+		if err := _q.loadItemStatusChanges(ctx, query, nodes,
+			func(n *Group) { n.Edges.ItemStatusChanges = []*ItemStatusChange{} },
+			func(n *Group, e *ItemStatusChange) { n.Edges.ItemStatusChanges = append(n.Edges.ItemStatusChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStocktakeSessions; query != nil {
+		if err := _q.loadStocktakeSessions(ctx, query, nodes,
+			func(n *Group) { n.Edges.StocktakeSessions = []*StocktakeSession{} },
+			func(n *Group, e *StocktakeSession) { n.Edges.StocktakeSessions = append(n.Edges.StocktakeSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLabelTemplates; query != nil {
+		if err := _q.loadLabelTemplates(ctx, query, nodes,
+			func(n *Group) { n.Edges.LabelTemplates = []*LabelTemplate{} },
+			func(n *Group, e *LabelTemplate) { n.Edges.LabelTemplates = append(n.Edges.LabelTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPrinters; query != nil {
+		if err := _q.loadPrinters(ctx, query, nodes,
+			func(n *Group) { n.Edges.Printers = []*Printer{} },
+			func(n *Group, e *Printer) { n.Edges.Printers = append(n.Edges.Printers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPrintJobs; query != nil {
+		if err := _q.loadPrintJobs(ctx, query, nodes,
+			func(n *Group) { n.Edges.PrintJobs = []*PrintJob{} },
+			func(n *Group, e *PrintJob) { n.Edges.PrintJobs = append(n.Edges.PrintJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
 func (_q *GroupQuery) loadUsers(ctx context.Context, query *UserQuery, nodes []*Group, init func(*Group), assign func(*Group, *User)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Group)
@@ -1036,6 +1520,337 @@ func (_q *GroupQuery) loadLoans(ctx context.Context, query *LoanQuery, nodes []*
 	}
 	return nil
 }
+func (_q *GroupQuery) loadKioskSyncActions(ctx context.Context, query *KioskSyncActionQuery, nodes []*Group, init func(*Group), assign func(*Group, *KioskSyncAction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.KioskSyncAction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.KioskSyncActionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.group_kiosk_sync_actions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "group_kiosk_sync_actions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_kiosk_sync_actions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQuery, nodes []*Group, init func(*Group), assign func(*Group, *SavedSearch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(savedsearch.FieldGroupID)
+	}
+	query.Where(predicate.SavedSearch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.SavedSearchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadAuditEntries(ctx context.Context, query *AuditEntryQuery, nodes []*Group, init func(*Group), assign func(*Group, *AuditEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(auditentry.FieldGroupID)
+	}
+	query.Where(predicate.AuditEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.AuditEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadFieldDefinitions(ctx context.Context, query *FieldDefinitionQuery, nodes []*Group, init func(*Group), assign func(*Group, *FieldDefinition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(fielddefinition.FieldGroupID)
+	}
+	query.Where(predicate.FieldDefinition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.FieldDefinitionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadStockMovements(ctx context.Context, query *StockMovementQuery, nodes []*Group, init func(*Group), assign func(*Group, *StockMovement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(stockmovement.FieldGroupID)
+	}
+	query.Where(predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.StockMovementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadItemIdentifiers(ctx context.Context, query *ItemIdentifierQuery, nodes []*Group, init func(*Group), assign func(*Group, *ItemIdentifier)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemidentifier.FieldGroupID)
+	}
+	query.Where(predicate.ItemIdentifier(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.ItemIdentifiersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadItemStatusChanges(ctx context.Context, query *ItemStatusChangeQuery, nodes []*Group, init func(*Group), assign func(*Group, *ItemStatusChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemstatuschange.FieldGroupID)
+	}
+	query.Where(predicate.ItemStatusChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.ItemStatusChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadStocktakeSessions(ctx context.Context, query *StocktakeSessionQuery, nodes []*Group, init func(*Group), assign func(*Group, *StocktakeSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(stocktakesession.FieldGroupID)
+	}
+	query.Where(predicate.StocktakeSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.StocktakeSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadLabelTemplates(ctx context.Context, query *LabelTemplateQuery, nodes []*Group, init func(*Group), assign func(*Group, *LabelTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(labeltemplate.FieldGroupID)
+	}
+	query.Where(predicate.LabelTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.LabelTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadPrinters(ctx context.Context, query *PrinterQuery, nodes []*Group, init func(*Group), assign func(*Group, *Printer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(printer.FieldGroupID)
+	}
+	query.Where(predicate.Printer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.PrintersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GroupQuery) loadPrintJobs(ctx context.Context, query *PrintJobQuery, nodes []*Group, init func(*Group), assign func(*Group, *PrintJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(printjob.FieldGroupID)
+	}
+	query.Where(predicate.PrintJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.PrintJobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,16 +12,27 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/fielddefinition"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -72,6 +83,76 @@ func (_u *GroupUpdate) SetNillableCurrency(v *string) *GroupUpdate {
 	return _u
 }
 
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_u *GroupUpdate) SetAssetIDPrefix(v string) *GroupUpdate {
+	_u.mutation.SetAssetIDPrefix(v)
+	return _u
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableAssetIDPrefix(v *string) *GroupUpdate {
+	if v != nil {
+		_u.SetAssetIDPrefix(*v)
+	}
+	return _u
+}
+
+// SetAssetIDWidth sets the "asset_id_width" field.
+func (_u *GroupUpdate) SetAssetIDWidth(v int) *GroupUpdate {
+	_u.mutation.ResetAssetIDWidth()
+	_u.mutation.SetAssetIDWidth(v)
+	return _u
+}
+
+// SetNillableAssetIDWidth sets the "asset_id_width" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableAssetIDWidth(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetAssetIDWidth(*v)
+	}
+	return _u
+}
+
+// AddAssetIDWidth adds value to the "asset_id_width" field.
+func (_u *GroupUpdate) AddAssetIDWidth(v int) *GroupUpdate {
+	_u.mutation.AddAssetIDWidth(v)
+	return _u
+}
+
+// SetAssetIDCheckDigit sets the "asset_id_check_digit" field.
+func (_u *GroupUpdate) SetAssetIDCheckDigit(v group.AssetIDCheckDigit) *GroupUpdate {
+	_u.mutation.SetAssetIDCheckDigit(v)
+	return _u
+}
+
+// SetNillableAssetIDCheckDigit sets the "asset_id_check_digit" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableAssetIDCheckDigit(v *group.AssetIDCheckDigit) *GroupUpdate {
+	if v != nil {
+		_u.SetAssetIDCheckDigit(*v)
+	}
+	return _u
+}
+
+// SetAssetIDUncheckedMax sets the "asset_id_unchecked_max" field.
+func (_u *GroupUpdate) SetAssetIDUncheckedMax(v int) *GroupUpdate {
+	_u.mutation.ResetAssetIDUncheckedMax()
+	_u.mutation.SetAssetIDUncheckedMax(v)
+	return _u
+}
+
+// SetNillableAssetIDUncheckedMax sets the "asset_id_unchecked_max" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableAssetIDUncheckedMax(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetAssetIDUncheckedMax(*v)
+	}
+	return _u
+}
+
+// AddAssetIDUncheckedMax adds value to the "asset_id_unchecked_max" field.
+func (_u *GroupUpdate) AddAssetIDUncheckedMax(v int) *GroupUpdate {
+	_u.mutation.AddAssetIDUncheckedMax(v)
+	return _u
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (_u *GroupUpdate) AddUserIDs(ids ...uuid.UUID) *GroupUpdate {
 	_u.mutation.AddUserIDs(ids...)
@@ -207,6 +288,171 @@ func (_u *GroupUpdate) AddLoans(v ...*Loan) *GroupUpdate {
 	return _u.AddLoanIDs(ids...)
 }
 
+// AddKioskSyncActionIDs adds the "kiosk_sync_actions" edge to the KioskSyncAction entity by IDs.
+func (_u *GroupUpdate) AddKioskSyncActionIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddKioskSyncActionIDs(ids...)
+	return _u
+}
+
+// AddKioskSyncActions adds the "kiosk_sync_actions" edges to the KioskSyncAction entity.
+func (_u *GroupUpdate) AddKioskSyncActions(v ...*KioskSyncAction) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKioskSyncActionIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *GroupUpdate) AddSavedSearchIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddSavedSearchIDs(ids...)
+	return _u
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *GroupUpdate) AddSavedSearches(v ...*SavedSearch) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavedSearchIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (_u *GroupUpdate) AddAuditEntryIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddAuditEntryIDs(ids...)
+	return _u
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (_u *GroupUpdate) AddAuditEntries(v ...*AuditEntry) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuditEntryIDs(ids...)
+}
+
+// AddFieldDefinitionIDs adds the "field_definitions" edge to the FieldDefinition entity by IDs.
+func (_u *GroupUpdate) AddFieldDefinitionIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddFieldDefinitionIDs(ids...)
+	return _u
+}
+
+// AddFieldDefinitions adds the "field_definitions" edges to the FieldDefinition entity.
+func (_u *GroupUpdate) AddFieldDefinitions(v ...*FieldDefinition) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFieldDefinitionIDs(ids...)
+}
+
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *GroupUpdate) AddStockMovementIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddStockMovementIDs(ids...)
+	return _u
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *GroupUpdate) AddStockMovements(v ...*StockMovement) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockMovementIDs(ids...)
+}
+
+// AddItemIdentifierIDs adds the "item_identifiers" edge to the ItemIdentifier entity by IDs.
+func (_u *GroupUpdate) AddItemIdentifierIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddItemIdentifierIDs(ids...)
+	return _u
+}
+
+// AddItemIdentifiers adds the "item_identifiers" edges to the ItemIdentifier entity.
+func (_u *GroupUpdate) AddItemIdentifiers(v ...*ItemIdentifier) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIdentifierIDs(ids...)
+}
+
+// AddItemStatusChangeIDs adds the "item_status_changes" edge to the ItemStatusChange entity by IDs.
+func (_u *GroupUpdate) AddItemStatusChangeIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddItemStatusChangeIDs(ids...)
+	return _u
+}
+
+// AddItemStatusChanges adds the "item_status_changes" edges to the ItemStatusChange entity.
+func (_u *GroupUpdate) AddItemStatusChanges(v ...*ItemStatusChange) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemStatusChangeIDs(ids...)
+}
+
+// AddStocktakeSessionIDs adds the "stocktake_sessions" edge to the StocktakeSession entity by IDs.
+func (_u *GroupUpdate) AddStocktakeSessionIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddStocktakeSessionIDs(ids...)
+	return _u
+}
+
+// AddStocktakeSessions adds the "stocktake_sessions" edges to the StocktakeSession entity.
+func (_u *GroupUpdate) AddStocktakeSessions(v ...*StocktakeSession) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStocktakeSessionIDs(ids...)
+}
+
+// AddLabelTemplateIDs adds the "label_templates" edge to the LabelTemplate entity by IDs.
+func (_u *GroupUpdate) AddLabelTemplateIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddLabelTemplateIDs(ids...)
+	return _u
+}
+
+// AddLabelTemplates adds the "label_templates" edges to the LabelTemplate entity.
+func (_u *GroupUpdate) AddLabelTemplates(v ...*LabelTemplate) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLabelTemplateIDs(ids...)
+}
+
+// AddPrinterIDs adds the "printers" edge to the Printer entity by IDs.
+func (_u *GroupUpdate) AddPrinterIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddPrinterIDs(ids...)
+	return _u
+}
+
+// AddPrinters adds the "printers" edges to the Printer entity.
+func (_u *GroupUpdate) AddPrinters(v ...*Printer) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPrinterIDs(ids...)
+}
+
+// AddPrintJobIDs adds the "print_jobs" edge to the PrintJob entity by IDs.
+func (_u *GroupUpdate) AddPrintJobIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.AddPrintJobIDs(ids...)
+	return _u
+}
+
+// AddPrintJobs adds the "print_jobs" edges to the PrintJob entity.
+func (_u *GroupUpdate) AddPrintJobs(v ...*PrintJob) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPrintJobIDs(ids...)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (_u *GroupUpdate) Mutation() *GroupMutation {
 	return _u.mutation
@@ -401,73 +647,342 @@ func (_u *GroupUpdate) RemoveLoans(v ...*Loan) *GroupUpdate {
 	return _u.RemoveLoanIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *GroupUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearKioskSyncActions clears all "kiosk_sync_actions" edges to the KioskSyncAction entity.
+func (_u *GroupUpdate) ClearKioskSyncActions() *GroupUpdate {
+	_u.mutation.ClearKioskSyncActions()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *GroupUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveKioskSyncActionIDs removes the "kiosk_sync_actions" edge to KioskSyncAction entities by IDs.
+func (_u *GroupUpdate) RemoveKioskSyncActionIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveKioskSyncActionIDs(ids...)
+	return _u
+}
+
+// RemoveKioskSyncActions removes "kiosk_sync_actions" edges to KioskSyncAction entities.
+func (_u *GroupUpdate) RemoveKioskSyncActions(v ...*KioskSyncAction) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveKioskSyncActionIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *GroupUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *GroupUpdate) ClearSavedSearches() *GroupUpdate {
+	_u.mutation.ClearSavedSearches()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *GroupUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *GroupUpdate) RemoveSavedSearchIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *GroupUpdate) RemoveSavedSearches(v ...*SavedSearch) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveSavedSearchIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *GroupUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := group.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+// ClearAuditEntries clears all "audit_entries" edges to the AuditEntry entity.
+func (_u *GroupUpdate) ClearAuditEntries() *GroupUpdate {
+	_u.mutation.ClearAuditEntries()
+	return _u
+}
+
+// RemoveAuditEntryIDs removes the "audit_entries" edge to AuditEntry entities by IDs.
+func (_u *GroupUpdate) RemoveAuditEntryIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveAuditEntryIDs(ids...)
+	return _u
+}
+
+// RemoveAuditEntries removes "audit_entries" edges to AuditEntry entities.
+func (_u *GroupUpdate) RemoveAuditEntries(v ...*AuditEntry) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveAuditEntryIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *GroupUpdate) check() error {
-	if v, ok := _u.mutation.Name(); ok {
-		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
-		}
+// ClearFieldDefinitions clears all "field_definitions" edges to the FieldDefinition entity.
+func (_u *GroupUpdate) ClearFieldDefinitions() *GroupUpdate {
+	_u.mutation.ClearFieldDefinitions()
+	return _u
+}
+
+// RemoveFieldDefinitionIDs removes the "field_definitions" edge to FieldDefinition entities by IDs.
+func (_u *GroupUpdate) RemoveFieldDefinitionIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveFieldDefinitionIDs(ids...)
+	return _u
+}
+
+// RemoveFieldDefinitions removes "field_definitions" edges to FieldDefinition entities.
+func (_u *GroupUpdate) RemoveFieldDefinitions(v ...*FieldDefinition) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return nil
+	return _u.RemoveFieldDefinitionIDs(ids...)
 }
 
-func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *GroupUpdate) ClearStockMovements() *GroupUpdate {
+	_u.mutation.ClearStockMovements()
+	return _u
+}
+
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *GroupUpdate) RemoveStockMovementIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveStockMovementIDs(ids...)
+	return _u
+}
+
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *GroupUpdate) RemoveStockMovements(v ...*StockMovement) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(group.Table, group.Columns, sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return _u.RemoveStockMovementIDs(ids...)
+}
+
+// ClearItemIdentifiers clears all "item_identifiers" edges to the ItemIdentifier entity.
+func (_u *GroupUpdate) ClearItemIdentifiers() *GroupUpdate {
+	_u.mutation.ClearItemIdentifiers()
+	return _u
+}
+
+// RemoveItemIdentifierIDs removes the "item_identifiers" edge to ItemIdentifier entities by IDs.
+func (_u *GroupUpdate) RemoveItemIdentifierIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveItemIdentifierIDs(ids...)
+	return _u
+}
+
+// RemoveItemIdentifiers removes "item_identifiers" edges to ItemIdentifier entities.
+func (_u *GroupUpdate) RemoveItemIdentifiers(v ...*ItemIdentifier) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(group.FieldUpdatedAt, field.TypeTime, value)
+	return _u.RemoveItemIdentifierIDs(ids...)
+}
+
+// ClearItemStatusChanges clears all "item_status_changes" edges to the ItemStatusChange entity.
+func (_u *GroupUpdate) ClearItemStatusChanges() *GroupUpdate {
+	_u.mutation.ClearItemStatusChanges()
+	return _u
+}
+
+// RemoveItemStatusChangeIDs removes the "item_status_changes" edge to ItemStatusChange entities by IDs.
+func (_u *GroupUpdate) RemoveItemStatusChangeIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveItemStatusChangeIDs(ids...)
+	return _u
+}
+
+// RemoveItemStatusChanges removes "item_status_changes" edges to ItemStatusChange entities.
+func (_u *GroupUpdate) RemoveItemStatusChanges(v ...*ItemStatusChange) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(group.FieldName, field.TypeString, value)
+	return _u.RemoveItemStatusChangeIDs(ids...)
+}
+
+// ClearStocktakeSessions clears all "stocktake_sessions" edges to the StocktakeSession entity.
+func (_u *GroupUpdate) ClearStocktakeSessions() *GroupUpdate {
+	_u.mutation.ClearStocktakeSessions()
+	return _u
+}
+
+// RemoveStocktakeSessionIDs removes the "stocktake_sessions" edge to StocktakeSession entities by IDs.
+func (_u *GroupUpdate) RemoveStocktakeSessionIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveStocktakeSessionIDs(ids...)
+	return _u
+}
+
+// RemoveStocktakeSessions removes "stocktake_sessions" edges to StocktakeSession entities.
+func (_u *GroupUpdate) RemoveStocktakeSessions(v ...*StocktakeSession) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Currency(); ok {
+	return _u.RemoveStocktakeSessionIDs(ids...)
+}
+
+// ClearLabelTemplates clears all "label_templates" edges to the LabelTemplate entity.
+func (_u *GroupUpdate) ClearLabelTemplates() *GroupUpdate {
+	_u.mutation.ClearLabelTemplates()
+	return _u
+}
+
+// RemoveLabelTemplateIDs removes the "label_templates" edge to LabelTemplate entities by IDs.
+func (_u *GroupUpdate) RemoveLabelTemplateIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemoveLabelTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveLabelTemplates removes "label_templates" edges to LabelTemplate entities.
+func (_u *GroupUpdate) RemoveLabelTemplates(v ...*LabelTemplate) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLabelTemplateIDs(ids...)
+}
+
+// ClearPrinters clears all "printers" edges to the Printer entity.
+func (_u *GroupUpdate) ClearPrinters() *GroupUpdate {
+	_u.mutation.ClearPrinters()
+	return _u
+}
+
+// RemovePrinterIDs removes the "printers" edge to Printer entities by IDs.
+func (_u *GroupUpdate) RemovePrinterIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemovePrinterIDs(ids...)
+	return _u
+}
+
+// RemovePrinters removes "printers" edges to Printer entities.
+func (_u *GroupUpdate) RemovePrinters(v ...*Printer) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrinterIDs(ids...)
+}
+
+// ClearPrintJobs clears all "print_jobs" edges to the PrintJob entity.
+func (_u *GroupUpdate) ClearPrintJobs() *GroupUpdate {
+	_u.mutation.ClearPrintJobs()
+	return _u
+}
+
+// RemovePrintJobIDs removes the "print_jobs" edge to PrintJob entities by IDs.
+func (_u *GroupUpdate) RemovePrintJobIDs(ids ...uuid.UUID) *GroupUpdate {
+	_u.mutation.RemovePrintJobIDs(ids...)
+	return _u
+}
+
+// RemovePrintJobs removes "print_jobs" edges to PrintJob entities.
+func (_u *GroupUpdate) RemovePrintJobs(v ...*PrintJob) *GroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrintJobIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GroupUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GroupUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := group.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GroupUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDPrefix(); ok {
+		if err := group.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_prefix": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDWidth(); ok {
+		if err := group.AssetIDWidthValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_width", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDCheckDigit(); ok {
+		if err := group.AssetIDCheckDigitValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_check_digit", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_check_digit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDUncheckedMax(); ok {
+		if err := group.AssetIDUncheckedMaxValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_unchecked_max", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_unchecked_max": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(group.Table, group.Columns, sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(group.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(group.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
 		_spec.SetField(group.FieldCurrency, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(group.FieldAssetIDPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetIDWidth(); ok {
+		_spec.SetField(group.FieldAssetIDWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAssetIDWidth(); ok {
+		_spec.AddField(group.FieldAssetIDWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AssetIDCheckDigit(); ok {
+		_spec.SetField(group.FieldAssetIDCheckDigit, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AssetIDUncheckedMax(); ok {
+		_spec.SetField(group.FieldAssetIDUncheckedMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAssetIDUncheckedMax(); ok {
+		_spec.AddField(group.FieldAssetIDUncheckedMax, field.TypeInt, value)
+	}
 	if _u.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -873,508 +1388,2002 @@ func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{group.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.KioskSyncActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.KioskSyncActionsTable,
+			Columns: []string{group.KioskSyncActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// GroupUpdateOne is the builder for updating a single Group entity.
-type GroupUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *GroupMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *GroupUpdateOne) SetUpdatedAt(v time.Time) *GroupUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *GroupUpdateOne) SetName(v string) *GroupUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *GroupUpdateOne) SetNillableName(v *string) *GroupUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetCurrency sets the "currency" field.
-func (_u *GroupUpdateOne) SetCurrency(v string) *GroupUpdateOne {
-	_u.mutation.SetCurrency(v)
-	return _u
-}
-
-// SetNillableCurrency sets the "currency" field if the given value is not nil.
-func (_u *GroupUpdateOne) SetNillableCurrency(v *string) *GroupUpdateOne {
-	if v != nil {
-		_u.SetCurrency(*v)
+	if nodes := _u.mutation.RemovedKioskSyncActionsIDs(); len(nodes) > 0 && !_u.mutation.KioskSyncActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.KioskSyncActionsTable,
+			Columns: []string{group.KioskSyncActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// AddUserIDs adds the "users" edge to the User entity by IDs.
-func (_u *GroupUpdateOne) AddUserIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddUserIDs(ids...)
-	return _u
-}
-
-// AddUsers adds the "users" edges to the User entity.
-func (_u *GroupUpdateOne) AddUsers(v ...*User) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.KioskSyncActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.KioskSyncActionsTable,
+			Columns: []string{group.KioskSyncActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddUserIDs(ids...)
-}
-
-// AddLocationIDs adds the "locations" edge to the Location entity by IDs.
-func (_u *GroupUpdateOne) AddLocationIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddLocationIDs(ids...)
-	return _u
-}
-
-// AddLocations adds the "locations" edges to the Location entity.
-func (_u *GroupUpdateOne) AddLocations(v ...*Location) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddLocationIDs(ids...)
-}
-
-// AddItemIDs adds the "items" edge to the Item entity by IDs.
-func (_u *GroupUpdateOne) AddItemIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddItemIDs(ids...)
-	return _u
-}
-
-// AddItems adds the "items" edges to the Item entity.
-func (_u *GroupUpdateOne) AddItems(v ...*Item) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddItemIDs(ids...)
-}
-
-// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
-func (_u *GroupUpdateOne) AddLabelIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddLabelIDs(ids...)
-	return _u
-}
-
-// AddLabels adds the "labels" edges to the Label entity.
-func (_u *GroupUpdateOne) AddLabels(v ...*Label) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddLabelIDs(ids...)
-}
-
-// AddInvitationTokenIDs adds the "invitation_tokens" edge to the GroupInvitationToken entity by IDs.
-func (_u *GroupUpdateOne) AddInvitationTokenIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddInvitationTokenIDs(ids...)
-	return _u
-}
-
-// AddInvitationTokens adds the "invitation_tokens" edges to the GroupInvitationToken entity.
-func (_u *GroupUpdateOne) AddInvitationTokens(v ...*GroupInvitationToken) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddInvitationTokenIDs(ids...)
-}
-
-// AddNotifierIDs adds the "notifiers" edge to the Notifier entity by IDs.
-func (_u *GroupUpdateOne) AddNotifierIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddNotifierIDs(ids...)
-	return _u
-}
-
-// AddNotifiers adds the "notifiers" edges to the Notifier entity.
-func (_u *GroupUpdateOne) AddNotifiers(v ...*Notifier) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedAuditEntriesIDs(); len(nodes) > 0 && !_u.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddNotifierIDs(ids...)
-}
-
-// AddItemTemplateIDs adds the "item_templates" edge to the ItemTemplate entity by IDs.
-func (_u *GroupUpdateOne) AddItemTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddItemTemplateIDs(ids...)
-	return _u
-}
-
-// AddItemTemplates adds the "item_templates" edges to the ItemTemplate entity.
-func (_u *GroupUpdateOne) AddItemTemplates(v ...*ItemTemplate) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddItemTemplateIDs(ids...)
-}
-
-// AddBorrowerIDs adds the "borrowers" edge to the Borrower entity by IDs.
-func (_u *GroupUpdateOne) AddBorrowerIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddBorrowerIDs(ids...)
-	return _u
-}
-
-// AddBorrowers adds the "borrowers" edges to the Borrower entity.
-func (_u *GroupUpdateOne) AddBorrowers(v ...*Borrower) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.FieldDefinitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.FieldDefinitionsTable,
+			Columns: []string{group.FieldDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fielddefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddBorrowerIDs(ids...)
-}
-
-// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
-func (_u *GroupUpdateOne) AddLoanIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.AddLoanIDs(ids...)
-	return _u
-}
-
-// AddLoans adds the "loans" edges to the Loan entity.
-func (_u *GroupUpdateOne) AddLoans(v ...*Loan) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedFieldDefinitionsIDs(); len(nodes) > 0 && !_u.mutation.FieldDefinitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.FieldDefinitionsTable,
+			Columns: []string{group.FieldDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fielddefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddLoanIDs(ids...)
-}
-
-// Mutation returns the GroupMutation object of the builder.
-func (_u *GroupUpdateOne) Mutation() *GroupMutation {
-	return _u.mutation
-}
-
-// ClearUsers clears all "users" edges to the User entity.
-func (_u *GroupUpdateOne) ClearUsers() *GroupUpdateOne {
-	_u.mutation.ClearUsers()
-	return _u
-}
-
-// RemoveUserIDs removes the "users" edge to User entities by IDs.
-func (_u *GroupUpdateOne) RemoveUserIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveUserIDs(ids...)
-	return _u
-}
-
-// RemoveUsers removes "users" edges to User entities.
-func (_u *GroupUpdateOne) RemoveUsers(v ...*User) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.FieldDefinitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.FieldDefinitionsTable,
+			Columns: []string{group.FieldDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fielddefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveUserIDs(ids...)
-}
-
-// ClearLocations clears all "locations" edges to the Location entity.
-func (_u *GroupUpdateOne) ClearLocations() *GroupUpdateOne {
-	_u.mutation.ClearLocations()
-	return _u
-}
-
-// RemoveLocationIDs removes the "locations" edge to Location entities by IDs.
-func (_u *GroupUpdateOne) RemoveLocationIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveLocationIDs(ids...)
-	return _u
-}
-
-// RemoveLocations removes "locations" edges to Location entities.
-func (_u *GroupUpdateOne) RemoveLocations(v ...*Location) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.StockMovementsTable,
+			Columns: []string{group.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveLocationIDs(ids...)
-}
-
-// ClearItems clears all "items" edges to the Item entity.
-func (_u *GroupUpdateOne) ClearItems() *GroupUpdateOne {
-	_u.mutation.ClearItems()
-	return _u
-}
-
-// RemoveItemIDs removes the "items" edge to Item entities by IDs.
-func (_u *GroupUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveItemIDs(ids...)
-	return _u
-}
-
-// RemoveItems removes "items" edges to Item entities.
-func (_u *GroupUpdateOne) RemoveItems(v ...*Item) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.StockMovementsTable,
+			Columns: []string{group.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveItemIDs(ids...)
-}
-
-// ClearLabels clears all "labels" edges to the Label entity.
-func (_u *GroupUpdateOne) ClearLabels() *GroupUpdateOne {
-	_u.mutation.ClearLabels()
-	return _u
-}
-
-// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
-func (_u *GroupUpdateOne) RemoveLabelIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveLabelIDs(ids...)
-	return _u
-}
-
-// RemoveLabels removes "labels" edges to Label entities.
-func (_u *GroupUpdateOne) RemoveLabels(v ...*Label) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.StockMovementsTable,
+			Columns: []string{group.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveLabelIDs(ids...)
+	if _u.mutation.ItemIdentifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemIdentifiersTable,
+			Columns: []string{group.ItemIdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemIdentifiersIDs(); len(nodes) > 0 && !_u.mutation.ItemIdentifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemIdentifiersTable,
+			Columns: []string{group.ItemIdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIdentifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemIdentifiersTable,
+			Columns: []string{group.ItemIdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemStatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemStatusChangesTable,
+			Columns: []string{group.ItemStatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.ItemStatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemStatusChangesTable,
+			Columns: []string{group.ItemStatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemStatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemStatusChangesTable,
+			Columns: []string{group.ItemStatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StocktakeSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.StocktakeSessionsTable,
+			Columns: []string{group.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStocktakeSessionsIDs(); len(nodes) > 0 && !_u.mutation.StocktakeSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.StocktakeSessionsTable,
+			Columns: []string{group.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StocktakeSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.StocktakeSessionsTable,
+			Columns: []string{group.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LabelTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LabelTemplatesTable,
+			Columns: []string{group.LabelTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLabelTemplatesIDs(); len(nodes) > 0 && !_u.mutation.LabelTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LabelTemplatesTable,
+			Columns: []string{group.LabelTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LabelTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LabelTemplatesTable,
+			Columns: []string{group.LabelTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PrintersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.PrintersTable,
+			Columns: []string{group.PrintersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPrintersIDs(); len(nodes) > 0 && !_u.mutation.PrintersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.PrintersTable,
+			Columns: []string{group.PrintersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrintersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.PrintersTable,
+			Columns: []string{group.PrintersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PrintJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.PrintJobsTable,
+			Columns: []string{group.PrintJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPrintJobsIDs(); len(nodes) > 0 && !_u.mutation.PrintJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.PrintJobsTable,
+			Columns: []string{group.PrintJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrintJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.PrintJobsTable,
+			Columns: []string{group.PrintJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{group.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
 }
 
-// ClearInvitationTokens clears all "invitation_tokens" edges to the GroupInvitationToken entity.
-func (_u *GroupUpdateOne) ClearInvitationTokens() *GroupUpdateOne {
-	_u.mutation.ClearInvitationTokens()
+// GroupUpdateOne is the builder for updating a single Group entity.
+type GroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GroupUpdateOne) SetUpdatedAt(v time.Time) *GroupUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
-// RemoveInvitationTokenIDs removes the "invitation_tokens" edge to GroupInvitationToken entities by IDs.
-func (_u *GroupUpdateOne) RemoveInvitationTokenIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveInvitationTokenIDs(ids...)
+// SetName sets the "name" field.
+func (_u *GroupUpdateOne) SetName(v string) *GroupUpdateOne {
+	_u.mutation.SetName(v)
 	return _u
 }
 
-// RemoveInvitationTokens removes "invitation_tokens" edges to GroupInvitationToken entities.
-func (_u *GroupUpdateOne) RemoveInvitationTokens(v ...*GroupInvitationToken) *GroupUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableName(v *string) *GroupUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
 	}
-	return _u.RemoveInvitationTokenIDs(ids...)
+	return _u
 }
 
-// ClearNotifiers clears all "notifiers" edges to the Notifier entity.
-func (_u *GroupUpdateOne) ClearNotifiers() *GroupUpdateOne {
-	_u.mutation.ClearNotifiers()
+// SetCurrency sets the "currency" field.
+func (_u *GroupUpdateOne) SetCurrency(v string) *GroupUpdateOne {
+	_u.mutation.SetCurrency(v)
 	return _u
 }
 
-// RemoveNotifierIDs removes the "notifiers" edge to Notifier entities by IDs.
-func (_u *GroupUpdateOne) RemoveNotifierIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveNotifierIDs(ids...)
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableCurrency(v *string) *GroupUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
 	return _u
 }
 
-// RemoveNotifiers removes "notifiers" edges to Notifier entities.
-func (_u *GroupUpdateOne) RemoveNotifiers(v ...*Notifier) *GroupUpdateOne {
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_u *GroupUpdateOne) SetAssetIDPrefix(v string) *GroupUpdateOne {
+	_u.mutation.SetAssetIDPrefix(v)
+	return _u
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableAssetIDPrefix(v *string) *GroupUpdateOne {
+	if v != nil {
+		_u.SetAssetIDPrefix(*v)
+	}
+	return _u
+}
+
+// SetAssetIDWidth sets the "asset_id_width" field.
+func (_u *GroupUpdateOne) SetAssetIDWidth(v int) *GroupUpdateOne {
+	_u.mutation.ResetAssetIDWidth()
+	_u.mutation.SetAssetIDWidth(v)
+	return _u
+}
+
+// SetNillableAssetIDWidth sets the "asset_id_width" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableAssetIDWidth(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetAssetIDWidth(*v)
+	}
+	return _u
+}
+
+// AddAssetIDWidth adds value to the "asset_id_width" field.
+func (_u *GroupUpdateOne) AddAssetIDWidth(v int) *GroupUpdateOne {
+	_u.mutation.AddAssetIDWidth(v)
+	return _u
+}
+
+// SetAssetIDCheckDigit sets the "asset_id_check_digit" field.
+func (_u *GroupUpdateOne) SetAssetIDCheckDigit(v group.AssetIDCheckDigit) *GroupUpdateOne {
+	_u.mutation.SetAssetIDCheckDigit(v)
+	return _u
+}
+
+// SetNillableAssetIDCheckDigit sets the "asset_id_check_digit" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableAssetIDCheckDigit(v *group.AssetIDCheckDigit) *GroupUpdateOne {
+	if v != nil {
+		_u.SetAssetIDCheckDigit(*v)
+	}
+	return _u
+}
+
+// SetAssetIDUncheckedMax sets the "asset_id_unchecked_max" field.
+func (_u *GroupUpdateOne) SetAssetIDUncheckedMax(v int) *GroupUpdateOne {
+	_u.mutation.ResetAssetIDUncheckedMax()
+	_u.mutation.SetAssetIDUncheckedMax(v)
+	return _u
+}
+
+// SetNillableAssetIDUncheckedMax sets the "asset_id_unchecked_max" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableAssetIDUncheckedMax(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetAssetIDUncheckedMax(*v)
+	}
+	return _u
+}
+
+// AddAssetIDUncheckedMax adds value to the "asset_id_unchecked_max" field.
+func (_u *GroupUpdateOne) AddAssetIDUncheckedMax(v int) *GroupUpdateOne {
+	_u.mutation.AddAssetIDUncheckedMax(v)
+	return _u
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *GroupUpdateOne) AddUserIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *GroupUpdateOne) AddUsers(v ...*User) *GroupUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveNotifierIDs(ids...)
+	return _u.AddUserIDs(ids...)
 }
 
-// ClearItemTemplates clears all "item_templates" edges to the ItemTemplate entity.
-func (_u *GroupUpdateOne) ClearItemTemplates() *GroupUpdateOne {
-	_u.mutation.ClearItemTemplates()
+// AddLocationIDs adds the "locations" edge to the Location entity by IDs.
+func (_u *GroupUpdateOne) AddLocationIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddLocationIDs(ids...)
 	return _u
 }
 
-// RemoveItemTemplateIDs removes the "item_templates" edge to ItemTemplate entities by IDs.
-func (_u *GroupUpdateOne) RemoveItemTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveItemTemplateIDs(ids...)
+// AddLocations adds the "locations" edges to the Location entity.
+func (_u *GroupUpdateOne) AddLocations(v ...*Location) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLocationIDs(ids...)
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (_u *GroupUpdateOne) AddItemIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
 	return _u
 }
 
-// RemoveItemTemplates removes "item_templates" edges to ItemTemplate entities.
-func (_u *GroupUpdateOne) RemoveItemTemplates(v ...*ItemTemplate) *GroupUpdateOne {
+// AddItems adds the "items" edges to the Item entity.
+func (_u *GroupUpdateOne) AddItems(v ...*Item) *GroupUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveItemTemplateIDs(ids...)
+	return _u.AddItemIDs(ids...)
 }
 
-// ClearBorrowers clears all "borrowers" edges to the Borrower entity.
-func (_u *GroupUpdateOne) ClearBorrowers() *GroupUpdateOne {
-	_u.mutation.ClearBorrowers()
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (_u *GroupUpdateOne) AddLabelIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddLabelIDs(ids...)
 	return _u
 }
 
-// RemoveBorrowerIDs removes the "borrowers" edge to Borrower entities by IDs.
-func (_u *GroupUpdateOne) RemoveBorrowerIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveBorrowerIDs(ids...)
+// AddLabels adds the "labels" edges to the Label entity.
+func (_u *GroupUpdateOne) AddLabels(v ...*Label) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLabelIDs(ids...)
+}
+
+// AddInvitationTokenIDs adds the "invitation_tokens" edge to the GroupInvitationToken entity by IDs.
+func (_u *GroupUpdateOne) AddInvitationTokenIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddInvitationTokenIDs(ids...)
 	return _u
 }
 
-// RemoveBorrowers removes "borrowers" edges to Borrower entities.
-func (_u *GroupUpdateOne) RemoveBorrowers(v ...*Borrower) *GroupUpdateOne {
+// AddInvitationTokens adds the "invitation_tokens" edges to the GroupInvitationToken entity.
+func (_u *GroupUpdateOne) AddInvitationTokens(v ...*GroupInvitationToken) *GroupUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBorrowerIDs(ids...)
+	return _u.AddInvitationTokenIDs(ids...)
 }
 
-// ClearLoans clears all "loans" edges to the Loan entity.
-func (_u *GroupUpdateOne) ClearLoans() *GroupUpdateOne {
-	_u.mutation.ClearLoans()
+// AddNotifierIDs adds the "notifiers" edge to the Notifier entity by IDs.
+func (_u *GroupUpdateOne) AddNotifierIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddNotifierIDs(ids...)
 	return _u
 }
 
-// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
-func (_u *GroupUpdateOne) RemoveLoanIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	_u.mutation.RemoveLoanIDs(ids...)
+// AddNotifiers adds the "notifiers" edges to the Notifier entity.
+func (_u *GroupUpdateOne) AddNotifiers(v ...*Notifier) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotifierIDs(ids...)
+}
+
+// AddItemTemplateIDs adds the "item_templates" edge to the ItemTemplate entity by IDs.
+func (_u *GroupUpdateOne) AddItemTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddItemTemplateIDs(ids...)
 	return _u
 }
 
-// RemoveLoans removes "loans" edges to Loan entities.
-func (_u *GroupUpdateOne) RemoveLoans(v ...*Loan) *GroupUpdateOne {
+// AddItemTemplates adds the "item_templates" edges to the ItemTemplate entity.
+func (_u *GroupUpdateOne) AddItemTemplates(v ...*ItemTemplate) *GroupUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLoanIDs(ids...)
+	return _u.AddItemTemplateIDs(ids...)
 }
 
-// Where appends a list predicates to the GroupUpdate builder.
-func (_u *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
-	_u.mutation.Where(ps...)
+// AddBorrowerIDs adds the "borrowers" edge to the Borrower entity by IDs.
+func (_u *GroupUpdateOne) AddBorrowerIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddBorrowerIDs(ids...)
 	return _u
 }
 
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *GroupUpdateOne) Select(field string, fields ...string) *GroupUpdateOne {
-	_u.fields = append([]string{field}, fields...)
+// AddBorrowers adds the "borrowers" edges to the Borrower entity.
+func (_u *GroupUpdateOne) AddBorrowers(v ...*Borrower) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBorrowerIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (_u *GroupUpdateOne) AddLoanIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddLoanIDs(ids...)
 	return _u
 }
 
-// Save executes the query and returns the updated Group entity.
-func (_u *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// AddLoans adds the "loans" edges to the Loan entity.
+func (_u *GroupUpdateOne) AddLoans(v ...*Loan) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLoanIDs(ids...)
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *GroupUpdateOne) SaveX(ctx context.Context) *Group {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddKioskSyncActionIDs adds the "kiosk_sync_actions" edge to the KioskSyncAction entity by IDs.
+func (_u *GroupUpdateOne) AddKioskSyncActionIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddKioskSyncActionIDs(ids...)
+	return _u
+}
+
+// AddKioskSyncActions adds the "kiosk_sync_actions" edges to the KioskSyncAction entity.
+func (_u *GroupUpdateOne) AddKioskSyncActions(v ...*KioskSyncAction) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return node
+	return _u.AddKioskSyncActionIDs(ids...)
 }
 
-// Exec executes the query on the entity.
-func (_u *GroupUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *GroupUpdateOne) AddSavedSearchIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddSavedSearchIDs(ids...)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *GroupUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *GroupUpdateOne) AddSavedSearches(v ...*SavedSearch) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavedSearchIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (_u *GroupUpdateOne) AddAuditEntryIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddAuditEntryIDs(ids...)
+	return _u
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (_u *GroupUpdateOne) AddAuditEntries(v ...*AuditEntry) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuditEntryIDs(ids...)
+}
+
+// AddFieldDefinitionIDs adds the "field_definitions" edge to the FieldDefinition entity by IDs.
+func (_u *GroupUpdateOne) AddFieldDefinitionIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddFieldDefinitionIDs(ids...)
+	return _u
+}
+
+// AddFieldDefinitions adds the "field_definitions" edges to the FieldDefinition entity.
+func (_u *GroupUpdateOne) AddFieldDefinitions(v ...*FieldDefinition) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFieldDefinitionIDs(ids...)
+}
+
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *GroupUpdateOne) AddStockMovementIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddStockMovementIDs(ids...)
+	return _u
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *GroupUpdateOne) AddStockMovements(v ...*StockMovement) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockMovementIDs(ids...)
+}
+
+// AddItemIdentifierIDs adds the "item_identifiers" edge to the ItemIdentifier entity by IDs.
+func (_u *GroupUpdateOne) AddItemIdentifierIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddItemIdentifierIDs(ids...)
+	return _u
+}
+
+// AddItemIdentifiers adds the "item_identifiers" edges to the ItemIdentifier entity.
+func (_u *GroupUpdateOne) AddItemIdentifiers(v ...*ItemIdentifier) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIdentifierIDs(ids...)
+}
+
+// AddItemStatusChangeIDs adds the "item_status_changes" edge to the ItemStatusChange entity by IDs.
+func (_u *GroupUpdateOne) AddItemStatusChangeIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddItemStatusChangeIDs(ids...)
+	return _u
+}
+
+// AddItemStatusChanges adds the "item_status_changes" edges to the ItemStatusChange entity.
+func (_u *GroupUpdateOne) AddItemStatusChanges(v ...*ItemStatusChange) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemStatusChangeIDs(ids...)
+}
+
+// AddStocktakeSessionIDs adds the "stocktake_sessions" edge to the StocktakeSession entity by IDs.
+func (_u *GroupUpdateOne) AddStocktakeSessionIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddStocktakeSessionIDs(ids...)
+	return _u
+}
+
+// AddStocktakeSessions adds the "stocktake_sessions" edges to the StocktakeSession entity.
+func (_u *GroupUpdateOne) AddStocktakeSessions(v ...*StocktakeSession) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStocktakeSessionIDs(ids...)
+}
+
+// AddLabelTemplateIDs adds the "label_templates" edge to the LabelTemplate entity by IDs.
+func (_u *GroupUpdateOne) AddLabelTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddLabelTemplateIDs(ids...)
+	return _u
+}
+
+// AddLabelTemplates adds the "label_templates" edges to the LabelTemplate entity.
+func (_u *GroupUpdateOne) AddLabelTemplates(v ...*LabelTemplate) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLabelTemplateIDs(ids...)
+}
+
+// AddPrinterIDs adds the "printers" edge to the Printer entity by IDs.
+func (_u *GroupUpdateOne) AddPrinterIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddPrinterIDs(ids...)
+	return _u
+}
+
+// AddPrinters adds the "printers" edges to the Printer entity.
+func (_u *GroupUpdateOne) AddPrinters(v ...*Printer) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPrinterIDs(ids...)
+}
+
+// AddPrintJobIDs adds the "print_jobs" edge to the PrintJob entity by IDs.
+func (_u *GroupUpdateOne) AddPrintJobIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.AddPrintJobIDs(ids...)
+	return _u
+}
+
+// AddPrintJobs adds the "print_jobs" edges to the PrintJob entity.
+func (_u *GroupUpdateOne) AddPrintJobs(v ...*PrintJob) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPrintJobIDs(ids...)
+}
+
+// Mutation returns the GroupMutation object of the builder.
+func (_u *GroupUpdateOne) Mutation() *GroupMutation {
+	return _u.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *GroupUpdateOne) ClearUsers() *GroupUpdateOne {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *GroupUpdateOne) RemoveUserIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *GroupUpdateOne) RemoveUsers(v ...*User) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// ClearLocations clears all "locations" edges to the Location entity.
+func (_u *GroupUpdateOne) ClearLocations() *GroupUpdateOne {
+	_u.mutation.ClearLocations()
+	return _u
+}
+
+// RemoveLocationIDs removes the "locations" edge to Location entities by IDs.
+func (_u *GroupUpdateOne) RemoveLocationIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveLocationIDs(ids...)
+	return _u
+}
+
+// RemoveLocations removes "locations" edges to Location entities.
+func (_u *GroupUpdateOne) RemoveLocations(v ...*Location) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLocationIDs(ids...)
+}
+
+// ClearItems clears all "items" edges to the Item entity.
+func (_u *GroupUpdateOne) ClearItems() *GroupUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to Item entities by IDs.
+func (_u *GroupUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to Item entities.
+func (_u *GroupUpdateOne) RemoveItems(v ...*Item) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (_u *GroupUpdateOne) ClearLabels() *GroupUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (_u *GroupUpdateOne) RemoveLabelIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveLabelIDs(ids...)
+	return _u
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (_u *GroupUpdateOne) RemoveLabels(v ...*Label) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLabelIDs(ids...)
+}
+
+// ClearInvitationTokens clears all "invitation_tokens" edges to the GroupInvitationToken entity.
+func (_u *GroupUpdateOne) ClearInvitationTokens() *GroupUpdateOne {
+	_u.mutation.ClearInvitationTokens()
+	return _u
+}
+
+// RemoveInvitationTokenIDs removes the "invitation_tokens" edge to GroupInvitationToken entities by IDs.
+func (_u *GroupUpdateOne) RemoveInvitationTokenIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveInvitationTokenIDs(ids...)
+	return _u
+}
+
+// RemoveInvitationTokens removes "invitation_tokens" edges to GroupInvitationToken entities.
+func (_u *GroupUpdateOne) RemoveInvitationTokens(v ...*GroupInvitationToken) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvitationTokenIDs(ids...)
+}
+
+// ClearNotifiers clears all "notifiers" edges to the Notifier entity.
+func (_u *GroupUpdateOne) ClearNotifiers() *GroupUpdateOne {
+	_u.mutation.ClearNotifiers()
+	return _u
+}
+
+// RemoveNotifierIDs removes the "notifiers" edge to Notifier entities by IDs.
+func (_u *GroupUpdateOne) RemoveNotifierIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveNotifierIDs(ids...)
+	return _u
+}
+
+// RemoveNotifiers removes "notifiers" edges to Notifier entities.
+func (_u *GroupUpdateOne) RemoveNotifiers(v ...*Notifier) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotifierIDs(ids...)
+}
+
+// ClearItemTemplates clears all "item_templates" edges to the ItemTemplate entity.
+func (_u *GroupUpdateOne) ClearItemTemplates() *GroupUpdateOne {
+	_u.mutation.ClearItemTemplates()
+	return _u
+}
+
+// RemoveItemTemplateIDs removes the "item_templates" edge to ItemTemplate entities by IDs.
+func (_u *GroupUpdateOne) RemoveItemTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveItemTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveItemTemplates removes "item_templates" edges to ItemTemplate entities.
+func (_u *GroupUpdateOne) RemoveItemTemplates(v ...*ItemTemplate) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemTemplateIDs(ids...)
+}
+
+// ClearBorrowers clears all "borrowers" edges to the Borrower entity.
+func (_u *GroupUpdateOne) ClearBorrowers() *GroupUpdateOne {
+	_u.mutation.ClearBorrowers()
+	return _u
+}
+
+// RemoveBorrowerIDs removes the "borrowers" edge to Borrower entities by IDs.
+func (_u *GroupUpdateOne) RemoveBorrowerIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveBorrowerIDs(ids...)
+	return _u
+}
+
+// RemoveBorrowers removes "borrowers" edges to Borrower entities.
+func (_u *GroupUpdateOne) RemoveBorrowers(v ...*Borrower) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBorrowerIDs(ids...)
+}
+
+// ClearLoans clears all "loans" edges to the Loan entity.
+func (_u *GroupUpdateOne) ClearLoans() *GroupUpdateOne {
+	_u.mutation.ClearLoans()
+	return _u
+}
+
+// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
+func (_u *GroupUpdateOne) RemoveLoanIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveLoanIDs(ids...)
+	return _u
+}
+
+// RemoveLoans removes "loans" edges to Loan entities.
+func (_u *GroupUpdateOne) RemoveLoans(v ...*Loan) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLoanIDs(ids...)
+}
+
+// ClearKioskSyncActions clears all "kiosk_sync_actions" edges to the KioskSyncAction entity.
+func (_u *GroupUpdateOne) ClearKioskSyncActions() *GroupUpdateOne {
+	_u.mutation.ClearKioskSyncActions()
+	return _u
+}
+
+// RemoveKioskSyncActionIDs removes the "kiosk_sync_actions" edge to KioskSyncAction entities by IDs.
+func (_u *GroupUpdateOne) RemoveKioskSyncActionIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveKioskSyncActionIDs(ids...)
+	return _u
+}
+
+// RemoveKioskSyncActions removes "kiosk_sync_actions" edges to KioskSyncAction entities.
+func (_u *GroupUpdateOne) RemoveKioskSyncActions(v ...*KioskSyncAction) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKioskSyncActionIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *GroupUpdateOne) ClearSavedSearches() *GroupUpdateOne {
+	_u.mutation.ClearSavedSearches()
+	return _u
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *GroupUpdateOne) RemoveSavedSearchIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *GroupUpdateOne) RemoveSavedSearches(v ...*SavedSearch) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearAuditEntries clears all "audit_entries" edges to the AuditEntry entity.
+func (_u *GroupUpdateOne) ClearAuditEntries() *GroupUpdateOne {
+	_u.mutation.ClearAuditEntries()
+	return _u
+}
+
+// RemoveAuditEntryIDs removes the "audit_entries" edge to AuditEntry entities by IDs.
+func (_u *GroupUpdateOne) RemoveAuditEntryIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveAuditEntryIDs(ids...)
+	return _u
+}
+
+// RemoveAuditEntries removes "audit_entries" edges to AuditEntry entities.
+func (_u *GroupUpdateOne) RemoveAuditEntries(v ...*AuditEntry) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuditEntryIDs(ids...)
+}
+
+// ClearFieldDefinitions clears all "field_definitions" edges to the FieldDefinition entity.
+func (_u *GroupUpdateOne) ClearFieldDefinitions() *GroupUpdateOne {
+	_u.mutation.ClearFieldDefinitions()
+	return _u
+}
+
+// RemoveFieldDefinitionIDs removes the "field_definitions" edge to FieldDefinition entities by IDs.
+func (_u *GroupUpdateOne) RemoveFieldDefinitionIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveFieldDefinitionIDs(ids...)
+	return _u
+}
+
+// RemoveFieldDefinitions removes "field_definitions" edges to FieldDefinition entities.
+func (_u *GroupUpdateOne) RemoveFieldDefinitions(v ...*FieldDefinition) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFieldDefinitionIDs(ids...)
+}
+
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *GroupUpdateOne) ClearStockMovements() *GroupUpdateOne {
+	_u.mutation.ClearStockMovements()
+	return _u
+}
+
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *GroupUpdateOne) RemoveStockMovementIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveStockMovementIDs(ids...)
+	return _u
+}
+
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *GroupUpdateOne) RemoveStockMovements(v ...*StockMovement) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockMovementIDs(ids...)
+}
+
+// ClearItemIdentifiers clears all "item_identifiers" edges to the ItemIdentifier entity.
+func (_u *GroupUpdateOne) ClearItemIdentifiers() *GroupUpdateOne {
+	_u.mutation.ClearItemIdentifiers()
+	return _u
+}
+
+// RemoveItemIdentifierIDs removes the "item_identifiers" edge to ItemIdentifier entities by IDs.
+func (_u *GroupUpdateOne) RemoveItemIdentifierIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveItemIdentifierIDs(ids...)
+	return _u
+}
+
+// RemoveItemIdentifiers removes "item_identifiers" edges to ItemIdentifier entities.
+func (_u *GroupUpdateOne) RemoveItemIdentifiers(v ...*ItemIdentifier) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIdentifierIDs(ids...)
+}
+
+// ClearItemStatusChanges clears all "item_status_changes" edges to the ItemStatusChange entity.
+func (_u *GroupUpdateOne) ClearItemStatusChanges() *GroupUpdateOne {
+	_u.mutation.ClearItemStatusChanges()
+	return _u
+}
+
+// RemoveItemStatusChangeIDs removes the "item_status_changes" edge to ItemStatusChange entities by IDs.
+func (_u *GroupUpdateOne) RemoveItemStatusChangeIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveItemStatusChangeIDs(ids...)
+	return _u
+}
+
+// RemoveItemStatusChanges removes "item_status_changes" edges to ItemStatusChange entities.
+func (_u *GroupUpdateOne) RemoveItemStatusChanges(v ...*ItemStatusChange) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemStatusChangeIDs(ids...)
+}
+
+// ClearStocktakeSessions clears all "stocktake_sessions" edges to the StocktakeSession entity.
+func (_u *GroupUpdateOne) ClearStocktakeSessions() *GroupUpdateOne {
+	_u.mutation.ClearStocktakeSessions()
+	return _u
+}
+
+// RemoveStocktakeSessionIDs removes the "stocktake_sessions" edge to StocktakeSession entities by IDs.
+func (_u *GroupUpdateOne) RemoveStocktakeSessionIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveStocktakeSessionIDs(ids...)
+	return _u
+}
+
+// RemoveStocktakeSessions removes "stocktake_sessions" edges to StocktakeSession entities.
+func (_u *GroupUpdateOne) RemoveStocktakeSessions(v ...*StocktakeSession) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStocktakeSessionIDs(ids...)
+}
+
+// ClearLabelTemplates clears all "label_templates" edges to the LabelTemplate entity.
+func (_u *GroupUpdateOne) ClearLabelTemplates() *GroupUpdateOne {
+	_u.mutation.ClearLabelTemplates()
+	return _u
+}
+
+// RemoveLabelTemplateIDs removes the "label_templates" edge to LabelTemplate entities by IDs.
+func (_u *GroupUpdateOne) RemoveLabelTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemoveLabelTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveLabelTemplates removes "label_templates" edges to LabelTemplate entities.
+func (_u *GroupUpdateOne) RemoveLabelTemplates(v ...*LabelTemplate) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLabelTemplateIDs(ids...)
+}
+
+// ClearPrinters clears all "printers" edges to the Printer entity.
+func (_u *GroupUpdateOne) ClearPrinters() *GroupUpdateOne {
+	_u.mutation.ClearPrinters()
+	return _u
+}
+
+// RemovePrinterIDs removes the "printers" edge to Printer entities by IDs.
+func (_u *GroupUpdateOne) RemovePrinterIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemovePrinterIDs(ids...)
+	return _u
+}
+
+// RemovePrinters removes "printers" edges to Printer entities.
+func (_u *GroupUpdateOne) RemovePrinters(v ...*Printer) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrinterIDs(ids...)
+}
+
+// ClearPrintJobs clears all "print_jobs" edges to the PrintJob entity.
+func (_u *GroupUpdateOne) ClearPrintJobs() *GroupUpdateOne {
+	_u.mutation.ClearPrintJobs()
+	return _u
+}
+
+// RemovePrintJobIDs removes the "print_jobs" edge to PrintJob entities by IDs.
+func (_u *GroupUpdateOne) RemovePrintJobIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	_u.mutation.RemovePrintJobIDs(ids...)
+	return _u
+}
+
+// RemovePrintJobs removes "print_jobs" edges to PrintJob entities.
+func (_u *GroupUpdateOne) RemovePrintJobs(v ...*PrintJob) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrintJobIDs(ids...)
+}
+
+// Where appends a list predicates to the GroupUpdate builder.
+func (_u *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GroupUpdateOne) Select(field string, fields ...string) *GroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Group entity.
+func (_u *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GroupUpdateOne) SaveX(ctx context.Context) *Group {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GroupUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := group.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GroupUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDPrefix(); ok {
+		if err := group.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_prefix": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDWidth(); ok {
+		if err := group.AssetIDWidthValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_width", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDCheckDigit(); ok {
+		if err := group.AssetIDCheckDigitValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_check_digit", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_check_digit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDUncheckedMax(); ok {
+		if err := group.AssetIDUncheckedMaxValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_unchecked_max", err: fmt.Errorf(`ent: validator failed for field "Group.asset_id_unchecked_max": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(group.Table, group.Columns, sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Group.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, group.FieldID)
+		for _, f := range fields {
+			if !group.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != group.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(group.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(group.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(group.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(group.FieldAssetIDPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetIDWidth(); ok {
+		_spec.SetField(group.FieldAssetIDWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAssetIDWidth(); ok {
+		_spec.AddField(group.FieldAssetIDWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AssetIDCheckDigit(); ok {
+		_spec.SetField(group.FieldAssetIDCheckDigit, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AssetIDUncheckedMax(); ok {
+		_spec.SetField(group.FieldAssetIDUncheckedMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAssetIDUncheckedMax(); ok {
+		_spec.AddField(group.FieldAssetIDUncheckedMax, field.TypeInt, value)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.UsersTable,
+			Columns: []string{group.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.UsersTable,
+			Columns: []string{group.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.UsersTable,
+			Columns: []string{group.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LocationsTable,
+			Columns: []string{group.LocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLocationsIDs(); len(nodes) > 0 && !_u.mutation.LocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LocationsTable,
+			Columns: []string{group.LocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LocationsTable,
+			Columns: []string{group.LocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemsTable,
+			Columns: []string{group.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemsTable,
+			Columns: []string{group.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemsTable,
+			Columns: []string{group.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LabelsTable,
+			Columns: []string{group.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !_u.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LabelsTable,
+			Columns: []string{group.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LabelsTable,
+			Columns: []string{group.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.InvitationTokensTable,
+			Columns: []string{group.InvitationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvitationtoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvitationTokensIDs(); len(nodes) > 0 && !_u.mutation.InvitationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.InvitationTokensTable,
+			Columns: []string{group.InvitationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvitationtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitationTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.InvitationTokensTable,
+			Columns: []string{group.InvitationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvitationtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotifiersTable,
+			Columns: []string{group.NotifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotifiersIDs(); len(nodes) > 0 && !_u.mutation.NotifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotifiersTable,
+			Columns: []string{group.NotifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotifiersTable,
+			Columns: []string{group.NotifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ItemTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.ItemTemplatesTable,
+			Columns: []string{group.ItemTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BorrowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.BorrowersTable,
+			Columns: []string{group.BorrowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrower.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBorrowersIDs(); len(nodes) > 0 && !_u.mutation.BorrowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.BorrowersTable,
+			Columns: []string{group.BorrowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrower.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BorrowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.BorrowersTable,
+			Columns: []string{group.BorrowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrower.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (_u *GroupUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := group.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+	if _u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LoansTable,
+			Columns: []string{group.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (_u *GroupUpdateOne) check() error {
-	if v, ok := _u.mutation.Name(); ok {
-		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+	if nodes := _u.mutation.RemovedLoansIDs(); len(nodes) > 0 && !_u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LoansTable,
+			Columns: []string{group.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+	if nodes := _u.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.LoansTable,
+			Columns: []string{group.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(group.Table, group.Columns, sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Group.id" for update`)}
+	if _u.mutation.KioskSyncActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.KioskSyncActionsTable,
+			Columns: []string{group.KioskSyncActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, group.FieldID)
-		for _, f := range fields {
-			if !group.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != group.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := _u.mutation.RemovedKioskSyncActionsIDs(); len(nodes) > 0 && !_u.mutation.KioskSyncActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.KioskSyncActionsTable,
+			Columns: []string{group.KioskSyncActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.KioskSyncActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.KioskSyncActionsTable,
+			Columns: []string{group.KioskSyncActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(group.FieldUpdatedAt, field.TypeTime, value)
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(group.FieldName, field.TypeString, value)
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Currency(); ok {
-		_spec.SetField(group.FieldCurrency, field.TypeString, value)
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UsersCleared() {
+	if _u.mutation.AuditEntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.UsersTable,
-			Columns: []string{group.UsersColumn},
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+	if nodes := _u.mutation.RemovedAuditEntriesIDs(); len(nodes) > 0 && !_u.mutation.AuditEntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.UsersTable,
-			Columns: []string{group.UsersColumn},
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1382,15 +3391,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AuditEntriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.UsersTable,
-			Columns: []string{group.UsersColumn},
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1398,28 +3407,28 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LocationsCleared() {
+	if _u.mutation.FieldDefinitionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LocationsTable,
-			Columns: []string{group.LocationsColumn},
+			Table:   group.FieldDefinitionsTable,
+			Columns: []string{group.FieldDefinitionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(fielddefinition.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLocationsIDs(); len(nodes) > 0 && !_u.mutation.LocationsCleared() {
+	if nodes := _u.mutation.RemovedFieldDefinitionsIDs(); len(nodes) > 0 && !_u.mutation.FieldDefinitionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LocationsTable,
-			Columns: []string{group.LocationsColumn},
+			Table:   group.FieldDefinitionsTable,
+			Columns: []string{group.FieldDefinitionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(fielddefinition.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1427,15 +3436,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LocationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.FieldDefinitionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LocationsTable,
-			Columns: []string{group.LocationsColumn},
+			Table:   group.FieldDefinitionsTable,
+			Columns: []string{group.FieldDefinitionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(fielddefinition.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1443,28 +3452,28 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ItemsCleared() {
+	if _u.mutation.StockMovementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.ItemsTable,
-			Columns: []string{group.ItemsColumn},
+			Table:   group.StockMovementsTable,
+			Columns: []string{group.StockMovementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.ItemsTable,
-			Columns: []string{group.ItemsColumn},
+			Table:   group.StockMovementsTable,
+			Columns: []string{group.StockMovementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1472,15 +3481,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.ItemsTable,
-			Columns: []string{group.ItemsColumn},
+			Table:   group.StockMovementsTable,
+			Columns: []string{group.StockMovementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1488,28 +3497,28 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LabelsCleared() {
+	if _u.mutation.ItemIdentifiersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LabelsTable,
-			Columns: []string{group.LabelsColumn},
+			Table:   group.ItemIdentifiersTable,
+			Columns: []string{group.ItemIdentifiersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !_u.mutation.LabelsCleared() {
+	if nodes := _u.mutation.RemovedItemIdentifiersIDs(); len(nodes) > 0 && !_u.mutation.ItemIdentifiersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LabelsTable,
-			Columns: []string{group.LabelsColumn},
+			Table:   group.ItemIdentifiersTable,
+			Columns: []string{group.ItemIdentifiersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1517,15 +3526,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LabelsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ItemIdentifiersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LabelsTable,
-			Columns: []string{group.LabelsColumn},
+			Table:   group.ItemIdentifiersTable,
+			Columns: []string{group.ItemIdentifiersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1533,28 +3542,28 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InvitationTokensCleared() {
+	if _u.mutation.ItemStatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.InvitationTokensTable,
-			Columns: []string{group.InvitationTokensColumn},
+			Table:   group.ItemStatusChangesTable,
+			Columns: []string{group.ItemStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupinvitationtoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInvitationTokensIDs(); len(nodes) > 0 && !_u.mutation.InvitationTokensCleared() {
+	if nodes := _u.mutation.RemovedItemStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.ItemStatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.InvitationTokensTable,
-			Columns: []string{group.InvitationTokensColumn},
+			Table:   group.ItemStatusChangesTable,
+			Columns: []string{group.ItemStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupinvitationtoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1562,15 +3571,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvitationTokensIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ItemStatusChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.InvitationTokensTable,
-			Columns: []string{group.InvitationTokensColumn},
+			Table:   group.ItemStatusChangesTable,
+			Columns: []string{group.ItemStatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupinvitationtoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1578,28 +3587,28 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NotifiersCleared() {
+	if _u.mutation.StocktakeSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.NotifiersTable,
-			Columns: []string{group.NotifiersColumn},
+			Table:   group.StocktakeSessionsTable,
+			Columns: []string{group.StocktakeSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNotifiersIDs(); len(nodes) > 0 && !_u.mutation.NotifiersCleared() {
+	if nodes := _u.mutation.RemovedStocktakeSessionsIDs(); len(nodes) > 0 && !_u.mutation.StocktakeSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.NotifiersTable,
-			Columns: []string{group.NotifiersColumn},
+			Table:   group.StocktakeSessionsTable,
+			Columns: []string{group.StocktakeSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1607,15 +3616,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NotifiersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.StocktakeSessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.NotifiersTable,
-			Columns: []string{group.NotifiersColumn},
+			Table:   group.StocktakeSessionsTable,
+			Columns: []string{group.StocktakeSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1623,28 +3632,28 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ItemTemplatesCleared() {
+	if _u.mutation.LabelTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.ItemTemplatesTable,
-			Columns: []string{group.ItemTemplatesColumn},
+			Table:   group.LabelTemplatesTable,
+			Columns: []string{group.LabelTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedItemTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ItemTemplatesCleared() {
+	if nodes := _u.mutation.RemovedLabelTemplatesIDs(); len(nodes) > 0 && !_u.mutation.LabelTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.ItemTemplatesTable,
-			Columns: []string{group.ItemTemplatesColumn},
+			Table:   group.LabelTemplatesTable,
+			Columns: []string{group.LabelTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1652,15 +3661,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ItemTemplatesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LabelTemplatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.ItemTemplatesTable,
-			Columns: []string{group.ItemTemplatesColumn},
+			Table:   group.LabelTemplatesTable,
+			Columns: []string{group.LabelTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(itemtemplate.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1668,28 +3677,28 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BorrowersCleared() {
+	if _u.mutation.PrintersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.BorrowersTable,
-			Columns: []string{group.BorrowersColumn},
+			Table:   group.PrintersTable,
+			Columns: []string{group.PrintersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(borrower.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBorrowersIDs(); len(nodes) > 0 && !_u.mutation.BorrowersCleared() {
+	if nodes := _u.mutation.RemovedPrintersIDs(); len(nodes) > 0 && !_u.mutation.PrintersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.BorrowersTable,
-			Columns: []string{group.BorrowersColumn},
+			Table:   group.PrintersTable,
+			Columns: []string{group.PrintersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(borrower.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1697,15 +3706,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BorrowersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PrintersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.BorrowersTable,
-			Columns: []string{group.BorrowersColumn},
+			Table:   group.PrintersTable,
+			Columns: []string{group.PrintersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(borrower.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1713,28 +3722,28 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LoansCleared() {
+	if _u.mutation.PrintJobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LoansTable,
-			Columns: []string{group.LoansColumn},
+			Table:   group.PrintJobsTable,
+			Columns: []string{group.PrintJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLoansIDs(); len(nodes) > 0 && !_u.mutation.LoansCleared() {
+	if nodes := _u.mutation.RemovedPrintJobsIDs(); len(nodes) > 0 && !_u.mutation.PrintJobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LoansTable,
-			Columns: []string{group.LoansColumn},
+			Table:   group.PrintJobsTable,
+			Columns: []string{group.PrintJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1742,15 +3751,15 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LoansIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PrintJobsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   group.LoansTable,
-			Columns: []string{group.LoansColumn},
+			Table:   group.PrintJobsTable,
+			Columns: []string{group.PrintJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
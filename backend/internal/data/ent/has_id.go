@@ -8,6 +8,10 @@ func (_m *Attachment) GetID() uuid.UUID {
 	return _m.ID
 }
 
+func (_m *AuditEntry) GetID() uuid.UUID {
+	return _m.ID
+}
+
 func (_m *AuthRoles) GetID() int {
 	return _m.ID
 }
@@ -20,6 +24,10 @@ func (_m *Borrower) GetID() uuid.UUID {
 	return _m.ID
 }
 
+func (_m *FieldDefinition) GetID() uuid.UUID {
+	return _m.ID
+}
+
 func (_m *Group) GetID() uuid.UUID {
 	return _m.ID
 }
@@ -36,6 +44,14 @@ func (_m *ItemField) GetID() uuid.UUID {
 	return _m.ID
 }
 
+func (_m *ItemIdentifier) GetID() uuid.UUID {
+	return _m.ID
+}
+
+func (_m *ItemStatusChange) GetID() uuid.UUID {
+	return _m.ID
+}
+
 func (_m *ItemTemplate) GetID() uuid.UUID {
 	return _m.ID
 }
@@ -44,10 +60,18 @@ func (_m *KioskSession) GetID() uuid.UUID {
 	return _m.ID
 }
 
+func (_m *KioskSyncAction) GetID() uuid.UUID {
+	return _m.ID
+}
+
 func (_m *Label) GetID() uuid.UUID {
 	return _m.ID
 }
 
+func (_m *LabelTemplate) GetID() uuid.UUID {
+	return _m.ID
+}
+
 func (_m *Loan) GetID() uuid.UUID {
 	return _m.ID
 }
@@ -64,6 +88,30 @@ func (_m *Notifier) GetID() uuid.UUID {
 	return _m.ID
 }
 
+func (_m *PrintJob) GetID() uuid.UUID {
+	return _m.ID
+}
+
+func (_m *Printer) GetID() uuid.UUID {
+	return _m.ID
+}
+
+func (_m *SavedSearch) GetID() uuid.UUID {
+	return _m.ID
+}
+
+func (_m *StockMovement) GetID() uuid.UUID {
+	return _m.ID
+}
+
+func (_m *StocktakeEntry) GetID() uuid.UUID {
+	return _m.ID
+}
+
+func (_m *StocktakeSession) GetID() uuid.UUID {
+	return _m.ID
+}
+
 func (_m *TemplateField) GetID() uuid.UUID {
 	return _m.ID
 }
@@ -21,6 +21,18 @@ func (f AttachmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttachmentMutation", m)
 }
 
+// The AuditEntryFunc type is an adapter to allow the use of ordinary
+// function as AuditEntry mutator.
+type AuditEntryFunc func(context.Context, *ent.AuditEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEntryMutation", m)
+}
+
 // The AuthRolesFunc type is an adapter to allow the use of ordinary
 // function as AuthRoles mutator.
 type AuthRolesFunc func(context.Context, *ent.AuthRolesMutation) (ent.Value, error)
@@ -57,6 +69,18 @@ func (f BorrowerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BorrowerMutation", m)
 }
 
+// The FieldDefinitionFunc type is an adapter to allow the use of ordinary
+// function as FieldDefinition mutator.
+type FieldDefinitionFunc func(context.Context, *ent.FieldDefinitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FieldDefinitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FieldDefinitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FieldDefinitionMutation", m)
+}
+
 // The GroupFunc type is an adapter to allow the use of ordinary
 // function as Group mutator.
 type GroupFunc func(context.Context, *ent.GroupMutation) (ent.Value, error)
@@ -105,6 +129,30 @@ func (f ItemFieldFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemFieldMutation", m)
 }
 
+// The ItemIdentifierFunc type is an adapter to allow the use of ordinary
+// function as ItemIdentifier mutator.
+type ItemIdentifierFunc func(context.Context, *ent.ItemIdentifierMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemIdentifierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ItemIdentifierMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemIdentifierMutation", m)
+}
+
+// The ItemStatusChangeFunc type is an adapter to allow the use of ordinary
+// function as ItemStatusChange mutator.
+type ItemStatusChangeFunc func(context.Context, *ent.ItemStatusChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemStatusChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ItemStatusChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemStatusChangeMutation", m)
+}
+
 // The ItemTemplateFunc type is an adapter to allow the use of ordinary
 // function as ItemTemplate mutator.
 type ItemTemplateFunc func(context.Context, *ent.ItemTemplateMutation) (ent.Value, error)
@@ -129,6 +177,18 @@ func (f KioskSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KioskSessionMutation", m)
 }
 
+// The KioskSyncActionFunc type is an adapter to allow the use of ordinary
+// function as KioskSyncAction mutator.
+type KioskSyncActionFunc func(context.Context, *ent.KioskSyncActionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KioskSyncActionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KioskSyncActionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KioskSyncActionMutation", m)
+}
+
 // The LabelFunc type is an adapter to allow the use of ordinary
 // function as Label mutator.
 type LabelFunc func(context.Context, *ent.LabelMutation) (ent.Value, error)
@@ -141,6 +201,18 @@ func (f LabelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LabelMutation", m)
 }
 
+// The LabelTemplateFunc type is an adapter to allow the use of ordinary
+// function as LabelTemplate mutator.
+type LabelTemplateFunc func(context.Context, *ent.LabelTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LabelTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LabelTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LabelTemplateMutation", m)
+}
+
 // The LoanFunc type is an adapter to allow the use of ordinary
 // function as Loan mutator.
 type LoanFunc func(context.Context, *ent.LoanMutation) (ent.Value, error)
@@ -189,6 +261,78 @@ func (f NotifierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotifierMutation", m)
 }
 
+// The PrintJobFunc type is an adapter to allow the use of ordinary
+// function as PrintJob mutator.
+type PrintJobFunc func(context.Context, *ent.PrintJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PrintJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PrintJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PrintJobMutation", m)
+}
+
+// The PrinterFunc type is an adapter to allow the use of ordinary
+// function as Printer mutator.
+type PrinterFunc func(context.Context, *ent.PrinterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PrinterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PrinterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PrinterMutation", m)
+}
+
+// The SavedSearchFunc type is an adapter to allow the use of ordinary
+// function as SavedSearch mutator.
+type SavedSearchFunc func(context.Context, *ent.SavedSearchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedSearchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedSearchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedSearchMutation", m)
+}
+
+// The StockMovementFunc type is an adapter to allow the use of ordinary
+// function as StockMovement mutator.
+type StockMovementFunc func(context.Context, *ent.StockMovementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockMovementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StockMovementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockMovementMutation", m)
+}
+
+// The StocktakeEntryFunc type is an adapter to allow the use of ordinary
+// function as StocktakeEntry mutator.
+type StocktakeEntryFunc func(context.Context, *ent.StocktakeEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StocktakeEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StocktakeEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StocktakeEntryMutation", m)
+}
+
+// The StocktakeSessionFunc type is an adapter to allow the use of ordinary
+// function as StocktakeSession mutator.
+type StocktakeSessionFunc func(context.Context, *ent.StocktakeSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StocktakeSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StocktakeSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StocktakeSessionMutation", m)
+}
+
 // The TemplateFieldFunc type is an adapter to allow the use of ordinary
 // function as TemplateField mutator.
 type TemplateFieldFunc func(context.Context, *ent.TemplateFieldMutation) (ent.Value, error)
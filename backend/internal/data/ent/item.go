@@ -28,6 +28,8 @@ type Item struct {
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// ImportRef holds the value of the "import_ref" field.
 	ImportRef string `json:"import_ref,omitempty"`
 	// Notes holds the value of the "notes" field.
@@ -40,8 +42,12 @@ type Item struct {
 	Archived bool `json:"archived,omitempty"`
 	// AssetID holds the value of the "asset_id" field.
 	AssetID int `json:"asset_id,omitempty"`
+	// Prefix of the series the asset ID is numbered in, empty for the group's
+	AssetIDPrefix string `json:"asset_id_prefix,omitempty"`
 	// SyncChildItemsLocations holds the value of the "sync_child_items_locations" field.
 	SyncChildItemsLocations bool `json:"sync_child_items_locations,omitempty"`
+	// Lifecycle status, only changed through the allowed transitions
+	Status item.Status `json:"status,omitempty"`
 	// SerialNumber holds the value of the "serial_number" field.
 	SerialNumber string `json:"serial_number,omitempty"`
 	// ModelNumber holds the value of the "model_number" field.
@@ -68,6 +74,14 @@ type Item struct {
 	SoldPrice float64 `json:"sold_price,omitempty"`
 	// SoldNotes holds the value of the "sold_notes" field.
 	SoldNotes string `json:"sold_notes,omitempty"`
+	// When the item was returned into quarantine (null = not quarantined)
+	QuarantinedAt *time.Time `json:"quarantined_at,omitempty"`
+	// When the quarantine ends on its own (null = until staff sign off)
+	QuarantineUntil *time.Time `json:"quarantine_until,omitempty"`
+	// Quantity below which the item is low on stock (0 = not tracked)
+	MinStock int `json:"min_stock,omitempty"`
+	// Quantity to order when the item is low on stock
+	ReorderQuantity int `json:"reorder_quantity,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ItemQuery when eager-loading is set.
 	Edges          ItemEdges `json:"edges"`
@@ -95,11 +109,19 @@ type ItemEdges struct {
 	MaintenanceEntries []*MaintenanceEntry `json:"maintenance_entries,omitempty"`
 	// Attachments holds the value of the attachments edge.
 	Attachments []*Attachment `json:"attachments,omitempty"`
+	// StockMovements holds the value of the stock_movements edge.
+	StockMovements []*StockMovement `json:"stock_movements,omitempty"`
+	// Identifiers holds the value of the identifiers edge.
+	Identifiers []*ItemIdentifier `json:"identifiers,omitempty"`
+	// StatusChanges holds the value of the status_changes edge.
+	StatusChanges []*ItemStatusChange `json:"status_changes,omitempty"`
+	// StocktakeEntries holds the value of the stocktake_entries edge.
+	StocktakeEntries []*StocktakeEntry `json:"stocktake_entries,omitempty"`
 	// Loans holds the value of the loans edge.
 	Loans []*Loan `json:"loans,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [9]bool
+	loadedTypes [13]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -180,10 +202,46 @@ func (e ItemEdges) AttachmentsOrErr() ([]*Attachment, error) {
 	return nil, &NotLoadedError{edge: "attachments"}
 }
 
+// StockMovementsOrErr returns the StockMovements value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) StockMovementsOrErr() ([]*StockMovement, error) {
+	if e.loadedTypes[8] {
+		return e.StockMovements, nil
+	}
+	return nil, &NotLoadedError{edge: "stock_movements"}
+}
+
+// IdentifiersOrErr returns the Identifiers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) IdentifiersOrErr() ([]*ItemIdentifier, error) {
+	if e.loadedTypes[9] {
+		return e.Identifiers, nil
+	}
+	return nil, &NotLoadedError{edge: "identifiers"}
+}
+
+// StatusChangesOrErr returns the StatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) StatusChangesOrErr() ([]*ItemStatusChange, error) {
+	if e.loadedTypes[10] {
+		return e.StatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "status_changes"}
+}
+
+// StocktakeEntriesOrErr returns the StocktakeEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) StocktakeEntriesOrErr() ([]*StocktakeEntry, error) {
+	if e.loadedTypes[11] {
+		return e.StocktakeEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "stocktake_entries"}
+}
+
 // LoansOrErr returns the Loans value or an error if the edge
 // was not loaded in eager-loading.
 func (e ItemEdges) LoansOrErr() ([]*Loan, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[12] {
 		return e.Loans, nil
 	}
 	return nil, &NotLoadedError{edge: "loans"}
@@ -198,11 +256,11 @@ func (*Item) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case item.FieldPurchasePrice, item.FieldSoldPrice:
 			values[i] = new(sql.NullFloat64)
-		case item.FieldQuantity, item.FieldAssetID:
+		case item.FieldQuantity, item.FieldAssetID, item.FieldMinStock, item.FieldReorderQuantity:
 			values[i] = new(sql.NullInt64)
-		case item.FieldName, item.FieldDescription, item.FieldImportRef, item.FieldNotes, item.FieldSerialNumber, item.FieldModelNumber, item.FieldManufacturer, item.FieldWarrantyDetails, item.FieldPurchaseFrom, item.FieldSoldTo, item.FieldSoldNotes:
+		case item.FieldName, item.FieldDescription, item.FieldImportRef, item.FieldNotes, item.FieldAssetIDPrefix, item.FieldStatus, item.FieldSerialNumber, item.FieldModelNumber, item.FieldManufacturer, item.FieldWarrantyDetails, item.FieldPurchaseFrom, item.FieldSoldTo, item.FieldSoldNotes:
 			values[i] = new(sql.NullString)
-		case item.FieldCreatedAt, item.FieldUpdatedAt, item.FieldWarrantyExpires, item.FieldPurchaseTime, item.FieldSoldTime:
+		case item.FieldCreatedAt, item.FieldUpdatedAt, item.FieldDeletedAt, item.FieldWarrantyExpires, item.FieldPurchaseTime, item.FieldSoldTime, item.FieldQuarantinedAt, item.FieldQuarantineUntil:
 			values[i] = new(sql.NullTime)
 		case item.FieldID:
 			values[i] = new(uuid.UUID)
@@ -257,6 +315,13 @@ func (_m *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case item.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case item.FieldImportRef:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field import_ref", values[i])
@@ -293,12 +358,24 @@ func (_m *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AssetID = int(value.Int64)
 			}
+		case item.FieldAssetIDPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id_prefix", values[i])
+			} else if value.Valid {
+				_m.AssetIDPrefix = value.String
+			}
 		case item.FieldSyncChildItemsLocations:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field sync_child_items_locations", values[i])
 			} else if value.Valid {
 				_m.SyncChildItemsLocations = value.Bool
 			}
+		case item.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = item.Status(value.String)
+			}
 		case item.FieldSerialNumber:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field serial_number", values[i])
@@ -377,6 +454,32 @@ func (_m *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SoldNotes = value.String
 			}
+		case item.FieldQuarantinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantined_at", values[i])
+			} else if value.Valid {
+				_m.QuarantinedAt = new(time.Time)
+				*_m.QuarantinedAt = value.Time
+			}
+		case item.FieldQuarantineUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantine_until", values[i])
+			} else if value.Valid {
+				_m.QuarantineUntil = new(time.Time)
+				*_m.QuarantineUntil = value.Time
+			}
+		case item.FieldMinStock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_stock", values[i])
+			} else if value.Valid {
+				_m.MinStock = int(value.Int64)
+			}
+		case item.FieldReorderQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reorder_quantity", values[i])
+			} else if value.Valid {
+				_m.ReorderQuantity = int(value.Int64)
+			}
 		case item.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field group_items", values[i])
@@ -451,6 +554,26 @@ func (_m *Item) QueryAttachments() *AttachmentQuery {
 	return NewItemClient(_m.config).QueryAttachments(_m)
 }
 
+// QueryStockMovements queries the "stock_movements" edge of the Item entity.
+func (_m *Item) QueryStockMovements() *StockMovementQuery {
+	return NewItemClient(_m.config).QueryStockMovements(_m)
+}
+
+// QueryIdentifiers queries the "identifiers" edge of the Item entity.
+func (_m *Item) QueryIdentifiers() *ItemIdentifierQuery {
+	return NewItemClient(_m.config).QueryIdentifiers(_m)
+}
+
+// QueryStatusChanges queries the "status_changes" edge of the Item entity.
+func (_m *Item) QueryStatusChanges() *ItemStatusChangeQuery {
+	return NewItemClient(_m.config).QueryStatusChanges(_m)
+}
+
+// QueryStocktakeEntries queries the "stocktake_entries" edge of the Item entity.
+func (_m *Item) QueryStocktakeEntries() *StocktakeEntryQuery {
+	return NewItemClient(_m.config).QueryStocktakeEntries(_m)
+}
+
 // QueryLoans queries the "loans" edge of the Item entity.
 func (_m *Item) QueryLoans() *LoanQuery {
 	return NewItemClient(_m.config).QueryLoans(_m)
@@ -491,6 +614,11 @@ func (_m *Item) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("import_ref=")
 	builder.WriteString(_m.ImportRef)
 	builder.WriteString(", ")
@@ -509,9 +637,15 @@ func (_m *Item) String() string {
 	builder.WriteString("asset_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AssetID))
 	builder.WriteString(", ")
+	builder.WriteString("asset_id_prefix=")
+	builder.WriteString(_m.AssetIDPrefix)
+	builder.WriteString(", ")
 	builder.WriteString("sync_child_items_locations=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SyncChildItemsLocations))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
 	builder.WriteString("serial_number=")
 	builder.WriteString(_m.SerialNumber)
 	builder.WriteString(", ")
@@ -550,6 +684,22 @@ func (_m *Item) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("sold_notes=")
 	builder.WriteString(_m.SoldNotes)
+	builder.WriteString(", ")
+	if v := _m.QuarantinedAt; v != nil {
+		builder.WriteString("quarantined_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.QuarantineUntil; v != nil {
+		builder.WriteString("quarantine_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("min_stock=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinStock))
+	builder.WriteString(", ")
+	builder.WriteString("reorder_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReorderQuantity))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package item
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -23,6 +24,8 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldImportRef holds the string denoting the import_ref field in the database.
 	FieldImportRef = "import_ref"
 	// FieldNotes holds the string denoting the notes field in the database.
@@ -35,8 +38,12 @@ const (
 	FieldArchived = "archived"
 	// FieldAssetID holds the string denoting the asset_id field in the database.
 	FieldAssetID = "asset_id"
+	// FieldAssetIDPrefix holds the string denoting the asset_id_prefix field in the database.
+	FieldAssetIDPrefix = "asset_id_prefix"
 	// FieldSyncChildItemsLocations holds the string denoting the sync_child_items_locations field in the database.
 	FieldSyncChildItemsLocations = "sync_child_items_locations"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// FieldSerialNumber holds the string denoting the serial_number field in the database.
 	FieldSerialNumber = "serial_number"
 	// FieldModelNumber holds the string denoting the model_number field in the database.
@@ -63,6 +70,14 @@ const (
 	FieldSoldPrice = "sold_price"
 	// FieldSoldNotes holds the string denoting the sold_notes field in the database.
 	FieldSoldNotes = "sold_notes"
+	// FieldQuarantinedAt holds the string denoting the quarantined_at field in the database.
+	FieldQuarantinedAt = "quarantined_at"
+	// FieldQuarantineUntil holds the string denoting the quarantine_until field in the database.
+	FieldQuarantineUntil = "quarantine_until"
+	// FieldMinStock holds the string denoting the min_stock field in the database.
+	FieldMinStock = "min_stock"
+	// FieldReorderQuantity holds the string denoting the reorder_quantity field in the database.
+	FieldReorderQuantity = "reorder_quantity"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
@@ -79,6 +94,14 @@ const (
 	EdgeMaintenanceEntries = "maintenance_entries"
 	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
 	EdgeAttachments = "attachments"
+	// EdgeStockMovements holds the string denoting the stock_movements edge name in mutations.
+	EdgeStockMovements = "stock_movements"
+	// EdgeIdentifiers holds the string denoting the identifiers edge name in mutations.
+	EdgeIdentifiers = "identifiers"
+	// EdgeStatusChanges holds the string denoting the status_changes edge name in mutations.
+	EdgeStatusChanges = "status_changes"
+	// EdgeStocktakeEntries holds the string denoting the stocktake_entries edge name in mutations.
+	EdgeStocktakeEntries = "stocktake_entries"
 	// EdgeLoans holds the string denoting the loans edge name in mutations.
 	EdgeLoans = "loans"
 	// Table holds the table name of the item in the database.
@@ -131,6 +154,34 @@ const (
 	AttachmentsInverseTable = "attachments"
 	// AttachmentsColumn is the table column denoting the attachments relation/edge.
 	AttachmentsColumn = "item_attachments"
+	// StockMovementsTable is the table that holds the stock_movements relation/edge.
+	StockMovementsTable = "stock_movements"
+	// StockMovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	StockMovementsInverseTable = "stock_movements"
+	// StockMovementsColumn is the table column denoting the stock_movements relation/edge.
+	StockMovementsColumn = "item_id"
+	// IdentifiersTable is the table that holds the identifiers relation/edge.
+	IdentifiersTable = "item_identifiers"
+	// IdentifiersInverseTable is the table name for the ItemIdentifier entity.
+	// It exists in this package in order to avoid circular dependency with the "itemidentifier" package.
+	IdentifiersInverseTable = "item_identifiers"
+	// IdentifiersColumn is the table column denoting the identifiers relation/edge.
+	IdentifiersColumn = "item_id"
+	// StatusChangesTable is the table that holds the status_changes relation/edge.
+	StatusChangesTable = "item_status_changes"
+	// StatusChangesInverseTable is the table name for the ItemStatusChange entity.
+	// It exists in this package in order to avoid circular dependency with the "itemstatuschange" package.
+	StatusChangesInverseTable = "item_status_changes"
+	// StatusChangesColumn is the table column denoting the status_changes relation/edge.
+	StatusChangesColumn = "item_id"
+	// StocktakeEntriesTable is the table that holds the stocktake_entries relation/edge.
+	StocktakeEntriesTable = "stocktake_entries"
+	// StocktakeEntriesInverseTable is the table name for the StocktakeEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "stocktakeentry" package.
+	StocktakeEntriesInverseTable = "stocktake_entries"
+	// StocktakeEntriesColumn is the table column denoting the stocktake_entries relation/edge.
+	StocktakeEntriesColumn = "item_id"
 	// LoansTable is the table that holds the loans relation/edge.
 	LoansTable = "loans"
 	// LoansInverseTable is the table name for the Loan entity.
@@ -147,13 +198,16 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldName,
 	FieldDescription,
+	FieldDeletedAt,
 	FieldImportRef,
 	FieldNotes,
 	FieldQuantity,
 	FieldInsured,
 	FieldArchived,
 	FieldAssetID,
+	FieldAssetIDPrefix,
 	FieldSyncChildItemsLocations,
+	FieldStatus,
 	FieldSerialNumber,
 	FieldModelNumber,
 	FieldManufacturer,
@@ -167,6 +221,10 @@ var Columns = []string{
 	FieldSoldTo,
 	FieldSoldPrice,
 	FieldSoldNotes,
+	FieldQuarantinedAt,
+	FieldQuarantineUntil,
+	FieldMinStock,
+	FieldReorderQuantity,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "items"
@@ -221,6 +279,10 @@ var (
 	DefaultArchived bool
 	// DefaultAssetID holds the default value on creation for the "asset_id" field.
 	DefaultAssetID int
+	// DefaultAssetIDPrefix holds the default value on creation for the "asset_id_prefix" field.
+	DefaultAssetIDPrefix string
+	// AssetIDPrefixValidator is a validator for the "asset_id_prefix" field. It is called by the builders before save.
+	AssetIDPrefixValidator func(string) error
 	// DefaultSyncChildItemsLocations holds the default value on creation for the "sync_child_items_locations" field.
 	DefaultSyncChildItemsLocations bool
 	// SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
@@ -239,10 +301,44 @@ var (
 	DefaultSoldPrice float64
 	// SoldNotesValidator is a validator for the "sold_notes" field. It is called by the builders before save.
 	SoldNotesValidator func(string) error
+	// DefaultMinStock holds the default value on creation for the "min_stock" field.
+	DefaultMinStock int
+	// DefaultReorderQuantity holds the default value on creation for the "reorder_quantity" field.
+	DefaultReorderQuantity int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusInService is the default value of the Status enum.
+const DefaultStatus = StatusInService
+
+// Status values.
+const (
+	StatusInService Status = "in_service"
+	StatusInRepair  Status = "in_repair"
+	StatusLost      Status = "lost"
+	StatusStolen    Status = "stolen"
+	StatusRetired   Status = "retired"
+	StatusDisposed  Status = "disposed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusInService, StatusInRepair, StatusLost, StatusStolen, StatusRetired, StatusDisposed:
+		return nil
+	default:
+		return fmt.Errorf("item: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Item queries.
 type OrderOption func(*sql.Selector)
 
@@ -271,6 +367,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByImportRef orders the results by the import_ref field.
 func ByImportRef(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImportRef, opts...).ToFunc()
@@ -301,11 +402,21 @@ func ByAssetID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAssetID, opts...).ToFunc()
 }
 
+// ByAssetIDPrefix orders the results by the asset_id_prefix field.
+func ByAssetIDPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetIDPrefix, opts...).ToFunc()
+}
+
 // BySyncChildItemsLocations orders the results by the sync_child_items_locations field.
 func BySyncChildItemsLocations(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSyncChildItemsLocations, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
 // BySerialNumber orders the results by the serial_number field.
 func BySerialNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSerialNumber, opts...).ToFunc()
@@ -371,6 +482,26 @@ func BySoldNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSoldNotes, opts...).ToFunc()
 }
 
+// ByQuarantinedAt orders the results by the quarantined_at field.
+func ByQuarantinedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantinedAt, opts...).ToFunc()
+}
+
+// ByQuarantineUntil orders the results by the quarantine_until field.
+func ByQuarantineUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantineUntil, opts...).ToFunc()
+}
+
+// ByMinStock orders the results by the min_stock field.
+func ByMinStock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinStock, opts...).ToFunc()
+}
+
+// ByReorderQuantity orders the results by the reorder_quantity field.
+func ByReorderQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReorderQuantity, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -462,6 +593,62 @@ func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByStockMovementsCount orders the results by stock_movements count.
+func ByStockMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStockMovementsStep(), opts...)
+	}
+}
+
+// ByStockMovements orders the results by stock_movements terms.
+func ByStockMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdentifiersCount orders the results by identifiers count.
+func ByIdentifiersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentifiersStep(), opts...)
+	}
+}
+
+// ByIdentifiers orders the results by identifiers terms.
+func ByIdentifiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentifiersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatusChangesCount orders the results by status_changes count.
+func ByStatusChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusChangesStep(), opts...)
+	}
+}
+
+// ByStatusChanges orders the results by status_changes terms.
+func ByStatusChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStocktakeEntriesCount orders the results by stocktake_entries count.
+func ByStocktakeEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStocktakeEntriesStep(), opts...)
+	}
+}
+
+// ByStocktakeEntries orders the results by stocktake_entries terms.
+func ByStocktakeEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStocktakeEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByLoansCount orders the results by loans count.
 func ByLoansCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -531,6 +718,34 @@ func newAttachmentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
 	)
 }
+func newStockMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockMovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+	)
+}
+func newIdentifiersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentifiersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentifiersTable, IdentifiersColumn),
+	)
+}
+func newStatusChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+	)
+}
+func newStocktakeEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StocktakeEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StocktakeEntriesTable, StocktakeEntriesColumn),
+	)
+}
 func newLoansStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -76,6 +76,11 @@ func Description(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldDescription, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // ImportRef applies equality check predicate on the "import_ref" field. It's identical to ImportRefEQ.
 func ImportRef(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldImportRef, v))
@@ -106,6 +111,11 @@ func AssetID(v int) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldAssetID, v))
 }
 
+// AssetIDPrefix applies equality check predicate on the "asset_id_prefix" field. It's identical to AssetIDPrefixEQ.
+func AssetIDPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAssetIDPrefix, v))
+}
+
 // SyncChildItemsLocations applies equality check predicate on the "sync_child_items_locations" field. It's identical to SyncChildItemsLocationsEQ.
 func SyncChildItemsLocations(v bool) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldSyncChildItemsLocations, v))
@@ -176,6 +186,26 @@ func SoldNotes(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldSoldNotes, v))
 }
 
+// QuarantinedAt applies equality check predicate on the "quarantined_at" field. It's identical to QuarantinedAtEQ.
+func QuarantinedAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldQuarantinedAt, v))
+}
+
+// QuarantineUntil applies equality check predicate on the "quarantine_until" field. It's identical to QuarantineUntilEQ.
+func QuarantineUntil(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldQuarantineUntil, v))
+}
+
+// MinStock applies equality check predicate on the "min_stock" field. It's identical to MinStockEQ.
+func MinStock(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldMinStock, v))
+}
+
+// ReorderQuantity applies equality check predicate on the "reorder_quantity" field. It's identical to ReorderQuantityEQ.
+func ReorderQuantity(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldReorderQuantity, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldCreatedAt, v))
@@ -396,6 +426,56 @@ func DescriptionContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // ImportRefEQ applies the EQ predicate on the "import_ref" field.
 func ImportRefEQ(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldImportRef, v))
@@ -646,6 +726,71 @@ func AssetIDLTE(v int) predicate.Item {
 	return predicate.Item(sql.FieldLTE(FieldAssetID, v))
 }
 
+// AssetIDPrefixEQ applies the EQ predicate on the "asset_id_prefix" field.
+func AssetIDPrefixEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixNEQ applies the NEQ predicate on the "asset_id_prefix" field.
+func AssetIDPrefixNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixIn applies the In predicate on the "asset_id_prefix" field.
+func AssetIDPrefixIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldAssetIDPrefix, vs...))
+}
+
+// AssetIDPrefixNotIn applies the NotIn predicate on the "asset_id_prefix" field.
+func AssetIDPrefixNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldAssetIDPrefix, vs...))
+}
+
+// AssetIDPrefixGT applies the GT predicate on the "asset_id_prefix" field.
+func AssetIDPrefixGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixGTE applies the GTE predicate on the "asset_id_prefix" field.
+func AssetIDPrefixGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixLT applies the LT predicate on the "asset_id_prefix" field.
+func AssetIDPrefixLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixLTE applies the LTE predicate on the "asset_id_prefix" field.
+func AssetIDPrefixLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixContains applies the Contains predicate on the "asset_id_prefix" field.
+func AssetIDPrefixContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixHasPrefix applies the HasPrefix predicate on the "asset_id_prefix" field.
+func AssetIDPrefixHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixHasSuffix applies the HasSuffix predicate on the "asset_id_prefix" field.
+func AssetIDPrefixHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixEqualFold applies the EqualFold predicate on the "asset_id_prefix" field.
+func AssetIDPrefixEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixContainsFold applies the ContainsFold predicate on the "asset_id_prefix" field.
+func AssetIDPrefixContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldAssetIDPrefix, v))
+}
+
 // SyncChildItemsLocationsEQ applies the EQ predicate on the "sync_child_items_locations" field.
 func SyncChildItemsLocationsEQ(v bool) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldSyncChildItemsLocations, v))
@@ -656,6 +801,26 @@ func SyncChildItemsLocationsNEQ(v bool) predicate.Item {
 	return predicate.Item(sql.FieldNEQ(FieldSyncChildItemsLocations, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldStatus, vs...))
+}
+
 // SerialNumberEQ applies the EQ predicate on the "serial_number" field.
 func SerialNumberEQ(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldSerialNumber, v))
@@ -1421,6 +1586,186 @@ func SoldNotesContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldSoldNotes, v))
 }
 
+// QuarantinedAtEQ applies the EQ predicate on the "quarantined_at" field.
+func QuarantinedAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldQuarantinedAt, v))
+}
+
+// QuarantinedAtNEQ applies the NEQ predicate on the "quarantined_at" field.
+func QuarantinedAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldQuarantinedAt, v))
+}
+
+// QuarantinedAtIn applies the In predicate on the "quarantined_at" field.
+func QuarantinedAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldQuarantinedAt, vs...))
+}
+
+// QuarantinedAtNotIn applies the NotIn predicate on the "quarantined_at" field.
+func QuarantinedAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldQuarantinedAt, vs...))
+}
+
+// QuarantinedAtGT applies the GT predicate on the "quarantined_at" field.
+func QuarantinedAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldQuarantinedAt, v))
+}
+
+// QuarantinedAtGTE applies the GTE predicate on the "quarantined_at" field.
+func QuarantinedAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldQuarantinedAt, v))
+}
+
+// QuarantinedAtLT applies the LT predicate on the "quarantined_at" field.
+func QuarantinedAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldQuarantinedAt, v))
+}
+
+// QuarantinedAtLTE applies the LTE predicate on the "quarantined_at" field.
+func QuarantinedAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldQuarantinedAt, v))
+}
+
+// QuarantinedAtIsNil applies the IsNil predicate on the "quarantined_at" field.
+func QuarantinedAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldQuarantinedAt))
+}
+
+// QuarantinedAtNotNil applies the NotNil predicate on the "quarantined_at" field.
+func QuarantinedAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldQuarantinedAt))
+}
+
+// QuarantineUntilEQ applies the EQ predicate on the "quarantine_until" field.
+func QuarantineUntilEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldQuarantineUntil, v))
+}
+
+// QuarantineUntilNEQ applies the NEQ predicate on the "quarantine_until" field.
+func QuarantineUntilNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldQuarantineUntil, v))
+}
+
+// QuarantineUntilIn applies the In predicate on the "quarantine_until" field.
+func QuarantineUntilIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldQuarantineUntil, vs...))
+}
+
+// QuarantineUntilNotIn applies the NotIn predicate on the "quarantine_until" field.
+func QuarantineUntilNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldQuarantineUntil, vs...))
+}
+
+// QuarantineUntilGT applies the GT predicate on the "quarantine_until" field.
+func QuarantineUntilGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldQuarantineUntil, v))
+}
+
+// QuarantineUntilGTE applies the GTE predicate on the "quarantine_until" field.
+func QuarantineUntilGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldQuarantineUntil, v))
+}
+
+// QuarantineUntilLT applies the LT predicate on the "quarantine_until" field.
+func QuarantineUntilLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldQuarantineUntil, v))
+}
+
+// QuarantineUntilLTE applies the LTE predicate on the "quarantine_until" field.
+func QuarantineUntilLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldQuarantineUntil, v))
+}
+
+// QuarantineUntilIsNil applies the IsNil predicate on the "quarantine_until" field.
+func QuarantineUntilIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldQuarantineUntil))
+}
+
+// QuarantineUntilNotNil applies the NotNil predicate on the "quarantine_until" field.
+func QuarantineUntilNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldQuarantineUntil))
+}
+
+// MinStockEQ applies the EQ predicate on the "min_stock" field.
+func MinStockEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldMinStock, v))
+}
+
+// MinStockNEQ applies the NEQ predicate on the "min_stock" field.
+func MinStockNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldMinStock, v))
+}
+
+// MinStockIn applies the In predicate on the "min_stock" field.
+func MinStockIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldMinStock, vs...))
+}
+
+// MinStockNotIn applies the NotIn predicate on the "min_stock" field.
+func MinStockNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldMinStock, vs...))
+}
+
+// MinStockGT applies the GT predicate on the "min_stock" field.
+func MinStockGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldMinStock, v))
+}
+
+// MinStockGTE applies the GTE predicate on the "min_stock" field.
+func MinStockGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldMinStock, v))
+}
+
+// MinStockLT applies the LT predicate on the "min_stock" field.
+func MinStockLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldMinStock, v))
+}
+
+// MinStockLTE applies the LTE predicate on the "min_stock" field.
+func MinStockLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldMinStock, v))
+}
+
+// ReorderQuantityEQ applies the EQ predicate on the "reorder_quantity" field.
+func ReorderQuantityEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityNEQ applies the NEQ predicate on the "reorder_quantity" field.
+func ReorderQuantityNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityIn applies the In predicate on the "reorder_quantity" field.
+func ReorderQuantityIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldReorderQuantity, vs...))
+}
+
+// ReorderQuantityNotIn applies the NotIn predicate on the "reorder_quantity" field.
+func ReorderQuantityNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldReorderQuantity, vs...))
+}
+
+// ReorderQuantityGT applies the GT predicate on the "reorder_quantity" field.
+func ReorderQuantityGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityGTE applies the GTE predicate on the "reorder_quantity" field.
+func ReorderQuantityGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityLT applies the LT predicate on the "reorder_quantity" field.
+func ReorderQuantityLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityLTE applies the LTE predicate on the "reorder_quantity" field.
+func ReorderQuantityLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldReorderQuantity, v))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
@@ -1605,6 +1950,98 @@ func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Item {
 	})
 }
 
+// HasStockMovements applies the HasEdge predicate on the "stock_movements" edge.
+func HasStockMovements() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStockMovementsWith applies the HasEdge predicate on the "stock_movements" edge with a given conditions (other predicates).
+func HasStockMovementsWith(preds ...predicate.StockMovement) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newStockMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIdentifiers applies the HasEdge predicate on the "identifiers" edge.
+func HasIdentifiers() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdentifiersTable, IdentifiersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentifiersWith applies the HasEdge predicate on the "identifiers" edge with a given conditions (other predicates).
+func HasIdentifiersWith(preds ...predicate.ItemIdentifier) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newIdentifiersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatusChanges applies the HasEdge predicate on the "status_changes" edge.
+func HasStatusChanges() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusChangesWith applies the HasEdge predicate on the "status_changes" edge with a given conditions (other predicates).
+func HasStatusChangesWith(preds ...predicate.ItemStatusChange) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newStatusChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStocktakeEntries applies the HasEdge predicate on the "stocktake_entries" edge.
+func HasStocktakeEntries() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StocktakeEntriesTable, StocktakeEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStocktakeEntriesWith applies the HasEdge predicate on the "stocktake_entries" edge with a given conditions (other predicates).
+func HasStocktakeEntriesWith(preds ...predicate.StocktakeEntry) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newStocktakeEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLoans applies the HasEdge predicate on the "loans" edge.
 func HasLoans() predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
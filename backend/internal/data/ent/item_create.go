@@ -15,10 +15,14 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
 )
 
 // ItemCreate is the builder for creating a Item entity.
@@ -76,6 +80,20 @@ func (_c *ItemCreate) SetNillableDescription(v *string) *ItemCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ItemCreate) SetDeletedAt(v time.Time) *ItemCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableDeletedAt(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetImportRef sets the "import_ref" field.
 func (_c *ItemCreate) SetImportRef(v string) *ItemCreate {
 	_c.mutation.SetImportRef(v)
@@ -160,6 +178,20 @@ func (_c *ItemCreate) SetNillableAssetID(v *int) *ItemCreate {
 	return _c
 }
 
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_c *ItemCreate) SetAssetIDPrefix(v string) *ItemCreate {
+	_c.mutation.SetAssetIDPrefix(v)
+	return _c
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableAssetIDPrefix(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetAssetIDPrefix(*v)
+	}
+	return _c
+}
+
 // SetSyncChildItemsLocations sets the "sync_child_items_locations" field.
 func (_c *ItemCreate) SetSyncChildItemsLocations(v bool) *ItemCreate {
 	_c.mutation.SetSyncChildItemsLocations(v)
@@ -174,6 +206,20 @@ func (_c *ItemCreate) SetNillableSyncChildItemsLocations(v *bool) *ItemCreate {
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *ItemCreate) SetStatus(v item.Status) *ItemCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableStatus(v *item.Status) *ItemCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
 // SetSerialNumber sets the "serial_number" field.
 func (_c *ItemCreate) SetSerialNumber(v string) *ItemCreate {
 	_c.mutation.SetSerialNumber(v)
@@ -356,6 +402,62 @@ func (_c *ItemCreate) SetNillableSoldNotes(v *string) *ItemCreate {
 	return _c
 }
 
+// SetQuarantinedAt sets the "quarantined_at" field.
+func (_c *ItemCreate) SetQuarantinedAt(v time.Time) *ItemCreate {
+	_c.mutation.SetQuarantinedAt(v)
+	return _c
+}
+
+// SetNillableQuarantinedAt sets the "quarantined_at" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableQuarantinedAt(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetQuarantinedAt(*v)
+	}
+	return _c
+}
+
+// SetQuarantineUntil sets the "quarantine_until" field.
+func (_c *ItemCreate) SetQuarantineUntil(v time.Time) *ItemCreate {
+	_c.mutation.SetQuarantineUntil(v)
+	return _c
+}
+
+// SetNillableQuarantineUntil sets the "quarantine_until" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableQuarantineUntil(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetQuarantineUntil(*v)
+	}
+	return _c
+}
+
+// SetMinStock sets the "min_stock" field.
+func (_c *ItemCreate) SetMinStock(v int) *ItemCreate {
+	_c.mutation.SetMinStock(v)
+	return _c
+}
+
+// SetNillableMinStock sets the "min_stock" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableMinStock(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetMinStock(*v)
+	}
+	return _c
+}
+
+// SetReorderQuantity sets the "reorder_quantity" field.
+func (_c *ItemCreate) SetReorderQuantity(v int) *ItemCreate {
+	_c.mutation.SetReorderQuantity(v)
+	return _c
+}
+
+// SetNillableReorderQuantity sets the "reorder_quantity" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableReorderQuantity(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetReorderQuantity(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ItemCreate) SetID(v uuid.UUID) *ItemCreate {
 	_c.mutation.SetID(v)
@@ -494,6 +596,66 @@ func (_c *ItemCreate) AddAttachments(v ...*Attachment) *ItemCreate {
 	return _c.AddAttachmentIDs(ids...)
 }
 
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_c *ItemCreate) AddStockMovementIDs(ids ...uuid.UUID) *ItemCreate {
+	_c.mutation.AddStockMovementIDs(ids...)
+	return _c
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_c *ItemCreate) AddStockMovements(v ...*StockMovement) *ItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStockMovementIDs(ids...)
+}
+
+// AddIdentifierIDs adds the "identifiers" edge to the ItemIdentifier entity by IDs.
+func (_c *ItemCreate) AddIdentifierIDs(ids ...uuid.UUID) *ItemCreate {
+	_c.mutation.AddIdentifierIDs(ids...)
+	return _c
+}
+
+// AddIdentifiers adds the "identifiers" edges to the ItemIdentifier entity.
+func (_c *ItemCreate) AddIdentifiers(v ...*ItemIdentifier) *ItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIdentifierIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ItemStatusChange entity by IDs.
+func (_c *ItemCreate) AddStatusChangeIDs(ids ...uuid.UUID) *ItemCreate {
+	_c.mutation.AddStatusChangeIDs(ids...)
+	return _c
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ItemStatusChange entity.
+func (_c *ItemCreate) AddStatusChanges(v ...*ItemStatusChange) *ItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatusChangeIDs(ids...)
+}
+
+// AddStocktakeEntryIDs adds the "stocktake_entries" edge to the StocktakeEntry entity by IDs.
+func (_c *ItemCreate) AddStocktakeEntryIDs(ids ...uuid.UUID) *ItemCreate {
+	_c.mutation.AddStocktakeEntryIDs(ids...)
+	return _c
+}
+
+// AddStocktakeEntries adds the "stocktake_entries" edges to the StocktakeEntry entity.
+func (_c *ItemCreate) AddStocktakeEntries(v ...*StocktakeEntry) *ItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStocktakeEntryIDs(ids...)
+}
+
 // AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
 func (_c *ItemCreate) AddLoanIDs(ids ...uuid.UUID) *ItemCreate {
 	_c.mutation.AddLoanIDs(ids...)
@@ -568,10 +730,18 @@ func (_c *ItemCreate) defaults() {
 		v := item.DefaultAssetID
 		_c.mutation.SetAssetID(v)
 	}
+	if _, ok := _c.mutation.AssetIDPrefix(); !ok {
+		v := item.DefaultAssetIDPrefix
+		_c.mutation.SetAssetIDPrefix(v)
+	}
 	if _, ok := _c.mutation.SyncChildItemsLocations(); !ok {
 		v := item.DefaultSyncChildItemsLocations
 		_c.mutation.SetSyncChildItemsLocations(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := item.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 	if _, ok := _c.mutation.LifetimeWarranty(); !ok {
 		v := item.DefaultLifetimeWarranty
 		_c.mutation.SetLifetimeWarranty(v)
@@ -584,6 +754,14 @@ func (_c *ItemCreate) defaults() {
 		v := item.DefaultSoldPrice
 		_c.mutation.SetSoldPrice(v)
 	}
+	if _, ok := _c.mutation.MinStock(); !ok {
+		v := item.DefaultMinStock
+		_c.mutation.SetMinStock(v)
+	}
+	if _, ok := _c.mutation.ReorderQuantity(); !ok {
+		v := item.DefaultReorderQuantity
+		_c.mutation.SetReorderQuantity(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := item.DefaultID()
 		_c.mutation.SetID(v)
@@ -633,9 +811,25 @@ func (_c *ItemCreate) check() error {
 	if _, ok := _c.mutation.AssetID(); !ok {
 		return &ValidationError{Name: "asset_id", err: errors.New(`ent: missing required field "Item.asset_id"`)}
 	}
+	if _, ok := _c.mutation.AssetIDPrefix(); !ok {
+		return &ValidationError{Name: "asset_id_prefix", err: errors.New(`ent: missing required field "Item.asset_id_prefix"`)}
+	}
+	if v, ok := _c.mutation.AssetIDPrefix(); ok {
+		if err := item.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Item.asset_id_prefix": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.SyncChildItemsLocations(); !ok {
 		return &ValidationError{Name: "sync_child_items_locations", err: errors.New(`ent: missing required field "Item.sync_child_items_locations"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Item.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.SerialNumber(); ok {
 		if err := item.SerialNumberValidator(v); err != nil {
 			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Item.serial_number": %w`, err)}
@@ -670,6 +864,12 @@ func (_c *ItemCreate) check() error {
 			return &ValidationError{Name: "sold_notes", err: fmt.Errorf(`ent: validator failed for field "Item.sold_notes": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.MinStock(); !ok {
+		return &ValidationError{Name: "min_stock", err: errors.New(`ent: missing required field "Item.min_stock"`)}
+	}
+	if _, ok := _c.mutation.ReorderQuantity(); !ok {
+		return &ValidationError{Name: "reorder_quantity", err: errors.New(`ent: missing required field "Item.reorder_quantity"`)}
+	}
 	if len(_c.mutation.GroupIDs()) == 0 {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "Item.group"`)}
 	}
@@ -724,6 +924,10 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.ImportRef(); ok {
 		_spec.SetField(item.FieldImportRef, field.TypeString, value)
 		_node.ImportRef = value
@@ -748,10 +952,18 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldAssetID, field.TypeInt, value)
 		_node.AssetID = value
 	}
+	if value, ok := _c.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(item.FieldAssetIDPrefix, field.TypeString, value)
+		_node.AssetIDPrefix = value
+	}
 	if value, ok := _c.mutation.SyncChildItemsLocations(); ok {
 		_spec.SetField(item.FieldSyncChildItemsLocations, field.TypeBool, value)
 		_node.SyncChildItemsLocations = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
 	if value, ok := _c.mutation.SerialNumber(); ok {
 		_spec.SetField(item.FieldSerialNumber, field.TypeString, value)
 		_node.SerialNumber = value
@@ -804,6 +1016,22 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldSoldNotes, field.TypeString, value)
 		_node.SoldNotes = value
 	}
+	if value, ok := _c.mutation.QuarantinedAt(); ok {
+		_spec.SetField(item.FieldQuarantinedAt, field.TypeTime, value)
+		_node.QuarantinedAt = &value
+	}
+	if value, ok := _c.mutation.QuarantineUntil(); ok {
+		_spec.SetField(item.FieldQuarantineUntil, field.TypeTime, value)
+		_node.QuarantineUntil = &value
+	}
+	if value, ok := _c.mutation.MinStock(); ok {
+		_spec.SetField(item.FieldMinStock, field.TypeInt, value)
+		_node.MinStock = value
+	}
+	if value, ok := _c.mutation.ReorderQuantity(); ok {
+		_spec.SetField(item.FieldReorderQuantity, field.TypeInt, value)
+		_node.ReorderQuantity = value
+	}
 	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -935,6 +1163,70 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StockMovementsTable,
+			Columns: []string{item.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IdentifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.IdentifiersTable,
+			Columns: []string{item.IdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StatusChangesTable,
+			Columns: []string{item.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StocktakeEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StocktakeEntriesTable,
+			Columns: []string{item.StocktakeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.LoansIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -17,11 +17,15 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
 )
 
 // ItemQuery is the builder for querying Item entities.
@@ -39,6 +43,10 @@ type ItemQuery struct {
 	withFields             *ItemFieldQuery
 	withMaintenanceEntries *MaintenanceEntryQuery
 	withAttachments        *AttachmentQuery
+	withStockMovements     *StockMovementQuery
+	withIdentifiers        *ItemIdentifierQuery
+	withStatusChanges      *ItemStatusChangeQuery
+	withStocktakeEntries   *StocktakeEntryQuery
 	withLoans              *LoanQuery
 	withFKs                bool
 	// intermediate query (i.e. traversal path).
@@ -253,6 +261,94 @@ func (_q *ItemQuery) QueryAttachments() *AttachmentQuery {
 	return query
 }
 
+// QueryStockMovements chains the current query on the "stock_movements" edge.
+func (_q *ItemQuery) QueryStockMovements() *StockMovementQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.StockMovementsTable, item.StockMovementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdentifiers chains the current query on the "identifiers" edge.
+func (_q *ItemQuery) QueryIdentifiers() *ItemIdentifierQuery {
+	query := (&ItemIdentifierClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(itemidentifier.Table, itemidentifier.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.IdentifiersTable, item.IdentifiersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatusChanges chains the current query on the "status_changes" edge.
+func (_q *ItemQuery) QueryStatusChanges() *ItemStatusChangeQuery {
+	query := (&ItemStatusChangeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(itemstatuschange.Table, itemstatuschange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.StatusChangesTable, item.StatusChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStocktakeEntries chains the current query on the "stocktake_entries" edge.
+func (_q *ItemQuery) QueryStocktakeEntries() *StocktakeEntryQuery {
+	query := (&StocktakeEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(stocktakeentry.Table, stocktakeentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.StocktakeEntriesTable, item.StocktakeEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryLoans chains the current query on the "loans" edge.
 func (_q *ItemQuery) QueryLoans() *LoanQuery {
 	query := (&LoanClient{config: _q.config}).Query()
@@ -475,6 +571,10 @@ func (_q *ItemQuery) Clone() *ItemQuery {
 		withFields:             _q.withFields.Clone(),
 		withMaintenanceEntries: _q.withMaintenanceEntries.Clone(),
 		withAttachments:        _q.withAttachments.Clone(),
+		withStockMovements:     _q.withStockMovements.Clone(),
+		withIdentifiers:        _q.withIdentifiers.Clone(),
+		withStatusChanges:      _q.withStatusChanges.Clone(),
+		withStocktakeEntries:   _q.withStocktakeEntries.Clone(),
 		withLoans:              _q.withLoans.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
@@ -570,6 +670,50 @@ func (_q *ItemQuery) WithAttachments(opts ...func(*AttachmentQuery)) *ItemQuery
 	return _q
 }
 
+// WithStockMovements tells the query-builder to eager-load the nodes that are connected to
+// the "stock_movements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ItemQuery) WithStockMovements(opts ...func(*StockMovementQuery)) *ItemQuery {
+	query := (&StockMovementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStockMovements = query
+	return _q
+}
+
+// WithIdentifiers tells the query-builder to eager-load the nodes that are connected to
+// the "identifiers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ItemQuery) WithIdentifiers(opts ...func(*ItemIdentifierQuery)) *ItemQuery {
+	query := (&ItemIdentifierClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withIdentifiers = query
+	return _q
+}
+
+// WithStatusChanges tells the query-builder to eager-load the nodes that are connected to
+// the "status_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ItemQuery) WithStatusChanges(opts ...func(*ItemStatusChangeQuery)) *ItemQuery {
+	query := (&ItemStatusChangeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatusChanges = query
+	return _q
+}
+
+// WithStocktakeEntries tells the query-builder to eager-load the nodes that are connected to
+// the "stocktake_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ItemQuery) WithStocktakeEntries(opts ...func(*StocktakeEntryQuery)) *ItemQuery {
+	query := (&StocktakeEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStocktakeEntries = query
+	return _q
+}
+
 // WithLoans tells the query-builder to eager-load the nodes that are connected to
 // the "loans" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *ItemQuery) WithLoans(opts ...func(*LoanQuery)) *ItemQuery {
@@ -660,7 +804,7 @@ func (_q *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 		nodes       = []*Item{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [9]bool{
+		loadedTypes = [13]bool{
 			_q.withGroup != nil,
 			_q.withParent != nil,
 			_q.withChildren != nil,
@@ -669,6 +813,10 @@ func (_q *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 			_q.withFields != nil,
 			_q.withMaintenanceEntries != nil,
 			_q.withAttachments != nil,
+			_q.withStockMovements != nil,
+			_q.withIdentifiers != nil,
+			_q.withStatusChanges != nil,
+			_q.withStocktakeEntries != nil,
 			_q.withLoans != nil,
 		}
 	)
@@ -749,6 +897,34 @@ func (_q *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 			return nil, err
 		}
 	}
+	if query := _q.withStockMovements; query != nil {
+		if err := _q.loadStockMovements(ctx, query, nodes,
+			func(n *Item) { n.Edges.StockMovements = []*StockMovement{} },
+			func(n *Item, e *StockMovement) { n.Edges.StockMovements = append(n.Edges.StockMovements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withIdentifiers; query != nil {
+		if err := _q.loadIdentifiers(ctx, query, nodes,
+			func(n *Item) { n.Edges.Identifiers = []*ItemIdentifier{} },
+			func(n *Item, e *ItemIdentifier) { n.Edges.Identifiers = append(n.Edges.Identifiers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStatusChanges; query != nil {
+		if err := _q.loadStatusChanges(ctx, query, nodes,
+			func(n *Item) { n.Edges.StatusChanges = []*ItemStatusChange{} },
+			func(n *Item, e *ItemStatusChange) { n.Edges.StatusChanges = append(n.Edges.StatusChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStocktakeEntries; query != nil {
+		if err := _q.loadStocktakeEntries(ctx, query, nodes,
+			func(n *Item) { n.Edges.StocktakeEntries = []*StocktakeEntry{} },
+			func(n *Item, e *StocktakeEntry) { n.Edges.StocktakeEntries = append(n.Edges.StocktakeEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withLoans; query != nil {
 		if err := _q.loadLoans(ctx, query, nodes,
 			func(n *Item) { n.Edges.Loans = []*Loan{} },
@@ -1039,6 +1215,129 @@ func (_q *ItemQuery) loadAttachments(ctx context.Context, query *AttachmentQuery
 	}
 	return nil
 }
+func (_q *ItemQuery) loadStockMovements(ctx context.Context, query *StockMovementQuery, nodes []*Item, init func(*Item), assign func(*Item, *StockMovement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(stockmovement.FieldItemID)
+	}
+	query.Where(predicate.StockMovement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.StockMovementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ItemQuery) loadIdentifiers(ctx context.Context, query *ItemIdentifierQuery, nodes []*Item, init func(*Item), assign func(*Item, *ItemIdentifier)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemidentifier.FieldItemID)
+	}
+	query.Where(predicate.ItemIdentifier(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.IdentifiersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ItemQuery) loadStatusChanges(ctx context.Context, query *ItemStatusChangeQuery, nodes []*Item, init func(*Item), assign func(*Item, *ItemStatusChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemstatuschange.FieldItemID)
+	}
+	query.Where(predicate.ItemStatusChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.StatusChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ItemQuery) loadStocktakeEntries(ctx context.Context, query *StocktakeEntryQuery, nodes []*Item, init func(*Item), assign func(*Item, *StocktakeEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(stocktakeentry.FieldItemID)
+	}
+	query.Where(predicate.StocktakeEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.StocktakeEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "item_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *ItemQuery) loadLoans(ctx context.Context, query *LoanQuery, nodes []*Item, init func(*Item), assign func(*Item, *Loan)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Item)
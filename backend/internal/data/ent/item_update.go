@@ -16,11 +16,15 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
 )
 
 // ItemUpdate is the builder for updating Item entities.
@@ -76,6 +80,26 @@ func (_u *ItemUpdate) ClearDescription() *ItemUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ItemUpdate) SetDeletedAt(v time.Time) *ItemUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableDeletedAt(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ItemUpdate) ClearDeletedAt() *ItemUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetImportRef sets the "import_ref" field.
 func (_u *ItemUpdate) SetImportRef(v string) *ItemUpdate {
 	_u.mutation.SetImportRef(v)
@@ -186,6 +210,20 @@ func (_u *ItemUpdate) AddAssetID(v int) *ItemUpdate {
 	return _u
 }
 
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_u *ItemUpdate) SetAssetIDPrefix(v string) *ItemUpdate {
+	_u.mutation.SetAssetIDPrefix(v)
+	return _u
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableAssetIDPrefix(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetAssetIDPrefix(*v)
+	}
+	return _u
+}
+
 // SetSyncChildItemsLocations sets the "sync_child_items_locations" field.
 func (_u *ItemUpdate) SetSyncChildItemsLocations(v bool) *ItemUpdate {
 	_u.mutation.SetSyncChildItemsLocations(v)
@@ -200,6 +238,20 @@ func (_u *ItemUpdate) SetNillableSyncChildItemsLocations(v *bool) *ItemUpdate {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *ItemUpdate) SetStatus(v item.Status) *ItemUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableStatus(v *item.Status) *ItemUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // SetSerialNumber sets the "serial_number" field.
 func (_u *ItemUpdate) SetSerialNumber(v string) *ItemUpdate {
 	_u.mutation.SetSerialNumber(v)
@@ -456,6 +508,88 @@ func (_u *ItemUpdate) ClearSoldNotes() *ItemUpdate {
 	return _u
 }
 
+// SetQuarantinedAt sets the "quarantined_at" field.
+func (_u *ItemUpdate) SetQuarantinedAt(v time.Time) *ItemUpdate {
+	_u.mutation.SetQuarantinedAt(v)
+	return _u
+}
+
+// SetNillableQuarantinedAt sets the "quarantined_at" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableQuarantinedAt(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetQuarantinedAt(*v)
+	}
+	return _u
+}
+
+// ClearQuarantinedAt clears the value of the "quarantined_at" field.
+func (_u *ItemUpdate) ClearQuarantinedAt() *ItemUpdate {
+	_u.mutation.ClearQuarantinedAt()
+	return _u
+}
+
+// SetQuarantineUntil sets the "quarantine_until" field.
+func (_u *ItemUpdate) SetQuarantineUntil(v time.Time) *ItemUpdate {
+	_u.mutation.SetQuarantineUntil(v)
+	return _u
+}
+
+// SetNillableQuarantineUntil sets the "quarantine_until" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableQuarantineUntil(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetQuarantineUntil(*v)
+	}
+	return _u
+}
+
+// ClearQuarantineUntil clears the value of the "quarantine_until" field.
+func (_u *ItemUpdate) ClearQuarantineUntil() *ItemUpdate {
+	_u.mutation.ClearQuarantineUntil()
+	return _u
+}
+
+// SetMinStock sets the "min_stock" field.
+func (_u *ItemUpdate) SetMinStock(v int) *ItemUpdate {
+	_u.mutation.ResetMinStock()
+	_u.mutation.SetMinStock(v)
+	return _u
+}
+
+// SetNillableMinStock sets the "min_stock" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableMinStock(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetMinStock(*v)
+	}
+	return _u
+}
+
+// AddMinStock adds value to the "min_stock" field.
+func (_u *ItemUpdate) AddMinStock(v int) *ItemUpdate {
+	_u.mutation.AddMinStock(v)
+	return _u
+}
+
+// SetReorderQuantity sets the "reorder_quantity" field.
+func (_u *ItemUpdate) SetReorderQuantity(v int) *ItemUpdate {
+	_u.mutation.ResetReorderQuantity()
+	_u.mutation.SetReorderQuantity(v)
+	return _u
+}
+
+// SetNillableReorderQuantity sets the "reorder_quantity" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableReorderQuantity(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetReorderQuantity(*v)
+	}
+	return _u
+}
+
+// AddReorderQuantity adds value to the "reorder_quantity" field.
+func (_u *ItemUpdate) AddReorderQuantity(v int) *ItemUpdate {
+	_u.mutation.AddReorderQuantity(v)
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *ItemUpdate) SetGroupID(id uuid.UUID) *ItemUpdate {
 	_u.mutation.SetGroupID(id)
@@ -580,6 +714,66 @@ func (_u *ItemUpdate) AddAttachments(v ...*Attachment) *ItemUpdate {
 	return _u.AddAttachmentIDs(ids...)
 }
 
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *ItemUpdate) AddStockMovementIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.AddStockMovementIDs(ids...)
+	return _u
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *ItemUpdate) AddStockMovements(v ...*StockMovement) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockMovementIDs(ids...)
+}
+
+// AddIdentifierIDs adds the "identifiers" edge to the ItemIdentifier entity by IDs.
+func (_u *ItemUpdate) AddIdentifierIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.AddIdentifierIDs(ids...)
+	return _u
+}
+
+// AddIdentifiers adds the "identifiers" edges to the ItemIdentifier entity.
+func (_u *ItemUpdate) AddIdentifiers(v ...*ItemIdentifier) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIdentifierIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ItemStatusChange entity by IDs.
+func (_u *ItemUpdate) AddStatusChangeIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.AddStatusChangeIDs(ids...)
+	return _u
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ItemStatusChange entity.
+func (_u *ItemUpdate) AddStatusChanges(v ...*ItemStatusChange) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusChangeIDs(ids...)
+}
+
+// AddStocktakeEntryIDs adds the "stocktake_entries" edge to the StocktakeEntry entity by IDs.
+func (_u *ItemUpdate) AddStocktakeEntryIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.AddStocktakeEntryIDs(ids...)
+	return _u
+}
+
+// AddStocktakeEntries adds the "stocktake_entries" edges to the StocktakeEntry entity.
+func (_u *ItemUpdate) AddStocktakeEntries(v ...*StocktakeEntry) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStocktakeEntryIDs(ids...)
+}
+
 // AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
 func (_u *ItemUpdate) AddLoanIDs(ids ...uuid.UUID) *ItemUpdate {
 	_u.mutation.AddLoanIDs(ids...)
@@ -723,6 +917,90 @@ func (_u *ItemUpdate) RemoveAttachments(v ...*Attachment) *ItemUpdate {
 	return _u.RemoveAttachmentIDs(ids...)
 }
 
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *ItemUpdate) ClearStockMovements() *ItemUpdate {
+	_u.mutation.ClearStockMovements()
+	return _u
+}
+
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *ItemUpdate) RemoveStockMovementIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.RemoveStockMovementIDs(ids...)
+	return _u
+}
+
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *ItemUpdate) RemoveStockMovements(v ...*StockMovement) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockMovementIDs(ids...)
+}
+
+// ClearIdentifiers clears all "identifiers" edges to the ItemIdentifier entity.
+func (_u *ItemUpdate) ClearIdentifiers() *ItemUpdate {
+	_u.mutation.ClearIdentifiers()
+	return _u
+}
+
+// RemoveIdentifierIDs removes the "identifiers" edge to ItemIdentifier entities by IDs.
+func (_u *ItemUpdate) RemoveIdentifierIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.RemoveIdentifierIDs(ids...)
+	return _u
+}
+
+// RemoveIdentifiers removes "identifiers" edges to ItemIdentifier entities.
+func (_u *ItemUpdate) RemoveIdentifiers(v ...*ItemIdentifier) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIdentifierIDs(ids...)
+}
+
+// ClearStatusChanges clears all "status_changes" edges to the ItemStatusChange entity.
+func (_u *ItemUpdate) ClearStatusChanges() *ItemUpdate {
+	_u.mutation.ClearStatusChanges()
+	return _u
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to ItemStatusChange entities by IDs.
+func (_u *ItemUpdate) RemoveStatusChangeIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.RemoveStatusChangeIDs(ids...)
+	return _u
+}
+
+// RemoveStatusChanges removes "status_changes" edges to ItemStatusChange entities.
+func (_u *ItemUpdate) RemoveStatusChanges(v ...*ItemStatusChange) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearStocktakeEntries clears all "stocktake_entries" edges to the StocktakeEntry entity.
+func (_u *ItemUpdate) ClearStocktakeEntries() *ItemUpdate {
+	_u.mutation.ClearStocktakeEntries()
+	return _u
+}
+
+// RemoveStocktakeEntryIDs removes the "stocktake_entries" edge to StocktakeEntry entities by IDs.
+func (_u *ItemUpdate) RemoveStocktakeEntryIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.RemoveStocktakeEntryIDs(ids...)
+	return _u
+}
+
+// RemoveStocktakeEntries removes "stocktake_entries" edges to StocktakeEntry entities.
+func (_u *ItemUpdate) RemoveStocktakeEntries(v ...*StocktakeEntry) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStocktakeEntryIDs(ids...)
+}
+
 // ClearLoans clears all "loans" edges to the Loan entity.
 func (_u *ItemUpdate) ClearLoans() *ItemUpdate {
 	_u.mutation.ClearLoans()
@@ -802,6 +1080,16 @@ func (_u *ItemUpdate) check() error {
 			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "Item.notes": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AssetIDPrefix(); ok {
+		if err := item.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Item.asset_id_prefix": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.SerialNumber(); ok {
 		if err := item.SerialNumberValidator(v); err != nil {
 			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Item.serial_number": %w`, err)}
@@ -857,6 +1145,12 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(item.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ImportRef(); ok {
 		_spec.SetField(item.FieldImportRef, field.TypeString, value)
 	}
@@ -887,9 +1181,15 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedAssetID(); ok {
 		_spec.AddField(item.FieldAssetID, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(item.FieldAssetIDPrefix, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.SyncChildItemsLocations(); ok {
 		_spec.SetField(item.FieldSyncChildItemsLocations, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.SerialNumber(); ok {
 		_spec.SetField(item.FieldSerialNumber, field.TypeString, value)
 	}
@@ -965,6 +1265,30 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SoldNotesCleared() {
 		_spec.ClearField(item.FieldSoldNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.QuarantinedAt(); ok {
+		_spec.SetField(item.FieldQuarantinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.QuarantinedAtCleared() {
+		_spec.ClearField(item.FieldQuarantinedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QuarantineUntil(); ok {
+		_spec.SetField(item.FieldQuarantineUntil, field.TypeTime, value)
+	}
+	if _u.mutation.QuarantineUntilCleared() {
+		_spec.ClearField(item.FieldQuarantineUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MinStock(); ok {
+		_spec.SetField(item.FieldMinStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinStock(); ok {
+		_spec.AddField(item.FieldMinStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReorderQuantity(); ok {
+		_spec.SetField(item.FieldReorderQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReorderQuantity(); ok {
+		_spec.AddField(item.FieldReorderQuantity, field.TypeInt, value)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1277,28 +1601,28 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LoansCleared() {
+	if _u.mutation.StockMovementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   item.LoansTable,
-			Columns: []string{item.LoansColumn},
+			Table:   item.StockMovementsTable,
+			Columns: []string{item.StockMovementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLoansIDs(); len(nodes) > 0 && !_u.mutation.LoansCleared() {
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   item.LoansTable,
-			Columns: []string{item.LoansColumn},
+			Table:   item.StockMovementsTable,
+			Columns: []string{item.StockMovementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1306,15 +1630,15 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LoansIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   item.LoansTable,
-			Columns: []string{item.LoansColumn},
+			Table:   item.StockMovementsTable,
+			Columns: []string{item.StockMovementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1322,64 +1646,264 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{item.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.IdentifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.IdentifiersTable,
+			Columns: []string{item.IdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// ItemUpdateOne is the builder for updating a single Item entity.
-type ItemUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ItemMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ItemUpdateOne) SetUpdatedAt(v time.Time) *ItemUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *ItemUpdateOne) SetName(v string) *ItemUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *ItemUpdateOne) SetNillableName(v *string) *ItemUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDescription sets the "description" field.
-func (_u *ItemUpdateOne) SetDescription(v string) *ItemUpdateOne {
-	_u.mutation.SetDescription(v)
-	return _u
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *ItemUpdateOne) SetNillableDescription(v *string) *ItemUpdateOne {
-	if v != nil {
-		_u.SetDescription(*v)
+	if nodes := _u.mutation.RemovedIdentifiersIDs(); len(nodes) > 0 && !_u.mutation.IdentifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.IdentifiersTable,
+			Columns: []string{item.IdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearDescription clears the value of the "description" field.
-func (_u *ItemUpdateOne) ClearDescription() *ItemUpdateOne {
-	_u.mutation.ClearDescription()
-	return _u
+	if nodes := _u.mutation.IdentifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.IdentifiersTable,
+			Columns: []string{item.IdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StatusChangesTable,
+			Columns: []string{item.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StatusChangesTable,
+			Columns: []string{item.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StatusChangesTable,
+			Columns: []string{item.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StocktakeEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StocktakeEntriesTable,
+			Columns: []string{item.StocktakeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStocktakeEntriesIDs(); len(nodes) > 0 && !_u.mutation.StocktakeEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StocktakeEntriesTable,
+			Columns: []string{item.StocktakeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StocktakeEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StocktakeEntriesTable,
+			Columns: []string{item.StocktakeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.LoansTable,
+			Columns: []string{item.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLoansIDs(); len(nodes) > 0 && !_u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.LoansTable,
+			Columns: []string{item.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.LoansTable,
+			Columns: []string{item.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{item.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ItemUpdateOne is the builder for updating a single Item entity.
+type ItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ItemUpdateOne) SetUpdatedAt(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ItemUpdateOne) SetName(v string) *ItemUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableName(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ItemUpdateOne) SetDescription(v string) *ItemUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableDescription(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ItemUpdateOne) ClearDescription() *ItemUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ItemUpdateOne) SetDeletedAt(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableDeletedAt(v *time.Time) *ItemUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ItemUpdateOne) ClearDeletedAt() *ItemUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
 }
 
 // SetImportRef sets the "import_ref" field.
@@ -1492,6 +2016,20 @@ func (_u *ItemUpdateOne) AddAssetID(v int) *ItemUpdateOne {
 	return _u
 }
 
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_u *ItemUpdateOne) SetAssetIDPrefix(v string) *ItemUpdateOne {
+	_u.mutation.SetAssetIDPrefix(v)
+	return _u
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableAssetIDPrefix(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetAssetIDPrefix(*v)
+	}
+	return _u
+}
+
 // SetSyncChildItemsLocations sets the "sync_child_items_locations" field.
 func (_u *ItemUpdateOne) SetSyncChildItemsLocations(v bool) *ItemUpdateOne {
 	_u.mutation.SetSyncChildItemsLocations(v)
@@ -1506,6 +2044,20 @@ func (_u *ItemUpdateOne) SetNillableSyncChildItemsLocations(v *bool) *ItemUpdate
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *ItemUpdateOne) SetStatus(v item.Status) *ItemUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableStatus(v *item.Status) *ItemUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // SetSerialNumber sets the "serial_number" field.
 func (_u *ItemUpdateOne) SetSerialNumber(v string) *ItemUpdateOne {
 	_u.mutation.SetSerialNumber(v)
@@ -1762,6 +2314,88 @@ func (_u *ItemUpdateOne) ClearSoldNotes() *ItemUpdateOne {
 	return _u
 }
 
+// SetQuarantinedAt sets the "quarantined_at" field.
+func (_u *ItemUpdateOne) SetQuarantinedAt(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetQuarantinedAt(v)
+	return _u
+}
+
+// SetNillableQuarantinedAt sets the "quarantined_at" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableQuarantinedAt(v *time.Time) *ItemUpdateOne {
+	if v != nil {
+		_u.SetQuarantinedAt(*v)
+	}
+	return _u
+}
+
+// ClearQuarantinedAt clears the value of the "quarantined_at" field.
+func (_u *ItemUpdateOne) ClearQuarantinedAt() *ItemUpdateOne {
+	_u.mutation.ClearQuarantinedAt()
+	return _u
+}
+
+// SetQuarantineUntil sets the "quarantine_until" field.
+func (_u *ItemUpdateOne) SetQuarantineUntil(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetQuarantineUntil(v)
+	return _u
+}
+
+// SetNillableQuarantineUntil sets the "quarantine_until" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableQuarantineUntil(v *time.Time) *ItemUpdateOne {
+	if v != nil {
+		_u.SetQuarantineUntil(*v)
+	}
+	return _u
+}
+
+// ClearQuarantineUntil clears the value of the "quarantine_until" field.
+func (_u *ItemUpdateOne) ClearQuarantineUntil() *ItemUpdateOne {
+	_u.mutation.ClearQuarantineUntil()
+	return _u
+}
+
+// SetMinStock sets the "min_stock" field.
+func (_u *ItemUpdateOne) SetMinStock(v int) *ItemUpdateOne {
+	_u.mutation.ResetMinStock()
+	_u.mutation.SetMinStock(v)
+	return _u
+}
+
+// SetNillableMinStock sets the "min_stock" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableMinStock(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetMinStock(*v)
+	}
+	return _u
+}
+
+// AddMinStock adds value to the "min_stock" field.
+func (_u *ItemUpdateOne) AddMinStock(v int) *ItemUpdateOne {
+	_u.mutation.AddMinStock(v)
+	return _u
+}
+
+// SetReorderQuantity sets the "reorder_quantity" field.
+func (_u *ItemUpdateOne) SetReorderQuantity(v int) *ItemUpdateOne {
+	_u.mutation.ResetReorderQuantity()
+	_u.mutation.SetReorderQuantity(v)
+	return _u
+}
+
+// SetNillableReorderQuantity sets the "reorder_quantity" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableReorderQuantity(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetReorderQuantity(*v)
+	}
+	return _u
+}
+
+// AddReorderQuantity adds value to the "reorder_quantity" field.
+func (_u *ItemUpdateOne) AddReorderQuantity(v int) *ItemUpdateOne {
+	_u.mutation.AddReorderQuantity(v)
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *ItemUpdateOne) SetGroupID(id uuid.UUID) *ItemUpdateOne {
 	_u.mutation.SetGroupID(id)
@@ -1886,6 +2520,66 @@ func (_u *ItemUpdateOne) AddAttachments(v ...*Attachment) *ItemUpdateOne {
 	return _u.AddAttachmentIDs(ids...)
 }
 
+// AddStockMovementIDs adds the "stock_movements" edge to the StockMovement entity by IDs.
+func (_u *ItemUpdateOne) AddStockMovementIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.AddStockMovementIDs(ids...)
+	return _u
+}
+
+// AddStockMovements adds the "stock_movements" edges to the StockMovement entity.
+func (_u *ItemUpdateOne) AddStockMovements(v ...*StockMovement) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStockMovementIDs(ids...)
+}
+
+// AddIdentifierIDs adds the "identifiers" edge to the ItemIdentifier entity by IDs.
+func (_u *ItemUpdateOne) AddIdentifierIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.AddIdentifierIDs(ids...)
+	return _u
+}
+
+// AddIdentifiers adds the "identifiers" edges to the ItemIdentifier entity.
+func (_u *ItemUpdateOne) AddIdentifiers(v ...*ItemIdentifier) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIdentifierIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ItemStatusChange entity by IDs.
+func (_u *ItemUpdateOne) AddStatusChangeIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.AddStatusChangeIDs(ids...)
+	return _u
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ItemStatusChange entity.
+func (_u *ItemUpdateOne) AddStatusChanges(v ...*ItemStatusChange) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusChangeIDs(ids...)
+}
+
+// AddStocktakeEntryIDs adds the "stocktake_entries" edge to the StocktakeEntry entity by IDs.
+func (_u *ItemUpdateOne) AddStocktakeEntryIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.AddStocktakeEntryIDs(ids...)
+	return _u
+}
+
+// AddStocktakeEntries adds the "stocktake_entries" edges to the StocktakeEntry entity.
+func (_u *ItemUpdateOne) AddStocktakeEntries(v ...*StocktakeEntry) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStocktakeEntryIDs(ids...)
+}
+
 // AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
 func (_u *ItemUpdateOne) AddLoanIDs(ids ...uuid.UUID) *ItemUpdateOne {
 	_u.mutation.AddLoanIDs(ids...)
@@ -2029,6 +2723,90 @@ func (_u *ItemUpdateOne) RemoveAttachments(v ...*Attachment) *ItemUpdateOne {
 	return _u.RemoveAttachmentIDs(ids...)
 }
 
+// ClearStockMovements clears all "stock_movements" edges to the StockMovement entity.
+func (_u *ItemUpdateOne) ClearStockMovements() *ItemUpdateOne {
+	_u.mutation.ClearStockMovements()
+	return _u
+}
+
+// RemoveStockMovementIDs removes the "stock_movements" edge to StockMovement entities by IDs.
+func (_u *ItemUpdateOne) RemoveStockMovementIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.RemoveStockMovementIDs(ids...)
+	return _u
+}
+
+// RemoveStockMovements removes "stock_movements" edges to StockMovement entities.
+func (_u *ItemUpdateOne) RemoveStockMovements(v ...*StockMovement) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStockMovementIDs(ids...)
+}
+
+// ClearIdentifiers clears all "identifiers" edges to the ItemIdentifier entity.
+func (_u *ItemUpdateOne) ClearIdentifiers() *ItemUpdateOne {
+	_u.mutation.ClearIdentifiers()
+	return _u
+}
+
+// RemoveIdentifierIDs removes the "identifiers" edge to ItemIdentifier entities by IDs.
+func (_u *ItemUpdateOne) RemoveIdentifierIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.RemoveIdentifierIDs(ids...)
+	return _u
+}
+
+// RemoveIdentifiers removes "identifiers" edges to ItemIdentifier entities.
+func (_u *ItemUpdateOne) RemoveIdentifiers(v ...*ItemIdentifier) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIdentifierIDs(ids...)
+}
+
+// ClearStatusChanges clears all "status_changes" edges to the ItemStatusChange entity.
+func (_u *ItemUpdateOne) ClearStatusChanges() *ItemUpdateOne {
+	_u.mutation.ClearStatusChanges()
+	return _u
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to ItemStatusChange entities by IDs.
+func (_u *ItemUpdateOne) RemoveStatusChangeIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.RemoveStatusChangeIDs(ids...)
+	return _u
+}
+
+// RemoveStatusChanges removes "status_changes" edges to ItemStatusChange entities.
+func (_u *ItemUpdateOne) RemoveStatusChanges(v ...*ItemStatusChange) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearStocktakeEntries clears all "stocktake_entries" edges to the StocktakeEntry entity.
+func (_u *ItemUpdateOne) ClearStocktakeEntries() *ItemUpdateOne {
+	_u.mutation.ClearStocktakeEntries()
+	return _u
+}
+
+// RemoveStocktakeEntryIDs removes the "stocktake_entries" edge to StocktakeEntry entities by IDs.
+func (_u *ItemUpdateOne) RemoveStocktakeEntryIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.RemoveStocktakeEntryIDs(ids...)
+	return _u
+}
+
+// RemoveStocktakeEntries removes "stocktake_entries" edges to StocktakeEntry entities.
+func (_u *ItemUpdateOne) RemoveStocktakeEntries(v ...*StocktakeEntry) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStocktakeEntryIDs(ids...)
+}
+
 // ClearLoans clears all "loans" edges to the Loan entity.
 func (_u *ItemUpdateOne) ClearLoans() *ItemUpdateOne {
 	_u.mutation.ClearLoans()
@@ -2121,6 +2899,16 @@ func (_u *ItemUpdateOne) check() error {
 			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "Item.notes": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AssetIDPrefix(); ok {
+		if err := item.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Item.asset_id_prefix": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.SerialNumber(); ok {
 		if err := item.SerialNumberValidator(v); err != nil {
 			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "Item.serial_number": %w`, err)}
@@ -2193,6 +2981,12 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(item.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ImportRef(); ok {
 		_spec.SetField(item.FieldImportRef, field.TypeString, value)
 	}
@@ -2223,9 +3017,15 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 	if value, ok := _u.mutation.AddedAssetID(); ok {
 		_spec.AddField(item.FieldAssetID, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(item.FieldAssetIDPrefix, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.SyncChildItemsLocations(); ok {
 		_spec.SetField(item.FieldSyncChildItemsLocations, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.SerialNumber(); ok {
 		_spec.SetField(item.FieldSerialNumber, field.TypeString, value)
 	}
@@ -2301,6 +3101,30 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 	if _u.mutation.SoldNotesCleared() {
 		_spec.ClearField(item.FieldSoldNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.QuarantinedAt(); ok {
+		_spec.SetField(item.FieldQuarantinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.QuarantinedAtCleared() {
+		_spec.ClearField(item.FieldQuarantinedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QuarantineUntil(); ok {
+		_spec.SetField(item.FieldQuarantineUntil, field.TypeTime, value)
+	}
+	if _u.mutation.QuarantineUntilCleared() {
+		_spec.ClearField(item.FieldQuarantineUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MinStock(); ok {
+		_spec.SetField(item.FieldMinStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinStock(); ok {
+		_spec.AddField(item.FieldMinStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReorderQuantity(); ok {
+		_spec.SetField(item.FieldReorderQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReorderQuantity(); ok {
+		_spec.AddField(item.FieldReorderQuantity, field.TypeInt, value)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -2613,6 +3437,186 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StockMovementsTable,
+			Columns: []string{item.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStockMovementsIDs(); len(nodes) > 0 && !_u.mutation.StockMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StockMovementsTable,
+			Columns: []string{item.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StockMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StockMovementsTable,
+			Columns: []string{item.StockMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IdentifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.IdentifiersTable,
+			Columns: []string{item.IdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIdentifiersIDs(); len(nodes) > 0 && !_u.mutation.IdentifiersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.IdentifiersTable,
+			Columns: []string{item.IdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IdentifiersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.IdentifiersTable,
+			Columns: []string{item.IdentifiersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StatusChangesTable,
+			Columns: []string{item.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StatusChangesTable,
+			Columns: []string{item.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StatusChangesTable,
+			Columns: []string{item.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StocktakeEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StocktakeEntriesTable,
+			Columns: []string{item.StocktakeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStocktakeEntriesIDs(); len(nodes) > 0 && !_u.mutation.StocktakeEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StocktakeEntriesTable,
+			Columns: []string{item.StocktakeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StocktakeEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.StocktakeEntriesTable,
+			Columns: []string{item.StocktakeEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.LoansCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -29,10 +29,10 @@ type ItemField struct {
 	Description string `json:"description,omitempty"`
 	// Type holds the value of the "type" field.
 	Type itemfield.Type `json:"type,omitempty"`
-	// TextValue holds the value of the "text_value" field.
+	// Value of text, select and url fields, and the semicolon separated values of multiselect fields
 	TextValue string `json:"text_value,omitempty"`
-	// NumberValue holds the value of the "number_value" field.
-	NumberValue int `json:"number_value,omitempty"`
+	// Value of number and decimal fields
+	NumberValue float64 `json:"number_value,omitempty"`
 	// BooleanValue holds the value of the "boolean_value" field.
 	BooleanValue bool `json:"boolean_value,omitempty"`
 	// TimeValue holds the value of the "time_value" field.
@@ -72,7 +72,7 @@ func (*ItemField) scanValues(columns []string) ([]any, error) {
 		case itemfield.FieldBooleanValue:
 			values[i] = new(sql.NullBool)
 		case itemfield.FieldNumberValue:
-			values[i] = new(sql.NullInt64)
+			values[i] = new(sql.NullFloat64)
 		case itemfield.FieldName, itemfield.FieldDescription, itemfield.FieldType, itemfield.FieldTextValue:
 			values[i] = new(sql.NullString)
 		case itemfield.FieldCreatedAt, itemfield.FieldUpdatedAt, itemfield.FieldTimeValue:
@@ -139,10 +139,10 @@ func (_m *ItemField) assignValues(columns []string, values []any) error {
 				_m.TextValue = value.String
 			}
 		case itemfield.FieldNumberValue:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field number_value", values[i])
 			} else if value.Valid {
-				_m.NumberValue = int(value.Int64)
+				_m.NumberValue = value.Float64
 			}
 		case itemfield.FieldBooleanValue:
 			if value, ok := values[i].(*sql.NullBool); !ok {
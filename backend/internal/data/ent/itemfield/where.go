@@ -82,7 +82,7 @@ func TextValue(v string) predicate.ItemField {
 }
 
 // NumberValue applies equality check predicate on the "number_value" field. It's identical to NumberValueEQ.
-func NumberValue(v int) predicate.ItemField {
+func NumberValue(v float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldEQ(FieldNumberValue, v))
 }
 
@@ -412,42 +412,42 @@ func TextValueContainsFold(v string) predicate.ItemField {
 }
 
 // NumberValueEQ applies the EQ predicate on the "number_value" field.
-func NumberValueEQ(v int) predicate.ItemField {
+func NumberValueEQ(v float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldEQ(FieldNumberValue, v))
 }
 
 // NumberValueNEQ applies the NEQ predicate on the "number_value" field.
-func NumberValueNEQ(v int) predicate.ItemField {
+func NumberValueNEQ(v float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldNEQ(FieldNumberValue, v))
 }
 
 // NumberValueIn applies the In predicate on the "number_value" field.
-func NumberValueIn(vs ...int) predicate.ItemField {
+func NumberValueIn(vs ...float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldIn(FieldNumberValue, vs...))
 }
 
 // NumberValueNotIn applies the NotIn predicate on the "number_value" field.
-func NumberValueNotIn(vs ...int) predicate.ItemField {
+func NumberValueNotIn(vs ...float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldNotIn(FieldNumberValue, vs...))
 }
 
 // NumberValueGT applies the GT predicate on the "number_value" field.
-func NumberValueGT(v int) predicate.ItemField {
+func NumberValueGT(v float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldGT(FieldNumberValue, v))
 }
 
 // NumberValueGTE applies the GTE predicate on the "number_value" field.
-func NumberValueGTE(v int) predicate.ItemField {
+func NumberValueGTE(v float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldGTE(FieldNumberValue, v))
 }
 
 // NumberValueLT applies the LT predicate on the "number_value" field.
-func NumberValueLT(v int) predicate.ItemField {
+func NumberValueLT(v float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldLT(FieldNumberValue, v))
 }
 
 // NumberValueLTE applies the LTE predicate on the "number_value" field.
-func NumberValueLTE(v int) predicate.ItemField {
+func NumberValueLTE(v float64) predicate.ItemField {
 	return predicate.ItemField(sql.FieldLTE(FieldNumberValue, v))
 }
 
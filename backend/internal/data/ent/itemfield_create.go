@@ -91,13 +91,13 @@ func (_c *ItemFieldCreate) SetNillableTextValue(v *string) *ItemFieldCreate {
 }
 
 // SetNumberValue sets the "number_value" field.
-func (_c *ItemFieldCreate) SetNumberValue(v int) *ItemFieldCreate {
+func (_c *ItemFieldCreate) SetNumberValue(v float64) *ItemFieldCreate {
 	_c.mutation.SetNumberValue(v)
 	return _c
 }
 
 // SetNillableNumberValue sets the "number_value" field if the given value is not nil.
-func (_c *ItemFieldCreate) SetNillableNumberValue(v *int) *ItemFieldCreate {
+func (_c *ItemFieldCreate) SetNillableNumberValue(v *float64) *ItemFieldCreate {
 	if v != nil {
 		_c.SetNumberValue(*v)
 	}
@@ -322,7 +322,7 @@ func (_c *ItemFieldCreate) createSpec() (*ItemField, *sqlgraph.CreateSpec) {
 		_node.TextValue = value
 	}
 	if value, ok := _c.mutation.NumberValue(); ok {
-		_spec.SetField(itemfield.FieldNumberValue, field.TypeInt, value)
+		_spec.SetField(itemfield.FieldNumberValue, field.TypeFloat64, value)
 		_node.NumberValue = value
 	}
 	if value, ok := _c.mutation.BooleanValue(); ok {
@@ -105,14 +105,14 @@ func (_u *ItemFieldUpdate) ClearTextValue() *ItemFieldUpdate {
 }
 
 // SetNumberValue sets the "number_value" field.
-func (_u *ItemFieldUpdate) SetNumberValue(v int) *ItemFieldUpdate {
+func (_u *ItemFieldUpdate) SetNumberValue(v float64) *ItemFieldUpdate {
 	_u.mutation.ResetNumberValue()
 	_u.mutation.SetNumberValue(v)
 	return _u
 }
 
 // SetNillableNumberValue sets the "number_value" field if the given value is not nil.
-func (_u *ItemFieldUpdate) SetNillableNumberValue(v *int) *ItemFieldUpdate {
+func (_u *ItemFieldUpdate) SetNillableNumberValue(v *float64) *ItemFieldUpdate {
 	if v != nil {
 		_u.SetNumberValue(*v)
 	}
@@ -120,7 +120,7 @@ func (_u *ItemFieldUpdate) SetNillableNumberValue(v *int) *ItemFieldUpdate {
 }
 
 // AddNumberValue adds value to the "number_value" field.
-func (_u *ItemFieldUpdate) AddNumberValue(v int) *ItemFieldUpdate {
+func (_u *ItemFieldUpdate) AddNumberValue(v float64) *ItemFieldUpdate {
 	_u.mutation.AddNumberValue(v)
 	return _u
 }
@@ -284,13 +284,13 @@ func (_u *ItemFieldUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.ClearField(itemfield.FieldTextValue, field.TypeString)
 	}
 	if value, ok := _u.mutation.NumberValue(); ok {
-		_spec.SetField(itemfield.FieldNumberValue, field.TypeInt, value)
+		_spec.SetField(itemfield.FieldNumberValue, field.TypeFloat64, value)
 	}
 	if value, ok := _u.mutation.AddedNumberValue(); ok {
-		_spec.AddField(itemfield.FieldNumberValue, field.TypeInt, value)
+		_spec.AddField(itemfield.FieldNumberValue, field.TypeFloat64, value)
 	}
 	if _u.mutation.NumberValueCleared() {
-		_spec.ClearField(itemfield.FieldNumberValue, field.TypeInt)
+		_spec.ClearField(itemfield.FieldNumberValue, field.TypeFloat64)
 	}
 	if value, ok := _u.mutation.BooleanValue(); ok {
 		_spec.SetField(itemfield.FieldBooleanValue, field.TypeBool, value)
@@ -422,14 +422,14 @@ func (_u *ItemFieldUpdateOne) ClearTextValue() *ItemFieldUpdateOne {
 }
 
 // SetNumberValue sets the "number_value" field.
-func (_u *ItemFieldUpdateOne) SetNumberValue(v int) *ItemFieldUpdateOne {
+func (_u *ItemFieldUpdateOne) SetNumberValue(v float64) *ItemFieldUpdateOne {
 	_u.mutation.ResetNumberValue()
 	_u.mutation.SetNumberValue(v)
 	return _u
 }
 
 // SetNillableNumberValue sets the "number_value" field if the given value is not nil.
-func (_u *ItemFieldUpdateOne) SetNillableNumberValue(v *int) *ItemFieldUpdateOne {
+func (_u *ItemFieldUpdateOne) SetNillableNumberValue(v *float64) *ItemFieldUpdateOne {
 	if v != nil {
 		_u.SetNumberValue(*v)
 	}
@@ -437,7 +437,7 @@ func (_u *ItemFieldUpdateOne) SetNillableNumberValue(v *int) *ItemFieldUpdateOne
 }
 
 // AddNumberValue adds value to the "number_value" field.
-func (_u *ItemFieldUpdateOne) AddNumberValue(v int) *ItemFieldUpdateOne {
+func (_u *ItemFieldUpdateOne) AddNumberValue(v float64) *ItemFieldUpdateOne {
 	_u.mutation.AddNumberValue(v)
 	return _u
 }
@@ -631,13 +631,13 @@ func (_u *ItemFieldUpdateOne) sqlSave(ctx context.Context) (_node *ItemField, er
 		_spec.ClearField(itemfield.FieldTextValue, field.TypeString)
 	}
 	if value, ok := _u.mutation.NumberValue(); ok {
-		_spec.SetField(itemfield.FieldNumberValue, field.TypeInt, value)
+		_spec.SetField(itemfield.FieldNumberValue, field.TypeFloat64, value)
 	}
 	if value, ok := _u.mutation.AddedNumberValue(); ok {
-		_spec.AddField(itemfield.FieldNumberValue, field.TypeInt, value)
+		_spec.AddField(itemfield.FieldNumberValue, field.TypeFloat64, value)
 	}
 	if _u.mutation.NumberValueCleared() {
-		_spec.ClearField(itemfield.FieldNumberValue, field.TypeInt)
+		_spec.ClearField(itemfield.FieldNumberValue, field.TypeFloat64)
 	}
 	if value, ok := _u.mutation.BooleanValue(); ok {
 		_spec.SetField(itemfield.FieldBooleanValue, field.TypeBool, value)
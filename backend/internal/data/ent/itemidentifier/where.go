@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package itemidentifier
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldGroupID, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldItemID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldValue, v))
+}
+
+// NormalizedValue applies equality check predicate on the "normalized_value" field. It's identical to NormalizedValueEQ.
+func NormalizedValue(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldNormalizedValue, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...uuid.UUID) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNotIn(FieldType, vs...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldContainsFold(FieldValue, v))
+}
+
+// NormalizedValueEQ applies the EQ predicate on the "normalized_value" field.
+func NormalizedValueEQ(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEQ(FieldNormalizedValue, v))
+}
+
+// NormalizedValueNEQ applies the NEQ predicate on the "normalized_value" field.
+func NormalizedValueNEQ(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNEQ(FieldNormalizedValue, v))
+}
+
+// NormalizedValueIn applies the In predicate on the "normalized_value" field.
+func NormalizedValueIn(vs ...string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldIn(FieldNormalizedValue, vs...))
+}
+
+// NormalizedValueNotIn applies the NotIn predicate on the "normalized_value" field.
+func NormalizedValueNotIn(vs ...string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldNotIn(FieldNormalizedValue, vs...))
+}
+
+// NormalizedValueGT applies the GT predicate on the "normalized_value" field.
+func NormalizedValueGT(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGT(FieldNormalizedValue, v))
+}
+
+// NormalizedValueGTE applies the GTE predicate on the "normalized_value" field.
+func NormalizedValueGTE(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldGTE(FieldNormalizedValue, v))
+}
+
+// NormalizedValueLT applies the LT predicate on the "normalized_value" field.
+func NormalizedValueLT(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLT(FieldNormalizedValue, v))
+}
+
+// NormalizedValueLTE applies the LTE predicate on the "normalized_value" field.
+func NormalizedValueLTE(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldLTE(FieldNormalizedValue, v))
+}
+
+// NormalizedValueContains applies the Contains predicate on the "normalized_value" field.
+func NormalizedValueContains(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldContains(FieldNormalizedValue, v))
+}
+
+// NormalizedValueHasPrefix applies the HasPrefix predicate on the "normalized_value" field.
+func NormalizedValueHasPrefix(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldHasPrefix(FieldNormalizedValue, v))
+}
+
+// NormalizedValueHasSuffix applies the HasSuffix predicate on the "normalized_value" field.
+func NormalizedValueHasSuffix(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldHasSuffix(FieldNormalizedValue, v))
+}
+
+// NormalizedValueEqualFold applies the EqualFold predicate on the "normalized_value" field.
+func NormalizedValueEqualFold(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldEqualFold(FieldNormalizedValue, v))
+}
+
+// NormalizedValueContainsFold applies the ContainsFold predicate on the "normalized_value" field.
+func NormalizedValueContainsFold(v string) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.FieldContainsFold(FieldNormalizedValue, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ItemIdentifier) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ItemIdentifier) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ItemIdentifier) predicate.ItemIdentifier {
+	return predicate.ItemIdentifier(sql.NotPredicates(p))
+}
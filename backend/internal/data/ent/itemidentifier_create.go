@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+)
+
+// ItemIdentifierCreate is the builder for creating a ItemIdentifier entity.
+type ItemIdentifierCreate struct {
+	config
+	mutation *ItemIdentifierMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ItemIdentifierCreate) SetCreatedAt(v time.Time) *ItemIdentifierCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ItemIdentifierCreate) SetNillableCreatedAt(v *time.Time) *ItemIdentifierCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ItemIdentifierCreate) SetUpdatedAt(v time.Time) *ItemIdentifierCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ItemIdentifierCreate) SetNillableUpdatedAt(v *time.Time) *ItemIdentifierCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *ItemIdentifierCreate) SetGroupID(v uuid.UUID) *ItemIdentifierCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ItemIdentifierCreate) SetItemID(v uuid.UUID) *ItemIdentifierCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *ItemIdentifierCreate) SetType(v itemidentifier.Type) *ItemIdentifierCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *ItemIdentifierCreate) SetNillableType(v *itemidentifier.Type) *ItemIdentifierCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *ItemIdentifierCreate) SetValue(v string) *ItemIdentifierCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNormalizedValue sets the "normalized_value" field.
+func (_c *ItemIdentifierCreate) SetNormalizedValue(v string) *ItemIdentifierCreate {
+	_c.mutation.SetNormalizedValue(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ItemIdentifierCreate) SetID(v uuid.UUID) *ItemIdentifierCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ItemIdentifierCreate) SetNillableID(v *uuid.UUID) *ItemIdentifierCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_c *ItemIdentifierCreate) SetGroup(v *Group) *ItemIdentifierCreate {
+	return _c.SetGroupID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *ItemIdentifierCreate) SetItem(v *Item) *ItemIdentifierCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// Mutation returns the ItemIdentifierMutation object of the builder.
+func (_c *ItemIdentifierCreate) Mutation() *ItemIdentifierMutation {
+	return _c.mutation
+}
+
+// Save creates the ItemIdentifier in the database.
+func (_c *ItemIdentifierCreate) Save(ctx context.Context) (*ItemIdentifier, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ItemIdentifierCreate) SaveX(ctx context.Context) *ItemIdentifier {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ItemIdentifierCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ItemIdentifierCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ItemIdentifierCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := itemidentifier.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := itemidentifier.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := itemidentifier.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := itemidentifier.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ItemIdentifierCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemIdentifier.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ItemIdentifier.updated_at"`)}
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "ItemIdentifier.group_id"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemIdentifier.item_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ItemIdentifier.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := itemidentifier.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "ItemIdentifier.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := itemidentifier.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NormalizedValue(); !ok {
+		return &ValidationError{Name: "normalized_value", err: errors.New(`ent: missing required field "ItemIdentifier.normalized_value"`)}
+	}
+	if v, ok := _c.mutation.NormalizedValue(); ok {
+		if err := itemidentifier.NormalizedValueValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_value", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.normalized_value": %w`, err)}
+		}
+	}
+	if len(_c.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "ItemIdentifier.group"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ItemIdentifier.item"`)}
+	}
+	return nil
+}
+
+func (_c *ItemIdentifierCreate) sqlSave(ctx context.Context) (*ItemIdentifier, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ItemIdentifierCreate) createSpec() (*ItemIdentifier, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemIdentifier{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(itemidentifier.Table, sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(itemidentifier.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemidentifier.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(itemidentifier.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(itemidentifier.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.NormalizedValue(); ok {
+		_spec.SetField(itemidentifier.FieldNormalizedValue, field.TypeString, value)
+		_node.NormalizedValue = value
+	}
+	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.GroupTable,
+			Columns: []string{itemidentifier.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.ItemTable,
+			Columns: []string{itemidentifier.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemIdentifierCreateBulk is the builder for creating many ItemIdentifier entities in bulk.
+type ItemIdentifierCreateBulk struct {
+	config
+	err      error
+	builders []*ItemIdentifierCreate
+}
+
+// Save creates the ItemIdentifier entities in the database.
+func (_c *ItemIdentifierCreateBulk) Save(ctx context.Context) ([]*ItemIdentifier, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ItemIdentifier, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemIdentifierMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ItemIdentifierCreateBulk) SaveX(ctx context.Context) []*ItemIdentifier {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ItemIdentifierCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ItemIdentifierCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
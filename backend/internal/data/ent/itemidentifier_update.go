@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ItemIdentifierUpdate is the builder for updating ItemIdentifier entities.
+type ItemIdentifierUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemIdentifierMutation
+}
+
+// Where appends a list predicates to the ItemIdentifierUpdate builder.
+func (_u *ItemIdentifierUpdate) Where(ps ...predicate.ItemIdentifier) *ItemIdentifierUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ItemIdentifierUpdate) SetUpdatedAt(v time.Time) *ItemIdentifierUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ItemIdentifierUpdate) SetGroupID(v uuid.UUID) *ItemIdentifierUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ItemIdentifierUpdate) SetNillableGroupID(v *uuid.UUID) *ItemIdentifierUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ItemIdentifierUpdate) SetItemID(v uuid.UUID) *ItemIdentifierUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ItemIdentifierUpdate) SetNillableItemID(v *uuid.UUID) *ItemIdentifierUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ItemIdentifierUpdate) SetType(v itemidentifier.Type) *ItemIdentifierUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ItemIdentifierUpdate) SetNillableType(v *itemidentifier.Type) *ItemIdentifierUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *ItemIdentifierUpdate) SetValue(v string) *ItemIdentifierUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *ItemIdentifierUpdate) SetNillableValue(v *string) *ItemIdentifierUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetNormalizedValue sets the "normalized_value" field.
+func (_u *ItemIdentifierUpdate) SetNormalizedValue(v string) *ItemIdentifierUpdate {
+	_u.mutation.SetNormalizedValue(v)
+	return _u
+}
+
+// SetNillableNormalizedValue sets the "normalized_value" field if the given value is not nil.
+func (_u *ItemIdentifierUpdate) SetNillableNormalizedValue(v *string) *ItemIdentifierUpdate {
+	if v != nil {
+		_u.SetNormalizedValue(*v)
+	}
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *ItemIdentifierUpdate) SetGroup(v *Group) *ItemIdentifierUpdate {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ItemIdentifierUpdate) SetItem(v *Item) *ItemIdentifierUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the ItemIdentifierMutation object of the builder.
+func (_u *ItemIdentifierUpdate) Mutation() *ItemIdentifierMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *ItemIdentifierUpdate) ClearGroup() *ItemIdentifierUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ItemIdentifierUpdate) ClearItem() *ItemIdentifierUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ItemIdentifierUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ItemIdentifierUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ItemIdentifierUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ItemIdentifierUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ItemIdentifierUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := itemidentifier.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ItemIdentifierUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := itemidentifier.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := itemidentifier.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.value": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NormalizedValue(); ok {
+		if err := itemidentifier.NormalizedValueValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_value", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.normalized_value": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemIdentifier.group"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemIdentifier.item"`)
+	}
+	return nil
+}
+
+func (_u *ItemIdentifierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemidentifier.Table, itemidentifier.Columns, sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemidentifier.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(itemidentifier.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(itemidentifier.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NormalizedValue(); ok {
+		_spec.SetField(itemidentifier.FieldNormalizedValue, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.GroupTable,
+			Columns: []string{itemidentifier.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.GroupTable,
+			Columns: []string{itemidentifier.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.ItemTable,
+			Columns: []string{itemidentifier.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.ItemTable,
+			Columns: []string{itemidentifier.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemidentifier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ItemIdentifierUpdateOne is the builder for updating a single ItemIdentifier entity.
+type ItemIdentifierUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemIdentifierMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ItemIdentifierUpdateOne) SetUpdatedAt(v time.Time) *ItemIdentifierUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ItemIdentifierUpdateOne) SetGroupID(v uuid.UUID) *ItemIdentifierUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ItemIdentifierUpdateOne) SetNillableGroupID(v *uuid.UUID) *ItemIdentifierUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ItemIdentifierUpdateOne) SetItemID(v uuid.UUID) *ItemIdentifierUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ItemIdentifierUpdateOne) SetNillableItemID(v *uuid.UUID) *ItemIdentifierUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ItemIdentifierUpdateOne) SetType(v itemidentifier.Type) *ItemIdentifierUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ItemIdentifierUpdateOne) SetNillableType(v *itemidentifier.Type) *ItemIdentifierUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *ItemIdentifierUpdateOne) SetValue(v string) *ItemIdentifierUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *ItemIdentifierUpdateOne) SetNillableValue(v *string) *ItemIdentifierUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetNormalizedValue sets the "normalized_value" field.
+func (_u *ItemIdentifierUpdateOne) SetNormalizedValue(v string) *ItemIdentifierUpdateOne {
+	_u.mutation.SetNormalizedValue(v)
+	return _u
+}
+
+// SetNillableNormalizedValue sets the "normalized_value" field if the given value is not nil.
+func (_u *ItemIdentifierUpdateOne) SetNillableNormalizedValue(v *string) *ItemIdentifierUpdateOne {
+	if v != nil {
+		_u.SetNormalizedValue(*v)
+	}
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *ItemIdentifierUpdateOne) SetGroup(v *Group) *ItemIdentifierUpdateOne {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ItemIdentifierUpdateOne) SetItem(v *Item) *ItemIdentifierUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the ItemIdentifierMutation object of the builder.
+func (_u *ItemIdentifierUpdateOne) Mutation() *ItemIdentifierMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *ItemIdentifierUpdateOne) ClearGroup() *ItemIdentifierUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ItemIdentifierUpdateOne) ClearItem() *ItemIdentifierUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Where appends a list predicates to the ItemIdentifierUpdate builder.
+func (_u *ItemIdentifierUpdateOne) Where(ps ...predicate.ItemIdentifier) *ItemIdentifierUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ItemIdentifierUpdateOne) Select(field string, fields ...string) *ItemIdentifierUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ItemIdentifier entity.
+func (_u *ItemIdentifierUpdateOne) Save(ctx context.Context) (*ItemIdentifier, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ItemIdentifierUpdateOne) SaveX(ctx context.Context) *ItemIdentifier {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ItemIdentifierUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ItemIdentifierUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ItemIdentifierUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := itemidentifier.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ItemIdentifierUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := itemidentifier.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := itemidentifier.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.value": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NormalizedValue(); ok {
+		if err := itemidentifier.NormalizedValueValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_value", err: fmt.Errorf(`ent: validator failed for field "ItemIdentifier.normalized_value": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemIdentifier.group"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemIdentifier.item"`)
+	}
+	return nil
+}
+
+func (_u *ItemIdentifierUpdateOne) sqlSave(ctx context.Context) (_node *ItemIdentifier, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemidentifier.Table, itemidentifier.Columns, sqlgraph.NewFieldSpec(itemidentifier.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemIdentifier.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemidentifier.FieldID)
+		for _, f := range fields {
+			if !itemidentifier.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemidentifier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemidentifier.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(itemidentifier.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(itemidentifier.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NormalizedValue(); ok {
+		_spec.SetField(itemidentifier.FieldNormalizedValue, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.GroupTable,
+			Columns: []string{itemidentifier.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.GroupTable,
+			Columns: []string{itemidentifier.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.ItemTable,
+			Columns: []string{itemidentifier.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemidentifier.ItemTable,
+			Columns: []string{itemidentifier.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ItemIdentifier{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemidentifier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
+)
+
+// ItemStatusChangeCreate is the builder for creating a ItemStatusChange entity.
+type ItemStatusChangeCreate struct {
+	config
+	mutation *ItemStatusChangeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ItemStatusChangeCreate) SetCreatedAt(v time.Time) *ItemStatusChangeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ItemStatusChangeCreate) SetNillableCreatedAt(v *time.Time) *ItemStatusChangeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ItemStatusChangeCreate) SetUpdatedAt(v time.Time) *ItemStatusChangeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ItemStatusChangeCreate) SetNillableUpdatedAt(v *time.Time) *ItemStatusChangeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *ItemStatusChangeCreate) SetGroupID(v uuid.UUID) *ItemStatusChangeCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ItemStatusChangeCreate) SetItemID(v uuid.UUID) *ItemStatusChangeCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *ItemStatusChangeCreate) SetFromStatus(v itemstatuschange.FromStatus) *ItemStatusChangeCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *ItemStatusChangeCreate) SetToStatus(v itemstatuschange.ToStatus) *ItemStatusChangeCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ItemStatusChangeCreate) SetReason(v string) *ItemStatusChangeCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ItemStatusChangeCreate) SetNillableReason(v *string) *ItemStatusChangeCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *ItemStatusChangeCreate) SetActorID(v uuid.UUID) *ItemStatusChangeCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *ItemStatusChangeCreate) SetNillableActorID(v *uuid.UUID) *ItemStatusChangeCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ItemStatusChangeCreate) SetID(v uuid.UUID) *ItemStatusChangeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ItemStatusChangeCreate) SetNillableID(v *uuid.UUID) *ItemStatusChangeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_c *ItemStatusChangeCreate) SetGroup(v *Group) *ItemStatusChangeCreate {
+	return _c.SetGroupID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *ItemStatusChangeCreate) SetItem(v *Item) *ItemStatusChangeCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// Mutation returns the ItemStatusChangeMutation object of the builder.
+func (_c *ItemStatusChangeCreate) Mutation() *ItemStatusChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the ItemStatusChange in the database.
+func (_c *ItemStatusChangeCreate) Save(ctx context.Context) (*ItemStatusChange, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ItemStatusChangeCreate) SaveX(ctx context.Context) *ItemStatusChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ItemStatusChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ItemStatusChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ItemStatusChangeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := itemstatuschange.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := itemstatuschange.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := itemstatuschange.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ItemStatusChangeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemStatusChange.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ItemStatusChange.updated_at"`)}
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "ItemStatusChange.group_id"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemStatusChange.item_id"`)}
+	}
+	if _, ok := _c.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "ItemStatusChange.from_status"`)}
+	}
+	if v, ok := _c.mutation.FromStatus(); ok {
+		if err := itemstatuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.from_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "ItemStatusChange.to_status"`)}
+	}
+	if v, ok := _c.mutation.ToStatus(); ok {
+		if err := itemstatuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.to_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := itemstatuschange.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.reason": %w`, err)}
+		}
+	}
+	if len(_c.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "ItemStatusChange.group"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ItemStatusChange.item"`)}
+	}
+	return nil
+}
+
+func (_c *ItemStatusChangeCreate) sqlSave(ctx context.Context) (*ItemStatusChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ItemStatusChangeCreate) createSpec() (*ItemStatusChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemStatusChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(itemstatuschange.Table, sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(itemstatuschange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemstatuschange.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(itemstatuschange.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(itemstatuschange.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(itemstatuschange.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(itemstatuschange.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.GroupTable,
+			Columns: []string{itemstatuschange.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.ItemTable,
+			Columns: []string{itemstatuschange.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemStatusChangeCreateBulk is the builder for creating many ItemStatusChange entities in bulk.
+type ItemStatusChangeCreateBulk struct {
+	config
+	err      error
+	builders []*ItemStatusChangeCreate
+}
+
+// Save creates the ItemStatusChange entities in the database.
+func (_c *ItemStatusChangeCreateBulk) Save(ctx context.Context) ([]*ItemStatusChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ItemStatusChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemStatusChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ItemStatusChangeCreateBulk) SaveX(ctx context.Context) []*ItemStatusChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ItemStatusChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ItemStatusChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ItemStatusChangeUpdate is the builder for updating ItemStatusChange entities.
+type ItemStatusChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemStatusChangeMutation
+}
+
+// Where appends a list predicates to the ItemStatusChangeUpdate builder.
+func (_u *ItemStatusChangeUpdate) Where(ps ...predicate.ItemStatusChange) *ItemStatusChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ItemStatusChangeUpdate) SetUpdatedAt(v time.Time) *ItemStatusChangeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ItemStatusChangeUpdate) SetGroupID(v uuid.UUID) *ItemStatusChangeUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdate) SetNillableGroupID(v *uuid.UUID) *ItemStatusChangeUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ItemStatusChangeUpdate) SetItemID(v uuid.UUID) *ItemStatusChangeUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdate) SetNillableItemID(v *uuid.UUID) *ItemStatusChangeUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *ItemStatusChangeUpdate) SetFromStatus(v itemstatuschange.FromStatus) *ItemStatusChangeUpdate {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdate) SetNillableFromStatus(v *itemstatuschange.FromStatus) *ItemStatusChangeUpdate {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *ItemStatusChangeUpdate) SetToStatus(v itemstatuschange.ToStatus) *ItemStatusChangeUpdate {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdate) SetNillableToStatus(v *itemstatuschange.ToStatus) *ItemStatusChangeUpdate {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ItemStatusChangeUpdate) SetReason(v string) *ItemStatusChangeUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdate) SetNillableReason(v *string) *ItemStatusChangeUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ItemStatusChangeUpdate) ClearReason() *ItemStatusChangeUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *ItemStatusChangeUpdate) SetActorID(v uuid.UUID) *ItemStatusChangeUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdate) SetNillableActorID(v *uuid.UUID) *ItemStatusChangeUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *ItemStatusChangeUpdate) ClearActorID() *ItemStatusChangeUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *ItemStatusChangeUpdate) SetGroup(v *Group) *ItemStatusChangeUpdate {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ItemStatusChangeUpdate) SetItem(v *Item) *ItemStatusChangeUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the ItemStatusChangeMutation object of the builder.
+func (_u *ItemStatusChangeUpdate) Mutation() *ItemStatusChangeMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *ItemStatusChangeUpdate) ClearGroup() *ItemStatusChangeUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ItemStatusChangeUpdate) ClearItem() *ItemStatusChangeUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ItemStatusChangeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ItemStatusChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ItemStatusChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ItemStatusChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ItemStatusChangeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := itemstatuschange.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ItemStatusChangeUpdate) check() error {
+	if v, ok := _u.mutation.FromStatus(); ok {
+		if err := itemstatuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.from_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToStatus(); ok {
+		if err := itemstatuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.to_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := itemstatuschange.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemStatusChange.group"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemStatusChange.item"`)
+	}
+	return nil
+}
+
+func (_u *ItemStatusChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemstatuschange.Table, itemstatuschange.Columns, sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemstatuschange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(itemstatuschange.FieldFromStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(itemstatuschange.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(itemstatuschange.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(itemstatuschange.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(itemstatuschange.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(itemstatuschange.FieldActorID, field.TypeUUID)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.GroupTable,
+			Columns: []string{itemstatuschange.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.GroupTable,
+			Columns: []string{itemstatuschange.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.ItemTable,
+			Columns: []string{itemstatuschange.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.ItemTable,
+			Columns: []string{itemstatuschange.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemstatuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ItemStatusChangeUpdateOne is the builder for updating a single ItemStatusChange entity.
+type ItemStatusChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemStatusChangeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ItemStatusChangeUpdateOne) SetUpdatedAt(v time.Time) *ItemStatusChangeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ItemStatusChangeUpdateOne) SetGroupID(v uuid.UUID) *ItemStatusChangeUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdateOne) SetNillableGroupID(v *uuid.UUID) *ItemStatusChangeUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ItemStatusChangeUpdateOne) SetItemID(v uuid.UUID) *ItemStatusChangeUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdateOne) SetNillableItemID(v *uuid.UUID) *ItemStatusChangeUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *ItemStatusChangeUpdateOne) SetFromStatus(v itemstatuschange.FromStatus) *ItemStatusChangeUpdateOne {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdateOne) SetNillableFromStatus(v *itemstatuschange.FromStatus) *ItemStatusChangeUpdateOne {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *ItemStatusChangeUpdateOne) SetToStatus(v itemstatuschange.ToStatus) *ItemStatusChangeUpdateOne {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdateOne) SetNillableToStatus(v *itemstatuschange.ToStatus) *ItemStatusChangeUpdateOne {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ItemStatusChangeUpdateOne) SetReason(v string) *ItemStatusChangeUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdateOne) SetNillableReason(v *string) *ItemStatusChangeUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ItemStatusChangeUpdateOne) ClearReason() *ItemStatusChangeUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *ItemStatusChangeUpdateOne) SetActorID(v uuid.UUID) *ItemStatusChangeUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *ItemStatusChangeUpdateOne) SetNillableActorID(v *uuid.UUID) *ItemStatusChangeUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *ItemStatusChangeUpdateOne) ClearActorID() *ItemStatusChangeUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *ItemStatusChangeUpdateOne) SetGroup(v *Group) *ItemStatusChangeUpdateOne {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ItemStatusChangeUpdateOne) SetItem(v *Item) *ItemStatusChangeUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the ItemStatusChangeMutation object of the builder.
+func (_u *ItemStatusChangeUpdateOne) Mutation() *ItemStatusChangeMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *ItemStatusChangeUpdateOne) ClearGroup() *ItemStatusChangeUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ItemStatusChangeUpdateOne) ClearItem() *ItemStatusChangeUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Where appends a list predicates to the ItemStatusChangeUpdate builder.
+func (_u *ItemStatusChangeUpdateOne) Where(ps ...predicate.ItemStatusChange) *ItemStatusChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ItemStatusChangeUpdateOne) Select(field string, fields ...string) *ItemStatusChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ItemStatusChange entity.
+func (_u *ItemStatusChangeUpdateOne) Save(ctx context.Context) (*ItemStatusChange, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ItemStatusChangeUpdateOne) SaveX(ctx context.Context) *ItemStatusChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ItemStatusChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ItemStatusChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ItemStatusChangeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := itemstatuschange.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ItemStatusChangeUpdateOne) check() error {
+	if v, ok := _u.mutation.FromStatus(); ok {
+		if err := itemstatuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.from_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToStatus(); ok {
+		if err := itemstatuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.to_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := itemstatuschange.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ItemStatusChange.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemStatusChange.group"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemStatusChange.item"`)
+	}
+	return nil
+}
+
+func (_u *ItemStatusChangeUpdateOne) sqlSave(ctx context.Context) (_node *ItemStatusChange, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemstatuschange.Table, itemstatuschange.Columns, sqlgraph.NewFieldSpec(itemstatuschange.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemStatusChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemstatuschange.FieldID)
+		for _, f := range fields {
+			if !itemstatuschange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemstatuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemstatuschange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(itemstatuschange.FieldFromStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(itemstatuschange.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(itemstatuschange.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(itemstatuschange.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(itemstatuschange.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(itemstatuschange.FieldActorID, field.TypeUUID)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.GroupTable,
+			Columns: []string{itemstatuschange.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.GroupTable,
+			Columns: []string{itemstatuschange.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.ItemTable,
+			Columns: []string{itemstatuschange.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatuschange.ItemTable,
+			Columns: []string{itemstatuschange.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ItemStatusChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemstatuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
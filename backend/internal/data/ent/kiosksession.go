@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -27,20 +28,37 @@ type KioskSession struct {
 	IsActive bool `json:"is_active,omitempty"`
 	// When the temporary admin unlock expires (null = locked)
 	UnlockedUntil *time.Time `json:"unlocked_until,omitempty"`
+	// When the kiosk device last sent a heartbeat
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// When someone last interacted with the kiosk device, as reported by its heartbeat
+	LastActivityAt *time.Time `json:"last_activity_at,omitempty"`
+	// AppVersion holds the value of the "app_version" field.
+	AppVersion string `json:"app_version,omitempty"`
+	// Battery charge in percent (null = unknown or no battery)
+	BatteryLevel *int `json:"battery_level,omitempty"`
+	// BatteryCharging holds the value of the "battery_charging" field.
+	BatteryCharging *bool `json:"battery_charging,omitempty"`
+	// Network connection reported by the device, e.g. wifi, ethernet or cellular
+	NetworkType string `json:"network_type,omitempty"`
+	// When staff were alerted that the kiosk went silent (cleared on the next heartbeat)
+	OfflineAlertedAt *time.Time `json:"offline_alerted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the KioskSessionQuery when eager-loading is set.
-	Edges              KioskSessionEdges `json:"edges"`
-	user_kiosk_session *uuid.UUID
-	selectValues       sql.SelectValues
+	Edges                   KioskSessionEdges `json:"edges"`
+	location_kiosk_sessions *uuid.UUID
+	user_kiosk_session      *uuid.UUID
+	selectValues            sql.SelectValues
 }
 
 // KioskSessionEdges holds the relations/edges for other nodes in the graph.
 type KioskSessionEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -54,18 +72,35 @@ func (e KioskSessionEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KioskSessionEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*KioskSession) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case kiosksession.FieldIsActive:
+		case kiosksession.FieldIsActive, kiosksession.FieldBatteryCharging:
 			values[i] = new(sql.NullBool)
-		case kiosksession.FieldCreatedAt, kiosksession.FieldUpdatedAt, kiosksession.FieldUnlockedUntil:
+		case kiosksession.FieldBatteryLevel:
+			values[i] = new(sql.NullInt64)
+		case kiosksession.FieldAppVersion, kiosksession.FieldNetworkType:
+			values[i] = new(sql.NullString)
+		case kiosksession.FieldCreatedAt, kiosksession.FieldUpdatedAt, kiosksession.FieldUnlockedUntil, kiosksession.FieldLastSeenAt, kiosksession.FieldLastActivityAt, kiosksession.FieldOfflineAlertedAt:
 			values[i] = new(sql.NullTime)
 		case kiosksession.FieldID:
 			values[i] = new(uuid.UUID)
-		case kiosksession.ForeignKeys[0]: // user_kiosk_session
+		case kiosksession.ForeignKeys[0]: // location_kiosk_sessions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case kiosksession.ForeignKeys[1]: // user_kiosk_session
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -113,7 +148,61 @@ func (_m *KioskSession) assignValues(columns []string, values []any) error {
 				_m.UnlockedUntil = new(time.Time)
 				*_m.UnlockedUntil = value.Time
 			}
+		case kiosksession.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = new(time.Time)
+				*_m.LastSeenAt = value.Time
+			}
+		case kiosksession.FieldLastActivityAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_activity_at", values[i])
+			} else if value.Valid {
+				_m.LastActivityAt = new(time.Time)
+				*_m.LastActivityAt = value.Time
+			}
+		case kiosksession.FieldAppVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_version", values[i])
+			} else if value.Valid {
+				_m.AppVersion = value.String
+			}
+		case kiosksession.FieldBatteryLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field battery_level", values[i])
+			} else if value.Valid {
+				_m.BatteryLevel = new(int)
+				*_m.BatteryLevel = int(value.Int64)
+			}
+		case kiosksession.FieldBatteryCharging:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field battery_charging", values[i])
+			} else if value.Valid {
+				_m.BatteryCharging = new(bool)
+				*_m.BatteryCharging = value.Bool
+			}
+		case kiosksession.FieldNetworkType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_type", values[i])
+			} else if value.Valid {
+				_m.NetworkType = value.String
+			}
+		case kiosksession.FieldOfflineAlertedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offline_alerted_at", values[i])
+			} else if value.Valid {
+				_m.OfflineAlertedAt = new(time.Time)
+				*_m.OfflineAlertedAt = value.Time
+			}
 		case kiosksession.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field location_kiosk_sessions", values[i])
+			} else if value.Valid {
+				_m.location_kiosk_sessions = new(uuid.UUID)
+				*_m.location_kiosk_sessions = *value.S.(*uuid.UUID)
+			}
+		case kiosksession.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_kiosk_session", values[i])
 			} else if value.Valid {
@@ -138,6 +227,11 @@ func (_m *KioskSession) QueryUser() *UserQuery {
 	return NewKioskSessionClient(_m.config).QueryUser(_m)
 }
 
+// QueryLocation queries the "location" edge of the KioskSession entity.
+func (_m *KioskSession) QueryLocation() *LocationQuery {
+	return NewKioskSessionClient(_m.config).QueryLocation(_m)
+}
+
 // Update returns a builder for updating this KioskSession.
 // Note that you need to call KioskSession.Unwrap() before calling this method if this KioskSession
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -174,6 +268,37 @@ func (_m *KioskSession) String() string {
 		builder.WriteString("unlocked_until=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastActivityAt; v != nil {
+		builder.WriteString("last_activity_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("app_version=")
+	builder.WriteString(_m.AppVersion)
+	builder.WriteString(", ")
+	if v := _m.BatteryLevel; v != nil {
+		builder.WriteString("battery_level=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BatteryCharging; v != nil {
+		builder.WriteString("battery_charging=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("network_type=")
+	builder.WriteString(_m.NetworkType)
+	builder.WriteString(", ")
+	if v := _m.OfflineAlertedAt; v != nil {
+		builder.WriteString("offline_alerted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
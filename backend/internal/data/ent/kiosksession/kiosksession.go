@@ -23,8 +23,24 @@ const (
 	FieldIsActive = "is_active"
 	// FieldUnlockedUntil holds the string denoting the unlocked_until field in the database.
 	FieldUnlockedUntil = "unlocked_until"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldLastActivityAt holds the string denoting the last_activity_at field in the database.
+	FieldLastActivityAt = "last_activity_at"
+	// FieldAppVersion holds the string denoting the app_version field in the database.
+	FieldAppVersion = "app_version"
+	// FieldBatteryLevel holds the string denoting the battery_level field in the database.
+	FieldBatteryLevel = "battery_level"
+	// FieldBatteryCharging holds the string denoting the battery_charging field in the database.
+	FieldBatteryCharging = "battery_charging"
+	// FieldNetworkType holds the string denoting the network_type field in the database.
+	FieldNetworkType = "network_type"
+	// FieldOfflineAlertedAt holds the string denoting the offline_alerted_at field in the database.
+	FieldOfflineAlertedAt = "offline_alerted_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeLocation holds the string denoting the location edge name in mutations.
+	EdgeLocation = "location"
 	// Table holds the table name of the kiosksession in the database.
 	Table = "kiosk_sessions"
 	// UserTable is the table that holds the user relation/edge.
@@ -34,6 +50,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_kiosk_session"
+	// LocationTable is the table that holds the location relation/edge.
+	LocationTable = "kiosk_sessions"
+	// LocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationInverseTable = "locations"
+	// LocationColumn is the table column denoting the location relation/edge.
+	LocationColumn = "location_kiosk_sessions"
 )
 
 // Columns holds all SQL columns for kiosksession fields.
@@ -43,11 +66,19 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldIsActive,
 	FieldUnlockedUntil,
+	FieldLastSeenAt,
+	FieldLastActivityAt,
+	FieldAppVersion,
+	FieldBatteryLevel,
+	FieldBatteryCharging,
+	FieldNetworkType,
+	FieldOfflineAlertedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "kiosk_sessions"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"location_kiosk_sessions",
 	"user_kiosk_session",
 }
 
@@ -75,6 +106,12 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// AppVersionValidator is a validator for the "app_version" field. It is called by the builders before save.
+	AppVersionValidator func(string) error
+	// BatteryLevelValidator is a validator for the "battery_level" field. It is called by the builders before save.
+	BatteryLevelValidator func(int) error
+	// NetworkTypeValidator is a validator for the "network_type" field. It is called by the builders before save.
+	NetworkTypeValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -107,12 +144,54 @@ func ByUnlockedUntil(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUnlockedUntil, opts...).ToFunc()
 }
 
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByLastActivityAt orders the results by the last_activity_at field.
+func ByLastActivityAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastActivityAt, opts...).ToFunc()
+}
+
+// ByAppVersion orders the results by the app_version field.
+func ByAppVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppVersion, opts...).ToFunc()
+}
+
+// ByBatteryLevel orders the results by the battery_level field.
+func ByBatteryLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatteryLevel, opts...).ToFunc()
+}
+
+// ByBatteryCharging orders the results by the battery_charging field.
+func ByBatteryCharging(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatteryCharging, opts...).ToFunc()
+}
+
+// ByNetworkType orders the results by the network_type field.
+func ByNetworkType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkType, opts...).ToFunc()
+}
+
+// ByOfflineAlertedAt orders the results by the offline_alerted_at field.
+func ByOfflineAlertedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOfflineAlertedAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByLocationField orders the results by location field.
+func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -120,3 +199,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
 	)
 }
+func newLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+	)
+}
@@ -76,6 +76,41 @@ func UnlockedUntil(v time.Time) predicate.KioskSession {
 	return predicate.KioskSession(sql.FieldEQ(FieldUnlockedUntil, v))
 }
 
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastActivityAt applies equality check predicate on the "last_activity_at" field. It's identical to LastActivityAtEQ.
+func LastActivityAt(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldLastActivityAt, v))
+}
+
+// AppVersion applies equality check predicate on the "app_version" field. It's identical to AppVersionEQ.
+func AppVersion(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldAppVersion, v))
+}
+
+// BatteryLevel applies equality check predicate on the "battery_level" field. It's identical to BatteryLevelEQ.
+func BatteryLevel(v int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldBatteryLevel, v))
+}
+
+// BatteryCharging applies equality check predicate on the "battery_charging" field. It's identical to BatteryChargingEQ.
+func BatteryCharging(v bool) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldBatteryCharging, v))
+}
+
+// NetworkType applies equality check predicate on the "network_type" field. It's identical to NetworkTypeEQ.
+func NetworkType(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldNetworkType, v))
+}
+
+// OfflineAlertedAt applies equality check predicate on the "offline_alerted_at" field. It's identical to OfflineAlertedAtEQ.
+func OfflineAlertedAt(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldOfflineAlertedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.KioskSession {
 	return predicate.KioskSession(sql.FieldEQ(FieldCreatedAt, v))
@@ -216,6 +251,376 @@ func UnlockedUntilNotNil() predicate.KioskSession {
 	return predicate.KioskSession(sql.FieldNotNull(FieldUnlockedUntil))
 }
 
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotNull(FieldLastSeenAt))
+}
+
+// LastActivityAtEQ applies the EQ predicate on the "last_activity_at" field.
+func LastActivityAtEQ(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldLastActivityAt, v))
+}
+
+// LastActivityAtNEQ applies the NEQ predicate on the "last_activity_at" field.
+func LastActivityAtNEQ(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNEQ(FieldLastActivityAt, v))
+}
+
+// LastActivityAtIn applies the In predicate on the "last_activity_at" field.
+func LastActivityAtIn(vs ...time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIn(FieldLastActivityAt, vs...))
+}
+
+// LastActivityAtNotIn applies the NotIn predicate on the "last_activity_at" field.
+func LastActivityAtNotIn(vs ...time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotIn(FieldLastActivityAt, vs...))
+}
+
+// LastActivityAtGT applies the GT predicate on the "last_activity_at" field.
+func LastActivityAtGT(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGT(FieldLastActivityAt, v))
+}
+
+// LastActivityAtGTE applies the GTE predicate on the "last_activity_at" field.
+func LastActivityAtGTE(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGTE(FieldLastActivityAt, v))
+}
+
+// LastActivityAtLT applies the LT predicate on the "last_activity_at" field.
+func LastActivityAtLT(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLT(FieldLastActivityAt, v))
+}
+
+// LastActivityAtLTE applies the LTE predicate on the "last_activity_at" field.
+func LastActivityAtLTE(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLTE(FieldLastActivityAt, v))
+}
+
+// LastActivityAtIsNil applies the IsNil predicate on the "last_activity_at" field.
+func LastActivityAtIsNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIsNull(FieldLastActivityAt))
+}
+
+// LastActivityAtNotNil applies the NotNil predicate on the "last_activity_at" field.
+func LastActivityAtNotNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotNull(FieldLastActivityAt))
+}
+
+// AppVersionEQ applies the EQ predicate on the "app_version" field.
+func AppVersionEQ(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldAppVersion, v))
+}
+
+// AppVersionNEQ applies the NEQ predicate on the "app_version" field.
+func AppVersionNEQ(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNEQ(FieldAppVersion, v))
+}
+
+// AppVersionIn applies the In predicate on the "app_version" field.
+func AppVersionIn(vs ...string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIn(FieldAppVersion, vs...))
+}
+
+// AppVersionNotIn applies the NotIn predicate on the "app_version" field.
+func AppVersionNotIn(vs ...string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotIn(FieldAppVersion, vs...))
+}
+
+// AppVersionGT applies the GT predicate on the "app_version" field.
+func AppVersionGT(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGT(FieldAppVersion, v))
+}
+
+// AppVersionGTE applies the GTE predicate on the "app_version" field.
+func AppVersionGTE(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGTE(FieldAppVersion, v))
+}
+
+// AppVersionLT applies the LT predicate on the "app_version" field.
+func AppVersionLT(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLT(FieldAppVersion, v))
+}
+
+// AppVersionLTE applies the LTE predicate on the "app_version" field.
+func AppVersionLTE(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLTE(FieldAppVersion, v))
+}
+
+// AppVersionContains applies the Contains predicate on the "app_version" field.
+func AppVersionContains(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldContains(FieldAppVersion, v))
+}
+
+// AppVersionHasPrefix applies the HasPrefix predicate on the "app_version" field.
+func AppVersionHasPrefix(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldHasPrefix(FieldAppVersion, v))
+}
+
+// AppVersionHasSuffix applies the HasSuffix predicate on the "app_version" field.
+func AppVersionHasSuffix(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldHasSuffix(FieldAppVersion, v))
+}
+
+// AppVersionIsNil applies the IsNil predicate on the "app_version" field.
+func AppVersionIsNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIsNull(FieldAppVersion))
+}
+
+// AppVersionNotNil applies the NotNil predicate on the "app_version" field.
+func AppVersionNotNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotNull(FieldAppVersion))
+}
+
+// AppVersionEqualFold applies the EqualFold predicate on the "app_version" field.
+func AppVersionEqualFold(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEqualFold(FieldAppVersion, v))
+}
+
+// AppVersionContainsFold applies the ContainsFold predicate on the "app_version" field.
+func AppVersionContainsFold(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldContainsFold(FieldAppVersion, v))
+}
+
+// BatteryLevelEQ applies the EQ predicate on the "battery_level" field.
+func BatteryLevelEQ(v int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldBatteryLevel, v))
+}
+
+// BatteryLevelNEQ applies the NEQ predicate on the "battery_level" field.
+func BatteryLevelNEQ(v int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNEQ(FieldBatteryLevel, v))
+}
+
+// BatteryLevelIn applies the In predicate on the "battery_level" field.
+func BatteryLevelIn(vs ...int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIn(FieldBatteryLevel, vs...))
+}
+
+// BatteryLevelNotIn applies the NotIn predicate on the "battery_level" field.
+func BatteryLevelNotIn(vs ...int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotIn(FieldBatteryLevel, vs...))
+}
+
+// BatteryLevelGT applies the GT predicate on the "battery_level" field.
+func BatteryLevelGT(v int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGT(FieldBatteryLevel, v))
+}
+
+// BatteryLevelGTE applies the GTE predicate on the "battery_level" field.
+func BatteryLevelGTE(v int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGTE(FieldBatteryLevel, v))
+}
+
+// BatteryLevelLT applies the LT predicate on the "battery_level" field.
+func BatteryLevelLT(v int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLT(FieldBatteryLevel, v))
+}
+
+// BatteryLevelLTE applies the LTE predicate on the "battery_level" field.
+func BatteryLevelLTE(v int) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLTE(FieldBatteryLevel, v))
+}
+
+// BatteryLevelIsNil applies the IsNil predicate on the "battery_level" field.
+func BatteryLevelIsNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIsNull(FieldBatteryLevel))
+}
+
+// BatteryLevelNotNil applies the NotNil predicate on the "battery_level" field.
+func BatteryLevelNotNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotNull(FieldBatteryLevel))
+}
+
+// BatteryChargingEQ applies the EQ predicate on the "battery_charging" field.
+func BatteryChargingEQ(v bool) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldBatteryCharging, v))
+}
+
+// BatteryChargingNEQ applies the NEQ predicate on the "battery_charging" field.
+func BatteryChargingNEQ(v bool) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNEQ(FieldBatteryCharging, v))
+}
+
+// BatteryChargingIsNil applies the IsNil predicate on the "battery_charging" field.
+func BatteryChargingIsNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIsNull(FieldBatteryCharging))
+}
+
+// BatteryChargingNotNil applies the NotNil predicate on the "battery_charging" field.
+func BatteryChargingNotNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotNull(FieldBatteryCharging))
+}
+
+// NetworkTypeEQ applies the EQ predicate on the "network_type" field.
+func NetworkTypeEQ(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldNetworkType, v))
+}
+
+// NetworkTypeNEQ applies the NEQ predicate on the "network_type" field.
+func NetworkTypeNEQ(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNEQ(FieldNetworkType, v))
+}
+
+// NetworkTypeIn applies the In predicate on the "network_type" field.
+func NetworkTypeIn(vs ...string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIn(FieldNetworkType, vs...))
+}
+
+// NetworkTypeNotIn applies the NotIn predicate on the "network_type" field.
+func NetworkTypeNotIn(vs ...string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotIn(FieldNetworkType, vs...))
+}
+
+// NetworkTypeGT applies the GT predicate on the "network_type" field.
+func NetworkTypeGT(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGT(FieldNetworkType, v))
+}
+
+// NetworkTypeGTE applies the GTE predicate on the "network_type" field.
+func NetworkTypeGTE(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGTE(FieldNetworkType, v))
+}
+
+// NetworkTypeLT applies the LT predicate on the "network_type" field.
+func NetworkTypeLT(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLT(FieldNetworkType, v))
+}
+
+// NetworkTypeLTE applies the LTE predicate on the "network_type" field.
+func NetworkTypeLTE(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLTE(FieldNetworkType, v))
+}
+
+// NetworkTypeContains applies the Contains predicate on the "network_type" field.
+func NetworkTypeContains(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldContains(FieldNetworkType, v))
+}
+
+// NetworkTypeHasPrefix applies the HasPrefix predicate on the "network_type" field.
+func NetworkTypeHasPrefix(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldHasPrefix(FieldNetworkType, v))
+}
+
+// NetworkTypeHasSuffix applies the HasSuffix predicate on the "network_type" field.
+func NetworkTypeHasSuffix(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldHasSuffix(FieldNetworkType, v))
+}
+
+// NetworkTypeIsNil applies the IsNil predicate on the "network_type" field.
+func NetworkTypeIsNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIsNull(FieldNetworkType))
+}
+
+// NetworkTypeNotNil applies the NotNil predicate on the "network_type" field.
+func NetworkTypeNotNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotNull(FieldNetworkType))
+}
+
+// NetworkTypeEqualFold applies the EqualFold predicate on the "network_type" field.
+func NetworkTypeEqualFold(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEqualFold(FieldNetworkType, v))
+}
+
+// NetworkTypeContainsFold applies the ContainsFold predicate on the "network_type" field.
+func NetworkTypeContainsFold(v string) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldContainsFold(FieldNetworkType, v))
+}
+
+// OfflineAlertedAtEQ applies the EQ predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtEQ(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldEQ(FieldOfflineAlertedAt, v))
+}
+
+// OfflineAlertedAtNEQ applies the NEQ predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtNEQ(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNEQ(FieldOfflineAlertedAt, v))
+}
+
+// OfflineAlertedAtIn applies the In predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtIn(vs ...time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIn(FieldOfflineAlertedAt, vs...))
+}
+
+// OfflineAlertedAtNotIn applies the NotIn predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtNotIn(vs ...time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotIn(FieldOfflineAlertedAt, vs...))
+}
+
+// OfflineAlertedAtGT applies the GT predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtGT(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGT(FieldOfflineAlertedAt, v))
+}
+
+// OfflineAlertedAtGTE applies the GTE predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtGTE(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldGTE(FieldOfflineAlertedAt, v))
+}
+
+// OfflineAlertedAtLT applies the LT predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtLT(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLT(FieldOfflineAlertedAt, v))
+}
+
+// OfflineAlertedAtLTE applies the LTE predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtLTE(v time.Time) predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldLTE(FieldOfflineAlertedAt, v))
+}
+
+// OfflineAlertedAtIsNil applies the IsNil predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtIsNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldIsNull(FieldOfflineAlertedAt))
+}
+
+// OfflineAlertedAtNotNil applies the NotNil predicate on the "offline_alerted_at" field.
+func OfflineAlertedAtNotNil() predicate.KioskSession {
+	return predicate.KioskSession(sql.FieldNotNull(FieldOfflineAlertedAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.KioskSession {
 	return predicate.KioskSession(func(s *sql.Selector) {
@@ -239,6 +644,29 @@ func HasUserWith(preds ...predicate.User) predicate.KioskSession {
 	})
 }
 
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.KioskSession {
+	return predicate.KioskSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.KioskSession {
+	return predicate.KioskSession(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.KioskSession) predicate.KioskSession {
 	return predicate.KioskSession(sql.AndPredicates(predicates...))
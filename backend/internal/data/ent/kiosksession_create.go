@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -78,6 +79,104 @@ func (_c *KioskSessionCreate) SetNillableUnlockedUntil(v *time.Time) *KioskSessi
 	return _c
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *KioskSessionCreate) SetLastSeenAt(v time.Time) *KioskSessionCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *KioskSessionCreate) SetNillableLastSeenAt(v *time.Time) *KioskSessionCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (_c *KioskSessionCreate) SetLastActivityAt(v time.Time) *KioskSessionCreate {
+	_c.mutation.SetLastActivityAt(v)
+	return _c
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (_c *KioskSessionCreate) SetNillableLastActivityAt(v *time.Time) *KioskSessionCreate {
+	if v != nil {
+		_c.SetLastActivityAt(*v)
+	}
+	return _c
+}
+
+// SetAppVersion sets the "app_version" field.
+func (_c *KioskSessionCreate) SetAppVersion(v string) *KioskSessionCreate {
+	_c.mutation.SetAppVersion(v)
+	return _c
+}
+
+// SetNillableAppVersion sets the "app_version" field if the given value is not nil.
+func (_c *KioskSessionCreate) SetNillableAppVersion(v *string) *KioskSessionCreate {
+	if v != nil {
+		_c.SetAppVersion(*v)
+	}
+	return _c
+}
+
+// SetBatteryLevel sets the "battery_level" field.
+func (_c *KioskSessionCreate) SetBatteryLevel(v int) *KioskSessionCreate {
+	_c.mutation.SetBatteryLevel(v)
+	return _c
+}
+
+// SetNillableBatteryLevel sets the "battery_level" field if the given value is not nil.
+func (_c *KioskSessionCreate) SetNillableBatteryLevel(v *int) *KioskSessionCreate {
+	if v != nil {
+		_c.SetBatteryLevel(*v)
+	}
+	return _c
+}
+
+// SetBatteryCharging sets the "battery_charging" field.
+func (_c *KioskSessionCreate) SetBatteryCharging(v bool) *KioskSessionCreate {
+	_c.mutation.SetBatteryCharging(v)
+	return _c
+}
+
+// SetNillableBatteryCharging sets the "battery_charging" field if the given value is not nil.
+func (_c *KioskSessionCreate) SetNillableBatteryCharging(v *bool) *KioskSessionCreate {
+	if v != nil {
+		_c.SetBatteryCharging(*v)
+	}
+	return _c
+}
+
+// SetNetworkType sets the "network_type" field.
+func (_c *KioskSessionCreate) SetNetworkType(v string) *KioskSessionCreate {
+	_c.mutation.SetNetworkType(v)
+	return _c
+}
+
+// SetNillableNetworkType sets the "network_type" field if the given value is not nil.
+func (_c *KioskSessionCreate) SetNillableNetworkType(v *string) *KioskSessionCreate {
+	if v != nil {
+		_c.SetNetworkType(*v)
+	}
+	return _c
+}
+
+// SetOfflineAlertedAt sets the "offline_alerted_at" field.
+func (_c *KioskSessionCreate) SetOfflineAlertedAt(v time.Time) *KioskSessionCreate {
+	_c.mutation.SetOfflineAlertedAt(v)
+	return _c
+}
+
+// SetNillableOfflineAlertedAt sets the "offline_alerted_at" field if the given value is not nil.
+func (_c *KioskSessionCreate) SetNillableOfflineAlertedAt(v *time.Time) *KioskSessionCreate {
+	if v != nil {
+		_c.SetOfflineAlertedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *KioskSessionCreate) SetID(v uuid.UUID) *KioskSessionCreate {
 	_c.mutation.SetID(v)
@@ -103,6 +202,25 @@ func (_c *KioskSessionCreate) SetUser(v *User) *KioskSessionCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_c *KioskSessionCreate) SetLocationID(id uuid.UUID) *KioskSessionCreate {
+	_c.mutation.SetLocationID(id)
+	return _c
+}
+
+// SetNillableLocationID sets the "location" edge to the Location entity by ID if the given value is not nil.
+func (_c *KioskSessionCreate) SetNillableLocationID(id *uuid.UUID) *KioskSessionCreate {
+	if id != nil {
+		_c = _c.SetLocationID(*id)
+	}
+	return _c
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_c *KioskSessionCreate) SetLocation(v *Location) *KioskSessionCreate {
+	return _c.SetLocationID(v.ID)
+}
+
 // Mutation returns the KioskSessionMutation object of the builder.
 func (_c *KioskSessionCreate) Mutation() *KioskSessionMutation {
 	return _c.mutation
@@ -167,6 +285,21 @@ func (_c *KioskSessionCreate) check() error {
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "KioskSession.is_active"`)}
 	}
+	if v, ok := _c.mutation.AppVersion(); ok {
+		if err := kiosksession.AppVersionValidator(v); err != nil {
+			return &ValidationError{Name: "app_version", err: fmt.Errorf(`ent: validator failed for field "KioskSession.app_version": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BatteryLevel(); ok {
+		if err := kiosksession.BatteryLevelValidator(v); err != nil {
+			return &ValidationError{Name: "battery_level", err: fmt.Errorf(`ent: validator failed for field "KioskSession.battery_level": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NetworkType(); ok {
+		if err := kiosksession.NetworkTypeValidator(v); err != nil {
+			return &ValidationError{Name: "network_type", err: fmt.Errorf(`ent: validator failed for field "KioskSession.network_type": %w`, err)}
+		}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "KioskSession.user"`)}
 	}
@@ -221,6 +354,34 @@ func (_c *KioskSessionCreate) createSpec() (*KioskSession, *sqlgraph.CreateSpec)
 		_spec.SetField(kiosksession.FieldUnlockedUntil, field.TypeTime, value)
 		_node.UnlockedUntil = &value
 	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(kiosksession.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if value, ok := _c.mutation.LastActivityAt(); ok {
+		_spec.SetField(kiosksession.FieldLastActivityAt, field.TypeTime, value)
+		_node.LastActivityAt = &value
+	}
+	if value, ok := _c.mutation.AppVersion(); ok {
+		_spec.SetField(kiosksession.FieldAppVersion, field.TypeString, value)
+		_node.AppVersion = value
+	}
+	if value, ok := _c.mutation.BatteryLevel(); ok {
+		_spec.SetField(kiosksession.FieldBatteryLevel, field.TypeInt, value)
+		_node.BatteryLevel = &value
+	}
+	if value, ok := _c.mutation.BatteryCharging(); ok {
+		_spec.SetField(kiosksession.FieldBatteryCharging, field.TypeBool, value)
+		_node.BatteryCharging = &value
+	}
+	if value, ok := _c.mutation.NetworkType(); ok {
+		_spec.SetField(kiosksession.FieldNetworkType, field.TypeString, value)
+		_node.NetworkType = value
+	}
+	if value, ok := _c.mutation.OfflineAlertedAt(); ok {
+		_spec.SetField(kiosksession.FieldOfflineAlertedAt, field.TypeTime, value)
+		_node.OfflineAlertedAt = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -238,6 +399,23 @@ func (_c *KioskSessionCreate) createSpec() (*KioskSession, *sqlgraph.CreateSpec)
 		_node.user_kiosk_session = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksession.LocationTable,
+			Columns: []string{kiosksession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.location_kiosk_sessions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
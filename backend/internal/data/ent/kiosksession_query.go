@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
@@ -20,12 +21,13 @@ import (
 // KioskSessionQuery is the builder for querying KioskSession entities.
 type KioskSessionQuery struct {
 	config
-	ctx        *QueryContext
-	order      []kiosksession.OrderOption
-	inters     []Interceptor
-	predicates []predicate.KioskSession
-	withUser   *UserQuery
-	withFKs    bool
+	ctx          *QueryContext
+	order        []kiosksession.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.KioskSession
+	withUser     *UserQuery
+	withLocation *LocationQuery
+	withFKs      bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +86,28 @@ func (_q *KioskSessionQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryLocation chains the current query on the "location" edge.
+func (_q *KioskSessionQuery) QueryLocation() *LocationQuery {
+	query := (&LocationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(kiosksession.Table, kiosksession.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, kiosksession.LocationTable, kiosksession.LocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first KioskSession entity from the query.
 // Returns a *NotFoundError when no KioskSession was found.
 func (_q *KioskSessionQuery) First(ctx context.Context) (*KioskSession, error) {
@@ -271,12 +295,13 @@ func (_q *KioskSessionQuery) Clone() *KioskSessionQuery {
 		return nil
 	}
 	return &KioskSessionQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]kiosksession.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.KioskSession{}, _q.predicates...),
-		withUser:   _q.withUser.Clone(),
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]kiosksession.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.KioskSession{}, _q.predicates...),
+		withUser:     _q.withUser.Clone(),
+		withLocation: _q.withLocation.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,6 +319,17 @@ func (_q *KioskSessionQuery) WithUser(opts ...func(*UserQuery)) *KioskSessionQue
 	return _q
 }
 
+// WithLocation tells the query-builder to eager-load the nodes that are connected to
+// the "location" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *KioskSessionQuery) WithLocation(opts ...func(*LocationQuery)) *KioskSessionQuery {
+	query := (&LocationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLocation = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,11 +409,12 @@ func (_q *KioskSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		nodes       = []*KioskSession{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withUser != nil,
+			_q.withLocation != nil,
 		}
 	)
-	if _q.withUser != nil {
+	if _q.withUser != nil || _q.withLocation != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -407,6 +444,12 @@ func (_q *KioskSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withLocation; query != nil {
+		if err := _q.loadLocation(ctx, query, nodes, nil,
+			func(n *KioskSession, e *Location) { n.Edges.Location = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -442,6 +485,38 @@ func (_q *KioskSessionQuery) loadUser(ctx context.Context, query *UserQuery, nod
 	}
 	return nil
 }
+func (_q *KioskSessionQuery) loadLocation(ctx context.Context, query *LocationQuery, nodes []*KioskSession, init func(*KioskSession), assign func(*KioskSession, *Location)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*KioskSession)
+	for i := range nodes {
+		if nodes[i].location_kiosk_sessions == nil {
+			continue
+		}
+		fk := *nodes[i].location_kiosk_sessions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(location.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "location_kiosk_sessions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *KioskSessionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
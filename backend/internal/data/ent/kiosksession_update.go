@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
@@ -70,6 +71,153 @@ func (_u *KioskSessionUpdate) ClearUnlockedUntil() *KioskSessionUpdate {
 	return _u
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *KioskSessionUpdate) SetLastSeenAt(v time.Time) *KioskSessionUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *KioskSessionUpdate) SetNillableLastSeenAt(v *time.Time) *KioskSessionUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *KioskSessionUpdate) ClearLastSeenAt() *KioskSessionUpdate {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (_u *KioskSessionUpdate) SetLastActivityAt(v time.Time) *KioskSessionUpdate {
+	_u.mutation.SetLastActivityAt(v)
+	return _u
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (_u *KioskSessionUpdate) SetNillableLastActivityAt(v *time.Time) *KioskSessionUpdate {
+	if v != nil {
+		_u.SetLastActivityAt(*v)
+	}
+	return _u
+}
+
+// ClearLastActivityAt clears the value of the "last_activity_at" field.
+func (_u *KioskSessionUpdate) ClearLastActivityAt() *KioskSessionUpdate {
+	_u.mutation.ClearLastActivityAt()
+	return _u
+}
+
+// SetAppVersion sets the "app_version" field.
+func (_u *KioskSessionUpdate) SetAppVersion(v string) *KioskSessionUpdate {
+	_u.mutation.SetAppVersion(v)
+	return _u
+}
+
+// SetNillableAppVersion sets the "app_version" field if the given value is not nil.
+func (_u *KioskSessionUpdate) SetNillableAppVersion(v *string) *KioskSessionUpdate {
+	if v != nil {
+		_u.SetAppVersion(*v)
+	}
+	return _u
+}
+
+// ClearAppVersion clears the value of the "app_version" field.
+func (_u *KioskSessionUpdate) ClearAppVersion() *KioskSessionUpdate {
+	_u.mutation.ClearAppVersion()
+	return _u
+}
+
+// SetBatteryLevel sets the "battery_level" field.
+func (_u *KioskSessionUpdate) SetBatteryLevel(v int) *KioskSessionUpdate {
+	_u.mutation.ResetBatteryLevel()
+	_u.mutation.SetBatteryLevel(v)
+	return _u
+}
+
+// SetNillableBatteryLevel sets the "battery_level" field if the given value is not nil.
+func (_u *KioskSessionUpdate) SetNillableBatteryLevel(v *int) *KioskSessionUpdate {
+	if v != nil {
+		_u.SetBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddBatteryLevel adds value to the "battery_level" field.
+func (_u *KioskSessionUpdate) AddBatteryLevel(v int) *KioskSessionUpdate {
+	_u.mutation.AddBatteryLevel(v)
+	return _u
+}
+
+// ClearBatteryLevel clears the value of the "battery_level" field.
+func (_u *KioskSessionUpdate) ClearBatteryLevel() *KioskSessionUpdate {
+	_u.mutation.ClearBatteryLevel()
+	return _u
+}
+
+// SetBatteryCharging sets the "battery_charging" field.
+func (_u *KioskSessionUpdate) SetBatteryCharging(v bool) *KioskSessionUpdate {
+	_u.mutation.SetBatteryCharging(v)
+	return _u
+}
+
+// SetNillableBatteryCharging sets the "battery_charging" field if the given value is not nil.
+func (_u *KioskSessionUpdate) SetNillableBatteryCharging(v *bool) *KioskSessionUpdate {
+	if v != nil {
+		_u.SetBatteryCharging(*v)
+	}
+	return _u
+}
+
+// ClearBatteryCharging clears the value of the "battery_charging" field.
+func (_u *KioskSessionUpdate) ClearBatteryCharging() *KioskSessionUpdate {
+	_u.mutation.ClearBatteryCharging()
+	return _u
+}
+
+// SetNetworkType sets the "network_type" field.
+func (_u *KioskSessionUpdate) SetNetworkType(v string) *KioskSessionUpdate {
+	_u.mutation.SetNetworkType(v)
+	return _u
+}
+
+// SetNillableNetworkType sets the "network_type" field if the given value is not nil.
+func (_u *KioskSessionUpdate) SetNillableNetworkType(v *string) *KioskSessionUpdate {
+	if v != nil {
+		_u.SetNetworkType(*v)
+	}
+	return _u
+}
+
+// ClearNetworkType clears the value of the "network_type" field.
+func (_u *KioskSessionUpdate) ClearNetworkType() *KioskSessionUpdate {
+	_u.mutation.ClearNetworkType()
+	return _u
+}
+
+// SetOfflineAlertedAt sets the "offline_alerted_at" field.
+func (_u *KioskSessionUpdate) SetOfflineAlertedAt(v time.Time) *KioskSessionUpdate {
+	_u.mutation.SetOfflineAlertedAt(v)
+	return _u
+}
+
+// SetNillableOfflineAlertedAt sets the "offline_alerted_at" field if the given value is not nil.
+func (_u *KioskSessionUpdate) SetNillableOfflineAlertedAt(v *time.Time) *KioskSessionUpdate {
+	if v != nil {
+		_u.SetOfflineAlertedAt(*v)
+	}
+	return _u
+}
+
+// ClearOfflineAlertedAt clears the value of the "offline_alerted_at" field.
+func (_u *KioskSessionUpdate) ClearOfflineAlertedAt() *KioskSessionUpdate {
+	_u.mutation.ClearOfflineAlertedAt()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *KioskSessionUpdate) SetUserID(id uuid.UUID) *KioskSessionUpdate {
 	_u.mutation.SetUserID(id)
@@ -81,6 +229,25 @@ func (_u *KioskSessionUpdate) SetUser(v *User) *KioskSessionUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *KioskSessionUpdate) SetLocationID(id uuid.UUID) *KioskSessionUpdate {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetNillableLocationID sets the "location" edge to the Location entity by ID if the given value is not nil.
+func (_u *KioskSessionUpdate) SetNillableLocationID(id *uuid.UUID) *KioskSessionUpdate {
+	if id != nil {
+		_u = _u.SetLocationID(*id)
+	}
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *KioskSessionUpdate) SetLocation(v *Location) *KioskSessionUpdate {
+	return _u.SetLocationID(v.ID)
+}
+
 // Mutation returns the KioskSessionMutation object of the builder.
 func (_u *KioskSessionUpdate) Mutation() *KioskSessionMutation {
 	return _u.mutation
@@ -92,6 +259,12 @@ func (_u *KioskSessionUpdate) ClearUser() *KioskSessionUpdate {
 	return _u
 }
 
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *KioskSessionUpdate) ClearLocation() *KioskSessionUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *KioskSessionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -130,6 +303,21 @@ func (_u *KioskSessionUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *KioskSessionUpdate) check() error {
+	if v, ok := _u.mutation.AppVersion(); ok {
+		if err := kiosksession.AppVersionValidator(v); err != nil {
+			return &ValidationError{Name: "app_version", err: fmt.Errorf(`ent: validator failed for field "KioskSession.app_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatteryLevel(); ok {
+		if err := kiosksession.BatteryLevelValidator(v); err != nil {
+			return &ValidationError{Name: "battery_level", err: fmt.Errorf(`ent: validator failed for field "KioskSession.battery_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NetworkType(); ok {
+		if err := kiosksession.NetworkTypeValidator(v); err != nil {
+			return &ValidationError{Name: "network_type", err: fmt.Errorf(`ent: validator failed for field "KioskSession.network_type": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "KioskSession.user"`)
 	}
@@ -160,6 +348,51 @@ func (_u *KioskSessionUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.UnlockedUntilCleared() {
 		_spec.ClearField(kiosksession.FieldUnlockedUntil, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(kiosksession.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(kiosksession.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastActivityAt(); ok {
+		_spec.SetField(kiosksession.FieldLastActivityAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastActivityAtCleared() {
+		_spec.ClearField(kiosksession.FieldLastActivityAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AppVersion(); ok {
+		_spec.SetField(kiosksession.FieldAppVersion, field.TypeString, value)
+	}
+	if _u.mutation.AppVersionCleared() {
+		_spec.ClearField(kiosksession.FieldAppVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.BatteryLevel(); ok {
+		_spec.SetField(kiosksession.FieldBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatteryLevel(); ok {
+		_spec.AddField(kiosksession.FieldBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.BatteryLevelCleared() {
+		_spec.ClearField(kiosksession.FieldBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatteryCharging(); ok {
+		_spec.SetField(kiosksession.FieldBatteryCharging, field.TypeBool, value)
+	}
+	if _u.mutation.BatteryChargingCleared() {
+		_spec.ClearField(kiosksession.FieldBatteryCharging, field.TypeBool)
+	}
+	if value, ok := _u.mutation.NetworkType(); ok {
+		_spec.SetField(kiosksession.FieldNetworkType, field.TypeString, value)
+	}
+	if _u.mutation.NetworkTypeCleared() {
+		_spec.ClearField(kiosksession.FieldNetworkType, field.TypeString)
+	}
+	if value, ok := _u.mutation.OfflineAlertedAt(); ok {
+		_spec.SetField(kiosksession.FieldOfflineAlertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfflineAlertedAtCleared() {
+		_spec.ClearField(kiosksession.FieldOfflineAlertedAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -189,6 +422,35 @@ func (_u *KioskSessionUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksession.LocationTable,
+			Columns: []string{kiosksession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksession.LocationTable,
+			Columns: []string{kiosksession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{kiosksession.Label}
@@ -249,6 +511,153 @@ func (_u *KioskSessionUpdateOne) ClearUnlockedUntil() *KioskSessionUpdateOne {
 	return _u
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *KioskSessionUpdateOne) SetLastSeenAt(v time.Time) *KioskSessionUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *KioskSessionUpdateOne) SetNillableLastSeenAt(v *time.Time) *KioskSessionUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *KioskSessionUpdateOne) ClearLastSeenAt() *KioskSessionUpdateOne {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (_u *KioskSessionUpdateOne) SetLastActivityAt(v time.Time) *KioskSessionUpdateOne {
+	_u.mutation.SetLastActivityAt(v)
+	return _u
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (_u *KioskSessionUpdateOne) SetNillableLastActivityAt(v *time.Time) *KioskSessionUpdateOne {
+	if v != nil {
+		_u.SetLastActivityAt(*v)
+	}
+	return _u
+}
+
+// ClearLastActivityAt clears the value of the "last_activity_at" field.
+func (_u *KioskSessionUpdateOne) ClearLastActivityAt() *KioskSessionUpdateOne {
+	_u.mutation.ClearLastActivityAt()
+	return _u
+}
+
+// SetAppVersion sets the "app_version" field.
+func (_u *KioskSessionUpdateOne) SetAppVersion(v string) *KioskSessionUpdateOne {
+	_u.mutation.SetAppVersion(v)
+	return _u
+}
+
+// SetNillableAppVersion sets the "app_version" field if the given value is not nil.
+func (_u *KioskSessionUpdateOne) SetNillableAppVersion(v *string) *KioskSessionUpdateOne {
+	if v != nil {
+		_u.SetAppVersion(*v)
+	}
+	return _u
+}
+
+// ClearAppVersion clears the value of the "app_version" field.
+func (_u *KioskSessionUpdateOne) ClearAppVersion() *KioskSessionUpdateOne {
+	_u.mutation.ClearAppVersion()
+	return _u
+}
+
+// SetBatteryLevel sets the "battery_level" field.
+func (_u *KioskSessionUpdateOne) SetBatteryLevel(v int) *KioskSessionUpdateOne {
+	_u.mutation.ResetBatteryLevel()
+	_u.mutation.SetBatteryLevel(v)
+	return _u
+}
+
+// SetNillableBatteryLevel sets the "battery_level" field if the given value is not nil.
+func (_u *KioskSessionUpdateOne) SetNillableBatteryLevel(v *int) *KioskSessionUpdateOne {
+	if v != nil {
+		_u.SetBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddBatteryLevel adds value to the "battery_level" field.
+func (_u *KioskSessionUpdateOne) AddBatteryLevel(v int) *KioskSessionUpdateOne {
+	_u.mutation.AddBatteryLevel(v)
+	return _u
+}
+
+// ClearBatteryLevel clears the value of the "battery_level" field.
+func (_u *KioskSessionUpdateOne) ClearBatteryLevel() *KioskSessionUpdateOne {
+	_u.mutation.ClearBatteryLevel()
+	return _u
+}
+
+// SetBatteryCharging sets the "battery_charging" field.
+func (_u *KioskSessionUpdateOne) SetBatteryCharging(v bool) *KioskSessionUpdateOne {
+	_u.mutation.SetBatteryCharging(v)
+	return _u
+}
+
+// SetNillableBatteryCharging sets the "battery_charging" field if the given value is not nil.
+func (_u *KioskSessionUpdateOne) SetNillableBatteryCharging(v *bool) *KioskSessionUpdateOne {
+	if v != nil {
+		_u.SetBatteryCharging(*v)
+	}
+	return _u
+}
+
+// ClearBatteryCharging clears the value of the "battery_charging" field.
+func (_u *KioskSessionUpdateOne) ClearBatteryCharging() *KioskSessionUpdateOne {
+	_u.mutation.ClearBatteryCharging()
+	return _u
+}
+
+// SetNetworkType sets the "network_type" field.
+func (_u *KioskSessionUpdateOne) SetNetworkType(v string) *KioskSessionUpdateOne {
+	_u.mutation.SetNetworkType(v)
+	return _u
+}
+
+// SetNillableNetworkType sets the "network_type" field if the given value is not nil.
+func (_u *KioskSessionUpdateOne) SetNillableNetworkType(v *string) *KioskSessionUpdateOne {
+	if v != nil {
+		_u.SetNetworkType(*v)
+	}
+	return _u
+}
+
+// ClearNetworkType clears the value of the "network_type" field.
+func (_u *KioskSessionUpdateOne) ClearNetworkType() *KioskSessionUpdateOne {
+	_u.mutation.ClearNetworkType()
+	return _u
+}
+
+// SetOfflineAlertedAt sets the "offline_alerted_at" field.
+func (_u *KioskSessionUpdateOne) SetOfflineAlertedAt(v time.Time) *KioskSessionUpdateOne {
+	_u.mutation.SetOfflineAlertedAt(v)
+	return _u
+}
+
+// SetNillableOfflineAlertedAt sets the "offline_alerted_at" field if the given value is not nil.
+func (_u *KioskSessionUpdateOne) SetNillableOfflineAlertedAt(v *time.Time) *KioskSessionUpdateOne {
+	if v != nil {
+		_u.SetOfflineAlertedAt(*v)
+	}
+	return _u
+}
+
+// ClearOfflineAlertedAt clears the value of the "offline_alerted_at" field.
+func (_u *KioskSessionUpdateOne) ClearOfflineAlertedAt() *KioskSessionUpdateOne {
+	_u.mutation.ClearOfflineAlertedAt()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *KioskSessionUpdateOne) SetUserID(id uuid.UUID) *KioskSessionUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -260,6 +669,25 @@ func (_u *KioskSessionUpdateOne) SetUser(v *User) *KioskSessionUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *KioskSessionUpdateOne) SetLocationID(id uuid.UUID) *KioskSessionUpdateOne {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetNillableLocationID sets the "location" edge to the Location entity by ID if the given value is not nil.
+func (_u *KioskSessionUpdateOne) SetNillableLocationID(id *uuid.UUID) *KioskSessionUpdateOne {
+	if id != nil {
+		_u = _u.SetLocationID(*id)
+	}
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *KioskSessionUpdateOne) SetLocation(v *Location) *KioskSessionUpdateOne {
+	return _u.SetLocationID(v.ID)
+}
+
 // Mutation returns the KioskSessionMutation object of the builder.
 func (_u *KioskSessionUpdateOne) Mutation() *KioskSessionMutation {
 	return _u.mutation
@@ -271,6 +699,12 @@ func (_u *KioskSessionUpdateOne) ClearUser() *KioskSessionUpdateOne {
 	return _u
 }
 
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *KioskSessionUpdateOne) ClearLocation() *KioskSessionUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
 // Where appends a list predicates to the KioskSessionUpdate builder.
 func (_u *KioskSessionUpdateOne) Where(ps ...predicate.KioskSession) *KioskSessionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -322,6 +756,21 @@ func (_u *KioskSessionUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *KioskSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.AppVersion(); ok {
+		if err := kiosksession.AppVersionValidator(v); err != nil {
+			return &ValidationError{Name: "app_version", err: fmt.Errorf(`ent: validator failed for field "KioskSession.app_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BatteryLevel(); ok {
+		if err := kiosksession.BatteryLevelValidator(v); err != nil {
+			return &ValidationError{Name: "battery_level", err: fmt.Errorf(`ent: validator failed for field "KioskSession.battery_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NetworkType(); ok {
+		if err := kiosksession.NetworkTypeValidator(v); err != nil {
+			return &ValidationError{Name: "network_type", err: fmt.Errorf(`ent: validator failed for field "KioskSession.network_type": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "KioskSession.user"`)
 	}
@@ -369,6 +818,51 @@ func (_u *KioskSessionUpdateOne) sqlSave(ctx context.Context) (_node *KioskSessi
 	if _u.mutation.UnlockedUntilCleared() {
 		_spec.ClearField(kiosksession.FieldUnlockedUntil, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(kiosksession.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(kiosksession.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastActivityAt(); ok {
+		_spec.SetField(kiosksession.FieldLastActivityAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastActivityAtCleared() {
+		_spec.ClearField(kiosksession.FieldLastActivityAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AppVersion(); ok {
+		_spec.SetField(kiosksession.FieldAppVersion, field.TypeString, value)
+	}
+	if _u.mutation.AppVersionCleared() {
+		_spec.ClearField(kiosksession.FieldAppVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.BatteryLevel(); ok {
+		_spec.SetField(kiosksession.FieldBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatteryLevel(); ok {
+		_spec.AddField(kiosksession.FieldBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.BatteryLevelCleared() {
+		_spec.ClearField(kiosksession.FieldBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatteryCharging(); ok {
+		_spec.SetField(kiosksession.FieldBatteryCharging, field.TypeBool, value)
+	}
+	if _u.mutation.BatteryChargingCleared() {
+		_spec.ClearField(kiosksession.FieldBatteryCharging, field.TypeBool)
+	}
+	if value, ok := _u.mutation.NetworkType(); ok {
+		_spec.SetField(kiosksession.FieldNetworkType, field.TypeString, value)
+	}
+	if _u.mutation.NetworkTypeCleared() {
+		_spec.ClearField(kiosksession.FieldNetworkType, field.TypeString)
+	}
+	if value, ok := _u.mutation.OfflineAlertedAt(); ok {
+		_spec.SetField(kiosksession.FieldOfflineAlertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OfflineAlertedAtCleared() {
+		_spec.ClearField(kiosksession.FieldOfflineAlertedAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -398,6 +892,35 @@ func (_u *KioskSessionUpdateOne) sqlSave(ctx context.Context) (_node *KioskSessi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksession.LocationTable,
+			Columns: []string{kiosksession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksession.LocationTable,
+			Columns: []string{kiosksession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &KioskSession{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
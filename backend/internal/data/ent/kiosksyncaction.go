@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
+)
+
+// KioskSyncAction is the model entity for the KioskSyncAction schema.
+type KioskSyncAction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Client generated key identifying the queued action
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	// Action holds the value of the "action" field.
+	Action kiosksyncaction.Action `json:"action,omitempty"`
+	// pending while the action is being applied, applied once it succeeded
+	Status kiosksyncaction.Status `json:"status,omitempty"`
+	// When the action happened on the kiosk
+	ClientTimestamp time.Time `json:"client_timestamp,omitempty"`
+	// ID of the loan or borrower the action created or updated
+	ResultID *uuid.UUID `json:"result_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KioskSyncActionQuery when eager-loading is set.
+	Edges                    KioskSyncActionEdges `json:"edges"`
+	group_kiosk_sync_actions *uuid.UUID
+	selectValues             sql.SelectValues
+}
+
+// KioskSyncActionEdges holds the relations/edges for other nodes in the graph.
+type KioskSyncActionEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KioskSyncActionEdges) GroupOrErr() (*Group, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: group.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KioskSyncAction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case kiosksyncaction.FieldResultID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case kiosksyncaction.FieldIdempotencyKey, kiosksyncaction.FieldAction, kiosksyncaction.FieldStatus:
+			values[i] = new(sql.NullString)
+		case kiosksyncaction.FieldCreatedAt, kiosksyncaction.FieldUpdatedAt, kiosksyncaction.FieldClientTimestamp:
+			values[i] = new(sql.NullTime)
+		case kiosksyncaction.FieldID:
+			values[i] = new(uuid.UUID)
+		case kiosksyncaction.ForeignKeys[0]: // group_kiosk_sync_actions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KioskSyncAction fields.
+func (_m *KioskSyncAction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case kiosksyncaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case kiosksyncaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case kiosksyncaction.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case kiosksyncaction.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				_m.IdempotencyKey = value.String
+			}
+		case kiosksyncaction.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = kiosksyncaction.Action(value.String)
+			}
+		case kiosksyncaction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = kiosksyncaction.Status(value.String)
+			}
+		case kiosksyncaction.FieldClientTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field client_timestamp", values[i])
+			} else if value.Valid {
+				_m.ClientTimestamp = value.Time
+			}
+		case kiosksyncaction.FieldResultID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field result_id", values[i])
+			} else if value.Valid {
+				_m.ResultID = new(uuid.UUID)
+				*_m.ResultID = *value.S.(*uuid.UUID)
+			}
+		case kiosksyncaction.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_kiosk_sync_actions", values[i])
+			} else if value.Valid {
+				_m.group_kiosk_sync_actions = new(uuid.UUID)
+				*_m.group_kiosk_sync_actions = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KioskSyncAction.
+// This includes values selected through modifiers, order, etc.
+func (_m *KioskSyncAction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the KioskSyncAction entity.
+func (_m *KioskSyncAction) QueryGroup() *GroupQuery {
+	return NewKioskSyncActionClient(_m.config).QueryGroup(_m)
+}
+
+// Update returns a builder for updating this KioskSyncAction.
+// Note that you need to call KioskSyncAction.Unwrap() before calling this method if this KioskSyncAction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *KioskSyncAction) Update() *KioskSyncActionUpdateOne {
+	return NewKioskSyncActionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the KioskSyncAction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *KioskSyncAction) Unwrap() *KioskSyncAction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KioskSyncAction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *KioskSyncAction) String() string {
+	var builder strings.Builder
+	builder.WriteString("KioskSyncAction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("idempotency_key=")
+	builder.WriteString(_m.IdempotencyKey)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("client_timestamp=")
+	builder.WriteString(_m.ClientTimestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ResultID; v != nil {
+		builder.WriteString("result_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KioskSyncActions is a parsable slice of KioskSyncAction.
+type KioskSyncActions []*KioskSyncAction
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kiosksyncaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// ClientTimestamp applies equality check predicate on the "client_timestamp" field. It's identical to ClientTimestampEQ.
+func ClientTimestamp(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldClientTimestamp, v))
+}
+
+// ResultID applies equality check predicate on the "result_id" field. It's identical to ResultIDEQ.
+func ResultID(v uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldResultID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ClientTimestampEQ applies the EQ predicate on the "client_timestamp" field.
+func ClientTimestampEQ(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldClientTimestamp, v))
+}
+
+// ClientTimestampNEQ applies the NEQ predicate on the "client_timestamp" field.
+func ClientTimestampNEQ(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNEQ(FieldClientTimestamp, v))
+}
+
+// ClientTimestampIn applies the In predicate on the "client_timestamp" field.
+func ClientTimestampIn(vs ...time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIn(FieldClientTimestamp, vs...))
+}
+
+// ClientTimestampNotIn applies the NotIn predicate on the "client_timestamp" field.
+func ClientTimestampNotIn(vs ...time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotIn(FieldClientTimestamp, vs...))
+}
+
+// ClientTimestampGT applies the GT predicate on the "client_timestamp" field.
+func ClientTimestampGT(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGT(FieldClientTimestamp, v))
+}
+
+// ClientTimestampGTE applies the GTE predicate on the "client_timestamp" field.
+func ClientTimestampGTE(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGTE(FieldClientTimestamp, v))
+}
+
+// ClientTimestampLT applies the LT predicate on the "client_timestamp" field.
+func ClientTimestampLT(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLT(FieldClientTimestamp, v))
+}
+
+// ClientTimestampLTE applies the LTE predicate on the "client_timestamp" field.
+func ClientTimestampLTE(v time.Time) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLTE(FieldClientTimestamp, v))
+}
+
+// ResultIDEQ applies the EQ predicate on the "result_id" field.
+func ResultIDEQ(v uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldEQ(FieldResultID, v))
+}
+
+// ResultIDNEQ applies the NEQ predicate on the "result_id" field.
+func ResultIDNEQ(v uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNEQ(FieldResultID, v))
+}
+
+// ResultIDIn applies the In predicate on the "result_id" field.
+func ResultIDIn(vs ...uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIn(FieldResultID, vs...))
+}
+
+// ResultIDNotIn applies the NotIn predicate on the "result_id" field.
+func ResultIDNotIn(vs ...uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotIn(FieldResultID, vs...))
+}
+
+// ResultIDGT applies the GT predicate on the "result_id" field.
+func ResultIDGT(v uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGT(FieldResultID, v))
+}
+
+// ResultIDGTE applies the GTE predicate on the "result_id" field.
+func ResultIDGTE(v uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldGTE(FieldResultID, v))
+}
+
+// ResultIDLT applies the LT predicate on the "result_id" field.
+func ResultIDLT(v uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLT(FieldResultID, v))
+}
+
+// ResultIDLTE applies the LTE predicate on the "result_id" field.
+func ResultIDLTE(v uuid.UUID) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldLTE(FieldResultID, v))
+}
+
+// ResultIDIsNil applies the IsNil predicate on the "result_id" field.
+func ResultIDIsNil() predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldIsNull(FieldResultID))
+}
+
+// ResultIDNotNil applies the NotNil predicate on the "result_id" field.
+func ResultIDNotNil() predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.FieldNotNull(FieldResultID))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KioskSyncAction) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KioskSyncAction) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KioskSyncAction) predicate.KioskSyncAction {
+	return predicate.KioskSyncAction(sql.NotPredicates(p))
+}
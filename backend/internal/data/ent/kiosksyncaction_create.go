@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
+)
+
+// KioskSyncActionCreate is the builder for creating a KioskSyncAction entity.
+type KioskSyncActionCreate struct {
+	config
+	mutation *KioskSyncActionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *KioskSyncActionCreate) SetCreatedAt(v time.Time) *KioskSyncActionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *KioskSyncActionCreate) SetNillableCreatedAt(v *time.Time) *KioskSyncActionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *KioskSyncActionCreate) SetUpdatedAt(v time.Time) *KioskSyncActionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *KioskSyncActionCreate) SetNillableUpdatedAt(v *time.Time) *KioskSyncActionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_c *KioskSyncActionCreate) SetIdempotencyKey(v string) *KioskSyncActionCreate {
+	_c.mutation.SetIdempotencyKey(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *KioskSyncActionCreate) SetAction(v kiosksyncaction.Action) *KioskSyncActionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *KioskSyncActionCreate) SetStatus(v kiosksyncaction.Status) *KioskSyncActionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *KioskSyncActionCreate) SetNillableStatus(v *kiosksyncaction.Status) *KioskSyncActionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetClientTimestamp sets the "client_timestamp" field.
+func (_c *KioskSyncActionCreate) SetClientTimestamp(v time.Time) *KioskSyncActionCreate {
+	_c.mutation.SetClientTimestamp(v)
+	return _c
+}
+
+// SetResultID sets the "result_id" field.
+func (_c *KioskSyncActionCreate) SetResultID(v uuid.UUID) *KioskSyncActionCreate {
+	_c.mutation.SetResultID(v)
+	return _c
+}
+
+// SetNillableResultID sets the "result_id" field if the given value is not nil.
+func (_c *KioskSyncActionCreate) SetNillableResultID(v *uuid.UUID) *KioskSyncActionCreate {
+	if v != nil {
+		_c.SetResultID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *KioskSyncActionCreate) SetID(v uuid.UUID) *KioskSyncActionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *KioskSyncActionCreate) SetNillableID(v *uuid.UUID) *KioskSyncActionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (_c *KioskSyncActionCreate) SetGroupID(id uuid.UUID) *KioskSyncActionCreate {
+	_c.mutation.SetGroupID(id)
+	return _c
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_c *KioskSyncActionCreate) SetGroup(v *Group) *KioskSyncActionCreate {
+	return _c.SetGroupID(v.ID)
+}
+
+// Mutation returns the KioskSyncActionMutation object of the builder.
+func (_c *KioskSyncActionCreate) Mutation() *KioskSyncActionMutation {
+	return _c.mutation
+}
+
+// Save creates the KioskSyncAction in the database.
+func (_c *KioskSyncActionCreate) Save(ctx context.Context) (*KioskSyncAction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *KioskSyncActionCreate) SaveX(ctx context.Context) *KioskSyncAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KioskSyncActionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KioskSyncActionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *KioskSyncActionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := kiosksyncaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := kiosksyncaction.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := kiosksyncaction.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := kiosksyncaction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *KioskSyncActionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KioskSyncAction.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "KioskSyncAction.updated_at"`)}
+	}
+	if _, ok := _c.mutation.IdempotencyKey(); !ok {
+		return &ValidationError{Name: "idempotency_key", err: errors.New(`ent: missing required field "KioskSyncAction.idempotency_key"`)}
+	}
+	if v, ok := _c.mutation.IdempotencyKey(); ok {
+		if err := kiosksyncaction.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.idempotency_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "KioskSyncAction.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := kiosksyncaction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "KioskSyncAction.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := kiosksyncaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientTimestamp(); !ok {
+		return &ValidationError{Name: "client_timestamp", err: errors.New(`ent: missing required field "KioskSyncAction.client_timestamp"`)}
+	}
+	if len(_c.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "KioskSyncAction.group"`)}
+	}
+	return nil
+}
+
+func (_c *KioskSyncActionCreate) sqlSave(ctx context.Context) (*KioskSyncAction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *KioskSyncActionCreate) createSpec() (*KioskSyncAction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KioskSyncAction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(kiosksyncaction.Table, sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(kiosksyncaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(kiosksyncaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.IdempotencyKey(); ok {
+		_spec.SetField(kiosksyncaction.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(kiosksyncaction.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(kiosksyncaction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ClientTimestamp(); ok {
+		_spec.SetField(kiosksyncaction.FieldClientTimestamp, field.TypeTime, value)
+		_node.ClientTimestamp = value
+	}
+	if value, ok := _c.mutation.ResultID(); ok {
+		_spec.SetField(kiosksyncaction.FieldResultID, field.TypeUUID, value)
+		_node.ResultID = &value
+	}
+	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksyncaction.GroupTable,
+			Columns: []string{kiosksyncaction.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.group_kiosk_sync_actions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// KioskSyncActionCreateBulk is the builder for creating many KioskSyncAction entities in bulk.
+type KioskSyncActionCreateBulk struct {
+	config
+	err      error
+	builders []*KioskSyncActionCreate
+}
+
+// Save creates the KioskSyncAction entities in the database.
+func (_c *KioskSyncActionCreateBulk) Save(ctx context.Context) ([]*KioskSyncAction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*KioskSyncAction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KioskSyncActionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *KioskSyncActionCreateBulk) SaveX(ctx context.Context) []*KioskSyncAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KioskSyncActionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KioskSyncActionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
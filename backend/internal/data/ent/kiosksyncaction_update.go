@@ -0,0 +1,546 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// KioskSyncActionUpdate is the builder for updating KioskSyncAction entities.
+type KioskSyncActionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KioskSyncActionMutation
+}
+
+// Where appends a list predicates to the KioskSyncActionUpdate builder.
+func (_u *KioskSyncActionUpdate) Where(ps ...predicate.KioskSyncAction) *KioskSyncActionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *KioskSyncActionUpdate) SetUpdatedAt(v time.Time) *KioskSyncActionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *KioskSyncActionUpdate) SetIdempotencyKey(v string) *KioskSyncActionUpdate {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *KioskSyncActionUpdate) SetNillableIdempotencyKey(v *string) *KioskSyncActionUpdate {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *KioskSyncActionUpdate) SetAction(v kiosksyncaction.Action) *KioskSyncActionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *KioskSyncActionUpdate) SetNillableAction(v *kiosksyncaction.Action) *KioskSyncActionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *KioskSyncActionUpdate) SetStatus(v kiosksyncaction.Status) *KioskSyncActionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *KioskSyncActionUpdate) SetNillableStatus(v *kiosksyncaction.Status) *KioskSyncActionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClientTimestamp sets the "client_timestamp" field.
+func (_u *KioskSyncActionUpdate) SetClientTimestamp(v time.Time) *KioskSyncActionUpdate {
+	_u.mutation.SetClientTimestamp(v)
+	return _u
+}
+
+// SetNillableClientTimestamp sets the "client_timestamp" field if the given value is not nil.
+func (_u *KioskSyncActionUpdate) SetNillableClientTimestamp(v *time.Time) *KioskSyncActionUpdate {
+	if v != nil {
+		_u.SetClientTimestamp(*v)
+	}
+	return _u
+}
+
+// SetResultID sets the "result_id" field.
+func (_u *KioskSyncActionUpdate) SetResultID(v uuid.UUID) *KioskSyncActionUpdate {
+	_u.mutation.SetResultID(v)
+	return _u
+}
+
+// SetNillableResultID sets the "result_id" field if the given value is not nil.
+func (_u *KioskSyncActionUpdate) SetNillableResultID(v *uuid.UUID) *KioskSyncActionUpdate {
+	if v != nil {
+		_u.SetResultID(*v)
+	}
+	return _u
+}
+
+// ClearResultID clears the value of the "result_id" field.
+func (_u *KioskSyncActionUpdate) ClearResultID() *KioskSyncActionUpdate {
+	_u.mutation.ClearResultID()
+	return _u
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (_u *KioskSyncActionUpdate) SetGroupID(id uuid.UUID) *KioskSyncActionUpdate {
+	_u.mutation.SetGroupID(id)
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *KioskSyncActionUpdate) SetGroup(v *Group) *KioskSyncActionUpdate {
+	return _u.SetGroupID(v.ID)
+}
+
+// Mutation returns the KioskSyncActionMutation object of the builder.
+func (_u *KioskSyncActionUpdate) Mutation() *KioskSyncActionMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *KioskSyncActionUpdate) ClearGroup() *KioskSyncActionUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *KioskSyncActionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KioskSyncActionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *KioskSyncActionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KioskSyncActionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *KioskSyncActionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := kiosksyncaction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KioskSyncActionUpdate) check() error {
+	if v, ok := _u.mutation.IdempotencyKey(); ok {
+		if err := kiosksyncaction.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.idempotency_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := kiosksyncaction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := kiosksyncaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.status": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KioskSyncAction.group"`)
+	}
+	return nil
+}
+
+func (_u *KioskSyncActionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(kiosksyncaction.Table, kiosksyncaction.Columns, sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(kiosksyncaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(kiosksyncaction.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(kiosksyncaction.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(kiosksyncaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClientTimestamp(); ok {
+		_spec.SetField(kiosksyncaction.FieldClientTimestamp, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResultID(); ok {
+		_spec.SetField(kiosksyncaction.FieldResultID, field.TypeUUID, value)
+	}
+	if _u.mutation.ResultIDCleared() {
+		_spec.ClearField(kiosksyncaction.FieldResultID, field.TypeUUID)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksyncaction.GroupTable,
+			Columns: []string{kiosksyncaction.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksyncaction.GroupTable,
+			Columns: []string{kiosksyncaction.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kiosksyncaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// KioskSyncActionUpdateOne is the builder for updating a single KioskSyncAction entity.
+type KioskSyncActionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KioskSyncActionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *KioskSyncActionUpdateOne) SetUpdatedAt(v time.Time) *KioskSyncActionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *KioskSyncActionUpdateOne) SetIdempotencyKey(v string) *KioskSyncActionUpdateOne {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *KioskSyncActionUpdateOne) SetNillableIdempotencyKey(v *string) *KioskSyncActionUpdateOne {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *KioskSyncActionUpdateOne) SetAction(v kiosksyncaction.Action) *KioskSyncActionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *KioskSyncActionUpdateOne) SetNillableAction(v *kiosksyncaction.Action) *KioskSyncActionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *KioskSyncActionUpdateOne) SetStatus(v kiosksyncaction.Status) *KioskSyncActionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *KioskSyncActionUpdateOne) SetNillableStatus(v *kiosksyncaction.Status) *KioskSyncActionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClientTimestamp sets the "client_timestamp" field.
+func (_u *KioskSyncActionUpdateOne) SetClientTimestamp(v time.Time) *KioskSyncActionUpdateOne {
+	_u.mutation.SetClientTimestamp(v)
+	return _u
+}
+
+// SetNillableClientTimestamp sets the "client_timestamp" field if the given value is not nil.
+func (_u *KioskSyncActionUpdateOne) SetNillableClientTimestamp(v *time.Time) *KioskSyncActionUpdateOne {
+	if v != nil {
+		_u.SetClientTimestamp(*v)
+	}
+	return _u
+}
+
+// SetResultID sets the "result_id" field.
+func (_u *KioskSyncActionUpdateOne) SetResultID(v uuid.UUID) *KioskSyncActionUpdateOne {
+	_u.mutation.SetResultID(v)
+	return _u
+}
+
+// SetNillableResultID sets the "result_id" field if the given value is not nil.
+func (_u *KioskSyncActionUpdateOne) SetNillableResultID(v *uuid.UUID) *KioskSyncActionUpdateOne {
+	if v != nil {
+		_u.SetResultID(*v)
+	}
+	return _u
+}
+
+// ClearResultID clears the value of the "result_id" field.
+func (_u *KioskSyncActionUpdateOne) ClearResultID() *KioskSyncActionUpdateOne {
+	_u.mutation.ClearResultID()
+	return _u
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (_u *KioskSyncActionUpdateOne) SetGroupID(id uuid.UUID) *KioskSyncActionUpdateOne {
+	_u.mutation.SetGroupID(id)
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *KioskSyncActionUpdateOne) SetGroup(v *Group) *KioskSyncActionUpdateOne {
+	return _u.SetGroupID(v.ID)
+}
+
+// Mutation returns the KioskSyncActionMutation object of the builder.
+func (_u *KioskSyncActionUpdateOne) Mutation() *KioskSyncActionMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *KioskSyncActionUpdateOne) ClearGroup() *KioskSyncActionUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// Where appends a list predicates to the KioskSyncActionUpdate builder.
+func (_u *KioskSyncActionUpdateOne) Where(ps ...predicate.KioskSyncAction) *KioskSyncActionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *KioskSyncActionUpdateOne) Select(field string, fields ...string) *KioskSyncActionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated KioskSyncAction entity.
+func (_u *KioskSyncActionUpdateOne) Save(ctx context.Context) (*KioskSyncAction, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KioskSyncActionUpdateOne) SaveX(ctx context.Context) *KioskSyncAction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *KioskSyncActionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KioskSyncActionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *KioskSyncActionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := kiosksyncaction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KioskSyncActionUpdateOne) check() error {
+	if v, ok := _u.mutation.IdempotencyKey(); ok {
+		if err := kiosksyncaction.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.idempotency_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := kiosksyncaction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := kiosksyncaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KioskSyncAction.status": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KioskSyncAction.group"`)
+	}
+	return nil
+}
+
+func (_u *KioskSyncActionUpdateOne) sqlSave(ctx context.Context) (_node *KioskSyncAction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(kiosksyncaction.Table, kiosksyncaction.Columns, sqlgraph.NewFieldSpec(kiosksyncaction.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KioskSyncAction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kiosksyncaction.FieldID)
+		for _, f := range fields {
+			if !kiosksyncaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != kiosksyncaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(kiosksyncaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(kiosksyncaction.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(kiosksyncaction.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(kiosksyncaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClientTimestamp(); ok {
+		_spec.SetField(kiosksyncaction.FieldClientTimestamp, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResultID(); ok {
+		_spec.SetField(kiosksyncaction.FieldResultID, field.TypeUUID, value)
+	}
+	if _u.mutation.ResultIDCleared() {
+		_spec.ClearField(kiosksyncaction.FieldResultID, field.TypeUUID)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksyncaction.GroupTable,
+			Columns: []string{kiosksyncaction.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   kiosksyncaction.GroupTable,
+			Columns: []string{kiosksyncaction.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &KioskSyncAction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kiosksyncaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -29,6 +29,12 @@ type Label struct {
 	Description string `json:"description,omitempty"`
 	// Color holds the value of the "color" field.
 	Color string `json:"color,omitempty"`
+	// Whether returned items with this label must be inspected before they can be lent again
+	QuarantineOnReturn bool `json:"quarantine_on_return,omitempty"`
+	// How long returned items stay in quarantine (0 = until staff sign off)
+	QuarantineMinutes int `json:"quarantine_minutes,omitempty"`
+	// Items created with the label are numbered in their own series under this prefix
+	AssetIDPrefix string `json:"asset_id_prefix,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LabelQuery when eager-loading is set.
 	Edges        LabelEdges `json:"edges"`
@@ -72,7 +78,11 @@ func (*Label) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case label.FieldName, label.FieldDescription, label.FieldColor:
+		case label.FieldQuarantineOnReturn:
+			values[i] = new(sql.NullBool)
+		case label.FieldQuarantineMinutes:
+			values[i] = new(sql.NullInt64)
+		case label.FieldName, label.FieldDescription, label.FieldColor, label.FieldAssetIDPrefix:
 			values[i] = new(sql.NullString)
 		case label.FieldCreatedAt, label.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -131,6 +141,24 @@ func (_m *Label) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Color = value.String
 			}
+		case label.FieldQuarantineOnReturn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantine_on_return", values[i])
+			} else if value.Valid {
+				_m.QuarantineOnReturn = value.Bool
+			}
+		case label.FieldQuarantineMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantine_minutes", values[i])
+			} else if value.Valid {
+				_m.QuarantineMinutes = int(value.Int64)
+			}
+		case label.FieldAssetIDPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id_prefix", values[i])
+			} else if value.Valid {
+				_m.AssetIDPrefix = value.String
+			}
 		case label.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field group_labels", values[i])
@@ -198,6 +226,15 @@ func (_m *Label) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("color=")
 	builder.WriteString(_m.Color)
+	builder.WriteString(", ")
+	builder.WriteString("quarantine_on_return=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuarantineOnReturn))
+	builder.WriteString(", ")
+	builder.WriteString("quarantine_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuarantineMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("asset_id_prefix=")
+	builder.WriteString(_m.AssetIDPrefix)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -25,6 +25,12 @@ const (
 	FieldDescription = "description"
 	// FieldColor holds the string denoting the color field in the database.
 	FieldColor = "color"
+	// FieldQuarantineOnReturn holds the string denoting the quarantine_on_return field in the database.
+	FieldQuarantineOnReturn = "quarantine_on_return"
+	// FieldQuarantineMinutes holds the string denoting the quarantine_minutes field in the database.
+	FieldQuarantineMinutes = "quarantine_minutes"
+	// FieldAssetIDPrefix holds the string denoting the asset_id_prefix field in the database.
+	FieldAssetIDPrefix = "asset_id_prefix"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeItems holds the string denoting the items edge name in mutations.
@@ -53,6 +59,9 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldColor,
+	FieldQuarantineOnReturn,
+	FieldQuarantineMinutes,
+	FieldAssetIDPrefix,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "labels"
@@ -95,6 +104,14 @@ var (
 	DescriptionValidator func(string) error
 	// ColorValidator is a validator for the "color" field. It is called by the builders before save.
 	ColorValidator func(string) error
+	// DefaultQuarantineOnReturn holds the default value on creation for the "quarantine_on_return" field.
+	DefaultQuarantineOnReturn bool
+	// DefaultQuarantineMinutes holds the default value on creation for the "quarantine_minutes" field.
+	DefaultQuarantineMinutes int
+	// QuarantineMinutesValidator is a validator for the "quarantine_minutes" field. It is called by the builders before save.
+	QuarantineMinutesValidator func(int) error
+	// AssetIDPrefixValidator is a validator for the "asset_id_prefix" field. It is called by the builders before save.
+	AssetIDPrefixValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -132,6 +149,21 @@ func ByColor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldColor, opts...).ToFunc()
 }
 
+// ByQuarantineOnReturn orders the results by the quarantine_on_return field.
+func ByQuarantineOnReturn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantineOnReturn, opts...).ToFunc()
+}
+
+// ByQuarantineMinutes orders the results by the quarantine_minutes field.
+func ByQuarantineMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantineMinutes, opts...).ToFunc()
+}
+
+// ByAssetIDPrefix orders the results by the asset_id_prefix field.
+func ByAssetIDPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetIDPrefix, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -81,6 +81,21 @@ func Color(v string) predicate.Label {
 	return predicate.Label(sql.FieldEQ(FieldColor, v))
 }
 
+// QuarantineOnReturn applies equality check predicate on the "quarantine_on_return" field. It's identical to QuarantineOnReturnEQ.
+func QuarantineOnReturn(v bool) predicate.Label {
+	return predicate.Label(sql.FieldEQ(FieldQuarantineOnReturn, v))
+}
+
+// QuarantineMinutes applies equality check predicate on the "quarantine_minutes" field. It's identical to QuarantineMinutesEQ.
+func QuarantineMinutes(v int) predicate.Label {
+	return predicate.Label(sql.FieldEQ(FieldQuarantineMinutes, v))
+}
+
+// AssetIDPrefix applies equality check predicate on the "asset_id_prefix" field. It's identical to AssetIDPrefixEQ.
+func AssetIDPrefix(v string) predicate.Label {
+	return predicate.Label(sql.FieldEQ(FieldAssetIDPrefix, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Label {
 	return predicate.Label(sql.FieldEQ(FieldCreatedAt, v))
@@ -376,6 +391,131 @@ func ColorContainsFold(v string) predicate.Label {
 	return predicate.Label(sql.FieldContainsFold(FieldColor, v))
 }
 
+// QuarantineOnReturnEQ applies the EQ predicate on the "quarantine_on_return" field.
+func QuarantineOnReturnEQ(v bool) predicate.Label {
+	return predicate.Label(sql.FieldEQ(FieldQuarantineOnReturn, v))
+}
+
+// QuarantineOnReturnNEQ applies the NEQ predicate on the "quarantine_on_return" field.
+func QuarantineOnReturnNEQ(v bool) predicate.Label {
+	return predicate.Label(sql.FieldNEQ(FieldQuarantineOnReturn, v))
+}
+
+// QuarantineMinutesEQ applies the EQ predicate on the "quarantine_minutes" field.
+func QuarantineMinutesEQ(v int) predicate.Label {
+	return predicate.Label(sql.FieldEQ(FieldQuarantineMinutes, v))
+}
+
+// QuarantineMinutesNEQ applies the NEQ predicate on the "quarantine_minutes" field.
+func QuarantineMinutesNEQ(v int) predicate.Label {
+	return predicate.Label(sql.FieldNEQ(FieldQuarantineMinutes, v))
+}
+
+// QuarantineMinutesIn applies the In predicate on the "quarantine_minutes" field.
+func QuarantineMinutesIn(vs ...int) predicate.Label {
+	return predicate.Label(sql.FieldIn(FieldQuarantineMinutes, vs...))
+}
+
+// QuarantineMinutesNotIn applies the NotIn predicate on the "quarantine_minutes" field.
+func QuarantineMinutesNotIn(vs ...int) predicate.Label {
+	return predicate.Label(sql.FieldNotIn(FieldQuarantineMinutes, vs...))
+}
+
+// QuarantineMinutesGT applies the GT predicate on the "quarantine_minutes" field.
+func QuarantineMinutesGT(v int) predicate.Label {
+	return predicate.Label(sql.FieldGT(FieldQuarantineMinutes, v))
+}
+
+// QuarantineMinutesGTE applies the GTE predicate on the "quarantine_minutes" field.
+func QuarantineMinutesGTE(v int) predicate.Label {
+	return predicate.Label(sql.FieldGTE(FieldQuarantineMinutes, v))
+}
+
+// QuarantineMinutesLT applies the LT predicate on the "quarantine_minutes" field.
+func QuarantineMinutesLT(v int) predicate.Label {
+	return predicate.Label(sql.FieldLT(FieldQuarantineMinutes, v))
+}
+
+// QuarantineMinutesLTE applies the LTE predicate on the "quarantine_minutes" field.
+func QuarantineMinutesLTE(v int) predicate.Label {
+	return predicate.Label(sql.FieldLTE(FieldQuarantineMinutes, v))
+}
+
+// AssetIDPrefixEQ applies the EQ predicate on the "asset_id_prefix" field.
+func AssetIDPrefixEQ(v string) predicate.Label {
+	return predicate.Label(sql.FieldEQ(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixNEQ applies the NEQ predicate on the "asset_id_prefix" field.
+func AssetIDPrefixNEQ(v string) predicate.Label {
+	return predicate.Label(sql.FieldNEQ(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixIn applies the In predicate on the "asset_id_prefix" field.
+func AssetIDPrefixIn(vs ...string) predicate.Label {
+	return predicate.Label(sql.FieldIn(FieldAssetIDPrefix, vs...))
+}
+
+// AssetIDPrefixNotIn applies the NotIn predicate on the "asset_id_prefix" field.
+func AssetIDPrefixNotIn(vs ...string) predicate.Label {
+	return predicate.Label(sql.FieldNotIn(FieldAssetIDPrefix, vs...))
+}
+
+// AssetIDPrefixGT applies the GT predicate on the "asset_id_prefix" field.
+func AssetIDPrefixGT(v string) predicate.Label {
+	return predicate.Label(sql.FieldGT(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixGTE applies the GTE predicate on the "asset_id_prefix" field.
+func AssetIDPrefixGTE(v string) predicate.Label {
+	return predicate.Label(sql.FieldGTE(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixLT applies the LT predicate on the "asset_id_prefix" field.
+func AssetIDPrefixLT(v string) predicate.Label {
+	return predicate.Label(sql.FieldLT(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixLTE applies the LTE predicate on the "asset_id_prefix" field.
+func AssetIDPrefixLTE(v string) predicate.Label {
+	return predicate.Label(sql.FieldLTE(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixContains applies the Contains predicate on the "asset_id_prefix" field.
+func AssetIDPrefixContains(v string) predicate.Label {
+	return predicate.Label(sql.FieldContains(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixHasPrefix applies the HasPrefix predicate on the "asset_id_prefix" field.
+func AssetIDPrefixHasPrefix(v string) predicate.Label {
+	return predicate.Label(sql.FieldHasPrefix(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixHasSuffix applies the HasSuffix predicate on the "asset_id_prefix" field.
+func AssetIDPrefixHasSuffix(v string) predicate.Label {
+	return predicate.Label(sql.FieldHasSuffix(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixIsNil applies the IsNil predicate on the "asset_id_prefix" field.
+func AssetIDPrefixIsNil() predicate.Label {
+	return predicate.Label(sql.FieldIsNull(FieldAssetIDPrefix))
+}
+
+// AssetIDPrefixNotNil applies the NotNil predicate on the "asset_id_prefix" field.
+func AssetIDPrefixNotNil() predicate.Label {
+	return predicate.Label(sql.FieldNotNull(FieldAssetIDPrefix))
+}
+
+// AssetIDPrefixEqualFold applies the EqualFold predicate on the "asset_id_prefix" field.
+func AssetIDPrefixEqualFold(v string) predicate.Label {
+	return predicate.Label(sql.FieldEqualFold(FieldAssetIDPrefix, v))
+}
+
+// AssetIDPrefixContainsFold applies the ContainsFold predicate on the "asset_id_prefix" field.
+func AssetIDPrefixContainsFold(v string) predicate.Label {
+	return predicate.Label(sql.FieldContainsFold(FieldAssetIDPrefix, v))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.Label {
 	return predicate.Label(func(s *sql.Selector) {
@@ -85,6 +85,48 @@ func (_c *LabelCreate) SetNillableColor(v *string) *LabelCreate {
 	return _c
 }
 
+// SetQuarantineOnReturn sets the "quarantine_on_return" field.
+func (_c *LabelCreate) SetQuarantineOnReturn(v bool) *LabelCreate {
+	_c.mutation.SetQuarantineOnReturn(v)
+	return _c
+}
+
+// SetNillableQuarantineOnReturn sets the "quarantine_on_return" field if the given value is not nil.
+func (_c *LabelCreate) SetNillableQuarantineOnReturn(v *bool) *LabelCreate {
+	if v != nil {
+		_c.SetQuarantineOnReturn(*v)
+	}
+	return _c
+}
+
+// SetQuarantineMinutes sets the "quarantine_minutes" field.
+func (_c *LabelCreate) SetQuarantineMinutes(v int) *LabelCreate {
+	_c.mutation.SetQuarantineMinutes(v)
+	return _c
+}
+
+// SetNillableQuarantineMinutes sets the "quarantine_minutes" field if the given value is not nil.
+func (_c *LabelCreate) SetNillableQuarantineMinutes(v *int) *LabelCreate {
+	if v != nil {
+		_c.SetQuarantineMinutes(*v)
+	}
+	return _c
+}
+
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_c *LabelCreate) SetAssetIDPrefix(v string) *LabelCreate {
+	_c.mutation.SetAssetIDPrefix(v)
+	return _c
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_c *LabelCreate) SetNillableAssetIDPrefix(v *string) *LabelCreate {
+	if v != nil {
+		_c.SetAssetIDPrefix(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LabelCreate) SetID(v uuid.UUID) *LabelCreate {
 	_c.mutation.SetID(v)
@@ -168,6 +210,14 @@ func (_c *LabelCreate) defaults() {
 		v := label.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.QuarantineOnReturn(); !ok {
+		v := label.DefaultQuarantineOnReturn
+		_c.mutation.SetQuarantineOnReturn(v)
+	}
+	if _, ok := _c.mutation.QuarantineMinutes(); !ok {
+		v := label.DefaultQuarantineMinutes
+		_c.mutation.SetQuarantineMinutes(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := label.DefaultID()
 		_c.mutation.SetID(v)
@@ -200,6 +250,22 @@ func (_c *LabelCreate) check() error {
 			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Label.color": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.QuarantineOnReturn(); !ok {
+		return &ValidationError{Name: "quarantine_on_return", err: errors.New(`ent: missing required field "Label.quarantine_on_return"`)}
+	}
+	if _, ok := _c.mutation.QuarantineMinutes(); !ok {
+		return &ValidationError{Name: "quarantine_minutes", err: errors.New(`ent: missing required field "Label.quarantine_minutes"`)}
+	}
+	if v, ok := _c.mutation.QuarantineMinutes(); ok {
+		if err := label.QuarantineMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "quarantine_minutes", err: fmt.Errorf(`ent: validator failed for field "Label.quarantine_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AssetIDPrefix(); ok {
+		if err := label.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Label.asset_id_prefix": %w`, err)}
+		}
+	}
 	if len(_c.mutation.GroupIDs()) == 0 {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "Label.group"`)}
 	}
@@ -258,6 +324,18 @@ func (_c *LabelCreate) createSpec() (*Label, *sqlgraph.CreateSpec) {
 		_spec.SetField(label.FieldColor, field.TypeString, value)
 		_node.Color = value
 	}
+	if value, ok := _c.mutation.QuarantineOnReturn(); ok {
+		_spec.SetField(label.FieldQuarantineOnReturn, field.TypeBool, value)
+		_node.QuarantineOnReturn = value
+	}
+	if value, ok := _c.mutation.QuarantineMinutes(); ok {
+		_spec.SetField(label.FieldQuarantineMinutes, field.TypeInt, value)
+		_node.QuarantineMinutes = value
+	}
+	if value, ok := _c.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(label.FieldAssetIDPrefix, field.TypeString, value)
+		_node.AssetIDPrefix = value
+	}
 	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -91,6 +91,61 @@ func (_u *LabelUpdate) ClearColor() *LabelUpdate {
 	return _u
 }
 
+// SetQuarantineOnReturn sets the "quarantine_on_return" field.
+func (_u *LabelUpdate) SetQuarantineOnReturn(v bool) *LabelUpdate {
+	_u.mutation.SetQuarantineOnReturn(v)
+	return _u
+}
+
+// SetNillableQuarantineOnReturn sets the "quarantine_on_return" field if the given value is not nil.
+func (_u *LabelUpdate) SetNillableQuarantineOnReturn(v *bool) *LabelUpdate {
+	if v != nil {
+		_u.SetQuarantineOnReturn(*v)
+	}
+	return _u
+}
+
+// SetQuarantineMinutes sets the "quarantine_minutes" field.
+func (_u *LabelUpdate) SetQuarantineMinutes(v int) *LabelUpdate {
+	_u.mutation.ResetQuarantineMinutes()
+	_u.mutation.SetQuarantineMinutes(v)
+	return _u
+}
+
+// SetNillableQuarantineMinutes sets the "quarantine_minutes" field if the given value is not nil.
+func (_u *LabelUpdate) SetNillableQuarantineMinutes(v *int) *LabelUpdate {
+	if v != nil {
+		_u.SetQuarantineMinutes(*v)
+	}
+	return _u
+}
+
+// AddQuarantineMinutes adds value to the "quarantine_minutes" field.
+func (_u *LabelUpdate) AddQuarantineMinutes(v int) *LabelUpdate {
+	_u.mutation.AddQuarantineMinutes(v)
+	return _u
+}
+
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_u *LabelUpdate) SetAssetIDPrefix(v string) *LabelUpdate {
+	_u.mutation.SetAssetIDPrefix(v)
+	return _u
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_u *LabelUpdate) SetNillableAssetIDPrefix(v *string) *LabelUpdate {
+	if v != nil {
+		_u.SetAssetIDPrefix(*v)
+	}
+	return _u
+}
+
+// ClearAssetIDPrefix clears the value of the "asset_id_prefix" field.
+func (_u *LabelUpdate) ClearAssetIDPrefix() *LabelUpdate {
+	_u.mutation.ClearAssetIDPrefix()
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *LabelUpdate) SetGroupID(id uuid.UUID) *LabelUpdate {
 	_u.mutation.SetGroupID(id)
@@ -202,6 +257,16 @@ func (_u *LabelUpdate) check() error {
 			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Label.color": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.QuarantineMinutes(); ok {
+		if err := label.QuarantineMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "quarantine_minutes", err: fmt.Errorf(`ent: validator failed for field "Label.quarantine_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDPrefix(); ok {
+		if err := label.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Label.asset_id_prefix": %w`, err)}
+		}
+	}
 	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Label.group"`)
 	}
@@ -238,6 +303,21 @@ func (_u *LabelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ColorCleared() {
 		_spec.ClearField(label.FieldColor, field.TypeString)
 	}
+	if value, ok := _u.mutation.QuarantineOnReturn(); ok {
+		_spec.SetField(label.FieldQuarantineOnReturn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.QuarantineMinutes(); ok {
+		_spec.SetField(label.FieldQuarantineMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuarantineMinutes(); ok {
+		_spec.AddField(label.FieldQuarantineMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(label.FieldAssetIDPrefix, field.TypeString, value)
+	}
+	if _u.mutation.AssetIDPrefixCleared() {
+		_spec.ClearField(label.FieldAssetIDPrefix, field.TypeString)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -392,6 +472,61 @@ func (_u *LabelUpdateOne) ClearColor() *LabelUpdateOne {
 	return _u
 }
 
+// SetQuarantineOnReturn sets the "quarantine_on_return" field.
+func (_u *LabelUpdateOne) SetQuarantineOnReturn(v bool) *LabelUpdateOne {
+	_u.mutation.SetQuarantineOnReturn(v)
+	return _u
+}
+
+// SetNillableQuarantineOnReturn sets the "quarantine_on_return" field if the given value is not nil.
+func (_u *LabelUpdateOne) SetNillableQuarantineOnReturn(v *bool) *LabelUpdateOne {
+	if v != nil {
+		_u.SetQuarantineOnReturn(*v)
+	}
+	return _u
+}
+
+// SetQuarantineMinutes sets the "quarantine_minutes" field.
+func (_u *LabelUpdateOne) SetQuarantineMinutes(v int) *LabelUpdateOne {
+	_u.mutation.ResetQuarantineMinutes()
+	_u.mutation.SetQuarantineMinutes(v)
+	return _u
+}
+
+// SetNillableQuarantineMinutes sets the "quarantine_minutes" field if the given value is not nil.
+func (_u *LabelUpdateOne) SetNillableQuarantineMinutes(v *int) *LabelUpdateOne {
+	if v != nil {
+		_u.SetQuarantineMinutes(*v)
+	}
+	return _u
+}
+
+// AddQuarantineMinutes adds value to the "quarantine_minutes" field.
+func (_u *LabelUpdateOne) AddQuarantineMinutes(v int) *LabelUpdateOne {
+	_u.mutation.AddQuarantineMinutes(v)
+	return _u
+}
+
+// SetAssetIDPrefix sets the "asset_id_prefix" field.
+func (_u *LabelUpdateOne) SetAssetIDPrefix(v string) *LabelUpdateOne {
+	_u.mutation.SetAssetIDPrefix(v)
+	return _u
+}
+
+// SetNillableAssetIDPrefix sets the "asset_id_prefix" field if the given value is not nil.
+func (_u *LabelUpdateOne) SetNillableAssetIDPrefix(v *string) *LabelUpdateOne {
+	if v != nil {
+		_u.SetAssetIDPrefix(*v)
+	}
+	return _u
+}
+
+// ClearAssetIDPrefix clears the value of the "asset_id_prefix" field.
+func (_u *LabelUpdateOne) ClearAssetIDPrefix() *LabelUpdateOne {
+	_u.mutation.ClearAssetIDPrefix()
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *LabelUpdateOne) SetGroupID(id uuid.UUID) *LabelUpdateOne {
 	_u.mutation.SetGroupID(id)
@@ -516,6 +651,16 @@ func (_u *LabelUpdateOne) check() error {
 			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Label.color": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.QuarantineMinutes(); ok {
+		if err := label.QuarantineMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "quarantine_minutes", err: fmt.Errorf(`ent: validator failed for field "Label.quarantine_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetIDPrefix(); ok {
+		if err := label.AssetIDPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id_prefix", err: fmt.Errorf(`ent: validator failed for field "Label.asset_id_prefix": %w`, err)}
+		}
+	}
 	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Label.group"`)
 	}
@@ -569,6 +714,21 @@ func (_u *LabelUpdateOne) sqlSave(ctx context.Context) (_node *Label, err error)
 	if _u.mutation.ColorCleared() {
 		_spec.ClearField(label.FieldColor, field.TypeString)
 	}
+	if value, ok := _u.mutation.QuarantineOnReturn(); ok {
+		_spec.SetField(label.FieldQuarantineOnReturn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.QuarantineMinutes(); ok {
+		_spec.SetField(label.FieldQuarantineMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuarantineMinutes(); ok {
+		_spec.AddField(label.FieldQuarantineMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AssetIDPrefix(); ok {
+		_spec.SetField(label.FieldAssetIDPrefix, field.TypeString, value)
+	}
+	if _u.mutation.AssetIDPrefixCleared() {
+		_spec.ClearField(label.FieldAssetIDPrefix, field.TypeString)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
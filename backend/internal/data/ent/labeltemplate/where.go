@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package labeltemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldGroupID, v))
+}
+
+// Fields applies equality check predicate on the "fields" field. It's identical to FieldsEQ.
+func Fields(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldFields, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// SymbologyEQ applies the EQ predicate on the "symbology" field.
+func SymbologyEQ(v Symbology) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldSymbology, v))
+}
+
+// SymbologyNEQ applies the NEQ predicate on the "symbology" field.
+func SymbologyNEQ(v Symbology) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldSymbology, v))
+}
+
+// SymbologyIn applies the In predicate on the "symbology" field.
+func SymbologyIn(vs ...Symbology) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldSymbology, vs...))
+}
+
+// SymbologyNotIn applies the NotIn predicate on the "symbology" field.
+func SymbologyNotIn(vs ...Symbology) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldSymbology, vs...))
+}
+
+// FieldsEQ applies the EQ predicate on the "fields" field.
+func FieldsEQ(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEQ(FieldFields, v))
+}
+
+// FieldsNEQ applies the NEQ predicate on the "fields" field.
+func FieldsNEQ(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNEQ(FieldFields, v))
+}
+
+// FieldsIn applies the In predicate on the "fields" field.
+func FieldsIn(vs ...string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldIn(FieldFields, vs...))
+}
+
+// FieldsNotIn applies the NotIn predicate on the "fields" field.
+func FieldsNotIn(vs ...string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldNotIn(FieldFields, vs...))
+}
+
+// FieldsGT applies the GT predicate on the "fields" field.
+func FieldsGT(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGT(FieldFields, v))
+}
+
+// FieldsGTE applies the GTE predicate on the "fields" field.
+func FieldsGTE(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldGTE(FieldFields, v))
+}
+
+// FieldsLT applies the LT predicate on the "fields" field.
+func FieldsLT(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLT(FieldFields, v))
+}
+
+// FieldsLTE applies the LTE predicate on the "fields" field.
+func FieldsLTE(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldLTE(FieldFields, v))
+}
+
+// FieldsContains applies the Contains predicate on the "fields" field.
+func FieldsContains(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldContains(FieldFields, v))
+}
+
+// FieldsHasPrefix applies the HasPrefix predicate on the "fields" field.
+func FieldsHasPrefix(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldHasPrefix(FieldFields, v))
+}
+
+// FieldsHasSuffix applies the HasSuffix predicate on the "fields" field.
+func FieldsHasSuffix(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldHasSuffix(FieldFields, v))
+}
+
+// FieldsEqualFold applies the EqualFold predicate on the "fields" field.
+func FieldsEqualFold(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldEqualFold(FieldFields, v))
+}
+
+// FieldsContainsFold applies the ContainsFold predicate on the "fields" field.
+func FieldsContainsFold(v string) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.FieldContainsFold(FieldFields, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.LabelTemplate {
+	return predicate.LabelTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.LabelTemplate {
+	return predicate.LabelTemplate(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LabelTemplate) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LabelTemplate) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LabelTemplate) predicate.LabelTemplate {
+	return predicate.LabelTemplate(sql.NotPredicates(p))
+}
@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
+)
+
+// LabelTemplateCreate is the builder for creating a LabelTemplate entity.
+type LabelTemplateCreate struct {
+	config
+	mutation *LabelTemplateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LabelTemplateCreate) SetCreatedAt(v time.Time) *LabelTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LabelTemplateCreate) SetNillableCreatedAt(v *time.Time) *LabelTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LabelTemplateCreate) SetUpdatedAt(v time.Time) *LabelTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LabelTemplateCreate) SetNillableUpdatedAt(v *time.Time) *LabelTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LabelTemplateCreate) SetName(v string) *LabelTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *LabelTemplateCreate) SetDescription(v string) *LabelTemplateCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *LabelTemplateCreate) SetNillableDescription(v *string) *LabelTemplateCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *LabelTemplateCreate) SetGroupID(v uuid.UUID) *LabelTemplateCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetSymbology sets the "symbology" field.
+func (_c *LabelTemplateCreate) SetSymbology(v labeltemplate.Symbology) *LabelTemplateCreate {
+	_c.mutation.SetSymbology(v)
+	return _c
+}
+
+// SetNillableSymbology sets the "symbology" field if the given value is not nil.
+func (_c *LabelTemplateCreate) SetNillableSymbology(v *labeltemplate.Symbology) *LabelTemplateCreate {
+	if v != nil {
+		_c.SetSymbology(*v)
+	}
+	return _c
+}
+
+// SetFields sets the "fields" field.
+func (_c *LabelTemplateCreate) SetFields(v string) *LabelTemplateCreate {
+	_c.mutation.SetFields(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LabelTemplateCreate) SetID(v uuid.UUID) *LabelTemplateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LabelTemplateCreate) SetNillableID(v *uuid.UUID) *LabelTemplateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_c *LabelTemplateCreate) SetGroup(v *Group) *LabelTemplateCreate {
+	return _c.SetGroupID(v.ID)
+}
+
+// Mutation returns the LabelTemplateMutation object of the builder.
+func (_c *LabelTemplateCreate) Mutation() *LabelTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the LabelTemplate in the database.
+func (_c *LabelTemplateCreate) Save(ctx context.Context) (*LabelTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LabelTemplateCreate) SaveX(ctx context.Context) *LabelTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LabelTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LabelTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LabelTemplateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := labeltemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := labeltemplate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Symbology(); !ok {
+		v := labeltemplate.DefaultSymbology
+		_c.mutation.SetSymbology(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := labeltemplate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LabelTemplateCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LabelTemplate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LabelTemplate.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LabelTemplate.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := labeltemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := labeltemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "LabelTemplate.group_id"`)}
+	}
+	if _, ok := _c.mutation.Symbology(); !ok {
+		return &ValidationError{Name: "symbology", err: errors.New(`ent: missing required field "LabelTemplate.symbology"`)}
+	}
+	if v, ok := _c.mutation.Symbology(); ok {
+		if err := labeltemplate.SymbologyValidator(v); err != nil {
+			return &ValidationError{Name: "symbology", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.symbology": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetFields(); !ok {
+		return &ValidationError{Name: "fields", err: errors.New(`ent: missing required field "LabelTemplate.fields"`)}
+	}
+	if len(_c.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "LabelTemplate.group"`)}
+	}
+	return nil
+}
+
+func (_c *LabelTemplateCreate) sqlSave(ctx context.Context) (*LabelTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LabelTemplateCreate) createSpec() (*LabelTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LabelTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(labeltemplate.Table, sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(labeltemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(labeltemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(labeltemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(labeltemplate.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Symbology(); ok {
+		_spec.SetField(labeltemplate.FieldSymbology, field.TypeEnum, value)
+		_node.Symbology = value
+	}
+	if value, ok := _c.mutation.GetFields(); ok {
+		_spec.SetField(labeltemplate.FieldFields, field.TypeString, value)
+		_node.Fields = value
+	}
+	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.GroupTable,
+			Columns: []string{labeltemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LabelTemplateCreateBulk is the builder for creating many LabelTemplate entities in bulk.
+type LabelTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*LabelTemplateCreate
+}
+
+// Save creates the LabelTemplate entities in the database.
+func (_c *LabelTemplateCreateBulk) Save(ctx context.Context) ([]*LabelTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LabelTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LabelTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LabelTemplateCreateBulk) SaveX(ctx context.Context) []*LabelTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LabelTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LabelTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// LabelTemplateUpdate is the builder for updating LabelTemplate entities.
+type LabelTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LabelTemplateMutation
+}
+
+// Where appends a list predicates to the LabelTemplateUpdate builder.
+func (_u *LabelTemplateUpdate) Where(ps ...predicate.LabelTemplate) *LabelTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LabelTemplateUpdate) SetUpdatedAt(v time.Time) *LabelTemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LabelTemplateUpdate) SetName(v string) *LabelTemplateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableName(v *string) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *LabelTemplateUpdate) SetDescription(v string) *LabelTemplateUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableDescription(v *string) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *LabelTemplateUpdate) ClearDescription() *LabelTemplateUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *LabelTemplateUpdate) SetGroupID(v uuid.UUID) *LabelTemplateUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableGroupID(v *uuid.UUID) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetSymbology sets the "symbology" field.
+func (_u *LabelTemplateUpdate) SetSymbology(v labeltemplate.Symbology) *LabelTemplateUpdate {
+	_u.mutation.SetSymbology(v)
+	return _u
+}
+
+// SetNillableSymbology sets the "symbology" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableSymbology(v *labeltemplate.Symbology) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetSymbology(*v)
+	}
+	return _u
+}
+
+// SetFields sets the "fields" field.
+func (_u *LabelTemplateUpdate) SetFields(v string) *LabelTemplateUpdate {
+	_u.mutation.SetFields(v)
+	return _u
+}
+
+// SetNillableFields sets the "fields" field if the given value is not nil.
+func (_u *LabelTemplateUpdate) SetNillableFields(v *string) *LabelTemplateUpdate {
+	if v != nil {
+		_u.SetFields(*v)
+	}
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *LabelTemplateUpdate) SetGroup(v *Group) *LabelTemplateUpdate {
+	return _u.SetGroupID(v.ID)
+}
+
+// Mutation returns the LabelTemplateMutation object of the builder.
+func (_u *LabelTemplateUpdate) Mutation() *LabelTemplateMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *LabelTemplateUpdate) ClearGroup() *LabelTemplateUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LabelTemplateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LabelTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LabelTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LabelTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LabelTemplateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := labeltemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LabelTemplateUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := labeltemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := labeltemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbology(); ok {
+		if err := labeltemplate.SymbologyValidator(v); err != nil {
+			return &ValidationError{Name: "symbology", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.symbology": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LabelTemplate.group"`)
+	}
+	return nil
+}
+
+func (_u *LabelTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(labeltemplate.Table, labeltemplate.Columns, sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(labeltemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(labeltemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(labeltemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(labeltemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Symbology(); ok {
+		_spec.SetField(labeltemplate.FieldSymbology, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GetFields(); ok {
+		_spec.SetField(labeltemplate.FieldFields, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.GroupTable,
+			Columns: []string{labeltemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.GroupTable,
+			Columns: []string{labeltemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{labeltemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LabelTemplateUpdateOne is the builder for updating a single LabelTemplate entity.
+type LabelTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LabelTemplateMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LabelTemplateUpdateOne) SetUpdatedAt(v time.Time) *LabelTemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LabelTemplateUpdateOne) SetName(v string) *LabelTemplateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableName(v *string) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *LabelTemplateUpdateOne) SetDescription(v string) *LabelTemplateUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableDescription(v *string) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *LabelTemplateUpdateOne) ClearDescription() *LabelTemplateUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *LabelTemplateUpdateOne) SetGroupID(v uuid.UUID) *LabelTemplateUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableGroupID(v *uuid.UUID) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetSymbology sets the "symbology" field.
+func (_u *LabelTemplateUpdateOne) SetSymbology(v labeltemplate.Symbology) *LabelTemplateUpdateOne {
+	_u.mutation.SetSymbology(v)
+	return _u
+}
+
+// SetNillableSymbology sets the "symbology" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableSymbology(v *labeltemplate.Symbology) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetSymbology(*v)
+	}
+	return _u
+}
+
+// SetFields sets the "fields" field.
+func (_u *LabelTemplateUpdateOne) SetFields(v string) *LabelTemplateUpdateOne {
+	_u.mutation.SetFields(v)
+	return _u
+}
+
+// SetNillableFields sets the "fields" field if the given value is not nil.
+func (_u *LabelTemplateUpdateOne) SetNillableFields(v *string) *LabelTemplateUpdateOne {
+	if v != nil {
+		_u.SetFields(*v)
+	}
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *LabelTemplateUpdateOne) SetGroup(v *Group) *LabelTemplateUpdateOne {
+	return _u.SetGroupID(v.ID)
+}
+
+// Mutation returns the LabelTemplateMutation object of the builder.
+func (_u *LabelTemplateUpdateOne) Mutation() *LabelTemplateMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *LabelTemplateUpdateOne) ClearGroup() *LabelTemplateUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// Where appends a list predicates to the LabelTemplateUpdate builder.
+func (_u *LabelTemplateUpdateOne) Where(ps ...predicate.LabelTemplate) *LabelTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LabelTemplateUpdateOne) Select(field string, fields ...string) *LabelTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LabelTemplate entity.
+func (_u *LabelTemplateUpdateOne) Save(ctx context.Context) (*LabelTemplate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LabelTemplateUpdateOne) SaveX(ctx context.Context) *LabelTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LabelTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LabelTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LabelTemplateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := labeltemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LabelTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := labeltemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := labeltemplate.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbology(); ok {
+		if err := labeltemplate.SymbologyValidator(v); err != nil {
+			return &ValidationError{Name: "symbology", err: fmt.Errorf(`ent: validator failed for field "LabelTemplate.symbology": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LabelTemplate.group"`)
+	}
+	return nil
+}
+
+func (_u *LabelTemplateUpdateOne) sqlSave(ctx context.Context) (_node *LabelTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(labeltemplate.Table, labeltemplate.Columns, sqlgraph.NewFieldSpec(labeltemplate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LabelTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, labeltemplate.FieldID)
+		for _, f := range fields {
+			if !labeltemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != labeltemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(labeltemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(labeltemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(labeltemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(labeltemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Symbology(); ok {
+		_spec.SetField(labeltemplate.FieldSymbology, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GetFields(); ok {
+		_spec.SetField(labeltemplate.FieldFields, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.GroupTable,
+			Columns: []string{labeltemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   labeltemplate.GroupTable,
+			Columns: []string{labeltemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LabelTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{labeltemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
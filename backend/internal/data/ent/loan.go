@@ -14,6 +14,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -40,15 +41,18 @@ type Loan struct {
 	Quantity int `json:"quantity,omitempty"`
 	// Whether this loan was created/returned via kiosk self-service
 	KioskAction bool `json:"kiosk_action,omitempty"`
+	// Whether the item was returned at a kiosk outside its home location
+	Transfer bool `json:"transfer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LoanQuery when eager-loading is set.
-	Edges          LoanEdges `json:"edges"`
-	borrower_loans *uuid.UUID
-	group_loans    *uuid.UUID
-	item_loans     *uuid.UUID
-	user_checkouts *uuid.UUID
-	user_returns   *uuid.UUID
-	selectValues   sql.SelectValues
+	Edges                   LoanEdges `json:"edges"`
+	borrower_loans          *uuid.UUID
+	group_loans             *uuid.UUID
+	item_loans              *uuid.UUID
+	location_returned_loans *uuid.UUID
+	user_checkouts          *uuid.UUID
+	user_returns            *uuid.UUID
+	selectValues            sql.SelectValues
 }
 
 // LoanEdges holds the relations/edges for other nodes in the graph.
@@ -63,9 +67,11 @@ type LoanEdges struct {
 	CheckedOutBy *User `json:"checked_out_by,omitempty"`
 	// ReturnedBy holds the value of the returned_by edge.
 	ReturnedBy *User `json:"returned_by,omitempty"`
+	// ReturnLocation holds the value of the return_location edge.
+	ReturnLocation *Location `json:"return_location,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -123,12 +129,23 @@ func (e LoanEdges) ReturnedByOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "returned_by"}
 }
 
+// ReturnLocationOrErr returns the ReturnLocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanEdges) ReturnLocationOrErr() (*Location, error) {
+	if e.ReturnLocation != nil {
+		return e.ReturnLocation, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "return_location"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Loan) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case loan.FieldKioskAction:
+		case loan.FieldKioskAction, loan.FieldTransfer:
 			values[i] = new(sql.NullBool)
 		case loan.FieldQuantity:
 			values[i] = new(sql.NullInt64)
@@ -144,9 +161,11 @@ func (*Loan) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case loan.ForeignKeys[2]: // item_loans
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case loan.ForeignKeys[3]: // user_checkouts
+		case loan.ForeignKeys[3]: // location_returned_loans
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case loan.ForeignKeys[4]: // user_returns
+		case loan.ForeignKeys[4]: // user_checkouts
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case loan.ForeignKeys[5]: // user_returns
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -224,6 +243,12 @@ func (_m *Loan) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.KioskAction = value.Bool
 			}
+		case loan.FieldTransfer:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer", values[i])
+			} else if value.Valid {
+				_m.Transfer = value.Bool
+			}
 		case loan.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field borrower_loans", values[i])
@@ -246,13 +271,20 @@ func (_m *Loan) assignValues(columns []string, values []any) error {
 				*_m.item_loans = *value.S.(*uuid.UUID)
 			}
 		case loan.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field location_returned_loans", values[i])
+			} else if value.Valid {
+				_m.location_returned_loans = new(uuid.UUID)
+				*_m.location_returned_loans = *value.S.(*uuid.UUID)
+			}
+		case loan.ForeignKeys[4]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_checkouts", values[i])
 			} else if value.Valid {
 				_m.user_checkouts = new(uuid.UUID)
 				*_m.user_checkouts = *value.S.(*uuid.UUID)
 			}
-		case loan.ForeignKeys[4]:
+		case loan.ForeignKeys[5]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_returns", values[i])
 			} else if value.Valid {
@@ -297,6 +329,11 @@ func (_m *Loan) QueryReturnedBy() *UserQuery {
 	return NewLoanClient(_m.config).QueryReturnedBy(_m)
 }
 
+// QueryReturnLocation queries the "return_location" edge of the Loan entity.
+func (_m *Loan) QueryReturnLocation() *LocationQuery {
+	return NewLoanClient(_m.config).QueryReturnLocation(_m)
+}
+
 // Update returns a builder for updating this Loan.
 // Note that you need to call Loan.Unwrap() before calling this method if this Loan
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -348,6 +385,9 @@ func (_m *Loan) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("kiosk_action=")
 	builder.WriteString(fmt.Sprintf("%v", _m.KioskAction))
+	builder.WriteString(", ")
+	builder.WriteString("transfer=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Transfer))
 	builder.WriteByte(')')
 	return builder.String()
 }
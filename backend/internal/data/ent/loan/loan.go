@@ -33,6 +33,8 @@ const (
 	FieldQuantity = "quantity"
 	// FieldKioskAction holds the string denoting the kiosk_action field in the database.
 	FieldKioskAction = "kiosk_action"
+	// FieldTransfer holds the string denoting the transfer field in the database.
+	FieldTransfer = "transfer"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeItem holds the string denoting the item edge name in mutations.
@@ -43,6 +45,8 @@ const (
 	EdgeCheckedOutBy = "checked_out_by"
 	// EdgeReturnedBy holds the string denoting the returned_by edge name in mutations.
 	EdgeReturnedBy = "returned_by"
+	// EdgeReturnLocation holds the string denoting the return_location edge name in mutations.
+	EdgeReturnLocation = "return_location"
 	// Table holds the table name of the loan in the database.
 	Table = "loans"
 	// GroupTable is the table that holds the group relation/edge.
@@ -80,6 +84,13 @@ const (
 	ReturnedByInverseTable = "users"
 	// ReturnedByColumn is the table column denoting the returned_by relation/edge.
 	ReturnedByColumn = "user_returns"
+	// ReturnLocationTable is the table that holds the return_location relation/edge.
+	ReturnLocationTable = "loans"
+	// ReturnLocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	ReturnLocationInverseTable = "locations"
+	// ReturnLocationColumn is the table column denoting the return_location relation/edge.
+	ReturnLocationColumn = "location_returned_loans"
 )
 
 // Columns holds all SQL columns for loan fields.
@@ -94,6 +105,7 @@ var Columns = []string{
 	FieldReturnNotes,
 	FieldQuantity,
 	FieldKioskAction,
+	FieldTransfer,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "loans"
@@ -102,6 +114,7 @@ var ForeignKeys = []string{
 	"borrower_loans",
 	"group_loans",
 	"item_loans",
+	"location_returned_loans",
 	"user_checkouts",
 	"user_returns",
 }
@@ -140,6 +153,8 @@ var (
 	QuantityValidator func(int) error
 	// DefaultKioskAction holds the default value on creation for the "kiosk_action" field.
 	DefaultKioskAction bool
+	// DefaultTransfer holds the default value on creation for the "transfer" field.
+	DefaultTransfer bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -197,6 +212,11 @@ func ByKioskAction(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKioskAction, opts...).ToFunc()
 }
 
+// ByTransfer orders the results by the transfer field.
+func ByTransfer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransfer, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -231,6 +251,13 @@ func ByReturnedByField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newReturnedByStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByReturnLocationField orders the results by return_location field.
+func ByReturnLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReturnLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -266,3 +293,10 @@ func newReturnedByStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ReturnedByTable, ReturnedByColumn),
 	)
 }
+func newReturnLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReturnLocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReturnLocationTable, ReturnLocationColumn),
+	)
+}
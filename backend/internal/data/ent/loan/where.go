@@ -101,6 +101,11 @@ func KioskAction(v bool) predicate.Loan {
 	return predicate.Loan(sql.FieldEQ(FieldKioskAction, v))
 }
 
+// Transfer applies equality check predicate on the "transfer" field. It's identical to TransferEQ.
+func Transfer(v bool) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldTransfer, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Loan {
 	return predicate.Loan(sql.FieldEQ(FieldCreatedAt, v))
@@ -511,6 +516,16 @@ func KioskActionNEQ(v bool) predicate.Loan {
 	return predicate.Loan(sql.FieldNEQ(FieldKioskAction, v))
 }
 
+// TransferEQ applies the EQ predicate on the "transfer" field.
+func TransferEQ(v bool) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldTransfer, v))
+}
+
+// TransferNEQ applies the NEQ predicate on the "transfer" field.
+func TransferNEQ(v bool) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldTransfer, v))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.Loan {
 	return predicate.Loan(func(s *sql.Selector) {
@@ -626,6 +641,29 @@ func HasReturnedByWith(preds ...predicate.User) predicate.Loan {
 	})
 }
 
+// HasReturnLocation applies the HasEdge predicate on the "return_location" edge.
+func HasReturnLocation() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReturnLocationTable, ReturnLocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReturnLocationWith applies the HasEdge predicate on the "return_location" edge with a given conditions (other predicates).
+func HasReturnLocationWith(preds ...predicate.Location) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newReturnLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Loan) predicate.Loan {
 	return predicate.Loan(sql.AndPredicates(predicates...))
@@ -15,6 +15,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -143,6 +144,20 @@ func (_c *LoanCreate) SetNillableKioskAction(v *bool) *LoanCreate {
 	return _c
 }
 
+// SetTransfer sets the "transfer" field.
+func (_c *LoanCreate) SetTransfer(v bool) *LoanCreate {
+	_c.mutation.SetTransfer(v)
+	return _c
+}
+
+// SetNillableTransfer sets the "transfer" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableTransfer(v *bool) *LoanCreate {
+	if v != nil {
+		_c.SetTransfer(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LoanCreate) SetID(v uuid.UUID) *LoanCreate {
 	_c.mutation.SetID(v)
@@ -228,6 +243,25 @@ func (_c *LoanCreate) SetReturnedBy(v *User) *LoanCreate {
 	return _c.SetReturnedByID(v.ID)
 }
 
+// SetReturnLocationID sets the "return_location" edge to the Location entity by ID.
+func (_c *LoanCreate) SetReturnLocationID(id uuid.UUID) *LoanCreate {
+	_c.mutation.SetReturnLocationID(id)
+	return _c
+}
+
+// SetNillableReturnLocationID sets the "return_location" edge to the Location entity by ID if the given value is not nil.
+func (_c *LoanCreate) SetNillableReturnLocationID(id *uuid.UUID) *LoanCreate {
+	if id != nil {
+		_c = _c.SetReturnLocationID(*id)
+	}
+	return _c
+}
+
+// SetReturnLocation sets the "return_location" edge to the Location entity.
+func (_c *LoanCreate) SetReturnLocation(v *Location) *LoanCreate {
+	return _c.SetReturnLocationID(v.ID)
+}
+
 // Mutation returns the LoanMutation object of the builder.
 func (_c *LoanCreate) Mutation() *LoanMutation {
 	return _c.mutation
@@ -283,6 +317,10 @@ func (_c *LoanCreate) defaults() {
 		v := loan.DefaultKioskAction
 		_c.mutation.SetKioskAction(v)
 	}
+	if _, ok := _c.mutation.Transfer(); !ok {
+		v := loan.DefaultTransfer
+		_c.mutation.SetTransfer(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := loan.DefaultID()
 		_c.mutation.SetID(v)
@@ -324,6 +362,9 @@ func (_c *LoanCreate) check() error {
 	if _, ok := _c.mutation.KioskAction(); !ok {
 		return &ValidationError{Name: "kiosk_action", err: errors.New(`ent: missing required field "Loan.kiosk_action"`)}
 	}
+	if _, ok := _c.mutation.Transfer(); !ok {
+		return &ValidationError{Name: "transfer", err: errors.New(`ent: missing required field "Loan.transfer"`)}
+	}
 	if len(_c.mutation.GroupIDs()) == 0 {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "Loan.group"`)}
 	}
@@ -404,6 +445,10 @@ func (_c *LoanCreate) createSpec() (*Loan, *sqlgraph.CreateSpec) {
 		_spec.SetField(loan.FieldKioskAction, field.TypeBool, value)
 		_node.KioskAction = value
 	}
+	if value, ok := _c.mutation.Transfer(); ok {
+		_spec.SetField(loan.FieldTransfer, field.TypeBool, value)
+		_node.Transfer = value
+	}
 	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -489,6 +534,23 @@ func (_c *LoanCreate) createSpec() (*Loan, *sqlgraph.CreateSpec) {
 		_node.user_returns = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReturnLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.ReturnLocationTable,
+			Columns: []string{loan.ReturnLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.location_returned_loans = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
@@ -23,16 +24,17 @@ import (
 // LoanQuery is the builder for querying Loan entities.
 type LoanQuery struct {
 	config
-	ctx              *QueryContext
-	order            []loan.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Loan
-	withGroup        *GroupQuery
-	withItem         *ItemQuery
-	withBorrower     *BorrowerQuery
-	withCheckedOutBy *UserQuery
-	withReturnedBy   *UserQuery
-	withFKs          bool
+	ctx                *QueryContext
+	order              []loan.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Loan
+	withGroup          *GroupQuery
+	withItem           *ItemQuery
+	withBorrower       *BorrowerQuery
+	withCheckedOutBy   *UserQuery
+	withReturnedBy     *UserQuery
+	withReturnLocation *LocationQuery
+	withFKs            bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -179,6 +181,28 @@ func (_q *LoanQuery) QueryReturnedBy() *UserQuery {
 	return query
 }
 
+// QueryReturnLocation chains the current query on the "return_location" edge.
+func (_q *LoanQuery) QueryReturnLocation() *LocationQuery {
+	query := (&LocationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.ReturnLocationTable, loan.ReturnLocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Loan entity from the query.
 // Returns a *NotFoundError when no Loan was found.
 func (_q *LoanQuery) First(ctx context.Context) (*Loan, error) {
@@ -366,16 +390,17 @@ func (_q *LoanQuery) Clone() *LoanQuery {
 		return nil
 	}
 	return &LoanQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]loan.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Loan{}, _q.predicates...),
-		withGroup:        _q.withGroup.Clone(),
-		withItem:         _q.withItem.Clone(),
-		withBorrower:     _q.withBorrower.Clone(),
-		withCheckedOutBy: _q.withCheckedOutBy.Clone(),
-		withReturnedBy:   _q.withReturnedBy.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]loan.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Loan{}, _q.predicates...),
+		withGroup:          _q.withGroup.Clone(),
+		withItem:           _q.withItem.Clone(),
+		withBorrower:       _q.withBorrower.Clone(),
+		withCheckedOutBy:   _q.withCheckedOutBy.Clone(),
+		withReturnedBy:     _q.withReturnedBy.Clone(),
+		withReturnLocation: _q.withReturnLocation.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -437,6 +462,17 @@ func (_q *LoanQuery) WithReturnedBy(opts ...func(*UserQuery)) *LoanQuery {
 	return _q
 }
 
+// WithReturnLocation tells the query-builder to eager-load the nodes that are connected to
+// the "return_location" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LoanQuery) WithReturnLocation(opts ...func(*LocationQuery)) *LoanQuery {
+	query := (&LocationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReturnLocation = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -516,15 +552,16 @@ func (_q *LoanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Loan, e
 		nodes       = []*Loan{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			_q.withGroup != nil,
 			_q.withItem != nil,
 			_q.withBorrower != nil,
 			_q.withCheckedOutBy != nil,
 			_q.withReturnedBy != nil,
+			_q.withReturnLocation != nil,
 		}
 	)
-	if _q.withGroup != nil || _q.withItem != nil || _q.withBorrower != nil || _q.withCheckedOutBy != nil || _q.withReturnedBy != nil {
+	if _q.withGroup != nil || _q.withItem != nil || _q.withBorrower != nil || _q.withCheckedOutBy != nil || _q.withReturnedBy != nil || _q.withReturnLocation != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -578,6 +615,12 @@ func (_q *LoanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Loan, e
 			return nil, err
 		}
 	}
+	if query := _q.withReturnLocation; query != nil {
+		if err := _q.loadReturnLocation(ctx, query, nodes, nil,
+			func(n *Loan, e *Location) { n.Edges.ReturnLocation = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -741,6 +784,38 @@ func (_q *LoanQuery) loadReturnedBy(ctx context.Context, query *UserQuery, nodes
 	}
 	return nil
 }
+func (_q *LoanQuery) loadReturnLocation(ctx context.Context, query *LocationQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *Location)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Loan)
+	for i := range nodes {
+		if nodes[i].location_returned_loans == nil {
+			continue
+		}
+		fk := *nodes[i].location_returned_loans
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(location.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "location_returned_loans" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *LoanQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -16,6 +16,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
@@ -162,6 +163,20 @@ func (_u *LoanUpdate) SetNillableKioskAction(v *bool) *LoanUpdate {
 	return _u
 }
 
+// SetTransfer sets the "transfer" field.
+func (_u *LoanUpdate) SetTransfer(v bool) *LoanUpdate {
+	_u.mutation.SetTransfer(v)
+	return _u
+}
+
+// SetNillableTransfer sets the "transfer" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableTransfer(v *bool) *LoanUpdate {
+	if v != nil {
+		_u.SetTransfer(*v)
+	}
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *LoanUpdate) SetGroupID(id uuid.UUID) *LoanUpdate {
 	_u.mutation.SetGroupID(id)
@@ -233,6 +248,25 @@ func (_u *LoanUpdate) SetReturnedBy(v *User) *LoanUpdate {
 	return _u.SetReturnedByID(v.ID)
 }
 
+// SetReturnLocationID sets the "return_location" edge to the Location entity by ID.
+func (_u *LoanUpdate) SetReturnLocationID(id uuid.UUID) *LoanUpdate {
+	_u.mutation.SetReturnLocationID(id)
+	return _u
+}
+
+// SetNillableReturnLocationID sets the "return_location" edge to the Location entity by ID if the given value is not nil.
+func (_u *LoanUpdate) SetNillableReturnLocationID(id *uuid.UUID) *LoanUpdate {
+	if id != nil {
+		_u = _u.SetReturnLocationID(*id)
+	}
+	return _u
+}
+
+// SetReturnLocation sets the "return_location" edge to the Location entity.
+func (_u *LoanUpdate) SetReturnLocation(v *Location) *LoanUpdate {
+	return _u.SetReturnLocationID(v.ID)
+}
+
 // Mutation returns the LoanMutation object of the builder.
 func (_u *LoanUpdate) Mutation() *LoanMutation {
 	return _u.mutation
@@ -268,6 +302,12 @@ func (_u *LoanUpdate) ClearReturnedBy() *LoanUpdate {
 	return _u
 }
 
+// ClearReturnLocation clears the "return_location" edge to the Location entity.
+func (_u *LoanUpdate) ClearReturnLocation() *LoanUpdate {
+	_u.mutation.ClearReturnLocation()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LoanUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -381,6 +421,9 @@ func (_u *LoanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.KioskAction(); ok {
 		_spec.SetField(loan.FieldKioskAction, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Transfer(); ok {
+		_spec.SetField(loan.FieldTransfer, field.TypeBool, value)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -526,6 +569,35 @@ func (_u *LoanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReturnLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.ReturnLocationTable,
+			Columns: []string{loan.ReturnLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.ReturnLocationTable,
+			Columns: []string{loan.ReturnLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{loan.Label}
@@ -675,6 +747,20 @@ func (_u *LoanUpdateOne) SetNillableKioskAction(v *bool) *LoanUpdateOne {
 	return _u
 }
 
+// SetTransfer sets the "transfer" field.
+func (_u *LoanUpdateOne) SetTransfer(v bool) *LoanUpdateOne {
+	_u.mutation.SetTransfer(v)
+	return _u
+}
+
+// SetNillableTransfer sets the "transfer" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableTransfer(v *bool) *LoanUpdateOne {
+	if v != nil {
+		_u.SetTransfer(*v)
+	}
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *LoanUpdateOne) SetGroupID(id uuid.UUID) *LoanUpdateOne {
 	_u.mutation.SetGroupID(id)
@@ -746,6 +832,25 @@ func (_u *LoanUpdateOne) SetReturnedBy(v *User) *LoanUpdateOne {
 	return _u.SetReturnedByID(v.ID)
 }
 
+// SetReturnLocationID sets the "return_location" edge to the Location entity by ID.
+func (_u *LoanUpdateOne) SetReturnLocationID(id uuid.UUID) *LoanUpdateOne {
+	_u.mutation.SetReturnLocationID(id)
+	return _u
+}
+
+// SetNillableReturnLocationID sets the "return_location" edge to the Location entity by ID if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableReturnLocationID(id *uuid.UUID) *LoanUpdateOne {
+	if id != nil {
+		_u = _u.SetReturnLocationID(*id)
+	}
+	return _u
+}
+
+// SetReturnLocation sets the "return_location" edge to the Location entity.
+func (_u *LoanUpdateOne) SetReturnLocation(v *Location) *LoanUpdateOne {
+	return _u.SetReturnLocationID(v.ID)
+}
+
 // Mutation returns the LoanMutation object of the builder.
 func (_u *LoanUpdateOne) Mutation() *LoanMutation {
 	return _u.mutation
@@ -781,6 +886,12 @@ func (_u *LoanUpdateOne) ClearReturnedBy() *LoanUpdateOne {
 	return _u
 }
 
+// ClearReturnLocation clears the "return_location" edge to the Location entity.
+func (_u *LoanUpdateOne) ClearReturnLocation() *LoanUpdateOne {
+	_u.mutation.ClearReturnLocation()
+	return _u
+}
+
 // Where appends a list predicates to the LoanUpdate builder.
 func (_u *LoanUpdateOne) Where(ps ...predicate.Loan) *LoanUpdateOne {
 	_u.mutation.Where(ps...)
@@ -924,6 +1035,9 @@ func (_u *LoanUpdateOne) sqlSave(ctx context.Context) (_node *Loan, err error) {
 	if value, ok := _u.mutation.KioskAction(); ok {
 		_spec.SetField(loan.FieldKioskAction, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Transfer(); ok {
+		_spec.SetField(loan.FieldTransfer, field.TypeBool, value)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1069,6 +1183,35 @@ func (_u *LoanUpdateOne) sqlSave(ctx context.Context) (_node *Loan, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReturnLocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.ReturnLocationTable,
+			Columns: []string{loan.ReturnLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnLocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.ReturnLocationTable,
+			Columns: []string{loan.ReturnLocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Loan{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -27,6 +27,8 @@ type Location struct {
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LocationQuery when eager-loading is set.
 	Edges             LocationEdges `json:"edges"`
@@ -45,9 +47,15 @@ type LocationEdges struct {
 	Children []*Location `json:"children,omitempty"`
 	// Items holds the value of the items edge.
 	Items []*Item `json:"items,omitempty"`
+	// KioskSessions holds the value of the kiosk_sessions edge.
+	KioskSessions []*KioskSession `json:"kiosk_sessions,omitempty"`
+	// ReturnedLoans holds the value of the returned_loans edge.
+	ReturnedLoans []*Loan `json:"returned_loans,omitempty"`
+	// StocktakeSessions holds the value of the stocktake_sessions edge.
+	StocktakeSessions []*StocktakeSession `json:"stocktake_sessions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -90,6 +98,33 @@ func (e LocationEdges) ItemsOrErr() ([]*Item, error) {
 	return nil, &NotLoadedError{edge: "items"}
 }
 
+// KioskSessionsOrErr returns the KioskSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) KioskSessionsOrErr() ([]*KioskSession, error) {
+	if e.loadedTypes[4] {
+		return e.KioskSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "kiosk_sessions"}
+}
+
+// ReturnedLoansOrErr returns the ReturnedLoans value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) ReturnedLoansOrErr() ([]*Loan, error) {
+	if e.loadedTypes[5] {
+		return e.ReturnedLoans, nil
+	}
+	return nil, &NotLoadedError{edge: "returned_loans"}
+}
+
+// StocktakeSessionsOrErr returns the StocktakeSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) StocktakeSessionsOrErr() ([]*StocktakeSession, error) {
+	if e.loadedTypes[6] {
+		return e.StocktakeSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "stocktake_sessions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Location) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -97,7 +132,7 @@ func (*Location) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case location.FieldName, location.FieldDescription:
 			values[i] = new(sql.NullString)
-		case location.FieldCreatedAt, location.FieldUpdatedAt:
+		case location.FieldCreatedAt, location.FieldUpdatedAt, location.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case location.FieldID:
 			values[i] = new(uuid.UUID)
@@ -150,6 +185,13 @@ func (_m *Location) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case location.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case location.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field group_locations", values[i])
@@ -197,6 +239,21 @@ func (_m *Location) QueryItems() *ItemQuery {
 	return NewLocationClient(_m.config).QueryItems(_m)
 }
 
+// QueryKioskSessions queries the "kiosk_sessions" edge of the Location entity.
+func (_m *Location) QueryKioskSessions() *KioskSessionQuery {
+	return NewLocationClient(_m.config).QueryKioskSessions(_m)
+}
+
+// QueryReturnedLoans queries the "returned_loans" edge of the Location entity.
+func (_m *Location) QueryReturnedLoans() *LoanQuery {
+	return NewLocationClient(_m.config).QueryReturnedLoans(_m)
+}
+
+// QueryStocktakeSessions queries the "stocktake_sessions" edge of the Location entity.
+func (_m *Location) QueryStocktakeSessions() *StocktakeSessionQuery {
+	return NewLocationClient(_m.config).QueryStocktakeSessions(_m)
+}
+
 // Update returns a builder for updating this Location.
 // Note that you need to call Location.Unwrap() before calling this method if this Location
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -231,6 +288,11 @@ func (_m *Location) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -23,6 +23,8 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
@@ -31,6 +33,12 @@ const (
 	EdgeChildren = "children"
 	// EdgeItems holds the string denoting the items edge name in mutations.
 	EdgeItems = "items"
+	// EdgeKioskSessions holds the string denoting the kiosk_sessions edge name in mutations.
+	EdgeKioskSessions = "kiosk_sessions"
+	// EdgeReturnedLoans holds the string denoting the returned_loans edge name in mutations.
+	EdgeReturnedLoans = "returned_loans"
+	// EdgeStocktakeSessions holds the string denoting the stocktake_sessions edge name in mutations.
+	EdgeStocktakeSessions = "stocktake_sessions"
 	// Table holds the table name of the location in the database.
 	Table = "locations"
 	// GroupTable is the table that holds the group relation/edge.
@@ -55,6 +63,27 @@ const (
 	ItemsInverseTable = "items"
 	// ItemsColumn is the table column denoting the items relation/edge.
 	ItemsColumn = "location_items"
+	// KioskSessionsTable is the table that holds the kiosk_sessions relation/edge.
+	KioskSessionsTable = "kiosk_sessions"
+	// KioskSessionsInverseTable is the table name for the KioskSession entity.
+	// It exists in this package in order to avoid circular dependency with the "kiosksession" package.
+	KioskSessionsInverseTable = "kiosk_sessions"
+	// KioskSessionsColumn is the table column denoting the kiosk_sessions relation/edge.
+	KioskSessionsColumn = "location_kiosk_sessions"
+	// ReturnedLoansTable is the table that holds the returned_loans relation/edge.
+	ReturnedLoansTable = "loans"
+	// ReturnedLoansInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	ReturnedLoansInverseTable = "loans"
+	// ReturnedLoansColumn is the table column denoting the returned_loans relation/edge.
+	ReturnedLoansColumn = "location_returned_loans"
+	// StocktakeSessionsTable is the table that holds the stocktake_sessions relation/edge.
+	StocktakeSessionsTable = "stocktake_sessions"
+	// StocktakeSessionsInverseTable is the table name for the StocktakeSession entity.
+	// It exists in this package in order to avoid circular dependency with the "stocktakesession" package.
+	StocktakeSessionsInverseTable = "stocktake_sessions"
+	// StocktakeSessionsColumn is the table column denoting the stocktake_sessions relation/edge.
+	StocktakeSessionsColumn = "location_id"
 )
 
 // Columns holds all SQL columns for location fields.
@@ -64,6 +93,7 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldName,
 	FieldDescription,
+	FieldDeletedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "locations"
@@ -131,6 +161,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -172,6 +207,48 @@ func ByItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByKioskSessionsCount orders the results by kiosk_sessions count.
+func ByKioskSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKioskSessionsStep(), opts...)
+	}
+}
+
+// ByKioskSessions orders the results by kiosk_sessions terms.
+func ByKioskSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKioskSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReturnedLoansCount orders the results by returned_loans count.
+func ByReturnedLoansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReturnedLoansStep(), opts...)
+	}
+}
+
+// ByReturnedLoans orders the results by returned_loans terms.
+func ByReturnedLoans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReturnedLoansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStocktakeSessionsCount orders the results by stocktake_sessions count.
+func ByStocktakeSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStocktakeSessionsStep(), opts...)
+	}
+}
+
+// ByStocktakeSessions orders the results by stocktake_sessions terms.
+func ByStocktakeSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStocktakeSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -200,3 +277,24 @@ func newItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
 	)
 }
+func newKioskSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KioskSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, KioskSessionsTable, KioskSessionsColumn),
+	)
+}
+func newReturnedLoansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReturnedLoansInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReturnedLoansTable, ReturnedLoansColumn),
+	)
+}
+func newStocktakeSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StocktakeSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StocktakeSessionsTable, StocktakeSessionsColumn),
+	)
+}
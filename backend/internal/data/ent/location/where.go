@@ -76,6 +76,11 @@ func Description(v string) predicate.Location {
 	return predicate.Location(sql.FieldEQ(FieldDescription, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Location {
 	return predicate.Location(sql.FieldEQ(FieldCreatedAt, v))
@@ -296,6 +301,56 @@ func DescriptionContainsFold(v string) predicate.Location {
 	return predicate.Location(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Location {
+	return predicate.Location(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Location {
+	return predicate.Location(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Location {
+	return predicate.Location(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Location {
+	return predicate.Location(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.Location {
 	return predicate.Location(func(s *sql.Selector) {
@@ -388,6 +443,75 @@ func HasItemsWith(preds ...predicate.Item) predicate.Location {
 	})
 }
 
+// HasKioskSessions applies the HasEdge predicate on the "kiosk_sessions" edge.
+func HasKioskSessions() predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, KioskSessionsTable, KioskSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKioskSessionsWith applies the HasEdge predicate on the "kiosk_sessions" edge with a given conditions (other predicates).
+func HasKioskSessionsWith(preds ...predicate.KioskSession) predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := newKioskSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReturnedLoans applies the HasEdge predicate on the "returned_loans" edge.
+func HasReturnedLoans() predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReturnedLoansTable, ReturnedLoansColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReturnedLoansWith applies the HasEdge predicate on the "returned_loans" edge with a given conditions (other predicates).
+func HasReturnedLoansWith(preds ...predicate.Loan) predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := newReturnedLoansStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStocktakeSessions applies the HasEdge predicate on the "stocktake_sessions" edge.
+func HasStocktakeSessions() predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StocktakeSessionsTable, StocktakeSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStocktakeSessionsWith applies the HasEdge predicate on the "stocktake_sessions" edge with a given conditions (other predicates).
+func HasStocktakeSessionsWith(preds ...predicate.StocktakeSession) predicate.Location {
+	return predicate.Location(func(s *sql.Selector) {
+		step := newStocktakeSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Location) predicate.Location {
 	return predicate.Location(sql.AndPredicates(predicates...))
@@ -13,7 +13,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 )
 
 // LocationCreate is the builder for creating a Location entity.
@@ -71,6 +74,20 @@ func (_c *LocationCreate) SetNillableDescription(v *string) *LocationCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *LocationCreate) SetDeletedAt(v time.Time) *LocationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableDeletedAt(v *time.Time) *LocationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LocationCreate) SetID(v uuid.UUID) *LocationCreate {
 	_c.mutation.SetID(v)
@@ -145,6 +162,51 @@ func (_c *LocationCreate) AddItems(v ...*Item) *LocationCreate {
 	return _c.AddItemIDs(ids...)
 }
 
+// AddKioskSessionIDs adds the "kiosk_sessions" edge to the KioskSession entity by IDs.
+func (_c *LocationCreate) AddKioskSessionIDs(ids ...uuid.UUID) *LocationCreate {
+	_c.mutation.AddKioskSessionIDs(ids...)
+	return _c
+}
+
+// AddKioskSessions adds the "kiosk_sessions" edges to the KioskSession entity.
+func (_c *LocationCreate) AddKioskSessions(v ...*KioskSession) *LocationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddKioskSessionIDs(ids...)
+}
+
+// AddReturnedLoanIDs adds the "returned_loans" edge to the Loan entity by IDs.
+func (_c *LocationCreate) AddReturnedLoanIDs(ids ...uuid.UUID) *LocationCreate {
+	_c.mutation.AddReturnedLoanIDs(ids...)
+	return _c
+}
+
+// AddReturnedLoans adds the "returned_loans" edges to the Loan entity.
+func (_c *LocationCreate) AddReturnedLoans(v ...*Loan) *LocationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReturnedLoanIDs(ids...)
+}
+
+// AddStocktakeSessionIDs adds the "stocktake_sessions" edge to the StocktakeSession entity by IDs.
+func (_c *LocationCreate) AddStocktakeSessionIDs(ids ...uuid.UUID) *LocationCreate {
+	_c.mutation.AddStocktakeSessionIDs(ids...)
+	return _c
+}
+
+// AddStocktakeSessions adds the "stocktake_sessions" edges to the StocktakeSession entity.
+func (_c *LocationCreate) AddStocktakeSessions(v ...*StocktakeSession) *LocationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStocktakeSessionIDs(ids...)
+}
+
 // Mutation returns the LocationMutation object of the builder.
 func (_c *LocationCreate) Mutation() *LocationMutation {
 	return _c.mutation
@@ -269,6 +331,10 @@ func (_c *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
 		_spec.SetField(location.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(location.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -335,6 +401,54 @@ func (_c *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.KioskSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.KioskSessionsTable,
+			Columns: []string{location.KioskSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReturnedLoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReturnedLoansTable,
+			Columns: []string{location.ReturnedLoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StocktakeSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StocktakeSessionsTable,
+			Columns: []string{location.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,22 +15,28 @@ import (
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 )
 
 // LocationQuery is the builder for querying Location entities.
 type LocationQuery struct {
 	config
-	ctx          *QueryContext
-	order        []location.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Location
-	withGroup    *GroupQuery
-	withParent   *LocationQuery
-	withChildren *LocationQuery
-	withItems    *ItemQuery
-	withFKs      bool
+	ctx                   *QueryContext
+	order                 []location.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Location
+	withGroup             *GroupQuery
+	withParent            *LocationQuery
+	withChildren          *LocationQuery
+	withItems             *ItemQuery
+	withKioskSessions     *KioskSessionQuery
+	withReturnedLoans     *LoanQuery
+	withStocktakeSessions *StocktakeSessionQuery
+	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +161,72 @@ func (_q *LocationQuery) QueryItems() *ItemQuery {
 	return query
 }
 
+// QueryKioskSessions chains the current query on the "kiosk_sessions" edge.
+func (_q *LocationQuery) QueryKioskSessions() *KioskSessionQuery {
+	query := (&KioskSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(kiosksession.Table, kiosksession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.KioskSessionsTable, location.KioskSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReturnedLoans chains the current query on the "returned_loans" edge.
+func (_q *LocationQuery) QueryReturnedLoans() *LoanQuery {
+	query := (&LoanClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ReturnedLoansTable, location.ReturnedLoansColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStocktakeSessions chains the current query on the "stocktake_sessions" edge.
+func (_q *LocationQuery) QueryStocktakeSessions() *StocktakeSessionQuery {
+	query := (&StocktakeSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(stocktakesession.Table, stocktakesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.StocktakeSessionsTable, location.StocktakeSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Location entity from the query.
 // Returns a *NotFoundError when no Location was found.
 func (_q *LocationQuery) First(ctx context.Context) (*Location, error) {
@@ -342,15 +414,18 @@ func (_q *LocationQuery) Clone() *LocationQuery {
 		return nil
 	}
 	return &LocationQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]location.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Location{}, _q.predicates...),
-		withGroup:    _q.withGroup.Clone(),
-		withParent:   _q.withParent.Clone(),
-		withChildren: _q.withChildren.Clone(),
-		withItems:    _q.withItems.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]location.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.Location{}, _q.predicates...),
+		withGroup:             _q.withGroup.Clone(),
+		withParent:            _q.withParent.Clone(),
+		withChildren:          _q.withChildren.Clone(),
+		withItems:             _q.withItems.Clone(),
+		withKioskSessions:     _q.withKioskSessions.Clone(),
+		withReturnedLoans:     _q.withReturnedLoans.Clone(),
+		withStocktakeSessions: _q.withStocktakeSessions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -401,6 +476,39 @@ func (_q *LocationQuery) WithItems(opts ...func(*ItemQuery)) *LocationQuery {
 	return _q
 }
 
+// WithKioskSessions tells the query-builder to eager-load the nodes that are connected to
+// the "kiosk_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LocationQuery) WithKioskSessions(opts ...func(*KioskSessionQuery)) *LocationQuery {
+	query := (&KioskSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withKioskSessions = query
+	return _q
+}
+
+// WithReturnedLoans tells the query-builder to eager-load the nodes that are connected to
+// the "returned_loans" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LocationQuery) WithReturnedLoans(opts ...func(*LoanQuery)) *LocationQuery {
+	query := (&LoanClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReturnedLoans = query
+	return _q
+}
+
+// WithStocktakeSessions tells the query-builder to eager-load the nodes that are connected to
+// the "stocktake_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LocationQuery) WithStocktakeSessions(opts ...func(*StocktakeSessionQuery)) *LocationQuery {
+	query := (&StocktakeSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStocktakeSessions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -480,11 +588,14 @@ func (_q *LocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Loc
 		nodes       = []*Location{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			_q.withGroup != nil,
 			_q.withParent != nil,
 			_q.withChildren != nil,
 			_q.withItems != nil,
+			_q.withKioskSessions != nil,
+			_q.withReturnedLoans != nil,
+			_q.withStocktakeSessions != nil,
 		}
 	)
 	if _q.withGroup != nil || _q.withParent != nil {
@@ -537,6 +648,29 @@ func (_q *LocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Loc
 			return nil, err
 		}
 	}
+	if query := _q.withKioskSessions; query != nil {
+		if err := _q.loadKioskSessions(ctx, query, nodes,
+			func(n *Location) { n.Edges.KioskSessions = []*KioskSession{} },
+			func(n *Location, e *KioskSession) { n.Edges.KioskSessions = append(n.Edges.KioskSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReturnedLoans; query != nil {
+		if err := _q.loadReturnedLoans(ctx, query, nodes,
+			func(n *Location) { n.Edges.ReturnedLoans = []*Loan{} },
+			func(n *Location, e *Loan) { n.Edges.ReturnedLoans = append(n.Edges.ReturnedLoans, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStocktakeSessions; query != nil {
+		if err := _q.loadStocktakeSessions(ctx, query, nodes,
+			func(n *Location) { n.Edges.StocktakeSessions = []*StocktakeSession{} },
+			func(n *Location, e *StocktakeSession) {
+				n.Edges.StocktakeSessions = append(n.Edges.StocktakeSessions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -666,6 +800,98 @@ func (_q *LocationQuery) loadItems(ctx context.Context, query *ItemQuery, nodes
 	}
 	return nil
 }
+func (_q *LocationQuery) loadKioskSessions(ctx context.Context, query *KioskSessionQuery, nodes []*Location, init func(*Location), assign func(*Location, *KioskSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.KioskSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.KioskSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.location_kiosk_sessions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_kiosk_sessions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_kiosk_sessions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LocationQuery) loadReturnedLoans(ctx context.Context, query *LoanQuery, nodes []*Location, init func(*Location), assign func(*Location, *Loan)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Loan(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.ReturnedLoansColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.location_returned_loans
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_returned_loans" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_returned_loans" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LocationQuery) loadStocktakeSessions(ctx context.Context, query *StocktakeSessionQuery, nodes []*Location, init func(*Location), assign func(*Location, *StocktakeSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(stocktakesession.FieldLocationID)
+	}
+	query.Where(predicate.StocktakeSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.StocktakeSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *LocationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
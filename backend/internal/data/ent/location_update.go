@@ -14,8 +14,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 )
 
 // LocationUpdate is the builder for updating Location entities.
@@ -71,6 +74,26 @@ func (_u *LocationUpdate) ClearDescription() *LocationUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LocationUpdate) SetDeletedAt(v time.Time) *LocationUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LocationUpdate) SetNillableDeletedAt(v *time.Time) *LocationUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LocationUpdate) ClearDeletedAt() *LocationUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *LocationUpdate) SetGroupID(id uuid.UUID) *LocationUpdate {
 	_u.mutation.SetGroupID(id)
@@ -131,6 +154,51 @@ func (_u *LocationUpdate) AddItems(v ...*Item) *LocationUpdate {
 	return _u.AddItemIDs(ids...)
 }
 
+// AddKioskSessionIDs adds the "kiosk_sessions" edge to the KioskSession entity by IDs.
+func (_u *LocationUpdate) AddKioskSessionIDs(ids ...uuid.UUID) *LocationUpdate {
+	_u.mutation.AddKioskSessionIDs(ids...)
+	return _u
+}
+
+// AddKioskSessions adds the "kiosk_sessions" edges to the KioskSession entity.
+func (_u *LocationUpdate) AddKioskSessions(v ...*KioskSession) *LocationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKioskSessionIDs(ids...)
+}
+
+// AddReturnedLoanIDs adds the "returned_loans" edge to the Loan entity by IDs.
+func (_u *LocationUpdate) AddReturnedLoanIDs(ids ...uuid.UUID) *LocationUpdate {
+	_u.mutation.AddReturnedLoanIDs(ids...)
+	return _u
+}
+
+// AddReturnedLoans adds the "returned_loans" edges to the Loan entity.
+func (_u *LocationUpdate) AddReturnedLoans(v ...*Loan) *LocationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReturnedLoanIDs(ids...)
+}
+
+// AddStocktakeSessionIDs adds the "stocktake_sessions" edge to the StocktakeSession entity by IDs.
+func (_u *LocationUpdate) AddStocktakeSessionIDs(ids ...uuid.UUID) *LocationUpdate {
+	_u.mutation.AddStocktakeSessionIDs(ids...)
+	return _u
+}
+
+// AddStocktakeSessions adds the "stocktake_sessions" edges to the StocktakeSession entity.
+func (_u *LocationUpdate) AddStocktakeSessions(v ...*StocktakeSession) *LocationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStocktakeSessionIDs(ids...)
+}
+
 // Mutation returns the LocationMutation object of the builder.
 func (_u *LocationUpdate) Mutation() *LocationMutation {
 	return _u.mutation
@@ -190,6 +258,69 @@ func (_u *LocationUpdate) RemoveItems(v ...*Item) *LocationUpdate {
 	return _u.RemoveItemIDs(ids...)
 }
 
+// ClearKioskSessions clears all "kiosk_sessions" edges to the KioskSession entity.
+func (_u *LocationUpdate) ClearKioskSessions() *LocationUpdate {
+	_u.mutation.ClearKioskSessions()
+	return _u
+}
+
+// RemoveKioskSessionIDs removes the "kiosk_sessions" edge to KioskSession entities by IDs.
+func (_u *LocationUpdate) RemoveKioskSessionIDs(ids ...uuid.UUID) *LocationUpdate {
+	_u.mutation.RemoveKioskSessionIDs(ids...)
+	return _u
+}
+
+// RemoveKioskSessions removes "kiosk_sessions" edges to KioskSession entities.
+func (_u *LocationUpdate) RemoveKioskSessions(v ...*KioskSession) *LocationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKioskSessionIDs(ids...)
+}
+
+// ClearReturnedLoans clears all "returned_loans" edges to the Loan entity.
+func (_u *LocationUpdate) ClearReturnedLoans() *LocationUpdate {
+	_u.mutation.ClearReturnedLoans()
+	return _u
+}
+
+// RemoveReturnedLoanIDs removes the "returned_loans" edge to Loan entities by IDs.
+func (_u *LocationUpdate) RemoveReturnedLoanIDs(ids ...uuid.UUID) *LocationUpdate {
+	_u.mutation.RemoveReturnedLoanIDs(ids...)
+	return _u
+}
+
+// RemoveReturnedLoans removes "returned_loans" edges to Loan entities.
+func (_u *LocationUpdate) RemoveReturnedLoans(v ...*Loan) *LocationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReturnedLoanIDs(ids...)
+}
+
+// ClearStocktakeSessions clears all "stocktake_sessions" edges to the StocktakeSession entity.
+func (_u *LocationUpdate) ClearStocktakeSessions() *LocationUpdate {
+	_u.mutation.ClearStocktakeSessions()
+	return _u
+}
+
+// RemoveStocktakeSessionIDs removes the "stocktake_sessions" edge to StocktakeSession entities by IDs.
+func (_u *LocationUpdate) RemoveStocktakeSessionIDs(ids ...uuid.UUID) *LocationUpdate {
+	_u.mutation.RemoveStocktakeSessionIDs(ids...)
+	return _u
+}
+
+// RemoveStocktakeSessions removes "stocktake_sessions" edges to StocktakeSession entities.
+func (_u *LocationUpdate) RemoveStocktakeSessions(v ...*StocktakeSession) *LocationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStocktakeSessionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LocationUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -268,6 +399,12 @@ func (_u *LocationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(location.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(location.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(location.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -416,6 +553,141 @@ func (_u *LocationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KioskSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.KioskSessionsTable,
+			Columns: []string{location.KioskSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKioskSessionsIDs(); len(nodes) > 0 && !_u.mutation.KioskSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.KioskSessionsTable,
+			Columns: []string{location.KioskSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KioskSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.KioskSessionsTable,
+			Columns: []string{location.KioskSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnedLoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReturnedLoansTable,
+			Columns: []string{location.ReturnedLoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReturnedLoansIDs(); len(nodes) > 0 && !_u.mutation.ReturnedLoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReturnedLoansTable,
+			Columns: []string{location.ReturnedLoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnedLoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReturnedLoansTable,
+			Columns: []string{location.ReturnedLoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StocktakeSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StocktakeSessionsTable,
+			Columns: []string{location.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStocktakeSessionsIDs(); len(nodes) > 0 && !_u.mutation.StocktakeSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StocktakeSessionsTable,
+			Columns: []string{location.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StocktakeSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StocktakeSessionsTable,
+			Columns: []string{location.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{location.Label}
@@ -476,6 +748,26 @@ func (_u *LocationUpdateOne) ClearDescription() *LocationUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LocationUpdateOne) SetDeletedAt(v time.Time) *LocationUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LocationUpdateOne) SetNillableDeletedAt(v *time.Time) *LocationUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LocationUpdateOne) ClearDeletedAt() *LocationUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (_u *LocationUpdateOne) SetGroupID(id uuid.UUID) *LocationUpdateOne {
 	_u.mutation.SetGroupID(id)
@@ -536,6 +828,51 @@ func (_u *LocationUpdateOne) AddItems(v ...*Item) *LocationUpdateOne {
 	return _u.AddItemIDs(ids...)
 }
 
+// AddKioskSessionIDs adds the "kiosk_sessions" edge to the KioskSession entity by IDs.
+func (_u *LocationUpdateOne) AddKioskSessionIDs(ids ...uuid.UUID) *LocationUpdateOne {
+	_u.mutation.AddKioskSessionIDs(ids...)
+	return _u
+}
+
+// AddKioskSessions adds the "kiosk_sessions" edges to the KioskSession entity.
+func (_u *LocationUpdateOne) AddKioskSessions(v ...*KioskSession) *LocationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKioskSessionIDs(ids...)
+}
+
+// AddReturnedLoanIDs adds the "returned_loans" edge to the Loan entity by IDs.
+func (_u *LocationUpdateOne) AddReturnedLoanIDs(ids ...uuid.UUID) *LocationUpdateOne {
+	_u.mutation.AddReturnedLoanIDs(ids...)
+	return _u
+}
+
+// AddReturnedLoans adds the "returned_loans" edges to the Loan entity.
+func (_u *LocationUpdateOne) AddReturnedLoans(v ...*Loan) *LocationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReturnedLoanIDs(ids...)
+}
+
+// AddStocktakeSessionIDs adds the "stocktake_sessions" edge to the StocktakeSession entity by IDs.
+func (_u *LocationUpdateOne) AddStocktakeSessionIDs(ids ...uuid.UUID) *LocationUpdateOne {
+	_u.mutation.AddStocktakeSessionIDs(ids...)
+	return _u
+}
+
+// AddStocktakeSessions adds the "stocktake_sessions" edges to the StocktakeSession entity.
+func (_u *LocationUpdateOne) AddStocktakeSessions(v ...*StocktakeSession) *LocationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStocktakeSessionIDs(ids...)
+}
+
 // Mutation returns the LocationMutation object of the builder.
 func (_u *LocationUpdateOne) Mutation() *LocationMutation {
 	return _u.mutation
@@ -595,6 +932,69 @@ func (_u *LocationUpdateOne) RemoveItems(v ...*Item) *LocationUpdateOne {
 	return _u.RemoveItemIDs(ids...)
 }
 
+// ClearKioskSessions clears all "kiosk_sessions" edges to the KioskSession entity.
+func (_u *LocationUpdateOne) ClearKioskSessions() *LocationUpdateOne {
+	_u.mutation.ClearKioskSessions()
+	return _u
+}
+
+// RemoveKioskSessionIDs removes the "kiosk_sessions" edge to KioskSession entities by IDs.
+func (_u *LocationUpdateOne) RemoveKioskSessionIDs(ids ...uuid.UUID) *LocationUpdateOne {
+	_u.mutation.RemoveKioskSessionIDs(ids...)
+	return _u
+}
+
+// RemoveKioskSessions removes "kiosk_sessions" edges to KioskSession entities.
+func (_u *LocationUpdateOne) RemoveKioskSessions(v ...*KioskSession) *LocationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKioskSessionIDs(ids...)
+}
+
+// ClearReturnedLoans clears all "returned_loans" edges to the Loan entity.
+func (_u *LocationUpdateOne) ClearReturnedLoans() *LocationUpdateOne {
+	_u.mutation.ClearReturnedLoans()
+	return _u
+}
+
+// RemoveReturnedLoanIDs removes the "returned_loans" edge to Loan entities by IDs.
+func (_u *LocationUpdateOne) RemoveReturnedLoanIDs(ids ...uuid.UUID) *LocationUpdateOne {
+	_u.mutation.RemoveReturnedLoanIDs(ids...)
+	return _u
+}
+
+// RemoveReturnedLoans removes "returned_loans" edges to Loan entities.
+func (_u *LocationUpdateOne) RemoveReturnedLoans(v ...*Loan) *LocationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReturnedLoanIDs(ids...)
+}
+
+// ClearStocktakeSessions clears all "stocktake_sessions" edges to the StocktakeSession entity.
+func (_u *LocationUpdateOne) ClearStocktakeSessions() *LocationUpdateOne {
+	_u.mutation.ClearStocktakeSessions()
+	return _u
+}
+
+// RemoveStocktakeSessionIDs removes the "stocktake_sessions" edge to StocktakeSession entities by IDs.
+func (_u *LocationUpdateOne) RemoveStocktakeSessionIDs(ids ...uuid.UUID) *LocationUpdateOne {
+	_u.mutation.RemoveStocktakeSessionIDs(ids...)
+	return _u
+}
+
+// RemoveStocktakeSessions removes "stocktake_sessions" edges to StocktakeSession entities.
+func (_u *LocationUpdateOne) RemoveStocktakeSessions(v ...*StocktakeSession) *LocationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStocktakeSessionIDs(ids...)
+}
+
 // Where appends a list predicates to the LocationUpdate builder.
 func (_u *LocationUpdateOne) Where(ps ...predicate.Location) *LocationUpdateOne {
 	_u.mutation.Where(ps...)
@@ -703,6 +1103,12 @@ func (_u *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(location.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(location.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(location.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -851,6 +1257,141 @@ func (_u *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KioskSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.KioskSessionsTable,
+			Columns: []string{location.KioskSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKioskSessionsIDs(); len(nodes) > 0 && !_u.mutation.KioskSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.KioskSessionsTable,
+			Columns: []string{location.KioskSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KioskSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.KioskSessionsTable,
+			Columns: []string{location.KioskSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(kiosksession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnedLoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReturnedLoansTable,
+			Columns: []string{location.ReturnedLoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReturnedLoansIDs(); len(nodes) > 0 && !_u.mutation.ReturnedLoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReturnedLoansTable,
+			Columns: []string{location.ReturnedLoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnedLoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ReturnedLoansTable,
+			Columns: []string{location.ReturnedLoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StocktakeSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StocktakeSessionsTable,
+			Columns: []string{location.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStocktakeSessionsIDs(); len(nodes) > 0 && !_u.mutation.StocktakeSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StocktakeSessionsTable,
+			Columns: []string{location.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StocktakeSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StocktakeSessionsTable,
+			Columns: []string{location.StocktakeSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Location{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
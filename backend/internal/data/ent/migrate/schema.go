@@ -41,6 +41,45 @@ var (
 			},
 		},
 	}
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "entity_type", Type: field.TypeString, Size: 64},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete"}},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"api", "kiosk", "import", "system"}, Default: "system"},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "changes", Type: field.TypeString, Size: 2147483647},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_entries_groups_audit_entries",
+				Columns:    []*schema.Column{AuditEntriesColumns[9]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_group_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[9], AuditEntriesColumns[1]},
+			},
+			{
+				Name:    "auditentry_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[3], AuditEntriesColumns[4]},
+			},
+		},
+	}
 	// AuthRolesColumns holds the columns for the "auth_roles" table.
 	AuthRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -104,6 +143,11 @@ var (
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 1000},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "self_registered", Type: field.TypeBool, Default: false},
+		{Name: "verification_status", Type: field.TypeEnum, Enums: []string{"verified", "pending_email", "pending_approval", "rejected"}, Default: "verified"},
+		{Name: "verification_token", Type: field.TypeBytes, Nullable: true},
+		{Name: "verification_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "group_borrowers", Type: field.TypeUUID},
 	}
 	// BorrowersTable holds the schema information for the "borrowers" table.
@@ -114,7 +158,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "borrowers_groups_borrowers",
-				Columns:    []*schema.Column{BorrowersColumns[11]},
+				Columns:    []*schema.Column{BorrowersColumns[16]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -135,6 +179,48 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{BorrowersColumns[9]},
 			},
+			{
+				Name:    "borrower_verification_status",
+				Unique:  false,
+				Columns: []*schema.Column{BorrowersColumns[11]},
+			},
+			{
+				Name:    "borrower_verification_token",
+				Unique:  false,
+				Columns: []*schema.Column{BorrowersColumns[12]},
+			},
+		},
+	}
+	// FieldDefinitionsColumns holds the columns for the "field_definitions" table.
+	FieldDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "decimal", "boolean", "time", "select", "multiselect", "url"}},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// FieldDefinitionsTable holds the schema information for the "field_definitions" table.
+	FieldDefinitionsTable = &schema.Table{
+		Name:       "field_definitions",
+		Columns:    FieldDefinitionsColumns,
+		PrimaryKey: []*schema.Column{FieldDefinitionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "field_definitions_groups_field_definitions",
+				Columns:    []*schema.Column{FieldDefinitionsColumns[7]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fielddefinition_group_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{FieldDefinitionsColumns[7], FieldDefinitionsColumns[3]},
+			},
 		},
 	}
 	// GroupsColumns holds the columns for the "groups" table.
@@ -144,6 +230,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "currency", Type: field.TypeString, Default: "usd"},
+		{Name: "asset_id_prefix", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "asset_id_width", Type: field.TypeInt, Default: 0},
+		{Name: "asset_id_check_digit", Type: field.TypeEnum, Enums: []string{"none", "luhn", "mod11"}, Default: "none"},
+		{Name: "asset_id_unchecked_max", Type: field.TypeInt, Default: 0},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -182,13 +272,16 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "import_ref", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 1000},
 		{Name: "quantity", Type: field.TypeInt, Default: 1},
 		{Name: "insured", Type: field.TypeBool, Default: false},
 		{Name: "archived", Type: field.TypeBool, Default: false},
 		{Name: "asset_id", Type: field.TypeInt, Default: 0},
+		{Name: "asset_id_prefix", Type: field.TypeString, Size: 32, Default: ""},
 		{Name: "sync_child_items_locations", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"in_service", "in_repair", "lost", "stolen", "retired", "disposed"}, Default: "in_service"},
 		{Name: "serial_number", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "model_number", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "manufacturer", Type: field.TypeString, Nullable: true, Size: 255},
@@ -202,6 +295,10 @@ var (
 		{Name: "sold_to", Type: field.TypeString, Nullable: true},
 		{Name: "sold_price", Type: field.TypeFloat64, Default: 0},
 		{Name: "sold_notes", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "quarantined_at", Type: field.TypeTime, Nullable: true},
+		{Name: "quarantine_until", Type: field.TypeTime, Nullable: true},
+		{Name: "min_stock", Type: field.TypeInt, Default: 0},
+		{Name: "reorder_quantity", Type: field.TypeInt, Default: 0},
 		{Name: "group_items", Type: field.TypeUUID},
 		{Name: "item_children", Type: field.TypeUUID, Nullable: true},
 		{Name: "location_items", Type: field.TypeUUID, Nullable: true},
@@ -214,24 +311,29 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "items_groups_items",
-				Columns:    []*schema.Column{ItemsColumns[25]},
+				Columns:    []*schema.Column{ItemsColumns[32]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "items_items_children",
-				Columns:    []*schema.Column{ItemsColumns[26]},
+				Columns:    []*schema.Column{ItemsColumns[33]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "items_locations_items",
-				Columns:    []*schema.Column{ItemsColumns[27]},
+				Columns:    []*schema.Column{ItemsColumns[34]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "item_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[5]},
+			},
 			{
 				Name:    "item_name",
 				Unique:  false,
@@ -240,27 +342,37 @@ var (
 			{
 				Name:    "item_manufacturer",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[14]},
+				Columns: []*schema.Column{ItemsColumns[17]},
 			},
 			{
 				Name:    "item_model_number",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[13]},
+				Columns: []*schema.Column{ItemsColumns[16]},
 			},
 			{
 				Name:    "item_serial_number",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[12]},
+				Columns: []*schema.Column{ItemsColumns[15]},
 			},
 			{
 				Name:    "item_archived",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[9]},
+				Columns: []*schema.Column{ItemsColumns[10]},
 			},
 			{
 				Name:    "item_asset_id",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[10]},
+				Columns: []*schema.Column{ItemsColumns[11]},
+			},
+			{
+				Name:    "item_quarantined_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[28]},
+			},
+			{
+				Name:    "item_status",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[14]},
 			},
 		},
 	}
@@ -271,9 +383,9 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "boolean", "time"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "decimal", "boolean", "time", "select", "multiselect", "url"}},
 		{Name: "text_value", Type: field.TypeString, Nullable: true, Size: 500},
-		{Name: "number_value", Type: field.TypeInt, Nullable: true},
+		{Name: "number_value", Type: field.TypeFloat64, Nullable: true},
 		{Name: "boolean_value", Type: field.TypeBool, Default: false},
 		{Name: "time_value", Type: field.TypeTime},
 		{Name: "item_fields", Type: field.TypeUUID, Nullable: true},
@@ -292,6 +404,83 @@ var (
 			},
 		},
 	}
+	// ItemIdentifiersColumns holds the columns for the "item_identifiers" table.
+	ItemIdentifiersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"upc", "ean", "isbn", "legacy_tag", "nfc", "rfid", "other"}, Default: "other"},
+		{Name: "value", Type: field.TypeString, Size: 255},
+		{Name: "normalized_value", Type: field.TypeString, Size: 255},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "item_id", Type: field.TypeUUID},
+	}
+	// ItemIdentifiersTable holds the schema information for the "item_identifiers" table.
+	ItemIdentifiersTable = &schema.Table{
+		Name:       "item_identifiers",
+		Columns:    ItemIdentifiersColumns,
+		PrimaryKey: []*schema.Column{ItemIdentifiersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_identifiers_groups_item_identifiers",
+				Columns:    []*schema.Column{ItemIdentifiersColumns[6]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_identifiers_items_identifiers",
+				Columns:    []*schema.Column{ItemIdentifiersColumns[7]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemidentifier_group_id_type_normalized_value",
+				Unique:  true,
+				Columns: []*schema.Column{ItemIdentifiersColumns[6], ItemIdentifiersColumns[3], ItemIdentifiersColumns[5]},
+			},
+		},
+	}
+	// ItemStatusChangesColumns holds the columns for the "item_status_changes" table.
+	ItemStatusChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "from_status", Type: field.TypeEnum, Enums: []string{"in_service", "in_repair", "lost", "stolen", "retired", "disposed"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"in_service", "in_repair", "lost", "stolen", "retired", "disposed"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "item_id", Type: field.TypeUUID},
+	}
+	// ItemStatusChangesTable holds the schema information for the "item_status_changes" table.
+	ItemStatusChangesTable = &schema.Table{
+		Name:       "item_status_changes",
+		Columns:    ItemStatusChangesColumns,
+		PrimaryKey: []*schema.Column{ItemStatusChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_status_changes_groups_item_status_changes",
+				Columns:    []*schema.Column{ItemStatusChangesColumns[7]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_status_changes_items_status_changes",
+				Columns:    []*schema.Column{ItemStatusChangesColumns[8]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemstatuschange_item_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemStatusChangesColumns[8], ItemStatusChangesColumns[1]},
+			},
+		},
+	}
 	// ItemTemplatesColumns holds the columns for the "item_templates" table.
 	ItemTemplatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -349,6 +538,14 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "unlocked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_activity_at", Type: field.TypeTime, Nullable: true},
+		{Name: "app_version", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "battery_level", Type: field.TypeInt, Nullable: true},
+		{Name: "battery_charging", Type: field.TypeBool, Nullable: true},
+		{Name: "network_type", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "offline_alerted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "location_kiosk_sessions", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_kiosk_session", Type: field.TypeUUID, Unique: true},
 	}
 	// KioskSessionsTable holds the schema information for the "kiosk_sessions" table.
@@ -357,9 +554,15 @@ var (
 		Columns:    KioskSessionsColumns,
 		PrimaryKey: []*schema.Column{KioskSessionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kiosk_sessions_locations_kiosk_sessions",
+				Columns:    []*schema.Column{KioskSessionsColumns[12]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "kiosk_sessions_users_kiosk_session",
-				Columns:    []*schema.Column{KioskSessionsColumns[5]},
+				Columns:    []*schema.Column{KioskSessionsColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -370,6 +573,44 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{KioskSessionsColumns[3]},
 			},
+			{
+				Name:    "kiosksession_last_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{KioskSessionsColumns[5]},
+			},
+		},
+	}
+	// KioskSyncActionsColumns holds the columns for the "kiosk_sync_actions" table.
+	KioskSyncActionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "idempotency_key", Type: field.TypeString, Size: 255},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"checkout", "return", "register_borrower"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "applied"}, Default: "pending"},
+		{Name: "client_timestamp", Type: field.TypeTime},
+		{Name: "result_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_kiosk_sync_actions", Type: field.TypeUUID},
+	}
+	// KioskSyncActionsTable holds the schema information for the "kiosk_sync_actions" table.
+	KioskSyncActionsTable = &schema.Table{
+		Name:       "kiosk_sync_actions",
+		Columns:    KioskSyncActionsColumns,
+		PrimaryKey: []*schema.Column{KioskSyncActionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kiosk_sync_actions_groups_kiosk_sync_actions",
+				Columns:    []*schema.Column{KioskSyncActionsColumns[8]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "kiosksyncaction_idempotency_key_group_kiosk_sync_actions",
+				Unique:  true,
+				Columns: []*schema.Column{KioskSyncActionsColumns[3], KioskSyncActionsColumns[8]},
+			},
 		},
 	}
 	// LabelsColumns holds the columns for the "labels" table.
@@ -380,6 +621,9 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
 		{Name: "color", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "quarantine_on_return", Type: field.TypeBool, Default: false},
+		{Name: "quarantine_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "asset_id_prefix", Type: field.TypeString, Nullable: true, Size: 32},
 		{Name: "group_labels", Type: field.TypeUUID},
 	}
 	// LabelsTable holds the schema information for the "labels" table.
@@ -390,12 +634,44 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "labels_groups_labels",
-				Columns:    []*schema.Column{LabelsColumns[6]},
+				Columns:    []*schema.Column{LabelsColumns[9]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// LabelTemplatesColumns holds the columns for the "label_templates" table.
+	LabelTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "symbology", Type: field.TypeEnum, Enums: []string{"qr", "datamatrix", "code128", "none"}, Default: "qr"},
+		{Name: "fields", Type: field.TypeString, Size: 2147483647},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// LabelTemplatesTable holds the schema information for the "label_templates" table.
+	LabelTemplatesTable = &schema.Table{
+		Name:       "label_templates",
+		Columns:    LabelTemplatesColumns,
+		PrimaryKey: []*schema.Column{LabelTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "label_templates_groups_label_templates",
+				Columns:    []*schema.Column{LabelTemplatesColumns[7]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "labeltemplate_group_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{LabelTemplatesColumns[7], LabelTemplatesColumns[3]},
+			},
+		},
+	}
 	// LoansColumns holds the columns for the "loans" table.
 	LoansColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -408,9 +684,11 @@ var (
 		{Name: "return_notes", Type: field.TypeString, Nullable: true, Size: 1000},
 		{Name: "quantity", Type: field.TypeInt, Default: 1},
 		{Name: "kiosk_action", Type: field.TypeBool, Default: false},
+		{Name: "transfer", Type: field.TypeBool, Default: false},
 		{Name: "borrower_loans", Type: field.TypeUUID},
 		{Name: "group_loans", Type: field.TypeUUID},
 		{Name: "item_loans", Type: field.TypeUUID},
+		{Name: "location_returned_loans", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_checkouts", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_returns", Type: field.TypeUUID, Nullable: true},
 	}
@@ -422,31 +700,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "loans_borrowers_loans",
-				Columns:    []*schema.Column{LoansColumns[10]},
+				Columns:    []*schema.Column{LoansColumns[11]},
 				RefColumns: []*schema.Column{BorrowersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "loans_groups_loans",
-				Columns:    []*schema.Column{LoansColumns[11]},
+				Columns:    []*schema.Column{LoansColumns[12]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "loans_items_loans",
-				Columns:    []*schema.Column{LoansColumns[12]},
+				Columns:    []*schema.Column{LoansColumns[13]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "loans_locations_returned_loans",
+				Columns:    []*schema.Column{LoansColumns[14]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "loans_users_checkouts",
-				Columns:    []*schema.Column{LoansColumns[13]},
+				Columns:    []*schema.Column{LoansColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "loans_users_returns",
-				Columns:    []*schema.Column{LoansColumns[14]},
+				Columns:    []*schema.Column{LoansColumns[16]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -476,6 +760,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "group_locations", Type: field.TypeUUID},
 		{Name: "location_children", Type: field.TypeUUID, Nullable: true},
 	}
@@ -487,17 +772,24 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "locations_groups_locations",
-				Columns:    []*schema.Column{LocationsColumns[5]},
+				Columns:    []*schema.Column{LocationsColumns[6]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "locations_locations_children",
-				Columns:    []*schema.Column{LocationsColumns[6]},
+				Columns:    []*schema.Column{LocationsColumns[7]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "location_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{LocationsColumns[5]},
+			},
+		},
 	}
 	// MaintenanceEntriesColumns holds the columns for the "maintenance_entries" table.
 	MaintenanceEntriesColumns = []*schema.Column{
@@ -578,6 +870,257 @@ var (
 			},
 		},
 	}
+	// PrintJobsColumns holds the columns for the "print_jobs" table.
+	PrintJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "printing", "done", "failed", "cancelled"}, Default: "queued"},
+		{Name: "labels", Type: field.TypeInt, Default: 0},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 3},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "printer_id", Type: field.TypeUUID},
+	}
+	// PrintJobsTable holds the schema information for the "print_jobs" table.
+	PrintJobsTable = &schema.Table{
+		Name:       "print_jobs",
+		Columns:    PrintJobsColumns,
+		PrimaryKey: []*schema.Column{PrintJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "print_jobs_groups_print_jobs",
+				Columns:    []*schema.Column{PrintJobsColumns[13]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "print_jobs_printers_jobs",
+				Columns:    []*schema.Column{PrintJobsColumns[14]},
+				RefColumns: []*schema.Column{PrintersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "printjob_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{PrintJobsColumns[4], PrintJobsColumns[10]},
+			},
+			{
+				Name:    "printjob_group_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PrintJobsColumns[13], PrintJobsColumns[1]},
+			},
+		},
+	}
+	// PrintersColumns holds the columns for the "printers" table.
+	PrintersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"command", "raw", "ipp"}, Default: "raw"},
+		{Name: "address", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "language", Type: field.TypeEnum, Enums: []string{"zpl", "epl"}, Default: "zpl"},
+		{Name: "dpi", Type: field.TypeInt, Default: 203},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// PrintersTable holds the schema information for the "printers" table.
+	PrintersTable = &schema.Table{
+		Name:       "printers",
+		Columns:    PrintersColumns,
+		PrimaryKey: []*schema.Column{PrintersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "printers_groups_printers",
+				Columns:    []*schema.Column{PrintersColumns[9]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "printer_group_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{PrintersColumns[9], PrintersColumns[3]},
+			},
+		},
+	}
+	// SavedSearchesColumns holds the columns for the "saved_searches" table.
+	SavedSearchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "query", Type: field.TypeString, Size: 2147483647},
+		{Name: "shared", Type: field.TypeBool, Default: false},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// SavedSearchesTable holds the schema information for the "saved_searches" table.
+	SavedSearchesTable = &schema.Table{
+		Name:       "saved_searches",
+		Columns:    SavedSearchesColumns,
+		PrimaryKey: []*schema.Column{SavedSearchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_searches_groups_saved_searches",
+				Columns:    []*schema.Column{SavedSearchesColumns[7]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "saved_searches_users_saved_searches",
+				Columns:    []*schema.Column{SavedSearchesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedsearch_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SavedSearchesColumns[8]},
+			},
+			{
+				Name:    "savedsearch_group_id_shared",
+				Unique:  false,
+				Columns: []*schema.Column{SavedSearchesColumns[7], SavedSearchesColumns[6]},
+			},
+		},
+	}
+	// StockMovementsColumns holds the columns for the "stock_movements" table.
+	StockMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "delta", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"purchase", "issue", "loss", "adjustment", "count_correction"}, Default: "adjustment"},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "item_id", Type: field.TypeUUID},
+	}
+	// StockMovementsTable holds the schema information for the "stock_movements" table.
+	StockMovementsTable = &schema.Table{
+		Name:       "stock_movements",
+		Columns:    StockMovementsColumns,
+		PrimaryKey: []*schema.Column{StockMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stock_movements_groups_stock_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[8]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "stock_movements_items_stock_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[9]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stockmovement_item_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementsColumns[9], StockMovementsColumns[1]},
+			},
+		},
+	}
+	// StocktakeEntriesColumns holds the columns for the "stocktake_entries" table.
+	StocktakeEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "value", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "expected", Type: field.TypeBool, Default: false},
+		{Name: "expected_location_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "found_location_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "found_at", Type: field.TypeTime, Nullable: true},
+		{Name: "found_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "on_loan", Type: field.TypeBool, Default: false},
+		{Name: "item_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "session_id", Type: field.TypeUUID},
+	}
+	// StocktakeEntriesTable holds the schema information for the "stocktake_entries" table.
+	StocktakeEntriesTable = &schema.Table{
+		Name:       "stocktake_entries",
+		Columns:    StocktakeEntriesColumns,
+		PrimaryKey: []*schema.Column{StocktakeEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stocktake_entries_items_stocktake_entries",
+				Columns:    []*schema.Column{StocktakeEntriesColumns[10]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "stocktake_entries_stocktake_sessions_entries",
+				Columns:    []*schema.Column{StocktakeEntriesColumns[11]},
+				RefColumns: []*schema.Column{StocktakeSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stocktakeentry_session_id_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{StocktakeEntriesColumns[11], StocktakeEntriesColumns[10]},
+			},
+		},
+	}
+	// StocktakeSessionsColumns holds the columns for the "stocktake_sessions" table.
+	StocktakeSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "closed"}, Default: "open"},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "started_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "closed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "location_id", Type: field.TypeUUID},
+	}
+	// StocktakeSessionsTable holds the schema information for the "stocktake_sessions" table.
+	StocktakeSessionsTable = &schema.Table{
+		Name:       "stocktake_sessions",
+		Columns:    StocktakeSessionsColumns,
+		PrimaryKey: []*schema.Column{StocktakeSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stocktake_sessions_groups_stocktake_sessions",
+				Columns:    []*schema.Column{StocktakeSessionsColumns[9]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "stocktake_sessions_locations_stocktake_sessions",
+				Columns:    []*schema.Column{StocktakeSessionsColumns[10]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stocktakesession_status",
+				Unique:  false,
+				Columns: []*schema.Column{StocktakeSessionsColumns[5]},
+			},
+		},
+	}
 	// TemplateFieldsColumns holds the columns for the "template_fields" table.
 	TemplateFieldsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -585,7 +1128,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"text"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "decimal", "boolean", "time", "select", "multiselect", "url"}},
 		{Name: "text_value", Type: field.TypeString, Nullable: true, Size: 500},
 		{Name: "item_template_fields", Type: field.TypeUUID, Nullable: true},
 	}
@@ -668,20 +1211,32 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AttachmentsTable,
+		AuditEntriesTable,
 		AuthRolesTable,
 		AuthTokensTable,
 		BorrowersTable,
+		FieldDefinitionsTable,
 		GroupsTable,
 		GroupInvitationTokensTable,
 		ItemsTable,
 		ItemFieldsTable,
+		ItemIdentifiersTable,
+		ItemStatusChangesTable,
 		ItemTemplatesTable,
 		KioskSessionsTable,
+		KioskSyncActionsTable,
 		LabelsTable,
+		LabelTemplatesTable,
 		LoansTable,
 		LocationsTable,
 		MaintenanceEntriesTable,
 		NotifiersTable,
+		PrintJobsTable,
+		PrintersTable,
+		SavedSearchesTable,
+		StockMovementsTable,
+		StocktakeEntriesTable,
+		StocktakeSessionsTable,
 		TemplateFieldsTable,
 		UsersTable,
 		LabelItemsTable,
@@ -691,28 +1246,49 @@ var (
 func init() {
 	AttachmentsTable.ForeignKeys[0].RefTable = AttachmentsTable
 	AttachmentsTable.ForeignKeys[1].RefTable = ItemsTable
+	AuditEntriesTable.ForeignKeys[0].RefTable = GroupsTable
 	AuthRolesTable.ForeignKeys[0].RefTable = AuthTokensTable
 	AuthTokensTable.ForeignKeys[0].RefTable = UsersTable
 	BorrowersTable.ForeignKeys[0].RefTable = GroupsTable
+	FieldDefinitionsTable.ForeignKeys[0].RefTable = GroupsTable
 	GroupInvitationTokensTable.ForeignKeys[0].RefTable = GroupsTable
 	ItemsTable.ForeignKeys[0].RefTable = GroupsTable
 	ItemsTable.ForeignKeys[1].RefTable = ItemsTable
 	ItemsTable.ForeignKeys[2].RefTable = LocationsTable
 	ItemFieldsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemIdentifiersTable.ForeignKeys[0].RefTable = GroupsTable
+	ItemIdentifiersTable.ForeignKeys[1].RefTable = ItemsTable
+	ItemStatusChangesTable.ForeignKeys[0].RefTable = GroupsTable
+	ItemStatusChangesTable.ForeignKeys[1].RefTable = ItemsTable
 	ItemTemplatesTable.ForeignKeys[0].RefTable = GroupsTable
 	ItemTemplatesTable.ForeignKeys[1].RefTable = LocationsTable
-	KioskSessionsTable.ForeignKeys[0].RefTable = UsersTable
+	KioskSessionsTable.ForeignKeys[0].RefTable = LocationsTable
+	KioskSessionsTable.ForeignKeys[1].RefTable = UsersTable
+	KioskSyncActionsTable.ForeignKeys[0].RefTable = GroupsTable
 	LabelsTable.ForeignKeys[0].RefTable = GroupsTable
+	LabelTemplatesTable.ForeignKeys[0].RefTable = GroupsTable
 	LoansTable.ForeignKeys[0].RefTable = BorrowersTable
 	LoansTable.ForeignKeys[1].RefTable = GroupsTable
 	LoansTable.ForeignKeys[2].RefTable = ItemsTable
-	LoansTable.ForeignKeys[3].RefTable = UsersTable
+	LoansTable.ForeignKeys[3].RefTable = LocationsTable
 	LoansTable.ForeignKeys[4].RefTable = UsersTable
+	LoansTable.ForeignKeys[5].RefTable = UsersTable
 	LocationsTable.ForeignKeys[0].RefTable = GroupsTable
 	LocationsTable.ForeignKeys[1].RefTable = LocationsTable
 	MaintenanceEntriesTable.ForeignKeys[0].RefTable = ItemsTable
 	NotifiersTable.ForeignKeys[0].RefTable = GroupsTable
 	NotifiersTable.ForeignKeys[1].RefTable = UsersTable
+	PrintJobsTable.ForeignKeys[0].RefTable = GroupsTable
+	PrintJobsTable.ForeignKeys[1].RefTable = PrintersTable
+	PrintersTable.ForeignKeys[0].RefTable = GroupsTable
+	SavedSearchesTable.ForeignKeys[0].RefTable = GroupsTable
+	SavedSearchesTable.ForeignKeys[1].RefTable = UsersTable
+	StockMovementsTable.ForeignKeys[0].RefTable = GroupsTable
+	StockMovementsTable.ForeignKeys[1].RefTable = ItemsTable
+	StocktakeEntriesTable.ForeignKeys[0].RefTable = ItemsTable
+	StocktakeEntriesTable.ForeignKeys[1].RefTable = StocktakeSessionsTable
+	StocktakeSessionsTable.ForeignKeys[0].RefTable = GroupsTable
+	StocktakeSessionsTable.ForeignKeys[1].RefTable = LocationsTable
 	TemplateFieldsTable.ForeignKeys[0].RefTable = ItemTemplatesTable
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
 	LabelItemsTable.ForeignKeys[0].RefTable = LabelsTable
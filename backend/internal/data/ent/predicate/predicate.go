@@ -9,6 +9,9 @@ import (
 // Attachment is the predicate function for attachment builders.
 type Attachment func(*sql.Selector)
 
+// AuditEntry is the predicate function for auditentry builders.
+type AuditEntry func(*sql.Selector)
+
 // AuthRoles is the predicate function for authroles builders.
 type AuthRoles func(*sql.Selector)
 
@@ -18,6 +21,9 @@ type AuthTokens func(*sql.Selector)
 // Borrower is the predicate function for borrower builders.
 type Borrower func(*sql.Selector)
 
+// FieldDefinition is the predicate function for fielddefinition builders.
+type FieldDefinition func(*sql.Selector)
+
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
@@ -30,15 +36,27 @@ type Item func(*sql.Selector)
 // ItemField is the predicate function for itemfield builders.
 type ItemField func(*sql.Selector)
 
+// ItemIdentifier is the predicate function for itemidentifier builders.
+type ItemIdentifier func(*sql.Selector)
+
+// ItemStatusChange is the predicate function for itemstatuschange builders.
+type ItemStatusChange func(*sql.Selector)
+
 // ItemTemplate is the predicate function for itemtemplate builders.
 type ItemTemplate func(*sql.Selector)
 
 // KioskSession is the predicate function for kiosksession builders.
 type KioskSession func(*sql.Selector)
 
+// KioskSyncAction is the predicate function for kiosksyncaction builders.
+type KioskSyncAction func(*sql.Selector)
+
 // Label is the predicate function for label builders.
 type Label func(*sql.Selector)
 
+// LabelTemplate is the predicate function for labeltemplate builders.
+type LabelTemplate func(*sql.Selector)
+
 // Loan is the predicate function for loan builders.
 type Loan func(*sql.Selector)
 
@@ -51,6 +69,24 @@ type MaintenanceEntry func(*sql.Selector)
 // Notifier is the predicate function for notifier builders.
 type Notifier func(*sql.Selector)
 
+// PrintJob is the predicate function for printjob builders.
+type PrintJob func(*sql.Selector)
+
+// Printer is the predicate function for printer builders.
+type Printer func(*sql.Selector)
+
+// SavedSearch is the predicate function for savedsearch builders.
+type SavedSearch func(*sql.Selector)
+
+// StockMovement is the predicate function for stockmovement builders.
+type StockMovement func(*sql.Selector)
+
+// StocktakeEntry is the predicate function for stocktakeentry builders.
+type StocktakeEntry func(*sql.Selector)
+
+// StocktakeSession is the predicate function for stocktakesession builders.
+type StocktakeSession func(*sql.Selector)
+
 // TemplateField is the predicate function for templatefield builders.
 type TemplateField func(*sql.Selector)
 
@@ -0,0 +1,817 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+)
+
+// PrinterUpdate is the builder for updating Printer entities.
+type PrinterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrinterMutation
+}
+
+// Where appends a list predicates to the PrinterUpdate builder.
+func (_u *PrinterUpdate) Where(ps ...predicate.Printer) *PrinterUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PrinterUpdate) SetUpdatedAt(v time.Time) *PrinterUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PrinterUpdate) SetName(v string) *PrinterUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PrinterUpdate) SetNillableName(v *string) *PrinterUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PrinterUpdate) SetDescription(v string) *PrinterUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PrinterUpdate) SetNillableDescription(v *string) *PrinterUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PrinterUpdate) ClearDescription() *PrinterUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *PrinterUpdate) SetGroupID(v uuid.UUID) *PrinterUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *PrinterUpdate) SetNillableGroupID(v *uuid.UUID) *PrinterUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *PrinterUpdate) SetKind(v printer.Kind) *PrinterUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *PrinterUpdate) SetNillableKind(v *printer.Kind) *PrinterUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *PrinterUpdate) SetAddress(v string) *PrinterUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *PrinterUpdate) SetNillableAddress(v *string) *PrinterUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (_u *PrinterUpdate) ClearAddress() *PrinterUpdate {
+	_u.mutation.ClearAddress()
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *PrinterUpdate) SetLanguage(v printer.Language) *PrinterUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *PrinterUpdate) SetNillableLanguage(v *printer.Language) *PrinterUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetDpi sets the "dpi" field.
+func (_u *PrinterUpdate) SetDpi(v int) *PrinterUpdate {
+	_u.mutation.ResetDpi()
+	_u.mutation.SetDpi(v)
+	return _u
+}
+
+// SetNillableDpi sets the "dpi" field if the given value is not nil.
+func (_u *PrinterUpdate) SetNillableDpi(v *int) *PrinterUpdate {
+	if v != nil {
+		_u.SetDpi(*v)
+	}
+	return _u
+}
+
+// AddDpi adds value to the "dpi" field.
+func (_u *PrinterUpdate) AddDpi(v int) *PrinterUpdate {
+	_u.mutation.AddDpi(v)
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *PrinterUpdate) SetGroup(v *Group) *PrinterUpdate {
+	return _u.SetGroupID(v.ID)
+}
+
+// AddJobIDs adds the "jobs" edge to the PrintJob entity by IDs.
+func (_u *PrinterUpdate) AddJobIDs(ids ...uuid.UUID) *PrinterUpdate {
+	_u.mutation.AddJobIDs(ids...)
+	return _u
+}
+
+// AddJobs adds the "jobs" edges to the PrintJob entity.
+func (_u *PrinterUpdate) AddJobs(v ...*PrintJob) *PrinterUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobIDs(ids...)
+}
+
+// Mutation returns the PrinterMutation object of the builder.
+func (_u *PrinterUpdate) Mutation() *PrinterMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *PrinterUpdate) ClearGroup() *PrinterUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearJobs clears all "jobs" edges to the PrintJob entity.
+func (_u *PrinterUpdate) ClearJobs() *PrinterUpdate {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to PrintJob entities by IDs.
+func (_u *PrinterUpdate) RemoveJobIDs(ids ...uuid.UUID) *PrinterUpdate {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to PrintJob entities.
+func (_u *PrinterUpdate) RemoveJobs(v ...*PrintJob) *PrinterUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PrinterUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrinterUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PrinterUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrinterUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PrinterUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := printer.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PrinterUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := printer.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Printer.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := printer.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Printer.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := printer.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Printer.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Address(); ok {
+		if err := printer.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Printer.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := printer.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Printer.language": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Printer.group"`)
+	}
+	return nil
+}
+
+func (_u *PrinterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(printer.Table, printer.Columns, sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(printer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(printer.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(printer.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(printer.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(printer.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(printer.FieldAddress, field.TypeString, value)
+	}
+	if _u.mutation.AddressCleared() {
+		_spec.ClearField(printer.FieldAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(printer.FieldLanguage, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Dpi(); ok {
+		_spec.SetField(printer.FieldDpi, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDpi(); ok {
+		_spec.AddField(printer.FieldDpi, field.TypeInt, value)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printer.GroupTable,
+			Columns: []string{printer.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printer.GroupTable,
+			Columns: []string{printer.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   printer.JobsTable,
+			Columns: []string{printer.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   printer.JobsTable,
+			Columns: []string{printer.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   printer.JobsTable,
+			Columns: []string{printer.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{printer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PrinterUpdateOne is the builder for updating a single Printer entity.
+type PrinterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrinterMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PrinterUpdateOne) SetUpdatedAt(v time.Time) *PrinterUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PrinterUpdateOne) SetName(v string) *PrinterUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PrinterUpdateOne) SetNillableName(v *string) *PrinterUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PrinterUpdateOne) SetDescription(v string) *PrinterUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PrinterUpdateOne) SetNillableDescription(v *string) *PrinterUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PrinterUpdateOne) ClearDescription() *PrinterUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *PrinterUpdateOne) SetGroupID(v uuid.UUID) *PrinterUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *PrinterUpdateOne) SetNillableGroupID(v *uuid.UUID) *PrinterUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *PrinterUpdateOne) SetKind(v printer.Kind) *PrinterUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *PrinterUpdateOne) SetNillableKind(v *printer.Kind) *PrinterUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *PrinterUpdateOne) SetAddress(v string) *PrinterUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *PrinterUpdateOne) SetNillableAddress(v *string) *PrinterUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (_u *PrinterUpdateOne) ClearAddress() *PrinterUpdateOne {
+	_u.mutation.ClearAddress()
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *PrinterUpdateOne) SetLanguage(v printer.Language) *PrinterUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *PrinterUpdateOne) SetNillableLanguage(v *printer.Language) *PrinterUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetDpi sets the "dpi" field.
+func (_u *PrinterUpdateOne) SetDpi(v int) *PrinterUpdateOne {
+	_u.mutation.ResetDpi()
+	_u.mutation.SetDpi(v)
+	return _u
+}
+
+// SetNillableDpi sets the "dpi" field if the given value is not nil.
+func (_u *PrinterUpdateOne) SetNillableDpi(v *int) *PrinterUpdateOne {
+	if v != nil {
+		_u.SetDpi(*v)
+	}
+	return _u
+}
+
+// AddDpi adds value to the "dpi" field.
+func (_u *PrinterUpdateOne) AddDpi(v int) *PrinterUpdateOne {
+	_u.mutation.AddDpi(v)
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *PrinterUpdateOne) SetGroup(v *Group) *PrinterUpdateOne {
+	return _u.SetGroupID(v.ID)
+}
+
+// AddJobIDs adds the "jobs" edge to the PrintJob entity by IDs.
+func (_u *PrinterUpdateOne) AddJobIDs(ids ...uuid.UUID) *PrinterUpdateOne {
+	_u.mutation.AddJobIDs(ids...)
+	return _u
+}
+
+// AddJobs adds the "jobs" edges to the PrintJob entity.
+func (_u *PrinterUpdateOne) AddJobs(v ...*PrintJob) *PrinterUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobIDs(ids...)
+}
+
+// Mutation returns the PrinterMutation object of the builder.
+func (_u *PrinterUpdateOne) Mutation() *PrinterMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *PrinterUpdateOne) ClearGroup() *PrinterUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearJobs clears all "jobs" edges to the PrintJob entity.
+func (_u *PrinterUpdateOne) ClearJobs() *PrinterUpdateOne {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to PrintJob entities by IDs.
+func (_u *PrinterUpdateOne) RemoveJobIDs(ids ...uuid.UUID) *PrinterUpdateOne {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to PrintJob entities.
+func (_u *PrinterUpdateOne) RemoveJobs(v ...*PrintJob) *PrinterUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
+// Where appends a list predicates to the PrinterUpdate builder.
+func (_u *PrinterUpdateOne) Where(ps ...predicate.Printer) *PrinterUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PrinterUpdateOne) Select(field string, fields ...string) *PrinterUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Printer entity.
+func (_u *PrinterUpdateOne) Save(ctx context.Context) (*Printer, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrinterUpdateOne) SaveX(ctx context.Context) *Printer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PrinterUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrinterUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PrinterUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := printer.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PrinterUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := printer.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Printer.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := printer.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Printer.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := printer.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Printer.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Address(); ok {
+		if err := printer.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Printer.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := printer.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Printer.language": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Printer.group"`)
+	}
+	return nil
+}
+
+func (_u *PrinterUpdateOne) sqlSave(ctx context.Context) (_node *Printer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(printer.Table, printer.Columns, sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Printer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, printer.FieldID)
+		for _, f := range fields {
+			if !printer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != printer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(printer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(printer.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(printer.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(printer.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(printer.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(printer.FieldAddress, field.TypeString, value)
+	}
+	if _u.mutation.AddressCleared() {
+		_spec.ClearField(printer.FieldAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(printer.FieldLanguage, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Dpi(); ok {
+		_spec.SetField(printer.FieldDpi, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDpi(); ok {
+		_spec.AddField(printer.FieldDpi, field.TypeInt, value)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printer.GroupTable,
+			Columns: []string{printer.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printer.GroupTable,
+			Columns: []string{printer.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   printer.JobsTable,
+			Columns: []string{printer.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   printer.JobsTable,
+			Columns: []string{printer.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   printer.JobsTable,
+			Columns: []string{printer.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Printer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{printer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
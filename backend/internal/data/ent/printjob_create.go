@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+)
+
+// PrintJobCreate is the builder for creating a PrintJob entity.
+type PrintJobCreate struct {
+	config
+	mutation *PrintJobMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PrintJobCreate) SetCreatedAt(v time.Time) *PrintJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableCreatedAt(v *time.Time) *PrintJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PrintJobCreate) SetUpdatedAt(v time.Time) *PrintJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableUpdatedAt(v *time.Time) *PrintJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *PrintJobCreate) SetGroupID(v uuid.UUID) *PrintJobCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetPrinterID sets the "printer_id" field.
+func (_c *PrintJobCreate) SetPrinterID(v uuid.UUID) *PrintJobCreate {
+	_c.mutation.SetPrinterID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PrintJobCreate) SetName(v string) *PrintJobCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PrintJobCreate) SetStatus(v printjob.Status) *PrintJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableStatus(v *printjob.Status) *PrintJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLabels sets the "labels" field.
+func (_c *PrintJobCreate) SetLabels(v int) *PrintJobCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
+// SetNillableLabels sets the "labels" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableLabels(v *int) *PrintJobCreate {
+	if v != nil {
+		_c.SetLabels(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *PrintJobCreate) SetPayload(v string) *PrintJobCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *PrintJobCreate) SetAttempts(v int) *PrintJobCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableAttempts(v *int) *PrintJobCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *PrintJobCreate) SetMaxAttempts(v int) *PrintJobCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableMaxAttempts(v *int) *PrintJobCreate {
+	if v != nil {
+		_c.SetMaxAttempts(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *PrintJobCreate) SetError(v string) *PrintJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableError(v *string) *PrintJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetRunAt sets the "run_at" field.
+func (_c *PrintJobCreate) SetRunAt(v time.Time) *PrintJobCreate {
+	_c.mutation.SetRunAt(v)
+	return _c
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableRunAt(v *time.Time) *PrintJobCreate {
+	if v != nil {
+		_c.SetRunAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *PrintJobCreate) SetFinishedAt(v time.Time) *PrintJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableFinishedAt(v *time.Time) *PrintJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PrintJobCreate) SetCreatedBy(v uuid.UUID) *PrintJobCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableCreatedBy(v *uuid.UUID) *PrintJobCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PrintJobCreate) SetID(v uuid.UUID) *PrintJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PrintJobCreate) SetNillableID(v *uuid.UUID) *PrintJobCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_c *PrintJobCreate) SetGroup(v *Group) *PrintJobCreate {
+	return _c.SetGroupID(v.ID)
+}
+
+// SetPrinter sets the "printer" edge to the Printer entity.
+func (_c *PrintJobCreate) SetPrinter(v *Printer) *PrintJobCreate {
+	return _c.SetPrinterID(v.ID)
+}
+
+// Mutation returns the PrintJobMutation object of the builder.
+func (_c *PrintJobCreate) Mutation() *PrintJobMutation {
+	return _c.mutation
+}
+
+// Save creates the PrintJob in the database.
+func (_c *PrintJobCreate) Save(ctx context.Context) (*PrintJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PrintJobCreate) SaveX(ctx context.Context) *PrintJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PrintJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PrintJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PrintJobCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := printjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := printjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := printjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Labels(); !ok {
+		v := printjob.DefaultLabels
+		_c.mutation.SetLabels(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := printjob.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		v := printjob.DefaultMaxAttempts
+		_c.mutation.SetMaxAttempts(v)
+	}
+	if _, ok := _c.mutation.RunAt(); !ok {
+		v := printjob.DefaultRunAt()
+		_c.mutation.SetRunAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := printjob.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PrintJobCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PrintJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PrintJob.updated_at"`)}
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "PrintJob.group_id"`)}
+	}
+	if _, ok := _c.mutation.PrinterID(); !ok {
+		return &ValidationError{Name: "printer_id", err: errors.New(`ent: missing required field "PrintJob.printer_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PrintJob.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := printjob.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PrintJob.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PrintJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := printjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PrintJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Labels(); !ok {
+		return &ValidationError{Name: "labels", err: errors.New(`ent: missing required field "PrintJob.labels"`)}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "PrintJob.payload"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "PrintJob.attempts"`)}
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`ent: missing required field "PrintJob.max_attempts"`)}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := printjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "PrintJob.error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RunAt(); !ok {
+		return &ValidationError{Name: "run_at", err: errors.New(`ent: missing required field "PrintJob.run_at"`)}
+	}
+	if len(_c.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "PrintJob.group"`)}
+	}
+	if len(_c.mutation.PrinterIDs()) == 0 {
+		return &ValidationError{Name: "printer", err: errors.New(`ent: missing required edge "PrintJob.printer"`)}
+	}
+	return nil
+}
+
+func (_c *PrintJobCreate) sqlSave(ctx context.Context) (*PrintJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PrintJobCreate) createSpec() (*PrintJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PrintJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(printjob.Table, sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(printjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(printjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(printjob.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(printjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(printjob.FieldLabels, field.TypeInt, value)
+		_node.Labels = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(printjob.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(printjob.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(printjob.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(printjob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.RunAt(); ok {
+		_spec.SetField(printjob.FieldRunAt, field.TypeTime, value)
+		_node.RunAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(printjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(printjob.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.GroupTable,
+			Columns: []string{printjob.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PrinterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.PrinterTable,
+			Columns: []string{printjob.PrinterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PrinterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PrintJobCreateBulk is the builder for creating many PrintJob entities in bulk.
+type PrintJobCreateBulk struct {
+	config
+	err      error
+	builders []*PrintJobCreate
+}
+
+// Save creates the PrintJob entities in the database.
+func (_c *PrintJobCreateBulk) Save(ctx context.Context) ([]*PrintJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PrintJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PrintJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PrintJobCreateBulk) SaveX(ctx context.Context) []*PrintJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PrintJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PrintJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
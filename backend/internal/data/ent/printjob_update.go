@@ -0,0 +1,943 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+)
+
+// PrintJobUpdate is the builder for updating PrintJob entities.
+type PrintJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrintJobMutation
+}
+
+// Where appends a list predicates to the PrintJobUpdate builder.
+func (_u *PrintJobUpdate) Where(ps ...predicate.PrintJob) *PrintJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PrintJobUpdate) SetUpdatedAt(v time.Time) *PrintJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *PrintJobUpdate) SetGroupID(v uuid.UUID) *PrintJobUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableGroupID(v *uuid.UUID) *PrintJobUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetPrinterID sets the "printer_id" field.
+func (_u *PrintJobUpdate) SetPrinterID(v uuid.UUID) *PrintJobUpdate {
+	_u.mutation.SetPrinterID(v)
+	return _u
+}
+
+// SetNillablePrinterID sets the "printer_id" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillablePrinterID(v *uuid.UUID) *PrintJobUpdate {
+	if v != nil {
+		_u.SetPrinterID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PrintJobUpdate) SetName(v string) *PrintJobUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableName(v *string) *PrintJobUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PrintJobUpdate) SetStatus(v printjob.Status) *PrintJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableStatus(v *printjob.Status) *PrintJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *PrintJobUpdate) SetLabels(v int) *PrintJobUpdate {
+	_u.mutation.ResetLabels()
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// SetNillableLabels sets the "labels" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableLabels(v *int) *PrintJobUpdate {
+	if v != nil {
+		_u.SetLabels(*v)
+	}
+	return _u
+}
+
+// AddLabels adds value to the "labels" field.
+func (_u *PrintJobUpdate) AddLabels(v int) *PrintJobUpdate {
+	_u.mutation.AddLabels(v)
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *PrintJobUpdate) SetPayload(v string) *PrintJobUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillablePayload(v *string) *PrintJobUpdate {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *PrintJobUpdate) SetAttempts(v int) *PrintJobUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableAttempts(v *int) *PrintJobUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *PrintJobUpdate) AddAttempts(v int) *PrintJobUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *PrintJobUpdate) SetMaxAttempts(v int) *PrintJobUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableMaxAttempts(v *int) *PrintJobUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *PrintJobUpdate) AddMaxAttempts(v int) *PrintJobUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *PrintJobUpdate) SetError(v string) *PrintJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableError(v *string) *PrintJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *PrintJobUpdate) ClearError() *PrintJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetRunAt sets the "run_at" field.
+func (_u *PrintJobUpdate) SetRunAt(v time.Time) *PrintJobUpdate {
+	_u.mutation.SetRunAt(v)
+	return _u
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableRunAt(v *time.Time) *PrintJobUpdate {
+	if v != nil {
+		_u.SetRunAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *PrintJobUpdate) SetFinishedAt(v time.Time) *PrintJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableFinishedAt(v *time.Time) *PrintJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *PrintJobUpdate) ClearFinishedAt() *PrintJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PrintJobUpdate) SetCreatedBy(v uuid.UUID) *PrintJobUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PrintJobUpdate) SetNillableCreatedBy(v *uuid.UUID) *PrintJobUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PrintJobUpdate) ClearCreatedBy() *PrintJobUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *PrintJobUpdate) SetGroup(v *Group) *PrintJobUpdate {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetPrinter sets the "printer" edge to the Printer entity.
+func (_u *PrintJobUpdate) SetPrinter(v *Printer) *PrintJobUpdate {
+	return _u.SetPrinterID(v.ID)
+}
+
+// Mutation returns the PrintJobMutation object of the builder.
+func (_u *PrintJobUpdate) Mutation() *PrintJobMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *PrintJobUpdate) ClearGroup() *PrintJobUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearPrinter clears the "printer" edge to the Printer entity.
+func (_u *PrintJobUpdate) ClearPrinter() *PrintJobUpdate {
+	_u.mutation.ClearPrinter()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PrintJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrintJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PrintJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrintJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PrintJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := printjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PrintJobUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := printjob.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PrintJob.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := printjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PrintJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := printjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "PrintJob.error": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PrintJob.group"`)
+	}
+	if _u.mutation.PrinterCleared() && len(_u.mutation.PrinterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PrintJob.printer"`)
+	}
+	return nil
+}
+
+func (_u *PrintJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(printjob.Table, printjob.Columns, sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(printjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(printjob.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(printjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(printjob.FieldLabels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLabels(); ok {
+		_spec.AddField(printjob.FieldLabels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(printjob.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(printjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(printjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(printjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(printjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(printjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(printjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunAt(); ok {
+		_spec.SetField(printjob.FieldRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(printjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(printjob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(printjob.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(printjob.FieldCreatedBy, field.TypeUUID)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.GroupTable,
+			Columns: []string{printjob.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.GroupTable,
+			Columns: []string{printjob.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PrinterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.PrinterTable,
+			Columns: []string{printjob.PrinterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrinterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.PrinterTable,
+			Columns: []string{printjob.PrinterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{printjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PrintJobUpdateOne is the builder for updating a single PrintJob entity.
+type PrintJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrintJobMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PrintJobUpdateOne) SetUpdatedAt(v time.Time) *PrintJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *PrintJobUpdateOne) SetGroupID(v uuid.UUID) *PrintJobUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableGroupID(v *uuid.UUID) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetPrinterID sets the "printer_id" field.
+func (_u *PrintJobUpdateOne) SetPrinterID(v uuid.UUID) *PrintJobUpdateOne {
+	_u.mutation.SetPrinterID(v)
+	return _u
+}
+
+// SetNillablePrinterID sets the "printer_id" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillablePrinterID(v *uuid.UUID) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetPrinterID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PrintJobUpdateOne) SetName(v string) *PrintJobUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableName(v *string) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PrintJobUpdateOne) SetStatus(v printjob.Status) *PrintJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableStatus(v *printjob.Status) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *PrintJobUpdateOne) SetLabels(v int) *PrintJobUpdateOne {
+	_u.mutation.ResetLabels()
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// SetNillableLabels sets the "labels" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableLabels(v *int) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetLabels(*v)
+	}
+	return _u
+}
+
+// AddLabels adds value to the "labels" field.
+func (_u *PrintJobUpdateOne) AddLabels(v int) *PrintJobUpdateOne {
+	_u.mutation.AddLabels(v)
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *PrintJobUpdateOne) SetPayload(v string) *PrintJobUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillablePayload(v *string) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *PrintJobUpdateOne) SetAttempts(v int) *PrintJobUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableAttempts(v *int) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *PrintJobUpdateOne) AddAttempts(v int) *PrintJobUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *PrintJobUpdateOne) SetMaxAttempts(v int) *PrintJobUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableMaxAttempts(v *int) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *PrintJobUpdateOne) AddMaxAttempts(v int) *PrintJobUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *PrintJobUpdateOne) SetError(v string) *PrintJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableError(v *string) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *PrintJobUpdateOne) ClearError() *PrintJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetRunAt sets the "run_at" field.
+func (_u *PrintJobUpdateOne) SetRunAt(v time.Time) *PrintJobUpdateOne {
+	_u.mutation.SetRunAt(v)
+	return _u
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableRunAt(v *time.Time) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetRunAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *PrintJobUpdateOne) SetFinishedAt(v time.Time) *PrintJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableFinishedAt(v *time.Time) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *PrintJobUpdateOne) ClearFinishedAt() *PrintJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PrintJobUpdateOne) SetCreatedBy(v uuid.UUID) *PrintJobUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PrintJobUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *PrintJobUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PrintJobUpdateOne) ClearCreatedBy() *PrintJobUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *PrintJobUpdateOne) SetGroup(v *Group) *PrintJobUpdateOne {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetPrinter sets the "printer" edge to the Printer entity.
+func (_u *PrintJobUpdateOne) SetPrinter(v *Printer) *PrintJobUpdateOne {
+	return _u.SetPrinterID(v.ID)
+}
+
+// Mutation returns the PrintJobMutation object of the builder.
+func (_u *PrintJobUpdateOne) Mutation() *PrintJobMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *PrintJobUpdateOne) ClearGroup() *PrintJobUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearPrinter clears the "printer" edge to the Printer entity.
+func (_u *PrintJobUpdateOne) ClearPrinter() *PrintJobUpdateOne {
+	_u.mutation.ClearPrinter()
+	return _u
+}
+
+// Where appends a list predicates to the PrintJobUpdate builder.
+func (_u *PrintJobUpdateOne) Where(ps ...predicate.PrintJob) *PrintJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PrintJobUpdateOne) Select(field string, fields ...string) *PrintJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PrintJob entity.
+func (_u *PrintJobUpdateOne) Save(ctx context.Context) (*PrintJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrintJobUpdateOne) SaveX(ctx context.Context) *PrintJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PrintJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrintJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PrintJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := printjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PrintJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := printjob.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PrintJob.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := printjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PrintJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := printjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "PrintJob.error": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PrintJob.group"`)
+	}
+	if _u.mutation.PrinterCleared() && len(_u.mutation.PrinterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PrintJob.printer"`)
+	}
+	return nil
+}
+
+func (_u *PrintJobUpdateOne) sqlSave(ctx context.Context) (_node *PrintJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(printjob.Table, printjob.Columns, sqlgraph.NewFieldSpec(printjob.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PrintJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, printjob.FieldID)
+		for _, f := range fields {
+			if !printjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != printjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(printjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(printjob.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(printjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(printjob.FieldLabels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLabels(); ok {
+		_spec.AddField(printjob.FieldLabels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(printjob.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(printjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(printjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(printjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(printjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(printjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(printjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunAt(); ok {
+		_spec.SetField(printjob.FieldRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(printjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(printjob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(printjob.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(printjob.FieldCreatedBy, field.TypeUUID)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.GroupTable,
+			Columns: []string{printjob.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.GroupTable,
+			Columns: []string{printjob.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PrinterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.PrinterTable,
+			Columns: []string{printjob.PrinterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrinterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   printjob.PrinterTable,
+			Columns: []string{printjob.PrinterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(printer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PrintJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{printjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,20 +7,32 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/authtokens"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/fielddefinition"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/groupinvitationtoken"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemtemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/templatefield"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
@@ -64,6 +76,43 @@ func init() {
 	attachmentDescID := attachmentMixinFields0[0].Descriptor()
 	// attachment.DefaultID holds the default value on creation for the id field.
 	attachment.DefaultID = attachmentDescID.Default.(func() uuid.UUID)
+	auditentryMixin := schema.AuditEntry{}.Mixin()
+	auditentryMixinFields0 := auditentryMixin[0].Fields()
+	_ = auditentryMixinFields0
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryMixinFields0[1].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
+	// auditentryDescUpdatedAt is the schema descriptor for updated_at field.
+	auditentryDescUpdatedAt := auditentryMixinFields0[2].Descriptor()
+	// auditentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditentry.DefaultUpdatedAt = auditentryDescUpdatedAt.Default.(func() time.Time)
+	// auditentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditentry.UpdateDefaultUpdatedAt = auditentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditentryDescEntityType is the schema descriptor for entity_type field.
+	auditentryDescEntityType := auditentryFields[0].Descriptor()
+	// auditentry.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditentry.EntityTypeValidator = func() func(string) error {
+		validators := auditentryDescEntityType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(entity_type string) error {
+			for _, fn := range fns {
+				if err := fn(entity_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// auditentryDescID is the schema descriptor for id field.
+	auditentryDescID := auditentryMixinFields0[0].Descriptor()
+	// auditentry.DefaultID holds the default value on creation for the id field.
+	auditentry.DefaultID = auditentryDescID.Default.(func() uuid.UUID)
 	authrolesFields := schema.AuthRoles{}.Fields()
 	_ = authrolesFields
 	authtokensMixin := schema.AuthTokens{}.Mixin()
@@ -168,6 +217,49 @@ func init() {
 	borrowerDescID := borrowerMixinFields0[0].Descriptor()
 	// borrower.DefaultID holds the default value on creation for the id field.
 	borrower.DefaultID = borrowerDescID.Default.(func() uuid.UUID)
+	fielddefinitionMixin := schema.FieldDefinition{}.Mixin()
+	fielddefinitionMixinFields0 := fielddefinitionMixin[0].Fields()
+	_ = fielddefinitionMixinFields0
+	fielddefinitionMixinFields1 := fielddefinitionMixin[1].Fields()
+	_ = fielddefinitionMixinFields1
+	fielddefinitionFields := schema.FieldDefinition{}.Fields()
+	_ = fielddefinitionFields
+	// fielddefinitionDescCreatedAt is the schema descriptor for created_at field.
+	fielddefinitionDescCreatedAt := fielddefinitionMixinFields0[1].Descriptor()
+	// fielddefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fielddefinition.DefaultCreatedAt = fielddefinitionDescCreatedAt.Default.(func() time.Time)
+	// fielddefinitionDescUpdatedAt is the schema descriptor for updated_at field.
+	fielddefinitionDescUpdatedAt := fielddefinitionMixinFields0[2].Descriptor()
+	// fielddefinition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	fielddefinition.DefaultUpdatedAt = fielddefinitionDescUpdatedAt.Default.(func() time.Time)
+	// fielddefinition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	fielddefinition.UpdateDefaultUpdatedAt = fielddefinitionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// fielddefinitionDescName is the schema descriptor for name field.
+	fielddefinitionDescName := fielddefinitionMixinFields1[0].Descriptor()
+	// fielddefinition.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	fielddefinition.NameValidator = func() func(string) error {
+		validators := fielddefinitionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fielddefinitionDescDescription is the schema descriptor for description field.
+	fielddefinitionDescDescription := fielddefinitionMixinFields1[1].Descriptor()
+	// fielddefinition.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	fielddefinition.DescriptionValidator = fielddefinitionDescDescription.Validators[0].(func(string) error)
+	// fielddefinitionDescID is the schema descriptor for id field.
+	fielddefinitionDescID := fielddefinitionMixinFields0[0].Descriptor()
+	// fielddefinition.DefaultID holds the default value on creation for the id field.
+	fielddefinition.DefaultID = fielddefinitionDescID.Default.(func() uuid.UUID)
 	groupMixin := schema.Group{}.Mixin()
 	groupMixinFields0 := groupMixin[0].Fields()
 	_ = groupMixinFields0
@@ -205,6 +297,24 @@ func init() {
 	groupDescCurrency := groupFields[1].Descriptor()
 	// group.DefaultCurrency holds the default value on creation for the currency field.
 	group.DefaultCurrency = groupDescCurrency.Default.(string)
+	// groupDescAssetIDPrefix is the schema descriptor for asset_id_prefix field.
+	groupDescAssetIDPrefix := groupFields[2].Descriptor()
+	// group.DefaultAssetIDPrefix holds the default value on creation for the asset_id_prefix field.
+	group.DefaultAssetIDPrefix = groupDescAssetIDPrefix.Default.(string)
+	// group.AssetIDPrefixValidator is a validator for the "asset_id_prefix" field. It is called by the builders before save.
+	group.AssetIDPrefixValidator = groupDescAssetIDPrefix.Validators[0].(func(string) error)
+	// groupDescAssetIDWidth is the schema descriptor for asset_id_width field.
+	groupDescAssetIDWidth := groupFields[3].Descriptor()
+	// group.DefaultAssetIDWidth holds the default value on creation for the asset_id_width field.
+	group.DefaultAssetIDWidth = groupDescAssetIDWidth.Default.(int)
+	// group.AssetIDWidthValidator is a validator for the "asset_id_width" field. It is called by the builders before save.
+	group.AssetIDWidthValidator = groupDescAssetIDWidth.Validators[0].(func(int) error)
+	// groupDescAssetIDUncheckedMax is the schema descriptor for asset_id_unchecked_max field.
+	groupDescAssetIDUncheckedMax := groupFields[5].Descriptor()
+	// group.DefaultAssetIDUncheckedMax holds the default value on creation for the asset_id_unchecked_max field.
+	group.DefaultAssetIDUncheckedMax = groupDescAssetIDUncheckedMax.Default.(int)
+	// group.AssetIDUncheckedMaxValidator is a validator for the "asset_id_unchecked_max" field. It is called by the builders before save.
+	group.AssetIDUncheckedMaxValidator = groupDescAssetIDUncheckedMax.Validators[0].(func(int) error)
 	// groupDescID is the schema descriptor for id field.
 	groupDescID := groupMixinFields0[0].Descriptor()
 	// group.DefaultID holds the default value on creation for the id field.
@@ -299,42 +409,56 @@ func init() {
 	itemDescAssetID := itemFields[5].Descriptor()
 	// item.DefaultAssetID holds the default value on creation for the asset_id field.
 	item.DefaultAssetID = itemDescAssetID.Default.(int)
+	// itemDescAssetIDPrefix is the schema descriptor for asset_id_prefix field.
+	itemDescAssetIDPrefix := itemFields[6].Descriptor()
+	// item.DefaultAssetIDPrefix holds the default value on creation for the asset_id_prefix field.
+	item.DefaultAssetIDPrefix = itemDescAssetIDPrefix.Default.(string)
+	// item.AssetIDPrefixValidator is a validator for the "asset_id_prefix" field. It is called by the builders before save.
+	item.AssetIDPrefixValidator = itemDescAssetIDPrefix.Validators[0].(func(string) error)
 	// itemDescSyncChildItemsLocations is the schema descriptor for sync_child_items_locations field.
-	itemDescSyncChildItemsLocations := itemFields[6].Descriptor()
+	itemDescSyncChildItemsLocations := itemFields[7].Descriptor()
 	// item.DefaultSyncChildItemsLocations holds the default value on creation for the sync_child_items_locations field.
 	item.DefaultSyncChildItemsLocations = itemDescSyncChildItemsLocations.Default.(bool)
 	// itemDescSerialNumber is the schema descriptor for serial_number field.
-	itemDescSerialNumber := itemFields[7].Descriptor()
+	itemDescSerialNumber := itemFields[9].Descriptor()
 	// item.SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
 	item.SerialNumberValidator = itemDescSerialNumber.Validators[0].(func(string) error)
 	// itemDescModelNumber is the schema descriptor for model_number field.
-	itemDescModelNumber := itemFields[8].Descriptor()
+	itemDescModelNumber := itemFields[10].Descriptor()
 	// item.ModelNumberValidator is a validator for the "model_number" field. It is called by the builders before save.
 	item.ModelNumberValidator = itemDescModelNumber.Validators[0].(func(string) error)
 	// itemDescManufacturer is the schema descriptor for manufacturer field.
-	itemDescManufacturer := itemFields[9].Descriptor()
+	itemDescManufacturer := itemFields[11].Descriptor()
 	// item.ManufacturerValidator is a validator for the "manufacturer" field. It is called by the builders before save.
 	item.ManufacturerValidator = itemDescManufacturer.Validators[0].(func(string) error)
 	// itemDescLifetimeWarranty is the schema descriptor for lifetime_warranty field.
-	itemDescLifetimeWarranty := itemFields[10].Descriptor()
+	itemDescLifetimeWarranty := itemFields[12].Descriptor()
 	// item.DefaultLifetimeWarranty holds the default value on creation for the lifetime_warranty field.
 	item.DefaultLifetimeWarranty = itemDescLifetimeWarranty.Default.(bool)
 	// itemDescWarrantyDetails is the schema descriptor for warranty_details field.
-	itemDescWarrantyDetails := itemFields[12].Descriptor()
+	itemDescWarrantyDetails := itemFields[14].Descriptor()
 	// item.WarrantyDetailsValidator is a validator for the "warranty_details" field. It is called by the builders before save.
 	item.WarrantyDetailsValidator = itemDescWarrantyDetails.Validators[0].(func(string) error)
 	// itemDescPurchasePrice is the schema descriptor for purchase_price field.
-	itemDescPurchasePrice := itemFields[15].Descriptor()
+	itemDescPurchasePrice := itemFields[17].Descriptor()
 	// item.DefaultPurchasePrice holds the default value on creation for the purchase_price field.
 	item.DefaultPurchasePrice = itemDescPurchasePrice.Default.(float64)
 	// itemDescSoldPrice is the schema descriptor for sold_price field.
-	itemDescSoldPrice := itemFields[18].Descriptor()
+	itemDescSoldPrice := itemFields[20].Descriptor()
 	// item.DefaultSoldPrice holds the default value on creation for the sold_price field.
 	item.DefaultSoldPrice = itemDescSoldPrice.Default.(float64)
 	// itemDescSoldNotes is the schema descriptor for sold_notes field.
-	itemDescSoldNotes := itemFields[19].Descriptor()
+	itemDescSoldNotes := itemFields[21].Descriptor()
 	// item.SoldNotesValidator is a validator for the "sold_notes" field. It is called by the builders before save.
 	item.SoldNotesValidator = itemDescSoldNotes.Validators[0].(func(string) error)
+	// itemDescMinStock is the schema descriptor for min_stock field.
+	itemDescMinStock := itemFields[24].Descriptor()
+	// item.DefaultMinStock holds the default value on creation for the min_stock field.
+	item.DefaultMinStock = itemDescMinStock.Default.(int)
+	// itemDescReorderQuantity is the schema descriptor for reorder_quantity field.
+	itemDescReorderQuantity := itemFields[25].Descriptor()
+	// item.DefaultReorderQuantity holds the default value on creation for the reorder_quantity field.
+	item.DefaultReorderQuantity = itemDescReorderQuantity.Default.(int)
 	// itemDescID is the schema descriptor for id field.
 	itemDescID := itemMixinFields0[0].Descriptor()
 	// item.DefaultID holds the default value on creation for the id field.
@@ -394,6 +518,70 @@ func init() {
 	itemfieldDescID := itemfieldMixinFields0[0].Descriptor()
 	// itemfield.DefaultID holds the default value on creation for the id field.
 	itemfield.DefaultID = itemfieldDescID.Default.(func() uuid.UUID)
+	itemidentifierMixin := schema.ItemIdentifier{}.Mixin()
+	itemidentifierMixinFields0 := itemidentifierMixin[0].Fields()
+	_ = itemidentifierMixinFields0
+	itemidentifierFields := schema.ItemIdentifier{}.Fields()
+	_ = itemidentifierFields
+	// itemidentifierDescCreatedAt is the schema descriptor for created_at field.
+	itemidentifierDescCreatedAt := itemidentifierMixinFields0[1].Descriptor()
+	// itemidentifier.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemidentifier.DefaultCreatedAt = itemidentifierDescCreatedAt.Default.(func() time.Time)
+	// itemidentifierDescUpdatedAt is the schema descriptor for updated_at field.
+	itemidentifierDescUpdatedAt := itemidentifierMixinFields0[2].Descriptor()
+	// itemidentifier.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	itemidentifier.DefaultUpdatedAt = itemidentifierDescUpdatedAt.Default.(func() time.Time)
+	// itemidentifier.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	itemidentifier.UpdateDefaultUpdatedAt = itemidentifierDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// itemidentifierDescValue is the schema descriptor for value field.
+	itemidentifierDescValue := itemidentifierFields[2].Descriptor()
+	// itemidentifier.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	itemidentifier.ValueValidator = func() func(string) error {
+		validators := itemidentifierDescValue.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(value string) error {
+			for _, fn := range fns {
+				if err := fn(value); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// itemidentifierDescNormalizedValue is the schema descriptor for normalized_value field.
+	itemidentifierDescNormalizedValue := itemidentifierFields[3].Descriptor()
+	// itemidentifier.NormalizedValueValidator is a validator for the "normalized_value" field. It is called by the builders before save.
+	itemidentifier.NormalizedValueValidator = itemidentifierDescNormalizedValue.Validators[0].(func(string) error)
+	// itemidentifierDescID is the schema descriptor for id field.
+	itemidentifierDescID := itemidentifierMixinFields0[0].Descriptor()
+	// itemidentifier.DefaultID holds the default value on creation for the id field.
+	itemidentifier.DefaultID = itemidentifierDescID.Default.(func() uuid.UUID)
+	itemstatuschangeMixin := schema.ItemStatusChange{}.Mixin()
+	itemstatuschangeMixinFields0 := itemstatuschangeMixin[0].Fields()
+	_ = itemstatuschangeMixinFields0
+	itemstatuschangeFields := schema.ItemStatusChange{}.Fields()
+	_ = itemstatuschangeFields
+	// itemstatuschangeDescCreatedAt is the schema descriptor for created_at field.
+	itemstatuschangeDescCreatedAt := itemstatuschangeMixinFields0[1].Descriptor()
+	// itemstatuschange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemstatuschange.DefaultCreatedAt = itemstatuschangeDescCreatedAt.Default.(func() time.Time)
+	// itemstatuschangeDescUpdatedAt is the schema descriptor for updated_at field.
+	itemstatuschangeDescUpdatedAt := itemstatuschangeMixinFields0[2].Descriptor()
+	// itemstatuschange.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	itemstatuschange.DefaultUpdatedAt = itemstatuschangeDescUpdatedAt.Default.(func() time.Time)
+	// itemstatuschange.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	itemstatuschange.UpdateDefaultUpdatedAt = itemstatuschangeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// itemstatuschangeDescReason is the schema descriptor for reason field.
+	itemstatuschangeDescReason := itemstatuschangeFields[3].Descriptor()
+	// itemstatuschange.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	itemstatuschange.ReasonValidator = itemstatuschangeDescReason.Validators[0].(func(string) error)
+	// itemstatuschangeDescID is the schema descriptor for id field.
+	itemstatuschangeDescID := itemstatuschangeMixinFields0[0].Descriptor()
+	// itemstatuschange.DefaultID holds the default value on creation for the id field.
+	itemstatuschange.DefaultID = itemstatuschangeDescID.Default.(func() uuid.UUID)
 	itemtemplateMixin := schema.ItemTemplate{}.Mixin()
 	itemtemplateMixinFields0 := itemtemplateMixin[0].Fields()
 	_ = itemtemplateMixinFields0
@@ -504,10 +692,73 @@ func init() {
 	kiosksessionDescIsActive := kiosksessionFields[0].Descriptor()
 	// kiosksession.DefaultIsActive holds the default value on creation for the is_active field.
 	kiosksession.DefaultIsActive = kiosksessionDescIsActive.Default.(bool)
+	// kiosksessionDescAppVersion is the schema descriptor for app_version field.
+	kiosksessionDescAppVersion := kiosksessionFields[4].Descriptor()
+	// kiosksession.AppVersionValidator is a validator for the "app_version" field. It is called by the builders before save.
+	kiosksession.AppVersionValidator = kiosksessionDescAppVersion.Validators[0].(func(string) error)
+	// kiosksessionDescBatteryLevel is the schema descriptor for battery_level field.
+	kiosksessionDescBatteryLevel := kiosksessionFields[5].Descriptor()
+	// kiosksession.BatteryLevelValidator is a validator for the "battery_level" field. It is called by the builders before save.
+	kiosksession.BatteryLevelValidator = func() func(int) error {
+		validators := kiosksessionDescBatteryLevel.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(battery_level int) error {
+			for _, fn := range fns {
+				if err := fn(battery_level); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// kiosksessionDescNetworkType is the schema descriptor for network_type field.
+	kiosksessionDescNetworkType := kiosksessionFields[7].Descriptor()
+	// kiosksession.NetworkTypeValidator is a validator for the "network_type" field. It is called by the builders before save.
+	kiosksession.NetworkTypeValidator = kiosksessionDescNetworkType.Validators[0].(func(string) error)
 	// kiosksessionDescID is the schema descriptor for id field.
 	kiosksessionDescID := kiosksessionMixinFields0[0].Descriptor()
 	// kiosksession.DefaultID holds the default value on creation for the id field.
 	kiosksession.DefaultID = kiosksessionDescID.Default.(func() uuid.UUID)
+	kiosksyncactionMixin := schema.KioskSyncAction{}.Mixin()
+	kiosksyncactionMixinFields0 := kiosksyncactionMixin[0].Fields()
+	_ = kiosksyncactionMixinFields0
+	kiosksyncactionFields := schema.KioskSyncAction{}.Fields()
+	_ = kiosksyncactionFields
+	// kiosksyncactionDescCreatedAt is the schema descriptor for created_at field.
+	kiosksyncactionDescCreatedAt := kiosksyncactionMixinFields0[1].Descriptor()
+	// kiosksyncaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	kiosksyncaction.DefaultCreatedAt = kiosksyncactionDescCreatedAt.Default.(func() time.Time)
+	// kiosksyncactionDescUpdatedAt is the schema descriptor for updated_at field.
+	kiosksyncactionDescUpdatedAt := kiosksyncactionMixinFields0[2].Descriptor()
+	// kiosksyncaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	kiosksyncaction.DefaultUpdatedAt = kiosksyncactionDescUpdatedAt.Default.(func() time.Time)
+	// kiosksyncaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	kiosksyncaction.UpdateDefaultUpdatedAt = kiosksyncactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// kiosksyncactionDescIdempotencyKey is the schema descriptor for idempotency_key field.
+	kiosksyncactionDescIdempotencyKey := kiosksyncactionFields[0].Descriptor()
+	// kiosksyncaction.IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	kiosksyncaction.IdempotencyKeyValidator = func() func(string) error {
+		validators := kiosksyncactionDescIdempotencyKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(idempotency_key string) error {
+			for _, fn := range fns {
+				if err := fn(idempotency_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// kiosksyncactionDescID is the schema descriptor for id field.
+	kiosksyncactionDescID := kiosksyncactionMixinFields0[0].Descriptor()
+	// kiosksyncaction.DefaultID holds the default value on creation for the id field.
+	kiosksyncaction.DefaultID = kiosksyncactionDescID.Default.(func() uuid.UUID)
 	labelMixin := schema.Label{}.Mixin()
 	labelMixinFields0 := labelMixin[0].Fields()
 	_ = labelMixinFields0
@@ -551,10 +802,67 @@ func init() {
 	labelDescColor := labelFields[0].Descriptor()
 	// label.ColorValidator is a validator for the "color" field. It is called by the builders before save.
 	label.ColorValidator = labelDescColor.Validators[0].(func(string) error)
+	// labelDescQuarantineOnReturn is the schema descriptor for quarantine_on_return field.
+	labelDescQuarantineOnReturn := labelFields[1].Descriptor()
+	// label.DefaultQuarantineOnReturn holds the default value on creation for the quarantine_on_return field.
+	label.DefaultQuarantineOnReturn = labelDescQuarantineOnReturn.Default.(bool)
+	// labelDescQuarantineMinutes is the schema descriptor for quarantine_minutes field.
+	labelDescQuarantineMinutes := labelFields[2].Descriptor()
+	// label.DefaultQuarantineMinutes holds the default value on creation for the quarantine_minutes field.
+	label.DefaultQuarantineMinutes = labelDescQuarantineMinutes.Default.(int)
+	// label.QuarantineMinutesValidator is a validator for the "quarantine_minutes" field. It is called by the builders before save.
+	label.QuarantineMinutesValidator = labelDescQuarantineMinutes.Validators[0].(func(int) error)
+	// labelDescAssetIDPrefix is the schema descriptor for asset_id_prefix field.
+	labelDescAssetIDPrefix := labelFields[3].Descriptor()
+	// label.AssetIDPrefixValidator is a validator for the "asset_id_prefix" field. It is called by the builders before save.
+	label.AssetIDPrefixValidator = labelDescAssetIDPrefix.Validators[0].(func(string) error)
 	// labelDescID is the schema descriptor for id field.
 	labelDescID := labelMixinFields0[0].Descriptor()
 	// label.DefaultID holds the default value on creation for the id field.
 	label.DefaultID = labelDescID.Default.(func() uuid.UUID)
+	labeltemplateMixin := schema.LabelTemplate{}.Mixin()
+	labeltemplateMixinFields0 := labeltemplateMixin[0].Fields()
+	_ = labeltemplateMixinFields0
+	labeltemplateMixinFields1 := labeltemplateMixin[1].Fields()
+	_ = labeltemplateMixinFields1
+	labeltemplateFields := schema.LabelTemplate{}.Fields()
+	_ = labeltemplateFields
+	// labeltemplateDescCreatedAt is the schema descriptor for created_at field.
+	labeltemplateDescCreatedAt := labeltemplateMixinFields0[1].Descriptor()
+	// labeltemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	labeltemplate.DefaultCreatedAt = labeltemplateDescCreatedAt.Default.(func() time.Time)
+	// labeltemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	labeltemplateDescUpdatedAt := labeltemplateMixinFields0[2].Descriptor()
+	// labeltemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	labeltemplate.DefaultUpdatedAt = labeltemplateDescUpdatedAt.Default.(func() time.Time)
+	// labeltemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	labeltemplate.UpdateDefaultUpdatedAt = labeltemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// labeltemplateDescName is the schema descriptor for name field.
+	labeltemplateDescName := labeltemplateMixinFields1[0].Descriptor()
+	// labeltemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	labeltemplate.NameValidator = func() func(string) error {
+		validators := labeltemplateDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// labeltemplateDescDescription is the schema descriptor for description field.
+	labeltemplateDescDescription := labeltemplateMixinFields1[1].Descriptor()
+	// labeltemplate.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	labeltemplate.DescriptionValidator = labeltemplateDescDescription.Validators[0].(func(string) error)
+	// labeltemplateDescID is the schema descriptor for id field.
+	labeltemplateDescID := labeltemplateMixinFields0[0].Descriptor()
+	// labeltemplate.DefaultID holds the default value on creation for the id field.
+	labeltemplate.DefaultID = labeltemplateDescID.Default.(func() uuid.UUID)
 	loanMixin := schema.Loan{}.Mixin()
 	loanMixinFields0 := loanMixin[0].Fields()
 	_ = loanMixinFields0
@@ -592,6 +900,10 @@ func init() {
 	loanDescKioskAction := loanFields[6].Descriptor()
 	// loan.DefaultKioskAction holds the default value on creation for the kiosk_action field.
 	loan.DefaultKioskAction = loanDescKioskAction.Default.(bool)
+	// loanDescTransfer is the schema descriptor for transfer field.
+	loanDescTransfer := loanFields[7].Descriptor()
+	// loan.DefaultTransfer holds the default value on creation for the transfer field.
+	loan.DefaultTransfer = loanDescTransfer.Default.(bool)
 	// loanDescID is the schema descriptor for id field.
 	loanDescID := loanMixinFields0[0].Descriptor()
 	// loan.DefaultID holds the default value on creation for the id field.
@@ -743,6 +1055,242 @@ func init() {
 	notifierDescID := notifierMixinFields0[0].Descriptor()
 	// notifier.DefaultID holds the default value on creation for the id field.
 	notifier.DefaultID = notifierDescID.Default.(func() uuid.UUID)
+	printjobMixin := schema.PrintJob{}.Mixin()
+	printjobMixinFields0 := printjobMixin[0].Fields()
+	_ = printjobMixinFields0
+	printjobFields := schema.PrintJob{}.Fields()
+	_ = printjobFields
+	// printjobDescCreatedAt is the schema descriptor for created_at field.
+	printjobDescCreatedAt := printjobMixinFields0[1].Descriptor()
+	// printjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	printjob.DefaultCreatedAt = printjobDescCreatedAt.Default.(func() time.Time)
+	// printjobDescUpdatedAt is the schema descriptor for updated_at field.
+	printjobDescUpdatedAt := printjobMixinFields0[2].Descriptor()
+	// printjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	printjob.DefaultUpdatedAt = printjobDescUpdatedAt.Default.(func() time.Time)
+	// printjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	printjob.UpdateDefaultUpdatedAt = printjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// printjobDescName is the schema descriptor for name field.
+	printjobDescName := printjobFields[1].Descriptor()
+	// printjob.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	printjob.NameValidator = printjobDescName.Validators[0].(func(string) error)
+	// printjobDescLabels is the schema descriptor for labels field.
+	printjobDescLabels := printjobFields[3].Descriptor()
+	// printjob.DefaultLabels holds the default value on creation for the labels field.
+	printjob.DefaultLabels = printjobDescLabels.Default.(int)
+	// printjobDescAttempts is the schema descriptor for attempts field.
+	printjobDescAttempts := printjobFields[5].Descriptor()
+	// printjob.DefaultAttempts holds the default value on creation for the attempts field.
+	printjob.DefaultAttempts = printjobDescAttempts.Default.(int)
+	// printjobDescMaxAttempts is the schema descriptor for max_attempts field.
+	printjobDescMaxAttempts := printjobFields[6].Descriptor()
+	// printjob.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	printjob.DefaultMaxAttempts = printjobDescMaxAttempts.Default.(int)
+	// printjobDescError is the schema descriptor for error field.
+	printjobDescError := printjobFields[7].Descriptor()
+	// printjob.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	printjob.ErrorValidator = printjobDescError.Validators[0].(func(string) error)
+	// printjobDescRunAt is the schema descriptor for run_at field.
+	printjobDescRunAt := printjobFields[8].Descriptor()
+	// printjob.DefaultRunAt holds the default value on creation for the run_at field.
+	printjob.DefaultRunAt = printjobDescRunAt.Default.(func() time.Time)
+	// printjobDescID is the schema descriptor for id field.
+	printjobDescID := printjobMixinFields0[0].Descriptor()
+	// printjob.DefaultID holds the default value on creation for the id field.
+	printjob.DefaultID = printjobDescID.Default.(func() uuid.UUID)
+	printerMixin := schema.Printer{}.Mixin()
+	printerMixinFields0 := printerMixin[0].Fields()
+	_ = printerMixinFields0
+	printerMixinFields1 := printerMixin[1].Fields()
+	_ = printerMixinFields1
+	printerFields := schema.Printer{}.Fields()
+	_ = printerFields
+	// printerDescCreatedAt is the schema descriptor for created_at field.
+	printerDescCreatedAt := printerMixinFields0[1].Descriptor()
+	// printer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	printer.DefaultCreatedAt = printerDescCreatedAt.Default.(func() time.Time)
+	// printerDescUpdatedAt is the schema descriptor for updated_at field.
+	printerDescUpdatedAt := printerMixinFields0[2].Descriptor()
+	// printer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	printer.DefaultUpdatedAt = printerDescUpdatedAt.Default.(func() time.Time)
+	// printer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	printer.UpdateDefaultUpdatedAt = printerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// printerDescName is the schema descriptor for name field.
+	printerDescName := printerMixinFields1[0].Descriptor()
+	// printer.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	printer.NameValidator = func() func(string) error {
+		validators := printerDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// printerDescDescription is the schema descriptor for description field.
+	printerDescDescription := printerMixinFields1[1].Descriptor()
+	// printer.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	printer.DescriptionValidator = printerDescDescription.Validators[0].(func(string) error)
+	// printerDescAddress is the schema descriptor for address field.
+	printerDescAddress := printerFields[1].Descriptor()
+	// printer.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	printer.AddressValidator = printerDescAddress.Validators[0].(func(string) error)
+	// printerDescDpi is the schema descriptor for dpi field.
+	printerDescDpi := printerFields[3].Descriptor()
+	// printer.DefaultDpi holds the default value on creation for the dpi field.
+	printer.DefaultDpi = printerDescDpi.Default.(int)
+	// printerDescID is the schema descriptor for id field.
+	printerDescID := printerMixinFields0[0].Descriptor()
+	// printer.DefaultID holds the default value on creation for the id field.
+	printer.DefaultID = printerDescID.Default.(func() uuid.UUID)
+	savedsearchMixin := schema.SavedSearch{}.Mixin()
+	savedsearchMixinFields0 := savedsearchMixin[0].Fields()
+	_ = savedsearchMixinFields0
+	savedsearchMixinFields1 := savedsearchMixin[1].Fields()
+	_ = savedsearchMixinFields1
+	savedsearchFields := schema.SavedSearch{}.Fields()
+	_ = savedsearchFields
+	// savedsearchDescCreatedAt is the schema descriptor for created_at field.
+	savedsearchDescCreatedAt := savedsearchMixinFields0[1].Descriptor()
+	// savedsearch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedsearch.DefaultCreatedAt = savedsearchDescCreatedAt.Default.(func() time.Time)
+	// savedsearchDescUpdatedAt is the schema descriptor for updated_at field.
+	savedsearchDescUpdatedAt := savedsearchMixinFields0[2].Descriptor()
+	// savedsearch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	savedsearch.DefaultUpdatedAt = savedsearchDescUpdatedAt.Default.(func() time.Time)
+	// savedsearch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	savedsearch.UpdateDefaultUpdatedAt = savedsearchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// savedsearchDescName is the schema descriptor for name field.
+	savedsearchDescName := savedsearchMixinFields1[0].Descriptor()
+	// savedsearch.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	savedsearch.NameValidator = func() func(string) error {
+		validators := savedsearchDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// savedsearchDescDescription is the schema descriptor for description field.
+	savedsearchDescDescription := savedsearchMixinFields1[1].Descriptor()
+	// savedsearch.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	savedsearch.DescriptionValidator = savedsearchDescDescription.Validators[0].(func(string) error)
+	// savedsearchDescShared is the schema descriptor for shared field.
+	savedsearchDescShared := savedsearchFields[1].Descriptor()
+	// savedsearch.DefaultShared holds the default value on creation for the shared field.
+	savedsearch.DefaultShared = savedsearchDescShared.Default.(bool)
+	// savedsearchDescID is the schema descriptor for id field.
+	savedsearchDescID := savedsearchMixinFields0[0].Descriptor()
+	// savedsearch.DefaultID holds the default value on creation for the id field.
+	savedsearch.DefaultID = savedsearchDescID.Default.(func() uuid.UUID)
+	stockmovementMixin := schema.StockMovement{}.Mixin()
+	stockmovementMixinFields0 := stockmovementMixin[0].Fields()
+	_ = stockmovementMixinFields0
+	stockmovementFields := schema.StockMovement{}.Fields()
+	_ = stockmovementFields
+	// stockmovementDescCreatedAt is the schema descriptor for created_at field.
+	stockmovementDescCreatedAt := stockmovementMixinFields0[1].Descriptor()
+	// stockmovement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stockmovement.DefaultCreatedAt = stockmovementDescCreatedAt.Default.(func() time.Time)
+	// stockmovementDescUpdatedAt is the schema descriptor for updated_at field.
+	stockmovementDescUpdatedAt := stockmovementMixinFields0[2].Descriptor()
+	// stockmovement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	stockmovement.DefaultUpdatedAt = stockmovementDescUpdatedAt.Default.(func() time.Time)
+	// stockmovement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	stockmovement.UpdateDefaultUpdatedAt = stockmovementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// stockmovementDescNote is the schema descriptor for note field.
+	stockmovementDescNote := stockmovementFields[5].Descriptor()
+	// stockmovement.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	stockmovement.NoteValidator = stockmovementDescNote.Validators[0].(func(string) error)
+	// stockmovementDescID is the schema descriptor for id field.
+	stockmovementDescID := stockmovementMixinFields0[0].Descriptor()
+	// stockmovement.DefaultID holds the default value on creation for the id field.
+	stockmovement.DefaultID = stockmovementDescID.Default.(func() uuid.UUID)
+	stocktakeentryMixin := schema.StocktakeEntry{}.Mixin()
+	stocktakeentryMixinFields0 := stocktakeentryMixin[0].Fields()
+	_ = stocktakeentryMixinFields0
+	stocktakeentryFields := schema.StocktakeEntry{}.Fields()
+	_ = stocktakeentryFields
+	// stocktakeentryDescCreatedAt is the schema descriptor for created_at field.
+	stocktakeentryDescCreatedAt := stocktakeentryMixinFields0[1].Descriptor()
+	// stocktakeentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stocktakeentry.DefaultCreatedAt = stocktakeentryDescCreatedAt.Default.(func() time.Time)
+	// stocktakeentryDescUpdatedAt is the schema descriptor for updated_at field.
+	stocktakeentryDescUpdatedAt := stocktakeentryMixinFields0[2].Descriptor()
+	// stocktakeentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	stocktakeentry.DefaultUpdatedAt = stocktakeentryDescUpdatedAt.Default.(func() time.Time)
+	// stocktakeentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	stocktakeentry.UpdateDefaultUpdatedAt = stocktakeentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// stocktakeentryDescValue is the schema descriptor for value field.
+	stocktakeentryDescValue := stocktakeentryFields[2].Descriptor()
+	// stocktakeentry.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	stocktakeentry.ValueValidator = stocktakeentryDescValue.Validators[0].(func(string) error)
+	// stocktakeentryDescExpected is the schema descriptor for expected field.
+	stocktakeentryDescExpected := stocktakeentryFields[3].Descriptor()
+	// stocktakeentry.DefaultExpected holds the default value on creation for the expected field.
+	stocktakeentry.DefaultExpected = stocktakeentryDescExpected.Default.(bool)
+	// stocktakeentryDescOnLoan is the schema descriptor for on_loan field.
+	stocktakeentryDescOnLoan := stocktakeentryFields[8].Descriptor()
+	// stocktakeentry.DefaultOnLoan holds the default value on creation for the on_loan field.
+	stocktakeentry.DefaultOnLoan = stocktakeentryDescOnLoan.Default.(bool)
+	// stocktakeentryDescID is the schema descriptor for id field.
+	stocktakeentryDescID := stocktakeentryMixinFields0[0].Descriptor()
+	// stocktakeentry.DefaultID holds the default value on creation for the id field.
+	stocktakeentry.DefaultID = stocktakeentryDescID.Default.(func() uuid.UUID)
+	stocktakesessionMixin := schema.StocktakeSession{}.Mixin()
+	stocktakesessionMixinFields0 := stocktakesessionMixin[0].Fields()
+	_ = stocktakesessionMixinFields0
+	stocktakesessionFields := schema.StocktakeSession{}.Fields()
+	_ = stocktakesessionFields
+	// stocktakesessionDescCreatedAt is the schema descriptor for created_at field.
+	stocktakesessionDescCreatedAt := stocktakesessionMixinFields0[1].Descriptor()
+	// stocktakesession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stocktakesession.DefaultCreatedAt = stocktakesessionDescCreatedAt.Default.(func() time.Time)
+	// stocktakesessionDescUpdatedAt is the schema descriptor for updated_at field.
+	stocktakesessionDescUpdatedAt := stocktakesessionMixinFields0[2].Descriptor()
+	// stocktakesession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	stocktakesession.DefaultUpdatedAt = stocktakesessionDescUpdatedAt.Default.(func() time.Time)
+	// stocktakesession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	stocktakesession.UpdateDefaultUpdatedAt = stocktakesessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// stocktakesessionDescName is the schema descriptor for name field.
+	stocktakesessionDescName := stocktakesessionFields[0].Descriptor()
+	// stocktakesession.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	stocktakesession.NameValidator = func() func(string) error {
+		validators := stocktakesessionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// stocktakesessionDescNotes is the schema descriptor for notes field.
+	stocktakesessionDescNotes := stocktakesessionFields[1].Descriptor()
+	// stocktakesession.NotesValidator is a validator for the "notes" field. It is called by the builders before save.
+	stocktakesession.NotesValidator = stocktakesessionDescNotes.Validators[0].(func(string) error)
+	// stocktakesessionDescID is the schema descriptor for id field.
+	stocktakesessionDescID := stocktakesessionMixinFields0[0].Descriptor()
+	// stocktakesession.DefaultID holds the default value on creation for the id field.
+	stocktakesession.DefaultID = stocktakesessionDescID.Default.(func() uuid.UUID)
 	templatefieldMixin := schema.TemplateField{}.Mixin()
 	templatefieldMixinFields0 := templatefieldMixin[0].Fields()
 	_ = templatefieldMixinFields0
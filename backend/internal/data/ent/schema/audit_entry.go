@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// AuditEntry holds the schema definition for the AuditEntry entity.
+// An AuditEntry records a single create, update or delete of an audited entity
+// together with who made it and the before/after values of the changed fields.
+type AuditEntry struct {
+	ent.Schema
+}
+
+func (AuditEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "audit_entries",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the AuditEntry.
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entity_type").
+			NotEmpty().
+			MaxLen(64),
+		field.UUID("entity_id", uuid.UUID{}),
+		field.Enum("action").
+			Values("create", "update", "delete"),
+		field.Enum("source").
+			Values("api", "kiosk", "import", "system").
+			Default("system"),
+		// Not an edge so that the history outlives the user
+		field.UUID("actor_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Text("changes").
+			Comment("JSON encoded map of field name to old and new value"),
+	}
+}
+
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "created_at"),
+		index.Fields("entity_type", "entity_id"),
+	}
+}
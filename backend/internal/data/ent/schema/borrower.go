@@ -27,6 +27,8 @@ func (Borrower) Indexes() []ent.Index {
 		index.Fields("name"),
 		index.Fields("email"),
 		index.Fields("is_active"),
+		index.Fields("verification_status"),
+		index.Fields("verification_token"),
 	}
 }
 
@@ -63,6 +65,27 @@ func (Borrower) Fields() []ent.Field {
 		field.Bool("self_registered").
 			Default(false).
 			Comment("Whether borrower registered themselves via kiosk self-service"),
+		field.Enum("verification_status").
+			Values("verified", "pending_email", "pending_approval", "rejected").
+			Default("verified").
+			Comment("Verification state of self-registered borrowers (only verified borrowers can check out)"),
+		field.Bytes("verification_token").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("Hash of the email confirmation token"),
+		field.Time("verification_expires_at").
+			Optional().
+			Nillable().
+			Comment("When the email confirmation token expires"),
+		field.Time("email_verified_at").
+			Optional().
+			Nillable().
+			Comment("When the borrower confirmed their email address"),
+		field.Time("approved_at").
+			Optional().
+			Nillable().
+			Comment("When a staff member approved the borrower"),
 	}
 }
 
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// FieldDefinition holds the schema definition for the FieldDefinition entity.
+// A FieldDefinition declares the type, and for select fields the allowed options,
+// of the custom fields with its name across the group's items and templates.
+type FieldDefinition struct {
+	ent.Schema
+}
+
+func (FieldDefinition) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.DetailsMixin{},
+		GroupMixin{
+			ref:   "field_definitions",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the FieldDefinition.
+func (FieldDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("text", "number", "decimal", "boolean", "time", "select", "multiselect", "url"),
+		field.JSON("options", []string{}).
+			Optional().
+			Comment("Allowed values of select and multiselect fields"),
+	}
+}
+
+func (FieldDefinition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "name").
+			Unique(),
+	}
+}
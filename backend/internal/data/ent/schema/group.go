@@ -29,6 +29,22 @@ func (Group) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("currency").
 			Default("usd"),
+		field.String("asset_id_prefix").
+			MaxLen(32).
+			Default("").
+			Comment("Prefix of the asset IDs, e.g. HB-"),
+		field.Int("asset_id_width").
+			Default(0).
+			NonNegative().
+			Comment("Digits the asset IDs are zero padded to (0 = 000-000)"),
+		field.Enum("asset_id_check_digit").
+			Values("none", "luhn", "mod11").
+			Default("none").
+			Comment("Check digit appended to asset IDs so that mis-scans are rejected"),
+		field.Int("asset_id_unchecked_max").
+			Default(0).
+			NonNegative().
+			Comment("Highest asset ID when check digits were turned on, labels of those may lack one"),
 	}
 }
 
@@ -51,6 +67,17 @@ func (Group) Edges() []ent.Edge {
 		owned("item_templates", ItemTemplate.Type),
 		owned("borrowers", Borrower.Type),
 		owned("loans", Loan.Type),
+		owned("kiosk_sync_actions", KioskSyncAction.Type),
+		owned("saved_searches", SavedSearch.Type),
+		owned("audit_entries", AuditEntry.Type),
+		owned("field_definitions", FieldDefinition.Type),
+		owned("stock_movements", StockMovement.Type),
+		owned("item_identifiers", ItemIdentifier.Type),
+		owned("item_status_changes", ItemStatusChange.Type),
+		owned("stocktake_sessions", StocktakeSession.Type),
+		owned("label_templates", LabelTemplate.Type),
+		owned("printers", Printer.Type),
+		owned("print_jobs", PrintJob.Type),
 		// $scaffold_edge
 	}
 }
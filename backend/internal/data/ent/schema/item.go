@@ -18,6 +18,7 @@ func (Item) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
 		mixins.DetailsMixin{},
+		mixins.SoftDeleteMixin{},
 		GroupMixin{ref: "items"},
 	}
 }
@@ -31,6 +32,8 @@ func (Item) Indexes() []ent.Index {
 		index.Fields("serial_number"),
 		index.Fields("archived"),
 		index.Fields("asset_id"),
+		index.Fields("quarantined_at"),
+		index.Fields("status"),
 	}
 }
 
@@ -51,8 +54,16 @@ func (Item) Fields() []ent.Field {
 			Default(false),
 		field.Int("asset_id").
 			Default(0),
+		field.String("asset_id_prefix").
+			MaxLen(32).
+			Default("").
+			Comment("Prefix of the series the asset ID is numbered in, empty for the group's"),
 		field.Bool("sync_child_items_locations").
 			Default(false),
+		field.Enum("status").
+			Values(itemStatuses...).
+			Default("in_service").
+			Comment("Lifecycle status, only changed through the allowed transitions"),
 
 		// ------------------------------------
 		// item identification
@@ -96,6 +107,26 @@ func (Item) Fields() []ent.Field {
 		field.String("sold_notes").
 			MaxLen(1000).
 			Optional(),
+
+		// ------------------------------------
+		// Post-return quarantine
+		field.Time("quarantined_at").
+			Optional().
+			Nillable().
+			Comment("When the item was returned into quarantine (null = not quarantined)"),
+		field.Time("quarantine_until").
+			Optional().
+			Nillable().
+			Comment("When the quarantine ends on its own (null = until staff sign off)"),
+
+		// ------------------------------------
+		// Consumable stock
+		field.Int("min_stock").
+			Default(0).
+			Comment("Quantity below which the item is low on stock (0 = not tracked)"),
+		field.Int("reorder_quantity").
+			Default(0).
+			Comment("Quantity to order when the item is low on stock"),
 	}
 }
 
@@ -120,6 +151,10 @@ func (Item) Edges() []ent.Edge {
 		owned("fields", ItemField.Type),
 		owned("maintenance_entries", MaintenanceEntry.Type),
 		owned("attachments", Attachment.Type),
+		owned("stock_movements", StockMovement.Type),
+		owned("identifiers", ItemIdentifier.Type),
+		owned("status_changes", ItemStatusChange.Type),
+		owned("stocktake_entries", StocktakeEntry.Type),
 		edge.To("loans", Loan.Type),
 	}
 }
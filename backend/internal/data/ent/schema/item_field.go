@@ -25,12 +25,14 @@ func (ItemField) Mixin() []ent.Mixin {
 func (ItemField) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("type").
-			Values("text", "number", "boolean", "time"),
+			Values("text", "number", "decimal", "boolean", "time", "select", "multiselect", "url"),
 		field.String("text_value").
 			MaxLen(500).
-			Optional(),
-		field.Int("number_value").
-			Optional(),
+			Optional().
+			Comment("Value of text, select and url fields, and the semicolon separated values of multiselect fields"),
+		field.Float("number_value").
+			Optional().
+			Comment("Value of number and decimal fields"),
 		field.Bool("boolean_value").
 			Default(false),
 		field.Time("time_value").
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// ItemIdentifier holds the schema definition for the ItemIdentifier entity.
+// An ItemIdentifier is a scannable code on an item besides its asset ID, like the
+// manufacturer's barcode or the UID of an NFC sticker.
+type ItemIdentifier struct {
+	ent.Schema
+}
+
+func (ItemIdentifier) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "item_identifiers",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the ItemIdentifier.
+func (ItemIdentifier) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("item_id", uuid.UUID{}),
+		field.Enum("type").
+			Values("upc", "ean", "isbn", "legacy_tag", "nfc", "rfid", "other").
+			Default("other"),
+		field.String("value").
+			NotEmpty().
+			MaxLen(255),
+		field.String("normalized_value").
+			MaxLen(255).
+			Comment("Value in lower case, identifiers are unique ignoring case"),
+	}
+}
+
+// Edges of the ItemIdentifier.
+func (ItemIdentifier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Field("item_id").
+			Ref("identifiers").
+			Required().
+			Unique(),
+	}
+}
+
+func (ItemIdentifier) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "type", "normalized_value").
+			Unique(),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// itemStatuses are the lifecycle statuses of an item, see Item.
+var itemStatuses = []string{"in_service", "in_repair", "lost", "stolen", "retired", "disposed"}
+
+// ItemStatusChange holds the schema definition for the ItemStatusChange entity.
+// An ItemStatusChange records a transition of the lifecycle status of an item with
+// who made it and why.
+type ItemStatusChange struct {
+	ent.Schema
+}
+
+func (ItemStatusChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "item_status_changes",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the ItemStatusChange.
+func (ItemStatusChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("item_id", uuid.UUID{}),
+		field.Enum("from_status").
+			Values(itemStatuses...),
+		field.Enum("to_status").
+			Values(itemStatuses...),
+		field.String("reason").
+			MaxLen(1000).
+			Optional(),
+		// Not an edge so that the history outlives the user
+		field.UUID("actor_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ItemStatusChange.
+func (ItemStatusChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Field("item_id").
+			Ref("status_changes").
+			Required().
+			Unique(),
+	}
+}
+
+func (ItemStatusChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "created_at"),
+	}
+}
@@ -24,6 +24,7 @@ func (KioskSession) Mixin() []ent.Mixin {
 func (KioskSession) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("is_active"),
+		index.Fields("last_seen_at"),
 	}
 }
 
@@ -37,6 +38,34 @@ func (KioskSession) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("When the temporary admin unlock expires (null = locked)"),
+		field.Time("last_seen_at").
+			Optional().
+			Nillable().
+			Comment("When the kiosk device last sent a heartbeat"),
+		field.Time("last_activity_at").
+			Optional().
+			Nillable().
+			Comment("When someone last interacted with the kiosk device, as reported by its heartbeat"),
+		field.String("app_version").
+			MaxLen(64).
+			Optional(),
+		field.Int("battery_level").
+			Min(0).
+			Max(100).
+			Optional().
+			Nillable().
+			Comment("Battery charge in percent (null = unknown or no battery)"),
+		field.Bool("battery_charging").
+			Optional().
+			Nillable(),
+		field.String("network_type").
+			MaxLen(32).
+			Optional().
+			Comment("Network connection reported by the device, e.g. wifi, ethernet or cellular"),
+		field.Time("offline_alerted_at").
+			Optional().
+			Nillable().
+			Comment("When staff were alerted that the kiosk went silent (cleared on the next heartbeat)"),
 	}
 }
 
@@ -51,5 +80,10 @@ func (KioskSession) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		// Optional: the location this kiosk serves; browsing, checkout and return
+		// are limited to items within its subtree (null = whole group)
+		edge.From("location", Location.Type).
+			Ref("kiosk_sessions").
+			Unique(),
 	}
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// KioskSyncAction holds the schema definition for the KioskSyncAction entity.
+// A KioskSyncAction records an action a kiosk queued while offline so that
+// replaying the same idempotency key never applies it twice.
+type KioskSyncAction struct {
+	ent.Schema
+}
+
+func (KioskSyncAction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{ref: "kiosk_sync_actions"},
+	}
+}
+
+func (KioskSyncAction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("idempotency_key").
+			Edges("group").
+			Unique(),
+	}
+}
+
+// Fields of the KioskSyncAction.
+func (KioskSyncAction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("idempotency_key").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Client generated key identifying the queued action"),
+		field.Enum("action").
+			Values("checkout", "return", "register_borrower"),
+		field.Enum("status").
+			Values("pending", "applied").
+			Default("pending").
+			Comment("pending while the action is being applied, applied once it succeeded"),
+		field.Time("client_timestamp").
+			Comment("When the action happened on the kiosk"),
+		field.UUID("result_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the loan or borrower the action created or updated"),
+	}
+}
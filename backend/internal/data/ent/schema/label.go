@@ -26,6 +26,17 @@ func (Label) Fields() []ent.Field {
 		field.String("color").
 			MaxLen(255).
 			Optional(),
+		field.Bool("quarantine_on_return").
+			Default(false).
+			Comment("Whether returned items with this label must be inspected before they can be lent again"),
+		field.Int("quarantine_minutes").
+			Default(0).
+			NonNegative().
+			Comment("How long returned items stay in quarantine (0 = until staff sign off)"),
+		field.String("asset_id_prefix").
+			MaxLen(32).
+			Optional().
+			Comment("Items created with the label are numbered in their own series under this prefix"),
 	}
 }
 
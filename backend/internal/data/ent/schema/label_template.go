@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// LabelTemplate holds the schema definition for the LabelTemplate entity.
+// A LabelTemplate decides which fields of an item or location are printed on its
+// label, in which order and size, and the kind of code printed next to them.
+type LabelTemplate struct {
+	ent.Schema
+}
+
+func (LabelTemplate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.DetailsMixin{},
+		GroupMixin{
+			ref:   "label_templates",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the LabelTemplate.
+func (LabelTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("symbology").
+			Values("qr", "datamatrix", "code128", "none").
+			Default("qr"),
+		field.Text("fields").
+			Comment("JSON encoded list of the printed fields"),
+	}
+}
+
+func (LabelTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "name").
+			Unique(),
+	}
+}
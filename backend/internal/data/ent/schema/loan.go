@@ -58,6 +58,9 @@ func (Loan) Fields() []ent.Field {
 		field.Bool("kiosk_action").
 			Default(false).
 			Comment("Whether this loan was created/returned via kiosk self-service"),
+		field.Bool("transfer").
+			Default(false).
+			Comment("Whether the item was returned at a kiosk outside its home location"),
 	}
 }
 
@@ -82,5 +85,9 @@ func (Loan) Edges() []ent.Edge {
 		edge.From("returned_by", User.Type).
 			Ref("returns").
 			Unique(),
+		// Optional: the location of the kiosk the item was returned at
+		edge.From("return_location", Location.Type).
+			Ref("returned_loans").
+			Unique(),
 	}
 }
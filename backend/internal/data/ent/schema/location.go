@@ -16,6 +16,7 @@ func (Location) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
 		mixins.DetailsMixin{},
+		mixins.SoftDeleteMixin{},
 		GroupMixin{ref: "locations"},
 	}
 }
@@ -35,5 +36,17 @@ func (Location) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("kiosk_sessions", KioskSession.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.SetNull,
+			}),
+		edge.To("returned_loans", Loan.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.SetNull,
+			}),
+		edge.To("stocktake_sessions", StocktakeSession.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 	}
 }
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// PrintJob holds the schema definition for the PrintJob entity.
+// A PrintJob is a batch of labels queued for a printer, printed in the background and
+// retried when the printer fails.
+type PrintJob struct {
+	ent.Schema
+}
+
+func (PrintJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "print_jobs",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the PrintJob.
+func (PrintJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("printer_id", uuid.UUID{}),
+		field.String("name").
+			MaxLen(255),
+		field.Enum("status").
+			Values("queued", "printing", "done", "failed", "cancelled").
+			Default("queued"),
+		field.Int("labels").
+			Default(0),
+		field.Text("payload").
+			Comment("JSON encoded labels and sheet layout"),
+		field.Int("attempts").
+			Default(0),
+		field.Int("max_attempts").
+			Default(3),
+		field.String("error").
+			MaxLen(1000).
+			Optional(),
+		field.Time("run_at").
+			Default(time.Now).
+			Comment("Time of the next attempt"),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		// Not an edge so that the job outlives the user
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the PrintJob.
+func (PrintJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("printer", Printer.Type).
+			Field("printer_id").
+			Ref("jobs").
+			Required().
+			Unique(),
+	}
+}
+
+func (PrintJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at"),
+		index.Fields("group_id", "created_at"),
+	}
+}
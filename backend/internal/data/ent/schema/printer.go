@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// Printer holds the schema definition for the Printer entity.
+// A Printer is a named printer of the group that print jobs are queued for, reached
+// through the server's print command, raw TCP or IPP.
+type Printer struct {
+	ent.Schema
+}
+
+func (Printer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.DetailsMixin{},
+		GroupMixin{
+			ref:   "printers",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the Printer.
+func (Printer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("command", "raw", "ipp").
+			Default("raw"),
+		field.String("address").
+			MaxLen(1024).
+			Optional().
+			Comment("Host and port of raw printers, URL of IPP printers, printer name for the print command"),
+		field.Enum("language").
+			Values("zpl", "epl").
+			Default("zpl").
+			Comment("Printer language of raw printers"),
+		field.Int("dpi").
+			Default(203),
+	}
+}
+
+// Edges of the Printer.
+func (Printer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("jobs", PrintJob.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+func (Printer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// SavedSearch holds the schema definition for the SavedSearch entity.
+// A SavedSearch stores a serialized item query so that it can be run again
+// later. Shared searches are visible to the whole group and can be used as the
+// source of exports and reports.
+type SavedSearch struct {
+	ent.Schema
+}
+
+func (SavedSearch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.DetailsMixin{},
+		GroupMixin{
+			ref:   "saved_searches",
+			field: "group_id",
+		},
+		UserMixin{
+			ref:   "saved_searches",
+			field: "user_id",
+		},
+	}
+}
+
+// Fields of the SavedSearch.
+func (SavedSearch) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("query").
+			Comment("JSON encoded item query"),
+		field.Bool("shared").
+			Default(false),
+	}
+}
+
+func (SavedSearch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("group_id", "shared"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// StockMovement holds the schema definition for the StockMovement entity.
+// A StockMovement records a single change to the quantity of an item, so that
+// the stock level can be traced back to who changed it and why.
+type StockMovement struct {
+	ent.Schema
+}
+
+func (StockMovement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "stock_movements",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the StockMovement.
+func (StockMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("item_id", uuid.UUID{}),
+		field.Int("delta"),
+		field.Int("quantity").
+			Comment("Quantity of the item after the movement"),
+		field.Enum("reason").
+			Values("purchase", "issue", "loss", "adjustment", "count_correction").
+			Default("adjustment"),
+		// Not an edge so that the ledger outlives the user
+		field.UUID("actor_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("note").
+			MaxLen(1000).
+			Optional(),
+	}
+}
+
+// Edges of the StockMovement.
+func (StockMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Field("item_id").
+			Ref("stock_movements").
+			Required().
+			Unique(),
+	}
+}
+
+func (StockMovement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "created_at"),
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// StocktakeEntry holds the schema definition for the StocktakeEntry entity.
+// A StocktakeEntry is an item of a stocktake, either one expected in the session's
+// locations when it started or one scanned there unexpectedly. Scans that match no
+// item are kept as entries without an item.
+type StocktakeEntry struct {
+	ent.Schema
+}
+
+func (StocktakeEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+// Fields of the StocktakeEntry.
+func (StocktakeEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("session_id", uuid.UUID{}),
+		field.UUID("item_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("value").
+			MaxLen(255).
+			Optional().
+			Comment("The scanned asset ID or identifier, empty for items not found yet"),
+		field.Bool("expected").
+			Default(false).
+			Comment("Whether the item was in the session's locations when it started"),
+		field.UUID("expected_location_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Where the item was recorded to be"),
+		field.UUID("found_location_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Where the item was scanned"),
+		field.Time("found_at").
+			Optional().
+			Nillable(),
+		field.UUID("found_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Bool("on_loan").
+			Default(false).
+			Comment("Whether the item was missing because it was on loan, set when the session closes"),
+	}
+}
+
+// Edges of the StocktakeEntry.
+func (StocktakeEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", StocktakeSession.Type).
+			Field("session_id").
+			Ref("entries").
+			Required().
+			Unique(),
+		edge.From("item", Item.Type).
+			Field("item_id").
+			Ref("stocktake_entries").
+			Unique(),
+	}
+}
+
+func (StocktakeEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id", "item_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// StocktakeSession holds the schema definition for the StocktakeSession entity.
+// A StocktakeSession is a physical stocktake of a location and the locations nested
+// below it, staff scan the items they find and closing the session reports which
+// items are missing or misplaced.
+type StocktakeSession struct {
+	ent.Schema
+}
+
+func (StocktakeSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "stocktake_sessions",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the StocktakeSession.
+func (StocktakeSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.String("notes").
+			MaxLen(1000).
+			Optional(),
+		field.UUID("location_id", uuid.UUID{}),
+		field.Enum("status").
+			Values("open", "closed").
+			Default("open"),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+		// Not edges so that the sessions outlive their users
+		field.UUID("started_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("closed_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the StocktakeSession.
+func (StocktakeSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).
+			Field("location_id").
+			Ref("stocktake_sessions").
+			Required().
+			Unique(),
+		edge.To("entries", StocktakeEntry.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+func (StocktakeSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
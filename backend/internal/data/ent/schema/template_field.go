@@ -24,10 +24,11 @@ func (TemplateField) Mixin() []ent.Mixin {
 func (TemplateField) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("type").
-			Values("text"),
+			Values("text", "number", "decimal", "boolean", "time", "select", "multiselect", "url"),
 		field.String("text_value").
 			MaxLen(500).
-			Optional(),
+			Optional().
+			Comment("Default value in the text form used by CSV imports, converted to the field's type"),
 	}
 }
 
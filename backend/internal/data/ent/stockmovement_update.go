@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+)
+
+// StockMovementUpdate is the builder for updating StockMovement entities.
+type StockMovementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StockMovementMutation
+}
+
+// Where appends a list predicates to the StockMovementUpdate builder.
+func (_u *StockMovementUpdate) Where(ps ...predicate.StockMovement) *StockMovementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StockMovementUpdate) SetUpdatedAt(v time.Time) *StockMovementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *StockMovementUpdate) SetGroupID(v uuid.UUID) *StockMovementUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableGroupID(v *uuid.UUID) *StockMovementUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *StockMovementUpdate) SetItemID(v uuid.UUID) *StockMovementUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableItemID(v *uuid.UUID) *StockMovementUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetDelta sets the "delta" field.
+func (_u *StockMovementUpdate) SetDelta(v int) *StockMovementUpdate {
+	_u.mutation.ResetDelta()
+	_u.mutation.SetDelta(v)
+	return _u
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableDelta(v *int) *StockMovementUpdate {
+	if v != nil {
+		_u.SetDelta(*v)
+	}
+	return _u
+}
+
+// AddDelta adds value to the "delta" field.
+func (_u *StockMovementUpdate) AddDelta(v int) *StockMovementUpdate {
+	_u.mutation.AddDelta(v)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *StockMovementUpdate) SetQuantity(v int) *StockMovementUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableQuantity(v *int) *StockMovementUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *StockMovementUpdate) AddQuantity(v int) *StockMovementUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *StockMovementUpdate) SetReason(v stockmovement.Reason) *StockMovementUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableReason(v *stockmovement.Reason) *StockMovementUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *StockMovementUpdate) SetActorID(v uuid.UUID) *StockMovementUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableActorID(v *uuid.UUID) *StockMovementUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *StockMovementUpdate) ClearActorID() *StockMovementUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *StockMovementUpdate) SetNote(v string) *StockMovementUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableNote(v *string) *StockMovementUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *StockMovementUpdate) ClearNote() *StockMovementUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *StockMovementUpdate) SetGroup(v *Group) *StockMovementUpdate {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *StockMovementUpdate) SetItem(v *Item) *StockMovementUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (_u *StockMovementUpdate) Mutation() *StockMovementMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *StockMovementUpdate) ClearGroup() *StockMovementUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *StockMovementUpdate) ClearItem() *StockMovementUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StockMovementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StockMovementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StockMovementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StockMovementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StockMovementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := stockmovement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StockMovementUpdate) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := stockmovement.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "StockMovement.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := stockmovement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "StockMovement.note": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockMovement.group"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockMovement.item"`)
+	}
+	return nil
+}
+
+func (_u *StockMovementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stockmovement.Table, stockmovement.Columns, sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(stockmovement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Delta(); ok {
+		_spec.SetField(stockmovement.FieldDelta, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDelta(); ok {
+		_spec.AddField(stockmovement.FieldDelta, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(stockmovement.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(stockmovement.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(stockmovement.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(stockmovement.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(stockmovement.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(stockmovement.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(stockmovement.FieldNote, field.TypeString)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.GroupTable,
+			Columns: []string{stockmovement.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.GroupTable,
+			Columns: []string{stockmovement.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StockMovementUpdateOne is the builder for updating a single StockMovement entity.
+type StockMovementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StockMovementMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StockMovementUpdateOne) SetUpdatedAt(v time.Time) *StockMovementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *StockMovementUpdateOne) SetGroupID(v uuid.UUID) *StockMovementUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableGroupID(v *uuid.UUID) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *StockMovementUpdateOne) SetItemID(v uuid.UUID) *StockMovementUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableItemID(v *uuid.UUID) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetDelta sets the "delta" field.
+func (_u *StockMovementUpdateOne) SetDelta(v int) *StockMovementUpdateOne {
+	_u.mutation.ResetDelta()
+	_u.mutation.SetDelta(v)
+	return _u
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableDelta(v *int) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetDelta(*v)
+	}
+	return _u
+}
+
+// AddDelta adds value to the "delta" field.
+func (_u *StockMovementUpdateOne) AddDelta(v int) *StockMovementUpdateOne {
+	_u.mutation.AddDelta(v)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *StockMovementUpdateOne) SetQuantity(v int) *StockMovementUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableQuantity(v *int) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *StockMovementUpdateOne) AddQuantity(v int) *StockMovementUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *StockMovementUpdateOne) SetReason(v stockmovement.Reason) *StockMovementUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableReason(v *stockmovement.Reason) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *StockMovementUpdateOne) SetActorID(v uuid.UUID) *StockMovementUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableActorID(v *uuid.UUID) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *StockMovementUpdateOne) ClearActorID() *StockMovementUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *StockMovementUpdateOne) SetNote(v string) *StockMovementUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableNote(v *string) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *StockMovementUpdateOne) ClearNote() *StockMovementUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *StockMovementUpdateOne) SetGroup(v *Group) *StockMovementUpdateOne {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *StockMovementUpdateOne) SetItem(v *Item) *StockMovementUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (_u *StockMovementUpdateOne) Mutation() *StockMovementMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *StockMovementUpdateOne) ClearGroup() *StockMovementUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *StockMovementUpdateOne) ClearItem() *StockMovementUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Where appends a list predicates to the StockMovementUpdate builder.
+func (_u *StockMovementUpdateOne) Where(ps ...predicate.StockMovement) *StockMovementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StockMovementUpdateOne) Select(field string, fields ...string) *StockMovementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StockMovement entity.
+func (_u *StockMovementUpdateOne) Save(ctx context.Context) (*StockMovement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StockMovementUpdateOne) SaveX(ctx context.Context) *StockMovement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StockMovementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StockMovementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StockMovementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := stockmovement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StockMovementUpdateOne) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := stockmovement.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "StockMovement.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := stockmovement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "StockMovement.note": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockMovement.group"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockMovement.item"`)
+	}
+	return nil
+}
+
+func (_u *StockMovementUpdateOne) sqlSave(ctx context.Context) (_node *StockMovement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stockmovement.Table, stockmovement.Columns, sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockMovement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockmovement.FieldID)
+		for _, f := range fields {
+			if !stockmovement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stockmovement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(stockmovement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Delta(); ok {
+		_spec.SetField(stockmovement.FieldDelta, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDelta(); ok {
+		_spec.AddField(stockmovement.FieldDelta, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(stockmovement.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(stockmovement.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(stockmovement.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(stockmovement.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(stockmovement.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(stockmovement.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(stockmovement.FieldNote, field.TypeString)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.GroupTable,
+			Columns: []string{stockmovement.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.GroupTable,
+			Columns: []string{stockmovement.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StockMovement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
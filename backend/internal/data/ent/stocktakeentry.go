@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
+)
+
+// StocktakeEntry is the model entity for the StocktakeEntry schema.
+type StocktakeEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID *uuid.UUID `json:"item_id,omitempty"`
+	// The scanned asset ID or identifier, empty for items not found yet
+	Value string `json:"value,omitempty"`
+	// Whether the item was in the session's locations when it started
+	Expected bool `json:"expected,omitempty"`
+	// Where the item was recorded to be
+	ExpectedLocationID *uuid.UUID `json:"expected_location_id,omitempty"`
+	// Where the item was scanned
+	FoundLocationID *uuid.UUID `json:"found_location_id,omitempty"`
+	// FoundAt holds the value of the "found_at" field.
+	FoundAt *time.Time `json:"found_at,omitempty"`
+	// FoundBy holds the value of the "found_by" field.
+	FoundBy *uuid.UUID `json:"found_by,omitempty"`
+	// Whether the item was missing because it was on loan, set when the session closes
+	OnLoan bool `json:"on_loan,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StocktakeEntryQuery when eager-loading is set.
+	Edges        StocktakeEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StocktakeEntryEdges holds the relations/edges for other nodes in the graph.
+type StocktakeEntryEdges struct {
+	// Session holds the value of the session edge.
+	Session *StocktakeSession `json:"session,omitempty"`
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StocktakeEntryEdges) SessionOrErr() (*StocktakeSession, error) {
+	if e.Session != nil {
+		return e.Session, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: stocktakesession.Label}
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StocktakeEntryEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StocktakeEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stocktakeentry.FieldItemID, stocktakeentry.FieldExpectedLocationID, stocktakeentry.FieldFoundLocationID, stocktakeentry.FieldFoundBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case stocktakeentry.FieldExpected, stocktakeentry.FieldOnLoan:
+			values[i] = new(sql.NullBool)
+		case stocktakeentry.FieldValue:
+			values[i] = new(sql.NullString)
+		case stocktakeentry.FieldCreatedAt, stocktakeentry.FieldUpdatedAt, stocktakeentry.FieldFoundAt:
+			values[i] = new(sql.NullTime)
+		case stocktakeentry.FieldID, stocktakeentry.FieldSessionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StocktakeEntry fields.
+func (_m *StocktakeEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stocktakeentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case stocktakeentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case stocktakeentry.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case stocktakeentry.FieldSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				_m.SessionID = *value
+			}
+		case stocktakeentry.FieldItemID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				_m.ItemID = new(uuid.UUID)
+				*_m.ItemID = *value.S.(*uuid.UUID)
+			}
+		case stocktakeentry.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case stocktakeentry.FieldExpected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field expected", values[i])
+			} else if value.Valid {
+				_m.Expected = value.Bool
+			}
+		case stocktakeentry.FieldExpectedLocationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_location_id", values[i])
+			} else if value.Valid {
+				_m.ExpectedLocationID = new(uuid.UUID)
+				*_m.ExpectedLocationID = *value.S.(*uuid.UUID)
+			}
+		case stocktakeentry.FieldFoundLocationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field found_location_id", values[i])
+			} else if value.Valid {
+				_m.FoundLocationID = new(uuid.UUID)
+				*_m.FoundLocationID = *value.S.(*uuid.UUID)
+			}
+		case stocktakeentry.FieldFoundAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field found_at", values[i])
+			} else if value.Valid {
+				_m.FoundAt = new(time.Time)
+				*_m.FoundAt = value.Time
+			}
+		case stocktakeentry.FieldFoundBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field found_by", values[i])
+			} else if value.Valid {
+				_m.FoundBy = new(uuid.UUID)
+				*_m.FoundBy = *value.S.(*uuid.UUID)
+			}
+		case stocktakeentry.FieldOnLoan:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field on_loan", values[i])
+			} else if value.Valid {
+				_m.OnLoan = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the StocktakeEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *StocktakeEntry) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySession queries the "session" edge of the StocktakeEntry entity.
+func (_m *StocktakeEntry) QuerySession() *StocktakeSessionQuery {
+	return NewStocktakeEntryClient(_m.config).QuerySession(_m)
+}
+
+// QueryItem queries the "item" edge of the StocktakeEntry entity.
+func (_m *StocktakeEntry) QueryItem() *ItemQuery {
+	return NewStocktakeEntryClient(_m.config).QueryItem(_m)
+}
+
+// Update returns a builder for updating this StocktakeEntry.
+// Note that you need to call StocktakeEntry.Unwrap() before calling this method if this StocktakeEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StocktakeEntry) Update() *StocktakeEntryUpdateOne {
+	return NewStocktakeEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StocktakeEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StocktakeEntry) Unwrap() *StocktakeEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StocktakeEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StocktakeEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("StocktakeEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SessionID))
+	builder.WriteString(", ")
+	if v := _m.ItemID; v != nil {
+		builder.WriteString("item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteString(", ")
+	builder.WriteString("expected=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Expected))
+	builder.WriteString(", ")
+	if v := _m.ExpectedLocationID; v != nil {
+		builder.WriteString("expected_location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FoundLocationID; v != nil {
+		builder.WriteString("found_location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FoundAt; v != nil {
+		builder.WriteString("found_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FoundBy; v != nil {
+		builder.WriteString("found_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("on_loan=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OnLoan))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StocktakeEntries is a parsable slice of StocktakeEntry.
+type StocktakeEntries []*StocktakeEntry
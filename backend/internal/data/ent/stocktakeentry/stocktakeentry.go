@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stocktakeentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the stocktakeentry type in the database.
+	Label = "stocktake_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldExpected holds the string denoting the expected field in the database.
+	FieldExpected = "expected"
+	// FieldExpectedLocationID holds the string denoting the expected_location_id field in the database.
+	FieldExpectedLocationID = "expected_location_id"
+	// FieldFoundLocationID holds the string denoting the found_location_id field in the database.
+	FieldFoundLocationID = "found_location_id"
+	// FieldFoundAt holds the string denoting the found_at field in the database.
+	FieldFoundAt = "found_at"
+	// FieldFoundBy holds the string denoting the found_by field in the database.
+	FieldFoundBy = "found_by"
+	// FieldOnLoan holds the string denoting the on_loan field in the database.
+	FieldOnLoan = "on_loan"
+	// EdgeSession holds the string denoting the session edge name in mutations.
+	EdgeSession = "session"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// Table holds the table name of the stocktakeentry in the database.
+	Table = "stocktake_entries"
+	// SessionTable is the table that holds the session relation/edge.
+	SessionTable = "stocktake_entries"
+	// SessionInverseTable is the table name for the StocktakeSession entity.
+	// It exists in this package in order to avoid circular dependency with the "stocktakesession" package.
+	SessionInverseTable = "stocktake_sessions"
+	// SessionColumn is the table column denoting the session relation/edge.
+	SessionColumn = "session_id"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "stocktake_entries"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "item_id"
+)
+
+// Columns holds all SQL columns for stocktakeentry fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSessionID,
+	FieldItemID,
+	FieldValue,
+	FieldExpected,
+	FieldExpectedLocationID,
+	FieldFoundLocationID,
+	FieldFoundAt,
+	FieldFoundBy,
+	FieldOnLoan,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(string) error
+	// DefaultExpected holds the default value on creation for the "expected" field.
+	DefaultExpected bool
+	// DefaultOnLoan holds the default value on creation for the "on_loan" field.
+	DefaultOnLoan bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the StocktakeEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByExpected orders the results by the expected field.
+func ByExpected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpected, opts...).ToFunc()
+}
+
+// ByExpectedLocationID orders the results by the expected_location_id field.
+func ByExpectedLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedLocationID, opts...).ToFunc()
+}
+
+// ByFoundLocationID orders the results by the found_location_id field.
+func ByFoundLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFoundLocationID, opts...).ToFunc()
+}
+
+// ByFoundAt orders the results by the found_at field.
+func ByFoundAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFoundAt, opts...).ToFunc()
+}
+
+// ByFoundBy orders the results by the found_by field.
+func ByFoundBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFoundBy, opts...).ToFunc()
+}
+
+// ByOnLoan orders the results by the on_loan field.
+func ByOnLoan(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnLoan, opts...).ToFunc()
+}
+
+// BySessionField orders the results by session field.
+func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+	)
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+	)
+}
@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stocktakeentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldSessionID, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldItemID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldValue, v))
+}
+
+// Expected applies equality check predicate on the "expected" field. It's identical to ExpectedEQ.
+func Expected(v bool) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldExpected, v))
+}
+
+// ExpectedLocationID applies equality check predicate on the "expected_location_id" field. It's identical to ExpectedLocationIDEQ.
+func ExpectedLocationID(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldExpectedLocationID, v))
+}
+
+// FoundLocationID applies equality check predicate on the "found_location_id" field. It's identical to FoundLocationIDEQ.
+func FoundLocationID(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldFoundLocationID, v))
+}
+
+// FoundAt applies equality check predicate on the "found_at" field. It's identical to FoundAtEQ.
+func FoundAt(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldFoundAt, v))
+}
+
+// FoundBy applies equality check predicate on the "found_by" field. It's identical to FoundByEQ.
+func FoundBy(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldFoundBy, v))
+}
+
+// OnLoan applies equality check predicate on the "on_loan" field. It's identical to OnLoanEQ.
+func OnLoan(v bool) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldOnLoan, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDIsNil applies the IsNil predicate on the "item_id" field.
+func ItemIDIsNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIsNull(FieldItemID))
+}
+
+// ItemIDNotNil applies the NotNil predicate on the "item_id" field.
+func ItemIDNotNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotNull(FieldItemID))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueIsNil applies the IsNil predicate on the "value" field.
+func ValueIsNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIsNull(FieldValue))
+}
+
+// ValueNotNil applies the NotNil predicate on the "value" field.
+func ValueNotNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotNull(FieldValue))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldContainsFold(FieldValue, v))
+}
+
+// ExpectedEQ applies the EQ predicate on the "expected" field.
+func ExpectedEQ(v bool) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldExpected, v))
+}
+
+// ExpectedNEQ applies the NEQ predicate on the "expected" field.
+func ExpectedNEQ(v bool) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldExpected, v))
+}
+
+// ExpectedLocationIDEQ applies the EQ predicate on the "expected_location_id" field.
+func ExpectedLocationIDEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldExpectedLocationID, v))
+}
+
+// ExpectedLocationIDNEQ applies the NEQ predicate on the "expected_location_id" field.
+func ExpectedLocationIDNEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldExpectedLocationID, v))
+}
+
+// ExpectedLocationIDIn applies the In predicate on the "expected_location_id" field.
+func ExpectedLocationIDIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldExpectedLocationID, vs...))
+}
+
+// ExpectedLocationIDNotIn applies the NotIn predicate on the "expected_location_id" field.
+func ExpectedLocationIDNotIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldExpectedLocationID, vs...))
+}
+
+// ExpectedLocationIDGT applies the GT predicate on the "expected_location_id" field.
+func ExpectedLocationIDGT(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGT(FieldExpectedLocationID, v))
+}
+
+// ExpectedLocationIDGTE applies the GTE predicate on the "expected_location_id" field.
+func ExpectedLocationIDGTE(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGTE(FieldExpectedLocationID, v))
+}
+
+// ExpectedLocationIDLT applies the LT predicate on the "expected_location_id" field.
+func ExpectedLocationIDLT(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLT(FieldExpectedLocationID, v))
+}
+
+// ExpectedLocationIDLTE applies the LTE predicate on the "expected_location_id" field.
+func ExpectedLocationIDLTE(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLTE(FieldExpectedLocationID, v))
+}
+
+// ExpectedLocationIDIsNil applies the IsNil predicate on the "expected_location_id" field.
+func ExpectedLocationIDIsNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIsNull(FieldExpectedLocationID))
+}
+
+// ExpectedLocationIDNotNil applies the NotNil predicate on the "expected_location_id" field.
+func ExpectedLocationIDNotNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotNull(FieldExpectedLocationID))
+}
+
+// FoundLocationIDEQ applies the EQ predicate on the "found_location_id" field.
+func FoundLocationIDEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldFoundLocationID, v))
+}
+
+// FoundLocationIDNEQ applies the NEQ predicate on the "found_location_id" field.
+func FoundLocationIDNEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldFoundLocationID, v))
+}
+
+// FoundLocationIDIn applies the In predicate on the "found_location_id" field.
+func FoundLocationIDIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldFoundLocationID, vs...))
+}
+
+// FoundLocationIDNotIn applies the NotIn predicate on the "found_location_id" field.
+func FoundLocationIDNotIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldFoundLocationID, vs...))
+}
+
+// FoundLocationIDGT applies the GT predicate on the "found_location_id" field.
+func FoundLocationIDGT(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGT(FieldFoundLocationID, v))
+}
+
+// FoundLocationIDGTE applies the GTE predicate on the "found_location_id" field.
+func FoundLocationIDGTE(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGTE(FieldFoundLocationID, v))
+}
+
+// FoundLocationIDLT applies the LT predicate on the "found_location_id" field.
+func FoundLocationIDLT(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLT(FieldFoundLocationID, v))
+}
+
+// FoundLocationIDLTE applies the LTE predicate on the "found_location_id" field.
+func FoundLocationIDLTE(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLTE(FieldFoundLocationID, v))
+}
+
+// FoundLocationIDIsNil applies the IsNil predicate on the "found_location_id" field.
+func FoundLocationIDIsNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIsNull(FieldFoundLocationID))
+}
+
+// FoundLocationIDNotNil applies the NotNil predicate on the "found_location_id" field.
+func FoundLocationIDNotNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotNull(FieldFoundLocationID))
+}
+
+// FoundAtEQ applies the EQ predicate on the "found_at" field.
+func FoundAtEQ(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldFoundAt, v))
+}
+
+// FoundAtNEQ applies the NEQ predicate on the "found_at" field.
+func FoundAtNEQ(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldFoundAt, v))
+}
+
+// FoundAtIn applies the In predicate on the "found_at" field.
+func FoundAtIn(vs ...time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldFoundAt, vs...))
+}
+
+// FoundAtNotIn applies the NotIn predicate on the "found_at" field.
+func FoundAtNotIn(vs ...time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldFoundAt, vs...))
+}
+
+// FoundAtGT applies the GT predicate on the "found_at" field.
+func FoundAtGT(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGT(FieldFoundAt, v))
+}
+
+// FoundAtGTE applies the GTE predicate on the "found_at" field.
+func FoundAtGTE(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGTE(FieldFoundAt, v))
+}
+
+// FoundAtLT applies the LT predicate on the "found_at" field.
+func FoundAtLT(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLT(FieldFoundAt, v))
+}
+
+// FoundAtLTE applies the LTE predicate on the "found_at" field.
+func FoundAtLTE(v time.Time) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLTE(FieldFoundAt, v))
+}
+
+// FoundAtIsNil applies the IsNil predicate on the "found_at" field.
+func FoundAtIsNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIsNull(FieldFoundAt))
+}
+
+// FoundAtNotNil applies the NotNil predicate on the "found_at" field.
+func FoundAtNotNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotNull(FieldFoundAt))
+}
+
+// FoundByEQ applies the EQ predicate on the "found_by" field.
+func FoundByEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldFoundBy, v))
+}
+
+// FoundByNEQ applies the NEQ predicate on the "found_by" field.
+func FoundByNEQ(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldFoundBy, v))
+}
+
+// FoundByIn applies the In predicate on the "found_by" field.
+func FoundByIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIn(FieldFoundBy, vs...))
+}
+
+// FoundByNotIn applies the NotIn predicate on the "found_by" field.
+func FoundByNotIn(vs ...uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotIn(FieldFoundBy, vs...))
+}
+
+// FoundByGT applies the GT predicate on the "found_by" field.
+func FoundByGT(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGT(FieldFoundBy, v))
+}
+
+// FoundByGTE applies the GTE predicate on the "found_by" field.
+func FoundByGTE(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldGTE(FieldFoundBy, v))
+}
+
+// FoundByLT applies the LT predicate on the "found_by" field.
+func FoundByLT(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLT(FieldFoundBy, v))
+}
+
+// FoundByLTE applies the LTE predicate on the "found_by" field.
+func FoundByLTE(v uuid.UUID) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldLTE(FieldFoundBy, v))
+}
+
+// FoundByIsNil applies the IsNil predicate on the "found_by" field.
+func FoundByIsNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldIsNull(FieldFoundBy))
+}
+
+// FoundByNotNil applies the NotNil predicate on the "found_by" field.
+func FoundByNotNil() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNotNull(FieldFoundBy))
+}
+
+// OnLoanEQ applies the EQ predicate on the "on_loan" field.
+func OnLoanEQ(v bool) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldEQ(FieldOnLoan, v))
+}
+
+// OnLoanNEQ applies the NEQ predicate on the "on_loan" field.
+func OnLoanNEQ(v bool) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.FieldNEQ(FieldOnLoan, v))
+}
+
+// HasSession applies the HasEdge predicate on the "session" edge.
+func HasSession() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionWith applies the HasEdge predicate on the "session" edge with a given conditions (other predicates).
+func HasSessionWith(preds ...predicate.StocktakeSession) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(func(s *sql.Selector) {
+		step := newSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StocktakeEntry) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StocktakeEntry) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StocktakeEntry) predicate.StocktakeEntry {
+	return predicate.StocktakeEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
+)
+
+// StocktakeEntryCreate is the builder for creating a StocktakeEntry entity.
+type StocktakeEntryCreate struct {
+	config
+	mutation *StocktakeEntryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StocktakeEntryCreate) SetCreatedAt(v time.Time) *StocktakeEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableCreatedAt(v *time.Time) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StocktakeEntryCreate) SetUpdatedAt(v time.Time) *StocktakeEntryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableUpdatedAt(v *time.Time) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *StocktakeEntryCreate) SetSessionID(v uuid.UUID) *StocktakeEntryCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *StocktakeEntryCreate) SetItemID(v uuid.UUID) *StocktakeEntryCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableItemID(v *uuid.UUID) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetItemID(*v)
+	}
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *StocktakeEntryCreate) SetValue(v string) *StocktakeEntryCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableValue(v *string) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetExpected sets the "expected" field.
+func (_c *StocktakeEntryCreate) SetExpected(v bool) *StocktakeEntryCreate {
+	_c.mutation.SetExpected(v)
+	return _c
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableExpected(v *bool) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetExpected(*v)
+	}
+	return _c
+}
+
+// SetExpectedLocationID sets the "expected_location_id" field.
+func (_c *StocktakeEntryCreate) SetExpectedLocationID(v uuid.UUID) *StocktakeEntryCreate {
+	_c.mutation.SetExpectedLocationID(v)
+	return _c
+}
+
+// SetNillableExpectedLocationID sets the "expected_location_id" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableExpectedLocationID(v *uuid.UUID) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetExpectedLocationID(*v)
+	}
+	return _c
+}
+
+// SetFoundLocationID sets the "found_location_id" field.
+func (_c *StocktakeEntryCreate) SetFoundLocationID(v uuid.UUID) *StocktakeEntryCreate {
+	_c.mutation.SetFoundLocationID(v)
+	return _c
+}
+
+// SetNillableFoundLocationID sets the "found_location_id" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableFoundLocationID(v *uuid.UUID) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetFoundLocationID(*v)
+	}
+	return _c
+}
+
+// SetFoundAt sets the "found_at" field.
+func (_c *StocktakeEntryCreate) SetFoundAt(v time.Time) *StocktakeEntryCreate {
+	_c.mutation.SetFoundAt(v)
+	return _c
+}
+
+// SetNillableFoundAt sets the "found_at" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableFoundAt(v *time.Time) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetFoundAt(*v)
+	}
+	return _c
+}
+
+// SetFoundBy sets the "found_by" field.
+func (_c *StocktakeEntryCreate) SetFoundBy(v uuid.UUID) *StocktakeEntryCreate {
+	_c.mutation.SetFoundBy(v)
+	return _c
+}
+
+// SetNillableFoundBy sets the "found_by" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableFoundBy(v *uuid.UUID) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetFoundBy(*v)
+	}
+	return _c
+}
+
+// SetOnLoan sets the "on_loan" field.
+func (_c *StocktakeEntryCreate) SetOnLoan(v bool) *StocktakeEntryCreate {
+	_c.mutation.SetOnLoan(v)
+	return _c
+}
+
+// SetNillableOnLoan sets the "on_loan" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableOnLoan(v *bool) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetOnLoan(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StocktakeEntryCreate) SetID(v uuid.UUID) *StocktakeEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *StocktakeEntryCreate) SetNillableID(v *uuid.UUID) *StocktakeEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSession sets the "session" edge to the StocktakeSession entity.
+func (_c *StocktakeEntryCreate) SetSession(v *StocktakeSession) *StocktakeEntryCreate {
+	return _c.SetSessionID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *StocktakeEntryCreate) SetItem(v *Item) *StocktakeEntryCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// Mutation returns the StocktakeEntryMutation object of the builder.
+func (_c *StocktakeEntryCreate) Mutation() *StocktakeEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the StocktakeEntry in the database.
+func (_c *StocktakeEntryCreate) Save(ctx context.Context) (*StocktakeEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StocktakeEntryCreate) SaveX(ctx context.Context) *StocktakeEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StocktakeEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StocktakeEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StocktakeEntryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := stocktakeentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := stocktakeentry.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Expected(); !ok {
+		v := stocktakeentry.DefaultExpected
+		_c.mutation.SetExpected(v)
+	}
+	if _, ok := _c.mutation.OnLoan(); !ok {
+		v := stocktakeentry.DefaultOnLoan
+		_c.mutation.SetOnLoan(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := stocktakeentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StocktakeEntryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StocktakeEntry.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "StocktakeEntry.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "StocktakeEntry.session_id"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := stocktakeentry.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "StocktakeEntry.value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Expected(); !ok {
+		return &ValidationError{Name: "expected", err: errors.New(`ent: missing required field "StocktakeEntry.expected"`)}
+	}
+	if _, ok := _c.mutation.OnLoan(); !ok {
+		return &ValidationError{Name: "on_loan", err: errors.New(`ent: missing required field "StocktakeEntry.on_loan"`)}
+	}
+	if len(_c.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "StocktakeEntry.session"`)}
+	}
+	return nil
+}
+
+func (_c *StocktakeEntryCreate) sqlSave(ctx context.Context) (*StocktakeEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StocktakeEntryCreate) createSpec() (*StocktakeEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StocktakeEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stocktakeentry.Table, sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(stocktakeentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocktakeentry.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(stocktakeentry.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.Expected(); ok {
+		_spec.SetField(stocktakeentry.FieldExpected, field.TypeBool, value)
+		_node.Expected = value
+	}
+	if value, ok := _c.mutation.ExpectedLocationID(); ok {
+		_spec.SetField(stocktakeentry.FieldExpectedLocationID, field.TypeUUID, value)
+		_node.ExpectedLocationID = &value
+	}
+	if value, ok := _c.mutation.FoundLocationID(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundLocationID, field.TypeUUID, value)
+		_node.FoundLocationID = &value
+	}
+	if value, ok := _c.mutation.FoundAt(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundAt, field.TypeTime, value)
+		_node.FoundAt = &value
+	}
+	if value, ok := _c.mutation.FoundBy(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundBy, field.TypeUUID, value)
+		_node.FoundBy = &value
+	}
+	if value, ok := _c.mutation.OnLoan(); ok {
+		_spec.SetField(stocktakeentry.FieldOnLoan, field.TypeBool, value)
+		_node.OnLoan = value
+	}
+	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.SessionTable,
+			Columns: []string{stocktakeentry.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.ItemTable,
+			Columns: []string{stocktakeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StocktakeEntryCreateBulk is the builder for creating many StocktakeEntry entities in bulk.
+type StocktakeEntryCreateBulk struct {
+	config
+	err      error
+	builders []*StocktakeEntryCreate
+}
+
+// Save creates the StocktakeEntry entities in the database.
+func (_c *StocktakeEntryCreateBulk) Save(ctx context.Context) ([]*StocktakeEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StocktakeEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StocktakeEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StocktakeEntryCreateBulk) SaveX(ctx context.Context) []*StocktakeEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StocktakeEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StocktakeEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
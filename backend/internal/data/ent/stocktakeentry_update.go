@@ -0,0 +1,803 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
+)
+
+// StocktakeEntryUpdate is the builder for updating StocktakeEntry entities.
+type StocktakeEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StocktakeEntryMutation
+}
+
+// Where appends a list predicates to the StocktakeEntryUpdate builder.
+func (_u *StocktakeEntryUpdate) Where(ps ...predicate.StocktakeEntry) *StocktakeEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StocktakeEntryUpdate) SetUpdatedAt(v time.Time) *StocktakeEntryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *StocktakeEntryUpdate) SetSessionID(v uuid.UUID) *StocktakeEntryUpdate {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableSessionID(v *uuid.UUID) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *StocktakeEntryUpdate) SetItemID(v uuid.UUID) *StocktakeEntryUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableItemID(v *uuid.UUID) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *StocktakeEntryUpdate) ClearItemID() *StocktakeEntryUpdate {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *StocktakeEntryUpdate) SetValue(v string) *StocktakeEntryUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableValue(v *string) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// ClearValue clears the value of the "value" field.
+func (_u *StocktakeEntryUpdate) ClearValue() *StocktakeEntryUpdate {
+	_u.mutation.ClearValue()
+	return _u
+}
+
+// SetExpected sets the "expected" field.
+func (_u *StocktakeEntryUpdate) SetExpected(v bool) *StocktakeEntryUpdate {
+	_u.mutation.SetExpected(v)
+	return _u
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableExpected(v *bool) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetExpected(*v)
+	}
+	return _u
+}
+
+// SetExpectedLocationID sets the "expected_location_id" field.
+func (_u *StocktakeEntryUpdate) SetExpectedLocationID(v uuid.UUID) *StocktakeEntryUpdate {
+	_u.mutation.SetExpectedLocationID(v)
+	return _u
+}
+
+// SetNillableExpectedLocationID sets the "expected_location_id" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableExpectedLocationID(v *uuid.UUID) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetExpectedLocationID(*v)
+	}
+	return _u
+}
+
+// ClearExpectedLocationID clears the value of the "expected_location_id" field.
+func (_u *StocktakeEntryUpdate) ClearExpectedLocationID() *StocktakeEntryUpdate {
+	_u.mutation.ClearExpectedLocationID()
+	return _u
+}
+
+// SetFoundLocationID sets the "found_location_id" field.
+func (_u *StocktakeEntryUpdate) SetFoundLocationID(v uuid.UUID) *StocktakeEntryUpdate {
+	_u.mutation.SetFoundLocationID(v)
+	return _u
+}
+
+// SetNillableFoundLocationID sets the "found_location_id" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableFoundLocationID(v *uuid.UUID) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetFoundLocationID(*v)
+	}
+	return _u
+}
+
+// ClearFoundLocationID clears the value of the "found_location_id" field.
+func (_u *StocktakeEntryUpdate) ClearFoundLocationID() *StocktakeEntryUpdate {
+	_u.mutation.ClearFoundLocationID()
+	return _u
+}
+
+// SetFoundAt sets the "found_at" field.
+func (_u *StocktakeEntryUpdate) SetFoundAt(v time.Time) *StocktakeEntryUpdate {
+	_u.mutation.SetFoundAt(v)
+	return _u
+}
+
+// SetNillableFoundAt sets the "found_at" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableFoundAt(v *time.Time) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetFoundAt(*v)
+	}
+	return _u
+}
+
+// ClearFoundAt clears the value of the "found_at" field.
+func (_u *StocktakeEntryUpdate) ClearFoundAt() *StocktakeEntryUpdate {
+	_u.mutation.ClearFoundAt()
+	return _u
+}
+
+// SetFoundBy sets the "found_by" field.
+func (_u *StocktakeEntryUpdate) SetFoundBy(v uuid.UUID) *StocktakeEntryUpdate {
+	_u.mutation.SetFoundBy(v)
+	return _u
+}
+
+// SetNillableFoundBy sets the "found_by" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableFoundBy(v *uuid.UUID) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetFoundBy(*v)
+	}
+	return _u
+}
+
+// ClearFoundBy clears the value of the "found_by" field.
+func (_u *StocktakeEntryUpdate) ClearFoundBy() *StocktakeEntryUpdate {
+	_u.mutation.ClearFoundBy()
+	return _u
+}
+
+// SetOnLoan sets the "on_loan" field.
+func (_u *StocktakeEntryUpdate) SetOnLoan(v bool) *StocktakeEntryUpdate {
+	_u.mutation.SetOnLoan(v)
+	return _u
+}
+
+// SetNillableOnLoan sets the "on_loan" field if the given value is not nil.
+func (_u *StocktakeEntryUpdate) SetNillableOnLoan(v *bool) *StocktakeEntryUpdate {
+	if v != nil {
+		_u.SetOnLoan(*v)
+	}
+	return _u
+}
+
+// SetSession sets the "session" edge to the StocktakeSession entity.
+func (_u *StocktakeEntryUpdate) SetSession(v *StocktakeSession) *StocktakeEntryUpdate {
+	return _u.SetSessionID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *StocktakeEntryUpdate) SetItem(v *Item) *StocktakeEntryUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the StocktakeEntryMutation object of the builder.
+func (_u *StocktakeEntryUpdate) Mutation() *StocktakeEntryMutation {
+	return _u.mutation
+}
+
+// ClearSession clears the "session" edge to the StocktakeSession entity.
+func (_u *StocktakeEntryUpdate) ClearSession() *StocktakeEntryUpdate {
+	_u.mutation.ClearSession()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *StocktakeEntryUpdate) ClearItem() *StocktakeEntryUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StocktakeEntryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StocktakeEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StocktakeEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StocktakeEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StocktakeEntryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := stocktakeentry.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StocktakeEntryUpdate) check() error {
+	if v, ok := _u.mutation.Value(); ok {
+		if err := stocktakeentry.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "StocktakeEntry.value": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StocktakeEntry.session"`)
+	}
+	return nil
+}
+
+func (_u *StocktakeEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stocktakeentry.Table, stocktakeentry.Columns, sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocktakeentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(stocktakeentry.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(stocktakeentry.FieldValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Expected(); ok {
+		_spec.SetField(stocktakeentry.FieldExpected, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpectedLocationID(); ok {
+		_spec.SetField(stocktakeentry.FieldExpectedLocationID, field.TypeUUID, value)
+	}
+	if _u.mutation.ExpectedLocationIDCleared() {
+		_spec.ClearField(stocktakeentry.FieldExpectedLocationID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FoundLocationID(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundLocationID, field.TypeUUID, value)
+	}
+	if _u.mutation.FoundLocationIDCleared() {
+		_spec.ClearField(stocktakeentry.FieldFoundLocationID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FoundAt(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundAt, field.TypeTime, value)
+	}
+	if _u.mutation.FoundAtCleared() {
+		_spec.ClearField(stocktakeentry.FieldFoundAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FoundBy(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundBy, field.TypeUUID, value)
+	}
+	if _u.mutation.FoundByCleared() {
+		_spec.ClearField(stocktakeentry.FieldFoundBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.OnLoan(); ok {
+		_spec.SetField(stocktakeentry.FieldOnLoan, field.TypeBool, value)
+	}
+	if _u.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.SessionTable,
+			Columns: []string{stocktakeentry.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.SessionTable,
+			Columns: []string{stocktakeentry.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.ItemTable,
+			Columns: []string{stocktakeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.ItemTable,
+			Columns: []string{stocktakeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stocktakeentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StocktakeEntryUpdateOne is the builder for updating a single StocktakeEntry entity.
+type StocktakeEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StocktakeEntryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StocktakeEntryUpdateOne) SetUpdatedAt(v time.Time) *StocktakeEntryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *StocktakeEntryUpdateOne) SetSessionID(v uuid.UUID) *StocktakeEntryUpdateOne {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableSessionID(v *uuid.UUID) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *StocktakeEntryUpdateOne) SetItemID(v uuid.UUID) *StocktakeEntryUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableItemID(v *uuid.UUID) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *StocktakeEntryUpdateOne) ClearItemID() *StocktakeEntryUpdateOne {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *StocktakeEntryUpdateOne) SetValue(v string) *StocktakeEntryUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableValue(v *string) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// ClearValue clears the value of the "value" field.
+func (_u *StocktakeEntryUpdateOne) ClearValue() *StocktakeEntryUpdateOne {
+	_u.mutation.ClearValue()
+	return _u
+}
+
+// SetExpected sets the "expected" field.
+func (_u *StocktakeEntryUpdateOne) SetExpected(v bool) *StocktakeEntryUpdateOne {
+	_u.mutation.SetExpected(v)
+	return _u
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableExpected(v *bool) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetExpected(*v)
+	}
+	return _u
+}
+
+// SetExpectedLocationID sets the "expected_location_id" field.
+func (_u *StocktakeEntryUpdateOne) SetExpectedLocationID(v uuid.UUID) *StocktakeEntryUpdateOne {
+	_u.mutation.SetExpectedLocationID(v)
+	return _u
+}
+
+// SetNillableExpectedLocationID sets the "expected_location_id" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableExpectedLocationID(v *uuid.UUID) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetExpectedLocationID(*v)
+	}
+	return _u
+}
+
+// ClearExpectedLocationID clears the value of the "expected_location_id" field.
+func (_u *StocktakeEntryUpdateOne) ClearExpectedLocationID() *StocktakeEntryUpdateOne {
+	_u.mutation.ClearExpectedLocationID()
+	return _u
+}
+
+// SetFoundLocationID sets the "found_location_id" field.
+func (_u *StocktakeEntryUpdateOne) SetFoundLocationID(v uuid.UUID) *StocktakeEntryUpdateOne {
+	_u.mutation.SetFoundLocationID(v)
+	return _u
+}
+
+// SetNillableFoundLocationID sets the "found_location_id" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableFoundLocationID(v *uuid.UUID) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetFoundLocationID(*v)
+	}
+	return _u
+}
+
+// ClearFoundLocationID clears the value of the "found_location_id" field.
+func (_u *StocktakeEntryUpdateOne) ClearFoundLocationID() *StocktakeEntryUpdateOne {
+	_u.mutation.ClearFoundLocationID()
+	return _u
+}
+
+// SetFoundAt sets the "found_at" field.
+func (_u *StocktakeEntryUpdateOne) SetFoundAt(v time.Time) *StocktakeEntryUpdateOne {
+	_u.mutation.SetFoundAt(v)
+	return _u
+}
+
+// SetNillableFoundAt sets the "found_at" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableFoundAt(v *time.Time) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetFoundAt(*v)
+	}
+	return _u
+}
+
+// ClearFoundAt clears the value of the "found_at" field.
+func (_u *StocktakeEntryUpdateOne) ClearFoundAt() *StocktakeEntryUpdateOne {
+	_u.mutation.ClearFoundAt()
+	return _u
+}
+
+// SetFoundBy sets the "found_by" field.
+func (_u *StocktakeEntryUpdateOne) SetFoundBy(v uuid.UUID) *StocktakeEntryUpdateOne {
+	_u.mutation.SetFoundBy(v)
+	return _u
+}
+
+// SetNillableFoundBy sets the "found_by" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableFoundBy(v *uuid.UUID) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetFoundBy(*v)
+	}
+	return _u
+}
+
+// ClearFoundBy clears the value of the "found_by" field.
+func (_u *StocktakeEntryUpdateOne) ClearFoundBy() *StocktakeEntryUpdateOne {
+	_u.mutation.ClearFoundBy()
+	return _u
+}
+
+// SetOnLoan sets the "on_loan" field.
+func (_u *StocktakeEntryUpdateOne) SetOnLoan(v bool) *StocktakeEntryUpdateOne {
+	_u.mutation.SetOnLoan(v)
+	return _u
+}
+
+// SetNillableOnLoan sets the "on_loan" field if the given value is not nil.
+func (_u *StocktakeEntryUpdateOne) SetNillableOnLoan(v *bool) *StocktakeEntryUpdateOne {
+	if v != nil {
+		_u.SetOnLoan(*v)
+	}
+	return _u
+}
+
+// SetSession sets the "session" edge to the StocktakeSession entity.
+func (_u *StocktakeEntryUpdateOne) SetSession(v *StocktakeSession) *StocktakeEntryUpdateOne {
+	return _u.SetSessionID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *StocktakeEntryUpdateOne) SetItem(v *Item) *StocktakeEntryUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the StocktakeEntryMutation object of the builder.
+func (_u *StocktakeEntryUpdateOne) Mutation() *StocktakeEntryMutation {
+	return _u.mutation
+}
+
+// ClearSession clears the "session" edge to the StocktakeSession entity.
+func (_u *StocktakeEntryUpdateOne) ClearSession() *StocktakeEntryUpdateOne {
+	_u.mutation.ClearSession()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *StocktakeEntryUpdateOne) ClearItem() *StocktakeEntryUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Where appends a list predicates to the StocktakeEntryUpdate builder.
+func (_u *StocktakeEntryUpdateOne) Where(ps ...predicate.StocktakeEntry) *StocktakeEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StocktakeEntryUpdateOne) Select(field string, fields ...string) *StocktakeEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StocktakeEntry entity.
+func (_u *StocktakeEntryUpdateOne) Save(ctx context.Context) (*StocktakeEntry, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StocktakeEntryUpdateOne) SaveX(ctx context.Context) *StocktakeEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StocktakeEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StocktakeEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StocktakeEntryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := stocktakeentry.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StocktakeEntryUpdateOne) check() error {
+	if v, ok := _u.mutation.Value(); ok {
+		if err := stocktakeentry.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "StocktakeEntry.value": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StocktakeEntry.session"`)
+	}
+	return nil
+}
+
+func (_u *StocktakeEntryUpdateOne) sqlSave(ctx context.Context) (_node *StocktakeEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stocktakeentry.Table, stocktakeentry.Columns, sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StocktakeEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stocktakeentry.FieldID)
+		for _, f := range fields {
+			if !stocktakeentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stocktakeentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocktakeentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(stocktakeentry.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(stocktakeentry.FieldValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Expected(); ok {
+		_spec.SetField(stocktakeentry.FieldExpected, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpectedLocationID(); ok {
+		_spec.SetField(stocktakeentry.FieldExpectedLocationID, field.TypeUUID, value)
+	}
+	if _u.mutation.ExpectedLocationIDCleared() {
+		_spec.ClearField(stocktakeentry.FieldExpectedLocationID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FoundLocationID(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundLocationID, field.TypeUUID, value)
+	}
+	if _u.mutation.FoundLocationIDCleared() {
+		_spec.ClearField(stocktakeentry.FieldFoundLocationID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FoundAt(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundAt, field.TypeTime, value)
+	}
+	if _u.mutation.FoundAtCleared() {
+		_spec.ClearField(stocktakeentry.FieldFoundAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FoundBy(); ok {
+		_spec.SetField(stocktakeentry.FieldFoundBy, field.TypeUUID, value)
+	}
+	if _u.mutation.FoundByCleared() {
+		_spec.ClearField(stocktakeentry.FieldFoundBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.OnLoan(); ok {
+		_spec.SetField(stocktakeentry.FieldOnLoan, field.TypeBool, value)
+	}
+	if _u.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.SessionTable,
+			Columns: []string{stocktakeentry.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.SessionTable,
+			Columns: []string{stocktakeentry.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.ItemTable,
+			Columns: []string{stocktakeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakeentry.ItemTable,
+			Columns: []string{stocktakeentry.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StocktakeEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stocktakeentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
+)
+
+// StocktakeSession is the model entity for the StocktakeSession schema.
+type StocktakeSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID uuid.UUID `json:"location_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status stocktakesession.Status `json:"status,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// StartedBy holds the value of the "started_by" field.
+	StartedBy *uuid.UUID `json:"started_by,omitempty"`
+	// ClosedBy holds the value of the "closed_by" field.
+	ClosedBy *uuid.UUID `json:"closed_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StocktakeSessionQuery when eager-loading is set.
+	Edges        StocktakeSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StocktakeSessionEdges holds the relations/edges for other nodes in the graph.
+type StocktakeSessionEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// Entries holds the value of the entries edge.
+	Entries []*StocktakeEntry `json:"entries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StocktakeSessionEdges) GroupOrErr() (*Group, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: group.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StocktakeSessionEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// EntriesOrErr returns the Entries value or an error if the edge
+// was not loaded in eager-loading.
+func (e StocktakeSessionEdges) EntriesOrErr() ([]*StocktakeEntry, error) {
+	if e.loadedTypes[2] {
+		return e.Entries, nil
+	}
+	return nil, &NotLoadedError{edge: "entries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StocktakeSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stocktakesession.FieldStartedBy, stocktakesession.FieldClosedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case stocktakesession.FieldName, stocktakesession.FieldNotes, stocktakesession.FieldStatus:
+			values[i] = new(sql.NullString)
+		case stocktakesession.FieldCreatedAt, stocktakesession.FieldUpdatedAt, stocktakesession.FieldClosedAt:
+			values[i] = new(sql.NullTime)
+		case stocktakesession.FieldID, stocktakesession.FieldGroupID, stocktakesession.FieldLocationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StocktakeSession fields.
+func (_m *StocktakeSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stocktakesession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case stocktakesession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case stocktakesession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case stocktakesession.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				_m.GroupID = *value
+			}
+		case stocktakesession.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case stocktakesession.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case stocktakesession.FieldLocationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value != nil {
+				_m.LocationID = *value
+			}
+		case stocktakesession.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = stocktakesession.Status(value.String)
+			}
+		case stocktakesession.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case stocktakesession.FieldStartedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field started_by", values[i])
+			} else if value.Valid {
+				_m.StartedBy = new(uuid.UUID)
+				*_m.StartedBy = *value.S.(*uuid.UUID)
+			}
+		case stocktakesession.FieldClosedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_by", values[i])
+			} else if value.Valid {
+				_m.ClosedBy = new(uuid.UUID)
+				*_m.ClosedBy = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StocktakeSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *StocktakeSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the StocktakeSession entity.
+func (_m *StocktakeSession) QueryGroup() *GroupQuery {
+	return NewStocktakeSessionClient(_m.config).QueryGroup(_m)
+}
+
+// QueryLocation queries the "location" edge of the StocktakeSession entity.
+func (_m *StocktakeSession) QueryLocation() *LocationQuery {
+	return NewStocktakeSessionClient(_m.config).QueryLocation(_m)
+}
+
+// QueryEntries queries the "entries" edge of the StocktakeSession entity.
+func (_m *StocktakeSession) QueryEntries() *StocktakeEntryQuery {
+	return NewStocktakeSessionClient(_m.config).QueryEntries(_m)
+}
+
+// Update returns a builder for updating this StocktakeSession.
+// Note that you need to call StocktakeSession.Unwrap() before calling this method if this StocktakeSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StocktakeSession) Update() *StocktakeSessionUpdateOne {
+	return NewStocktakeSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StocktakeSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StocktakeSession) Unwrap() *StocktakeSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StocktakeSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StocktakeSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("StocktakeSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("location_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LocationID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.StartedBy; v != nil {
+		builder.WriteString("started_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosedBy; v != nil {
+		builder.WriteString("closed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StocktakeSessions is a parsable slice of StocktakeSession.
+type StocktakeSessions []*StocktakeSession
@@ -0,0 +1,478 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
+)
+
+// StocktakeSessionCreate is the builder for creating a StocktakeSession entity.
+type StocktakeSessionCreate struct {
+	config
+	mutation *StocktakeSessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StocktakeSessionCreate) SetCreatedAt(v time.Time) *StocktakeSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StocktakeSessionCreate) SetNillableCreatedAt(v *time.Time) *StocktakeSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StocktakeSessionCreate) SetUpdatedAt(v time.Time) *StocktakeSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StocktakeSessionCreate) SetNillableUpdatedAt(v *time.Time) *StocktakeSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *StocktakeSessionCreate) SetGroupID(v uuid.UUID) *StocktakeSessionCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *StocktakeSessionCreate) SetName(v string) *StocktakeSessionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *StocktakeSessionCreate) SetNotes(v string) *StocktakeSessionCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *StocktakeSessionCreate) SetNillableNotes(v *string) *StocktakeSessionCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetLocationID sets the "location_id" field.
+func (_c *StocktakeSessionCreate) SetLocationID(v uuid.UUID) *StocktakeSessionCreate {
+	_c.mutation.SetLocationID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *StocktakeSessionCreate) SetStatus(v stocktakesession.Status) *StocktakeSessionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *StocktakeSessionCreate) SetNillableStatus(v *stocktakesession.Status) *StocktakeSessionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *StocktakeSessionCreate) SetClosedAt(v time.Time) *StocktakeSessionCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *StocktakeSessionCreate) SetNillableClosedAt(v *time.Time) *StocktakeSessionCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetStartedBy sets the "started_by" field.
+func (_c *StocktakeSessionCreate) SetStartedBy(v uuid.UUID) *StocktakeSessionCreate {
+	_c.mutation.SetStartedBy(v)
+	return _c
+}
+
+// SetNillableStartedBy sets the "started_by" field if the given value is not nil.
+func (_c *StocktakeSessionCreate) SetNillableStartedBy(v *uuid.UUID) *StocktakeSessionCreate {
+	if v != nil {
+		_c.SetStartedBy(*v)
+	}
+	return _c
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_c *StocktakeSessionCreate) SetClosedBy(v uuid.UUID) *StocktakeSessionCreate {
+	_c.mutation.SetClosedBy(v)
+	return _c
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_c *StocktakeSessionCreate) SetNillableClosedBy(v *uuid.UUID) *StocktakeSessionCreate {
+	if v != nil {
+		_c.SetClosedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StocktakeSessionCreate) SetID(v uuid.UUID) *StocktakeSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *StocktakeSessionCreate) SetNillableID(v *uuid.UUID) *StocktakeSessionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_c *StocktakeSessionCreate) SetGroup(v *Group) *StocktakeSessionCreate {
+	return _c.SetGroupID(v.ID)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_c *StocktakeSessionCreate) SetLocation(v *Location) *StocktakeSessionCreate {
+	return _c.SetLocationID(v.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the StocktakeEntry entity by IDs.
+func (_c *StocktakeSessionCreate) AddEntryIDs(ids ...uuid.UUID) *StocktakeSessionCreate {
+	_c.mutation.AddEntryIDs(ids...)
+	return _c
+}
+
+// AddEntries adds the "entries" edges to the StocktakeEntry entity.
+func (_c *StocktakeSessionCreate) AddEntries(v ...*StocktakeEntry) *StocktakeSessionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEntryIDs(ids...)
+}
+
+// Mutation returns the StocktakeSessionMutation object of the builder.
+func (_c *StocktakeSessionCreate) Mutation() *StocktakeSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the StocktakeSession in the database.
+func (_c *StocktakeSessionCreate) Save(ctx context.Context) (*StocktakeSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StocktakeSessionCreate) SaveX(ctx context.Context) *StocktakeSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StocktakeSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StocktakeSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StocktakeSessionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := stocktakesession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := stocktakesession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := stocktakesession.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := stocktakesession.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StocktakeSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StocktakeSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "StocktakeSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "StocktakeSession.group_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "StocktakeSession.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := stocktakesession.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Notes(); ok {
+		if err := stocktakesession.NotesValidator(v); err != nil {
+			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.notes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LocationID(); !ok {
+		return &ValidationError{Name: "location_id", err: errors.New(`ent: missing required field "StocktakeSession.location_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "StocktakeSession.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := stocktakesession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "StocktakeSession.group"`)}
+	}
+	if len(_c.mutation.LocationIDs()) == 0 {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required edge "StocktakeSession.location"`)}
+	}
+	return nil
+}
+
+func (_c *StocktakeSessionCreate) sqlSave(ctx context.Context) (*StocktakeSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StocktakeSessionCreate) createSpec() (*StocktakeSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StocktakeSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stocktakesession.Table, sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(stocktakesession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocktakesession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(stocktakesession.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(stocktakesession.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(stocktakesession.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(stocktakesession.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.StartedBy(); ok {
+		_spec.SetField(stocktakesession.FieldStartedBy, field.TypeUUID, value)
+		_node.StartedBy = &value
+	}
+	if value, ok := _c.mutation.ClosedBy(); ok {
+		_spec.SetField(stocktakesession.FieldClosedBy, field.TypeUUID, value)
+		_node.ClosedBy = &value
+	}
+	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.GroupTable,
+			Columns: []string{stocktakesession.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.LocationTable,
+			Columns: []string{stocktakesession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stocktakesession.EntriesTable,
+			Columns: []string{stocktakesession.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StocktakeSessionCreateBulk is the builder for creating many StocktakeSession entities in bulk.
+type StocktakeSessionCreateBulk struct {
+	config
+	err      error
+	builders []*StocktakeSessionCreate
+}
+
+// Save creates the StocktakeSession entities in the database.
+func (_c *StocktakeSessionCreateBulk) Save(ctx context.Context) ([]*StocktakeSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StocktakeSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StocktakeSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StocktakeSessionCreateBulk) SaveX(ctx context.Context) []*StocktakeSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StocktakeSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StocktakeSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
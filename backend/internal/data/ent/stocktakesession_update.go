@@ -0,0 +1,928 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
+)
+
+// StocktakeSessionUpdate is the builder for updating StocktakeSession entities.
+type StocktakeSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StocktakeSessionMutation
+}
+
+// Where appends a list predicates to the StocktakeSessionUpdate builder.
+func (_u *StocktakeSessionUpdate) Where(ps ...predicate.StocktakeSession) *StocktakeSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StocktakeSessionUpdate) SetUpdatedAt(v time.Time) *StocktakeSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *StocktakeSessionUpdate) SetGroupID(v uuid.UUID) *StocktakeSessionUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *StocktakeSessionUpdate) SetNillableGroupID(v *uuid.UUID) *StocktakeSessionUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *StocktakeSessionUpdate) SetName(v string) *StocktakeSessionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *StocktakeSessionUpdate) SetNillableName(v *string) *StocktakeSessionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *StocktakeSessionUpdate) SetNotes(v string) *StocktakeSessionUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *StocktakeSessionUpdate) SetNillableNotes(v *string) *StocktakeSessionUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *StocktakeSessionUpdate) ClearNotes() *StocktakeSessionUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetLocationID sets the "location_id" field.
+func (_u *StocktakeSessionUpdate) SetLocationID(v uuid.UUID) *StocktakeSessionUpdate {
+	_u.mutation.SetLocationID(v)
+	return _u
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (_u *StocktakeSessionUpdate) SetNillableLocationID(v *uuid.UUID) *StocktakeSessionUpdate {
+	if v != nil {
+		_u.SetLocationID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *StocktakeSessionUpdate) SetStatus(v stocktakesession.Status) *StocktakeSessionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StocktakeSessionUpdate) SetNillableStatus(v *stocktakesession.Status) *StocktakeSessionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *StocktakeSessionUpdate) SetClosedAt(v time.Time) *StocktakeSessionUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *StocktakeSessionUpdate) SetNillableClosedAt(v *time.Time) *StocktakeSessionUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *StocktakeSessionUpdate) ClearClosedAt() *StocktakeSessionUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetStartedBy sets the "started_by" field.
+func (_u *StocktakeSessionUpdate) SetStartedBy(v uuid.UUID) *StocktakeSessionUpdate {
+	_u.mutation.SetStartedBy(v)
+	return _u
+}
+
+// SetNillableStartedBy sets the "started_by" field if the given value is not nil.
+func (_u *StocktakeSessionUpdate) SetNillableStartedBy(v *uuid.UUID) *StocktakeSessionUpdate {
+	if v != nil {
+		_u.SetStartedBy(*v)
+	}
+	return _u
+}
+
+// ClearStartedBy clears the value of the "started_by" field.
+func (_u *StocktakeSessionUpdate) ClearStartedBy() *StocktakeSessionUpdate {
+	_u.mutation.ClearStartedBy()
+	return _u
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_u *StocktakeSessionUpdate) SetClosedBy(v uuid.UUID) *StocktakeSessionUpdate {
+	_u.mutation.SetClosedBy(v)
+	return _u
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_u *StocktakeSessionUpdate) SetNillableClosedBy(v *uuid.UUID) *StocktakeSessionUpdate {
+	if v != nil {
+		_u.SetClosedBy(*v)
+	}
+	return _u
+}
+
+// ClearClosedBy clears the value of the "closed_by" field.
+func (_u *StocktakeSessionUpdate) ClearClosedBy() *StocktakeSessionUpdate {
+	_u.mutation.ClearClosedBy()
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *StocktakeSessionUpdate) SetGroup(v *Group) *StocktakeSessionUpdate {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *StocktakeSessionUpdate) SetLocation(v *Location) *StocktakeSessionUpdate {
+	return _u.SetLocationID(v.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the StocktakeEntry entity by IDs.
+func (_u *StocktakeSessionUpdate) AddEntryIDs(ids ...uuid.UUID) *StocktakeSessionUpdate {
+	_u.mutation.AddEntryIDs(ids...)
+	return _u
+}
+
+// AddEntries adds the "entries" edges to the StocktakeEntry entity.
+func (_u *StocktakeSessionUpdate) AddEntries(v ...*StocktakeEntry) *StocktakeSessionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEntryIDs(ids...)
+}
+
+// Mutation returns the StocktakeSessionMutation object of the builder.
+func (_u *StocktakeSessionUpdate) Mutation() *StocktakeSessionMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *StocktakeSessionUpdate) ClearGroup() *StocktakeSessionUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *StocktakeSessionUpdate) ClearLocation() *StocktakeSessionUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// ClearEntries clears all "entries" edges to the StocktakeEntry entity.
+func (_u *StocktakeSessionUpdate) ClearEntries() *StocktakeSessionUpdate {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// RemoveEntryIDs removes the "entries" edge to StocktakeEntry entities by IDs.
+func (_u *StocktakeSessionUpdate) RemoveEntryIDs(ids ...uuid.UUID) *StocktakeSessionUpdate {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to StocktakeEntry entities.
+func (_u *StocktakeSessionUpdate) RemoveEntries(v ...*StocktakeEntry) *StocktakeSessionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StocktakeSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StocktakeSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StocktakeSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StocktakeSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StocktakeSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := stocktakesession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StocktakeSessionUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := stocktakesession.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Notes(); ok {
+		if err := stocktakesession.NotesValidator(v); err != nil {
+			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.notes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := stocktakesession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.status": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StocktakeSession.group"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StocktakeSession.location"`)
+	}
+	return nil
+}
+
+func (_u *StocktakeSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stocktakesession.Table, stocktakesession.Columns, sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocktakesession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(stocktakesession.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(stocktakesession.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(stocktakesession.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(stocktakesession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(stocktakesession.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(stocktakesession.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartedBy(); ok {
+		_spec.SetField(stocktakesession.FieldStartedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.StartedByCleared() {
+		_spec.ClearField(stocktakesession.FieldStartedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ClosedBy(); ok {
+		_spec.SetField(stocktakesession.FieldClosedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ClosedByCleared() {
+		_spec.ClearField(stocktakesession.FieldClosedBy, field.TypeUUID)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.GroupTable,
+			Columns: []string{stocktakesession.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.GroupTable,
+			Columns: []string{stocktakesession.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.LocationTable,
+			Columns: []string{stocktakesession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.LocationTable,
+			Columns: []string{stocktakesession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stocktakesession.EntriesTable,
+			Columns: []string{stocktakesession.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !_u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stocktakesession.EntriesTable,
+			Columns: []string{stocktakesession.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stocktakesession.EntriesTable,
+			Columns: []string{stocktakesession.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stocktakesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StocktakeSessionUpdateOne is the builder for updating a single StocktakeSession entity.
+type StocktakeSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StocktakeSessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StocktakeSessionUpdateOne) SetUpdatedAt(v time.Time) *StocktakeSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *StocktakeSessionUpdateOne) SetGroupID(v uuid.UUID) *StocktakeSessionUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *StocktakeSessionUpdateOne) SetNillableGroupID(v *uuid.UUID) *StocktakeSessionUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *StocktakeSessionUpdateOne) SetName(v string) *StocktakeSessionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *StocktakeSessionUpdateOne) SetNillableName(v *string) *StocktakeSessionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *StocktakeSessionUpdateOne) SetNotes(v string) *StocktakeSessionUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *StocktakeSessionUpdateOne) SetNillableNotes(v *string) *StocktakeSessionUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *StocktakeSessionUpdateOne) ClearNotes() *StocktakeSessionUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetLocationID sets the "location_id" field.
+func (_u *StocktakeSessionUpdateOne) SetLocationID(v uuid.UUID) *StocktakeSessionUpdateOne {
+	_u.mutation.SetLocationID(v)
+	return _u
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (_u *StocktakeSessionUpdateOne) SetNillableLocationID(v *uuid.UUID) *StocktakeSessionUpdateOne {
+	if v != nil {
+		_u.SetLocationID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *StocktakeSessionUpdateOne) SetStatus(v stocktakesession.Status) *StocktakeSessionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StocktakeSessionUpdateOne) SetNillableStatus(v *stocktakesession.Status) *StocktakeSessionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *StocktakeSessionUpdateOne) SetClosedAt(v time.Time) *StocktakeSessionUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *StocktakeSessionUpdateOne) SetNillableClosedAt(v *time.Time) *StocktakeSessionUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *StocktakeSessionUpdateOne) ClearClosedAt() *StocktakeSessionUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetStartedBy sets the "started_by" field.
+func (_u *StocktakeSessionUpdateOne) SetStartedBy(v uuid.UUID) *StocktakeSessionUpdateOne {
+	_u.mutation.SetStartedBy(v)
+	return _u
+}
+
+// SetNillableStartedBy sets the "started_by" field if the given value is not nil.
+func (_u *StocktakeSessionUpdateOne) SetNillableStartedBy(v *uuid.UUID) *StocktakeSessionUpdateOne {
+	if v != nil {
+		_u.SetStartedBy(*v)
+	}
+	return _u
+}
+
+// ClearStartedBy clears the value of the "started_by" field.
+func (_u *StocktakeSessionUpdateOne) ClearStartedBy() *StocktakeSessionUpdateOne {
+	_u.mutation.ClearStartedBy()
+	return _u
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (_u *StocktakeSessionUpdateOne) SetClosedBy(v uuid.UUID) *StocktakeSessionUpdateOne {
+	_u.mutation.SetClosedBy(v)
+	return _u
+}
+
+// SetNillableClosedBy sets the "closed_by" field if the given value is not nil.
+func (_u *StocktakeSessionUpdateOne) SetNillableClosedBy(v *uuid.UUID) *StocktakeSessionUpdateOne {
+	if v != nil {
+		_u.SetClosedBy(*v)
+	}
+	return _u
+}
+
+// ClearClosedBy clears the value of the "closed_by" field.
+func (_u *StocktakeSessionUpdateOne) ClearClosedBy() *StocktakeSessionUpdateOne {
+	_u.mutation.ClearClosedBy()
+	return _u
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (_u *StocktakeSessionUpdateOne) SetGroup(v *Group) *StocktakeSessionUpdateOne {
+	return _u.SetGroupID(v.ID)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *StocktakeSessionUpdateOne) SetLocation(v *Location) *StocktakeSessionUpdateOne {
+	return _u.SetLocationID(v.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the StocktakeEntry entity by IDs.
+func (_u *StocktakeSessionUpdateOne) AddEntryIDs(ids ...uuid.UUID) *StocktakeSessionUpdateOne {
+	_u.mutation.AddEntryIDs(ids...)
+	return _u
+}
+
+// AddEntries adds the "entries" edges to the StocktakeEntry entity.
+func (_u *StocktakeSessionUpdateOne) AddEntries(v ...*StocktakeEntry) *StocktakeSessionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEntryIDs(ids...)
+}
+
+// Mutation returns the StocktakeSessionMutation object of the builder.
+func (_u *StocktakeSessionUpdateOne) Mutation() *StocktakeSessionMutation {
+	return _u.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (_u *StocktakeSessionUpdateOne) ClearGroup() *StocktakeSessionUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *StocktakeSessionUpdateOne) ClearLocation() *StocktakeSessionUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// ClearEntries clears all "entries" edges to the StocktakeEntry entity.
+func (_u *StocktakeSessionUpdateOne) ClearEntries() *StocktakeSessionUpdateOne {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// RemoveEntryIDs removes the "entries" edge to StocktakeEntry entities by IDs.
+func (_u *StocktakeSessionUpdateOne) RemoveEntryIDs(ids ...uuid.UUID) *StocktakeSessionUpdateOne {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to StocktakeEntry entities.
+func (_u *StocktakeSessionUpdateOne) RemoveEntries(v ...*StocktakeEntry) *StocktakeSessionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
+// Where appends a list predicates to the StocktakeSessionUpdate builder.
+func (_u *StocktakeSessionUpdateOne) Where(ps ...predicate.StocktakeSession) *StocktakeSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StocktakeSessionUpdateOne) Select(field string, fields ...string) *StocktakeSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StocktakeSession entity.
+func (_u *StocktakeSessionUpdateOne) Save(ctx context.Context) (*StocktakeSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StocktakeSessionUpdateOne) SaveX(ctx context.Context) *StocktakeSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StocktakeSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StocktakeSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StocktakeSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := stocktakesession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StocktakeSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := stocktakesession.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Notes(); ok {
+		if err := stocktakesession.NotesValidator(v); err != nil {
+			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.notes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := stocktakesession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StocktakeSession.status": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupCleared() && len(_u.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StocktakeSession.group"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StocktakeSession.location"`)
+	}
+	return nil
+}
+
+func (_u *StocktakeSessionUpdateOne) sqlSave(ctx context.Context) (_node *StocktakeSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stocktakesession.Table, stocktakesession.Columns, sqlgraph.NewFieldSpec(stocktakesession.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StocktakeSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stocktakesession.FieldID)
+		for _, f := range fields {
+			if !stocktakesession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stocktakesession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(stocktakesession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(stocktakesession.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(stocktakesession.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(stocktakesession.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(stocktakesession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(stocktakesession.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(stocktakesession.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartedBy(); ok {
+		_spec.SetField(stocktakesession.FieldStartedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.StartedByCleared() {
+		_spec.ClearField(stocktakesession.FieldStartedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ClosedBy(); ok {
+		_spec.SetField(stocktakesession.FieldClosedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ClosedByCleared() {
+		_spec.ClearField(stocktakesession.FieldClosedBy, field.TypeUUID)
+	}
+	if _u.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.GroupTable,
+			Columns: []string{stocktakesession.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.GroupTable,
+			Columns: []string{stocktakesession.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.LocationTable,
+			Columns: []string{stocktakesession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   stocktakesession.LocationTable,
+			Columns: []string{stocktakesession.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stocktakesession.EntriesTable,
+			Columns: []string{stocktakesession.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !_u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stocktakesession.EntriesTable,
+			Columns: []string{stocktakesession.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   stocktakesession.EntriesTable,
+			Columns: []string{stocktakesession.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stocktakeentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StocktakeSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stocktakesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -29,7 +29,7 @@ type TemplateField struct {
 	Description string `json:"description,omitempty"`
 	// Type holds the value of the "type" field.
 	Type templatefield.Type `json:"type,omitempty"`
-	// TextValue holds the value of the "text_value" field.
+	// Default value in the text form used by CSV imports, converted to the field's type
 	TextValue string `json:"text_value,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TemplateFieldQuery when eager-loading is set.
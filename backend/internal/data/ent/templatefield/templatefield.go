@@ -95,7 +95,14 @@ type Type string
 
 // Type values.
 const (
-	TypeText Type = "text"
+	TypeText        Type = "text"
+	TypeNumber      Type = "number"
+	TypeDecimal     Type = "decimal"
+	TypeBoolean     Type = "boolean"
+	TypeTime        Type = "time"
+	TypeSelect      Type = "select"
+	TypeMultiselect Type = "multiselect"
+	TypeURL         Type = "url"
 )
 
 func (_type Type) String() string {
@@ -105,7 +112,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeText:
+	case TypeText, TypeNumber, TypeDecimal, TypeBoolean, TypeTime, TypeSelect, TypeMultiselect, TypeURL:
 		return nil
 	default:
 		return fmt.Errorf("templatefield: invalid enum value for type field: %q", _type)
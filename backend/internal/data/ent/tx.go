@@ -14,12 +14,16 @@ type Tx struct {
 	config
 	// Attachment is the client for interacting with the Attachment builders.
 	Attachment *AttachmentClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
 	// AuthRoles is the client for interacting with the AuthRoles builders.
 	AuthRoles *AuthRolesClient
 	// AuthTokens is the client for interacting with the AuthTokens builders.
 	AuthTokens *AuthTokensClient
 	// Borrower is the client for interacting with the Borrower builders.
 	Borrower *BorrowerClient
+	// FieldDefinition is the client for interacting with the FieldDefinition builders.
+	FieldDefinition *FieldDefinitionClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
 	// GroupInvitationToken is the client for interacting with the GroupInvitationToken builders.
@@ -28,12 +32,20 @@ type Tx struct {
 	Item *ItemClient
 	// ItemField is the client for interacting with the ItemField builders.
 	ItemField *ItemFieldClient
+	// ItemIdentifier is the client for interacting with the ItemIdentifier builders.
+	ItemIdentifier *ItemIdentifierClient
+	// ItemStatusChange is the client for interacting with the ItemStatusChange builders.
+	ItemStatusChange *ItemStatusChangeClient
 	// ItemTemplate is the client for interacting with the ItemTemplate builders.
 	ItemTemplate *ItemTemplateClient
 	// KioskSession is the client for interacting with the KioskSession builders.
 	KioskSession *KioskSessionClient
+	// KioskSyncAction is the client for interacting with the KioskSyncAction builders.
+	KioskSyncAction *KioskSyncActionClient
 	// Label is the client for interacting with the Label builders.
 	Label *LabelClient
+	// LabelTemplate is the client for interacting with the LabelTemplate builders.
+	LabelTemplate *LabelTemplateClient
 	// Loan is the client for interacting with the Loan builders.
 	Loan *LoanClient
 	// Location is the client for interacting with the Location builders.
@@ -42,6 +54,18 @@ type Tx struct {
 	MaintenanceEntry *MaintenanceEntryClient
 	// Notifier is the client for interacting with the Notifier builders.
 	Notifier *NotifierClient
+	// PrintJob is the client for interacting with the PrintJob builders.
+	PrintJob *PrintJobClient
+	// Printer is the client for interacting with the Printer builders.
+	Printer *PrinterClient
+	// SavedSearch is the client for interacting with the SavedSearch builders.
+	SavedSearch *SavedSearchClient
+	// StockMovement is the client for interacting with the StockMovement builders.
+	StockMovement *StockMovementClient
+	// StocktakeEntry is the client for interacting with the StocktakeEntry builders.
+	StocktakeEntry *StocktakeEntryClient
+	// StocktakeSession is the client for interacting with the StocktakeSession builders.
+	StocktakeSession *StocktakeSessionClient
 	// TemplateField is the client for interacting with the TemplateField builders.
 	TemplateField *TemplateFieldClient
 	// User is the client for interacting with the User builders.
@@ -178,20 +202,32 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.AuditEntry = NewAuditEntryClient(tx.config)
 	tx.AuthRoles = NewAuthRolesClient(tx.config)
 	tx.AuthTokens = NewAuthTokensClient(tx.config)
 	tx.Borrower = NewBorrowerClient(tx.config)
+	tx.FieldDefinition = NewFieldDefinitionClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
 	tx.GroupInvitationToken = NewGroupInvitationTokenClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
 	tx.ItemField = NewItemFieldClient(tx.config)
+	tx.ItemIdentifier = NewItemIdentifierClient(tx.config)
+	tx.ItemStatusChange = NewItemStatusChangeClient(tx.config)
 	tx.ItemTemplate = NewItemTemplateClient(tx.config)
 	tx.KioskSession = NewKioskSessionClient(tx.config)
+	tx.KioskSyncAction = NewKioskSyncActionClient(tx.config)
 	tx.Label = NewLabelClient(tx.config)
+	tx.LabelTemplate = NewLabelTemplateClient(tx.config)
 	tx.Loan = NewLoanClient(tx.config)
 	tx.Location = NewLocationClient(tx.config)
 	tx.MaintenanceEntry = NewMaintenanceEntryClient(tx.config)
 	tx.Notifier = NewNotifierClient(tx.config)
+	tx.PrintJob = NewPrintJobClient(tx.config)
+	tx.Printer = NewPrinterClient(tx.config)
+	tx.SavedSearch = NewSavedSearchClient(tx.config)
+	tx.StockMovement = NewStockMovementClient(tx.config)
+	tx.StocktakeEntry = NewStocktakeEntryClient(tx.config)
+	tx.StocktakeSession = NewStocktakeSessionClient(tx.config)
 	tx.TemplateField = NewTemplateFieldClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
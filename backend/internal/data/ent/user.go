@@ -57,6 +57,8 @@ type UserEdges struct {
 	AuthTokens []*AuthTokens `json:"auth_tokens,omitempty"`
 	// Notifiers holds the value of the notifiers edge.
 	Notifiers []*Notifier `json:"notifiers,omitempty"`
+	// SavedSearches holds the value of the saved_searches edge.
+	SavedSearches []*SavedSearch `json:"saved_searches,omitempty"`
 	// Checkouts holds the value of the checkouts edge.
 	Checkouts []*Loan `json:"checkouts,omitempty"`
 	// Returns holds the value of the returns edge.
@@ -65,7 +67,7 @@ type UserEdges struct {
 	KioskSession *KioskSession `json:"kiosk_session,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [7]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -97,10 +99,19 @@ func (e UserEdges) NotifiersOrErr() ([]*Notifier, error) {
 	return nil, &NotLoadedError{edge: "notifiers"}
 }
 
+// SavedSearchesOrErr returns the SavedSearches value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SavedSearchesOrErr() ([]*SavedSearch, error) {
+	if e.loadedTypes[3] {
+		return e.SavedSearches, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_searches"}
+}
+
 // CheckoutsOrErr returns the Checkouts value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CheckoutsOrErr() ([]*Loan, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Checkouts, nil
 	}
 	return nil, &NotLoadedError{edge: "checkouts"}
@@ -109,7 +120,7 @@ func (e UserEdges) CheckoutsOrErr() ([]*Loan, error) {
 // ReturnsOrErr returns the Returns value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ReturnsOrErr() ([]*Loan, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Returns, nil
 	}
 	return nil, &NotLoadedError{edge: "returns"}
@@ -120,7 +131,7 @@ func (e UserEdges) ReturnsOrErr() ([]*Loan, error) {
 func (e UserEdges) KioskSessionOrErr() (*KioskSession, error) {
 	if e.KioskSession != nil {
 		return e.KioskSession, nil
-	} else if e.loadedTypes[5] {
+	} else if e.loadedTypes[6] {
 		return nil, &NotFoundError{label: kiosksession.Label}
 	}
 	return nil, &NotLoadedError{edge: "kiosk_session"}
@@ -266,6 +277,11 @@ func (_m *User) QueryNotifiers() *NotifierQuery {
 	return NewUserClient(_m.config).QueryNotifiers(_m)
 }
 
+// QuerySavedSearches queries the "saved_searches" edge of the User entity.
+func (_m *User) QuerySavedSearches() *SavedSearchQuery {
+	return NewUserClient(_m.config).QuerySavedSearches(_m)
+}
+
 // QueryCheckouts queries the "checkouts" edge of the User entity.
 func (_m *User) QueryCheckouts() *LoanQuery {
 	return NewUserClient(_m.config).QueryCheckouts(_m)
@@ -44,6 +44,8 @@ const (
 	EdgeAuthTokens = "auth_tokens"
 	// EdgeNotifiers holds the string denoting the notifiers edge name in mutations.
 	EdgeNotifiers = "notifiers"
+	// EdgeSavedSearches holds the string denoting the saved_searches edge name in mutations.
+	EdgeSavedSearches = "saved_searches"
 	// EdgeCheckouts holds the string denoting the checkouts edge name in mutations.
 	EdgeCheckouts = "checkouts"
 	// EdgeReturns holds the string denoting the returns edge name in mutations.
@@ -73,6 +75,13 @@ const (
 	NotifiersInverseTable = "notifiers"
 	// NotifiersColumn is the table column denoting the notifiers relation/edge.
 	NotifiersColumn = "user_id"
+	// SavedSearchesTable is the table that holds the saved_searches relation/edge.
+	SavedSearchesTable = "saved_searches"
+	// SavedSearchesInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchesInverseTable = "saved_searches"
+	// SavedSearchesColumn is the table column denoting the saved_searches relation/edge.
+	SavedSearchesColumn = "user_id"
 	// CheckoutsTable is the table that holds the checkouts relation/edge.
 	CheckoutsTable = "loans"
 	// CheckoutsInverseTable is the table name for the Loan entity.
@@ -278,6 +287,20 @@ func ByNotifiers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// BySavedSearchesCount orders the results by saved_searches count.
+func BySavedSearchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedSearchesStep(), opts...)
+	}
+}
+
+// BySavedSearches orders the results by saved_searches terms.
+func BySavedSearches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByCheckoutsCount orders the results by checkouts count.
 func ByCheckoutsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -333,6 +356,13 @@ func newNotifiersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NotifiersTable, NotifiersColumn),
 	)
 }
+func newSavedSearchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+	)
+}
 func newCheckoutsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -700,6 +700,29 @@ func HasNotifiersWith(preds ...predicate.Notifier) predicate.User {
 	})
 }
 
+// HasSavedSearches applies the HasEdge predicate on the "saved_searches" edge.
+func HasSavedSearches() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchesWith applies the HasEdge predicate on the "saved_searches" edge with a given conditions (other predicates).
+func HasSavedSearchesWith(preds ...predicate.SavedSearch) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSavedSearchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasCheckouts applies the HasEdge predicate on the "checkouts" edge.
 func HasCheckouts() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -16,6 +16,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -219,6 +220,21 @@ func (_c *UserCreate) AddNotifiers(v ...*Notifier) *UserCreate {
 	return _c.AddNotifierIDs(ids...)
 }
 
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_c *UserCreate) AddSavedSearchIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddSavedSearchIDs(ids...)
+	return _c
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_c *UserCreate) AddSavedSearches(v ...*SavedSearch) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSavedSearchIDs(ids...)
+}
+
 // AddCheckoutIDs adds the "checkouts" edge to the Loan entity by IDs.
 func (_c *UserCreate) AddCheckoutIDs(ids ...uuid.UUID) *UserCreate {
 	_c.mutation.AddCheckoutIDs(ids...)
@@ -503,6 +519,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.CheckoutsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -19,23 +19,25 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx              *QueryContext
-	order            []user.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.User
-	withGroup        *GroupQuery
-	withAuthTokens   *AuthTokensQuery
-	withNotifiers    *NotifierQuery
-	withCheckouts    *LoanQuery
-	withReturns      *LoanQuery
-	withKioskSession *KioskSessionQuery
-	withFKs          bool
+	ctx               *QueryContext
+	order             []user.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.User
+	withGroup         *GroupQuery
+	withAuthTokens    *AuthTokensQuery
+	withNotifiers     *NotifierQuery
+	withSavedSearches *SavedSearchQuery
+	withCheckouts     *LoanQuery
+	withReturns       *LoanQuery
+	withKioskSession  *KioskSessionQuery
+	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -138,6 +140,28 @@ func (_q *UserQuery) QueryNotifiers() *NotifierQuery {
 	return query
 }
 
+// QuerySavedSearches chains the current query on the "saved_searches" edge.
+func (_q *UserQuery) QuerySavedSearches() *SavedSearchQuery {
+	query := (&SavedSearchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SavedSearchesTable, user.SavedSearchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCheckouts chains the current query on the "checkouts" edge.
 func (_q *UserQuery) QueryCheckouts() *LoanQuery {
 	query := (&LoanClient{config: _q.config}).Query()
@@ -391,17 +415,18 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]user.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.User{}, _q.predicates...),
-		withGroup:        _q.withGroup.Clone(),
-		withAuthTokens:   _q.withAuthTokens.Clone(),
-		withNotifiers:    _q.withNotifiers.Clone(),
-		withCheckouts:    _q.withCheckouts.Clone(),
-		withReturns:      _q.withReturns.Clone(),
-		withKioskSession: _q.withKioskSession.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]user.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.User{}, _q.predicates...),
+		withGroup:         _q.withGroup.Clone(),
+		withAuthTokens:    _q.withAuthTokens.Clone(),
+		withNotifiers:     _q.withNotifiers.Clone(),
+		withSavedSearches: _q.withSavedSearches.Clone(),
+		withCheckouts:     _q.withCheckouts.Clone(),
+		withReturns:       _q.withReturns.Clone(),
+		withKioskSession:  _q.withKioskSession.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -441,6 +466,17 @@ func (_q *UserQuery) WithNotifiers(opts ...func(*NotifierQuery)) *UserQuery {
 	return _q
 }
 
+// WithSavedSearches tells the query-builder to eager-load the nodes that are connected to
+// the "saved_searches" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSavedSearches(opts ...func(*SavedSearchQuery)) *UserQuery {
+	query := (&SavedSearchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSavedSearches = query
+	return _q
+}
+
 // WithCheckouts tells the query-builder to eager-load the nodes that are connected to
 // the "checkouts" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithCheckouts(opts ...func(*LoanQuery)) *UserQuery {
@@ -553,10 +589,11 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		nodes       = []*User{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [7]bool{
 			_q.withGroup != nil,
 			_q.withAuthTokens != nil,
 			_q.withNotifiers != nil,
+			_q.withSavedSearches != nil,
 			_q.withCheckouts != nil,
 			_q.withReturns != nil,
 			_q.withKioskSession != nil,
@@ -606,6 +643,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withSavedSearches; query != nil {
+		if err := _q.loadSavedSearches(ctx, query, nodes,
+			func(n *User) { n.Edges.SavedSearches = []*SavedSearch{} },
+			func(n *User, e *SavedSearch) { n.Edges.SavedSearches = append(n.Edges.SavedSearches, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withCheckouts; query != nil {
 		if err := _q.loadCheckouts(ctx, query, nodes,
 			func(n *User) { n.Edges.Checkouts = []*Loan{} },
@@ -722,6 +766,36 @@ func (_q *UserQuery) loadNotifiers(ctx context.Context, query *NotifierQuery, no
 	}
 	return nil
 }
+func (_q *UserQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQuery, nodes []*User, init func(*User), assign func(*User, *SavedSearch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(savedsearch.FieldUserID)
+	}
+	query.Where(predicate.SavedSearch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SavedSearchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *UserQuery) loadCheckouts(ctx context.Context, query *LoanQuery, nodes []*User, init func(*User), assign func(*User, *Loan)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
@@ -18,6 +18,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -231,6 +232,21 @@ func (_u *UserUpdate) AddNotifiers(v ...*Notifier) *UserUpdate {
 	return _u.AddNotifierIDs(ids...)
 }
 
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *UserUpdate) AddSavedSearchIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddSavedSearchIDs(ids...)
+	return _u
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdate) AddSavedSearches(v ...*SavedSearch) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavedSearchIDs(ids...)
+}
+
 // AddCheckoutIDs adds the "checkouts" edge to the Loan entity by IDs.
 func (_u *UserUpdate) AddCheckoutIDs(ids ...uuid.UUID) *UserUpdate {
 	_u.mutation.AddCheckoutIDs(ids...)
@@ -333,6 +349,27 @@ func (_u *UserUpdate) RemoveNotifiers(v ...*Notifier) *UserUpdate {
 	return _u.RemoveNotifierIDs(ids...)
 }
 
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdate) ClearSavedSearches() *UserUpdate {
+	_u.mutation.ClearSavedSearches()
+	return _u
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *UserUpdate) RemoveSavedSearchIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *UserUpdate) RemoveSavedSearches(v ...*SavedSearch) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavedSearchIDs(ids...)
+}
+
 // ClearCheckouts clears all "checkouts" edges to the Loan entity.
 func (_u *UserUpdate) ClearCheckouts() *UserUpdate {
 	_u.mutation.ClearCheckouts()
@@ -618,6 +655,51 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CheckoutsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -954,6 +1036,21 @@ func (_u *UserUpdateOne) AddNotifiers(v ...*Notifier) *UserUpdateOne {
 	return _u.AddNotifierIDs(ids...)
 }
 
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *UserUpdateOne) AddSavedSearchIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddSavedSearchIDs(ids...)
+	return _u
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdateOne) AddSavedSearches(v ...*SavedSearch) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavedSearchIDs(ids...)
+}
+
 // AddCheckoutIDs adds the "checkouts" edge to the Loan entity by IDs.
 func (_u *UserUpdateOne) AddCheckoutIDs(ids ...uuid.UUID) *UserUpdateOne {
 	_u.mutation.AddCheckoutIDs(ids...)
@@ -1056,6 +1153,27 @@ func (_u *UserUpdateOne) RemoveNotifiers(v ...*Notifier) *UserUpdateOne {
 	return _u.RemoveNotifierIDs(ids...)
 }
 
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdateOne) ClearSavedSearches() *UserUpdateOne {
+	_u.mutation.ClearSavedSearches()
+	return _u
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *UserUpdateOne) RemoveSavedSearchIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *UserUpdateOne) RemoveSavedSearches(v ...*SavedSearch) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavedSearchIDs(ids...)
+}
+
 // ClearCheckouts clears all "checkouts" edges to the Loan entity.
 func (_u *UserUpdateOne) ClearCheckouts() *UserUpdateOne {
 	_u.mutation.ClearCheckouts()
@@ -1371,6 +1489,51 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CheckoutsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,86 @@
+package migrations_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/migrations"
+	_ "github.com/sysadminsmedia/homebox/backend/internal/data/migrations/sqlite3"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+	_ "github.com/sysadminsmedia/homebox/backend/pkgs/cgofreesqlite"
+)
+
+// migrated opens a database created by the migrations, as the server does, rather than
+// by ent's auto migration which the repository tests use.
+func migrated(t *testing.T) *ent.Client {
+	t.Helper()
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "homebox.db") + "?_pragma=busy_timeout=999&_pragma=journal_mode=WAL&_fk=1&_time_format=sqlite"
+	client, err := ent.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	fs, err := migrations.Migrations("sqlite3")
+	require.NoError(t, err)
+
+	goose.SetBaseFS(fs)
+	goose.SetLogger(goose.NopLogger())
+	require.NoError(t, goose.SetDialect("sqlite3"))
+	require.NoError(t, goose.Up(client.Sql(), "sqlite3"))
+
+	return client
+}
+
+func TestMigrations_ItemSearch(t *testing.T) {
+	ctx := context.Background()
+	client := migrated(t)
+
+	repos := repo.New(client, eventbus.New(), config.Storage{
+		PrefixPath: "/",
+		ConnString: "file://" + t.TempDir(),
+	}, "mem://{{ .Topic }}", config.Thumbnail{})
+
+	grp, err := repos.Groups.GroupCreate(ctx, "migrations")
+	require.NoError(t, err)
+
+	loc, err := repos.Locations.Create(ctx, grp.ID, repo.LocationCreate{Name: "Shelf"})
+	require.NoError(t, err)
+
+	inName, err := repos.Items.Create(ctx, grp.ID, repo.ItemCreate{Name: "Zebra Lamp", LocationID: loc.ID})
+	require.NoError(t, err)
+	inDescription, err := repos.Items.Create(ctx, grp.ID, repo.ItemCreate{Name: "Aardvark", Description: "a lamp shaped like an aardvark", LocationID: loc.ID})
+	require.NoError(t, err)
+
+	search := func(q string) []string {
+		out, err := repos.Items.QueryByGroup(ctx, grp.ID, repo.ItemQuery{Search: q})
+		require.NoError(t, err)
+
+		names := make([]string, len(out.Items))
+		for i, itm := range out.Items {
+			names[i] = itm.Name
+		}
+		return names
+	}
+
+	// The index ranks matches in the name above matches in the description, scanning
+	// the columns would sort them by name instead
+	assert.Equal(t, []string{inName.Name, inDescription.Name}, search("lamp"))
+
+	// Words are matched by their prefix
+	assert.Equal(t, []string{inName.Name}, search("zeb"))
+
+	// The triggers keep the index up to date
+	require.NoError(t, client.Item.UpdateOneID(inName.ID).SetName("Giraffe Lamp").Exec(ctx))
+	assert.Empty(t, search("zebra"))
+	assert.Equal(t, []string{"Giraffe Lamp"}, search("giraffe"))
+
+	require.NoError(t, client.Item.DeleteOneID(inDescription.ID).Exec(ctx))
+	assert.Equal(t, []string{"Giraffe Lamp"}, search("lamp"))
+}
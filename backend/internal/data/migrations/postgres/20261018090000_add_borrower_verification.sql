@@ -0,0 +1,19 @@
+-- +goose Up
+-- Add verification state for self-registered borrowers
+ALTER TABLE borrowers ADD COLUMN IF NOT EXISTS verification_status VARCHAR NOT NULL DEFAULT 'verified';
+ALTER TABLE borrowers ADD COLUMN IF NOT EXISTS verification_token BYTEA;
+ALTER TABLE borrowers ADD COLUMN IF NOT EXISTS verification_expires_at TIMESTAMPTZ;
+ALTER TABLE borrowers ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMPTZ;
+ALTER TABLE borrowers ADD COLUMN IF NOT EXISTS approved_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS borrower_verification_status ON borrowers(verification_status);
+CREATE INDEX IF NOT EXISTS borrower_verification_token ON borrowers(verification_token);
+
+-- +goose Down
+DROP INDEX IF EXISTS borrower_verification_token;
+DROP INDEX IF EXISTS borrower_verification_status;
+ALTER TABLE borrowers DROP COLUMN IF EXISTS approved_at;
+ALTER TABLE borrowers DROP COLUMN IF EXISTS email_verified_at;
+ALTER TABLE borrowers DROP COLUMN IF EXISTS verification_expires_at;
+ALTER TABLE borrowers DROP COLUMN IF EXISTS verification_token;
+ALTER TABLE borrowers DROP COLUMN IF EXISTS verification_status;
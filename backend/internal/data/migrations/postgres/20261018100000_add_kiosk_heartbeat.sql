@@ -0,0 +1,21 @@
+-- +goose Up
+-- Track kiosk device health reported by heartbeats
+ALTER TABLE kiosk_sessions ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ;
+ALTER TABLE kiosk_sessions ADD COLUMN IF NOT EXISTS last_activity_at TIMESTAMPTZ;
+ALTER TABLE kiosk_sessions ADD COLUMN IF NOT EXISTS app_version VARCHAR(64);
+ALTER TABLE kiosk_sessions ADD COLUMN IF NOT EXISTS battery_level INTEGER;
+ALTER TABLE kiosk_sessions ADD COLUMN IF NOT EXISTS battery_charging BOOLEAN;
+ALTER TABLE kiosk_sessions ADD COLUMN IF NOT EXISTS network_type VARCHAR(32);
+ALTER TABLE kiosk_sessions ADD COLUMN IF NOT EXISTS offline_alerted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS kiosksession_last_seen_at ON kiosk_sessions(last_seen_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS kiosksession_last_seen_at;
+ALTER TABLE kiosk_sessions DROP COLUMN IF EXISTS offline_alerted_at;
+ALTER TABLE kiosk_sessions DROP COLUMN IF EXISTS network_type;
+ALTER TABLE kiosk_sessions DROP COLUMN IF EXISTS battery_charging;
+ALTER TABLE kiosk_sessions DROP COLUMN IF EXISTS battery_level;
+ALTER TABLE kiosk_sessions DROP COLUMN IF EXISTS app_version;
+ALTER TABLE kiosk_sessions DROP COLUMN IF EXISTS last_activity_at;
+ALTER TABLE kiosk_sessions DROP COLUMN IF EXISTS last_seen_at;
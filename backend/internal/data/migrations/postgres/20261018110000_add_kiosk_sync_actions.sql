@@ -0,0 +1,22 @@
+-- +goose Up
+-- Create kiosk_sync_actions table for deduplicating actions replayed by offline kiosks
+CREATE TABLE IF NOT EXISTS kiosk_sync_actions (
+    id                       UUID         NOT NULL PRIMARY KEY,
+    created_at               TIMESTAMPTZ  NOT NULL,
+    updated_at               TIMESTAMPTZ  NOT NULL,
+    idempotency_key          VARCHAR(255) NOT NULL,
+    action                   VARCHAR      NOT NULL,
+    status                   VARCHAR      NOT NULL DEFAULT 'pending',
+    client_timestamp         TIMESTAMPTZ  NOT NULL,
+    result_id                UUID,
+    group_kiosk_sync_actions UUID         NOT NULL
+        CONSTRAINT kiosk_sync_actions_groups_kiosk_sync_actions
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS kiosksyncaction_idempotency_key_group_kiosk_sync_actions ON kiosk_sync_actions(idempotency_key, group_kiosk_sync_actions);
+
+-- +goose Down
+DROP INDEX IF EXISTS kiosksyncaction_idempotency_key_group_kiosk_sync_actions;
+DROP TABLE IF EXISTS kiosk_sync_actions;
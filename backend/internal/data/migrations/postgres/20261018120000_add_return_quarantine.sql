@@ -0,0 +1,17 @@
+-- +goose Up
+-- Per-label post-return quarantine settings
+ALTER TABLE labels ADD COLUMN IF NOT EXISTS quarantine_on_return BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE labels ADD COLUMN IF NOT EXISTS quarantine_minutes BIGINT NOT NULL DEFAULT 0;
+
+-- Quarantine state of returned items awaiting inspection
+ALTER TABLE items ADD COLUMN IF NOT EXISTS quarantined_at TIMESTAMPTZ;
+ALTER TABLE items ADD COLUMN IF NOT EXISTS quarantine_until TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS item_quarantined_at ON items(quarantined_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS item_quarantined_at;
+ALTER TABLE items DROP COLUMN IF EXISTS quarantine_until;
+ALTER TABLE items DROP COLUMN IF EXISTS quarantined_at;
+ALTER TABLE labels DROP COLUMN IF EXISTS quarantine_minutes;
+ALTER TABLE labels DROP COLUMN IF EXISTS quarantine_on_return;
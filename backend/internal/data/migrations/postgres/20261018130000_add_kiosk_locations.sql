@@ -0,0 +1,12 @@
+-- +goose Up
+-- Location a kiosk is bound to; its browsing, checkout and return are limited to that subtree
+ALTER TABLE kiosk_sessions ADD COLUMN IF NOT EXISTS location_kiosk_sessions UUID REFERENCES locations(id) ON DELETE SET NULL;
+
+-- Returns made at a kiosk bound to another site are flagged as transfers
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS transfer BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS location_returned_loans UUID REFERENCES locations(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE loans DROP COLUMN IF EXISTS location_returned_loans;
+ALTER TABLE loans DROP COLUMN IF EXISTS transfer;
+ALTER TABLE kiosk_sessions DROP COLUMN IF EXISTS location_kiosk_sessions;
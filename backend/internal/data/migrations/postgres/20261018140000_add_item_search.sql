@@ -0,0 +1,75 @@
+-- +goose Up
+-- Full-text index over item text and custom field text, kept in sync by triggers
+CREATE TABLE IF NOT EXISTS item_search (
+    item_id  UUID PRIMARY KEY REFERENCES items(id) ON DELETE CASCADE,
+    document TSVECTOR NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS item_search_document ON item_search USING GIN (document);
+
+-- Lowercases and strips the most common accents, matching textutils.NormalizeSearchQuery
+-- without depending on the unaccent extension
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION item_search_normalize(value TEXT) RETURNS TEXT AS $$
+    SELECT translate(lower(coalesce(value, '')), 'áéíóúñèêàçäöüãõ', 'aeiouneeacaouao');
+$$ LANGUAGE sql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION item_search_refresh(target UUID) RETURNS void AS $$
+    INSERT INTO item_search (item_id, document)
+    SELECT i.id,
+           setweight(to_tsvector('simple', item_search_normalize(i.name)), 'A') ||
+           setweight(to_tsvector('simple', item_search_normalize(concat_ws(' ', i.serial_number, i.model_number, i.manufacturer))), 'B') ||
+           setweight(to_tsvector('simple', item_search_normalize(i.description)), 'C') ||
+           setweight(to_tsvector('simple', item_search_normalize(concat_ws(' ', i.notes,
+               (SELECT string_agg(concat_ws(' ', f.name, f.text_value), ' ') FROM item_fields f WHERE f.item_fields = i.id)))), 'D')
+    FROM items i
+    WHERE i.id = target
+    ON CONFLICT (item_id) DO UPDATE SET document = EXCLUDED.document;
+$$ LANGUAGE sql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION item_search_items_trigger() RETURNS trigger AS $$
+BEGIN
+    PERFORM item_search_refresh(NEW.id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION item_search_fields_trigger() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM item_search_refresh(OLD.item_fields);
+    ELSE
+        PERFORM item_search_refresh(NEW.item_fields);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS item_search_items ON items;
+CREATE TRIGGER item_search_items
+    AFTER INSERT OR UPDATE OF name, description, serial_number, model_number, manufacturer, notes ON items
+    FOR EACH ROW EXECUTE FUNCTION item_search_items_trigger();
+
+DROP TRIGGER IF EXISTS item_search_fields ON item_fields;
+CREATE TRIGGER item_search_fields
+    AFTER INSERT OR UPDATE OR DELETE ON item_fields
+    FOR EACH ROW EXECUTE FUNCTION item_search_fields_trigger();
+
+-- Deleted items are removed from the index by the ON DELETE CASCADE above
+SELECT item_search_refresh(id) FROM items;
+
+-- +goose Down
+DROP TRIGGER IF EXISTS item_search_fields ON item_fields;
+DROP TRIGGER IF EXISTS item_search_items ON items;
+DROP FUNCTION IF EXISTS item_search_fields_trigger();
+DROP FUNCTION IF EXISTS item_search_items_trigger();
+DROP FUNCTION IF EXISTS item_search_refresh(UUID);
+DROP FUNCTION IF EXISTS item_search_normalize(TEXT);
+DROP TABLE IF EXISTS item_search;
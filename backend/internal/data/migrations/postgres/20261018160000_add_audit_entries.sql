@@ -0,0 +1,25 @@
+-- +goose Up
+-- Create audit_entries table recording changes to items, locations, labels, borrowers, loans and maintenance
+CREATE TABLE IF NOT EXISTS audit_entries (
+    id          UUID        NOT NULL PRIMARY KEY,
+    created_at  TIMESTAMPTZ NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL,
+    entity_type VARCHAR(64) NOT NULL,
+    entity_id   UUID        NOT NULL,
+    action      VARCHAR     NOT NULL,
+    source      VARCHAR     NOT NULL DEFAULT 'system',
+    actor_id    UUID,
+    changes     TEXT        NOT NULL,
+    group_id    UUID        NOT NULL
+        CONSTRAINT audit_entries_groups_audit_entries
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS auditentry_group_id_created_at ON audit_entries(group_id, created_at);
+CREATE INDEX IF NOT EXISTS auditentry_entity_type_entity_id ON audit_entries(entity_type, entity_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS auditentry_entity_type_entity_id;
+DROP INDEX IF EXISTS auditentry_group_id_created_at;
+DROP TABLE IF EXISTS audit_entries;
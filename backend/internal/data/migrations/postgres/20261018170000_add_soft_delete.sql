@@ -0,0 +1,13 @@
+-- +goose Up
+-- Items and locations are moved to the trash before they are purged
+ALTER TABLE items ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS item_deleted_at ON items(deleted_at);
+CREATE INDEX IF NOT EXISTS location_deleted_at ON locations(deleted_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS location_deleted_at;
+DROP INDEX IF EXISTS item_deleted_at;
+ALTER TABLE locations DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE items DROP COLUMN IF EXISTS deleted_at;
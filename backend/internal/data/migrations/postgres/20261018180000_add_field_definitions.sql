@@ -0,0 +1,25 @@
+-- +goose Up
+-- Create field_definitions table declaring the types of the group's custom fields
+CREATE TABLE IF NOT EXISTS field_definitions (
+    id          UUID           NOT NULL PRIMARY KEY,
+    created_at  TIMESTAMPTZ    NOT NULL,
+    updated_at  TIMESTAMPTZ    NOT NULL,
+    name        VARCHAR(255)   NOT NULL,
+    description VARCHAR(1000),
+    type        VARCHAR        NOT NULL,
+    options     JSONB,
+    group_id    UUID           NOT NULL
+        CONSTRAINT field_definitions_groups_field_definitions
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS fielddefinition_group_id_name ON field_definitions(group_id, name);
+
+-- Number fields can hold decimals
+ALTER TABLE item_fields ALTER COLUMN number_value TYPE DOUBLE PRECISION;
+
+-- +goose Down
+ALTER TABLE item_fields ALTER COLUMN number_value TYPE BIGINT USING round(number_value);
+DROP INDEX IF EXISTS fielddefinition_group_id_name;
+DROP TABLE IF EXISTS field_definitions;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Consumables are low on stock once their quantity drops below min_stock
+ALTER TABLE items ADD COLUMN IF NOT EXISTS min_stock BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE items ADD COLUMN IF NOT EXISTS reorder_quantity BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE items DROP COLUMN IF EXISTS reorder_quantity;
+ALTER TABLE items DROP COLUMN IF EXISTS min_stock;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Create stock_movements table recording every change to the quantity of an item
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id         UUID          NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ   NOT NULL,
+    updated_at TIMESTAMPTZ   NOT NULL,
+    delta      BIGINT        NOT NULL,
+    quantity   BIGINT        NOT NULL,
+    reason     VARCHAR       NOT NULL DEFAULT 'adjustment',
+    actor_id   UUID,
+    note       VARCHAR(1000),
+    group_id   UUID          NOT NULL
+        CONSTRAINT stock_movements_groups_stock_movements
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    item_id    UUID          NOT NULL
+        CONSTRAINT stock_movements_items_stock_movements
+            REFERENCES items(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS stockmovement_item_id_created_at ON stock_movements(item_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS stockmovement_item_id_created_at;
+DROP TABLE IF EXISTS stock_movements;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Asset IDs are formatted per group, labels may number their items in their own series
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS asset_id_prefix VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS asset_id_width BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS asset_id_check_digit VARCHAR NOT NULL DEFAULT 'none';
+ALTER TABLE labels ADD COLUMN IF NOT EXISTS asset_id_prefix VARCHAR(32);
+ALTER TABLE items ADD COLUMN IF NOT EXISTS asset_id_prefix VARCHAR(32) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE items DROP COLUMN IF EXISTS asset_id_prefix;
+ALTER TABLE labels DROP COLUMN IF EXISTS asset_id_prefix;
+ALTER TABLE groups DROP COLUMN IF EXISTS asset_id_check_digit;
+ALTER TABLE groups DROP COLUMN IF EXISTS asset_id_width;
+ALTER TABLE groups DROP COLUMN IF EXISTS asset_id_prefix;
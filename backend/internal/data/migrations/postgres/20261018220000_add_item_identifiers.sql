@@ -0,0 +1,23 @@
+-- +goose Up
+-- Create item_identifiers table holding the scannable codes of items besides their asset ID
+CREATE TABLE IF NOT EXISTS item_identifiers (
+    id         UUID         NOT NULL PRIMARY KEY,
+    created_at TIMESTAMPTZ  NOT NULL,
+    updated_at TIMESTAMPTZ  NOT NULL,
+    type       VARCHAR      NOT NULL DEFAULT 'other',
+    value      VARCHAR(255) NOT NULL,
+    group_id   UUID         NOT NULL
+        CONSTRAINT item_identifiers_groups_item_identifiers
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    item_id    UUID         NOT NULL
+        CONSTRAINT item_identifiers_items_identifiers
+            REFERENCES items(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS itemidentifier_group_id_type_value ON item_identifiers(group_id, type, value);
+
+-- +goose Down
+DROP INDEX IF EXISTS itemidentifier_group_id_type_value;
+DROP TABLE IF EXISTS item_identifiers;
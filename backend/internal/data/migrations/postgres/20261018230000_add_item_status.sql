@@ -0,0 +1,31 @@
+-- +goose Up
+-- Add the lifecycle status of items and the history of its transitions
+ALTER TABLE items ADD COLUMN IF NOT EXISTS status VARCHAR NOT NULL DEFAULT 'in_service';
+
+CREATE INDEX IF NOT EXISTS item_status ON items(status);
+
+CREATE TABLE IF NOT EXISTS item_status_changes (
+    id          UUID          NOT NULL PRIMARY KEY,
+    created_at  TIMESTAMPTZ   NOT NULL,
+    updated_at  TIMESTAMPTZ   NOT NULL,
+    from_status VARCHAR       NOT NULL,
+    to_status   VARCHAR       NOT NULL,
+    reason      VARCHAR(1000),
+    actor_id    UUID,
+    group_id    UUID          NOT NULL
+        CONSTRAINT item_status_changes_groups_item_status_changes
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    item_id     UUID          NOT NULL
+        CONSTRAINT item_status_changes_items_status_changes
+            REFERENCES items(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS itemstatuschange_item_id_created_at ON item_status_changes(item_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS itemstatuschange_item_id_created_at;
+DROP TABLE IF EXISTS item_status_changes;
+DROP INDEX IF EXISTS item_status;
+ALTER TABLE items DROP COLUMN IF EXISTS status;
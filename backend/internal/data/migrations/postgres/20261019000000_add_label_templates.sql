@@ -0,0 +1,21 @@
+-- +goose Up
+-- Create label_templates table holding the group's label layouts
+CREATE TABLE IF NOT EXISTS label_templates (
+    id          UUID           NOT NULL PRIMARY KEY,
+    created_at  TIMESTAMPTZ    NOT NULL,
+    updated_at  TIMESTAMPTZ    NOT NULL,
+    name        VARCHAR(255)   NOT NULL,
+    description VARCHAR(1000),
+    symbology   VARCHAR        NOT NULL DEFAULT 'qr',
+    fields      TEXT           NOT NULL,
+    group_id    UUID           NOT NULL
+        CONSTRAINT label_templates_groups_label_templates
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS labeltemplate_group_id_name ON label_templates(group_id, name);
+
+-- +goose Down
+DROP INDEX IF EXISTS labeltemplate_group_id_name;
+DROP TABLE IF EXISTS label_templates;
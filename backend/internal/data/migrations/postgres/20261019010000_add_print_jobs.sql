@@ -0,0 +1,54 @@
+-- +goose Up
+-- Create printers table holding the group's named printers
+CREATE TABLE IF NOT EXISTS printers (
+    id          UUID          NOT NULL PRIMARY KEY,
+    created_at  TIMESTAMPTZ   NOT NULL,
+    updated_at  TIMESTAMPTZ   NOT NULL,
+    name        VARCHAR(255)  NOT NULL,
+    description VARCHAR(1000),
+    kind        VARCHAR       NOT NULL DEFAULT 'raw',
+    address     VARCHAR(1024),
+    language    VARCHAR       NOT NULL DEFAULT 'zpl',
+    dpi         BIGINT        NOT NULL DEFAULT 203,
+    group_id    UUID          NOT NULL
+        CONSTRAINT printers_groups_printers
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS printer_group_id_name ON printers(group_id, name);
+
+-- Create print_jobs table queueing labels for the printers
+CREATE TABLE IF NOT EXISTS print_jobs (
+    id           UUID          NOT NULL PRIMARY KEY,
+    created_at   TIMESTAMPTZ   NOT NULL,
+    updated_at   TIMESTAMPTZ   NOT NULL,
+    name         VARCHAR(255)  NOT NULL,
+    status       VARCHAR       NOT NULL DEFAULT 'queued',
+    labels       BIGINT        NOT NULL DEFAULT 0,
+    payload      TEXT          NOT NULL,
+    attempts     BIGINT        NOT NULL DEFAULT 0,
+    max_attempts BIGINT        NOT NULL DEFAULT 3,
+    error        VARCHAR(1000),
+    run_at       TIMESTAMPTZ   NOT NULL,
+    finished_at  TIMESTAMPTZ,
+    created_by   UUID,
+    group_id     UUID          NOT NULL
+        CONSTRAINT print_jobs_groups_print_jobs
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    printer_id   UUID          NOT NULL
+        CONSTRAINT print_jobs_printers_jobs
+            REFERENCES printers(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS printjob_status_run_at ON print_jobs(status, run_at);
+CREATE INDEX IF NOT EXISTS printjob_group_id_created_at ON print_jobs(group_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS printjob_group_id_created_at;
+DROP INDEX IF EXISTS printjob_status_run_at;
+DROP TABLE IF EXISTS print_jobs;
+DROP INDEX IF EXISTS printer_group_id_name;
+DROP TABLE IF EXISTS printers;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Asset IDs that existed when check digits were turned on may be printed without one
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS asset_id_unchecked_max BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE groups DROP COLUMN IF EXISTS asset_id_unchecked_max;
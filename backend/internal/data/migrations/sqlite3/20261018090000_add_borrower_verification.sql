@@ -0,0 +1,15 @@
+-- +goose Up
+-- Add verification state for self-registered borrowers
+ALTER TABLE borrowers ADD COLUMN verification_status text NOT NULL DEFAULT 'verified';
+ALTER TABLE borrowers ADD COLUMN verification_token blob;
+ALTER TABLE borrowers ADD COLUMN verification_expires_at datetime;
+ALTER TABLE borrowers ADD COLUMN email_verified_at datetime;
+ALTER TABLE borrowers ADD COLUMN approved_at datetime;
+
+CREATE INDEX IF NOT EXISTS borrower_verification_status ON borrowers(verification_status);
+CREATE INDEX IF NOT EXISTS borrower_verification_token ON borrowers(verification_token);
+
+-- +goose Down
+DROP INDEX IF EXISTS borrower_verification_token;
+DROP INDEX IF EXISTS borrower_verification_status;
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Track kiosk device health reported by heartbeats
+ALTER TABLE kiosk_sessions ADD COLUMN last_seen_at datetime;
+ALTER TABLE kiosk_sessions ADD COLUMN last_activity_at datetime;
+ALTER TABLE kiosk_sessions ADD COLUMN app_version text;
+ALTER TABLE kiosk_sessions ADD COLUMN battery_level integer;
+ALTER TABLE kiosk_sessions ADD COLUMN battery_charging bool;
+ALTER TABLE kiosk_sessions ADD COLUMN network_type text;
+ALTER TABLE kiosk_sessions ADD COLUMN offline_alerted_at datetime;
+
+CREATE INDEX IF NOT EXISTS kiosksession_last_seen_at ON kiosk_sessions(last_seen_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS kiosksession_last_seen_at;
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
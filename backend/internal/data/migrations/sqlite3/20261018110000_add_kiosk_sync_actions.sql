@@ -0,0 +1,22 @@
+-- +goose Up
+-- Create kiosk_sync_actions table for deduplicating actions replayed by offline kiosks
+CREATE TABLE IF NOT EXISTS kiosk_sync_actions (
+    id                       uuid         NOT NULL PRIMARY KEY,
+    created_at               datetime     NOT NULL,
+    updated_at               datetime     NOT NULL,
+    idempotency_key          text         NOT NULL,
+    action                   text         NOT NULL,
+    status                   text         NOT NULL DEFAULT 'pending',
+    client_timestamp         datetime     NOT NULL,
+    result_id                uuid,
+    group_kiosk_sync_actions uuid         NOT NULL
+        CONSTRAINT kiosk_sync_actions_groups_kiosk_sync_actions
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS kiosksyncaction_idempotency_key_group_kiosk_sync_actions ON kiosk_sync_actions(idempotency_key, group_kiosk_sync_actions);
+
+-- +goose Down
+DROP INDEX IF EXISTS kiosksyncaction_idempotency_key_group_kiosk_sync_actions;
+DROP TABLE IF EXISTS kiosk_sync_actions;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Per-label post-return quarantine settings
+ALTER TABLE labels ADD COLUMN quarantine_on_return bool NOT NULL DEFAULT false;
+ALTER TABLE labels ADD COLUMN quarantine_minutes integer NOT NULL DEFAULT 0;
+
+-- Quarantine state of returned items awaiting inspection
+ALTER TABLE items ADD COLUMN quarantined_at datetime;
+ALTER TABLE items ADD COLUMN quarantine_until datetime;
+
+CREATE INDEX IF NOT EXISTS item_quarantined_at ON items(quarantined_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS item_quarantined_at;
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Location a kiosk is bound to; its browsing, checkout and return are limited to that subtree
+ALTER TABLE kiosk_sessions ADD COLUMN location_kiosk_sessions uuid REFERENCES locations(id) ON DELETE SET NULL;
+
+-- Returns made at a kiosk bound to another site are flagged as transfers
+ALTER TABLE loans ADD COLUMN transfer bool NOT NULL DEFAULT false;
+ALTER TABLE loans ADD COLUMN location_returned_loans uuid REFERENCES locations(id) ON DELETE SET NULL;
+
+-- +goose Down
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
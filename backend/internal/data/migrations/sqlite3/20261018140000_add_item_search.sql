@@ -0,0 +1,85 @@
+-- +goose Up
+-- Full-text index over item text and custom field text, kept in sync by triggers
+CREATE VIRTUAL TABLE IF NOT EXISTS item_search USING fts5(
+    item_id UNINDEXED,
+    name,
+    description,
+    serial_number,
+    model_number,
+    manufacturer,
+    notes,
+    fields,
+    tokenize = 'unicode61 remove_diacritics 2',
+    prefix = '2 3 4'
+);
+
+INSERT INTO item_search (item_id, name, description, serial_number, model_number, manufacturer, notes, fields)
+SELECT i.id, i.name, i.description, i.serial_number, i.model_number, i.manufacturer, i.notes,
+       (SELECT group_concat(f.name || ' ' || coalesce(f.text_value, ''), ' ') FROM item_fields f WHERE f.item_fields = i.id)
+FROM items i;
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS item_search_items_insert AFTER INSERT ON items
+BEGIN
+    INSERT INTO item_search (item_id, name, description, serial_number, model_number, manufacturer, notes, fields)
+    VALUES (new.id, new.name, new.description, new.serial_number, new.model_number, new.manufacturer, new.notes,
+            (SELECT group_concat(f.name || ' ' || coalesce(f.text_value, ''), ' ') FROM item_fields f WHERE f.item_fields = new.id));
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS item_search_items_update AFTER UPDATE OF name, description, serial_number, model_number, manufacturer, notes ON items
+BEGIN
+    UPDATE item_search
+    SET name          = new.name,
+        description   = new.description,
+        serial_number = new.serial_number,
+        model_number  = new.model_number,
+        manufacturer  = new.manufacturer,
+        notes         = new.notes
+    WHERE item_id = new.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS item_search_items_delete AFTER DELETE ON items
+BEGIN
+    DELETE FROM item_search WHERE item_id = old.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS item_search_fields_insert AFTER INSERT ON item_fields
+BEGIN
+    UPDATE item_search
+    SET fields = (SELECT group_concat(f.name || ' ' || coalesce(f.text_value, ''), ' ') FROM item_fields f WHERE f.item_fields = new.item_fields)
+    WHERE item_id = new.item_fields;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS item_search_fields_update AFTER UPDATE ON item_fields
+BEGIN
+    UPDATE item_search
+    SET fields = (SELECT group_concat(f.name || ' ' || coalesce(f.text_value, ''), ' ') FROM item_fields f WHERE f.item_fields = new.item_fields)
+    WHERE item_id = new.item_fields;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS item_search_fields_delete AFTER DELETE ON item_fields
+BEGIN
+    UPDATE item_search
+    SET fields = (SELECT group_concat(f.name || ' ' || coalesce(f.text_value, ''), ' ') FROM item_fields f WHERE f.item_fields = old.item_fields)
+    WHERE item_id = old.item_fields;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS item_search_fields_delete;
+DROP TRIGGER IF EXISTS item_search_fields_update;
+DROP TRIGGER IF EXISTS item_search_fields_insert;
+DROP TRIGGER IF EXISTS item_search_items_delete;
+DROP TRIGGER IF EXISTS item_search_items_update;
+DROP TRIGGER IF EXISTS item_search_items_insert;
+DROP TABLE IF EXISTS item_search;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- Create saved_searches table for stored item queries, optionally shared with the group
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id          uuid     NOT NULL PRIMARY KEY,
+    created_at  datetime NOT NULL,
+    updated_at  datetime NOT NULL,
+    name        text     NOT NULL,
+    description text,
+    query       text     NOT NULL,
+    shared      bool     NOT NULL DEFAULT false,
+    group_id    uuid     NOT NULL
+        CONSTRAINT saved_searches_groups_saved_searches
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    user_id     uuid     NOT NULL
+        CONSTRAINT saved_searches_users_saved_searches
+            REFERENCES users(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS savedsearch_user_id ON saved_searches(user_id);
+CREATE INDEX IF NOT EXISTS savedsearch_group_id_shared ON saved_searches(group_id, shared);
+
+-- +goose Down
+DROP INDEX IF EXISTS savedsearch_group_id_shared;
+DROP INDEX IF EXISTS savedsearch_user_id;
+DROP TABLE IF EXISTS saved_searches;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Create audit_entries table recording changes to items, locations, labels, borrowers, loans and maintenance
+CREATE TABLE IF NOT EXISTS audit_entries (
+    id          uuid     NOT NULL PRIMARY KEY,
+    created_at  datetime NOT NULL,
+    updated_at  datetime NOT NULL,
+    entity_type text     NOT NULL,
+    entity_id   uuid     NOT NULL,
+    action      text     NOT NULL,
+    source      text     NOT NULL DEFAULT 'system',
+    actor_id    uuid,
+    changes     text     NOT NULL,
+    group_id    uuid     NOT NULL
+        CONSTRAINT audit_entries_groups_audit_entries
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS auditentry_group_id_created_at ON audit_entries(group_id, created_at);
+CREATE INDEX IF NOT EXISTS auditentry_entity_type_entity_id ON audit_entries(entity_type, entity_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS auditentry_entity_type_entity_id;
+DROP INDEX IF EXISTS auditentry_group_id_created_at;
+DROP TABLE IF EXISTS audit_entries;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Items and locations are moved to the trash before they are purged
+ALTER TABLE items ADD COLUMN deleted_at datetime;
+ALTER TABLE locations ADD COLUMN deleted_at datetime;
+
+CREATE INDEX IF NOT EXISTS item_deleted_at ON items(deleted_at);
+CREATE INDEX IF NOT EXISTS location_deleted_at ON locations(deleted_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS location_deleted_at;
+DROP INDEX IF EXISTS item_deleted_at;
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
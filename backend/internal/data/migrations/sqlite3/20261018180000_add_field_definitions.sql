@@ -0,0 +1,24 @@
+-- +goose Up
+-- Create field_definitions table declaring the types of the group's custom fields
+CREATE TABLE IF NOT EXISTS field_definitions (
+    id          uuid     NOT NULL PRIMARY KEY,
+    created_at  datetime NOT NULL,
+    updated_at  datetime NOT NULL,
+    name        text     NOT NULL,
+    description text,
+    type        text     NOT NULL,
+    options     json,
+    group_id    uuid     NOT NULL
+        CONSTRAINT field_definitions_groups_field_definitions
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS fielddefinition_group_id_name ON field_definitions(group_id, name);
+
+-- item_fields.number_value now holds decimals as well. Its INTEGER affinity only converts
+-- values that are whole numbers, so the column doesn't need to be recreated.
+
+-- +goose Down
+DROP INDEX IF EXISTS fielddefinition_group_id_name;
+DROP TABLE IF EXISTS field_definitions;
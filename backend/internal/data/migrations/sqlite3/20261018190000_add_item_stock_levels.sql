@@ -0,0 +1,7 @@
+-- +goose Up
+-- Consumables are low on stock once their quantity drops below min_stock
+ALTER TABLE items ADD COLUMN min_stock integer NOT NULL DEFAULT 0;
+ALTER TABLE items ADD COLUMN reorder_quantity integer NOT NULL DEFAULT 0;
+
+-- +goose Down
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Create stock_movements table recording every change to the quantity of an item
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id         uuid     NOT NULL PRIMARY KEY,
+    created_at datetime NOT NULL,
+    updated_at datetime NOT NULL,
+    delta      integer  NOT NULL,
+    quantity   integer  NOT NULL,
+    reason     text     NOT NULL DEFAULT 'adjustment',
+    actor_id   uuid,
+    note       text,
+    group_id   uuid     NOT NULL
+        CONSTRAINT stock_movements_groups_stock_movements
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    item_id    uuid     NOT NULL
+        CONSTRAINT stock_movements_items_stock_movements
+            REFERENCES items(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS stockmovement_item_id_created_at ON stock_movements(item_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS stockmovement_item_id_created_at;
+DROP TABLE IF EXISTS stock_movements;
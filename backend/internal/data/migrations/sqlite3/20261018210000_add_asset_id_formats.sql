@@ -0,0 +1,10 @@
+-- +goose Up
+-- Asset IDs are formatted per group, labels may number their items in their own series
+ALTER TABLE groups ADD COLUMN asset_id_prefix text NOT NULL DEFAULT '';
+ALTER TABLE groups ADD COLUMN asset_id_width integer NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN asset_id_check_digit text NOT NULL DEFAULT 'none';
+ALTER TABLE labels ADD COLUMN asset_id_prefix text;
+ALTER TABLE items ADD COLUMN asset_id_prefix text NOT NULL DEFAULT '';
+
+-- +goose Down
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Create item_identifiers table holding the scannable codes of items besides their asset ID
+CREATE TABLE IF NOT EXISTS item_identifiers (
+    id         uuid     NOT NULL PRIMARY KEY,
+    created_at datetime NOT NULL,
+    updated_at datetime NOT NULL,
+    type       text     NOT NULL DEFAULT 'other',
+    value      text     NOT NULL,
+    group_id   uuid     NOT NULL
+        CONSTRAINT item_identifiers_groups_item_identifiers
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    item_id    uuid     NOT NULL
+        CONSTRAINT item_identifiers_items_identifiers
+            REFERENCES items(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS itemidentifier_group_id_type_value ON item_identifiers(group_id, type, value);
+
+-- +goose Down
+DROP INDEX IF EXISTS itemidentifier_group_id_type_value;
+DROP TABLE IF EXISTS item_identifiers;
@@ -0,0 +1,31 @@
+-- +goose Up
+-- Add the lifecycle status of items and the history of its transitions
+ALTER TABLE items ADD COLUMN status text NOT NULL DEFAULT 'in_service';
+
+CREATE INDEX IF NOT EXISTS item_status ON items(status);
+
+CREATE TABLE IF NOT EXISTS item_status_changes (
+    id          uuid     NOT NULL PRIMARY KEY,
+    created_at  datetime NOT NULL,
+    updated_at  datetime NOT NULL,
+    from_status text     NOT NULL,
+    to_status   text     NOT NULL,
+    reason      text,
+    actor_id    uuid,
+    group_id    uuid     NOT NULL
+        CONSTRAINT item_status_changes_groups_item_status_changes
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    item_id     uuid     NOT NULL
+        CONSTRAINT item_status_changes_items_status_changes
+            REFERENCES items(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS itemstatuschange_item_id_created_at ON item_status_changes(item_id, created_at);
+
+-- +goose Down
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
+DROP INDEX IF EXISTS itemstatuschange_item_id_created_at;
+DROP TABLE IF EXISTS item_status_changes;
+DROP INDEX IF EXISTS item_status;
@@ -0,0 +1,53 @@
+-- +goose Up
+-- Create stocktake_sessions table holding the physical stocktakes of a location subtree
+CREATE TABLE IF NOT EXISTS stocktake_sessions (
+    id          uuid     NOT NULL PRIMARY KEY,
+    created_at  datetime NOT NULL,
+    updated_at  datetime NOT NULL,
+    name        text     NOT NULL,
+    notes       text,
+    status      text     NOT NULL DEFAULT 'open',
+    closed_at   datetime,
+    started_by  uuid,
+    closed_by   uuid,
+    group_id    uuid     NOT NULL
+        CONSTRAINT stocktake_sessions_groups_stocktake_sessions
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    location_id uuid     NOT NULL
+        CONSTRAINT stocktake_sessions_locations_stocktake_sessions
+            REFERENCES locations(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS stocktakesession_status ON stocktake_sessions(status);
+
+-- Create stocktake_entries table holding the expected and scanned items of a stocktake
+CREATE TABLE IF NOT EXISTS stocktake_entries (
+    id                   uuid     NOT NULL PRIMARY KEY,
+    created_at           datetime NOT NULL,
+    updated_at           datetime NOT NULL,
+    value                text,
+    expected             bool     NOT NULL DEFAULT false,
+    expected_location_id uuid,
+    found_location_id    uuid,
+    found_at             datetime,
+    found_by             uuid,
+    on_loan              bool     NOT NULL DEFAULT false,
+    item_id              uuid
+        CONSTRAINT stocktake_entries_items_stocktake_entries
+            REFERENCES items(id)
+            ON DELETE CASCADE,
+    session_id           uuid     NOT NULL
+        CONSTRAINT stocktake_entries_stocktake_sessions_entries
+            REFERENCES stocktake_sessions(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS stocktakeentry_session_id_item_id ON stocktake_entries(session_id, item_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS stocktakeentry_session_id_item_id;
+DROP TABLE IF EXISTS stocktake_entries;
+DROP INDEX IF EXISTS stocktakesession_status;
+DROP TABLE IF EXISTS stocktake_sessions;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Create label_templates table holding the group's label layouts
+CREATE TABLE IF NOT EXISTS label_templates (
+    id          uuid     NOT NULL PRIMARY KEY,
+    created_at  datetime NOT NULL,
+    updated_at  datetime NOT NULL,
+    name        text     NOT NULL,
+    description text,
+    symbology   text     NOT NULL DEFAULT 'qr',
+    fields      text     NOT NULL,
+    group_id    uuid     NOT NULL
+        CONSTRAINT label_templates_groups_label_templates
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS labeltemplate_group_id_name ON label_templates(group_id, name);
+
+-- +goose Down
+DROP INDEX IF EXISTS labeltemplate_group_id_name;
+DROP TABLE IF EXISTS label_templates;
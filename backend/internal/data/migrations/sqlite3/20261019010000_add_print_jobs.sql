@@ -0,0 +1,54 @@
+-- +goose Up
+-- Create printers table holding the group's named printers
+CREATE TABLE IF NOT EXISTS printers (
+    id          uuid     NOT NULL PRIMARY KEY,
+    created_at  datetime NOT NULL,
+    updated_at  datetime NOT NULL,
+    name        text     NOT NULL,
+    description text,
+    kind        text     NOT NULL DEFAULT 'raw',
+    address     text,
+    language    text     NOT NULL DEFAULT 'zpl',
+    dpi         integer  NOT NULL DEFAULT 203,
+    group_id    uuid     NOT NULL
+        CONSTRAINT printers_groups_printers
+            REFERENCES groups(id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS printer_group_id_name ON printers(group_id, name);
+
+-- Create print_jobs table queueing labels for the printers
+CREATE TABLE IF NOT EXISTS print_jobs (
+    id           uuid     NOT NULL PRIMARY KEY,
+    created_at   datetime NOT NULL,
+    updated_at   datetime NOT NULL,
+    name         text     NOT NULL,
+    status       text     NOT NULL DEFAULT 'queued',
+    labels       integer  NOT NULL DEFAULT 0,
+    payload      text     NOT NULL,
+    attempts     integer  NOT NULL DEFAULT 0,
+    max_attempts integer  NOT NULL DEFAULT 3,
+    error        text,
+    run_at       datetime NOT NULL,
+    finished_at  datetime,
+    created_by   uuid,
+    group_id     uuid     NOT NULL
+        CONSTRAINT print_jobs_groups_print_jobs
+            REFERENCES groups(id)
+            ON DELETE CASCADE,
+    printer_id   uuid     NOT NULL
+        CONSTRAINT print_jobs_printers_jobs
+            REFERENCES printers(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS printjob_status_run_at ON print_jobs(status, run_at);
+CREATE INDEX IF NOT EXISTS printjob_group_id_created_at ON print_jobs(group_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS printjob_group_id_created_at;
+DROP INDEX IF EXISTS printjob_status_run_at;
+DROP TABLE IF EXISTS print_jobs;
+DROP INDEX IF EXISTS printer_group_id_name;
+DROP TABLE IF EXISTS printers;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Asset IDs that existed when check digits were turned on may be printed without one
+ALTER TABLE groups ADD COLUMN asset_id_unchecked_max integer NOT NULL DEFAULT 0;
+
+-- +goose Down
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Identifiers are unique ignoring case, the index is on their lower case value
+ALTER TABLE item_identifiers ADD COLUMN normalized_value text NOT NULL DEFAULT '';
+UPDATE item_identifiers SET normalized_value = lower(trim(value));
+
+DROP INDEX IF EXISTS itemidentifier_group_id_type_value;
+CREATE UNIQUE INDEX IF NOT EXISTS itemidentifier_group_id_type_normalized_value ON item_identifiers(group_id, type, normalized_value);
+
+-- +goose Down
+DROP INDEX IF EXISTS itemidentifier_group_id_type_normalized_value;
+CREATE UNIQUE INDEX IF NOT EXISTS itemidentifier_group_id_type_value ON item_identifiers(group_id, type, value);
+-- SQLite doesn't support DROP COLUMN, would need table recreation for full rollback
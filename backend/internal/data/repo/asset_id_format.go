@@ -0,0 +1,299 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+)
+
+// CheckDigit is the algorithm of the check digit appended to asset IDs.
+type CheckDigit string
+
+const (
+	CheckDigitNone CheckDigit = "none"
+	// CheckDigitLuhn catches every single digit error and most swaps of adjacent digits
+	CheckDigitLuhn CheckDigit = "luhn"
+	// CheckDigitMod11 catches every single digit error and swap of adjacent digits, its
+	// check digit may be an X
+	CheckDigitMod11 CheckDigit = "mod11"
+)
+
+var (
+	// ErrInvalidAssetID is returned for asset IDs that aren't written in any of the
+	// group's series.
+	ErrInvalidAssetID = errors.New("invalid asset ID")
+	// ErrAssetIDCheckDigit is returned for asset IDs whose check digit doesn't match the
+	// number, which usually means the ID was mis-scanned or mistyped.
+	ErrAssetIDCheckDigit = errors.New("asset ID check digit does not match")
+	// ErrInvalidAssetIDFormat is returned for prefixes that can't be told apart from the number.
+	ErrInvalidAssetIDFormat = errors.New("invalid asset ID format")
+)
+
+// AssetIDFormat is how the asset IDs of a group are written, e.g. HB-0042 for the
+// prefix HB- and a width of 4. The zero value writes them as 000-000 like before
+// formats existed.
+type AssetIDFormat struct {
+	Prefix     string     `json:"prefix"     validate:"max=32"`
+	Width      int        `json:"width"      validate:"min=0,max=12"`
+	CheckDigit CheckDigit `json:"checkDigit" validate:"omitempty,oneof=none luhn mod11"`
+}
+
+// Validate checks that the prefix can be told apart from the number.
+func (f AssetIDFormat) Validate() error {
+	return validateAssetIDPrefix(f.Prefix)
+}
+
+func validateAssetIDPrefix(prefix string) error {
+	if prefix != "" && strings.ContainsAny(prefix[len(prefix)-1:], "0123456789") {
+		return fmt.Errorf("%w: prefix %q must not end in a digit", ErrInvalidAssetIDFormat, prefix)
+	}
+	return nil
+}
+
+// AssetRef identifies an asset by the series it is numbered in and its number.
+type AssetRef struct {
+	// Prefix of the series, empty for the group's
+	Prefix string
+	ID     AssetID
+}
+
+// AssetIDFormats is the format of a group together with the labels that number their
+// items in their own series. Label series share the group's width and check digit.
+type AssetIDFormats struct {
+	AssetIDFormat
+	// Labels maps the labels with their own series to its prefix
+	Labels map[uuid.UUID]string
+	// Unchecked is the highest asset ID when the group turned on check digits. The
+	// labels of those IDs may have been printed without one.
+	Unchecked AssetID
+}
+
+func assetIDFormatOf(g *ent.Group) AssetIDFormat {
+	return AssetIDFormat{
+		Prefix:     g.AssetIDPrefix,
+		Width:      g.AssetIDWidth,
+		CheckDigit: CheckDigit(g.AssetIDCheckDigit),
+	}
+}
+
+// loadAssetIDFormats loads the asset ID formats of the group.
+func loadAssetIDFormats(ctx context.Context, db *ent.Client, gid uuid.UUID) (AssetIDFormats, error) {
+	g, err := db.Group.Get(ctx, gid)
+	if err != nil {
+		return AssetIDFormats{}, err
+	}
+
+	labels, err := db.Label.Query().
+		Where(
+			label.HasGroupWith(group.ID(gid)),
+			label.AssetIDPrefixNEQ(""),
+		).
+		All(ctx)
+	if err != nil {
+		return AssetIDFormats{}, err
+	}
+
+	formats := AssetIDFormats{
+		AssetIDFormat: assetIDFormatOf(g),
+		Labels:        make(map[uuid.UUID]string, len(labels)),
+		Unchecked:     AssetID(g.AssetIDUncheckedMax),
+	}
+	for _, l := range labels {
+		formats.Labels[l.ID] = l.AssetIDPrefix
+	}
+
+	return formats, nil
+}
+
+// Series returns the prefix of the series an item with the labels is numbered in. When
+// several of the labels have their own series the first prefix alphabetically is used,
+// items without such a label are numbered in the group's series.
+func (f AssetIDFormats) Series(labelIDs []uuid.UUID) string {
+	var prefixes []string
+	for _, id := range labelIDs {
+		if p, ok := f.Labels[id]; ok {
+			prefixes = append(prefixes, p)
+		}
+	}
+
+	if len(prefixes) == 0 {
+		return ""
+	}
+
+	sort.Strings(prefixes)
+	return f.series(prefixes[0])
+}
+
+// series returns the prefix items in the series are stored with. Labels that use the
+// group's own prefix number their items in the group's series.
+func (f AssetIDFormats) series(prefix string) string {
+	if strings.EqualFold(prefix, f.Prefix) {
+		return ""
+	}
+	return prefix
+}
+
+// Format writes the asset ID, or nothing for items without one.
+func (f AssetIDFormats) Format(ref AssetRef) string {
+	if ref.ID.Nil() {
+		return ""
+	}
+
+	prefix := ref.Prefix
+	if prefix == "" {
+		prefix = f.Prefix
+	}
+
+	number := f.number(ref.ID)
+	if check, ok := checkDigit(f.CheckDigit, strconv.Itoa(ref.ID.Int())); ok {
+		number += string(check)
+	}
+
+	return prefix + number
+}
+
+// number writes the asset ID without its prefix and check digit.
+func (f AssetIDFormat) number(id AssetID) string {
+	if f.Width > 0 {
+		return fmt.Sprintf("%0*d", f.Width, id.Int())
+	}
+	return id.String()
+}
+
+// Parse reads an asset ID written in any of the group's series, ignoring case. IDs
+// without a prefix are in the group's series, so the 000-000 IDs of before formats
+// existed keep working. When the group uses check digits, IDs whose check digit
+// doesn't match are rejected with ErrAssetIDCheckDigit, except that the IDs up to
+// Unchecked are also read without one, as printed before check digits were turned on.
+func (f AssetIDFormats) Parse(s string) (AssetRef, error) {
+	s = strings.TrimSpace(s)
+
+	// The longest prefix wins, so that HB-LAP-0042 is in the HB-LAP- series and not HB-
+	matched := ""
+	match := func(p string) {
+		if len(p) > len(matched) && len(s) >= len(p) && strings.EqualFold(s[:len(p)], p) {
+			matched = p
+		}
+	}
+
+	match(f.Prefix)
+	for _, p := range f.Labels {
+		match(p)
+	}
+
+	number := strings.ReplaceAll(s[len(matched):], "-", "")
+	series := f.series(matched)
+
+	if f.CheckDigit == "" || f.CheckDigit == CheckDigitNone {
+		id, err := parseAssetNumber(s, number)
+		return AssetRef{Prefix: series, ID: id}, err
+	}
+
+	checked, err := f.parseChecked(s, number)
+
+	// The same digits can read as an ID with a check digit and as an older one without,
+	// a label written exactly as the current format prints it is read with its check digit
+	exact := err == nil && number[:len(number)-1] == strings.ReplaceAll(f.number(checked), "-", "")
+	if !exact {
+		if unchecked, uerr := parseAssetNumber(s, number); uerr == nil && unchecked <= f.Unchecked {
+			return AssetRef{Prefix: series, ID: unchecked}, nil
+		}
+	}
+	if err != nil {
+		return AssetRef{}, err
+	}
+
+	return AssetRef{Prefix: series, ID: checked}, nil
+}
+
+// parseChecked reads the number of an asset ID that ends in its check digit.
+func (f AssetIDFormats) parseChecked(s, number string) (AssetID, error) {
+	if len(number) < 2 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAssetID, s)
+	}
+
+	digits, got := number[:len(number)-1], strings.ToUpper(number[len(number)-1:])[0]
+
+	want, ok := checkDigit(f.CheckDigit, digits)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAssetID, s)
+	}
+	if got != want {
+		return 0, fmt.Errorf("%w: %q", ErrAssetIDCheckDigit, s)
+	}
+
+	return parseAssetNumber(s, digits)
+}
+
+// parseAssetNumber reads the digits of an asset ID, s is the whole ID for the error.
+func parseAssetNumber(s, digits string) (AssetID, error) {
+	n, err := strconv.Atoi(digits)
+	if err != nil || n <= 0 || strings.ContainsAny(digits, "+-") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAssetID, s)
+	}
+	return AssetID(n), nil
+}
+
+// checkDigit computes the check digit of the digits, it reports false when the
+// algorithm is none or the digits aren't all digits.
+func checkDigit(alg CheckDigit, digits string) (byte, bool) {
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return 0, false
+	}
+
+	switch alg {
+	case CheckDigitLuhn:
+		return luhnDigit(digits), true
+	case CheckDigitMod11:
+		return mod11Digit(digits), true
+	default:
+		return 0, false
+	}
+}
+
+// luhnDigit computes the Luhn check digit, which makes the sum of the digits with every
+// second one from the right doubled a multiple of 10.
+func luhnDigit(digits string) byte {
+	sum := 0
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+// mod11Digit computes the mod 11 check digit with the weights 2 to 7 from the right,
+// a check value of 10 is written as X.
+func mod11Digit(digits string) byte {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		weight++
+		if weight > 7 {
+			weight = 2
+		}
+	}
+
+	c := (11 - sum%11) % 11
+	if c == 10 {
+		return 'X'
+	}
+	return byte('0' + c)
+}
@@ -0,0 +1,281 @@
+package repo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDigit(t *testing.T) {
+	luhn, ok := checkDigit(CheckDigitLuhn, "7992739871")
+	require.True(t, ok)
+	assert.Equal(t, byte('3'), luhn)
+
+	mod11, ok := checkDigit(CheckDigitMod11, "123")
+	require.True(t, ok)
+	assert.Equal(t, byte('6'), mod11)
+
+	_, ok = checkDigit(CheckDigitNone, "123")
+	assert.False(t, ok)
+
+	_, ok = checkDigit(CheckDigitLuhn, "12a")
+	assert.False(t, ok)
+
+	// Every single digit error changes the check digit
+	for _, alg := range []CheckDigit{CheckDigitLuhn, CheckDigitMod11} {
+		want, _ := checkDigit(alg, "4711")
+		for i := range 4 {
+			for d := byte('0'); d <= '9'; d++ {
+				typo := []byte("4711")
+				if typo[i] == d {
+					continue
+				}
+				typo[i] = d
+
+				got, _ := checkDigit(alg, string(typo))
+				assert.NotEqual(t, want, got, "%s %s", alg, typo)
+			}
+		}
+	}
+}
+
+func TestAssetIDFormats_Format(t *testing.T) {
+	lap := uuid.New()
+
+	testCases := []struct {
+		name   string
+		format AssetIDFormat
+		ref    AssetRef
+		want   string
+	}{
+		{"default", AssetIDFormat{}, AssetRef{ID: 123}, "000-123"},
+		{"no asset ID", AssetIDFormat{Prefix: "HB-", Width: 4}, AssetRef{}, ""},
+		{"prefix and width", AssetIDFormat{Prefix: "HB-", Width: 4}, AssetRef{ID: 42}, "HB-0042"},
+		{"wider than width", AssetIDFormat{Prefix: "HB-", Width: 2}, AssetRef{ID: 1234}, "HB-1234"},
+		{"label series", AssetIDFormat{Prefix: "HB-", Width: 4}, AssetRef{Prefix: "HB-LAP-", ID: 42}, "HB-LAP-0042"},
+		{"luhn", AssetIDFormat{Prefix: "HB-", Width: 4, CheckDigit: CheckDigitLuhn}, AssetRef{ID: 42}, "HB-00422"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			formats := AssetIDFormats{AssetIDFormat: tc.format, Labels: map[uuid.UUID]string{lap: "HB-LAP-"}}
+			assert.Equal(t, tc.want, formats.Format(tc.ref))
+		})
+	}
+}
+
+func TestAssetIDFormats_Parse(t *testing.T) {
+	lap := uuid.New()
+	formats := AssetIDFormats{
+		AssetIDFormat: AssetIDFormat{Prefix: "HB-", Width: 4, CheckDigit: CheckDigitMod11},
+		Labels:        map[uuid.UUID]string{lap: "HB-LAP-"},
+	}
+
+	for _, ref := range []AssetRef{{ID: 42}, {Prefix: "HB-LAP-", ID: 42}, {ID: 9876}} {
+		got, err := formats.Parse(formats.Format(ref))
+		require.NoError(t, err)
+		assert.Equal(t, ref, got)
+
+		got, err = formats.Parse(strings.ToLower(formats.Format(ref)))
+		require.NoError(t, err)
+		assert.Equal(t, ref, got, "prefixes are matched ignoring case")
+	}
+
+	assert.Equal(t, "HB-LAP-", formats.Series([]uuid.UUID{uuid.New(), lap}))
+	assert.Empty(t, formats.Series([]uuid.UUID{uuid.New()}))
+
+	_, err := formats.Parse("HB-00436")
+	require.ErrorIs(t, err, ErrAssetIDCheckDigit)
+
+	for _, s := range []string{"", "HB-", "HB-LAP-", "XX-00427", "HB-4A7"} {
+		_, err := formats.Parse(s)
+		require.ErrorIs(t, err, ErrInvalidAssetID, s)
+	}
+
+	// IDs that existed before check digits were turned on are also read without one
+	formats.Unchecked = 427
+	for s, want := range map[string]AssetID{"HB-0427": 427, "000-427": 427, "HB-04278": 427, "HB-0042": 42} {
+		got, err := formats.Parse(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, got.ID, s)
+	}
+	_, err = formats.Parse("HB-0436")
+	require.ErrorIs(t, err, ErrAssetIDCheckDigit)
+
+	// Groups without a format keep reading the IDs of before formats existed
+	for _, s := range []string{"000-123", "000123", "123"} {
+		got, err := AssetIDFormats{}.Parse(s)
+		require.NoError(t, err)
+		assert.Equal(t, AssetRef{ID: 123}, got)
+	}
+}
+
+func TestItemsRepository_AssetIDSeries(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, fk.Str(10))
+	require.NoError(t, err)
+
+	_, err = tRepos.Groups.UpdateAssetIDFormat(ctx, g.ID, AssetIDFormat{Prefix: "HB-", Width: 4, CheckDigit: CheckDigitLuhn})
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	lap, err := tRepos.Labels.Create(ctx, g.ID, LabelCreate{Name: "Laptops", AssetIDPrefix: "HB-LAP-"})
+	require.NoError(t, err)
+
+	create := func(labelIDs ...uuid.UUID) ItemOut {
+		ref, err := tRepos.Items.NextAssetID(ctx, g.ID, labelIDs)
+		require.NoError(t, err)
+
+		itm, err := tRepos.Items.Create(ctx, g.ID, ItemCreate{
+			Name:          fk.Str(10),
+			LocationID:    loc.ID,
+			LabelIDs:      labelIDs,
+			AssetID:       ref.ID,
+			AssetIDPrefix: ref.Prefix,
+		})
+		require.NoError(t, err)
+		return itm
+	}
+
+	first, second, laptop := create(), create(), create(lap.ID)
+
+	// Labels with their own prefix are numbered in their own series
+	assert.Equal(t, "HB-00018", first.AssetTag)
+	assert.Equal(t, "HB-00026", second.AssetTag)
+	assert.Equal(t, "HB-LAP-00018", laptop.AssetTag)
+
+	formats, err := tRepos.Items.AssetIDFormats(ctx, g.ID)
+	require.NoError(t, err)
+
+	ref, err := formats.Parse(laptop.AssetTag)
+	require.NoError(t, err)
+
+	res, err := tRepos.Items.QueryByAssetID(ctx, g.ID, ref, -1, -1)
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, laptop.ID, res.Items[0].ID)
+
+	parsed, err := ParseItemQuery("asset:" + strings.ToLower(first.AssetTag))
+	require.NoError(t, err)
+	q := ItemQuery{Page: -1, PageSize: -1}
+	parsed.Apply(&q)
+
+	found, err := tRepos.Items.QueryByGroup(ctx, g.ID, q)
+	require.NoError(t, err)
+	require.Len(t, found.Items, 1)
+	assert.Equal(t, first.ID, found.Items[0].ID)
+
+	// The number alone is read in the group's series, not the label's
+	found, err = tRepos.Items.QueryByGroup(ctx, g.ID, ItemQuery{Page: -1, PageSize: -1, AssetID: laptop.AssetID})
+	require.NoError(t, err)
+	require.Len(t, found.Items, 1)
+	assert.Equal(t, first.ID, found.Items[0].ID)
+
+	// A mis-scanned ID doesn't match any item
+	parsed, err = ParseItemQuery("asset:HB-00019")
+	require.NoError(t, err)
+	q = ItemQuery{Page: -1, PageSize: -1}
+	parsed.Apply(&q)
+
+	found, err = tRepos.Items.QueryByGroup(ctx, g.ID, q)
+	require.NoError(t, err)
+	assert.Empty(t, found.Items)
+}
+
+func TestLabelRepository_UpdateAssetIDPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, fk.Str(10))
+	require.NoError(t, err)
+
+	_, err = tRepos.Groups.UpdateAssetIDFormat(ctx, g.ID, AssetIDFormat{Prefix: "HB-", Width: 4})
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	cam, err := tRepos.Labels.Create(ctx, g.ID, LabelCreate{Name: "Cameras"})
+	require.NoError(t, err)
+
+	update := func(prefix string) {
+		updated, err := tRepos.Labels.UpdateByGroup(ctx, g.ID, LabelUpdate{ID: cam.ID, Name: cam.Name, AssetIDPrefix: prefix})
+		require.NoError(t, err)
+		assert.Equal(t, prefix, updated.AssetIDPrefix)
+	}
+
+	create := func() ItemOut {
+		ref, err := tRepos.Items.NextAssetID(ctx, g.ID, []uuid.UUID{cam.ID})
+		require.NoError(t, err)
+
+		itm, err := tRepos.Items.Create(ctx, g.ID, ItemCreate{
+			Name:          fk.Str(10),
+			LocationID:    loc.ID,
+			LabelIDs:      []uuid.UUID{cam.ID},
+			AssetID:       ref.ID,
+			AssetIDPrefix: ref.Prefix,
+		})
+		require.NoError(t, err)
+		return itm
+	}
+
+	update("HB-CAM-")
+	assert.Equal(t, "HB-CAM-0001", create().AssetTag)
+
+	// Clearing the prefix puts the label's new items back in the group's series
+	update("")
+	assert.Equal(t, "HB-0001", create().AssetTag)
+}
+
+func TestGroupRepository_UpdateAssetIDFormatPrintedLabels(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, fk.Str(10))
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	create := func() ItemOut {
+		ref, err := tRepos.Items.NextAssetID(ctx, g.ID, nil)
+		require.NoError(t, err)
+
+		itm, err := tRepos.Items.Create(ctx, g.ID, ItemCreate{Name: fk.Str(10), LocationID: loc.ID, AssetID: ref.ID})
+		require.NoError(t, err)
+		return itm
+	}
+
+	// The label is printed before the group turns on check digits
+	before := create()
+	assert.Equal(t, "000-001", before.AssetTag)
+
+	_, err = tRepos.Groups.UpdateAssetIDFormat(ctx, g.ID, AssetIDFormat{Prefix: "HB-", Width: 4, CheckDigit: CheckDigitLuhn})
+	require.NoError(t, err)
+
+	after := create()
+
+	formats, err := tRepos.Items.AssetIDFormats(ctx, g.ID)
+	require.NoError(t, err)
+
+	ref, err := formats.Parse(before.AssetTag)
+	require.NoError(t, err)
+	assert.Equal(t, before.AssetID, ref.ID)
+
+	ref, err = formats.Parse("HB-00018")
+	require.NoError(t, err)
+	assert.Equal(t, before.AssetID, ref.ID)
+
+	// Newer IDs always need their check digit
+	_, err = formats.Parse("000-002")
+	require.ErrorIs(t, err, ErrAssetIDCheckDigit)
+
+	ref, err = formats.Parse("HB-00026")
+	require.NoError(t, err)
+	assert.Equal(t, after.AssetID, ref.ID)
+}
@@ -28,6 +28,8 @@ func ParseAssetIDBytes(d []byte) (aid AssetID, ok bool) {
 	return AssetID(aidInt), true
 }
 
+// ParseAssetID parses an asset ID written as 000-000, the format of groups that haven't
+// configured their own. See AssetIDFormats.Parse for reading IDs in the group's format.
 func ParseAssetID(s string) (aid AssetID, ok bool) {
 	return ParseAssetIDBytes([]byte(s))
 }
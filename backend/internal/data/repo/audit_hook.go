@@ -0,0 +1,531 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	entfield "entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/migrate"
+)
+
+// auditedEntity describes how the mutations of an entity are recorded in the audit log.
+type auditedEntity struct {
+	name  string
+	table string
+	// group is the column holding the entity's group, empty when it has none
+	group string
+	// item is the column holding the item the entity belongs to, whose group is the
+	// entity's when it has no group of its own
+	item string
+	// edges are the unique edges that are recorded, by name, with the column holding their ID
+	edges map[string]string
+	// many are the many-to-many edges whose added and removed IDs are recorded
+	many []string
+	// skip are sensitive fields that are never recorded
+	skip []string
+}
+
+var auditedEntities = map[string]auditedEntity{
+	ent.TypeItem: {
+		name:  AuditEntityItem,
+		table: item.Table,
+		group: item.GroupColumn,
+		edges: map[string]string{
+			item.EdgeLocation: item.LocationColumn,
+			item.EdgeParent:   item.ParentColumn,
+		},
+		many: []string{item.EdgeLabel},
+	},
+	ent.TypeLocation: {
+		name:  AuditEntityLocation,
+		table: location.Table,
+		group: location.GroupColumn,
+		edges: map[string]string{
+			location.EdgeParent: location.ParentColumn,
+		},
+	},
+	ent.TypeLabel: {
+		name:  AuditEntityLabel,
+		table: label.Table,
+		group: label.GroupColumn,
+	},
+	ent.TypeBorrower: {
+		name:  AuditEntityBorrower,
+		table: borrower.Table,
+		group: borrower.GroupColumn,
+		skip:  []string{borrower.FieldVerificationToken},
+	},
+	ent.TypeLoan: {
+		name:  AuditEntityLoan,
+		table: loan.Table,
+		group: loan.GroupColumn,
+		edges: map[string]string{
+			loan.EdgeItem:           loan.ItemColumn,
+			loan.EdgeBorrower:       loan.BorrowerColumn,
+			loan.EdgeCheckedOutBy:   loan.CheckedOutByColumn,
+			loan.EdgeReturnedBy:     loan.ReturnedByColumn,
+			loan.EdgeReturnLocation: loan.ReturnLocationColumn,
+		},
+	},
+	ent.TypeMaintenanceEntry: {
+		name:  AuditEntityMaintenance,
+		table: maintenanceentry.Table,
+		item:  maintenanceentry.ItemColumn,
+	},
+}
+
+// auditIgnored are the fields that change on every mutation and are never recorded.
+var auditIgnored = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// auditRow is a snapshot of a database row by column name.
+type auditRow map[string]any
+
+// auditHook records every create, update and delete of the audited entities in the
+// audit log, in the same transaction as the mutation. Old values are read from the
+// rows before the mutation runs, so updates of many rows are recorded per row.
+func auditHook() ent.Hook {
+	bools := auditBoolColumns()
+
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			cfg, ok := auditedEntities[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			client := m.(interface{ Client() *ent.Client }).Client()
+			a := auditMutation{cfg: cfg, m: m, client: client, bools: bools[cfg.table]}
+
+			var before map[uuid.UUID]auditRow
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				before, err = a.snapshot(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			return v, a.record(ctx, v, before)
+		})
+	}
+}
+
+// auditBoolColumns returns the boolean columns of each table, which SQLite returns as integers.
+func auditBoolColumns() map[string]map[string]bool {
+	out := make(map[string]map[string]bool)
+	for _, t := range migrate.Tables {
+		cols := make(map[string]bool)
+		for _, c := range t.Columns {
+			if c.Type == entfield.TypeBool {
+				cols[c.Name] = true
+			}
+		}
+		out[t.Name] = cols
+	}
+	return out
+}
+
+type auditMutation struct {
+	cfg    auditedEntity
+	m      ent.Mutation
+	client *ent.Client
+	bools  map[string]bool
+}
+
+// snapshot reads the rows the mutation is about to change or delete.
+func (a auditMutation) snapshot(ctx context.Context) (map[uuid.UUID]auditRow, error) {
+	ids, err := a.m.(interface {
+		IDs(context.Context) ([]uuid.UUID, error)
+	}).IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	query, qargs := sql.Dialect(a.client.Dialect()).
+		Select(a.columns()...).
+		From(sql.Table(a.cfg.table)).
+		Where(sql.In("id", args...)).
+		Query()
+
+	rows, err := a.client.QueryContext(ctx, query, qargs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uuid.UUID]auditRow, len(ids))
+	for rows.Next() {
+		vals := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+
+		row := make(auditRow, len(cols))
+		for i, c := range cols {
+			row[c] = a.normalize(c, vals[i])
+		}
+
+		id, err := uuid.Parse(fmt.Sprint(row["id"]))
+		if err != nil {
+			return nil, err
+		}
+		out[id] = row
+	}
+
+	return out, rows.Err()
+}
+
+// columns returns the columns snapshot reads. Updates only need the columns they
+// change, deletes record the whole row.
+func (a auditMutation) columns() []string {
+	if a.m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+		return []string{"*"}
+	}
+
+	cols := []string{"id"}
+	add := func(col string) {
+		if col != "" && !slices.Contains(cols, col) && !a.skipped(col) {
+			cols = append(cols, col)
+		}
+	}
+
+	add(a.cfg.group)
+	add(a.cfg.item)
+	for _, f := range a.m.Fields() {
+		add(f)
+	}
+	for _, f := range a.m.ClearedFields() {
+		add(f)
+	}
+	for edge, col := range a.cfg.edges {
+		if len(a.m.AddedIDs(edge)) > 0 || a.m.EdgeCleared(edge) {
+			add(col)
+		}
+	}
+
+	return cols
+}
+
+// normalize converts the driver specific values of a column to the types ent uses.
+func (a auditMutation) normalize(col string, v any) any {
+	switch v := v.(type) {
+	case []byte:
+		return string(v)
+	case [16]byte:
+		return uuid.UUID(v).String()
+	case int64:
+		if a.bools[col] {
+			return v != 0
+		}
+	}
+	return v
+}
+
+func (a auditMutation) record(ctx context.Context, v ent.Value, before map[uuid.UUID]auditRow) error {
+	actor := auditActorFrom(ctx)
+
+	switch {
+	case a.m.Op().Is(ent.OpCreate):
+		e, ok := v.(HasID)
+		if !ok {
+			return nil
+		}
+
+		row := auditRow{}
+		if ids := a.m.AddedIDs("group"); len(ids) > 0 && a.cfg.group != "" {
+			row[a.cfg.group] = ids[0]
+		}
+		if a.cfg.item != "" {
+			row[a.cfg.item], _ = a.m.Field(a.cfg.item)
+		}
+
+		gid, err := a.groupOf(ctx, row, actor)
+		if err != nil {
+			return err
+		}
+
+		return a.write(ctx, actor, gid, e.GetID(), auditentry.ActionCreate, a.created())
+	case a.m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		for id, row := range before {
+			gid, err := a.groupOf(ctx, row, actor)
+			if err != nil {
+				return err
+			}
+			if err := a.write(ctx, actor, gid, id, auditentry.ActionDelete, a.deleted(row)); err != nil {
+				return err
+			}
+		}
+	default:
+		for id, row := range before {
+			gid, err := a.groupOf(ctx, row, actor)
+			if err != nil {
+				return err
+			}
+			if err := a.write(ctx, actor, gid, id, auditentry.ActionUpdate, a.updated(row)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// groupOf returns the group of a row, read from its group column or from the group of
+// its item. It falls back to the actor's group for entities that have neither.
+func (a auditMutation) groupOf(ctx context.Context, row auditRow, actor AuditActor) (uuid.UUID, error) {
+	if a.cfg.group != "" {
+		if gid, err := uuid.Parse(fmt.Sprint(row[a.cfg.group])); err == nil {
+			return gid, nil
+		}
+	}
+
+	if a.cfg.item != "" {
+		itemID, err := uuid.Parse(fmt.Sprint(row[a.cfg.item]))
+		if err != nil {
+			return uuid.Nil, nil
+		}
+		return a.itemGroup(ctx, itemID)
+	}
+
+	return actor.GroupID, nil
+}
+
+// itemGroup reads the group of the item straight from its row, as the item may be in
+// the trash and hidden from queries.
+func (a auditMutation) itemGroup(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	query, args := sql.Dialect(a.client.Dialect()).
+		Select(item.GroupColumn).
+		From(sql.Table(item.Table)).
+		Where(sql.EQ(item.FieldID, id)).
+		Query()
+
+	rows, err := a.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return uuid.Nil, rows.Err()
+	}
+
+	var gid any
+	if err := rows.Scan(&gid); err != nil {
+		return uuid.Nil, err
+	}
+
+	parsed, err := uuid.Parse(fmt.Sprint(a.normalize(item.GroupColumn, gid)))
+	if err != nil {
+		return uuid.Nil, nil
+	}
+	return parsed, nil
+}
+
+func (a auditMutation) write(ctx context.Context, actor AuditActor, gid, id uuid.UUID, action auditentry.Action, changes map[string]AuditChange) error {
+	if len(changes) == 0 || gid == uuid.Nil {
+		return nil
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	q := a.client.AuditEntry.Create().
+		SetGroupID(gid).
+		SetEntityType(a.cfg.name).
+		SetEntityID(id).
+		SetAction(action).
+		SetSource(auditentry.Source(actor.Source)).
+		SetChanges(string(data))
+
+	if actor.UserID != uuid.Nil {
+		q.SetActorID(actor.UserID)
+	}
+
+	return q.Exec(ctx)
+}
+
+func (a auditMutation) skipped(name string) bool {
+	if auditIgnored[name] {
+		return true
+	}
+	for _, s := range a.cfg.skip {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (a auditMutation) created() map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+
+	for _, f := range a.m.Fields() {
+		if a.skipped(f) {
+			continue
+		}
+		nv, _ := a.m.Field(f)
+		if !auditEqual(nil, nv) {
+			changes[f] = AuditChange{New: nv}
+		}
+	}
+
+	for edge := range a.cfg.edges {
+		if ids := a.m.AddedIDs(edge); len(ids) > 0 {
+			changes[edge] = AuditChange{New: ids[0]}
+		}
+	}
+
+	a.manyChanges(changes)
+	return changes
+}
+
+func (a auditMutation) updated(row auditRow) map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+
+	for _, f := range a.m.Fields() {
+		if a.skipped(f) {
+			continue
+		}
+		nv, _ := a.m.Field(f)
+		if !auditEqual(row[f], nv) {
+			changes[f] = AuditChange{Old: row[f], New: nv}
+		}
+	}
+
+	for _, f := range a.m.ClearedFields() {
+		if !a.skipped(f) && row[f] != nil {
+			changes[f] = AuditChange{Old: row[f]}
+		}
+	}
+
+	for edge, col := range a.cfg.edges {
+		var nv any
+		if ids := a.m.AddedIDs(edge); len(ids) > 0 {
+			nv = ids[0]
+		} else if !a.m.EdgeCleared(edge) {
+			continue
+		}
+
+		if !auditEqual(row[col], nv) {
+			changes[edge] = AuditChange{Old: row[col], New: nv}
+		}
+	}
+
+	a.manyChanges(changes)
+	return changes
+}
+
+func (a auditMutation) deleted(row auditRow) map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+
+	columns := make(map[string]string, len(a.cfg.edges))
+	for edge, col := range a.cfg.edges {
+		columns[col] = edge
+	}
+
+	for col, v := range row {
+		if a.skipped(col) || col == a.cfg.group || v == nil {
+			continue
+		}
+
+		name := col
+		if edge, ok := columns[col]; ok {
+			name = edge
+		}
+		changes[name] = AuditChange{Old: v}
+	}
+
+	return changes
+}
+
+func (a auditMutation) manyChanges(changes map[string]AuditChange) {
+	for _, edge := range a.cfg.many {
+		c := AuditChange{
+			Added:   auditIDs(a.m.AddedIDs(edge)),
+			Removed: auditIDs(a.m.RemovedIDs(edge)),
+		}
+		if len(c.Added) > 0 || len(c.Removed) > 0 {
+			changes[edge] = c
+		}
+	}
+}
+
+func auditIDs(values []ent.Value) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, v := range values {
+		if id, ok := v.(uuid.UUID); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// auditEqual reports whether an old database value and a new mutation value are the
+// same. NULL equals the zero value, so setting an empty optional field is not a change.
+func auditEqual(old, nv any) bool {
+	switch {
+	case old == nil && nv == nil:
+		return true
+	case old == nil:
+		return reflect.ValueOf(nv).IsZero()
+	case nv == nil:
+		return reflect.ValueOf(old).IsZero()
+	}
+
+	if ot, ok := old.(time.Time); ok {
+		if nt, ok := nv.(time.Time); ok {
+			return ot.Equal(nt)
+		}
+	}
+
+	ob, err := json.Marshal(old)
+	if err != nil {
+		return false
+	}
+	nb, err := json.Marshal(nv)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(ob, nb)
+}
@@ -0,0 +1,226 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+// FieldOp is the operator of a custom field filter.
+type FieldOp string
+
+const (
+	FieldOpEq FieldOp = "eq"
+	// FieldOpNe matches items without the value, including items that lack the field
+	FieldOpNe       FieldOp = "ne"
+	FieldOpLt       FieldOp = "lt"
+	FieldOpGt       FieldOp = "gt"
+	FieldOpBetween  FieldOp = "between"
+	FieldOpContains FieldOp = "contains"
+	FieldOpExists   FieldOp = "exists"
+)
+
+// fieldRangeSeparator separates the bounds of FieldOpBetween filters in the query string.
+const fieldRangeSeparator = ".."
+
+// ErrInvalidFieldQuery is returned for custom field filters with an unknown operator or
+// a value the operator can't compare.
+var ErrInvalidFieldQuery = errors.New("invalid field filter")
+
+func invalidFieldQuery(name, format string, args ...any) error {
+	return fmt.Errorf("%w %q: %s", ErrInvalidFieldQuery, name, fmt.Sprintf(format, args...))
+}
+
+// ParseFieldQuery parses a custom field filter of the items query string. Equality is
+// written `Name=value`, the other operators `Name[op]=value`, ranges
+// `Name[between]=from..to` and `Name[exists]` matches items that have the field.
+func ParseFieldQuery(s string) (FieldQuery, error) {
+	name, value, hasValue := strings.Cut(s, "=")
+
+	q := FieldQuery{Name: name, Op: FieldOpEq, Value: value}
+	if i := strings.LastIndex(name, "["); i > 0 && strings.HasSuffix(name, "]") {
+		// Names such as `Size[cm]` are kept whole
+		if op := FieldOp(name[i+1 : len(name)-1]); op.valid() {
+			q.Name, q.Op = name[:i], op
+		}
+	}
+
+	switch {
+	case !hasValue && q.Op != FieldOpExists:
+		return q, invalidFieldQuery(q.Name, "missing value")
+	case q.Op == FieldOpBetween:
+		var ok bool
+		q.Value, q.To, ok = strings.Cut(value, fieldRangeSeparator)
+		if !ok {
+			return q, invalidFieldQuery(q.Name, "ranges are written from%sto", fieldRangeSeparator)
+		}
+	}
+
+	return q, q.Validate()
+}
+
+func (op FieldOp) valid() bool {
+	switch op {
+	case FieldOpEq, FieldOpNe, FieldOpLt, FieldOpGt, FieldOpBetween, FieldOpContains, FieldOpExists:
+		return true
+	}
+	return false
+}
+
+// Validate checks that the operator is known and can compare the value. Filters without
+// an operator, such as those of saved searches created before operators existed, are
+// equality filters.
+func (q FieldQuery) Validate() error {
+	if q.Name == "" {
+		return invalidFieldQuery(q.Name, "missing field name")
+	}
+
+	switch q.Op {
+	case "", FieldOpEq, FieldOpNe, FieldOpContains, FieldOpExists:
+		return nil
+	case FieldOpLt, FieldOpGt:
+		if _, ok := fieldOperand(q.Value); !ok {
+			return invalidFieldQuery(q.Name, "%q is not a number or date", q.Value)
+		}
+		return nil
+	case FieldOpBetween:
+		from, fromOK := fieldOperand(q.Value)
+		to, toOK := fieldOperand(q.To)
+		if !fromOK || !toOK {
+			return invalidFieldQuery(q.Name, "%q and %q are not numbers or dates", q.Value, q.To)
+		}
+		_, fromNumber := from.(float64)
+		_, toNumber := to.(float64)
+		if fromNumber != toNumber {
+			return invalidFieldQuery(q.Name, "a range can't mix numbers and dates")
+		}
+		return nil
+	default:
+		return invalidFieldQuery(q.Name, "unknown operator %q", q.Op)
+	}
+}
+
+// predicate matches items with the field by name. Values are compared by the type of
+// the field, see fieldEqual and fieldCompare.
+func (q FieldQuery) predicate() predicate.Item {
+	name := itemfield.Name(q.Name)
+
+	switch q.Op {
+	case FieldOpExists:
+		return item.HasFieldsWith(name)
+	case FieldOpNe:
+		return item.Not(item.HasFieldsWith(name, fieldEqual(itemfield.TextValue(q.Value), q.Value)))
+	case FieldOpContains:
+		return item.HasFieldsWith(name, itemfield.TextValueContainsFold(q.Value))
+	case FieldOpLt:
+		value, _ := fieldCompare(QueryOpLt, q.Value)
+		return item.HasFieldsWith(name, value)
+	case FieldOpGt:
+		value, _ := fieldCompare(QueryOpGt, q.Value)
+		return item.HasFieldsWith(name, value)
+	case FieldOpBetween:
+		from, _ := fieldCompare(QueryOpGte, q.Value)
+		to, _ := fieldCompare(QueryOpLte, q.To)
+		return item.HasFieldsWith(name, from, to)
+	default:
+		return item.HasFieldsWith(name, fieldEqual(itemfield.TextValue(q.Value), q.Value))
+	}
+}
+
+// fieldQueriesPredicate combines the filters. Equality filters match when any of them
+// does, as the `Name=value` filters always have. Filters with an operator on the same
+// field match when any of them does, those on different fields must all match.
+func fieldQueriesPredicate(queries []FieldQuery) predicate.Item {
+	var (
+		equal  []predicate.Item
+		names  []string
+		byName = map[string][]predicate.Item{}
+	)
+	for _, q := range queries {
+		if q.Op == "" || q.Op == FieldOpEq {
+			equal = append(equal, q.predicate())
+			continue
+		}
+
+		if _, ok := byName[q.Name]; !ok {
+			names = append(names, q.Name)
+		}
+		byName[q.Name] = append(byName[q.Name], q.predicate())
+	}
+
+	and := make([]predicate.Item, 0, len(names)+1)
+	if len(equal) > 0 {
+		and = append(and, item.Or(equal...))
+	}
+	for _, name := range names {
+		and = append(and, item.Or(byName[name]...))
+	}
+	return item.And(and...)
+}
+
+// fieldOperand reads a number or, failing that, a date to compare fields of that type with.
+func fieldOperand(s string) (any, bool) {
+	s = strings.TrimSpace(s)
+
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return n, true
+	}
+
+	if d := types.DateFromString(s); !d.Time().IsZero() {
+		return d.Time(), true
+	}
+
+	return nil, false
+}
+
+// fieldCompare compares number and decimal fields when the value is a number and time
+// fields when it is a date. Time fields hold dates at midnight UTC, so dates compare
+// as whole days.
+func fieldCompare(op QueryOp, s string) (predicate.ItemField, bool) {
+	v, ok := fieldOperand(s)
+	if !ok {
+		return nil, false
+	}
+
+	if _, isNumber := v.(float64); isNumber {
+		return itemfield.And(
+			itemfield.TypeIn(itemfield.TypeNumber, itemfield.TypeDecimal),
+			predicate.ItemField(compare(itemfield.FieldNumberValue, op, v)),
+		), true
+	}
+
+	return itemfield.And(
+		itemfield.TypeEQ(itemfield.TypeTime),
+		predicate.ItemField(compare(itemfield.FieldTimeValue, op, v)),
+	), true
+}
+
+// fieldEqual matches fields holding the value. Text, select, multiselect and url fields
+// are matched by text, other types only by a value of their type.
+func fieldEqual(text predicate.ItemField, s string) predicate.ItemField {
+	matches := []predicate.ItemField{
+		itemfield.And(
+			itemfield.TypeNotIn(itemfield.TypeNumber, itemfield.TypeDecimal, itemfield.TypeBoolean, itemfield.TypeTime),
+			text,
+		),
+	}
+
+	if typed, ok := fieldCompare(QueryOpEq, s); ok {
+		matches = append(matches, typed)
+	}
+
+	if b := strings.ToLower(strings.TrimSpace(s)); b == "true" || b == "false" {
+		matches = append(matches, itemfield.And(
+			itemfield.TypeEQ(itemfield.TypeBoolean),
+			itemfield.BooleanValue(b == "true"),
+		))
+	}
+
+	return itemfield.Or(matches...)
+}
@@ -0,0 +1,112 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+func TestParseFieldQuery(t *testing.T) {
+	testCases := []struct {
+		raw  string
+		want FieldQuery
+	}{
+		{"Color=red", FieldQuery{Name: "Color", Op: FieldOpEq, Value: "red"}},
+		{"Battery Cycles[gt]=300", FieldQuery{Name: "Battery Cycles", Op: FieldOpGt, Value: "300"}},
+		{"Due[between]=2026-01-01..2026-12-01", FieldQuery{Name: "Due", Op: FieldOpBetween, Value: "2026-01-01", To: "2026-12-01"}},
+		{"Serial[exists]", FieldQuery{Name: "Serial", Op: FieldOpExists}},
+		{"Size[cm]=10", FieldQuery{Name: "Size[cm]", Op: FieldOpEq, Value: "10"}},
+		{"Notes[contains]=a=b", FieldQuery{Name: "Notes", Op: FieldOpContains, Value: "a=b"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.raw, func(t *testing.T) {
+			got, err := ParseFieldQuery(tc.raw)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	invalid := []string{
+		"Color",
+		"=red",
+		"Cycles[lt]=many",
+		"Due[between]=2026-01-01",
+		"Due[between]=1..2026-12-01",
+	}
+
+	for _, raw := range invalid {
+		_, err := ParseFieldQuery(raw)
+		require.ErrorIs(t, err, ErrInvalidFieldQuery, raw)
+	}
+}
+
+func TestItemsRepository_QueryByGroupFieldOperators(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+
+	cycles := fk.Str(10)
+	due := fk.Str(10)
+
+	set := func(itm ItemOut, fields ...ItemField) {
+		_, err := updateFields(ctx, itm, fields...)
+		require.NoError(t, err)
+	}
+
+	set(items[0],
+		ItemField{Type: FieldTypeNumber, Name: cycles, NumberValue: 120},
+		ItemField{Type: FieldTypeTime, Name: due, TimeValue: types.DateFromString("2026-06-01")},
+	)
+	set(items[1],
+		ItemField{Type: FieldTypeNumber, Name: cycles, NumberValue: 450},
+		ItemField{Type: FieldTypeTime, Name: due, TimeValue: types.DateFromString("2027-01-15")},
+	)
+
+	query := func(raw ...string) []uuid.UUID {
+		q := ItemQuery{Page: -1, PageSize: -1}
+		for _, r := range raw {
+			f, err := ParseFieldQuery(r)
+			require.NoError(t, err)
+			q.Fields = append(q.Fields, f)
+		}
+
+		res, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+		require.NoError(t, err)
+		return mapEach(res.Items, func(s ItemSummary) uuid.UUID { return s.ID })
+	}
+
+	assert.ElementsMatch(t, []uuid.UUID{items[1].ID}, query(cycles+"[gt]=300"))
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID}, query(cycles+"=120"))
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID}, query(due+"[lt]=2026-12-01"))
+	assert.ElementsMatch(t, []uuid.UUID{items[1].ID}, query(due+"=2027-01-15"))
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID, items[1].ID}, query(cycles+"[between]=100..500"))
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID, items[1].ID}, query(due+"[exists]"))
+
+	// Filters on different fields must all match, those on the same field any of them
+	assert.Empty(t, query(cycles+"[gt]=300", due+"[lt]=2026-12-01"))
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID, items[1].ID}, query(cycles+"[lt]=200", cycles+"[gt]=300"))
+
+	// Equality filters match any of them, even on different fields, as they always have
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID, items[1].ID}, query(cycles+"=120", cycles+"=450"))
+	assert.ElementsMatch(t, []uuid.UUID{items[0].ID, items[1].ID}, query(cycles+"=120", due+"=2027-01-15"))
+	assert.ElementsMatch(t, []uuid.UUID{items[1].ID}, query(cycles+"=120", due+"=2027-01-15", cycles+"[gt]=300"))
+
+	// Items without the field don't have the value either
+	notEqual := query(cycles + "[ne]=120")
+	assert.Contains(t, notEqual, items[1].ID)
+	assert.Contains(t, notEqual, items[2].ID)
+	assert.NotContains(t, notEqual, items[0].ID)
+
+	// The structured search compares the same way
+	parsed, err := ParseItemQuery(`field."` + due + `">=2027-01-01`)
+	require.NoError(t, err)
+	q := ItemQuery{Page: -1, PageSize: -1}
+	parsed.Apply(&q)
+	res, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{items[1].ID}, mapEach(res.Items, func(s ItemSummary) uuid.UUID { return s.ID }))
+}
@@ -0,0 +1,254 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+// Custom field types. Text, select and url fields keep their value in TextValue, number
+// and decimal fields in NumberValue, and time fields, which hold a date, in TimeValue.
+const (
+	FieldTypeText        = "text"
+	FieldTypeNumber      = "number"
+	FieldTypeDecimal     = "decimal"
+	FieldTypeBoolean     = "boolean"
+	FieldTypeTime        = "time"
+	FieldTypeSelect      = "select"
+	FieldTypeMultiSelect = "multiselect"
+	FieldTypeURL         = "url"
+)
+
+// multiSelectSeparator separates the values of multiselect fields in their text form,
+// which is how they are stored and written to CSV exports.
+const multiSelectSeparator = ";"
+
+// ErrInvalidFieldValue is returned when a custom field does not match its type or definition.
+var ErrInvalidFieldValue = errors.New("invalid custom field")
+
+func invalidField(name, format string, args ...any) error {
+	return fmt.Errorf("%w %q: %s", ErrInvalidFieldValue, name, fmt.Sprintf(format, args...))
+}
+
+// ValueText returns the value of the field in the text form used by CSV exports and
+// template defaults, which parseFieldText reads back.
+func (f ItemField) ValueText() string {
+	switch f.Type {
+	case FieldTypeNumber, FieldTypeDecimal:
+		return strconv.FormatFloat(f.NumberValue, 'f', -1, 64)
+	case FieldTypeBoolean:
+		return strconv.FormatBool(f.BooleanValue)
+	case FieldTypeTime:
+		return f.TimeValue.String()
+	case FieldTypeMultiSelect:
+		return strings.Join(f.SelectValues, multiSelectSeparator+" ")
+	default:
+		return f.TextValue
+	}
+}
+
+// parseFieldText reads a value of the given type from its text form.
+func parseFieldText(name, typ, s string) (ItemField, error) {
+	f := ItemField{Type: typ, Name: name}
+	s = strings.TrimSpace(s)
+
+	switch typ {
+	case FieldTypeNumber, FieldTypeDecimal:
+		if s == "" {
+			break
+		}
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return f, invalidField(name, "%q is not a number", s)
+		}
+		f.NumberValue = n
+	case FieldTypeBoolean:
+		if s == "" {
+			break
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return f, invalidField(name, "%q is not true or false", s)
+		}
+		f.BooleanValue = b
+	case FieldTypeTime:
+		f.TimeValue = types.DateFromString(s)
+		if s != "" && f.TimeValue.Time().IsZero() {
+			return f, invalidField(name, "%q is not a date", s)
+		}
+	case FieldTypeMultiSelect:
+		f.SelectValues = splitMultiSelect(s)
+	default:
+		f.TextValue = s
+	}
+
+	return f, nil
+}
+
+func splitMultiSelect(s string) []string {
+	values := []string{}
+	for _, v := range strings.Split(s, multiSelectSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// normalizeField checks the field against its type and definition, if the group has
+// one for its name, and returns it with only the value of its type set. Text fields
+// with a definition of another type, as sent by CSV imports, are converted to it.
+func normalizeField(f ItemField, def *FieldDefinitionOut) (ItemField, error) {
+	if f.Type == "" {
+		f.Type = FieldTypeText
+	}
+
+	if def != nil && f.Type != def.Type {
+		if f.Type != FieldTypeText {
+			return f, invalidField(f.Name, "must be of type %s", def.Type)
+		}
+
+		parsed, err := parseFieldText(f.Name, def.Type, f.TextValue)
+		if err != nil {
+			return f, err
+		}
+		parsed.ID = f.ID
+		f = parsed
+	}
+
+	out := ItemField{ID: f.ID, Type: f.Type, Name: f.Name}
+
+	switch f.Type {
+	case FieldTypeText:
+		out.TextValue = f.TextValue
+	case FieldTypeNumber, FieldTypeDecimal:
+		if math.IsNaN(f.NumberValue) || math.IsInf(f.NumberValue, 0) {
+			return f, invalidField(f.Name, "must be a finite number")
+		}
+		if f.Type == FieldTypeNumber && f.NumberValue != math.Trunc(f.NumberValue) {
+			return f, invalidField(f.Name, "must be a whole number")
+		}
+		out.NumberValue = f.NumberValue
+	case FieldTypeBoolean:
+		out.BooleanValue = f.BooleanValue
+	case FieldTypeTime:
+		out.TimeValue = f.TimeValue
+	case FieldTypeURL:
+		if f.TextValue != "" {
+			u, err := url.Parse(f.TextValue)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				return f, invalidField(f.Name, "%q is not an absolute URL", f.TextValue)
+			}
+		}
+		out.TextValue = f.TextValue
+	case FieldTypeSelect:
+		if def == nil {
+			return f, invalidField(f.Name, "select fields need a field definition")
+		}
+		if f.TextValue != "" && !slices.Contains(def.Options, f.TextValue) {
+			return f, invalidField(f.Name, "%q is not one of the options", f.TextValue)
+		}
+		out.TextValue = f.TextValue
+	case FieldTypeMultiSelect:
+		if def == nil {
+			return f, invalidField(f.Name, "multiselect fields need a field definition")
+		}
+		values := f.SelectValues
+		if values == nil {
+			// Clients that only know text fields send the values in their text form
+			values = splitMultiSelect(f.TextValue)
+		}
+		out.SelectValues = []string{}
+		for _, v := range values {
+			if !slices.Contains(def.Options, v) {
+				return f, invalidField(f.Name, "%q is not one of the options", v)
+			}
+			if !slices.Contains(out.SelectValues, v) {
+				out.SelectValues = append(out.SelectValues, v)
+			}
+		}
+		out.TextValue = out.ValueText()
+	default:
+		return f, invalidField(f.Name, "unknown type %q", f.Type)
+	}
+
+	return out, nil
+}
+
+// normalizeFields runs normalizeField over the fields with the group's definitions.
+func normalizeFields(fields []ItemField, defs fieldDefinitions) ([]ItemField, error) {
+	out := make([]ItemField, len(fields))
+	for i, f := range fields {
+		var err error
+		out[i], err = normalizeField(f, defs.get(f.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// itemFieldBuilder is implemented by the ent create and update builders of item fields.
+type itemFieldBuilder[T any] interface {
+	SetType(itemfield.Type) T
+	SetName(string) T
+	SetTextValue(string) T
+	SetNumberValue(float64) T
+	SetBooleanValue(bool) T
+	SetTimeValue(time.Time) T
+}
+
+// setItemField sets the type, name and value of a normalized field on the builder.
+func setItemField[T itemFieldBuilder[T]](b T, f ItemField) T {
+	b.SetType(itemfield.Type(f.Type))
+	b.SetName(f.Name)
+	b.SetTextValue(f.TextValue)
+	b.SetNumberValue(f.NumberValue)
+	b.SetBooleanValue(f.BooleanValue)
+	if f.Type == FieldTypeTime {
+		b.SetTimeValue(f.TimeValue.Time())
+	}
+	return b
+}
+
+// normalizeTemplateFields checks the default values of template fields like
+// normalizeField does and returns them in their text form.
+func normalizeTemplateFields(fields []TemplateField, defs fieldDefinitions) ([]TemplateField, error) {
+	out := make([]TemplateField, len(fields))
+	for i, tf := range fields {
+		def := defs.get(tf.Name)
+
+		typ := tf.Type
+		switch {
+		case def != nil && (typ == "" || typ == FieldTypeText):
+			typ = def.Type
+		case typ == "":
+			typ = FieldTypeText
+		}
+
+		f, err := parseFieldText(tf.Name, typ, tf.TextValue)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err = normalizeField(f, def)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = TemplateField{ID: tf.ID, Type: f.Type, Name: f.Name}
+		if strings.TrimSpace(tf.TextValue) != "" {
+			// Templates without a default leave the field empty on new items
+			out[i].TextValue = f.ValueText()
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,548 @@
+package repo
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// QueryOp is the comparison of a structured query term.
+type QueryOp string
+
+const (
+	QueryOpEq  QueryOp = "="
+	QueryOpNe  QueryOp = "!="
+	QueryOpGt  QueryOp = ">"
+	QueryOpGte QueryOp = ">="
+	QueryOpLt  QueryOp = "<"
+	QueryOpLte QueryOp = "<="
+)
+
+// Keys of structured query terms. Free text that is excluded with a leading `-` is
+// kept as a QueryKeyText term, included free text becomes the ItemQuery search.
+const (
+	QueryKeyText         = "text"
+	QueryKeyLabel        = "label"
+	QueryKeyLocation     = "location"
+	QueryKeyParent       = "parent"
+	QueryKeyName         = "name"
+	QueryKeySerial       = "serial"
+	QueryKeyModel        = "model"
+	QueryKeyManufacturer = "manufacturer"
+	QueryKeyAssetID      = "asset"
+	QueryKeyQuantity     = "quantity"
+	QueryKeyPrice        = "price"
+	QueryKeyField        = "field"
+	QueryKeyIs           = "is"
+	QueryKeyStatus       = "status"
+)
+
+var queryKeyAliases = map[string]string{
+	"label":        QueryKeyLabel,
+	"labels":       QueryKeyLabel,
+	"tag":          QueryKeyLabel,
+	"loc":          QueryKeyLocation,
+	"location":     QueryKeyLocation,
+	"parent":       QueryKeyParent,
+	"name":         QueryKeyName,
+	"serial":       QueryKeySerial,
+	"sn":           QueryKeySerial,
+	"model":        QueryKeyModel,
+	"mfr":          QueryKeyManufacturer,
+	"manufacturer": QueryKeyManufacturer,
+	"asset":        QueryKeyAssetID,
+	"qty":          QueryKeyQuantity,
+	"quantity":     QueryKeyQuantity,
+	"price":        QueryKeyPrice,
+	"is":           QueryKeyIs,
+	"status":       QueryKeyStatus,
+}
+
+// Flags usable with `is:` or on their own, e.g. `-archived`
+var queryFlags = map[string]bool{
+	"archived":    true,
+	"insured":     true,
+	"quarantined": true,
+	"lowstock":    true,
+}
+
+type (
+	// QueryTerm is a single filter of a structured item query, e.g. `label:camera` or `qty>2`.
+	QueryTerm struct {
+		Key string
+		// Field is the custom field name of QueryKeyField terms
+		Field string
+		Op    QueryOp
+		Value string
+		// Prefix is set when an unquoted value ends with `*`
+		Prefix bool
+		Negate bool
+		// Pos is the 1-based character position of the term in the query
+		Pos int
+
+		// asset is the value of QueryKeyAssetID terms read in the group's format, see
+		// ItemsRepository.resolveAssetTerms
+		asset *AssetRef
+	}
+
+	// ParsedItemQuery is a structured item query compiled by ParseItemQuery.
+	ParsedItemQuery struct {
+		// Text is the free text of the query, searched like ItemQuery.Search
+		Text  string
+		Terms []QueryTerm
+	}
+
+	// QueryParseError is returned for malformed structured queries.
+	QueryParseError struct {
+		// Pos is the 1-based character position of the error in the query
+		Pos     int
+		Message string
+	}
+)
+
+func (e *QueryParseError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Pos)
+}
+
+// ParseItemQuery parses a structured item search such as
+//
+//	label:camera loc:"Studio A" qty>2 -archived serial:AB* field.Color=red
+//
+// Terms are separated by spaces and all of them must match. A leading `-` negates a
+// term, double quotes group words into a phrase or value, and a trailing `*` on a value
+// matches by prefix. Words that are not filters are searched as free text, so a plain
+// search parses to itself.
+func ParseItemQuery(query string) (ParsedItemQuery, error) {
+	p := queryParser{src: []rune(query)}
+	return p.parse()
+}
+
+// Apply adds the parsed query to the item query.
+func (pq ParsedItemQuery) Apply(q *ItemQuery) {
+	q.Search = pq.Text
+	q.Terms = append(q.Terms, pq.Terms...)
+
+	for _, t := range pq.Terms {
+		if t.Key == QueryKeyIs && strings.EqualFold(t.Value, "archived") {
+			// The term decides whether archived items match
+			q.IncludeArchived = true
+		}
+	}
+}
+
+// ParseSearch parses the structured query held in Search, such as the one stored by a
+// saved search, and applies it to q. The custom field filters of q are validated too.
+func (q *ItemQuery) ParseSearch() error {
+	parsed, err := ParseItemQuery(q.Search)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range q.Fields {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+	}
+
+	parsed.Apply(q)
+	return nil
+}
+
+type queryParser struct {
+	src  []rune
+	pos  int
+	text []string
+}
+
+func (p *queryParser) errorf(pos int, format string, args ...any) error {
+	return &QueryParseError{Pos: pos + 1, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *queryParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *queryParser) peek() rune {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func isQueryOpRune(r rune) bool {
+	return r == ':' || r == '=' || r == '!' || r == '<' || r == '>'
+}
+
+func (p *queryParser) parse() (ParsedItemQuery, error) {
+	var out ParsedItemQuery
+
+	for {
+		for !p.eof() && unicode.IsSpace(p.peek()) {
+			p.pos++
+		}
+		if p.eof() {
+			break
+		}
+
+		term, ok, err := p.term()
+		if err != nil {
+			return ParsedItemQuery{}, err
+		}
+		if ok {
+			out.Terms = append(out.Terms, term)
+		}
+	}
+
+	out.Text = strings.Join(p.text, " ")
+	return out, nil
+}
+
+// term parses the next term. Included free text is collected in p.text, in which case
+// ok is false.
+func (p *queryParser) term() (term QueryTerm, ok bool, err error) {
+	start := p.pos
+	term.Pos = start + 1
+
+	if p.peek() == '-' && p.pos+1 < len(p.src) && !unicode.IsSpace(p.src[p.pos+1]) {
+		term.Negate = true
+		p.pos++
+	}
+
+	if p.peek() == '"' {
+		phrase, err := p.quoted()
+		if err != nil {
+			return term, false, err
+		}
+		return p.freeText(term, phrase)
+	}
+
+	headStart := p.pos
+	for !p.eof() && !unicode.IsSpace(p.peek()) && !isQueryOpRune(p.peek()) && p.peek() != '"' {
+		p.pos++
+	}
+	head := string(p.src[headStart:p.pos])
+
+	key, field, isKey := queryKey(head)
+	if isKey && key == QueryKeyField && p.peek() == '"' {
+		// field."Name With Spaces"=value
+		field, err = p.quoted()
+		if err != nil {
+			return term, false, err
+		}
+		isKey = field != ""
+	}
+
+	if !isKey || !isQueryOpRune(p.peek()) {
+		// Not a filter, so the whole word is free text or a flag
+		p.pos = headStart
+		word := p.word()
+		if flag := strings.ToLower(word); queryFlags[flag] {
+			term.Key = QueryKeyIs
+			term.Op = QueryOpEq
+			term.Value = flag
+			return term, true, nil
+		}
+		return p.freeText(term, word)
+	}
+
+	opPos := p.pos
+	term.Op, err = p.op()
+	if err != nil {
+		return term, false, err
+	}
+
+	valuePos := p.pos
+	if p.peek() == '"' {
+		term.Value, err = p.quoted()
+		if err != nil {
+			return term, false, err
+		}
+	} else {
+		term.Value = p.word()
+		if strings.HasSuffix(term.Value, "*") {
+			term.Value = strings.TrimSuffix(term.Value, "*")
+			term.Prefix = true
+		}
+	}
+
+	if term.Value == "" {
+		return term, false, p.errorf(valuePos, "missing value for %q", head)
+	}
+
+	term.Key = key
+	term.Field = field
+
+	if term.Op == QueryOpNe {
+		term.Op = QueryOpEq
+		term.Negate = !term.Negate
+	}
+
+	return term, true, p.validate(term, opPos, valuePos)
+}
+
+func (p *queryParser) freeText(term QueryTerm, text string) (QueryTerm, bool, error) {
+	if text == "" {
+		return term, false, nil
+	}
+
+	if !term.Negate {
+		p.text = append(p.text, text)
+		return term, false, nil
+	}
+
+	term.Key = QueryKeyText
+	term.Op = QueryOpEq
+	term.Value = text
+	return term, true, nil
+}
+
+// word reads up to the next space.
+func (p *queryParser) word() string {
+	start := p.pos
+	for !p.eof() && !unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+// quoted reads a double quoted string. A backslash escapes the next character.
+func (p *queryParser) quoted() (string, error) {
+	start := p.pos
+	p.pos++ // opening quote
+
+	var b strings.Builder
+	for !p.eof() {
+		r := p.peek()
+		p.pos++
+		switch {
+		case r == '"':
+			return b.String(), nil
+		case r == '\\' && !p.eof():
+			b.WriteRune(p.peek())
+			p.pos++
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return "", p.errorf(start, "unterminated quote")
+}
+
+func (p *queryParser) op() (QueryOp, error) {
+	start := p.pos
+	r := p.peek()
+	p.pos++
+
+	switch r {
+	case ':', '=':
+		return QueryOpEq, nil
+	case '!':
+		if p.peek() == '=' {
+			p.pos++
+			return QueryOpNe, nil
+		}
+	case '>':
+		if p.peek() == '=' {
+			p.pos++
+			return QueryOpGte, nil
+		}
+		return QueryOpGt, nil
+	case '<':
+		if p.peek() == '=' {
+			p.pos++
+			return QueryOpLte, nil
+		}
+		return QueryOpLt, nil
+	}
+
+	return "", p.errorf(start, "invalid operator")
+}
+
+// queryKey resolves the key of a filter, e.g. `loc` or `field.Color`.
+func queryKey(head string) (key, field string, ok bool) {
+	lower := strings.ToLower(head)
+	if strings.HasPrefix(lower, "field.") {
+		return QueryKeyField, head[len("field."):], true
+	}
+
+	key, ok = queryKeyAliases[lower]
+	return key, "", ok
+}
+
+// validate checks that the operator and value suit the key of the term.
+func (p *queryParser) validate(t QueryTerm, opPos, valuePos int) error {
+	numeric := t.Key == QueryKeyQuantity || t.Key == QueryKeyPrice
+	comparison := t.Op != QueryOpEq
+
+	switch {
+	case t.Key == QueryKeyField && t.Field == "":
+		return p.errorf(t.Pos-1, "missing custom field name")
+	case comparison && !numeric && t.Key != QueryKeyField:
+		return p.errorf(opPos, "%s can't be compared with %s", t.Key, t.Op)
+	case t.Prefix && (numeric || t.Key == QueryKeyIs || t.Key == QueryKeyAssetID || t.Key == QueryKeyStatus):
+		return p.errorf(valuePos, "%s can't be matched by prefix", t.Key)
+	case t.Key == QueryKeyIs && !queryFlags[strings.ToLower(t.Value)]:
+		return p.errorf(valuePos, "unknown flag %q", t.Value)
+	case t.Key == QueryKeyStatus:
+		if !slices.Contains(ItemStatuses, ItemStatus(strings.ToLower(t.Value))) {
+			return p.errorf(valuePos, "unknown status %q", t.Value)
+		}
+	}
+
+	switch {
+	case t.Key == QueryKeyQuantity:
+		if _, err := strconv.Atoi(t.Value); err != nil {
+			return p.errorf(valuePos, "%q is not a whole number", t.Value)
+		}
+	case t.Key == QueryKeyField && comparison:
+		if _, ok := fieldOperand(t.Value); !ok {
+			return p.errorf(valuePos, "%q is not a number or date", t.Value)
+		}
+	case t.Key == QueryKeyPrice:
+		if _, err := strconv.ParseFloat(t.Value, 64); err != nil {
+			return p.errorf(valuePos, "%q is not a number", t.Value)
+		}
+	case t.Key == QueryKeyAssetID:
+		// The format of the group is only known when the query runs
+		if !strings.ContainsAny(t.Value, "0123456789") {
+			return p.errorf(valuePos, "%q is not an asset ID", t.Value)
+		}
+	}
+
+	return nil
+}
+
+// predicate compiles the term into an item predicate. Terms are validated while
+// parsing, so malformed values don't occur here.
+func (t QueryTerm) predicate(now time.Time) predicate.Item {
+	var p predicate.Item
+
+	switch t.Key {
+	case QueryKeyText:
+		p = item.Or(
+			item.NameContainsFold(t.Value),
+			item.DescriptionContainsFold(t.Value),
+			item.SerialNumberContainsFold(t.Value),
+			item.ModelNumberContainsFold(t.Value),
+			item.ManufacturerContainsFold(t.Value),
+			item.NotesContainsFold(t.Value),
+		)
+	case QueryKeyLabel:
+		if id, err := uuid.Parse(t.Value); err == nil {
+			p = item.HasLabelWith(label.ID(id))
+		} else {
+			p = item.HasLabelWith(predicate.Label(t.textMatch(label.FieldName)))
+		}
+	case QueryKeyLocation:
+		if id, err := uuid.Parse(t.Value); err == nil {
+			p = item.HasLocationWith(location.ID(id))
+		} else {
+			p = item.HasLocationWith(predicate.Location(t.textMatch(location.FieldName)))
+		}
+	case QueryKeyParent:
+		if id, err := uuid.Parse(t.Value); err == nil {
+			p = item.HasParentWith(item.ID(id))
+		} else {
+			p = item.HasParentWith(predicate.Item(t.textMatch(item.FieldName)))
+		}
+	case QueryKeyName:
+		p = predicate.Item(t.textMatch(item.FieldName))
+	case QueryKeySerial:
+		p = predicate.Item(t.textMatch(item.FieldSerialNumber))
+	case QueryKeyModel:
+		p = predicate.Item(t.textMatch(item.FieldModelNumber))
+	case QueryKeyManufacturer:
+		p = predicate.Item(t.textMatch(item.FieldManufacturer))
+	case QueryKeyAssetID:
+		if t.asset == nil {
+			// Not an asset ID in the group's format, which matches nothing
+			p = item.IDIn()
+		} else {
+			p = item.And(item.AssetIDPrefix(t.asset.Prefix), item.AssetID(t.asset.ID.Int()))
+		}
+	case QueryKeyQuantity:
+		n, _ := strconv.Atoi(t.Value)
+		p = predicate.Item(compare(item.FieldQuantity, t.Op, n))
+	case QueryKeyPrice:
+		f, _ := strconv.ParseFloat(t.Value, 64)
+		p = predicate.Item(compare(item.FieldPurchasePrice, t.Op, f))
+	case QueryKeyField:
+		var value predicate.ItemField
+		switch {
+		case t.Op != QueryOpEq:
+			value, _ = fieldCompare(t.Op, t.Value)
+		case t.Prefix:
+			value = predicate.ItemField(t.textMatch(itemfield.FieldTextValue))
+		default:
+			value = fieldEqual(predicate.ItemField(t.textMatch(itemfield.FieldTextValue)), t.Value)
+		}
+		p = item.HasFieldsWith(itemfield.NameEqualFold(t.Field), value)
+	case QueryKeyStatus:
+		p = item.StatusEQ(item.Status(strings.ToLower(t.Value)))
+	case QueryKeyIs:
+		switch strings.ToLower(t.Value) {
+		case "archived":
+			p = item.Archived(true)
+		case "insured":
+			p = item.Insured(true)
+		case "quarantined":
+			p = itemQuarantined(now)
+		case "lowstock":
+			p = itemLowStock()
+		}
+	}
+
+	if t.Negate {
+		return item.Not(p)
+	}
+	return p
+}
+
+// textMatch matches the column case-insensitively, either exactly or by prefix.
+func (t QueryTerm) textMatch(column string) func(*sql.Selector) {
+	if t.Prefix {
+		return func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("LOWER(" + s.C(column) + ") LIKE ")
+				b.Arg(escapeLike(strings.ToLower(t.Value)) + "%")
+				b.WriteString(" ESCAPE '\\'")
+			}))
+		}
+	}
+
+	return func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(column), t.Value))
+	}
+}
+
+func compare(column string, op QueryOp, v any) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		switch op {
+		case QueryOpGt:
+			s.Where(sql.GT(s.C(column), v))
+		case QueryOpGte:
+			s.Where(sql.GTE(s.C(column), v))
+		case QueryOpLt:
+			s.Where(sql.LT(s.C(column), v))
+		case QueryOpLte:
+			s.Where(sql.LTE(s.C(column), v))
+		default:
+			s.Where(sql.EQ(s.C(column), v))
+		}
+	}
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,190 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseItemQuery(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+		text  string
+		terms []QueryTerm
+	}{
+		{
+			name:  "plain search",
+			query: "  canon camera ",
+			text:  "canon camera",
+		},
+		{
+			name:  "filters and free text",
+			query: `tripod label:camera loc:"Studio A" qty>2 -archived serial:AB*`,
+			text:  "tripod",
+			terms: []QueryTerm{
+				{Key: QueryKeyLabel, Op: QueryOpEq, Value: "camera", Pos: 8},
+				{Key: QueryKeyLocation, Op: QueryOpEq, Value: "Studio A", Pos: 21},
+				{Key: QueryKeyQuantity, Op: QueryOpGt, Value: "2", Pos: 36},
+				{Key: QueryKeyIs, Op: QueryOpEq, Value: "archived", Negate: true, Pos: 42},
+				{Key: QueryKeySerial, Op: QueryOpEq, Value: "AB", Prefix: true, Pos: 52},
+			},
+		},
+		{
+			name:  "custom fields",
+			query: `field.Color=red field."Max Load">=10`,
+			terms: []QueryTerm{
+				{Key: QueryKeyField, Field: "Color", Op: QueryOpEq, Value: "red", Pos: 1},
+				{Key: QueryKeyField, Field: "Max Load", Op: QueryOpGte, Value: "10", Pos: 17},
+			},
+		},
+		{
+			name:  "negation",
+			query: `-"water damage" -tag:broken mfr!=Acme -mfr!=Sony`,
+			terms: []QueryTerm{
+				{Key: QueryKeyText, Op: QueryOpEq, Value: "water damage", Negate: true, Pos: 1},
+				{Key: QueryKeyLabel, Op: QueryOpEq, Value: "broken", Negate: true, Pos: 17},
+				{Key: QueryKeyManufacturer, Op: QueryOpEq, Value: "Acme", Negate: true, Pos: 29},
+				{Key: QueryKeyManufacturer, Op: QueryOpEq, Value: "Sony", Pos: 39},
+			},
+		},
+		{
+			name:  "quoted phrases and escapes",
+			query: `"Studio A" name:"12\" ruler"`,
+			text:  "Studio A",
+			terms: []QueryTerm{
+				{Key: QueryKeyName, Op: QueryOpEq, Value: `12" ruler`, Pos: 12},
+			},
+		},
+		{
+			name:  "unknown keys are free text",
+			query: "10:30 http://example.com - is:insured",
+			text:  "10:30 http://example.com -",
+			terms: []QueryTerm{
+				{Key: QueryKeyIs, Op: QueryOpEq, Value: "insured", Pos: 28},
+			},
+		},
+		{
+			name:  "asset and price",
+			query: "asset:000-123 price<=99.5",
+			terms: []QueryTerm{
+				{Key: QueryKeyAssetID, Op: QueryOpEq, Value: "000-123", Pos: 1},
+				{Key: QueryKeyPrice, Op: QueryOpLte, Value: "99.5", Pos: 15},
+			},
+		},
+		{
+			name:  "status",
+			query: "status:in_repair -status:LOST",
+			terms: []QueryTerm{
+				{Key: QueryKeyStatus, Op: QueryOpEq, Value: "in_repair", Pos: 1},
+				{Key: QueryKeyStatus, Op: QueryOpEq, Value: "LOST", Negate: true, Pos: 18},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseItemQuery(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.text, got.Text)
+			assert.Equal(t, tc.terms, got.Terms)
+		})
+	}
+}
+
+func TestParseItemQueryErrors(t *testing.T) {
+	testCases := []struct {
+		query string
+		pos   int
+	}{
+		{`loc:"Studio A`, 5},
+		{`label:`, 7},
+		{`qty>many`, 5},
+		{`qty:2*`, 5},
+		{`name>b`, 5},
+		{`price=abc`, 7},
+		{`asset:nope`, 7},
+		{`is:broken`, 4},
+		{`status:broken`, 8},
+		{`status:in*`, 8},
+		{`field.=1`, 1},
+		{`field.Load>x`, 12},
+		{`label!camera`, 6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			_, err := ParseItemQuery(tc.query)
+			require.Error(t, err)
+
+			var perr *QueryParseError
+			require.ErrorAs(t, err, &perr)
+			assert.Equal(t, tc.pos, perr.Pos, perr.Error())
+		})
+	}
+}
+
+func TestParsedItemQuery_ApplyArchived(t *testing.T) {
+	for _, query := range []string{"is:archived", "is:ARCHIVED", "-Archived"} {
+		t.Run(query, func(t *testing.T) {
+			parsed, err := ParseItemQuery(query)
+			require.NoError(t, err)
+
+			var q ItemQuery
+			parsed.Apply(&q)
+			assert.True(t, q.IncludeArchived)
+		})
+	}
+}
+
+func TestItemsRepository_QueryByGroupTerms(t *testing.T) {
+	ctx := context.Background()
+	locs := useLocations(t, 2)
+	lbl := useLabels(t, 1)[0]
+
+	create := func(data ItemCreate) ItemOut {
+		itm, err := tRepos.Items.Create(ctx, tGroup.ID, data)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		})
+		return itm
+	}
+
+	labelled := create(ItemCreate{Name: fk.Str(10), LocationID: locs[0].ID, LabelIDs: []uuid.UUID{lbl.ID}, Quantity: 5})
+	plenty := create(ItemCreate{Name: fk.Str(10), LocationID: locs[1].ID, Quantity: 3})
+	single := create(ItemCreate{Name: fk.Str(10), LocationID: locs[1].ID, Quantity: 1})
+
+	_, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:           single.ID,
+		Name:         single.Name,
+		LocationID:   locs[1].ID,
+		Quantity:     1,
+		Fields:       []ItemField{{Type: "text", Name: "Color", TextValue: "Red"}},
+		SerialNumber: "AB-1234",
+	})
+	require.NoError(t, err)
+
+	query := func(s string) []uuid.UUID {
+		parsed, err := ParseItemQuery(s)
+		require.NoError(t, err)
+
+		q := ItemQuery{Page: -1, PageSize: -1}
+		parsed.Apply(&q)
+
+		res, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+		require.NoError(t, err)
+		return mapEach(res.Items, func(s ItemSummary) uuid.UUID { return s.ID })
+	}
+
+	assert.ElementsMatch(t, []uuid.UUID{labelled.ID}, query("label:"+lbl.Name))
+	assert.ElementsMatch(t, []uuid.UUID{plenty.ID, single.ID}, query(`loc:"`+locs[1].Name+`"`))
+	assert.ElementsMatch(t, []uuid.UUID{plenty.ID}, query(`loc:"`+locs[1].Name+`" qty>=2`))
+	assert.ElementsMatch(t, []uuid.UUID{single.ID}, query(`loc:"`+locs[1].Name+`" -qty>1`))
+	assert.ElementsMatch(t, []uuid.UUID{single.ID}, query("field.color=red"))
+	assert.ElementsMatch(t, []uuid.UUID{single.ID}, query("serial:ab-12*"))
+	assert.Empty(t, query("serial:ab-12"))
+}
@@ -0,0 +1,226 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
+)
+
+// AuditRepository reads the audit log written by auditHook.
+type AuditRepository struct {
+	db *ent.Client
+}
+
+// Entity types recorded in the audit log
+const (
+	AuditEntityItem        = "item"
+	AuditEntityLocation    = "location"
+	AuditEntityLabel       = "label"
+	AuditEntityBorrower    = "borrower"
+	AuditEntityLoan        = "loan"
+	AuditEntityMaintenance = "maintenance"
+)
+
+// AuditSource is where a change came from.
+type AuditSource string
+
+const (
+	AuditSourceAPI    AuditSource = "api"
+	AuditSourceKiosk  AuditSource = "kiosk"
+	AuditSourceImport AuditSource = "import"
+	// AuditSourceSystem is used for changes made without a user, such as scheduled tasks
+	AuditSourceSystem AuditSource = "system"
+)
+
+type (
+	// AuditActor is the user and source that changes made with a context are attributed to.
+	AuditActor struct {
+		GroupID uuid.UUID
+		UserID  uuid.UUID
+		Source  AuditSource
+	}
+
+	// AuditChange is the change of a single field or edge. Many-to-many edges such as
+	// labels record the added and removed IDs instead of old and new values.
+	AuditChange struct {
+		Old     any         `json:"old,omitempty"`
+		New     any         `json:"new,omitempty"`
+		Added   []uuid.UUID `json:"added,omitempty"`
+		Removed []uuid.UUID `json:"removed,omitempty"`
+	}
+
+	AuditEntryOut struct {
+		ID         uuid.UUID              `json:"id"`
+		EntityType string                 `json:"entityType"`
+		EntityID   uuid.UUID              `json:"entityId"`
+		Action     string                 `json:"action"`
+		Source     string                 `json:"source"`
+		ActorID    *uuid.UUID             `json:"actorId,omitempty" extensions:"x-nullable"`
+		ActorName  string                 `json:"actorName"`
+		Changes    map[string]AuditChange `json:"changes"`
+		CreatedAt  time.Time              `json:"createdAt"`
+	}
+
+	AuditQuery struct {
+		Page       int       `json:"page"       schema:"page"`
+		PageSize   int       `json:"pageSize"   schema:"pageSize"   validate:"max=100"`
+		EntityType string    `json:"entityType" schema:"entityType"`
+		EntityID   uuid.UUID `json:"entityId"   schema:"entityId"`
+		ActorID    uuid.UUID `json:"actorId"    schema:"actorId"`
+		Action     string    `json:"action"     schema:"action"     validate:"omitempty,oneof=create update delete"`
+	}
+)
+
+type auditActorKey struct{}
+
+// WithAuditActor attributes the changes made with the returned context to the actor.
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// WithAuditSource changes the source of the changes made with the returned context,
+// keeping the actor.
+func WithAuditSource(ctx context.Context, source AuditSource) context.Context {
+	actor := auditActorFrom(ctx)
+	actor.Source = source
+	return WithAuditActor(ctx, actor)
+}
+
+func auditActorFrom(ctx context.Context) AuditActor {
+	actor, _ := ctx.Value(auditActorKey{}).(AuditActor)
+	if actor.Source == "" {
+		actor.Source = AuditSourceSystem
+	}
+	return actor
+}
+
+func mapAuditEntryOut(e *ent.AuditEntry) AuditEntryOut {
+	out := AuditEntryOut{
+		ID:         e.ID,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		Action:     e.Action.String(),
+		Source:     e.Source.String(),
+		ActorID:    e.ActorID,
+		CreatedAt:  e.CreatedAt,
+	}
+
+	// The changes are only ever written by auditHook, so they are always valid JSON
+	_ = json.Unmarshal([]byte(e.Changes), &out.Changes)
+
+	return out
+}
+
+// withActorNames maps the entries and fills in the names of their actors.
+func (r *AuditRepository) withActorNames(ctx context.Context, entries []*ent.AuditEntry) ([]AuditEntryOut, error) {
+	ids := make([]uuid.UUID, 0, len(entries))
+	for _, e := range entries {
+		if e.ActorID != nil {
+			ids = append(ids, *e.ActorID)
+		}
+	}
+
+	names, err := userNames(ctx, r.db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]AuditEntryOut, len(entries))
+	for i, e := range entries {
+		out[i] = mapAuditEntryOut(e)
+		if e.ActorID != nil {
+			out[i].ActorName = names[*e.ActorID]
+		}
+	}
+
+	return out, nil
+}
+
+// userNames returns the names of the users by ID. Users that no longer exist are missing.
+func userNames(ctx context.Context, db *ent.Client, ids []uuid.UUID) (map[uuid.UUID]string, error) {
+	users, err := db.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uuid.UUID]string, len(users))
+	for _, u := range users {
+		names[u.ID] = u.Name
+	}
+
+	return names, nil
+}
+
+// GetByEntity returns the history of an entity, newest first.
+func (r *AuditRepository) GetByEntity(ctx context.Context, gid uuid.UUID, entityType string, id uuid.UUID) ([]AuditEntryOut, error) {
+	entries, err := r.db.AuditEntry.Query().
+		Where(
+			auditentry.GroupID(gid),
+			auditentry.EntityType(entityType),
+			auditentry.EntityID(id),
+		).
+		Order(ent.Desc(auditentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withActorNames(ctx, entries)
+}
+
+// GetByGroup returns a page of the group's audit log, newest first.
+func (r *AuditRepository) GetByGroup(ctx context.Context, gid uuid.UUID, q AuditQuery) (PaginationResult[AuditEntryOut], error) {
+	qb := r.db.AuditEntry.Query().Where(auditentry.GroupID(gid))
+
+	if q.EntityType != "" {
+		qb = qb.Where(auditentry.EntityType(q.EntityType))
+	}
+	if q.EntityID != uuid.Nil {
+		qb = qb.Where(auditentry.EntityID(q.EntityID))
+	}
+	if q.ActorID != uuid.Nil {
+		qb = qb.Where(auditentry.ActorID(q.ActorID))
+	}
+	if q.Action != "" {
+		qb = qb.Where(auditentry.ActionEQ(auditentry.Action(q.Action)))
+	}
+
+	count, err := qb.Count(ctx)
+	if err != nil {
+		return PaginationResult[AuditEntryOut]{}, err
+	}
+
+	if q.PageSize <= 0 {
+		q.PageSize = 50
+	}
+	q.PageSize = min(q.PageSize, 100)
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+
+	entries, err := qb.
+		Order(ent.Desc(auditentry.FieldCreatedAt)).
+		Offset(calculateOffset(q.Page, q.PageSize)).
+		Limit(q.PageSize).
+		All(ctx)
+	if err != nil {
+		return PaginationResult[AuditEntryOut]{}, err
+	}
+
+	items, err := r.withActorNames(ctx, entries)
+	if err != nil {
+		return PaginationResult[AuditEntryOut]{}, err
+	}
+
+	return PaginationResult[AuditEntryOut]{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Total:    count,
+		Items:    items,
+	}, nil
+}
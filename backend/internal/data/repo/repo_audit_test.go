@@ -0,0 +1,141 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEqual(t *testing.T) {
+	now := time.Now()
+
+	assert.True(t, auditEqual(nil, ""), "NULL equals the zero value")
+	assert.True(t, auditEqual(nil, time.Time{}))
+	assert.False(t, auditEqual(nil, "a"))
+	assert.True(t, auditEqual(int64(5), 5))
+	assert.True(t, auditEqual(true, true))
+	assert.True(t, auditEqual(now.UTC(), now))
+	assert.True(t, auditEqual(uuid.Nil.String(), uuid.Nil))
+	assert.False(t, auditEqual("a", "b"))
+}
+
+func TestAuditHook_ItemHistory(t *testing.T) {
+	ctx := WithAuditActor(context.Background(), AuditActor{
+		GroupID: tGroup.ID,
+		UserID:  tUser.ID,
+		Source:  AuditSourceAPI,
+	})
+	locs := useLocations(t, 2)
+	lbl := useLabels(t, 1)[0]
+
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{Name: "Drill", LocationID: locs[0].ID, Quantity: 1})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:         itm.ID,
+		AssetID:    itm.AssetID,
+		Name:       "Drill",
+		LocationID: locs[1].ID,
+		Quantity:   3,
+		LabelIDs:   []uuid.UUID{lbl.ID},
+	})
+	require.NoError(t, err)
+
+	// Changes made without an actor, e.g. by scheduled tasks, are attributed to the system
+	quantity := 4
+	err = tRepos.Items.Patch(context.Background(), tGroup.ID, itm.ID, ItemPatch{Quantity: &quantity})
+	require.NoError(t, err)
+
+	err = tRepos.Items.Delete(ctx, itm.ID)
+	require.NoError(t, err)
+
+	history, err := tRepos.Audit.GetByEntity(ctx, tGroup.ID, AuditEntityItem, itm.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+
+	deleted, patched, updated, created := history[0], history[1], history[2], history[3]
+
+	assert.Equal(t, "create", created.Action)
+	assert.Equal(t, "api", created.Source)
+	require.NotNil(t, created.ActorID)
+	assert.Equal(t, tUser.ID, *created.ActorID)
+	assert.Equal(t, tUser.Name, created.ActorName)
+	assert.Equal(t, "Drill", created.Changes["name"].New)
+	assert.Equal(t, locs[0].ID.String(), created.Changes["location"].New)
+
+	assert.Equal(t, "update", updated.Action)
+	assert.Equal(t, map[string]AuditChange{
+		"quantity": {Old: float64(1), New: float64(3)},
+		"location": {Old: locs[0].ID.String(), New: locs[1].ID.String()},
+		"label":    {Added: []uuid.UUID{lbl.ID}},
+	}, updated.Changes, "only the changed fields are recorded")
+
+	assert.Equal(t, "system", patched.Source)
+	assert.Nil(t, patched.ActorID)
+	assert.Equal(t, map[string]AuditChange{"quantity": {Old: float64(3), New: float64(4)}}, patched.Changes)
+
+	assert.Equal(t, "delete", deleted.Action)
+	assert.Equal(t, "Drill", deleted.Changes["name"].Old)
+	assert.Equal(t, locs[1].ID.String(), deleted.Changes["location"].Old)
+}
+
+func TestAuditHook_MaintenanceGroup(t *testing.T) {
+	itm := useItems(t, 1)[0]
+
+	// Scheduled tasks have no actor and the group comes from the maintained item
+	ctx := context.Background()
+
+	entry, err := tRepos.MaintEntry.Create(ctx, itm.ID, MaintenanceEntryCreate{Name: "Oil change"})
+	require.NoError(t, err)
+
+	_, err = tRepos.MaintEntry.Update(ctx, entry.ID, MaintenanceEntryUpdate{Name: "Oil and filter change"})
+	require.NoError(t, err)
+
+	require.NoError(t, tRepos.MaintEntry.Delete(ctx, entry.ID))
+
+	history, err := tRepos.Audit.GetByEntity(ctx, tGroup.ID, AuditEntityMaintenance, entry.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+
+	assert.Equal(t, "delete", history[0].Action)
+	assert.Equal(t, map[string]AuditChange{"name": {Old: "Oil change", New: "Oil and filter change"}}, history[1].Changes)
+	assert.Equal(t, "create", history[2].Action)
+}
+
+func TestAuditRepository_GetByGroup(t *testing.T) {
+	ctx := WithAuditActor(context.Background(), AuditActor{
+		GroupID: tGroup.ID,
+		UserID:  tUser.ID,
+		Source:  AuditSourceKiosk,
+	})
+
+	b, err := tRepos.Borrowers.Create(ctx, tGroup.ID, borrowerFactory())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Borrowers.DeleteByGroup(context.Background(), tGroup.ID, b.ID)
+	})
+
+	page, err := tRepos.Audit.GetByGroup(ctx, tGroup.ID, AuditQuery{
+		EntityType: AuditEntityBorrower,
+		EntityID:   b.ID,
+		Action:     "create",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, page.Total)
+	assert.Equal(t, "kiosk", page.Items[0].Source)
+	assert.Equal(t, b.Name, page.Items[0].Changes["name"].New)
+	assert.NotContains(t, page.Items[0].Changes, "verification_token")
+
+	page, err = tRepos.Audit.GetByGroup(ctx, tGroup.ID, AuditQuery{PageSize: 1})
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, 1, page.Page)
+
+	page, err = tRepos.Audit.GetByGroup(ctx, tGroup.ID, AuditQuery{PageSize: 1000000})
+	require.NoError(t, err)
+	assert.Equal(t, 100, page.PageSize)
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +18,18 @@ type BorrowerRepository struct {
 	bus *eventbus.EventBus
 }
 
+var (
+	// ErrBorrowerNotVerified is returned when a borrower that has not completed
+	// verification (or was rejected) attempts to check out an item.
+	ErrBorrowerNotVerified = errors.New("borrower has not been verified")
+	// ErrVerificationTokenInvalid is returned when an email confirmation token
+	// does not exist or has expired.
+	ErrVerificationTokenInvalid = errors.New("verification token is invalid or expired")
+	// ErrBorrowerNotPending is returned when approving or rejecting a borrower that
+	// was already verified or rejected.
+	ErrBorrowerNotPending = errors.New("borrower is not pending verification")
+)
+
 type (
 	BorrowerCreate struct {
 		Name         string `json:"name"         validate:"required,min=1,max=255"`
@@ -25,6 +38,12 @@ type (
 		Organization string `json:"organization" validate:"max=255"`
 		StudentID    string `json:"studentId"    validate:"max=100"`
 		Notes        string `json:"notes"        validate:"max=1000"`
+
+		// Set by the service layer for kiosk self-registration
+		SelfRegistered     bool                        `json:"-"`
+		VerificationStatus borrower.VerificationStatus `json:"-"`
+		VerificationToken  []byte                      `json:"-"`
+		VerificationExpiry time.Time                   `json:"-"`
 	}
 
 	BorrowerUpdate struct {
@@ -48,13 +67,18 @@ type (
 		IsActive     bool      `json:"isActive"`
 		CreatedAt    time.Time `json:"createdAt"`
 		UpdatedAt    time.Time `json:"updatedAt"`
+
+		SelfRegistered     bool   `json:"selfRegistered"`
+		VerificationStatus string `json:"verificationStatus"`
 	}
 
 	BorrowerOut struct {
 		BorrowerSummary
-		Notes       string `json:"notes"`
-		ActiveLoans int    `json:"activeLoans"`
-		TotalLoans  int    `json:"totalLoans"`
+		Notes           string     `json:"notes"`
+		ActiveLoans     int        `json:"activeLoans"`
+		TotalLoans      int        `json:"totalLoans"`
+		EmailVerifiedAt *time.Time `json:"emailVerifiedAt"`
+		ApprovedAt      *time.Time `json:"approvedAt"`
 	}
 )
 
@@ -69,6 +93,9 @@ func mapBorrowerSummary(b *ent.Borrower) BorrowerSummary {
 		IsActive:     b.IsActive,
 		CreatedAt:    b.CreatedAt,
 		UpdatedAt:    b.UpdatedAt,
+
+		SelfRegistered:     b.SelfRegistered,
+		VerificationStatus: b.VerificationStatus.String(),
 	}
 }
 
@@ -83,6 +110,8 @@ func mapBorrowerOut(b *ent.Borrower) BorrowerOut {
 		Notes:           b.Notes,
 		ActiveLoans:     0, // Will be populated by query if needed
 		TotalLoans:      0, // Will be populated by query if needed
+		EmailVerifiedAt: b.EmailVerifiedAt,
+		ApprovedAt:      b.ApprovedAt,
 	}
 }
 
@@ -122,6 +151,7 @@ func (r *BorrowerRepository) GetActive(ctx context.Context, groupID uuid.UUID) (
 		Where(
 			borrower.HasGroupWith(group.ID(groupID)),
 			borrower.IsActive(true),
+			borrower.VerificationStatusEQ(borrower.VerificationStatusVerified),
 		).
 		Order(ent.Asc(borrower.FieldName)).
 		WithGroup().
@@ -129,8 +159,24 @@ func (r *BorrowerRepository) GetActive(ctx context.Context, groupID uuid.UUID) (
 	)
 }
 
+// GetPending returns all borrowers that are waiting on email confirmation or staff approval
+func (r *BorrowerRepository) GetPending(ctx context.Context, groupID uuid.UUID) ([]BorrowerSummary, error) {
+	return mapBorrowersSummary(r.db.Borrower.Query().
+		Where(
+			borrower.HasGroupWith(group.ID(groupID)),
+			borrower.VerificationStatusIn(
+				borrower.VerificationStatusPendingEmail,
+				borrower.VerificationStatusPendingApproval,
+			),
+		).
+		Order(ent.Asc(borrower.FieldCreatedAt)).
+		WithGroup().
+		All(ctx),
+	)
+}
+
 func (r *BorrowerRepository) Create(ctx context.Context, groupID uuid.UUID, data BorrowerCreate) (BorrowerOut, error) {
-	b, err := r.db.Borrower.Create().
+	q := r.db.Borrower.Create().
 		SetName(data.Name).
 		SetEmail(data.Email).
 		SetPhone(data.Phone).
@@ -138,8 +184,19 @@ func (r *BorrowerRepository) Create(ctx context.Context, groupID uuid.UUID, data
 		SetStudentID(data.StudentID).
 		SetNotes(data.Notes).
 		SetIsActive(true).
-		SetGroupID(groupID).
-		Save(ctx)
+		SetSelfRegistered(data.SelfRegistered).
+		SetGroupID(groupID)
+
+	if data.VerificationStatus != "" {
+		q.SetVerificationStatus(data.VerificationStatus)
+	}
+
+	if data.VerificationToken != nil {
+		q.SetVerificationToken(data.VerificationToken).
+			SetVerificationExpiresAt(data.VerificationExpiry)
+	}
+
+	b, err := q.Save(ctx)
 	if err != nil {
 		return BorrowerOut{}, err
 	}
@@ -206,3 +263,82 @@ func (r *BorrowerRepository) SetActive(ctx context.Context, gid, id uuid.UUID, a
 	r.publishMutationEvent(gid)
 	return nil
 }
+
+// VerifyEmail confirms the email address of the borrower that owns the token. When approval
+// is required the borrower moves on to the staff approval queue, otherwise it is verified.
+func (r *BorrowerRepository) VerifyEmail(ctx context.Context, token []byte, requireApproval bool) (BorrowerOut, error) {
+	b, err := r.db.Borrower.Query().
+		Where(
+			borrower.VerificationToken(token),
+			borrower.VerificationStatusEQ(borrower.VerificationStatusPendingEmail),
+			borrower.VerificationExpiresAtGT(time.Now()),
+		).
+		WithGroup().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return BorrowerOut{}, ErrVerificationTokenInvalid
+		}
+		return BorrowerOut{}, err
+	}
+
+	next := borrower.VerificationStatusVerified
+	if requireApproval {
+		next = borrower.VerificationStatusPendingApproval
+	}
+
+	_, err = r.db.Borrower.UpdateOneID(b.ID).
+		SetVerificationStatus(next).
+		SetEmailVerifiedAt(time.Now()).
+		ClearVerificationToken().
+		ClearVerificationExpiresAt().
+		Save(ctx)
+	if err != nil {
+		return BorrowerOut{}, err
+	}
+
+	r.publishMutationEvent(b.Edges.Group.ID)
+	return r.GetOne(ctx, b.ID)
+}
+
+// Approve marks a pending borrower as verified so they are able to check out equipment
+func (r *BorrowerRepository) Approve(ctx context.Context, gid, id uuid.UUID) (BorrowerOut, error) {
+	return r.setVerificationStatus(ctx, gid, id, borrower.VerificationStatusVerified)
+}
+
+// Reject marks a pending borrower as rejected. Rejected borrowers are kept so that staff can
+// see who attempted to register, but they can never check out equipment.
+func (r *BorrowerRepository) Reject(ctx context.Context, gid, id uuid.UUID) (BorrowerOut, error) {
+	return r.setVerificationStatus(ctx, gid, id, borrower.VerificationStatusRejected)
+}
+
+func (r *BorrowerRepository) setVerificationStatus(ctx context.Context, gid, id uuid.UUID, status borrower.VerificationStatus) (BorrowerOut, error) {
+	q := r.db.Borrower.Update().
+		Where(
+			borrower.ID(id),
+			borrower.HasGroupWith(group.ID(gid)),
+			borrower.VerificationStatusIn(borrower.VerificationStatusPendingEmail, borrower.VerificationStatusPendingApproval),
+		).
+		SetVerificationStatus(status).
+		ClearVerificationToken().
+		ClearVerificationExpiresAt()
+
+	if status == borrower.VerificationStatusVerified {
+		q.SetApprovedAt(time.Now())
+	}
+
+	n, err := q.Save(ctx)
+	if err != nil {
+		return BorrowerOut{}, err
+	}
+
+	if n == 0 {
+		if _, err := r.GetOneByGroup(ctx, gid, id); err != nil {
+			return BorrowerOut{}, err
+		}
+		return BorrowerOut{}, ErrBorrowerNotPending
+	}
+
+	r.publishMutationEvent(gid)
+	return r.GetOne(ctx, id)
+}
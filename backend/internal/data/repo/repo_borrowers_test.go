@@ -0,0 +1,143 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/hasher"
+)
+
+func borrowerFactory() BorrowerCreate {
+	return BorrowerCreate{
+		Name:  fk.Str(10),
+		Email: fk.Email(),
+	}
+}
+
+func useBorrower(t *testing.T, data BorrowerCreate) BorrowerOut {
+	t.Helper()
+
+	b, err := tRepos.Borrowers.Create(context.Background(), tGroup.ID, data)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.Borrowers.DeleteByGroup(context.Background(), tGroup.ID, b.ID)
+	})
+
+	return b
+}
+
+func TestBorrowerRepository_CreateDefaultsToVerified(t *testing.T) {
+	b := useBorrower(t, borrowerFactory())
+
+	assert.Equal(t, borrower.VerificationStatusVerified.String(), b.VerificationStatus)
+	assert.False(t, b.SelfRegistered)
+}
+
+func TestBorrowerRepository_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+
+	token := hasher.GenerateToken()
+	data := borrowerFactory()
+	data.SelfRegistered = true
+	data.VerificationStatus = borrower.VerificationStatusPendingEmail
+	data.VerificationToken = token.Hash
+	data.VerificationExpiry = time.Now().Add(time.Hour)
+
+	b := useBorrower(t, data)
+	assert.Equal(t, borrower.VerificationStatusPendingEmail.String(), b.VerificationStatus)
+
+	pending, err := tRepos.Borrowers.GetPending(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Contains(t, mapEach(pending, func(s BorrowerSummary) string { return s.ID.String() }), b.ID.String())
+
+	_, err = tRepos.Borrowers.VerifyEmail(ctx, hasher.HashToken("not-the-token"), false)
+	require.ErrorIs(t, err, ErrVerificationTokenInvalid)
+
+	verified, err := tRepos.Borrowers.VerifyEmail(ctx, token.Hash, true)
+	require.NoError(t, err)
+	assert.Equal(t, borrower.VerificationStatusPendingApproval.String(), verified.VerificationStatus)
+	assert.NotNil(t, verified.EmailVerifiedAt)
+
+	// Tokens are single use
+	_, err = tRepos.Borrowers.VerifyEmail(ctx, token.Hash, true)
+	require.ErrorIs(t, err, ErrVerificationTokenInvalid)
+
+	approved, err := tRepos.Borrowers.Approve(ctx, tGroup.ID, b.ID)
+	require.NoError(t, err)
+	assert.Equal(t, borrower.VerificationStatusVerified.String(), approved.VerificationStatus)
+	assert.NotNil(t, approved.ApprovedAt)
+}
+
+func TestBorrowerRepository_VerifyEmailExpired(t *testing.T) {
+	token := hasher.GenerateToken()
+	data := borrowerFactory()
+	data.VerificationStatus = borrower.VerificationStatusPendingEmail
+	data.VerificationToken = token.Hash
+	data.VerificationExpiry = time.Now().Add(-time.Minute)
+
+	useBorrower(t, data)
+
+	_, err := tRepos.Borrowers.VerifyEmail(context.Background(), token.Hash, false)
+	require.ErrorIs(t, err, ErrVerificationTokenInvalid)
+}
+
+func TestLoanRepository_CreateRequiresVerifiedBorrower(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	data := borrowerFactory()
+	data.VerificationStatus = borrower.VerificationStatusPendingApproval
+	b := useBorrower(t, data)
+
+	loan := LoanCreate{
+		ItemID:     itm.ID,
+		BorrowerID: b.ID,
+		DueAt:      time.Now().Add(24 * time.Hour),
+		Quantity:   1,
+	}
+
+	_, err := tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.ErrorIs(t, err, ErrBorrowerNotVerified)
+
+	active, err := tRepos.Borrowers.GetActive(ctx, tGroup.ID)
+	require.NoError(t, err)
+	for _, a := range active {
+		assert.NotEqual(t, b.ID, a.ID)
+	}
+
+	_, err = tRepos.Borrowers.Reject(ctx, tGroup.ID, b.ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.ErrorIs(t, err, ErrBorrowerNotVerified)
+
+	// Rejected borrowers stay rejected
+	_, err = tRepos.Borrowers.Approve(ctx, tGroup.ID, b.ID)
+	require.ErrorIs(t, err, ErrBorrowerNotPending)
+
+	data = borrowerFactory()
+	data.VerificationStatus = borrower.VerificationStatusPendingApproval
+	b = useBorrower(t, data)
+	loan.BorrowerID = b.ID
+
+	_, err = tRepos.Borrowers.Approve(ctx, tGroup.ID, b.ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Borrowers.Reject(ctx, tGroup.ID, b.ID)
+	require.ErrorIs(t, err, ErrBorrowerNotPending)
+
+	out, err := tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.NoError(t, err)
+	assert.Equal(t, b.ID, out.BorrowerID)
+
+	_, err = tRepos.Loans.Return(ctx, tGroup.ID, tUser.ID, LoanReturn{ID: out.ID})
+	require.NoError(t, err)
+
+	_, err = tRepos.Loans.Return(ctx, tGroup.ID, tUser.ID, LoanReturn{ID: out.ID})
+	require.ErrorIs(t, err, ErrLoanAlreadyReturned)
+}
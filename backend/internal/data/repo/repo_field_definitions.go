@@ -0,0 +1,306 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/fielddefinition"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/templatefield"
+)
+
+// FieldDefinitionRepository manages the group's custom field definitions. Item and
+// template fields are matched to a definition by name.
+type FieldDefinitionRepository struct {
+	db *ent.Client
+}
+
+var (
+	// ErrFieldDefinitionOptions is returned when a select definition has no or invalid
+	// options, or a definition of another type has options.
+	ErrFieldDefinitionOptions = errors.New("select fields need unique, non-empty options without semicolons and other types none")
+	// ErrFieldDefinitionInUse is returned when changing the type of a definition that
+	// items or templates already have fields for.
+	ErrFieldDefinitionInUse = errors.New("the type of a field in use cannot be changed")
+)
+
+type (
+	FieldDefinitionCreate struct {
+		Name        string   `json:"name"        validate:"required,min=1,max=255"`
+		Description string   `json:"description" validate:"max=1000"`
+		Type        string   `json:"type"        validate:"required,oneof=text number decimal boolean time select multiselect url"`
+		Options     []string `json:"options"`
+	}
+
+	FieldDefinitionUpdate struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"        validate:"required,min=1,max=255"`
+		Description string    `json:"description" validate:"max=1000"`
+		Type        string    `json:"type"        validate:"required,oneof=text number decimal boolean time select multiselect url"`
+		Options     []string  `json:"options"`
+	}
+
+	FieldDefinitionOut struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		Type        string    `json:"type"`
+		Options     []string  `json:"options"`
+		CreatedAt   time.Time `json:"createdAt"`
+		UpdatedAt   time.Time `json:"updatedAt"`
+	}
+)
+
+var (
+	mapFieldDefinitionOutErr  = mapTErrFunc(mapFieldDefinitionOut)
+	mapFieldDefinitionsOutErr = mapTEachErrFunc(mapFieldDefinitionOut)
+)
+
+func mapFieldDefinitionOut(d *ent.FieldDefinition) FieldDefinitionOut {
+	options := d.Options
+	if options == nil {
+		options = []string{}
+	}
+
+	return FieldDefinitionOut{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		Type:        d.Type.String(),
+		Options:     options,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func isSelectType(typ string) bool {
+	return typ == FieldTypeSelect || typ == FieldTypeMultiSelect
+}
+
+func validateOptions(typ string, options []string) error {
+	if !isSelectType(typ) {
+		if len(options) > 0 {
+			return ErrFieldDefinitionOptions
+		}
+		return nil
+	}
+
+	if len(options) == 0 {
+		return ErrFieldDefinitionOptions
+	}
+
+	for i, o := range options {
+		if strings.TrimSpace(o) != o || o == "" || strings.Contains(o, multiSelectSeparator) ||
+			slices.Contains(options[:i], o) {
+			return ErrFieldDefinitionOptions
+		}
+	}
+
+	return nil
+}
+
+// fieldDefinitions are the definitions of a group by field name.
+type fieldDefinitions map[string]FieldDefinitionOut
+
+func (d fieldDefinitions) get(name string) *FieldDefinitionOut {
+	if def, ok := d[name]; ok {
+		return &def
+	}
+	return nil
+}
+
+// loadFieldDefinitions returns the definitions of the group. It takes the client so it
+// can be used inside transactions.
+func loadFieldDefinitions(ctx context.Context, db *ent.Client, gid uuid.UUID) (fieldDefinitions, error) {
+	defs, err := db.FieldDefinition.Query().
+		Where(fielddefinition.GroupID(gid)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(fieldDefinitions, len(defs))
+	for _, d := range defs {
+		out[d.Name] = mapFieldDefinitionOut(d)
+	}
+	return out, nil
+}
+
+// itemFieldsNamed matches the fields with the name on the group's items.
+func itemFieldsNamed(gid uuid.UUID, name string) predicate.ItemField {
+	return itemfield.And(
+		itemfield.Name(name),
+		itemfield.HasItemWith(item.HasGroupWith(group.ID(gid))),
+	)
+}
+
+// templateFieldsNamed matches the fields with the name on the group's templates.
+func templateFieldsNamed(gid uuid.UUID, name string) predicate.TemplateField {
+	return templatefield.And(
+		templatefield.Name(name),
+		templatefield.HasItemTemplateWith(itemtemplate.HasGroupWith(group.ID(gid))),
+	)
+}
+
+func (r *FieldDefinitionRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]FieldDefinitionOut, error) {
+	return mapFieldDefinitionsOutErr(r.db.FieldDefinition.Query().
+		Where(fielddefinition.GroupID(gid)).
+		Order(ent.Asc(fielddefinition.FieldName)).
+		All(ctx),
+	)
+}
+
+func (r *FieldDefinitionRepository) GetOne(ctx context.Context, gid, id uuid.UUID) (FieldDefinitionOut, error) {
+	return mapFieldDefinitionOutErr(r.db.FieldDefinition.Query().
+		Where(fielddefinition.ID(id), fielddefinition.GroupID(gid)).
+		Only(ctx),
+	)
+}
+
+func (r *FieldDefinitionRepository) Create(ctx context.Context, gid uuid.UUID, data FieldDefinitionCreate) (FieldDefinitionOut, error) {
+	if err := validateOptions(data.Type, data.Options); err != nil {
+		return FieldDefinitionOut{}, err
+	}
+
+	return mapFieldDefinitionOutErr(r.db.FieldDefinition.Create().
+		SetGroupID(gid).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetType(fielddefinition.Type(data.Type)).
+		SetOptions(data.Options).
+		Save(ctx),
+	)
+}
+
+// Update changes the definition. Renaming it also renames the item and template fields
+// of the group that had the old name, so they keep their definition.
+func (r *FieldDefinitionRepository) Update(ctx context.Context, gid uuid.UUID, data FieldDefinitionUpdate) (FieldDefinitionOut, error) {
+	if err := validateOptions(data.Type, data.Options); err != nil {
+		return FieldDefinitionOut{}, err
+	}
+
+	current, err := r.GetOne(ctx, gid, data.ID)
+	if err != nil {
+		return FieldDefinitionOut{}, err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return FieldDefinitionOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during field definition update")
+			}
+		}
+	}()
+
+	if data.Type != current.Type {
+		inUse, err := tx.ItemField.Query().Where(itemFieldsNamed(gid, current.Name)).Exist(ctx)
+		if err != nil {
+			return FieldDefinitionOut{}, err
+		}
+		if !inUse {
+			inUse, err = tx.TemplateField.Query().Where(templateFieldsNamed(gid, current.Name)).Exist(ctx)
+			if err != nil {
+				return FieldDefinitionOut{}, err
+			}
+		}
+		if inUse {
+			return FieldDefinitionOut{}, ErrFieldDefinitionInUse
+		}
+	}
+
+	if data.Name != current.Name {
+		err = tx.ItemField.Update().Where(itemFieldsNamed(gid, current.Name)).SetName(data.Name).Exec(ctx)
+		if err != nil {
+			return FieldDefinitionOut{}, err
+		}
+
+		err = tx.TemplateField.Update().Where(templateFieldsNamed(gid, current.Name)).SetName(data.Name).Exec(ctx)
+		if err != nil {
+			return FieldDefinitionOut{}, err
+		}
+	}
+
+	def, err := tx.FieldDefinition.UpdateOneID(data.ID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetType(fielddefinition.Type(data.Type)).
+		SetOptions(data.Options).
+		Save(ctx)
+	if err != nil {
+		return FieldDefinitionOut{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return FieldDefinitionOut{}, err
+	}
+	committed = true
+
+	return mapFieldDefinitionOut(def), nil
+}
+
+// Delete removes the definition. Select fields cannot exist without one, so the item
+// and template fields it defined are turned into text fields holding their values.
+func (r *FieldDefinitionRepository) Delete(ctx context.Context, gid, id uuid.UUID) error {
+	def, err := r.GetOne(ctx, gid, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during field definition deletion")
+			}
+		}
+	}()
+
+	if isSelectType(def.Type) {
+		err = tx.ItemField.Update().
+			Where(itemFieldsNamed(gid, def.Name)).
+			SetType(itemfield.TypeText).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = tx.TemplateField.Update().
+			Where(templateFieldsNamed(gid, def.Name)).
+			SetType(templatefield.TypeText).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.FieldDefinition.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+
+	return nil
+}
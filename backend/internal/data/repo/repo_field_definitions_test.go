@@ -0,0 +1,200 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+func useFieldDefinition(t *testing.T, typ string, options ...string) FieldDefinitionOut {
+	t.Helper()
+
+	def, err := tRepos.FieldDefinitions.Create(context.Background(), tGroup.ID, FieldDefinitionCreate{
+		Name:    fk.Str(10),
+		Type:    typ,
+		Options: options,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.FieldDefinitions.Delete(context.Background(), tGroup.ID, def.ID)
+	})
+
+	return def
+}
+
+func updateFields(ctx context.Context, itm ItemOut, fields ...ItemField) (ItemOut, error) {
+	return tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:         itm.ID,
+		Name:       itm.Name,
+		LocationID: itm.Location.ID,
+		Fields:     fields,
+	})
+}
+
+func TestFieldDefinitionRepository_CreateOptions(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		typ     string
+		options []string
+	}{
+		{FieldTypeSelect, nil},
+		{FieldTypeSelect, []string{"a", "a"}},
+		{FieldTypeMultiSelect, []string{"a;b"}},
+		{FieldTypeSelect, []string{" a"}},
+		{FieldTypeNumber, []string{"a"}},
+	}
+
+	for _, tc := range tests {
+		_, err := tRepos.FieldDefinitions.Create(ctx, tGroup.ID, FieldDefinitionCreate{
+			Name:    fk.Str(10),
+			Type:    tc.typ,
+			Options: tc.options,
+		})
+		require.ErrorIs(t, err, ErrFieldDefinitionOptions, "%s %v", tc.typ, tc.options)
+	}
+}
+
+func TestItemsRepository_TypedFields(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	number := useFieldDefinition(t, FieldTypeNumber)
+	decimal := useFieldDefinition(t, FieldTypeDecimal)
+	multi := useFieldDefinition(t, FieldTypeMultiSelect, "red", "green", "blue")
+	choice := useFieldDefinition(t, FieldTypeSelect, "small", "large")
+
+	got, err := updateFields(ctx, itm,
+		ItemField{Type: FieldTypeNumber, Name: number.Name, NumberValue: 4},
+		ItemField{Type: FieldTypeDecimal, Name: decimal.Name, NumberValue: 2.5},
+		ItemField{Type: FieldTypeMultiSelect, Name: multi.Name, SelectValues: []string{"red", "blue", "red"}},
+		ItemField{Type: FieldTypeSelect, Name: choice.Name, TextValue: "large"},
+		ItemField{Type: FieldTypeURL, Name: fk.Str(10), TextValue: "https://example.com/manual.pdf"},
+		ItemField{Type: FieldTypeTime, Name: fk.Str(10), TimeValue: types.DateFromString("2024-03-01")},
+	)
+	require.NoError(t, err)
+	require.Len(t, got.Fields, 6)
+
+	byName := map[string]ItemField{}
+	for _, f := range got.Fields {
+		byName[f.Name] = f
+	}
+	assert.InDelta(t, 4, byName[number.Name].NumberValue, 0)
+	assert.InDelta(t, 2.5, byName[decimal.Name].NumberValue, 0)
+	assert.Equal(t, []string{"red", "blue"}, byName[multi.Name].SelectValues)
+	assert.Equal(t, "red; blue", byName[multi.Name].ValueText())
+	assert.Equal(t, "large", byName[choice.Name].TextValue)
+
+	invalid := []ItemField{
+		{Type: FieldTypeNumber, Name: number.Name, NumberValue: 1.5},
+		{Type: FieldTypeBoolean, Name: number.Name, BooleanValue: true},
+		{Type: FieldTypeSelect, Name: choice.Name, TextValue: "medium"},
+		{Type: FieldTypeMultiSelect, Name: multi.Name, SelectValues: []string{"purple"}},
+		{Type: FieldTypeSelect, Name: fk.Str(10), TextValue: "small"},
+		{Type: FieldTypeURL, Name: fk.Str(10), TextValue: "not a url"},
+		{Type: FieldTypeText, Name: number.Name, TextValue: "four"},
+	}
+	for _, f := range invalid {
+		_, err := updateFields(ctx, itm, f)
+		require.ErrorIs(t, err, ErrInvalidFieldValue, "%+v", f)
+	}
+}
+
+func TestItemsRepository_TypedFieldsFromText(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	decimal := useFieldDefinition(t, FieldTypeDecimal)
+	flag := useFieldDefinition(t, FieldTypeBoolean)
+	date := useFieldDefinition(t, FieldTypeTime)
+	multi := useFieldDefinition(t, FieldTypeMultiSelect, "red", "green")
+
+	// CSV imports send every field as text
+	got, err := updateFields(ctx, itm,
+		ItemField{Type: FieldTypeText, Name: decimal.Name, TextValue: "12.75"},
+		ItemField{Type: FieldTypeText, Name: flag.Name, TextValue: "true"},
+		ItemField{Type: FieldTypeText, Name: date.Name, TextValue: "2024-03-01"},
+		ItemField{Type: FieldTypeText, Name: multi.Name, TextValue: "green; red"},
+	)
+	require.NoError(t, err)
+
+	for _, f := range got.Fields {
+		switch f.Name {
+		case decimal.Name:
+			assert.Equal(t, FieldTypeDecimal, f.Type)
+			assert.Equal(t, "12.75", f.ValueText())
+		case flag.Name:
+			assert.True(t, f.BooleanValue)
+		case date.Name:
+			assert.Equal(t, "2024-03-01", f.ValueText())
+		case multi.Name:
+			assert.Equal(t, []string{"green", "red"}, f.SelectValues)
+		}
+	}
+}
+
+func TestFieldDefinitionRepository_Update(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	def := useFieldDefinition(t, FieldTypeSelect, "a", "b")
+
+	_, err := updateFields(ctx, itm, ItemField{Type: FieldTypeSelect, Name: def.Name, TextValue: "a"})
+	require.NoError(t, err)
+
+	_, err = tRepos.FieldDefinitions.Update(ctx, tGroup.ID, FieldDefinitionUpdate{
+		ID:   def.ID,
+		Name: def.Name,
+		Type: FieldTypeText,
+	})
+	require.ErrorIs(t, err, ErrFieldDefinitionInUse)
+
+	// Renaming the definition renames the item's field
+	renamed := fk.Str(10)
+	_, err = tRepos.FieldDefinitions.Update(ctx, tGroup.ID, FieldDefinitionUpdate{
+		ID:      def.ID,
+		Name:    renamed,
+		Type:    FieldTypeSelect,
+		Options: []string{"a", "b", "c"},
+	})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Fields, 1)
+	assert.Equal(t, renamed, got.Fields[0].Name)
+
+	// Deleting it keeps the value as a text field
+	err = tRepos.FieldDefinitions.Delete(ctx, tGroup.ID, def.ID)
+	require.NoError(t, err)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, FieldTypeText, got.Fields[0].Type)
+	assert.Equal(t, "a", got.Fields[0].TextValue)
+}
+
+func TestItemTemplatesRepository_TypedFieldDefaults(t *testing.T) {
+	ctx := context.Background()
+	def := useFieldDefinition(t, FieldTypeNumber)
+
+	data := templateFactory()
+	data.Fields = []TemplateField{{Type: FieldTypeText, Name: def.Name, TextValue: "3"}}
+
+	tmpl, err := tRepos.ItemTemplates.Create(ctx, tGroup.ID, data)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.ItemTemplates.Delete(context.Background(), tGroup.ID, tmpl.ID)
+	})
+
+	require.Len(t, tmpl.Fields, 1)
+	assert.Equal(t, FieldTypeNumber, tmpl.Fields[0].Type)
+	assert.Equal(t, "3", tmpl.Fields[0].TextValue)
+
+	data.Fields = []TemplateField{{Type: FieldTypeNumber, Name: def.Name, TextValue: "three"}}
+	_, err = tRepos.ItemTemplates.Create(ctx, tGroup.ID, data)
+	require.ErrorIs(t, err, ErrInvalidFieldValue)
+}
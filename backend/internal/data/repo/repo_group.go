@@ -29,6 +29,8 @@ func NewGroupRepository(db *ent.Client) *GroupRepository {
 			CreatedAt: g.CreatedAt,
 			UpdatedAt: g.UpdatedAt,
 			Currency:  strings.ToUpper(g.Currency),
+
+			AssetIDFormat: assetIDFormatOf(g),
 		}
 	}
 
@@ -55,6 +57,8 @@ type (
 		CreatedAt time.Time `json:"createdAt,omitempty"`
 		UpdatedAt time.Time `json:"updatedAt,omitempty"`
 		Currency  string    `json:"currency,omitempty"`
+
+		AssetIDFormat AssetIDFormat `json:"assetIdFormat"`
 	}
 
 	GroupUpdate struct {
@@ -82,6 +86,8 @@ type (
 		TotalLabels       int     `json:"totalLabels"`
 		TotalItemPrice    float64 `json:"totalItemPrice"`
 		TotalWithWarranty int     `json:"totalWithWarranty"`
+		// ItemsByStatus counts the unarchived items in each lifecycle status
+		ItemsByStatus []ItemStatusCount `json:"itemsByStatus"`
 	}
 
 	ValueOverTimeEntry struct {
@@ -120,6 +126,7 @@ func (r *GroupRepository) StatsLocationsByPurchasePrice(ctx context.Context, gid
 		Aggregate(func(sq *sql.Selector) string {
 			t := sql.Table(item.Table)
 			sq.Join(t).On(sq.C(location.FieldID), t.C(item.LocationColumn))
+			sq.Where(sql.IsNull(t.C(item.FieldDeletedAt)))
 
 			return sql.As(sql.Sum(t.C(item.FieldPurchasePrice)), "total")
 		}).
@@ -146,6 +153,7 @@ func (r *GroupRepository) StatsLabelsByPurchasePrice(ctx context.Context, gid uu
 
 			sq.Join(jt).On(sq.C(label.FieldID), jt.C(label.ItemsPrimaryKey[0]))
 			sq.Join(itemTable).On(jt.C(label.ItemsPrimaryKey[1]), itemTable.C(item.FieldID))
+			sq.Where(sql.IsNull(itemTable.C(item.FieldDeletedAt)))
 
 			return sql.As(sql.Sum(itemTable.C(item.FieldPurchasePrice)), "total")
 		}).
@@ -164,7 +172,7 @@ func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, gid uuid.UUID,
 		SUM(CASE WHEN created_at < $1 THEN purchase_price ELSE 0 END) AS price_at_start,
 		SUM(CASE WHEN created_at < $2 THEN purchase_price ELSE 0 END) AS price_at_end
 	FROM items
-	WHERE group_items = $3 AND archived = false
+	WHERE group_items = $3 AND archived = false AND deleted_at IS NULL
 `
 	stats := ValueOverTime{
 		Start: start,
@@ -224,14 +232,15 @@ func (r *GroupRepository) StatsGroup(ctx context.Context, gid uuid.UUID) (GroupS
 	q := `
 		SELECT
             (SELECT COUNT(*) FROM users WHERE group_users = $2) AS total_users,
-            (SELECT COUNT(*) FROM items WHERE group_items = $2 AND items.archived = false) AS total_items,
-            (SELECT COUNT(*) FROM locations WHERE group_locations = $2) AS total_locations,
+            (SELECT COUNT(*) FROM items WHERE group_items = $2 AND items.archived = false AND items.deleted_at IS NULL) AS total_items,
+            (SELECT COUNT(*) FROM locations WHERE group_locations = $2 AND locations.deleted_at IS NULL) AS total_locations,
             (SELECT COUNT(*) FROM labels WHERE group_labels = $2) AS total_labels,
-            (SELECT SUM(purchase_price*quantity) FROM items WHERE group_items = $2 AND items.archived = false) AS total_item_price,
+            (SELECT SUM(purchase_price*quantity) FROM items WHERE group_items = $2 AND items.archived = false AND items.deleted_at IS NULL) AS total_item_price,
             (SELECT COUNT(*)
                 FROM items
                     WHERE group_items = $2
                     AND items.archived = false
+                    AND items.deleted_at IS NULL
                     AND (items.lifetime_warranty = true OR items.warranty_expires > $1)
                 ) AS total_with_warranty;
 `
@@ -249,9 +258,47 @@ func (r *GroupRepository) StatsGroup(ctx context.Context, gid uuid.UUID) (GroupS
 	stats.TotalItemPrice = orDefault(maybeTotalItemPrice, 0)
 	stats.TotalWithWarranty = orDefault(maybeTotalWithWarranty, 0)
 
+	stats.ItemsByStatus, err = r.statsItemsByStatus(ctx, gid)
+	if err != nil {
+		return GroupStatistics{}, err
+	}
+
 	return stats, nil
 }
 
+// statsItemsByStatus counts the unarchived items of the group in every status, including
+// the statuses no item is in.
+func (r *GroupRepository) statsItemsByStatus(ctx context.Context, gid uuid.UUID) ([]ItemStatusCount, error) {
+	var rows []struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+
+	err := r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.Archived(false),
+		).
+		GroupBy(item.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[ItemStatus]int, len(rows))
+	for _, row := range rows {
+		totals[ItemStatus(row.Status)] = row.Count
+	}
+
+	counts := make([]ItemStatusCount, len(ItemStatuses))
+	for i, s := range ItemStatuses {
+		counts[i] = ItemStatusCount{Status: s, Total: totals[s]}
+	}
+
+	return counts, nil
+}
+
 func (r *GroupRepository) GroupCreate(ctx context.Context, name string) (Group, error) {
 	return r.groupMapper.MapErr(r.db.Group.Create().
 		SetName(name).
@@ -267,6 +314,42 @@ func (r *GroupRepository) GroupUpdate(ctx context.Context, id uuid.UUID, data Gr
 	return r.groupMapper.MapErr(entity, err)
 }
 
+// UpdateAssetIDFormat changes how the group's asset IDs are written. The IDs themselves
+// don't change, but labels printed in the old format only resolve while they still
+// parse in the new one. When check digits are turned on, the IDs handed out so far are
+// recorded so that their labels keep resolving without one, see AssetIDFormats.Parse.
+func (r *GroupRepository) UpdateAssetIDFormat(ctx context.Context, id uuid.UUID, data AssetIDFormat) (Group, error) {
+	if data.CheckDigit == "" {
+		data.CheckDigit = CheckDigitNone
+	}
+
+	current, err := r.db.Group.Get(ctx, id)
+	if err != nil {
+		return Group{}, err
+	}
+
+	q := r.db.Group.UpdateOneID(id).
+		SetAssetIDPrefix(data.Prefix).
+		SetAssetIDWidth(data.Width).
+		SetAssetIDCheckDigit(group.AssetIDCheckDigit(data.CheckDigit))
+
+	if data.CheckDigit != CheckDigitNone && current.AssetIDCheckDigit == group.AssetIDCheckDigitNone {
+		// Trashed items keep their asset IDs and labels
+		highest, err := r.db.Item.Query().
+			Where(item.HasGroupWith(group.ID(id))).
+			Order(ent.Desc(item.FieldAssetID)).
+			First(withDeleted(ctx))
+		switch {
+		case err == nil:
+			q.SetAssetIDUncheckedMax(highest.AssetID)
+		case !ent.IsNotFound(err):
+			return Group{}, err
+		}
+	}
+
+	return r.groupMapper.MapErr(q.Save(ctx))
+}
+
 func (r *GroupRepository) GroupByID(ctx context.Context, id uuid.UUID) (Group, error) {
 	return r.groupMapper.MapErr(r.db.Group.Get(ctx, id))
 }
@@ -423,6 +423,42 @@ func (r *AttachmentRepo) Delete(ctx context.Context, gid uuid.UUID, itemId uuid.
 	return r.db.Attachment.DeleteOneID(id).Exec(ctx)
 }
 
+// deleteUnreferencedFiles removes the stored files at the given paths that no attachment
+// refers to anymore. The attachments are already gone, so failures are only logged.
+func (r *AttachmentRepo) deleteUnreferencedFiles(ctx context.Context, paths []string) {
+	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
+	if err != nil {
+		log.Err(err).Msg("failed to open bucket")
+		return
+	}
+	defer func(bucket *blob.Bucket) {
+		err := bucket.Close()
+		if err != nil {
+			log.Err(err).Msg("failed to close bucket")
+		}
+	}(bucket)
+
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+
+		used, err := r.db.Attachment.Query().Where(attachment.Path(p)).Exist(ctx)
+		if err != nil {
+			log.Err(err).Str("path", p).Msg("failed to check attachment file usage")
+			continue
+		}
+		if used {
+			continue
+		}
+
+		err = bucket.Delete(ctx, r.fullPath(p))
+		if err != nil {
+			log.Err(err).Str("path", p).Msg("failed to delete attachment file")
+		}
+	}
+}
+
 func (r *AttachmentRepo) Rename(ctx context.Context, gid uuid.UUID, id uuid.UUID, title string) (*ent.Attachment, error) {
 	// Validate that the attachment belongs to the specified group
 	_, err := r.db.Attachment.Query().
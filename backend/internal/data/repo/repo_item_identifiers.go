@@ -0,0 +1,325 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+)
+
+// ItemIdentifierRepository is a repository for the scannable codes of items besides
+// their asset ID, like the manufacturer's barcode or the UID of an NFC sticker.
+type ItemIdentifierRepository struct {
+	db *ent.Client
+}
+
+// IdentifierType is the kind of code an identifier is.
+type IdentifierType string
+
+const (
+	IdentifierTypeUPC  IdentifierType = "upc"
+	IdentifierTypeEAN  IdentifierType = "ean"
+	IdentifierTypeISBN IdentifierType = "isbn"
+	// IdentifierTypeLegacyTag is the tag number of an inventory system the items were
+	// tracked in before
+	IdentifierTypeLegacyTag IdentifierType = "legacy_tag"
+	IdentifierTypeNFC       IdentifierType = "nfc"
+	IdentifierTypeRFID      IdentifierType = "rfid"
+	IdentifierTypeOther     IdentifierType = "other"
+	// IdentifierTypeAssetID is reported by Lookup for items matched by their asset ID,
+	// it can't be stored as an identifier.
+	IdentifierTypeAssetID IdentifierType = "asset_id"
+)
+
+// IdentifierTypes are the types identifiers can be stored with.
+var IdentifierTypes = []IdentifierType{
+	IdentifierTypeUPC,
+	IdentifierTypeEAN,
+	IdentifierTypeISBN,
+	IdentifierTypeLegacyTag,
+	IdentifierTypeNFC,
+	IdentifierTypeRFID,
+	IdentifierTypeOther,
+}
+
+var (
+	// ErrIdentifierExists is returned when another item of the group already has an
+	// identifier of the same type and value.
+	ErrIdentifierExists = errors.New("identifier is already in use")
+	// ErrInvalidIdentifier is returned for identifiers without a value.
+	ErrInvalidIdentifier = errors.New("invalid identifier")
+)
+
+type (
+	ItemIdentifierCreate struct {
+		Type  IdentifierType `json:"type"  validate:"required,oneof=upc ean isbn legacy_tag nfc rfid other"`
+		Value string         `json:"value" validate:"required,max=255"`
+	}
+
+	ItemIdentifierOut struct {
+		ID        uuid.UUID      `json:"id"`
+		Type      IdentifierType `json:"type"`
+		Value     string         `json:"value"`
+		CreatedAt time.Time      `json:"createdAt"`
+	}
+
+	// IdentifierMatch is an item found by Lookup, with the identifier it was found by.
+	IdentifierMatch struct {
+		Type  IdentifierType `json:"type"`
+		Value string         `json:"value"`
+		Item  ItemSummary    `json:"item"`
+	}
+
+	IdentifierLookup struct {
+		Value string `json:"value" schema:"value" validate:"required"`
+	}
+)
+
+// Validate rejects values that are only whitespace, which can't be scanned.
+func (c ItemIdentifierCreate) Validate() error {
+	if strings.TrimSpace(c.Value) == "" {
+		return fmt.Errorf("%w: value must not be empty", ErrInvalidIdentifier)
+	}
+	return nil
+}
+
+var mapItemIdentifiersOutErr = mapTEachErrFunc(mapItemIdentifierOut)
+
+func mapItemIdentifierOut(id *ent.ItemIdentifier) ItemIdentifierOut {
+	return ItemIdentifierOut{
+		ID:        id.ID,
+		Type:      IdentifierType(id.Type),
+		Value:     id.Value,
+		CreatedAt: id.CreatedAt,
+	}
+}
+
+func orderIdentifiers(q *ent.ItemIdentifierQuery) {
+	q.Order(ent.Asc(itemidentifier.FieldType), ent.Asc(itemidentifier.FieldValue))
+}
+
+// GetByItem returns the identifiers of the item by type and value.
+func (r *ItemIdentifierRepository) GetByItem(ctx context.Context, gid, itemID uuid.UUID) ([]ItemIdentifierOut, error) {
+	q := r.db.ItemIdentifier.Query().
+		Where(
+			itemidentifier.GroupID(gid),
+			itemidentifier.ItemID(itemID),
+		)
+	orderIdentifiers(q)
+
+	return mapItemIdentifiersOutErr(q.All(ctx))
+}
+
+// normalizeIdentifier is the value identifiers are compared by, so that an NFC UID read
+// as 04a2 and 04A2 is the same identifier.
+func normalizeIdentifier(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// Create adds an identifier to the item. Values are compared ignoring case, see
+// normalizeIdentifier.
+func (r *ItemIdentifierRepository) Create(ctx context.Context, gid, itemID uuid.UUID, data ItemIdentifierCreate) (ItemIdentifierOut, error) {
+	exists, err := r.db.Item.Query().
+		Where(
+			item.ID(itemID),
+			item.HasGroupWith(group.ID(gid)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return ItemIdentifierOut{}, err
+	}
+	if !exists {
+		return ItemIdentifierOut{}, &ent.NotFoundError{}
+	}
+
+	id, err := createIdentifier(ctx, r.db, gid, itemID, data)
+	if err != nil {
+		return ItemIdentifierOut{}, err
+	}
+
+	return mapItemIdentifierOut(id), nil
+}
+
+func createIdentifier(ctx context.Context, db *ent.Client, gid, itemID uuid.UUID, data ItemIdentifierCreate) (*ent.ItemIdentifier, error) {
+	value := strings.TrimSpace(data.Value)
+	if value == "" {
+		return nil, fmt.Errorf("%w: value must not be empty", ErrInvalidIdentifier)
+	}
+
+	taken, err := db.ItemIdentifier.Query().
+		Where(
+			itemidentifier.GroupID(gid),
+			itemidentifier.TypeEQ(itemidentifier.Type(data.Type)),
+			itemidentifier.NormalizedValue(normalizeIdentifier(value)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, fmt.Errorf("%w: %s %q", ErrIdentifierExists, data.Type, value)
+	}
+
+	id, err := db.ItemIdentifier.Create().
+		SetGroupID(gid).
+		SetItemID(itemID).
+		SetType(itemidentifier.Type(data.Type)).
+		SetValue(value).
+		SetNormalizedValue(normalizeIdentifier(value)).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("%w: %s %q", ErrIdentifierExists, data.Type, value)
+	}
+
+	return id, err
+}
+
+// SetForItem replaces the identifiers of the item, as done by the CSV import. The
+// identifiers another item already has are skipped and returned together as an error
+// wrapping ErrIdentifierExists, the others are stored.
+func (r *ItemIdentifierRepository) SetForItem(ctx context.Context, gid, itemID uuid.UUID, data []ItemIdentifierCreate) error {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ItemIdentifier.Delete().
+		Where(
+			itemidentifier.GroupID(gid),
+			itemidentifier.ItemID(itemID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	var conflicts []error
+	for _, d := range data {
+		_, err := createIdentifier(ctx, tx.Client(), gid, itemID, d)
+		switch {
+		case errors.Is(err, ErrIdentifierExists):
+			conflicts = append(conflicts, err)
+		case err != nil:
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+
+	return errors.Join(conflicts...)
+}
+
+// Delete removes an identifier of the item.
+func (r *ItemIdentifierRepository) Delete(ctx context.Context, gid, itemID, id uuid.UUID) error {
+	n, err := r.db.ItemIdentifier.Delete().
+		Where(
+			itemidentifier.ID(id),
+			itemidentifier.GroupID(gid),
+			itemidentifier.ItemID(itemID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+}
+
+// Lookup returns the items of the group that the scanned value identifies, either by
+// their asset ID in the group's format or by one of their identifiers of any type.
+// Several items can match, e.g. when a legacy tag number is also an asset ID. When
+// nothing matches and the value reads as an asset ID with a wrong check digit,
+// ErrAssetIDCheckDigit is returned so that the mis-scan can be reported.
+func (r *ItemIdentifierRepository) Lookup(ctx context.Context, gid uuid.UUID, value string) ([]IdentifierMatch, error) {
+	value = strings.TrimSpace(value)
+	matches := []IdentifierMatch{}
+
+	formats, err := loadAssetIDFormats(ctx, r.db, gid)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, parseErr := formats.Parse(value)
+	if parseErr == nil {
+		items, err := r.db.Item.Query().
+			Where(
+				item.HasGroupWith(group.ID(gid)),
+				item.AssetIDPrefix(ref.Prefix),
+				item.AssetID(ref.ID.Int()),
+			).
+			WithLabel().
+			WithLocation().
+			Order(ent.Asc(item.FieldName)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, it := range items {
+			matches = append(matches, IdentifierMatch{
+				Type:  IdentifierTypeAssetID,
+				Value: formats.Format(ref),
+				Item:  mapItemSummary(it),
+			})
+		}
+	}
+
+	items, err := r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.HasIdentifiersWith(itemidentifier.NormalizedValue(normalizeIdentifier(value))),
+		).
+		WithIdentifiers(func(q *ent.ItemIdentifierQuery) {
+			q.Where(itemidentifier.NormalizedValue(normalizeIdentifier(value)))
+		}).
+		WithLabel().
+		WithLocation().
+		Order(ent.Asc(item.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, it := range items {
+		for _, id := range it.Edges.Identifiers {
+			matches = append(matches, IdentifierMatch{
+				Type:  IdentifierType(id.Type),
+				Value: id.Value,
+				Item:  mapItemSummary(it),
+			})
+		}
+	}
+
+	if len(matches) == 0 && errors.Is(parseErr, ErrAssetIDCheckDigit) {
+		return nil, parseErr
+	}
+
+	return matches, nil
+}
+
+// ParseIdentifierType reads the type of an identifier, ignoring case.
+func ParseIdentifierType(s string) (IdentifierType, bool) {
+	t := IdentifierType(strings.ToLower(strings.TrimSpace(s)))
+	return t, slices.Contains(IdentifierTypes, t)
+}
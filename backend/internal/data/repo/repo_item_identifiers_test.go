@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemidentifier"
+)
+
+func TestItemIdentifierRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 2)
+
+	upc, err := tRepos.Identifiers.Create(ctx, tGroup.ID, items[0].ID, ItemIdentifierCreate{
+		Type:  IdentifierTypeUPC,
+		Value: " 012345678905 ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "012345678905", upc.Value, "values are trimmed")
+
+	_, err = tRepos.Identifiers.Create(ctx, tGroup.ID, items[0].ID, ItemIdentifierCreate{
+		Type:  IdentifierTypeNFC,
+		Value: "04:a2:2b:1a",
+	})
+	require.NoError(t, err)
+
+	// Type and value are unique within the group, ignoring case
+	_, err = tRepos.Identifiers.Create(ctx, tGroup.ID, items[1].ID, ItemIdentifierCreate{
+		Type:  IdentifierTypeNFC,
+		Value: "04:A2:2B:1A",
+	})
+	require.ErrorIs(t, err, ErrIdentifierExists)
+
+	// The database enforces it too, for identifiers stored concurrently
+	err = tClient.ItemIdentifier.Create().
+		SetGroupID(tGroup.ID).
+		SetItemID(items[1].ID).
+		SetType(itemidentifier.TypeNfc).
+		SetValue("04:A2:2B:1A").
+		SetNormalizedValue(normalizeIdentifier("04:A2:2B:1A")).
+		Exec(ctx)
+	require.True(t, ent.IsConstraintError(err), "inserting a duplicate: %v", err)
+
+	// The same value with another type is another identifier
+	_, err = tRepos.Identifiers.Create(ctx, tGroup.ID, items[1].ID, ItemIdentifierCreate{
+		Type:  IdentifierTypeLegacyTag,
+		Value: "012345678905",
+	})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, items[0].ID)
+	require.NoError(t, err)
+	require.Len(t, got.Identifiers, 2)
+	assert.Equal(t, IdentifierTypeNFC, got.Identifiers[0].Type)
+	assert.Equal(t, IdentifierTypeUPC, got.Identifiers[1].Type)
+
+	err = tRepos.Identifiers.Delete(ctx, tGroup.ID, items[0].ID, upc.ID)
+	require.NoError(t, err)
+
+	ids, err := tRepos.Identifiers.GetByItem(ctx, tGroup.ID, items[0].ID)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+	assert.Equal(t, "04:a2:2b:1a", ids[0].Value)
+}
+
+func TestItemIdentifierRepository_Lookup(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+
+	err := tRepos.Identifiers.SetForItem(ctx, tGroup.ID, items[0].ID, []ItemIdentifierCreate{
+		{Type: IdentifierTypeLegacyTag, Value: "900-042"},
+		{Type: IdentifierTypeNFC, Value: "04A22B1A"},
+	})
+	require.NoError(t, err)
+
+	err = tRepos.Items.SetAssetID(ctx, tGroup.ID, items[1].ID, AssetRef{ID: 900042})
+	require.NoError(t, err)
+
+	matches, err := tRepos.Identifiers.Lookup(ctx, tGroup.ID, "04a22b1a")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, items[0].ID, matches[0].Item.ID)
+	assert.Equal(t, IdentifierTypeNFC, matches[0].Type)
+
+	// A legacy tag that is also an asset ID matches both items
+	matches, err = tRepos.Identifiers.Lookup(ctx, tGroup.ID, "900-042")
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	assert.Equal(t, IdentifierTypeAssetID, matches[0].Type)
+	assert.Equal(t, items[1].ID, matches[0].Item.ID)
+	assert.Equal(t, IdentifierTypeLegacyTag, matches[1].Type)
+	assert.Equal(t, items[0].ID, matches[1].Item.ID)
+
+	matches, err = tRepos.Identifiers.Lookup(ctx, tGroup.ID, "unknown")
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	// Setting the identifiers replaces them, which frees the old values
+	err = tRepos.Identifiers.SetForItem(ctx, tGroup.ID, items[0].ID, []ItemIdentifierCreate{
+		{Type: IdentifierTypeNFC, Value: "04A22B1A"},
+	})
+	require.NoError(t, err)
+
+	err = tRepos.Identifiers.SetForItem(ctx, tGroup.ID, items[2].ID, []ItemIdentifierCreate{
+		{Type: IdentifierTypeLegacyTag, Value: "900-042"},
+	})
+	require.NoError(t, err)
+
+	err = tRepos.Identifiers.SetForItem(ctx, tGroup.ID, items[2].ID, []ItemIdentifierCreate{
+		{Type: IdentifierTypeNFC, Value: "04a22b1a"},
+		{Type: IdentifierTypeOther, Value: "spare-7"},
+	})
+	require.ErrorIs(t, err, ErrIdentifierExists)
+
+	// The identifiers in use are skipped, the others replace those the item had
+	ids, err := tRepos.Identifiers.GetByItem(ctx, tGroup.ID, items[2].ID)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+	assert.Equal(t, "spare-7", ids[0].Value)
+}
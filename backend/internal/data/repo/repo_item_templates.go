@@ -262,6 +262,16 @@ func (r *ItemTemplatesRepository) GetOne(ctx context.Context, gid uuid.UUID, id
 
 // Create creates a new template
 func (r *ItemTemplatesRepository) Create(ctx context.Context, gid uuid.UUID, data ItemTemplateCreate) (ItemTemplateOut, error) {
+	defs, err := loadFieldDefinitions(ctx, r.db, gid)
+	if err != nil {
+		return ItemTemplateOut{}, err
+	}
+
+	data.Fields, err = normalizeTemplateFields(data.Fields, defs)
+	if err != nil {
+		return ItemTemplateOut{}, err
+	}
+
 	q := r.db.ItemTemplate.Create().
 		SetName(data.Name).
 		SetDescription(data.Description).
@@ -323,6 +333,16 @@ func (r *ItemTemplatesRepository) Update(ctx context.Context, gid uuid.UUID, dat
 		return ItemTemplateOut{}, err
 	}
 
+	defs, err := loadFieldDefinitions(ctx, r.db, gid)
+	if err != nil {
+		return ItemTemplateOut{}, err
+	}
+
+	data.Fields, err = normalizeTemplateFields(data.Fields, defs)
+	if err != nil {
+		return ItemTemplateOut{}, err
+	}
+
 	// Update template
 	updateQ := template.Update().
 		SetName(data.Name).
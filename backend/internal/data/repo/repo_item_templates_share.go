@@ -0,0 +1,343 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemtemplate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+)
+
+// ItemTemplateExportVersion is the version of the portable template format, it is
+// raised when the format changes in a way older versions can't import.
+const ItemTemplateExportVersion = 1
+
+// TemplateConflict is what importing does with a template named like an existing one.
+type TemplateConflict string
+
+const (
+	TemplateConflictSkip TemplateConflict = "skip"
+	// TemplateConflictRename imports the template under a free name, e.g. Laptop (2)
+	TemplateConflictRename TemplateConflict = "rename"
+	// TemplateConflictOverwrite replaces the existing template, keeping its ID
+	TemplateConflictOverwrite TemplateConflict = "overwrite"
+)
+
+// ErrTemplateExportVersion is returned for exports of an unknown version of the format.
+var ErrTemplateExportVersion = errors.New("unsupported template export version")
+
+type (
+	// PortableItemTemplate is a template without the IDs that tie it to a group. Its
+	// default location and labels are referred to by name.
+	PortableItemTemplate struct {
+		Name        string `json:"name"        validate:"required,min=1,max=255"`
+		Description string `json:"description" validate:"max=1000"`
+		Notes       string `json:"notes"       validate:"max=1000"`
+
+		DefaultQuantity         int    `json:"defaultQuantity"`
+		DefaultInsured          bool   `json:"defaultInsured"`
+		DefaultName             string `json:"defaultName"             validate:"max=255"`
+		DefaultDescription      string `json:"defaultDescription"      validate:"max=1000"`
+		DefaultManufacturer     string `json:"defaultManufacturer"     validate:"max=255"`
+		DefaultModelNumber      string `json:"defaultModelNumber"      validate:"max=255"`
+		DefaultLifetimeWarranty bool   `json:"defaultLifetimeWarranty"`
+		DefaultWarrantyDetails  string `json:"defaultWarrantyDetails"  validate:"max=1000"`
+
+		DefaultLocation string   `json:"defaultLocation,omitempty"`
+		DefaultLabels   []string `json:"defaultLabels"`
+
+		IncludeWarrantyFields bool `json:"includeWarrantyFields"`
+		IncludePurchaseFields bool `json:"includePurchaseFields"`
+		IncludeSoldFields     bool `json:"includeSoldFields"`
+
+		Fields []PortableTemplateField `json:"fields"`
+	}
+
+	PortableTemplateField struct {
+		Type      string `json:"type"`
+		Name      string `json:"name"      validate:"required"`
+		TextValue string `json:"textValue"`
+	}
+
+	ItemTemplateExport struct {
+		Version   int                    `json:"version"`
+		Templates []PortableItemTemplate `json:"templates" validate:"dive"`
+	}
+
+	ItemTemplateImport struct {
+		ItemTemplateExport
+		Conflict TemplateConflict `json:"conflict" validate:"omitempty,oneof=skip rename overwrite"`
+	}
+
+	ItemTemplateImportResult struct {
+		Created []ItemTemplateSummary `json:"created"`
+		Updated []ItemTemplateSummary `json:"updated"`
+		Skipped []string              `json:"skipped"`
+		// Warnings name the locations and labels that don't exist in the group, the
+		// templates are imported without them
+		Warnings []string `json:"warnings"`
+	}
+)
+
+// Export writes the templates in the portable format, all of the group's when no IDs
+// are given.
+func (r *ItemTemplatesRepository) Export(ctx context.Context, gid uuid.UUID, ids []uuid.UUID) (ItemTemplateExport, error) {
+	q := r.db.ItemTemplate.Query().
+		Where(itemtemplate.HasGroupWith(group.ID(gid)))
+	if len(ids) > 0 {
+		q.Where(itemtemplate.IDIn(ids...))
+	}
+
+	templates, err := q.
+		WithFields().
+		WithLocation().
+		Order(ent.Asc(itemtemplate.FieldName)).
+		All(ctx)
+	if err != nil {
+		return ItemTemplateExport{}, err
+	}
+
+	out := ItemTemplateExport{
+		Version:   ItemTemplateExportVersion,
+		Templates: make([]PortableItemTemplate, len(templates)),
+	}
+
+	for i, t := range templates {
+		tmpl := r.mapTemplateOut(ctx, t)
+
+		p := PortableItemTemplate{
+			Name:                    tmpl.Name,
+			Description:             tmpl.Description,
+			Notes:                   tmpl.Notes,
+			DefaultQuantity:         tmpl.DefaultQuantity,
+			DefaultInsured:          tmpl.DefaultInsured,
+			DefaultName:             tmpl.DefaultName,
+			DefaultDescription:      tmpl.DefaultDescription,
+			DefaultManufacturer:     tmpl.DefaultManufacturer,
+			DefaultModelNumber:      tmpl.DefaultModelNumber,
+			DefaultLifetimeWarranty: tmpl.DefaultLifetimeWarranty,
+			DefaultWarrantyDetails:  tmpl.DefaultWarrantyDetails,
+			DefaultLabels:           make([]string, len(tmpl.DefaultLabels)),
+			IncludeWarrantyFields:   tmpl.IncludeWarrantyFields,
+			IncludePurchaseFields:   tmpl.IncludePurchaseFields,
+			IncludeSoldFields:       tmpl.IncludeSoldFields,
+			Fields:                  make([]PortableTemplateField, len(tmpl.Fields)),
+		}
+
+		if tmpl.DefaultLocation != nil {
+			p.DefaultLocation = tmpl.DefaultLocation.Name
+		}
+		for j, l := range tmpl.DefaultLabels {
+			p.DefaultLabels[j] = l.Name
+		}
+		for j, f := range tmpl.Fields {
+			p.Fields[j] = PortableTemplateField{Type: f.Type, Name: f.Name, TextValue: f.TextValue}
+		}
+
+		out.Templates[i] = p
+	}
+
+	return out, nil
+}
+
+// Import creates the templates of an export in the group, resolving their default
+// location and labels by name ignoring case. Templates named like an existing one are
+// skipped, renamed or overwrite it depending on the conflict strategy, skip by default.
+// The templates are imported in a transaction, so a failing one leaves the group as it was.
+func (r *ItemTemplatesRepository) Import(ctx context.Context, gid uuid.UUID, data ItemTemplateImport) (ItemTemplateImportResult, error) {
+	if data.Version < 1 || data.Version > ItemTemplateExportVersion {
+		return ItemTemplateImportResult{}, fmt.Errorf("%w: %d", ErrTemplateExportVersion, data.Version)
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return ItemTemplateImportResult{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during template import")
+			}
+		}
+	}()
+
+	// Without the bus, the mutation event is published once the import commits
+	txr := &ItemTemplatesRepository{db: tx.Client()}
+
+	existing, err := tx.ItemTemplate.Query().
+		Where(itemtemplate.HasGroupWith(group.ID(gid))).
+		All(ctx)
+	if err != nil {
+		return ItemTemplateImportResult{}, err
+	}
+
+	byName := make(map[string]uuid.UUID, len(existing))
+	for _, t := range existing {
+		byName[strings.ToLower(t.Name)] = t.ID
+	}
+
+	locations, err := tx.Location.Query().
+		Where(location.HasGroupWith(group.ID(gid))).
+		Order(ent.Asc(location.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return ItemTemplateImportResult{}, err
+	}
+
+	// The oldest wins when several locations or labels share a name
+	locationIDs := make(map[string]uuid.UUID, len(locations))
+	for _, l := range locations {
+		if _, ok := locationIDs[strings.ToLower(l.Name)]; !ok {
+			locationIDs[strings.ToLower(l.Name)] = l.ID
+		}
+	}
+
+	labels, err := tx.Label.Query().
+		Where(label.HasGroupWith(group.ID(gid))).
+		Order(ent.Asc(label.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return ItemTemplateImportResult{}, err
+	}
+
+	labelIDs := make(map[string]uuid.UUID, len(labels))
+	for _, l := range labels {
+		if _, ok := labelIDs[strings.ToLower(l.Name)]; !ok {
+			labelIDs[strings.ToLower(l.Name)] = l.ID
+		}
+	}
+
+	result := ItemTemplateImportResult{
+		Created:  []ItemTemplateSummary{},
+		Updated:  []ItemTemplateSummary{},
+		Skipped:  []string{},
+		Warnings: []string{},
+	}
+
+	for _, p := range data.Templates {
+		create := ItemTemplateCreate{
+			Name:                    p.Name,
+			Description:             p.Description,
+			Notes:                   p.Notes,
+			DefaultQuantity:         &p.DefaultQuantity,
+			DefaultInsured:          p.DefaultInsured,
+			DefaultName:             &p.DefaultName,
+			DefaultDescription:      &p.DefaultDescription,
+			DefaultManufacturer:     &p.DefaultManufacturer,
+			DefaultModelNumber:      &p.DefaultModelNumber,
+			DefaultLifetimeWarranty: p.DefaultLifetimeWarranty,
+			DefaultWarrantyDetails:  &p.DefaultWarrantyDetails,
+			IncludeWarrantyFields:   p.IncludeWarrantyFields,
+			IncludePurchaseFields:   p.IncludePurchaseFields,
+			IncludeSoldFields:       p.IncludeSoldFields,
+			Fields:                  make([]TemplateField, len(p.Fields)),
+		}
+
+		if p.DefaultQuantity < 1 {
+			create.DefaultQuantity = nil
+		}
+
+		if p.DefaultLocation != "" {
+			if id, ok := locationIDs[strings.ToLower(p.DefaultLocation)]; ok {
+				create.DefaultLocationID = &id
+			} else {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s: location %q not found", p.Name, p.DefaultLocation))
+			}
+		}
+
+		ids := make([]uuid.UUID, 0, len(p.DefaultLabels))
+		for _, name := range p.DefaultLabels {
+			if id, ok := labelIDs[strings.ToLower(name)]; ok {
+				ids = append(ids, id)
+			} else {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s: label %q not found", p.Name, name))
+			}
+		}
+		create.DefaultLabelIDs = &ids
+
+		for i, f := range p.Fields {
+			create.Fields[i] = TemplateField{Type: f.Type, Name: f.Name, TextValue: f.TextValue}
+		}
+
+		existingID, conflict := byName[strings.ToLower(p.Name)]
+		if conflict {
+			switch data.Conflict {
+			case TemplateConflictOverwrite:
+				out, err := txr.Update(ctx, gid, ItemTemplateUpdate{
+					ID:                      existingID,
+					Name:                    create.Name,
+					Description:             create.Description,
+					Notes:                   create.Notes,
+					DefaultQuantity:         create.DefaultQuantity,
+					DefaultInsured:          create.DefaultInsured,
+					DefaultName:             create.DefaultName,
+					DefaultDescription:      create.DefaultDescription,
+					DefaultManufacturer:     create.DefaultManufacturer,
+					DefaultModelNumber:      create.DefaultModelNumber,
+					DefaultLifetimeWarranty: create.DefaultLifetimeWarranty,
+					DefaultWarrantyDetails:  create.DefaultWarrantyDetails,
+					DefaultLocationID:       create.DefaultLocationID,
+					DefaultLabelIDs:         create.DefaultLabelIDs,
+					IncludeWarrantyFields:   create.IncludeWarrantyFields,
+					IncludePurchaseFields:   create.IncludePurchaseFields,
+					IncludeSoldFields:       create.IncludeSoldFields,
+					Fields:                  create.Fields,
+				})
+				if err != nil {
+					return ItemTemplateImportResult{}, fmt.Errorf("template %q: %w", p.Name, err)
+				}
+
+				result.Updated = append(result.Updated, templateOutSummary(out))
+				continue
+			case TemplateConflictRename:
+				for n := 2; ; n++ {
+					name := fmt.Sprintf("%s (%d)", p.Name, n)
+					if _, taken := byName[strings.ToLower(name)]; !taken {
+						create.Name = name
+						break
+					}
+				}
+			default:
+				result.Skipped = append(result.Skipped, p.Name)
+				continue
+			}
+		}
+
+		out, err := txr.Create(ctx, gid, create)
+		if err != nil {
+			return ItemTemplateImportResult{}, fmt.Errorf("template %q: %w", p.Name, err)
+		}
+
+		byName[strings.ToLower(out.Name)] = out.ID
+		result.Created = append(result.Created, templateOutSummary(out))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ItemTemplateImportResult{}, err
+	}
+	committed = true
+
+	if len(result.Created) > 0 || len(result.Updated) > 0 {
+		r.publishMutationEvent(gid)
+	}
+
+	return result, nil
+}
+
+func templateOutSummary(t ItemTemplateOut) ItemTemplateSummary {
+	return ItemTemplateSummary{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemTemplatesRepository_ExportImport(t *testing.T) {
+	ctx := context.Background()
+	loc := useLocations(t, 1)[0]
+	labels := useLabels(t, 2)
+
+	data := templateFactory()
+	data.DefaultLocationID = &loc.ID
+	data.DefaultLabelIDs = &[]uuid.UUID{labels[0].ID, labels[1].ID}
+	data.Fields = []TemplateField{{Type: "text", Name: "Charger", TextValue: "USB-C"}}
+
+	tmpl, err := tRepos.ItemTemplates.Create(ctx, tGroup.ID, data)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.ItemTemplates.Delete(context.Background(), tGroup.ID, tmpl.ID)
+	})
+
+	export, err := tRepos.ItemTemplates.Export(ctx, tGroup.ID, []uuid.UUID{tmpl.ID})
+	require.NoError(t, err)
+	assert.Equal(t, ItemTemplateExportVersion, export.Version)
+	require.Len(t, export.Templates, 1)
+
+	p := export.Templates[0]
+	assert.Equal(t, tmpl.Name, p.Name)
+	assert.Equal(t, loc.Name, p.DefaultLocation)
+	assert.ElementsMatch(t, []string{labels[0].Name, labels[1].Name}, p.DefaultLabels)
+	require.Len(t, p.Fields, 1)
+	assert.Equal(t, "USB-C", p.Fields[0].TextValue)
+
+	// Import into another group that only has the location, named in another case
+	g, err := tRepos.Groups.GroupCreate(ctx, fk.Str(10))
+	require.NoError(t, err)
+
+	other, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: strings.ToUpper(loc.Name)})
+	require.NoError(t, err)
+
+	result, err := tRepos.ItemTemplates.Import(ctx, g.ID, ItemTemplateImport{ItemTemplateExport: export})
+	require.NoError(t, err)
+	require.Len(t, result.Created, 1)
+	assert.Len(t, result.Warnings, 2, "the labels don't exist in the group")
+
+	imported, err := tRepos.ItemTemplates.GetOne(ctx, g.ID, result.Created[0].ID)
+	require.NoError(t, err)
+	require.NotNil(t, imported.DefaultLocation)
+	assert.Equal(t, other.ID, imported.DefaultLocation.ID)
+	assert.Empty(t, imported.DefaultLabels)
+	require.Len(t, imported.Fields, 1)
+	assert.Equal(t, "Charger", imported.Fields[0].Name)
+
+	// Skipped by default
+	result, err = tRepos.ItemTemplates.Import(ctx, g.ID, ItemTemplateImport{ItemTemplateExport: export})
+	require.NoError(t, err)
+	assert.Empty(t, result.Created)
+	assert.Equal(t, []string{tmpl.Name}, result.Skipped)
+
+	result, err = tRepos.ItemTemplates.Import(ctx, g.ID, ItemTemplateImport{ItemTemplateExport: export, Conflict: TemplateConflictRename})
+	require.NoError(t, err)
+	require.Len(t, result.Created, 1)
+	assert.Equal(t, tmpl.Name+" (2)", result.Created[0].Name)
+
+	export.Templates[0].Notes = "overwritten"
+	result, err = tRepos.ItemTemplates.Import(ctx, g.ID, ItemTemplateImport{ItemTemplateExport: export, Conflict: TemplateConflictOverwrite})
+	require.NoError(t, err)
+	require.Len(t, result.Updated, 1)
+	assert.Equal(t, imported.ID, result.Updated[0].ID, "overwriting keeps the template")
+
+	imported, err = tRepos.ItemTemplates.GetOne(ctx, g.ID, imported.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "overwritten", imported.Notes)
+	assert.Len(t, imported.Fields, 1)
+
+	_, err = tRepos.ItemTemplates.Import(ctx, g.ID, ItemTemplateImport{ItemTemplateExport: ItemTemplateExport{Version: 99}})
+	require.ErrorIs(t, err, ErrTemplateExportVersion)
+}
+
+func TestItemTemplatesRepository_ImportAtomic(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, fk.Str(10))
+	require.NoError(t, err)
+
+	export := ItemTemplateExport{
+		Version: ItemTemplateExportVersion,
+		Templates: []PortableItemTemplate{
+			{Name: fk.Str(10)},
+			{Name: fk.Str(10), Notes: strings.Repeat("x", 1001)},
+		},
+	}
+
+	_, err = tRepos.ItemTemplates.Import(ctx, g.ID, ItemTemplateImport{ItemTemplateExport: export})
+	require.Error(t, err)
+
+	// The template imported before the failing one is rolled back
+	all, err := tRepos.ItemTemplates.GetAll(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
@@ -335,3 +335,44 @@ func TestItemTemplatesRepository_UpdateRemoveLocation(t *testing.T) {
 	err = tRepos.ItemTemplates.Delete(context.Background(), tGroup.ID, template.ID)
 	require.NoError(t, err)
 }
+
+func TestItemsRepository_CreateManyFromTemplate(t *testing.T) {
+	ctx := context.Background()
+	loc := useLocations(t, 1)[0]
+
+	ids, err := tRepos.Items.CreateManyFromTemplate(ctx, tGroup.ID, ItemCreateFromTemplate{
+		Name:         "Chromebook",
+		Quantity:     1,
+		LocationID:   loc.ID,
+		Manufacturer: "Acme",
+		Fields:       []ItemField{{Type: "text", Name: "Charger", TextValue: "USB-C"}},
+	}, []string{"SN-1", "SN-2", ""})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		for _, id := range ids {
+			_ = tRepos.Items.Delete(context.Background(), id)
+		}
+	})
+
+	require.Len(t, ids, 3)
+	items := make([]ItemOut, len(ids))
+	for i, id := range ids {
+		items[i], err = tRepos.Items.GetOne(ctx, id)
+		require.NoError(t, err)
+	}
+
+	for i, itm := range items {
+		assert.Equal(t, "Chromebook", itm.Name)
+		assert.Equal(t, "Acme", itm.Manufacturer)
+		require.Len(t, itm.Fields, 1)
+		assert.Equal(t, items[0].AssetID+AssetID(i), itm.AssetID, "asset IDs are sequential")
+	}
+
+	assert.Equal(t, "SN-1", items[0].SerialNumber)
+	assert.Equal(t, "SN-2", items[1].SerialNumber)
+	assert.Empty(t, items[2].SerialNumber)
+
+	highest, err := tRepos.Items.GetHighestAssetID(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Equal(t, items[2].AssetID, highest)
+}
@@ -24,17 +24,23 @@ type ItemsRepository struct {
 	db          *ent.Client
 	bus         *eventbus.EventBus
 	attachments *AttachmentRepo
+	search      *itemSearchIndex
 }
 
 type (
+	// FieldQuery filters items by a custom field, see ParseFieldQuery. An empty Op
+	// is an equality filter.
 	FieldQuery struct {
-		Name  string
-		Value string
+		Name  string  `json:"name"`
+		Op    FieldOp `json:"op,omitempty"`
+		Value string  `json:"value"`
+		// To is the upper bound of FieldOpBetween filters, Value the lower one
+		To string `json:"to,omitempty"`
 	}
 
 	ItemQuery struct {
-		Page             int
-		PageSize         int
+		Page             int          `json:"-"`
+		PageSize         int          `json:"-"`
 		Search           string       `json:"search"`
 		AssetID          AssetID      `json:"assetId"`
 		LocationIDs      []uuid.UUID  `json:"locationIds"`
@@ -47,6 +53,14 @@ type (
 		IncludeArchived  bool         `json:"includeArchived"`
 		Fields           []FieldQuery `json:"fields"`
 		OrderBy          string       `json:"orderBy"`
+		// Statuses limits the items to these lifecycle statuses, any status when empty
+		Statuses []ItemStatus `json:"statuses"`
+
+		// Terms of a structured query, see ParseItemQuery
+		Terms []QueryTerm `json:"-"`
+
+		// Set by the service layer to limit results to a kiosk's location subtree
+		LocationScope []uuid.UUID `json:"-"`
 	}
 
 	DuplicateOptions struct {
@@ -56,14 +70,17 @@ type (
 		CopyPrefix       string `json:"copyPrefix"`
 	}
 
+	// ItemField is a custom field of an item. Only the value matching its type is used,
+	// see the FieldType constants.
 	ItemField struct {
-		ID           uuid.UUID `json:"id,omitempty"`
-		Type         string    `json:"type"`
-		Name         string    `json:"name"`
-		TextValue    string    `json:"textValue"`
-		NumberValue  int       `json:"numberValue"`
-		BooleanValue bool      `json:"booleanValue"`
-		// TimeValue    time.Time `json:"timeValue,omitempty"`
+		ID           uuid.UUID  `json:"id,omitempty"`
+		Type         string     `json:"type"`
+		Name         string     `json:"name"`
+		TextValue    string     `json:"textValue"`
+		NumberValue  float64    `json:"numberValue"`
+		BooleanValue bool       `json:"booleanValue"`
+		TimeValue    types.Date `json:"timeValue"`
+		SelectValues []string   `json:"selectValues"`
 	}
 
 	ItemCreate struct {
@@ -73,6 +90,8 @@ type (
 		Quantity    int       `json:"quantity"`
 		Description string    `json:"description" validate:"max=1000"`
 		AssetID     AssetID   `json:"-"`
+		// Prefix of the series AssetID is numbered in, see AssetIDFormats.Series
+		AssetIDPrefix string `json:"-"`
 
 		// Edges
 		LocationID uuid.UUID   `json:"locationId"`
@@ -115,9 +134,20 @@ type (
 		SoldPrice float64    `json:"soldPrice" extensions:"x-nullable,x-omitempty"`
 		SoldNotes string     `json:"soldNotes"`
 
+		// Consumable stock, see ItemSummary.LowStock
+		MinStock        int `json:"minStock"        validate:"min=0"`
+		ReorderQuantity int `json:"reorderQuantity" validate:"min=0"`
+
+		// Why the quantity changed, recorded in the stock ledger
+		QuantityReason StockReason `json:"quantityReason,omitempty" validate:"omitempty,oneof=purchase issue loss adjustment count_correction"`
+		QuantityNote   string      `json:"quantityNote,omitempty"   validate:"max=1000"`
+
 		// Extras
 		Notes  string      `json:"notes"`
 		Fields []ItemField `json:"fields"`
+
+		// Set by CSV imports, which write the asset ID in its series; nil keeps the series
+		AssetIDPrefix *string `json:"-"`
 	}
 
 	ItemPatch struct {
@@ -126,6 +156,10 @@ type (
 		ImportRef  *string     `json:"-,omitempty"        extensions:"x-nullable,x-omitempty"`
 		LocationID uuid.UUID   `json:"locationId"         extensions:"x-nullable,x-omitempty"`
 		LabelIDs   []uuid.UUID `json:"labelIds"           extensions:"x-nullable,x-omitempty"`
+
+		// Why the quantity changed, recorded in the stock ledger
+		QuantityReason StockReason `json:"quantityReason,omitempty" validate:"omitempty,oneof=purchase issue loss adjustment count_correction"`
+		QuantityNote   string      `json:"quantityNote,omitempty"   validate:"max=1000"`
 	}
 
 	ItemSummary struct {
@@ -140,6 +174,9 @@ type (
 		CreatedAt   time.Time `json:"createdAt"`
 		UpdatedAt   time.Time `json:"updatedAt"`
 
+		// Status is the lifecycle status, see ItemStatusTransitions
+		Status ItemStatus `json:"status"`
+
 		PurchasePrice float64 `json:"purchasePrice"`
 
 		// Edges
@@ -151,12 +188,28 @@ type (
 
 		// Sale details
 		SoldTime time.Time `json:"soldTime"`
+
+		// Post-return quarantine
+		Quarantined     bool       `json:"quarantined"`
+		QuarantinedAt   *time.Time `json:"quarantinedAt,omitempty"   extensions:"x-nullable,x-omitempty"`
+		QuarantineUntil *time.Time `json:"quarantineUntil,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		// Consumable stock
+		MinStock        int `json:"minStock"`
+		ReorderQuantity int `json:"reorderQuantity"`
+		// LowStock is set when the quantity has dropped below MinStock
+		LowStock bool `json:"lowStock"`
 	}
 
 	ItemOut struct {
 		Parent *ItemSummary `json:"parent,omitempty" extensions:"x-nullable,x-omitempty"`
 		ItemSummary
 		AssetID AssetID `json:"assetId,string"`
+		// AssetIDPrefix is the prefix of the label series the item is numbered in, empty
+		// for the group's series
+		AssetIDPrefix string `json:"assetIdPrefix"`
+		// AssetTag is the asset ID written in the group's format, e.g. HB-LAP-0042
+		AssetTag string `json:"assetTag"`
 
 		SyncChildItemsLocations bool `json:"syncChildItemsLocations"`
 
@@ -182,8 +235,9 @@ type (
 		// Extras
 		Notes string `json:"notes"`
 
-		Attachments []ItemAttachment `json:"attachments"`
-		Fields      []ItemField      `json:"fields"`
+		Attachments []ItemAttachment    `json:"attachments"`
+		Fields      []ItemField         `json:"fields"`
+		Identifiers []ItemIdentifierOut `json:"identifiers"`
 	}
 )
 
@@ -231,6 +285,7 @@ func mapItemSummary(item *ent.Item) ItemSummary {
 		CreatedAt:     item.CreatedAt,
 		UpdatedAt:     item.UpdatedAt,
 		Archived:      item.Archived,
+		Status:        ItemStatus(item.Status),
 		PurchasePrice: item.PurchasePrice,
 
 		// Edges
@@ -241,6 +296,14 @@ func mapItemSummary(item *ent.Item) ItemSummary {
 		Insured:     item.Insured,
 		ImageID:     imageID,
 		ThumbnailId: thumbnailID,
+
+		Quarantined:     isQuarantined(item, time.Now()),
+		QuarantinedAt:   item.QuarantinedAt,
+		QuarantineUntil: item.QuarantineUntil,
+
+		MinStock:        item.MinStock,
+		ReorderQuantity: item.ReorderQuantity,
+		LowStock:        isLowStock(item),
 	}
 }
 
@@ -259,7 +322,13 @@ func mapFields(fields []*ent.ItemField) []ItemField {
 			TextValue:    f.TextValue,
 			NumberValue:  f.NumberValue,
 			BooleanValue: f.BooleanValue,
-			// TimeValue:    f.TimeValue,
+		}
+
+		switch f.Type {
+		case itemfield.TypeTime:
+			result[i].TimeValue = types.DateFromTime(f.TimeValue)
+		case itemfield.TypeMultiselect:
+			result[i].SelectValues = splitMultiSelect(f.TextValue)
 		}
 	}
 	return result
@@ -276,15 +345,29 @@ func mapItemOut(item *ent.Item) ItemOut {
 		fields = mapFields(item.Edges.Fields)
 	}
 
+	var identifiers []ItemIdentifierOut
+	if item.Edges.Identifiers != nil {
+		identifiers = mapEach(item.Edges.Identifiers, mapItemIdentifierOut)
+	}
+
 	var parent *ItemSummary
 	if item.Edges.Parent != nil {
 		v := mapItemSummary(item.Edges.Parent)
 		parent = &v
 	}
 
+	// The group is loaded by getOneTx, the asset ID is only written in its format there
+	var assetTag string
+	if item.Edges.Group != nil {
+		formats := AssetIDFormats{AssetIDFormat: assetIDFormatOf(item.Edges.Group)}
+		assetTag = formats.Format(AssetRef{Prefix: item.AssetIDPrefix, ID: AssetID(item.AssetID)})
+	}
+
 	return ItemOut{
 		Parent:                  parent,
 		AssetID:                 AssetID(item.AssetID),
+		AssetIDPrefix:           item.AssetIDPrefix,
+		AssetTag:                assetTag,
 		ItemSummary:             mapItemSummary(item),
 		LifetimeWarranty:        item.LifetimeWarranty,
 		WarrantyExpires:         types.DateFromTime(item.WarrantyExpires),
@@ -310,6 +393,7 @@ func mapItemOut(item *ent.Item) ItemOut {
 		Notes:       item.Notes,
 		Attachments: attachments,
 		Fields:      fields,
+		Identifiers: identifiers,
 	}
 }
 
@@ -329,6 +413,7 @@ func (e *ItemsRepository) getOneTx(ctx context.Context, tx *ent.Tx, where ...pre
 
 	return mapItemOutErr(q.
 		WithFields().
+		WithIdentifiers(orderIdentifiers).
 		WithLabel().
 		WithLocation().
 		WithGroup().
@@ -363,8 +448,9 @@ func (e *ItemsRepository) GetOneByGroup(ctx context.Context, gid, id uuid.UUID)
 	return e.getOne(ctx, item.ID(id), item.HasGroupWith(group.ID(gid)))
 }
 
-// QueryByGroup returns a list of items that belong to a specific group based on the provided query.
-func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q ItemQuery) (PaginationResult[ItemSummary], error) {
+// filterQuery builds the query selecting the items of the group matching q. The search
+// terms are returned along with whether the results should be ordered by relevance.
+func (e *ItemsRepository) filterQuery(gid uuid.UUID, q ItemQuery) (*ent.ItemQuery, []string, bool) {
 	qb := e.db.Item.Query().Where(
 		item.HasGroupWith(group.ID(gid)),
 	)
@@ -380,7 +466,21 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 		qb = qb.Where(item.Archived(false))
 	}
 
+	var (
+		terms  []string
+		ranked bool
+	)
+
 	if q.Search != "" {
+		terms = searchTerms(q.Search)
+	}
+
+	if len(terms) > 0 && e.search.available() {
+		// The full-text index covers the same columns as the fallback below plus
+		// custom field text, and ignores accents and case.
+		qb.Where(itemSearchMatch(terms))
+		ranked = q.OrderBy == "" || q.OrderBy == "relevance"
+	} else if q.Search != "" {
 		// Use accent-insensitive search predicates that normalize both
 		// the search query and database field values during comparison.
 		// For queries without accents, the traditional search is more efficient.
@@ -405,7 +505,9 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 	}
 
 	if !q.AssetID.Nil() {
-		qb = qb.Where(item.AssetID(q.AssetID.Int()))
+		// A plain number is in the group's own series, label series are only
+		// matched by asset terms with their prefix
+		qb = qb.Where(item.AssetIDPrefix(""), item.AssetID(q.AssetID.Int()))
 	}
 
 	// Filters within this block define a AND relationship where each subset
@@ -463,41 +565,91 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 			andPredicates = append(andPredicates, item.Or(locationPredicates...))
 		}
 
-		if len(q.Fields) > 0 {
-			fieldPredicates := make([]predicate.Item, 0, len(q.Fields))
-			for _, f := range q.Fields {
-				fieldPredicates = append(fieldPredicates, item.HasFieldsWith(
-					itemfield.And(
-						itemfield.Name(f.Name),
-						itemfield.TextValue(f.Value),
-					),
-				))
-			}
+		if len(q.LocationScope) > 0 {
+			andPredicates = append(andPredicates, item.HasLocationWith(location.IDIn(q.LocationScope...)))
+		}
 
-			andPredicates = append(andPredicates, item.Or(fieldPredicates...))
+		if len(q.Fields) > 0 {
+			andPredicates = append(andPredicates, fieldQueriesPredicate(q.Fields))
 		}
 
 		if len(q.ParentItemIDs) > 0 {
 			andPredicates = append(andPredicates, item.HasParentWith(item.IDIn(q.ParentItemIDs...)))
 		}
+
+		if len(q.Statuses) > 0 {
+			statuses := make([]item.Status, len(q.Statuses))
+			for i, s := range q.Statuses {
+				statuses[i] = item.Status(s)
+			}
+			andPredicates = append(andPredicates, item.StatusIn(statuses...))
+		}
+
+		now := time.Now()
+		for _, t := range q.Terms {
+			andPredicates = append(andPredicates, t.predicate(now))
+		}
 	}
 
 	if len(andPredicates) > 0 {
 		qb = qb.Where(item.And(andPredicates...))
 	}
 
+	return qb, terms, ranked
+}
+
+// resolveAssetTerms reads the values of the asset terms of the query in the group's
+// format. The formats are only loaded for queries with asset terms.
+func (e *ItemsRepository) resolveAssetTerms(ctx context.Context, gid uuid.UUID, q ItemQuery) (ItemQuery, error) {
+	var formats *AssetIDFormats
+
+	terms := make([]QueryTerm, len(q.Terms))
+	for i, t := range q.Terms {
+		terms[i] = t
+		if t.Key != QueryKeyAssetID {
+			continue
+		}
+
+		if formats == nil {
+			f, err := loadAssetIDFormats(ctx, e.db, gid)
+			if err != nil {
+				return q, err
+			}
+			formats = &f
+		}
+
+		if ref, err := formats.Parse(t.Value); err == nil {
+			terms[i].asset = &ref
+		}
+	}
+
+	q.Terms = terms
+	return q, nil
+}
+
+// QueryByGroup returns a list of items that belong to a specific group based on the provided query.
+func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q ItemQuery) (PaginationResult[ItemSummary], error) {
+	q, err := e.resolveAssetTerms(ctx, gid, q)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	qb, terms, ranked := e.filterQuery(gid, q)
+
 	count, err := qb.Count(ctx)
 	if err != nil {
 		return PaginationResult[ItemSummary]{}, err
 	}
 
 	// Order
-	switch q.OrderBy {
-	case "createdAt":
+	switch {
+	case ranked: // "relevance", the default when searching
+		qb = qb.Order(itemSearchRank(terms), ent.Asc(item.FieldName))
+	case q.OrderBy == "createdAt":
 		qb = qb.Order(ent.Desc(item.FieldCreatedAt))
-	case "updatedAt":
+	case q.OrderBy == "updatedAt":
 		qb = qb.Order(ent.Desc(item.FieldUpdatedAt))
-	case "assetId":
+	case q.OrderBy == "assetId":
 		qb = qb.Order(ent.Asc(item.FieldAssetID))
 	default: // "name"
 		qb = qb.Order(ent.Asc(item.FieldName))
@@ -532,11 +684,13 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 	}, nil
 }
 
-// QueryByAssetID returns items by asset ID. If the item does not exist, an error is returned.
-func (e *ItemsRepository) QueryByAssetID(ctx context.Context, gid uuid.UUID, assetID AssetID, page int, pageSize int) (PaginationResult[ItemSummary], error) {
+// QueryByAssetID returns items by asset ID, see AssetIDFormats.Parse. If the item does
+// not exist, an error is returned.
+func (e *ItemsRepository) QueryByAssetID(ctx context.Context, gid uuid.UUID, ref AssetRef, page int, pageSize int) (PaginationResult[ItemSummary], error) {
 	qb := e.db.Item.Query().Where(
 		item.HasGroupWith(group.ID(gid)),
-		item.AssetID(int(assetID)),
+		item.AssetIDPrefix(ref.Prefix),
+		item.AssetID(int(ref.ID)),
 	)
 
 	if page != -1 || pageSize != -1 {
@@ -565,6 +719,17 @@ func (e *ItemsRepository) QueryByAssetID(ctx context.Context, gid uuid.UUID, ass
 	}, nil
 }
 
+// InLocations reports whether the group's item is stored in one of the locations.
+func (e *ItemsRepository) InLocations(ctx context.Context, gid, id uuid.UUID, locationIDs []uuid.UUID) (bool, error) {
+	return e.db.Item.Query().
+		Where(
+			item.ID(id),
+			item.HasGroupWith(group.ID(gid)),
+			item.HasLocationWith(location.IDIn(locationIDs...)),
+		).
+		Exist(ctx)
+}
+
 // GetAll returns all the items in the database with the Labels and Locations eager loaded.
 func (e *ItemsRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]ItemOut, error) {
 	return mapItemsOutErr(e.db.Item.Query().
@@ -572,6 +737,26 @@ func (e *ItemsRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]ItemOut,
 		WithLabel().
 		WithLocation().
 		WithFields().
+		WithIdentifiers(orderIdentifiers).
+		All(ctx))
+}
+
+// GetAllByQuery returns every item of the group matching q with its labels, location
+// and fields, ignoring pagination.
+func (e *ItemsRepository) GetAllByQuery(ctx context.Context, gid uuid.UUID, q ItemQuery) ([]ItemOut, error) {
+	q, err := e.resolveAssetTerms(ctx, gid, q)
+	if err != nil {
+		return nil, err
+	}
+
+	qb, _, _ := e.filterQuery(gid, q)
+
+	return mapItemsOutErr(qb.
+		Order(ent.Asc(item.FieldName)).
+		WithLabel().
+		WithLocation().
+		WithFields().
+		WithIdentifiers(orderIdentifiers).
 		All(ctx))
 }
 
@@ -581,28 +766,32 @@ func (e *ItemsRepository) GetAllZeroAssetID(ctx context.Context, gid uuid.UUID)
 		item.AssetID(0),
 	).Order(
 		ent.Asc(item.FieldCreatedAt),
-	)
+	).WithLabel()
 
 	return mapItemsSummaryErr(q.All(ctx))
 }
 
 func (e *ItemsRepository) GetHighestAssetIDTx(ctx context.Context, tx *ent.Tx, gid uuid.UUID) (AssetID, error) {
-	var q *ent.ItemQuery
+	db := e.db
 	if tx != nil {
-		q = tx.Item.Query().Where(
-			item.HasGroupWith(group.ID(gid)),
-		).Order(
-			ent.Desc(item.FieldAssetID),
-		).Limit(1)
-	} else {
-		q = e.db.Item.Query().Where(
-			item.HasGroupWith(group.ID(gid)),
-		).Order(
-			ent.Desc(item.FieldAssetID),
-		).Limit(1)
+		db = tx.Client()
 	}
 
-	result, err := q.First(ctx)
+	return highestAssetID(ctx, db, gid, "")
+}
+
+// highestAssetID returns the highest asset ID of the series with the prefix.
+func highestAssetID(ctx context.Context, db *ent.Client, gid uuid.UUID, prefix string) (AssetID, error) {
+	// Trashed items keep their asset IDs, which must not be handed out again
+	ctx = withDeleted(ctx)
+
+	result, err := db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.AssetIDPrefix(prefix),
+		).
+		Order(ent.Desc(item.FieldAssetID)).
+		First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return 0, nil
@@ -613,28 +802,72 @@ func (e *ItemsRepository) GetHighestAssetIDTx(ctx context.Context, tx *ent.Tx, g
 	return AssetID(result.AssetID), nil
 }
 
+// nextAssetID returns the next asset ID of the series items with the labels are numbered in.
+func nextAssetID(ctx context.Context, db *ent.Client, gid uuid.UUID, labelIDs []uuid.UUID) (AssetRef, error) {
+	formats, err := loadAssetIDFormats(ctx, db, gid)
+	if err != nil {
+		return AssetRef{}, err
+	}
+
+	ref := AssetRef{Prefix: formats.Series(labelIDs)}
+
+	ref.ID, err = highestAssetID(ctx, db, gid, ref.Prefix)
+	if err != nil {
+		return AssetRef{}, err
+	}
+	ref.ID++
+
+	return ref, nil
+}
+
+// NextAssetID returns the next asset ID for an item with the labels, in the series of
+// the first label with its own or else in the group's.
+func (e *ItemsRepository) NextAssetID(ctx context.Context, gid uuid.UUID, labelIDs []uuid.UUID) (AssetRef, error) {
+	return nextAssetID(ctx, e.db, gid, labelIDs)
+}
+
+// AssetIDFormats returns the formats the group's asset IDs are written in.
+func (e *ItemsRepository) AssetIDFormats(ctx context.Context, gid uuid.UUID) (AssetIDFormats, error) {
+	return loadAssetIDFormats(ctx, e.db, gid)
+}
+
 func (e *ItemsRepository) GetHighestAssetID(ctx context.Context, gid uuid.UUID) (AssetID, error) {
 	return e.GetHighestAssetIDTx(ctx, nil, gid)
 }
 
-func (e *ItemsRepository) SetAssetID(ctx context.Context, gid uuid.UUID, id uuid.UUID, assetID AssetID) error {
+func (e *ItemsRepository) SetAssetID(ctx context.Context, gid uuid.UUID, id uuid.UUID, ref AssetRef) error {
 	q := e.db.Item.Update().Where(
 		item.HasGroupWith(group.ID(gid)),
 		item.ID(id),
 	)
 
-	_, err := q.SetAssetID(int(assetID)).Save(ctx)
+	_, err := q.SetAssetIDPrefix(ref.Prefix).SetAssetID(int(ref.ID)).Save(ctx)
 	return err
 }
 
 func (e *ItemsRepository) Create(ctx context.Context, gid uuid.UUID, data ItemCreate) (ItemOut, error) {
-	q := e.db.Item.Create().
+	// The starting quantity is recorded in the stock ledger along with the item
+	tx, err := e.db.Tx(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during item creation")
+			}
+		}
+	}()
+
+	q := tx.Item.Create().
 		SetImportRef(data.ImportRef).
 		SetName(data.Name).
 		SetQuantity(data.Quantity).
 		SetDescription(data.Description).
 		SetGroupID(gid).
 		SetLocationID(data.LocationID).
+		SetAssetIDPrefix(data.AssetIDPrefix).
 		SetAssetID(int(data.AssetID))
 
 	if data.ParentID != uuid.Nil {
@@ -650,6 +883,11 @@ func (e *ItemsRepository) Create(ctx context.Context, gid uuid.UUID, data ItemCr
 		return ItemOut{}, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return ItemOut{}, err
+	}
+	committed = true
+
 	e.publishMutationEvent(gid)
 	return e.GetOne(ctx, result.ID)
 }
@@ -664,17 +902,32 @@ type ItemCreateFromTemplate struct {
 	Insured          bool
 	Manufacturer     string
 	ModelNumber      string
+	SerialNumber     string
 	LifetimeWarranty bool
 	WarrantyDetails  string
-	Fields           []ItemField
+	// Fields hold their values in the text form templates keep them in
+	Fields []ItemField
 }
 
 // CreateFromTemplate creates an item with all template data in a single transaction.
 func (e *ItemsRepository) CreateFromTemplate(ctx context.Context, gid uuid.UUID, data ItemCreateFromTemplate) (ItemOut, error) {
-	tx, err := e.db.Tx(ctx)
+	ids, err := e.CreateManyFromTemplate(ctx, gid, data, []string{data.SerialNumber})
 	if err != nil {
 		return ItemOut{}, err
 	}
+
+	return e.GetOne(ctx, ids[0])
+}
+
+// CreateManyFromTemplate creates an item from the template for each of the serial
+// numbers, empty ones create items without a serial number, and returns their IDs in
+// the same order. The items get sequential asset IDs, assigned in the same transaction
+// so that no other item gets one in between.
+func (e *ItemsRepository) CreateManyFromTemplate(ctx context.Context, gid uuid.UUID, data ItemCreateFromTemplate, serialNumbers []string) ([]uuid.UUID, error) {
+	tx, err := e.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
 	committed := false
 	defer func() {
 		if !committed {
@@ -685,60 +938,79 @@ func (e *ItemsRepository) CreateFromTemplate(ctx context.Context, gid uuid.UUID,
 	}()
 
 	// Get next asset ID within transaction
-	nextAssetID, err := e.GetHighestAssetIDTx(ctx, tx, gid)
+	assetRef, err := nextAssetID(ctx, tx.Client(), gid, data.LabelIDs)
 	if err != nil {
-		return ItemOut{}, err
-	}
-	nextAssetID++
-
-	// Create item with all template data
-	newItemID := uuid.New()
-	itemBuilder := tx.Item.Create().
-		SetID(newItemID).
-		SetName(data.Name).
-		SetDescription(data.Description).
-		SetQuantity(data.Quantity).
-		SetLocationID(data.LocationID).
-		SetGroupID(gid).
-		SetAssetID(int(nextAssetID)).
-		SetInsured(data.Insured).
-		SetManufacturer(data.Manufacturer).
-		SetModelNumber(data.ModelNumber).
-		SetLifetimeWarranty(data.LifetimeWarranty).
-		SetWarrantyDetails(data.WarrantyDetails)
-
-	if len(data.LabelIDs) > 0 {
-		itemBuilder.AddLabelIDs(data.LabelIDs...)
+		return nil, err
 	}
 
-	_, err = itemBuilder.Save(ctx)
+	defs, err := loadFieldDefinitions(ctx, tx.Client(), gid)
 	if err != nil {
-		return ItemOut{}, err
+		return nil, err
 	}
 
-	// Create custom fields
-	for _, field := range data.Fields {
-		_, err = tx.ItemField.Create().
-			SetItemID(newItemID).
-			SetType(itemfield.Type(field.Type)).
-			SetName(field.Name).
-			SetTextValue(field.TextValue).
-			Save(ctx)
+	ids := make([]uuid.UUID, len(serialNumbers))
+	for i, serial := range serialNumbers {
+		ids[i] = uuid.New()
+
+		// Create item with all template data
+		itemBuilder := tx.Item.Create().
+			SetID(ids[i]).
+			SetName(data.Name).
+			SetDescription(data.Description).
+			SetQuantity(data.Quantity).
+			SetLocationID(data.LocationID).
+			SetGroupID(gid).
+			SetAssetIDPrefix(assetRef.Prefix).
+			SetAssetID(int(assetRef.ID) + i).
+			SetInsured(data.Insured).
+			SetManufacturer(data.Manufacturer).
+			SetModelNumber(data.ModelNumber).
+			SetSerialNumber(serial).
+			SetLifetimeWarranty(data.LifetimeWarranty).
+			SetWarrantyDetails(data.WarrantyDetails)
+
+		if len(data.LabelIDs) > 0 {
+			itemBuilder.AddLabelIDs(data.LabelIDs...)
+		}
+
+		_, err = itemBuilder.Save(ctx)
 		if err != nil {
-			return ItemOut{}, fmt.Errorf("failed to create field %s: %w", field.Name, err)
+			return nil, err
+		}
+
+		// Create custom fields, whose values templates keep in their text form
+		for _, field := range data.Fields {
+			field, err = parseFieldText(field.Name, field.Type, field.TextValue)
+			if err != nil {
+				return nil, err
+			}
+
+			field, err = normalizeField(field, defs.get(field.Name))
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = setItemField(tx.ItemField.Create().SetItemID(ids[i]), field).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create field %s: %w", field.Name, err)
+			}
 		}
 	}
 
 	if err = tx.Commit(); err != nil {
-		return ItemOut{}, err
+		return nil, err
 	}
 	committed = true
 
 	e.publishMutationEvent(gid)
-	return e.GetOne(ctx, newItemID)
+	return ids, nil
 }
 
+// Delete permanently deletes the item, whether or not it is in the trash.
 func (e *ItemsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ctx = withDeleted(ctx)
+
 	// Get the item with its group and attachments before deletion
 	itm, err := e.db.Item.Query().
 		Where(item.ID(id)).
@@ -773,44 +1045,59 @@ func (e *ItemsRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// DeleteByGroup moves the item to the trash along with the items nested below it.
+// Their attachments, maintenance and loan history are kept until they are purged,
+// see TrashRepository.
 func (e *ItemsRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID) error {
-	// Get the item with its attachments before deletion
-	itm, err := e.db.Item.Query().
-		Where(
-			item.ID(id),
-			item.HasGroupWith(group.ID(gid)),
-		).
-		WithAttachments().
-		Only(ctx)
+	tx, err := e.db.Tx(ctx)
 	if err != nil {
 		return err
 	}
-
-	// Delete all attachments (and their files) before deleting the item
-	for _, att := range itm.Edges.Attachments {
-		err := e.attachments.Delete(ctx, gid, id, att.ID)
-		if err != nil {
-			log.Err(err).Str("attachment_id", att.ID.String()).Msg("failed to delete attachment during item deletion")
-			// Continue with other attachments even if one fails
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during item deletion")
+			}
 		}
+	}()
+
+	if _, err := trashItem(ctx, tx, gid, id, trashTime()); err != nil {
+		return err
 	}
 
-	_, err = e.db.Item.
-		Delete().
-		Where(
-			item.ID(id),
-			item.HasGroupWith(group.ID(gid)),
-		).Exec(ctx)
-	if err != nil {
+	if err := tx.Commit(); err != nil {
 		return err
 	}
+	committed = true
 
 	e.publishMutationEvent(gid)
-	return err
+	return nil
 }
 
 func (e *ItemsRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data ItemUpdate) (ItemOut, error) {
-	q := e.db.Item.Update().Where(item.ID(data.ID), item.HasGroupWith(group.ID(gid))).
+	ctx = WithStockMovement(ctx, data.QuantityReason, data.QuantityNote)
+
+	tx, err := e.db.Tx(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during item update")
+			}
+		}
+	}()
+
+	// Trashed items are not found, they have to be restored before they can be changed
+	_, err = tx.Item.Query().Where(item.ID(data.ID), item.HasGroupWith(group.ID(gid))).OnlyID(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	q := tx.Item.Update().Where(item.ID(data.ID), item.HasGroupWith(group.ID(gid))).
 		SetName(data.Name).
 		SetDescription(data.Description).
 		SetLocationID(data.LocationID).
@@ -832,9 +1119,21 @@ func (e *ItemsRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data
 		SetWarrantyDetails(data.WarrantyDetails).
 		SetQuantity(data.Quantity).
 		SetAssetID(int(data.AssetID)).
-		SetSyncChildItemsLocations(data.SyncChildItemsLocations)
+		SetSyncChildItemsLocations(data.SyncChildItemsLocations).
+		SetMinStock(data.MinStock).
+		SetReorderQuantity(data.ReorderQuantity)
+
+	defs, err := loadFieldDefinitions(ctx, tx.Client(), gid)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	data.Fields, err = normalizeFields(data.Fields, defs)
+	if err != nil {
+		return ItemOut{}, err
+	}
 
-	currentLabels, err := e.db.Item.Query().Where(item.ID(data.ID)).QueryLabel().All(ctx)
+	currentLabels, err := tx.Item.Query().Where(item.ID(data.ID)).QueryLabel().All(ctx)
 	if err != nil {
 		return ItemOut{}, err
 	}
@@ -859,8 +1158,12 @@ func (e *ItemsRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data
 		q.ClearParent()
 	}
 
+	if data.AssetIDPrefix != nil {
+		q.SetAssetIDPrefix(*data.AssetIDPrefix)
+	}
+
 	if data.SyncChildItemsLocations {
-		children, err := e.db.Item.Query().Where(item.ID(data.ID)).QueryChildren().All(ctx)
+		children, err := tx.Item.Query().Where(item.ID(data.ID)).QueryChildren().All(ctx)
 		if err != nil {
 			return ItemOut{}, err
 		}
@@ -886,7 +1189,7 @@ func (e *ItemsRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data
 		return ItemOut{}, err
 	}
 
-	fields, err := e.db.ItemField.Query().Where(itemfield.HasItemWith(item.ID(data.ID))).All(ctx)
+	fields, err := tx.ItemField.Query().Where(itemfield.HasItemWith(item.ID(data.ID))).All(ctx)
 	if err != nil {
 		return ItemOut{}, err
 	}
@@ -897,31 +1200,18 @@ func (e *ItemsRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data
 	for _, f := range data.Fields {
 		if f.ID == uuid.Nil {
 			// Create New Field
-			_, err = e.db.ItemField.Create().
-				SetItemID(data.ID).
-				SetType(itemfield.Type(f.Type)).
-				SetName(f.Name).
-				SetTextValue(f.TextValue).
-				SetNumberValue(f.NumberValue).
-				SetBooleanValue(f.BooleanValue).
-				// SetTimeValue(f.TimeValue).
+			_, err = setItemField(tx.ItemField.Create().SetItemID(data.ID), f).
 				Save(ctx)
 			if err != nil {
 				return ItemOut{}, err
 			}
 		}
 
-		opt := e.db.ItemField.Update().
+		opt := setItemField(tx.ItemField.Update().
 			Where(
 				itemfield.ID(f.ID),
 				itemfield.HasItemWith(item.ID(data.ID)),
-			).
-			SetType(itemfield.Type(f.Type)).
-			SetName(f.Name).
-			SetTextValue(f.TextValue).
-			SetNumberValue(f.NumberValue).
-			SetBooleanValue(f.BooleanValue)
-		// SetTimeValue(f.TimeValue)
+			), f)
 
 		_, err = opt.Save(ctx)
 		if err != nil {
@@ -934,7 +1224,7 @@ func (e *ItemsRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data
 
 	// Delete Fields that are no longer present
 	if fieldIds.Len() > 0 {
-		_, err = e.db.ItemField.Delete().
+		_, err = tx.ItemField.Delete().
 			Where(
 				itemfield.IDIn(fieldIds.Slice()...),
 				itemfield.HasItemWith(item.ID(data.ID)),
@@ -944,6 +1234,11 @@ func (e *ItemsRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data
 		}
 	}
 
+	if err := tx.Commit(); err != nil {
+		return ItemOut{}, err
+	}
+	committed = true
+
 	e.publishMutationEvent(gid)
 	return e.GetOne(ctx, data.ID)
 }
@@ -969,6 +1264,8 @@ func (e *ItemsRepository) GetAllZeroImportRef(ctx context.Context, gid uuid.UUID
 }
 
 func (e *ItemsRepository) Patch(ctx context.Context, gid, id uuid.UUID, data ItemPatch) error {
+	ctx = WithStockMovement(ctx, data.QuantityReason, data.QuantityNote)
+
 	tx, err := e.db.Tx(ctx)
 	if err != nil {
 		return err
@@ -982,6 +1279,12 @@ func (e *ItemsRepository) Patch(ctx context.Context, gid, id uuid.UUID, data Ite
 		}
 	}()
 
+	// Trashed items are not found, they have to be restored before they can be changed
+	_, err = tx.Item.Query().Where(item.ID(id), item.HasGroupWith(group.ID(gid))).OnlyID(ctx)
+	if err != nil {
+		return err
+	}
+
 	q := tx.Item.Update().
 		Where(
 			item.ID(id),
@@ -1277,7 +1580,8 @@ func (e *ItemsRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opti
 		return ItemOut{}, err
 	}
 
-	nextAssetID, err := e.GetHighestAssetIDTx(ctx, tx, gid)
+	// The copy is numbered in the same series as the original
+	nextAssetID, err := highestAssetID(ctx, tx.Client(), gid, originalItem.AssetIDPrefix)
 	if err != nil {
 		return ItemOut{}, err
 	}
@@ -1297,6 +1601,7 @@ func (e *ItemsRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opti
 		SetQuantity(originalItem.Quantity).
 		SetLocationID(originalItem.Location.ID).
 		SetGroupID(gid).
+		SetAssetIDPrefix(originalItem.AssetIDPrefix).
 		SetAssetID(int(nextAssetID)).
 		SetSerialNumber(originalItem.SerialNumber).
 		SetModelNumber(originalItem.ModelNumber).
@@ -1314,7 +1619,9 @@ func (e *ItemsRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opti
 		SetNotes(originalItem.Notes).
 		SetInsured(originalItem.Insured).
 		SetArchived(originalItem.Archived).
-		SetSyncChildItemsLocations(originalItem.SyncChildItemsLocations)
+		SetSyncChildItemsLocations(originalItem.SyncChildItemsLocations).
+		SetMinStock(originalItem.MinStock).
+		SetReorderQuantity(originalItem.ReorderQuantity)
 
 	if originalItem.Parent != nil {
 		itemBuilder.SetParentID(originalItem.Parent.ID)
@@ -1337,13 +1644,7 @@ func (e *ItemsRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opti
 	// Copy custom fields if requested
 	if options.CopyCustomFields {
 		for _, field := range originalItem.Fields {
-			_, err = tx.ItemField.Create().
-				SetItemID(newItemID).
-				SetType(itemfield.Type(field.Type)).
-				SetName(field.Name).
-				SetTextValue(field.TextValue).
-				SetNumberValue(field.NumberValue).
-				SetBooleanValue(field.BooleanValue).
+			_, err = setItemField(tx.ItemField.Create().SetItemID(newItemID), field).
 				Save(ctx)
 			if err != nil {
 				log.Warn().Err(err).Str("field_name", field.Name).Msg("failed to copy custom field during duplication")
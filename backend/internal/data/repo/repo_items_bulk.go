@@ -0,0 +1,364 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/label"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/set"
+)
+
+// maxBulkItems caps the number of items a single bulk operation can change
+const maxBulkItems = 1000
+
+var (
+	// ErrBulkTarget is returned when a bulk operation selects its items with both or
+	// neither of an ID list and a query.
+	ErrBulkTarget = errors.New("exactly one of ids or query is required")
+	// ErrBulkEmptyQuery is returned when the query of a bulk operation has no filters, which
+	// would select every item of the group.
+	ErrBulkEmptyQuery = errors.New("the query of a bulk operation needs at least one filter")
+	// ErrBulkTooManyItems is returned when a bulk operation selects more than maxBulkItems items.
+	ErrBulkTooManyItems = errors.New("too many items selected for a bulk operation")
+	// ErrBulkNoOperations is returned when a bulk operation has nothing to do.
+	ErrBulkNoOperations = errors.New("no operations given")
+	// ErrBulkDeleteExclusive is returned when deleting items is combined with other operations.
+	ErrBulkDeleteExclusive = errors.New("delete cannot be combined with other operations")
+	// ErrBulkInvalidReference is returned when the location or a label of a bulk
+	// operation does not belong to the group.
+	ErrBulkInvalidReference = errors.New("location or label not found")
+)
+
+type ItemBulkStatus string
+
+const (
+	ItemBulkStatusUpdated  ItemBulkStatus = "updated"
+	ItemBulkStatusDeleted  ItemBulkStatus = "deleted"
+	ItemBulkStatusNotFound ItemBulkStatus = "not_found"
+	ItemBulkStatusFailed   ItemBulkStatus = "failed"
+	// ItemBulkStatusRolledBack marks the items whose changes were discarded because
+	// another item of the batch failed.
+	ItemBulkStatusRolledBack ItemBulkStatus = "rolled_back"
+)
+
+type (
+	// ItemBulkRequest selects items either by ID or with a query and applies the
+	// operations to all of them in a single transaction.
+	ItemBulkRequest struct {
+		IDs        []uuid.UUID        `json:"ids"`
+		Query      *ItemQuery         `json:"query"      extensions:"x-nullable"`
+		Operations ItemBulkOperations `json:"operations"`
+	}
+
+	ItemBulkOperations struct {
+		LocationID     *uuid.UUID     `json:"locationId"     extensions:"x-nullable"`
+		AddLabelIDs    []uuid.UUID    `json:"addLabelIds"`
+		RemoveLabelIDs []uuid.UUID    `json:"removeLabelIds"`
+		Archived       *bool          `json:"archived"       extensions:"x-nullable"`
+		Field          *ItemBulkField `json:"field"          extensions:"x-nullable"`
+		Delete         bool           `json:"delete"`
+	}
+
+	// ItemBulkField sets a custom field, creating it on items that do not have it. The
+	// value is converted to the type of the group's definition for the name, if any.
+	ItemBulkField struct {
+		Name  string `json:"name"  validate:"required,max=255"`
+		Value string `json:"value" validate:"max=500"`
+	}
+
+	ItemBulkResult struct {
+		ID     uuid.UUID      `json:"id"`
+		Status ItemBulkStatus `json:"status"`
+		Error  string         `json:"error,omitempty"`
+	}
+
+	ItemBulkOut struct {
+		// Committed is false when any item failed, in which case nothing was changed
+		Committed bool             `json:"committed"`
+		Results   []ItemBulkResult `json:"results"`
+	}
+)
+
+func (op ItemBulkOperations) validate() error {
+	changes := op.LocationID != nil || len(op.AddLabelIDs) > 0 || len(op.RemoveLabelIDs) > 0 ||
+		op.Archived != nil || op.Field != nil
+
+	switch {
+	case op.Delete && changes:
+		return ErrBulkDeleteExclusive
+	case !op.Delete && !changes:
+		return ErrBulkNoOperations
+	}
+
+	return nil
+}
+
+// filters reports whether the query narrows down the items of the group in any way.
+// Terms must be parsed from the search first.
+func (q ItemQuery) filters() bool {
+	return strings.TrimSpace(q.Search) != "" || len(q.Terms) > 0 || q.AssetID > 0 ||
+		len(q.LocationIDs) > 0 || len(q.LabelIDs) > 0 || len(q.ParentItemIDs) > 0 ||
+		len(q.Fields) > 0 || len(q.Statuses) > 0 || q.OnlyWithPhoto || q.OnlyWithoutPhoto
+}
+
+// bulkTargets returns the IDs of the items selected by the request, in the order they
+// are reported in the results.
+func (e *ItemsRepository) bulkTargets(ctx context.Context, gid uuid.UUID, data ItemBulkRequest) ([]uuid.UUID, error) {
+	if (len(data.IDs) > 0) == (data.Query != nil) {
+		return nil, ErrBulkTarget
+	}
+
+	ids := data.IDs
+	if data.Query != nil {
+		q := *data.Query
+		if err := q.ParseSearch(); err != nil {
+			return nil, err
+		}
+		if !q.filters() {
+			return nil, ErrBulkEmptyQuery
+		}
+
+		q, err := e.resolveAssetTerms(ctx, gid, q)
+		if err != nil {
+			return nil, err
+		}
+
+		qb, _, _ := e.filterQuery(gid, q)
+
+		ids, err = qb.Order(ent.Asc(item.FieldName)).Limit(maxBulkItems + 1).IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(ids) > maxBulkItems {
+		return nil, ErrBulkTooManyItems
+	}
+
+	return ids, nil
+}
+
+// Bulk applies the operations to every selected item in one transaction. Items that are
+// not found in the group are reported and skipped; if any other item fails the whole
+// batch is rolled back.
+func (e *ItemsRepository) Bulk(ctx context.Context, gid uuid.UUID, data ItemBulkRequest) (ItemBulkOut, error) {
+	if err := data.Operations.validate(); err != nil {
+		return ItemBulkOut{}, err
+	}
+
+	ids, err := e.bulkTargets(ctx, gid, data)
+	if err != nil {
+		return ItemBulkOut{}, err
+	}
+
+	tx, err := e.db.Tx(ctx)
+	if err != nil {
+		return ItemBulkOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during bulk item operation")
+			}
+		}
+	}()
+
+	if err := bulkCheckReferences(ctx, tx, gid, data.Operations); err != nil {
+		return ItemBulkOut{}, err
+	}
+
+	var field ItemField
+	if op := data.Operations.Field; op != nil {
+		defs, err := loadFieldDefinitions(ctx, tx.Client(), gid)
+		if err != nil {
+			return ItemBulkOut{}, err
+		}
+
+		field, err = normalizeField(ItemField{Type: FieldTypeText, Name: op.Name, TextValue: op.Value}, defs.get(op.Name))
+		if err != nil {
+			return ItemBulkOut{}, err
+		}
+	}
+
+	results := make([]ItemBulkResult, len(ids))
+	var (
+		now    = trashTime()
+		failed bool
+		// nested items trashed along with an item deleted before them
+		trashed = map[uuid.UUID]bool{}
+	)
+
+	for i, id := range ids {
+		results[i].ID = id
+
+		if failed {
+			results[i].Status = ItemBulkStatusRolledBack
+			continue
+		}
+
+		if trashed[id] {
+			results[i].Status = ItemBulkStatusDeleted
+			continue
+		}
+
+		itm, err := tx.Item.Query().
+			Where(item.ID(id), item.HasGroupWith(group.ID(gid))).
+			Only(ctx)
+		if err != nil {
+			if !ent.IsNotFound(err) {
+				return ItemBulkOut{}, err
+			}
+			results[i].Status = ItemBulkStatusNotFound
+			continue
+		}
+
+		if data.Operations.Delete {
+			var nested []uuid.UUID
+			nested, err = trashItem(ctx, tx, gid, itm.ID, now)
+			for _, n := range nested {
+				trashed[n] = true
+			}
+			results[i].Status = ItemBulkStatusDeleted
+		} else {
+			err = bulkUpdate(ctx, tx, itm, data.Operations, field)
+			results[i].Status = ItemBulkStatusUpdated
+		}
+
+		if err != nil {
+			// The transaction may no longer be usable, so stop at the first failure
+			failed = true
+			results[i].Status = ItemBulkStatusFailed
+			results[i].Error = err.Error()
+		}
+	}
+
+	if failed {
+		for i := range results {
+			if results[i].Status == ItemBulkStatusUpdated || results[i].Status == ItemBulkStatusDeleted {
+				results[i].Status = ItemBulkStatusRolledBack
+			}
+		}
+
+		return ItemBulkOut{Results: results}, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ItemBulkOut{}, err
+	}
+	committed = true
+
+	if slices.ContainsFunc(results, func(r ItemBulkResult) bool { return r.Status != ItemBulkStatusNotFound }) {
+		e.publishMutationEvent(gid)
+	}
+
+	return ItemBulkOut{Committed: true, Results: results}, nil
+}
+
+// bulkCheckReferences makes sure the location and labels of the operations belong to the group.
+func bulkCheckReferences(ctx context.Context, tx *ent.Tx, gid uuid.UUID, op ItemBulkOperations) error {
+	if op.LocationID != nil {
+		ok, err := tx.Location.Query().
+			Where(location.ID(*op.LocationID), location.HasGroupWith(group.ID(gid))).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrBulkInvalidReference
+		}
+	}
+
+	if len(op.AddLabelIDs) > 0 {
+		ids := set.New(op.AddLabelIDs...)
+
+		n, err := tx.Label.Query().
+			Where(label.IDIn(ids.Slice()...), label.HasGroupWith(group.ID(gid))).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if n != ids.Len() {
+			return ErrBulkInvalidReference
+		}
+	}
+
+	return nil
+}
+
+func bulkUpdate(ctx context.Context, tx *ent.Tx, itm *ent.Item, op ItemBulkOperations, field ItemField) error {
+	q := tx.Item.UpdateOneID(itm.ID)
+
+	if op.LocationID != nil {
+		q.SetLocationID(*op.LocationID)
+	}
+
+	if op.Archived != nil {
+		q.SetArchived(*op.Archived)
+	}
+
+	if len(op.AddLabelIDs) > 0 || len(op.RemoveLabelIDs) > 0 {
+		current, err := tx.Item.QueryLabel(itm).All(ctx)
+		if err != nil {
+			return err
+		}
+		set := newIDSet(current)
+
+		for _, l := range op.AddLabelIDs {
+			if !set.Contains(l) {
+				q.AddLabelIDs(l)
+				set.Insert(l)
+			}
+		}
+
+		q.RemoveLabelIDs(op.RemoveLabelIDs...)
+	}
+
+	if err := q.Exec(ctx); err != nil {
+		return err
+	}
+
+	if op.LocationID != nil && itm.SyncChildItemsLocations {
+		err := tx.Item.Update().
+			Where(
+				item.HasParentWith(item.ID(itm.ID)),
+				item.Not(item.HasLocationWith(location.ID(*op.LocationID))),
+			).
+			SetLocationID(*op.LocationID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.Field != nil {
+		n, err := setItemField(tx.ItemField.Update().
+			Where(
+				itemfield.HasItemWith(item.ID(itm.ID)),
+				itemfield.Name(field.Name),
+			), field).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if n == 0 {
+			err = setItemField(tx.ItemField.Create().SetItemID(itm.ID), field).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package repo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsRepository_Bulk(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+	dest := useLocations(t, 1)[0]
+	labels := useLabels(t, 2)
+
+	err := tRepos.Items.Patch(ctx, tGroup.ID, items[0].ID, ItemPatch{LabelIDs: []uuid.UUID{labels[1].ID}})
+	require.NoError(t, err)
+
+	archived := true
+	missing := uuid.New()
+	out, err := tRepos.Items.Bulk(ctx, tGroup.ID, ItemBulkRequest{
+		IDs: []uuid.UUID{items[0].ID, items[1].ID, missing},
+		Operations: ItemBulkOperations{
+			LocationID:     &dest.ID,
+			AddLabelIDs:    []uuid.UUID{labels[0].ID},
+			RemoveLabelIDs: []uuid.UUID{labels[1].ID},
+			Archived:       &archived,
+			Field:          &ItemBulkField{Name: "Room", Value: "Lab B"},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, out.Committed)
+	assert.Equal(t, []ItemBulkResult{
+		{ID: items[0].ID, Status: ItemBulkStatusUpdated},
+		{ID: items[1].ID, Status: ItemBulkStatusUpdated},
+		{ID: missing, Status: ItemBulkStatusNotFound},
+	}, out.Results)
+
+	for _, itm := range items[:2] {
+		got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+		require.NoError(t, err)
+		assert.Equal(t, dest.ID, got.Location.ID)
+		assert.True(t, got.Archived)
+		assert.Equal(t, []uuid.UUID{labels[0].ID}, mapEach(got.Labels, func(l LabelSummary) uuid.UUID { return l.ID }))
+		require.Len(t, got.Fields, 1)
+		assert.Equal(t, "Lab B", got.Fields[0].TextValue)
+	}
+
+	// Setting the field again updates it instead of adding a second one
+	out, err = tRepos.Items.Bulk(ctx, tGroup.ID, ItemBulkRequest{
+		IDs:        []uuid.UUID{items[0].ID},
+		Operations: ItemBulkOperations{Field: &ItemBulkField{Name: "Room", Value: "Lab C"}},
+	})
+	require.NoError(t, err)
+	require.True(t, out.Committed)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, items[0].ID)
+	require.NoError(t, err)
+	require.Len(t, got.Fields, 1)
+	assert.Equal(t, "Lab C", got.Fields[0].TextValue)
+
+	untouched, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, items[2].ID)
+	require.NoError(t, err)
+	assert.Equal(t, items[2].Location.ID, untouched.Location.ID)
+	assert.False(t, untouched.Archived)
+}
+
+func TestItemsRepository_BulkQueryAndDelete(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 2)
+
+	out, err := tRepos.Items.Bulk(ctx, tGroup.ID, ItemBulkRequest{
+		Query:      &ItemQuery{Search: `loc:"` + items[0].Location.Name + `"`},
+		Operations: ItemBulkOperations{Delete: true},
+	})
+	require.NoError(t, err)
+	require.True(t, out.Committed)
+	assert.ElementsMatch(t,
+		[]ItemBulkResult{
+			{ID: items[0].ID, Status: ItemBulkStatusDeleted},
+			{ID: items[1].ID, Status: ItemBulkStatusDeleted},
+		},
+		out.Results,
+	)
+
+	for _, itm := range items {
+		_, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+		require.Error(t, err)
+	}
+}
+
+func TestItemsRepository_BulkDeleteNested(t *testing.T) {
+	ctx := context.Background()
+	parent := useItems(t, 1)[0]
+
+	create := func(parentID uuid.UUID) ItemOut {
+		itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{
+			Name:       fk.Str(10),
+			LocationID: parent.Location.ID,
+			ParentID:   parentID,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		})
+		return itm
+	}
+
+	child := create(parent.ID)
+	grandchild := create(child.ID)
+
+	// The child is trashed with its parent, before it comes up itself
+	out, err := tRepos.Items.Bulk(ctx, tGroup.ID, ItemBulkRequest{
+		IDs:        []uuid.UUID{parent.ID, child.ID},
+		Operations: ItemBulkOperations{Delete: true},
+	})
+	require.NoError(t, err)
+	require.True(t, out.Committed)
+	assert.Equal(t, []ItemBulkResult{
+		{ID: parent.ID, Status: ItemBulkStatusDeleted},
+		{ID: child.ID, Status: ItemBulkStatusDeleted},
+	}, out.Results)
+
+	for _, itm := range []ItemOut{parent, child, grandchild} {
+		_, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+		require.Error(t, err, "nested items are trashed with their parent")
+	}
+}
+
+func TestItemsRepository_BulkRollback(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 2)
+	dest := useLocations(t, 1)[0]
+
+	// The field value is longer than the column allows, so the first item fails
+	out, err := tRepos.Items.Bulk(ctx, tGroup.ID, ItemBulkRequest{
+		IDs: []uuid.UUID{items[0].ID, items[1].ID},
+		Operations: ItemBulkOperations{
+			LocationID: &dest.ID,
+			Field:      &ItemBulkField{Name: "Notes", Value: strings.Repeat("x", 501)},
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, out.Committed)
+	require.Len(t, out.Results, 2)
+	assert.Equal(t, ItemBulkStatusFailed, out.Results[0].Status)
+	assert.NotEmpty(t, out.Results[0].Error)
+	assert.Equal(t, ItemBulkStatusRolledBack, out.Results[1].Status)
+
+	for _, itm := range items {
+		got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+		require.NoError(t, err)
+		assert.Equal(t, itm.Location.ID, got.Location.ID, "the location change is rolled back")
+	}
+}
+
+func TestItemsRepository_BulkValidation(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 1)
+	ids := []uuid.UUID{items[0].ID}
+	foreign := uuid.New()
+	archived := true
+
+	testCases := []struct {
+		name string
+		req  ItemBulkRequest
+		err  error
+	}{
+		{"no target", ItemBulkRequest{Operations: ItemBulkOperations{Delete: true}}, ErrBulkTarget},
+		{"both targets", ItemBulkRequest{IDs: ids, Query: &ItemQuery{}, Operations: ItemBulkOperations{Delete: true}}, ErrBulkTarget},
+		{"empty query", ItemBulkRequest{Query: &ItemQuery{Search: " "}, Operations: ItemBulkOperations{Delete: true}}, ErrBulkEmptyQuery},
+		{"empty query for an update", ItemBulkRequest{Query: &ItemQuery{IncludeArchived: true}, Operations: ItemBulkOperations{Archived: &archived}}, ErrBulkEmptyQuery},
+		{"no operations", ItemBulkRequest{IDs: ids}, ErrBulkNoOperations},
+		{"delete and update", ItemBulkRequest{IDs: ids, Operations: ItemBulkOperations{Delete: true, LocationID: &foreign}}, ErrBulkDeleteExclusive},
+		{"unknown location", ItemBulkRequest{IDs: ids, Operations: ItemBulkOperations{LocationID: &foreign}}, ErrBulkInvalidReference},
+		{"unknown label", ItemBulkRequest{IDs: ids, Operations: ItemBulkOperations{AddLabelIDs: []uuid.UUID{foreign}}}, ErrBulkInvalidReference},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tRepos.Items.Bulk(ctx, tGroup.ID, tc.req)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
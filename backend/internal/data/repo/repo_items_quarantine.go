@@ -0,0 +1,116 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+var (
+	// ErrItemQuarantined is returned when checking out an item that is awaiting
+	// post-return inspection.
+	ErrItemQuarantined = errors.New("item is awaiting inspection after its last return")
+	// ErrItemNotQuarantined is returned when signing off an item that is not awaiting inspection.
+	ErrItemNotQuarantined = errors.New("item is not awaiting inspection")
+)
+
+// isQuarantined reports whether the item is awaiting post-return inspection at the given time.
+func isQuarantined(itm *ent.Item, now time.Time) bool {
+	if itm.QuarantinedAt == nil {
+		return false
+	}
+	return itm.QuarantineUntil == nil || now.Before(*itm.QuarantineUntil)
+}
+
+// itemQuarantined matches the items that are awaiting post-return inspection at the given time.
+func itemQuarantined(now time.Time) predicate.Item {
+	return item.And(
+		item.QuarantinedAtNotNil(),
+		item.Or(
+			item.QuarantineUntilIsNil(),
+			item.QuarantineUntilGT(now),
+		),
+	)
+}
+
+// quarantineFor returns when a quarantine that starts at returnedAt ends for an item with
+// the given labels. ok is false if none of the labels require a quarantine, and until is
+// nil if the quarantine lasts until staff sign off.
+func quarantineFor(labels []*ent.Label, returnedAt time.Time) (until *time.Time, ok bool) {
+	longest := 0
+	for _, l := range labels {
+		if !l.QuarantineOnReturn {
+			continue
+		}
+
+		if l.QuarantineMinutes == 0 {
+			return nil, true
+		}
+
+		ok = true
+		longest = max(longest, l.QuarantineMinutes)
+	}
+
+	if !ok {
+		return nil, false
+	}
+
+	end := returnedAt.Add(time.Duration(longest) * time.Minute)
+	return &end, true
+}
+
+// GetInspectionQueue returns the items that are awaiting post-return inspection,
+// oldest return first.
+func (e *ItemsRepository) GetInspectionQueue(ctx context.Context, gid uuid.UUID) ([]ItemSummary, error) {
+	return mapItemsSummaryErr(e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			itemQuarantined(time.Now()),
+		).
+		Order(ent.Asc(item.FieldQuarantinedAt)).
+		WithLabel().
+		WithLocation().
+		WithAttachments(func(aq *ent.AttachmentQuery) {
+			aq.Where(
+				attachment.Primary(true),
+			)
+			aq.WithThumbnail()
+		}).
+		All(ctx),
+	)
+}
+
+// SignOffInspection releases an item from post-return quarantine so it can be lent again.
+func (e *ItemsRepository) SignOffInspection(ctx context.Context, gid, id uuid.UUID) (ItemOut, error) {
+	n, err := e.db.Item.Update().
+		Where(
+			item.ID(id),
+			item.HasGroupWith(group.ID(gid)),
+			itemQuarantined(time.Now()),
+		).
+		ClearQuarantinedAt().
+		ClearQuarantineUntil().
+		Save(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	out, err := e.GetOneByGroup(ctx, gid, id)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	if n == 0 {
+		return ItemOut{}, ErrItemNotQuarantined
+	}
+
+	e.publishMutationEvent(gid)
+	return out, nil
+}
@@ -0,0 +1,159 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+)
+
+func TestQuarantineFor(t *testing.T) {
+	returnedAt := time.Now()
+
+	none := labelEntities(LabelCreate{}, LabelCreate{QuarantineMinutes: 30})
+	_, ok := quarantineFor(none, returnedAt)
+	assert.False(t, ok, "labels without quarantine_on_return never quarantine")
+
+	timed := labelEntities(
+		LabelCreate{QuarantineOnReturn: true, QuarantineMinutes: 30},
+		LabelCreate{QuarantineOnReturn: true, QuarantineMinutes: 60},
+	)
+	until, ok := quarantineFor(timed, returnedAt)
+	require.True(t, ok)
+	require.NotNil(t, until)
+	assert.Equal(t, returnedAt.Add(time.Hour), *until, "the longest quarantine wins")
+
+	signOff := labelEntities(
+		LabelCreate{QuarantineOnReturn: true, QuarantineMinutes: 60},
+		LabelCreate{QuarantineOnReturn: true},
+	)
+	until, ok = quarantineFor(signOff, returnedAt)
+	require.True(t, ok)
+	assert.Nil(t, until, "a label requiring sign off overrides timed quarantines")
+}
+
+func TestItemsRepository_ReturnQuarantine(t *testing.T) {
+	ctx := context.Background()
+
+	lbl, err := tRepos.Labels.Create(ctx, tGroup.ID, LabelCreate{
+		Name:               fk.Str(10),
+		QuarantineOnReturn: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Labels.delete(context.Background(), lbl.ID)
+	})
+
+	loc := useLocations(t, 1)[0]
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{
+		Name:       fk.Str(10),
+		LocationID: loc.ID,
+		LabelIDs:   []uuid.UUID{lbl.ID},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+	})
+
+	b := useBorrower(t, borrowerFactory())
+	loan := LoanCreate{
+		ItemID:     itm.ID,
+		BorrowerID: b.ID,
+		DueAt:      time.Now().Add(time.Hour),
+	}
+
+	out, err := tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.SignOffInspection(ctx, tGroup.ID, itm.ID)
+	require.ErrorIs(t, err, ErrItemNotQuarantined)
+
+	_, err = tRepos.Loans.Return(ctx, tGroup.ID, tUser.ID, LoanReturn{ID: out.ID})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Quarantined)
+	assert.NotNil(t, got.QuarantinedAt)
+	assert.Nil(t, got.QuarantineUntil)
+
+	queue, err := tRepos.Items.GetInspectionQueue(ctx, tGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, queue, 1)
+	assert.Equal(t, itm.ID, queue[0].ID)
+
+	_, err = tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.ErrorIs(t, err, ErrItemQuarantined)
+
+	got, err = tRepos.Items.SignOffInspection(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Quarantined)
+
+	queue, err = tRepos.Items.GetInspectionQueue(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+
+	_, err = tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.NoError(t, err)
+}
+
+func TestItemsRepository_TimedQuarantineExpires(t *testing.T) {
+	ctx := context.Background()
+
+	lbl, err := tRepos.Labels.Create(ctx, tGroup.ID, LabelCreate{
+		Name:               fk.Str(10),
+		QuarantineOnReturn: true,
+		QuarantineMinutes:  30,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Labels.delete(context.Background(), lbl.ID)
+	})
+
+	loc := useLocations(t, 1)[0]
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{
+		Name:       fk.Str(10),
+		LocationID: loc.ID,
+		LabelIDs:   []uuid.UUID{lbl.ID},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+	})
+
+	b := useBorrower(t, borrowerFactory())
+	out, err := tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, LoanCreate{
+		ItemID:       itm.ID,
+		BorrowerID:   b.ID,
+		DueAt:        time.Now(),
+		CheckedOutAt: time.Now().Add(-2 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Returned an hour ago, so the 30 minute quarantine is already over
+	_, err = tRepos.Loans.Return(ctx, tGroup.ID, tUser.ID, LoanReturn{
+		ID:         out.ID,
+		ReturnedAt: time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Quarantined)
+	require.NotNil(t, got.QuarantineUntil)
+}
+
+func labelEntities(labels ...LabelCreate) []*ent.Label {
+	out := make([]*ent.Label, len(labels))
+	for i, l := range labels {
+		out[i] = &ent.Label{
+			QuarantineOnReturn: l.QuarantineOnReturn,
+			QuarantineMinutes:  l.QuarantineMinutes,
+		}
+	}
+	return out
+}
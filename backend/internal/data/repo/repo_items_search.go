@@ -0,0 +1,137 @@
+package repo
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/textutils"
+)
+
+// maxSearchTerms caps the number of words of a search that are sent to the full-text index
+const maxSearchTerms = 16
+
+// itemSearchIndex reports whether the item_search full-text index exists. The index is
+// created and kept in sync by the migrations, so databases created without them (e.g. by
+// ent's auto migration in tests) fall back to scanning the item columns.
+type itemSearchIndex struct {
+	db      *ent.Client
+	mu      sync.Mutex
+	checked bool
+	ok      bool
+}
+
+func (i *itemSearchIndex) available() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.checked {
+		return i.ok
+	}
+
+	rows, err := i.db.Sql().QueryContext(context.Background(), "SELECT item_id FROM item_search WHERE 1 = 0")
+	if err != nil {
+		// Only a missing table is for good, other errors such as a dropped connection
+		// are checked again on the next search
+		if isMissingTable(err) {
+			i.checked = true
+		}
+		return false
+	}
+	_ = rows.Close()
+
+	i.checked, i.ok = true, true
+	return true
+}
+
+// isMissingTable reports whether the error is SQLite's or Postgres' error for a table
+// that doesn't exist.
+func isMissingTable(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "no such table") || strings.Contains(msg, "SQLSTATE 42P01") ||
+		(strings.Contains(msg, "relation") && strings.Contains(msg, "does not exist"))
+}
+
+// searchTerms splits a search into the normalized words that are looked up in the
+// full-text index. Punctuation separates words, matching how the index tokenizes text.
+func searchTerms(search string) []string {
+	terms := strings.FieldsFunc(textutils.NormalizeSearchQuery(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+
+	return terms
+}
+
+// ftsQuery builds an SQLite FTS5 query that matches items containing every term,
+// each as a word prefix. Terms only contain letters and digits so quoting them is safe.
+func ftsQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		parts[i] = `"` + t + `"*`
+	}
+	return strings.Join(parts, " ")
+}
+
+// tsQuery builds a Postgres tsquery that matches items containing every term, each as
+// a word prefix.
+func tsQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		parts[i] = t + ":*"
+	}
+	return strings.Join(parts, " & ")
+}
+
+// itemSearchMatch joins the full-text index and keeps the items matching every term.
+func itemSearchMatch(terms []string) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		// Aliased as itself so that itemSearchRank can refer to it
+		t := sql.Table("item_search").As("item_search")
+		s.Join(t).On(s.C(item.FieldID), t.C("item_id"))
+
+		switch s.Dialect() {
+		case "postgres":
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString(t.C("document"))
+				b.WriteString(" @@ to_tsquery('simple', ")
+				b.Arg(tsQuery(terms))
+				b.WriteString(")")
+			}))
+		default:
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("item_search MATCH ")
+				b.Arg(ftsQuery(terms))
+			}))
+		}
+	})
+}
+
+// itemSearchRank orders items matched by itemSearchMatch by relevance. Matches in the
+// name count the most, followed by identifiers such as the serial and model number.
+func itemSearchRank(terms []string) item.OrderOption {
+	return func(s *sql.Selector) {
+		switch s.Dialect() {
+		case "postgres":
+			// Arguments of ORDER BY expressions are dropped by ent, so the query is
+			// written out. Terms only contain letters and digits so quoting them is safe.
+			s.OrderExprFunc(func(b *sql.Builder) {
+				b.WriteString("ts_rank(")
+				b.WriteString(sql.Dialect(s.Dialect()).Table("item_search").C("document"))
+				b.WriteString(", to_tsquery('simple', '" + tsQuery(terms) + "')) DESC")
+			})
+		default:
+			// Column weights follow the index columns: item_id, name, description,
+			// serial_number, model_number, manufacturer, notes, fields
+			s.OrderExpr(sql.Expr("bm25(item_search, 0.0, 10.0, 1.0, 5.0, 5.0, 3.0, 1.0, 2.0)"))
+		}
+	}
+}
@@ -1,9 +1,13 @@
 package repo
 
 import (
+	"strings"
 	"testing"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/textutils"
 )
 
@@ -211,3 +215,63 @@ func TestNormalizeSearchQueryIntegration(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchTerms(t *testing.T) {
+	testCases := []struct {
+		search string
+		terms  []string
+		fts    string
+		ts     string
+	}{
+		{"Canon", []string{"canon"}, `"canon"*`, "canon:*"},
+		{"  électronique  BOX ", []string{"electronique", "box"}, `"electronique"* "box"*`, "electronique:* & box:*"},
+		{"AB-123/x", []string{"ab", "123", "x"}, `"ab"* "123"* "x"*`, "ab:* & 123:* & x:*"},
+		// Query syntax is treated as punctuation rather than passed to the index
+		{`"quoted" OR name:* & !x`, []string{"quoted", "or", "name", "x"}, `"quoted"* "or"* "name"* "x"*`, "quoted:* & or:* & name:* & x:*"},
+		{"--", []string{}, "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.search, func(t *testing.T) {
+			terms := searchTerms(tc.search)
+			assert.Equal(t, tc.terms, terms)
+			assert.Equal(t, tc.fts, ftsQuery(terms))
+			assert.Equal(t, tc.ts, tsQuery(terms))
+		})
+	}
+}
+
+func TestSearchTermsLimit(t *testing.T) {
+	terms := searchTerms(strings.Repeat("word ", maxSearchTerms*2))
+	assert.Len(t, terms, maxSearchTerms)
+}
+
+func TestItemSearchIndex_Available(t *testing.T) {
+	// The test database is created by ent without the index
+	idx := &itemSearchIndex{db: tClient}
+	assert.False(t, idx.available())
+	assert.True(t, idx.checked)
+
+	// Errors other than the missing table aren't remembered
+	closed, err := ent.Open("sqlite3", "file:closed?mode=memory&_fk=1")
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+
+	idx = &itemSearchIndex{db: closed}
+	assert.False(t, idx.available())
+	assert.False(t, idx.checked)
+}
+
+func TestItemSearch_PostgresQuery(t *testing.T) {
+	// Postgres isn't available to the tests, so check the query that is sent to it
+	s := sql.Dialect("postgres").Select("*").From(sql.Table("items"))
+	s.Where(sql.EQ(s.C("group_id"), "gid"))
+	itemSearchMatch([]string{"lamp", "zeb"})(s)
+	itemSearchRank([]string{"lamp", "zeb"})(s)
+
+	query, args := s.Query()
+	assert.Equal(t, `SELECT * FROM "items" JOIN "item_search" AS "item_search" ON "items"."id" = "item_search"."item_id" `+
+		`WHERE "items"."group_id" = $1 AND "item_search"."document" @@ to_tsquery('simple', $2) `+
+		`ORDER BY ts_rank("item_search"."document", to_tsquery('simple', 'lamp:* & zeb:*')) DESC`, query)
+	assert.Equal(t, []any{"gid", "lamp:* & zeb:*"}, args)
+}
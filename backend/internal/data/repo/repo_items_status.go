@@ -0,0 +1,213 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/itemstatuschange"
+)
+
+// ItemStatus is the lifecycle status of an item.
+type ItemStatus string
+
+const (
+	ItemStatusInService ItemStatus = "in_service"
+	ItemStatusInRepair  ItemStatus = "in_repair"
+	ItemStatusLost      ItemStatus = "lost"
+	ItemStatusStolen    ItemStatus = "stolen"
+	ItemStatusRetired   ItemStatus = "retired"
+	// ItemStatusDisposed is final, disposed items can't change their status anymore
+	ItemStatusDisposed ItemStatus = "disposed"
+)
+
+// ItemStatuses are the lifecycle statuses in the order they are reported in.
+var ItemStatuses = []ItemStatus{
+	ItemStatusInService,
+	ItemStatusInRepair,
+	ItemStatusLost,
+	ItemStatusStolen,
+	ItemStatusRetired,
+	ItemStatusDisposed,
+}
+
+// ItemStatusTransitions are the statuses an item can move to from each status. Lost
+// and stolen items can be found again, retired items reinstated.
+var ItemStatusTransitions = map[ItemStatus][]ItemStatus{
+	ItemStatusInService: {ItemStatusInRepair, ItemStatusLost, ItemStatusStolen, ItemStatusRetired},
+	ItemStatusInRepair:  {ItemStatusInService, ItemStatusLost, ItemStatusRetired, ItemStatusDisposed},
+	ItemStatusLost:      {ItemStatusInService, ItemStatusStolen, ItemStatusDisposed},
+	ItemStatusStolen:    {ItemStatusInService, ItemStatusDisposed},
+	ItemStatusRetired:   {ItemStatusInService, ItemStatusDisposed},
+	ItemStatusDisposed:  {},
+}
+
+// CanTransition reports whether an item with the status can move to the other status.
+func (s ItemStatus) CanTransition(to ItemStatus) bool {
+	return slices.Contains(ItemStatusTransitions[s], to)
+}
+
+// Lendable reports whether items with the status can be checked out.
+func (s ItemStatus) Lendable() bool {
+	return s == ItemStatusInService
+}
+
+var (
+	// ErrInvalidStatusTransition is returned when moving an item to a status that
+	// can't be reached from its current one, see ItemStatusTransitions.
+	ErrInvalidStatusTransition = errors.New("invalid item status transition")
+	// ErrItemNotLendable is returned when checking out an item that is not in service.
+	ErrItemNotLendable = errors.New("item is not in service")
+)
+
+type (
+	ItemStatusTransition struct {
+		Status ItemStatus `json:"status" validate:"required,oneof=in_service in_repair lost stolen retired disposed"`
+		Reason string     `json:"reason" validate:"max=1000"`
+	}
+
+	ItemStatusChangeOut struct {
+		ID        uuid.UUID  `json:"id"`
+		From      ItemStatus `json:"from"`
+		To        ItemStatus `json:"to"`
+		Reason    string     `json:"reason"`
+		ActorID   *uuid.UUID `json:"actorId"   extensions:"x-nullable"`
+		ActorName string     `json:"actorName"`
+		CreatedAt time.Time  `json:"createdAt"`
+	}
+
+	// ItemStatusCount is the number of items in a status, see GroupStatistics.
+	ItemStatusCount struct {
+		Status ItemStatus `json:"status"`
+		Total  int        `json:"total"`
+	}
+)
+
+func mapItemStatusChangeOut(c *ent.ItemStatusChange) ItemStatusChangeOut {
+	return ItemStatusChangeOut{
+		ID:        c.ID,
+		From:      ItemStatus(c.FromStatus),
+		To:        ItemStatus(c.ToStatus),
+		Reason:    c.Reason,
+		ActorID:   c.ActorID,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+// TransitionStatus moves the item to another lifecycle status and records the change
+// with its reason and the user it is attributed to, see WithAuditActor. Returns
+// ErrInvalidStatusTransition if the status can't be reached from the item's current one.
+func (e *ItemsRepository) TransitionStatus(ctx context.Context, gid, id uuid.UUID, data ItemStatusTransition) (ItemOut, error) {
+	tx, err := e.db.Tx(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err := transitionStatus(ctx, tx, gid, id, data); err != nil {
+		return ItemOut{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ItemOut{}, err
+	}
+	committed = true
+
+	e.publishMutationEvent(gid)
+	return e.GetOneByGroup(ctx, gid, id)
+}
+
+// transitionStatus moves the item to another lifecycle status and records the change
+// within the transaction.
+func transitionStatus(ctx context.Context, tx *ent.Tx, gid, id uuid.UUID, data ItemStatusTransition) error {
+	itm, err := tx.Item.Query().
+		Where(
+			item.ID(id),
+			item.HasGroupWith(group.ID(gid)),
+		).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	from := ItemStatus(itm.Status)
+	if !from.CanTransition(data.Status) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidStatusTransition, from, data.Status)
+	}
+
+	// The status is part of the condition, so that a concurrent transition fails
+	// instead of being overwritten
+	n, err := tx.Item.Update().
+		Where(
+			item.ID(id),
+			item.StatusEQ(itm.Status),
+		).
+		SetStatus(item.Status(data.Status)).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: the status changed meanwhile", ErrInvalidStatusTransition)
+	}
+
+	q := tx.ItemStatusChange.Create().
+		SetGroupID(gid).
+		SetItemID(id).
+		SetFromStatus(itemstatuschange.FromStatus(from)).
+		SetToStatus(itemstatuschange.ToStatus(data.Status)).
+		SetReason(data.Reason)
+	if actor := auditActorFrom(ctx); actor.UserID != uuid.Nil {
+		q.SetActorID(actor.UserID)
+	}
+
+	return q.Exec(ctx)
+}
+
+// GetStatusHistory returns the lifecycle status changes of an item, newest first.
+func (e *ItemsRepository) GetStatusHistory(ctx context.Context, gid, id uuid.UUID) ([]ItemStatusChangeOut, error) {
+	changes, err := e.db.ItemStatusChange.Query().
+		Where(
+			itemstatuschange.GroupID(gid),
+			itemstatuschange.ItemID(id),
+		).
+		Order(ent.Desc(itemstatuschange.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(changes))
+	for _, c := range changes {
+		if c.ActorID != nil {
+			ids = append(ids, *c.ActorID)
+		}
+	}
+
+	names, err := userNames(ctx, e.db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]ItemStatusChangeOut, len(changes))
+	for i, c := range changes {
+		out[i] = mapItemStatusChangeOut(c)
+		if c.ActorID != nil {
+			out[i].ActorName = names[*c.ActorID]
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,118 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemStatusTransitions(t *testing.T) {
+	for _, from := range ItemStatuses {
+		_, ok := ItemStatusTransitions[from]
+		assert.True(t, ok, "%s has no transitions", from)
+		assert.False(t, from.CanTransition(from), "%s can transition to itself", from)
+	}
+
+	assert.True(t, ItemStatusLost.CanTransition(ItemStatusInService), "lost items can be found")
+	assert.False(t, ItemStatusInService.CanTransition(ItemStatusDisposed), "items are retired before disposal")
+	assert.Empty(t, ItemStatusTransitions[ItemStatusDisposed], "disposal is final")
+}
+
+func TestItemsRepository_TransitionStatus(t *testing.T) {
+	ctx := WithAuditActor(context.Background(), AuditActor{GroupID: tGroup.ID, UserID: tUser.ID, Source: AuditSourceAPI})
+	itm := useItems(t, 1)[0]
+	assert.Equal(t, ItemStatusInService, itm.Status)
+
+	out, err := tRepos.Items.TransitionStatus(ctx, tGroup.ID, itm.ID, ItemStatusTransition{
+		Status: ItemStatusInRepair,
+		Reason: "cracked screen",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ItemStatusInRepair, out.Status)
+
+	_, err = tRepos.Items.TransitionStatus(ctx, tGroup.ID, itm.ID, ItemStatusTransition{Status: ItemStatusStolen})
+	require.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	// Items out for repair can't be lent
+	b := useBorrower(t, borrowerFactory())
+	loan := LoanCreate{ItemID: itm.ID, BorrowerID: b.ID, DueAt: time.Now().Add(time.Hour)}
+
+	_, err = tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.ErrorIs(t, err, ErrItemNotLendable)
+
+	_, err = tRepos.Items.TransitionStatus(context.Background(), tGroup.ID, itm.ID, ItemStatusTransition{Status: ItemStatusInService})
+	require.NoError(t, err)
+
+	l, err := tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Loans.DeleteByGroup(context.Background(), tGroup.ID, l.ID)
+	})
+
+	history, err := tRepos.Items.GetStatusHistory(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	assert.Equal(t, ItemStatusInRepair, history[0].From)
+	assert.Equal(t, ItemStatusInService, history[0].To)
+	assert.Nil(t, history[0].ActorID)
+
+	assert.Equal(t, ItemStatusInService, history[1].From)
+	assert.Equal(t, ItemStatusInRepair, history[1].To)
+	assert.Equal(t, "cracked screen", history[1].Reason)
+	require.NotNil(t, history[1].ActorID)
+	assert.Equal(t, tUser.ID, *history[1].ActorID)
+	assert.Equal(t, tUser.Name, history[1].ActorName)
+}
+
+func TestItemsRepository_QueryByStatus(t *testing.T) {
+	ctx := context.Background()
+	g, err := tRepos.Groups.GroupCreate(ctx, fk.Str(10))
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	items := make([]ItemOut, 3)
+	for i := range items {
+		items[i], err = tRepos.Items.Create(ctx, g.ID, ItemCreate{Name: fk.Str(10), LocationID: loc.ID})
+		require.NoError(t, err)
+	}
+
+	_, err = tRepos.Items.TransitionStatus(ctx, g.ID, items[1].ID, ItemStatusTransition{Status: ItemStatusLost})
+	require.NoError(t, err)
+	_, err = tRepos.Items.TransitionStatus(ctx, g.ID, items[2].ID, ItemStatusTransition{Status: ItemStatusRetired})
+	require.NoError(t, err)
+
+	res, err := tRepos.Items.QueryByGroup(ctx, g.ID, ItemQuery{
+		Page:     -1,
+		PageSize: -1,
+		Statuses: []ItemStatus{ItemStatusLost, ItemStatusRetired},
+	})
+	require.NoError(t, err)
+	assert.Len(t, res.Items, 2)
+
+	parsed, err := ParseItemQuery("-status:in_service -status:Lost")
+	require.NoError(t, err)
+	q := ItemQuery{Page: -1, PageSize: -1}
+	parsed.Apply(&q)
+
+	res, err = tRepos.Items.QueryByGroup(ctx, g.ID, q)
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, items[2].ID, res.Items[0].ID)
+
+	stats, err := tRepos.Groups.StatsGroup(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []ItemStatusCount{
+		{Status: ItemStatusInService, Total: 1},
+		{Status: ItemStatusInRepair, Total: 0},
+		{Status: ItemStatusLost, Total: 1},
+		{Status: ItemStatusStolen, Total: 0},
+		{Status: ItemStatusRetired, Total: 1},
+		{Status: ItemStatusDisposed, Total: 0},
+	}, stats.ItemsByStatus)
+}
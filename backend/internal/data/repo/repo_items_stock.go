@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ErrInsufficientStock is returned when issuing more of an item than is in stock.
+var ErrInsufficientStock = errors.New("not enough of the item in stock")
+
+// ItemIssue takes consumables out of stock for good, unlike a loan they are not returned.
+type ItemIssue struct {
+	Quantity int    `json:"quantity" validate:"required,min=1"`
+	Note     string `json:"note"     validate:"max=1000"`
+
+	// Set by the service layer when the kiosk is bound to a location; only items
+	// stored in one of these locations can be issued
+	LocationScope []uuid.UUID `json:"-"`
+}
+
+// isLowStock reports whether the item tracks its stock and has dropped below the minimum.
+func isLowStock(itm *ent.Item) bool {
+	return itm.MinStock > 0 && itm.Quantity < itm.MinStock
+}
+
+// itemLowStock matches the items that track their stock and have dropped below the minimum.
+func itemLowStock() predicate.Item {
+	return item.And(
+		item.MinStockGT(0),
+		func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(item.FieldQuantity), s.C(item.FieldMinStock)))
+		},
+	)
+}
+
+// GetLowStock returns the group's unarchived items that are low on stock, by name.
+func (e *ItemsRepository) GetLowStock(ctx context.Context, gid uuid.UUID) ([]ItemSummary, error) {
+	return mapItemsSummaryErr(e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.Archived(false),
+			itemLowStock(),
+		).
+		Order(ent.Asc(item.FieldName)).
+		WithLabel().
+		WithLocation().
+		WithAttachments(func(aq *ent.AttachmentQuery) {
+			aq.Where(
+				attachment.Primary(true),
+			)
+			aq.WithThumbnail()
+		}).
+		All(ctx),
+	)
+}
+
+// Issue decrements the quantity of the item by the issued amount. The quantity is
+// checked in the same statement, so concurrent issues can't take it below zero.
+func (e *ItemsRepository) Issue(ctx context.Context, gid, id uuid.UUID, data ItemIssue) (ItemOut, error) {
+	itm, err := e.db.Item.Query().
+		Where(item.ID(id), item.HasGroupWith(group.ID(gid))).
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	if !inLocationScope(itm, data.LocationScope) {
+		return ItemOut{}, ErrItemOutsideLocation
+	}
+
+	tx, err := e.db.Tx(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during item issue")
+			}
+		}
+	}()
+
+	n, err := tx.Item.Update().
+		Where(item.ID(id), item.QuantityGTE(data.Quantity)).
+		AddQuantity(-data.Quantity).
+		Save(WithStockMovement(ctx, StockReasonIssue, data.Note))
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	if n == 0 {
+		return ItemOut{}, ErrInsufficientStock
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ItemOut{}, err
+	}
+	committed = true
+
+	e.publishMutationEvent(gid)
+	return e.GetOneByGroup(ctx, gid, id)
+}
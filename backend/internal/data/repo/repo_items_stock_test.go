@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setStock(t *testing.T, itm ItemOut, quantity, minStock int) {
+	t.Helper()
+
+	_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:              itm.ID,
+		Name:            itm.Name,
+		LocationID:      itm.Location.ID,
+		Quantity:        quantity,
+		MinStock:        minStock,
+		ReorderQuantity: 10,
+	})
+	require.NoError(t, err)
+}
+
+func TestItemsRepository_GetLowStock(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+
+	setStock(t, items[0], 2, 5)
+	setStock(t, items[1], 5, 5)
+	setStock(t, items[2], 0, 0)
+
+	low, err := tRepos.Items.GetLowStock(ctx, tGroup.ID)
+	require.NoError(t, err)
+
+	ids := mapEach(low, func(s ItemSummary) uuid.UUID { return s.ID })
+	assert.Contains(t, ids, items[0].ID)
+	assert.NotContains(t, ids, items[1].ID, "items at their minimum are not low")
+	assert.NotContains(t, ids, items[2].ID, "items without a minimum are not tracked")
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, items[0].ID)
+	require.NoError(t, err)
+	assert.True(t, got.LowStock)
+	assert.Equal(t, 10, got.ReorderQuantity)
+
+	parsed, err := ParseItemQuery("is:lowstock")
+	require.NoError(t, err)
+	q := ItemQuery{Page: -1, PageSize: -1}
+	parsed.Apply(&q)
+
+	res, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ids, mapEach(res.Items, func(s ItemSummary) uuid.UUID { return s.ID }))
+}
+
+func TestItemsRepository_Issue(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	setStock(t, itm, 5, 3)
+
+	got, err := tRepos.Items.Issue(ctx, tGroup.ID, itm.ID, ItemIssue{Quantity: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Quantity)
+	assert.True(t, got.LowStock)
+
+	_, err = tRepos.Items.Issue(ctx, tGroup.ID, itm.ID, ItemIssue{Quantity: 3})
+	require.ErrorIs(t, err, ErrInsufficientStock)
+
+	_, err = tRepos.Items.Issue(ctx, tGroup.ID, itm.ID, ItemIssue{Quantity: 1, LocationScope: []uuid.UUID{uuid.New()}})
+	require.ErrorIs(t, err, ErrItemOutsideLocation)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Quantity)
+}
+
+func TestItemsRepository_IssueLedgerFailure(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	setStock(t, itm, 5, 0)
+
+	movements, err := tRepos.StockMovements.GetByItem(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+
+	// The note is too long for the ledger, so the movement can't be written after
+	// the quantity is updated
+	_, err = tRepos.Items.Issue(ctx, tGroup.ID, itm.ID, ItemIssue{Quantity: 2, Note: strings.Repeat("x", 1001)})
+	require.Error(t, err)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, got.Quantity, "the quantity is rolled back with the movement")
+
+	after, err := tRepos.StockMovements.GetByItem(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Len(t, after, len(movements))
+
+	// Updates roll back the same way
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:           itm.ID,
+		Name:         itm.Name,
+		LocationID:   itm.Location.ID,
+		Quantity:     9,
+		QuantityNote: strings.Repeat("x", 1001),
+	})
+	require.Error(t, err)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, got.Quantity)
+}
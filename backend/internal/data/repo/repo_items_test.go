@@ -393,7 +393,14 @@ func TestItemsRepository_DeleteByGroupWithAttachments(t *testing.T) {
 	_, err = tRepos.Items.GetOneByGroup(context.Background(), tGroup.ID, item.ID)
 	require.Error(t, err)
 
-	// Verify the attachment is also deleted
+	// The attachment is kept while the item is in the trash
+	_, err = tRepos.Attachments.Get(context.Background(), tGroup.ID, attachment.ID)
+	require.NoError(t, err)
+
+	// Purging the trash deletes the attachment with the item
+	_, err = tRepos.Trash.Purge(context.Background(), time.Now().Add(time.Second))
+	require.NoError(t, err)
+
 	_, err = tRepos.Attachments.Get(context.Background(), tGroup.ID, attachment.ID)
 	require.Error(t, err)
 }
@@ -6,7 +6,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksession"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 )
 
@@ -19,10 +21,35 @@ type KioskSessionRepository struct {
 type KioskSessionOut struct {
 	ID            uuid.UUID  `json:"id"`
 	UserID        uuid.UUID  `json:"userId"`
+	UserName      string     `json:"userName"`
+	GroupID       uuid.UUID  `json:"groupId"`
 	IsActive      bool       `json:"isActive"`
 	UnlockedUntil *time.Time `json:"unlockedUntil,omitempty"`
 	CreatedAt     time.Time  `json:"createdAt"`
 	UpdatedAt     time.Time  `json:"updatedAt"`
+
+	// Location the kiosk is bound to (nil = whole group)
+	LocationID   *uuid.UUID `json:"locationId,omitempty"`
+	LocationName string     `json:"locationName"`
+
+	// Device health, as reported by the most recent heartbeat
+	LastSeenAt       *time.Time `json:"lastSeenAt,omitempty"`
+	LastActivityAt   *time.Time `json:"lastActivityAt,omitempty"`
+	AppVersion       string     `json:"appVersion"`
+	BatteryLevel     *int       `json:"batteryLevel,omitempty"`
+	BatteryCharging  *bool      `json:"batteryCharging,omitempty"`
+	NetworkType      string     `json:"networkType"`
+	OfflineAlertedAt *time.Time `json:"offlineAlertedAt,omitempty"`
+}
+
+// KioskHeartbeat is the device state a kiosk reports on every heartbeat
+type KioskHeartbeat struct {
+	AppVersion      string `json:"appVersion"      validate:"max=64"`
+	BatteryLevel    *int   `json:"batteryLevel"    validate:"omitempty,min=0,max=100"`
+	BatteryCharging *bool  `json:"batteryCharging"`
+	NetworkType     string `json:"networkType"     validate:"max=32"`
+	// IdleSeconds is how long ago someone last interacted with the device
+	IdleSeconds int `json:"idleSeconds" validate:"min=0"`
 }
 
 // IsUnlocked returns true if the kiosk session has temporary admin access
@@ -33,15 +60,50 @@ func (s *KioskSessionOut) IsUnlocked() bool {
 	return time.Now().Before(*s.UnlockedUntil)
 }
 
+// IsIdleSince returns true if nobody has interacted with the kiosk since the given time
+func (s *KioskSessionOut) IsIdleSince(t time.Time) bool {
+	return s.LastActivityAt != nil && s.LastActivityAt.Before(t)
+}
+
+// IsOnline returns true if the kiosk has sent a heartbeat since the given time
+func (s *KioskSessionOut) IsOnline(since time.Time) bool {
+	return s.LastSeenAt != nil && s.LastSeenAt.After(since)
+}
+
+var mapKioskSessionsOut = mapTEachErrFunc(mapKioskSessionOut)
+
 func mapKioskSessionOut(session *ent.KioskSession) KioskSessionOut {
-	return KioskSessionOut{
-		ID:            session.ID,
-		UserID:        session.Edges.User.ID,
-		IsActive:      session.IsActive,
-		UnlockedUntil: session.UnlockedUntil,
-		CreatedAt:     session.CreatedAt,
-		UpdatedAt:     session.UpdatedAt,
+	out := KioskSessionOut{
+		ID:               session.ID,
+		UserID:           session.Edges.User.ID,
+		UserName:         session.Edges.User.Name,
+		IsActive:         session.IsActive,
+		UnlockedUntil:    session.UnlockedUntil,
+		CreatedAt:        session.CreatedAt,
+		UpdatedAt:        session.UpdatedAt,
+		LastSeenAt:       session.LastSeenAt,
+		LastActivityAt:   session.LastActivityAt,
+		AppVersion:       session.AppVersion,
+		BatteryLevel:     session.BatteryLevel,
+		BatteryCharging:  session.BatteryCharging,
+		NetworkType:      session.NetworkType,
+		OfflineAlertedAt: session.OfflineAlertedAt,
 	}
+
+	if session.Edges.User.Edges.Group != nil {
+		out.GroupID = session.Edges.User.Edges.Group.ID
+	}
+
+	if session.Edges.Location != nil {
+		out.LocationID = &session.Edges.Location.ID
+		out.LocationName = session.Edges.Location.Name
+	}
+
+	return out
+}
+
+func withKioskUser(q *ent.UserQuery) {
+	q.WithGroup()
 }
 
 // GetByUserID gets the kiosk session for a user
@@ -49,7 +111,8 @@ func (r *KioskSessionRepository) GetByUserID(ctx context.Context, userID uuid.UU
 	session, err := r.db.KioskSession.
 		Query().
 		Where(kiosksession.HasUserWith(user.ID(userID))).
-		WithUser().
+		WithUser(withKioskUser).
+		WithLocation().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -77,6 +140,10 @@ func (r *KioskSessionRepository) Activate(ctx context.Context, userID uuid.UUID)
 			SetIsActive(true).
 			SetUpdatedAt(time.Now()).
 			ClearUnlockedUntil().
+			// A reactivated kiosk starts without health history so a stale
+			// heartbeat from a previous session doesn't trigger an offline alert
+			ClearLastSeenAt().
+			ClearOfflineAlertedAt().
 			Save(ctx)
 		if err != nil {
 			return nil, err
@@ -86,7 +153,8 @@ func (r *KioskSessionRepository) Activate(ctx context.Context, userID uuid.UUID)
 		session, err = r.db.KioskSession.
 			Query().
 			Where(kiosksession.ID(session.ID)).
-			WithUser().
+			WithUser(withKioskUser).
+			WithLocation().
 			Only(ctx)
 		if err != nil {
 			return nil, err
@@ -110,7 +178,8 @@ func (r *KioskSessionRepository) Activate(ctx context.Context, userID uuid.UUID)
 	session, err = r.db.KioskSession.
 		Query().
 		Where(kiosksession.ID(session.ID)).
-		WithUser().
+		WithUser(withKioskUser).
+		WithLocation().
 		Only(ctx)
 	if err != nil {
 		return nil, err
@@ -167,7 +236,8 @@ func (r *KioskSessionRepository) Unlock(ctx context.Context, userID uuid.UUID, d
 	session, err = r.db.KioskSession.
 		Query().
 		Where(kiosksession.ID(session.ID)).
-		WithUser().
+		WithUser(withKioskUser).
+		WithLocation().
 		Only(ctx)
 	if err != nil {
 		return nil, err
@@ -196,3 +266,137 @@ func (r *KioskSessionRepository) Lock(ctx context.Context, userID uuid.UUID) err
 
 	return err
 }
+
+// SetLocation binds the user's kiosk to a location of the group. A nil location ID
+// unbinds the kiosk. Returns nil if the user has no kiosk session.
+func (r *KioskSessionRepository) SetLocation(ctx context.Context, gid, userID, locationID uuid.UUID) (*KioskSessionOut, error) {
+	existing, err := r.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	q := r.db.KioskSession.
+		UpdateOneID(existing.ID).
+		SetUpdatedAt(time.Now())
+
+	if locationID == uuid.Nil {
+		q.ClearLocation()
+	} else {
+		// Ensure the location belongs to the kiosk's group
+		loc, err := r.db.Location.Query().
+			Where(
+				location.ID(locationID),
+				location.HasGroupWith(group.ID(gid)),
+			).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		q.SetLocation(loc)
+	}
+
+	_, err = q.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByUserID(ctx, userID)
+}
+
+// Heartbeat records the device state reported by an active kiosk. Returns nil if the
+// user has no active kiosk session.
+func (r *KioskSessionRepository) Heartbeat(ctx context.Context, userID uuid.UUID, data KioskHeartbeat) (*KioskSessionOut, error) {
+	existing, err := r.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil || !existing.IsActive {
+		return nil, nil
+	}
+
+	now := time.Now()
+
+	q := r.db.KioskSession.
+		UpdateOneID(existing.ID).
+		SetLastSeenAt(now).
+		SetLastActivityAt(now.Add(-time.Duration(data.IdleSeconds) * time.Second)).
+		SetAppVersion(data.AppVersion).
+		SetNetworkType(data.NetworkType).
+		ClearOfflineAlertedAt()
+
+	if data.BatteryLevel != nil {
+		q.SetBatteryLevel(*data.BatteryLevel)
+	} else {
+		q.ClearBatteryLevel()
+	}
+
+	if data.BatteryCharging != nil {
+		q.SetBatteryCharging(*data.BatteryCharging)
+	} else {
+		q.ClearBatteryCharging()
+	}
+
+	_, err = q.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByUserID(ctx, userID)
+}
+
+// GetAllByGroup returns every kiosk session belonging to users of the group
+func (r *KioskSessionRepository) GetAllByGroup(ctx context.Context, gid uuid.UUID) ([]KioskSessionOut, error) {
+	return mapKioskSessionsOut(r.db.KioskSession.
+		Query().
+		Where(kiosksession.HasUserWith(user.HasGroupWith(group.ID(gid)))).
+		WithUser(withKioskUser).
+		WithLocation().
+		Order(ent.Asc(kiosksession.FieldCreatedAt)).
+		All(ctx),
+	)
+}
+
+// LockIdle clears the unlock timer of every unlocked kiosk that nobody has interacted
+// with since the given time. Returns the number of kiosks that were locked.
+func (r *KioskSessionRepository) LockIdle(ctx context.Context, idleSince time.Time) (int, error) {
+	return r.db.KioskSession.
+		Update().
+		Where(
+			kiosksession.IsActive(true),
+			kiosksession.UnlockedUntilGT(time.Now()),
+			kiosksession.LastActivityAtLT(idleSince),
+		).
+		ClearUnlockedUntil().
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+// GetSilent returns the active kiosks that have not sent a heartbeat since the given time
+// and that staff have not been alerted about yet. Kiosks that never sent a heartbeat are
+// not considered silent.
+func (r *KioskSessionRepository) GetSilent(ctx context.Context, since time.Time) ([]KioskSessionOut, error) {
+	return mapKioskSessionsOut(r.db.KioskSession.
+		Query().
+		Where(
+			kiosksession.IsActive(true),
+			kiosksession.LastSeenAtLT(since),
+			kiosksession.OfflineAlertedAtIsNil(),
+		).
+		WithUser(withKioskUser).
+		WithLocation().
+		All(ctx),
+	)
+}
+
+// MarkOfflineAlerted records that staff were alerted about the silent kiosk
+func (r *KioskSessionRepository) MarkOfflineAlerted(ctx context.Context, id uuid.UUID) error {
+	return r.db.KioskSession.
+		UpdateOneID(id).
+		SetOfflineAlertedAt(time.Now()).
+		Exec(ctx)
+}
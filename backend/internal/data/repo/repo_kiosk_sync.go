@@ -0,0 +1,136 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/kiosksyncaction"
+)
+
+// kioskSyncStaleAfter is how long a pending action may stay reserved before another
+// sync is allowed to take it over (e.g. after the server stopped mid request).
+const kioskSyncStaleAfter = 5 * time.Minute
+
+// KioskSyncRepository records the idempotency keys of actions replayed by offline kiosks.
+type KioskSyncRepository struct {
+	db *ent.Client
+}
+
+type (
+	KioskSyncActionCreate struct {
+		Key             string
+		Action          kiosksyncaction.Action
+		ClientTimestamp time.Time
+	}
+
+	KioskSyncActionOut struct {
+		ID              uuid.UUID  `json:"id"`
+		Key             string     `json:"key"`
+		Action          string     `json:"action"`
+		Status          string     `json:"status"`
+		ClientTimestamp time.Time  `json:"clientTimestamp"`
+		ResultID        *uuid.UUID `json:"resultId,omitempty"`
+		CreatedAt       time.Time  `json:"createdAt"`
+		UpdatedAt       time.Time  `json:"updatedAt"`
+	}
+)
+
+var mapKioskSyncActionOutErr = mapTErrFunc(mapKioskSyncActionOut)
+
+func mapKioskSyncActionOut(a *ent.KioskSyncAction) KioskSyncActionOut {
+	return KioskSyncActionOut{
+		ID:              a.ID,
+		Key:             a.IdempotencyKey,
+		Action:          a.Action.String(),
+		Status:          a.Status.String(),
+		ClientTimestamp: a.ClientTimestamp,
+		ResultID:        a.ResultID,
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
+	}
+}
+
+// IsApplied returns true if the action has been applied successfully
+func (a KioskSyncActionOut) IsApplied() bool {
+	return a.Status == kiosksyncaction.StatusApplied.String()
+}
+
+// GetByKey returns the action recorded for the idempotency key
+func (r *KioskSyncRepository) GetByKey(ctx context.Context, gid uuid.UUID, key string) (KioskSyncActionOut, error) {
+	return mapKioskSyncActionOutErr(r.db.KioskSyncAction.Query().
+		Where(
+			kiosksyncaction.IdempotencyKey(key),
+			kiosksyncaction.HasGroupWith(group.ID(gid)),
+		).
+		Only(ctx),
+	)
+}
+
+// Reserve claims the idempotency key before the action is applied. If the key has
+// already been claimed, reserved is false and the existing record is returned instead.
+func (r *KioskSyncRepository) Reserve(ctx context.Context, gid uuid.UUID, data KioskSyncActionCreate) (out KioskSyncActionOut, reserved bool, err error) {
+	out, err = mapKioskSyncActionOutErr(r.db.KioskSyncAction.Create().
+		SetGroupID(gid).
+		SetIdempotencyKey(data.Key).
+		SetAction(data.Action).
+		SetClientTimestamp(data.ClientTimestamp).
+		Save(ctx),
+	)
+	if err == nil {
+		return out, true, nil
+	}
+
+	if !ent.IsConstraintError(err) {
+		return KioskSyncActionOut{}, false, err
+	}
+
+	existing, err := r.GetByKey(ctx, gid, data.Key)
+	if err != nil {
+		return KioskSyncActionOut{}, false, err
+	}
+
+	if existing.IsApplied() {
+		return existing, false, nil
+	}
+
+	// Take over a reservation that was abandoned before it was applied
+	n, err := r.db.KioskSyncAction.Update().
+		Where(
+			kiosksyncaction.ID(existing.ID),
+			kiosksyncaction.StatusEQ(kiosksyncaction.StatusPending),
+			kiosksyncaction.UpdatedAtLT(time.Now().Add(-kioskSyncStaleAfter)),
+		).
+		SetAction(data.Action).
+		SetClientTimestamp(data.ClientTimestamp).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return existing, false, err
+	}
+
+	out, err = r.GetByKey(ctx, gid, data.Key)
+	return out, err == nil, err
+}
+
+// Complete marks a reserved action as applied
+func (r *KioskSyncRepository) Complete(ctx context.Context, id uuid.UUID, resultID uuid.UUID) error {
+	return r.db.KioskSyncAction.UpdateOneID(id).
+		SetStatus(kiosksyncaction.StatusApplied).
+		SetResultID(resultID).
+		Exec(ctx)
+}
+
+// Release removes a reserved action that could not be applied so the kiosk can
+// retry it with the same idempotency key.
+func (r *KioskSyncRepository) Release(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.KioskSyncAction.Delete().
+		Where(
+			kiosksyncaction.ID(id),
+			kiosksyncaction.StatusEQ(kiosksyncaction.StatusPending),
+		).
+		Exec(ctx)
+	return err
+}
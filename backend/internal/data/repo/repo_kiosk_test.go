@@ -0,0 +1,216 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func useKioskSession(t *testing.T) KioskSessionOut {
+	t.Helper()
+
+	session, err := tRepos.KioskSessions.Activate(context.Background(), tUser.ID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.KioskSessions.Deactivate(context.Background(), tUser.ID)
+	})
+
+	return *session
+}
+
+func TestKioskSessionRepository_Heartbeat(t *testing.T) {
+	ctx := context.Background()
+	session := useKioskSession(t)
+	assert.Nil(t, session.LastSeenAt)
+	assert.Equal(t, tGroup.ID, session.GroupID)
+
+	battery := 42
+	charging := true
+	out, err := tRepos.KioskSessions.Heartbeat(ctx, tUser.ID, KioskHeartbeat{
+		AppVersion:      "1.2.3",
+		BatteryLevel:    &battery,
+		BatteryCharging: &charging,
+		NetworkType:     "wifi",
+		IdleSeconds:     60,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, out)
+
+	assert.Equal(t, "1.2.3", out.AppVersion)
+	assert.Equal(t, "wifi", out.NetworkType)
+	assert.Equal(t, battery, *out.BatteryLevel)
+	assert.True(t, *out.BatteryCharging)
+	require.NotNil(t, out.LastSeenAt)
+	require.NotNil(t, out.LastActivityAt)
+	assert.True(t, out.IsOnline(time.Now().Add(-time.Minute)))
+	assert.True(t, out.IsIdleSince(time.Now().Add(-30*time.Second)))
+	assert.False(t, out.IsIdleSince(time.Now().Add(-5*time.Minute)))
+
+	devices, err := tRepos.KioskSessions.GetAllByGroup(ctx, tGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.Equal(t, out.ID, devices[0].ID)
+}
+
+func TestKioskSessionRepository_HeartbeatInactive(t *testing.T) {
+	ctx := context.Background()
+	useKioskSession(t)
+
+	require.NoError(t, tRepos.KioskSessions.Deactivate(ctx, tUser.ID))
+
+	out, err := tRepos.KioskSessions.Heartbeat(ctx, tUser.ID, KioskHeartbeat{})
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestKioskSessionRepository_LockIdle(t *testing.T) {
+	ctx := context.Background()
+	useKioskSession(t)
+
+	_, err := tRepos.KioskSessions.Unlock(ctx, tUser.ID, 10*time.Minute)
+	require.NoError(t, err)
+
+	_, err = tRepos.KioskSessions.Heartbeat(ctx, tUser.ID, KioskHeartbeat{IdleSeconds: 600})
+	require.NoError(t, err)
+
+	// Activity 10 minutes ago is not idle for a 15 minute timeout
+	n, err := tRepos.KioskSessions.LockIdle(ctx, time.Now().Add(-15*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	n, err = tRepos.KioskSessions.LockIdle(ctx, time.Now().Add(-5*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	session, err := tRepos.KioskSessions.GetByUserID(ctx, tUser.ID)
+	require.NoError(t, err)
+	assert.False(t, session.IsUnlocked())
+	assert.True(t, session.IsActive)
+}
+
+func TestKioskSessionRepository_GetSilent(t *testing.T) {
+	ctx := context.Background()
+	session := useKioskSession(t)
+
+	// Kiosks that never sent a heartbeat are not reported
+	silent, err := tRepos.KioskSessions.GetSilent(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, silent)
+
+	_, err = tRepos.KioskSessions.Heartbeat(ctx, tUser.ID, KioskHeartbeat{})
+	require.NoError(t, err)
+
+	silent, err = tRepos.KioskSessions.GetSilent(ctx, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, silent)
+
+	silent, err = tRepos.KioskSessions.GetSilent(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, silent, 1)
+	assert.Equal(t, session.ID, silent[0].ID)
+
+	require.NoError(t, tRepos.KioskSessions.MarkOfflineAlerted(ctx, session.ID))
+
+	silent, err = tRepos.KioskSessions.GetSilent(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, silent)
+
+	// The next heartbeat clears the alert so the kiosk can be reported again
+	out, err := tRepos.KioskSessions.Heartbeat(ctx, tUser.ID, KioskHeartbeat{})
+	require.NoError(t, err)
+	assert.Nil(t, out.OfflineAlertedAt)
+}
+
+func TestKioskSessionRepository_SetLocation(t *testing.T) {
+	ctx := context.Background()
+	useKioskSession(t)
+	loc := useLocations(t, 1)[0]
+
+	out, err := tRepos.KioskSessions.SetLocation(ctx, tGroup.ID, tUser.ID, loc.ID)
+	require.NoError(t, err)
+	require.NotNil(t, out.LocationID)
+	assert.Equal(t, loc.ID, *out.LocationID)
+	assert.Equal(t, loc.Name, out.LocationName)
+
+	// Locations of other groups can't be bound
+	_, err = tRepos.KioskSessions.SetLocation(ctx, uuid.New(), tUser.ID, loc.ID)
+	require.Error(t, err)
+
+	out, err = tRepos.KioskSessions.SetLocation(ctx, tGroup.ID, tUser.ID, uuid.Nil)
+	require.NoError(t, err)
+	assert.Nil(t, out.LocationID)
+}
+
+func TestLoanRepository_LocationScope(t *testing.T) {
+	ctx := context.Background()
+	locs := useLocations(t, 2)
+	site, elsewhere := locs[0], locs[1]
+
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{
+		Name:       fk.Str(10),
+		LocationID: elsewhere.ID,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+	})
+
+	b := useBorrower(t, borrowerFactory())
+	loan := LoanCreate{
+		ItemID:        itm.ID,
+		BorrowerID:    b.ID,
+		DueAt:         time.Now().Add(time.Hour),
+		LocationScope: []uuid.UUID{site.ID},
+	}
+
+	_, err = tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.ErrorIs(t, err, ErrItemOutsideLocation)
+
+	loan.LocationScope = nil
+	out, err := tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.NoError(t, err)
+
+	// Returned at a kiosk bound to another site
+	out, err = tRepos.Loans.Return(ctx, tGroup.ID, tUser.ID, LoanReturn{
+		ID:               out.ID,
+		ReturnLocationID: site.ID,
+		LocationScope:    []uuid.UUID{site.ID},
+	})
+	require.NoError(t, err)
+	assert.True(t, out.IsTransfer)
+	require.NotNil(t, out.ReturnLocationID)
+	assert.Equal(t, site.ID, *out.ReturnLocationID)
+
+	// Returned at its own site
+	out, err = tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.NoError(t, err)
+
+	out, err = tRepos.Loans.Return(ctx, tGroup.ID, tUser.ID, LoanReturn{
+		ID:               out.ID,
+		ReturnLocationID: elsewhere.ID,
+		LocationScope:    []uuid.UUID{elsewhere.ID},
+	})
+	require.NoError(t, err)
+	assert.False(t, out.IsTransfer)
+	require.NotNil(t, out.ReturnLocationID)
+	assert.Equal(t, elsewhere.ID, *out.ReturnLocationID)
+
+	// A failed return leaves the loan open
+	out, err = tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, loan)
+	require.NoError(t, err)
+
+	_, err = tRepos.Loans.Return(ctx, tGroup.ID, tUser.ID, LoanReturn{
+		ID:               out.ID,
+		ReturnLocationID: uuid.New(),
+	})
+	require.Error(t, err)
+
+	out, err = tRepos.Loans.GetOneByGroup(ctx, tGroup.ID, out.ID)
+	require.NoError(t, err)
+	assert.Nil(t, out.ReturnedAt)
+}
@@ -0,0 +1,251 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/labeltemplate"
+)
+
+// LabelTemplateRepository manages the group's label templates, which choose the fields
+// printed on item and location labels.
+type LabelTemplateRepository struct {
+	db *ent.Client
+}
+
+// The fields a label template can print.
+const (
+	LabelFieldAssetID      = "asset_id"
+	LabelFieldName         = "name"
+	LabelFieldDescription  = "description"
+	LabelFieldSerialNumber = "serial_number"
+	LabelFieldModelNumber  = "model_number"
+	LabelFieldManufacturer = "manufacturer"
+	LabelFieldLocation     = "location"
+	// LabelFieldLocationPath is the location with its parents, e.g. Office / Cabinet / Drawer 1
+	LabelFieldLocationPath = "location_path"
+	// LabelFieldCustom is the value of the custom field named by FieldName
+	LabelFieldCustom = "custom_field"
+	// LabelFieldText is fixed text, e.g. who to return the item to
+	LabelFieldText = "text"
+)
+
+// ErrLabelTemplateField is returned for custom field lines without a field name and
+// text lines without text.
+var ErrLabelTemplateField = errors.New("custom field lines need a field name and text lines their text")
+
+type (
+	LabelTemplateField struct {
+		Field     string `json:"field"     validate:"required,oneof=asset_id name description serial_number model_number manufacturer location location_path custom_field text"`
+		FieldName string `json:"fieldName" validate:"max=255"`
+		Text      string `json:"text"      validate:"max=255"`
+		// Prefix is printed before the value, e.g. S/N:
+		Prefix string `json:"prefix" validate:"max=255"`
+		// FontSize is the size of the line, 0 for the configured size
+		FontSize float64 `json:"fontSize" validate:"min=0,max=200"`
+		Bold     bool    `json:"bold"`
+	}
+
+	LabelTemplateCreate struct {
+		Name        string               `json:"name"        validate:"required,min=1,max=255"`
+		Description string               `json:"description" validate:"max=1000"`
+		Symbology   string               `json:"symbology"   validate:"required,oneof=qr datamatrix code128 none"`
+		Fields      []LabelTemplateField `json:"fields"      validate:"required,min=1,max=20,dive"`
+	}
+
+	LabelTemplateUpdate struct {
+		ID          uuid.UUID            `json:"id"`
+		Name        string               `json:"name"        validate:"required,min=1,max=255"`
+		Description string               `json:"description" validate:"max=1000"`
+		Symbology   string               `json:"symbology"   validate:"required,oneof=qr datamatrix code128 none"`
+		Fields      []LabelTemplateField `json:"fields"      validate:"required,min=1,max=20,dive"`
+	}
+
+	LabelTemplateOut struct {
+		ID          uuid.UUID            `json:"id"`
+		Name        string               `json:"name"`
+		Description string               `json:"description"`
+		Symbology   string               `json:"symbology"`
+		Fields      []LabelTemplateField `json:"fields"`
+		CreatedAt   time.Time            `json:"createdAt"`
+		UpdatedAt   time.Time            `json:"updatedAt"`
+	}
+)
+
+// LabelValues are the values of an item or location that label templates print.
+type LabelValues struct {
+	AssetID      string
+	Name         string
+	Description  string
+	SerialNumber string
+	ModelNumber  string
+	Manufacturer string
+	Location     string
+	LocationPath string
+	// Fields are the values of the custom fields by name
+	Fields map[string]string
+}
+
+// LabelLine is a line of a label with its size, 0 for the configured one.
+type LabelLine struct {
+	Text     string
+	FontSize float64
+	Bold     bool
+}
+
+// Lines returns the lines of the template with the values, leaving out the fields
+// without a value.
+func (t LabelTemplateOut) Lines(v LabelValues) []LabelLine {
+	lines := make([]LabelLine, 0, len(t.Fields))
+
+	for _, f := range t.Fields {
+		var value string
+		switch f.Field {
+		case LabelFieldAssetID:
+			value = v.AssetID
+		case LabelFieldName:
+			value = v.Name
+		case LabelFieldDescription:
+			value = v.Description
+		case LabelFieldSerialNumber:
+			value = v.SerialNumber
+		case LabelFieldModelNumber:
+			value = v.ModelNumber
+		case LabelFieldManufacturer:
+			value = v.Manufacturer
+		case LabelFieldLocation:
+			value = v.Location
+		case LabelFieldLocationPath:
+			value = v.LocationPath
+		case LabelFieldCustom:
+			value = v.Fields[f.FieldName]
+		case LabelFieldText:
+			value = f.Text
+		}
+
+		if value == "" {
+			continue
+		}
+
+		if f.Prefix != "" {
+			value = f.Prefix + " " + value
+		}
+		lines = append(lines, LabelLine{Text: value, FontSize: f.FontSize, Bold: f.Bold})
+	}
+
+	return lines
+}
+
+func mapLabelTemplateOutErr(t *ent.LabelTemplate, err error) (LabelTemplateOut, error) {
+	if err != nil {
+		return LabelTemplateOut{}, err
+	}
+	return mapLabelTemplateOut(t)
+}
+
+func mapLabelTemplatesOutErr(ts []*ent.LabelTemplate, err error) ([]LabelTemplateOut, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]LabelTemplateOut, len(ts))
+	for i, t := range ts {
+		if out[i], err = mapLabelTemplateOut(t); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// mapLabelTemplateOut decodes the fields of the template, which fails for fields stored
+// in a format this version doesn't know.
+func mapLabelTemplateOut(t *ent.LabelTemplate) (LabelTemplateOut, error) {
+	fields := []LabelTemplateField{}
+	if err := json.Unmarshal([]byte(t.Fields), &fields); err != nil {
+		return LabelTemplateOut{}, fmt.Errorf("label template %q: %w", t.Name, err)
+	}
+
+	return LabelTemplateOut{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		Symbology:   t.Symbology.String(),
+		Fields:      fields,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}, nil
+}
+
+func encodeLabelFields(fields []LabelTemplateField) (string, error) {
+	for _, f := range fields {
+		if f.Field == LabelFieldCustom && f.FieldName == "" || f.Field == LabelFieldText && f.Text == "" {
+			return "", ErrLabelTemplateField
+		}
+	}
+
+	b, err := json.Marshal(fields)
+	return string(b), err
+}
+
+func (r *LabelTemplateRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]LabelTemplateOut, error) {
+	return mapLabelTemplatesOutErr(r.db.LabelTemplate.Query().
+		Where(labeltemplate.GroupID(gid)).
+		Order(ent.Asc(labeltemplate.FieldName)).
+		All(ctx),
+	)
+}
+
+func (r *LabelTemplateRepository) GetOne(ctx context.Context, gid, id uuid.UUID) (LabelTemplateOut, error) {
+	return mapLabelTemplateOutErr(r.db.LabelTemplate.Query().
+		Where(labeltemplate.ID(id), labeltemplate.GroupID(gid)).
+		Only(ctx),
+	)
+}
+
+func (r *LabelTemplateRepository) Create(ctx context.Context, gid uuid.UUID, data LabelTemplateCreate) (LabelTemplateOut, error) {
+	fields, err := encodeLabelFields(data.Fields)
+	if err != nil {
+		return LabelTemplateOut{}, err
+	}
+
+	return mapLabelTemplateOutErr(r.db.LabelTemplate.Create().
+		SetGroupID(gid).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetSymbology(labeltemplate.Symbology(data.Symbology)).
+		SetFields(fields).
+		Save(ctx),
+	)
+}
+
+func (r *LabelTemplateRepository) Update(ctx context.Context, gid uuid.UUID, data LabelTemplateUpdate) (LabelTemplateOut, error) {
+	fields, err := encodeLabelFields(data.Fields)
+	if err != nil {
+		return LabelTemplateOut{}, err
+	}
+
+	if _, err := r.GetOne(ctx, gid, data.ID); err != nil {
+		return LabelTemplateOut{}, err
+	}
+
+	return mapLabelTemplateOutErr(r.db.LabelTemplate.UpdateOneID(data.ID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetSymbology(labeltemplate.Symbology(data.Symbology)).
+		SetFields(fields).
+		Save(ctx),
+	)
+}
+
+func (r *LabelTemplateRepository) Delete(ctx context.Context, gid, id uuid.UUID) error {
+	if _, err := r.GetOne(ctx, gid, id); err != nil {
+		return err
+	}
+
+	return r.db.LabelTemplate.DeleteOneID(id).Exec(ctx)
+}
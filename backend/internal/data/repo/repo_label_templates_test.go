@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelTemplateRepository(t *testing.T) {
+	ctx := context.Background()
+
+	tmpl, err := tRepos.LabelTemplates.Create(ctx, tGroup.ID, LabelTemplateCreate{
+		Name:      fk.Str(10),
+		Symbology: "datamatrix",
+		Fields: []LabelTemplateField{
+			{Field: LabelFieldAssetID, FontSize: 40, Bold: true},
+			{Field: LabelFieldName},
+			{Field: LabelFieldSerialNumber, Prefix: "S/N:"},
+			{Field: LabelFieldCustom, FieldName: "Calibrated"},
+			{Field: LabelFieldLocationPath},
+			{Field: LabelFieldText, Text: "Return to the front desk"},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.LabelTemplates.Delete(context.Background(), tGroup.ID, tmpl.ID)
+	})
+
+	got, err := tRepos.LabelTemplates.GetOne(ctx, tGroup.ID, tmpl.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "datamatrix", got.Symbology)
+	assert.Equal(t, tmpl.Fields, got.Fields)
+
+	lines := got.Lines(LabelValues{
+		AssetID:      "000-042",
+		Name:         "Oscilloscope",
+		LocationPath: "Lab / Bench 2",
+		Fields:       map[string]string{"Calibrated": "2026-09-01"},
+	})
+	assert.Equal(t, []LabelLine{
+		{Text: "000-042", FontSize: 40, Bold: true},
+		{Text: "Oscilloscope"},
+		{Text: "2026-09-01"},
+		{Text: "Lab / Bench 2"},
+		{Text: "Return to the front desk"},
+	}, lines, "fields without a value are left out")
+
+	_, err = tRepos.LabelTemplates.Update(ctx, tGroup.ID, LabelTemplateUpdate{
+		ID:        tmpl.ID,
+		Name:      tmpl.Name,
+		Symbology: "code128",
+		Fields:    []LabelTemplateField{{Field: LabelFieldCustom}},
+	})
+	require.ErrorIs(t, err, ErrLabelTemplateField)
+
+	updated, err := tRepos.LabelTemplates.Update(ctx, tGroup.ID, LabelTemplateUpdate{
+		ID:        tmpl.ID,
+		Name:      tmpl.Name,
+		Symbology: "code128",
+		Fields:    []LabelTemplateField{{Field: LabelFieldSerialNumber, Prefix: "S/N:"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []LabelLine{{Text: "S/N: 12345"}}, updated.Lines(LabelValues{SerialNumber: "12345"}))
+
+	// Fields that can't be decoded are an error rather than an empty label
+	require.NoError(t, tClient.LabelTemplate.UpdateOneID(tmpl.ID).SetFields("not json").Exec(ctx))
+	_, err = tRepos.LabelTemplates.GetOne(ctx, tGroup.ID, tmpl.ID)
+	require.Error(t, err)
+	_, err = tRepos.LabelTemplates.GetAll(ctx, tGroup.ID)
+	require.Error(t, err)
+
+	other, err := tRepos.Groups.GroupCreate(ctx, fk.Str(10))
+	require.NoError(t, err)
+	_, err = tRepos.LabelTemplates.GetOne(ctx, other.ID, tmpl.ID)
+	require.Error(t, err, "templates are private to their group")
+}
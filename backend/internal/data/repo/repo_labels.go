@@ -19,25 +19,35 @@ type LabelRepository struct {
 
 type (
 	LabelCreate struct {
-		Name        string `json:"name"        validate:"required,min=1,max=255"`
-		Description string `json:"description" validate:"max=1000"`
-		Color       string `json:"color"`
+		Name               string `json:"name"               validate:"required,min=1,max=255"`
+		Description        string `json:"description"        validate:"max=1000"`
+		Color              string `json:"color"`
+		QuarantineOnReturn bool   `json:"quarantineOnReturn"`
+		QuarantineMinutes  int    `json:"quarantineMinutes"  validate:"min=0"`
+		// AssetIDPrefix numbers the items created with the label in their own series
+		AssetIDPrefix string `json:"assetIdPrefix" validate:"max=32"`
 	}
 
 	LabelUpdate struct {
-		ID          uuid.UUID `json:"id"`
-		Name        string    `json:"name"        validate:"required,min=1,max=255"`
-		Description string    `json:"description" validate:"max=1000"`
-		Color       string    `json:"color"`
+		ID                 uuid.UUID `json:"id"`
+		Name               string    `json:"name"               validate:"required,min=1,max=255"`
+		Description        string    `json:"description"        validate:"max=1000"`
+		Color              string    `json:"color"`
+		QuarantineOnReturn bool      `json:"quarantineOnReturn"`
+		QuarantineMinutes  int       `json:"quarantineMinutes"  validate:"min=0"`
+		AssetIDPrefix      string    `json:"assetIdPrefix"      validate:"max=32"`
 	}
 
 	LabelSummary struct {
-		ID          uuid.UUID `json:"id"`
-		Name        string    `json:"name"`
-		Description string    `json:"description"`
-		Color       string    `json:"color"`
-		CreatedAt   time.Time `json:"createdAt"`
-		UpdatedAt   time.Time `json:"updatedAt"`
+		ID                 uuid.UUID `json:"id"`
+		Name               string    `json:"name"`
+		Description        string    `json:"description"`
+		Color              string    `json:"color"`
+		QuarantineOnReturn bool      `json:"quarantineOnReturn"`
+		QuarantineMinutes  int       `json:"quarantineMinutes"`
+		AssetIDPrefix      string    `json:"assetIdPrefix"`
+		CreatedAt          time.Time `json:"createdAt"`
+		UpdatedAt          time.Time `json:"updatedAt"`
 	}
 
 	LabelOut struct {
@@ -45,14 +55,28 @@ type (
 	}
 )
 
+func (lc LabelCreate) Validate() error {
+	return validateAssetIDPrefix(lc.AssetIDPrefix)
+}
+
+func (lu LabelUpdate) Validate() error {
+	return validateAssetIDPrefix(lu.AssetIDPrefix)
+}
+
 func mapLabelSummary(label *ent.Label) LabelSummary {
 	return LabelSummary{
 		ID:          label.ID,
 		Name:        label.Name,
 		Description: label.Description,
 		Color:       label.Color,
-		CreatedAt:   label.CreatedAt,
-		UpdatedAt:   label.UpdatedAt,
+
+		QuarantineOnReturn: label.QuarantineOnReturn,
+		QuarantineMinutes:  label.QuarantineMinutes,
+
+		AssetIDPrefix: label.AssetIDPrefix,
+
+		CreatedAt: label.CreatedAt,
+		UpdatedAt: label.UpdatedAt,
 	}
 }
 
@@ -103,6 +127,9 @@ func (r *LabelRepository) Create(ctx context.Context, groupID uuid.UUID, data La
 		SetName(data.Name).
 		SetDescription(data.Description).
 		SetColor(data.Color).
+		SetQuarantineOnReturn(data.QuarantineOnReturn).
+		SetQuarantineMinutes(data.QuarantineMinutes).
+		SetAssetIDPrefix(data.AssetIDPrefix).
 		SetGroupID(groupID).
 		Save(ctx)
 	if err != nil {
@@ -124,6 +151,9 @@ func (r *LabelRepository) update(ctx context.Context, data LabelUpdate, where ..
 		SetName(data.Name).
 		SetDescription(data.Description).
 		SetColor(data.Color).
+		SetQuarantineOnReturn(data.QuarantineOnReturn).
+		SetQuarantineMinutes(data.QuarantineMinutes).
+		SetAssetIDPrefix(data.AssetIDPrefix).
 		Save(ctx)
 }
 
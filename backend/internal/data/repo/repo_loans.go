@@ -2,9 +2,13 @@ package repo
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/borrower"
@@ -19,6 +23,14 @@ type LoanRepository struct {
 	bus *eventbus.EventBus
 }
 
+var (
+	// ErrLoanAlreadyReturned is returned when returning a loan that has already been returned.
+	ErrLoanAlreadyReturned = errors.New("loan has already been returned")
+	// ErrItemOutsideLocation is returned when checking out an item that is not stored
+	// within the location subtree of the kiosk.
+	ErrItemOutsideLocation = errors.New("item is not stored at this kiosk's location")
+)
+
 type (
 	LoanCreate struct {
 		ItemID     uuid.UUID `json:"itemId"     validate:"required"`
@@ -26,6 +38,14 @@ type (
 		DueAt      time.Time `json:"dueAt"      validate:"required"`
 		Notes      string    `json:"notes"      validate:"max=1000"`
 		Quantity   int       `json:"quantity"   validate:"min=1"`
+
+		// Set by the service layer when replaying actions queued by an offline kiosk
+		CheckedOutAt time.Time `json:"-"`
+		KioskAction  bool      `json:"-"`
+
+		// Set by the service layer when the kiosk is bound to a location; only items
+		// stored in one of these locations can be checked out
+		LocationScope []uuid.UUID `json:"-"`
 	}
 
 	LoanUpdate struct {
@@ -37,6 +57,15 @@ type (
 	LoanReturn struct {
 		ID          uuid.UUID `json:"id"`
 		ReturnNotes string    `json:"returnNotes" validate:"max=1000"`
+
+		// Set by the service layer when replaying actions queued by an offline kiosk
+		ReturnedAt  time.Time `json:"-"`
+		KioskAction bool      `json:"-"`
+
+		// Set by the service layer when the kiosk is bound to a location; returns of
+		// items stored outside LocationScope are flagged as transfers
+		ReturnLocationID uuid.UUID   `json:"-"`
+		LocationScope    []uuid.UUID `json:"-"`
 	}
 
 	LoanSummary struct {
@@ -46,6 +75,7 @@ type (
 		ReturnedAt   *time.Time `json:"returnedAt"`
 		Quantity     int        `json:"quantity"`
 		IsOverdue    bool       `json:"isOverdue"`
+		IsTransfer   bool       `json:"isTransfer"`
 		ItemID       uuid.UUID  `json:"itemId"`
 		ItemName     string     `json:"itemName"`
 		BorrowerID   uuid.UUID  `json:"borrowerId"`
@@ -63,6 +93,10 @@ type (
 		BorrowerPhone string     `json:"borrowerPhone"`
 		CheckedOutBy  *uuid.UUID `json:"checkedOutBy"`
 		ReturnedBy    *uuid.UUID `json:"returnedBy"`
+		KioskAction   bool       `json:"kioskAction"`
+
+		ReturnLocationID   *uuid.UUID `json:"returnLocationId,omitempty"`
+		ReturnLocationName string     `json:"returnLocationName,omitempty"`
 	}
 )
 
@@ -74,6 +108,7 @@ func mapLoanSummary(l *ent.Loan) LoanSummary {
 		ReturnedAt:   l.ReturnedAt,
 		Quantity:     l.Quantity,
 		IsOverdue:    l.ReturnedAt == nil && time.Now().After(l.DueAt),
+		IsTransfer:   l.Transfer,
 		CreatedAt:    l.CreatedAt,
 		UpdatedAt:    l.UpdatedAt,
 	}
@@ -101,6 +136,7 @@ func mapLoanOut(l *ent.Loan) LoanOut {
 		LoanSummary: mapLoanSummary(l),
 		Notes:       l.Notes,
 		ReturnNotes: l.ReturnNotes,
+		KioskAction: l.KioskAction,
 	}
 
 	if l.Edges.Item != nil {
@@ -120,6 +156,11 @@ func mapLoanOut(l *ent.Loan) LoanOut {
 		out.ReturnedBy = &l.Edges.ReturnedBy.ID
 	}
 
+	if l.Edges.ReturnLocation != nil {
+		out.ReturnLocationID = &l.Edges.ReturnLocation.ID
+		out.ReturnLocationName = l.Edges.ReturnLocation.Name
+	}
+
 	return out
 }
 
@@ -137,6 +178,7 @@ func (r *LoanRepository) getOne(ctx context.Context, where ...predicate.Loan) (L
 		WithBorrower().
 		WithCheckedOutBy().
 		WithReturnedBy().
+		WithReturnLocation().
 		Only(ctx),
 	)
 }
@@ -237,15 +279,58 @@ func (r *LoanRepository) Create(ctx context.Context, gid uuid.UUID, userID uuid.
 		quantity = 1
 	}
 
+	checkedOutAt := data.CheckedOutAt
+	if checkedOutAt.IsZero() {
+		checkedOutAt = time.Now()
+	}
+
+	b, err := r.db.Borrower.Query().
+		Where(
+			borrower.ID(data.BorrowerID),
+			borrower.HasGroupWith(group.ID(gid)),
+		).
+		Only(ctx)
+	if err != nil {
+		return LoanOut{}, err
+	}
+
+	if b.VerificationStatus != borrower.VerificationStatusVerified {
+		return LoanOut{}, ErrBorrowerNotVerified
+	}
+
+	itm, err := r.db.Item.Query().
+		Where(
+			item.ID(data.ItemID),
+			item.HasGroupWith(group.ID(gid)),
+		).
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		return LoanOut{}, err
+	}
+
+	if !inLocationScope(itm, data.LocationScope) {
+		return LoanOut{}, ErrItemOutsideLocation
+	}
+
+	if isQuarantined(itm, time.Now()) {
+		return LoanOut{}, ErrItemQuarantined
+	}
+
+	if !ItemStatus(itm.Status).Lendable() {
+		return LoanOut{}, fmt.Errorf("%w: %s", ErrItemNotLendable, itm.Status)
+	}
+
 	l, err := r.db.Loan.Create().
 		SetItemID(data.ItemID).
 		SetBorrowerID(data.BorrowerID).
 		SetGroupID(gid).
-		SetCheckedOutAt(time.Now()).
+		SetCheckedOutAt(checkedOutAt).
 		SetDueAt(data.DueAt).
 		SetNotes(data.Notes).
 		SetQuantity(quantity).
 		SetCheckedOutByID(userID).
+		SetKioskAction(data.KioskAction).
 		Save(ctx)
 	if err != nil {
 		return LoanOut{}, err
@@ -255,27 +340,139 @@ func (r *LoanRepository) Create(ctx context.Context, gid uuid.UUID, userID uuid.
 	return r.GetOne(ctx, l.ID)
 }
 
-// Return marks a loan as returned
+// Return marks a loan as returned. Returns ErrLoanAlreadyReturned if the loan
+// has already been returned.
 func (r *LoanRepository) Return(ctx context.Context, gid uuid.UUID, userID uuid.UUID, data LoanReturn) (LoanOut, error) {
-	now := time.Now()
-	_, err := r.db.Loan.Update().
+	returnedAt := data.ReturnedAt
+	if returnedAt.IsZero() {
+		returnedAt = time.Now()
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return LoanOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during loan return")
+			}
+		}
+	}()
+
+	q := tx.Loan.Update().
 		Where(
 			loan.ID(data.ID),
 			loan.HasGroupWith(group.ID(gid)),
 			loan.ReturnedAtIsNil(), // Ensure not already returned
 		).
-		SetReturnedAt(now).
+		SetReturnedAt(returnedAt).
 		SetReturnNotes(data.ReturnNotes).
-		SetReturnedByID(userID).
-		Save(ctx)
+		SetReturnedByID(userID)
+
+	if data.KioskAction {
+		q.SetKioskAction(true)
+	}
+
+	n, err := q.Save(ctx)
+	if err != nil {
+		return LoanOut{}, err
+	}
+
+	if n == 0 {
+		// Distinguish a missing loan from one that was already returned
+		_, err = tx.Loan.Query().
+			Where(loan.ID(data.ID), loan.HasGroupWith(group.ID(gid))).
+			Only(ctx)
+		if err != nil {
+			return LoanOut{}, err
+		}
+		return LoanOut{}, ErrLoanAlreadyReturned
+	}
+
+	err = quarantineReturnedItem(ctx, tx.Client(), data.ID, returnedAt)
 	if err != nil {
 		return LoanOut{}, err
 	}
 
+	if data.ReturnLocationID != uuid.Nil {
+		err = recordReturnLocation(ctx, tx.Client(), data.ID, data.ReturnLocationID, data.LocationScope)
+		if err != nil {
+			return LoanOut{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return LoanOut{}, err
+	}
+	committed = true
+
 	r.publishMutationEvent(gid)
 	return r.GetOne(ctx, data.ID)
 }
 
+// quarantineReturnedItem puts the item of a returned loan into post-return quarantine
+// if any of its labels require it to be inspected before it is lent again.
+func quarantineReturnedItem(ctx context.Context, db *ent.Client, loanID uuid.UUID, returnedAt time.Time) error {
+	itm, err := db.Loan.Query().
+		Where(loan.ID(loanID)).
+		QueryItem().
+		WithLabel().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	until, ok := quarantineFor(itm.Edges.Label, returnedAt)
+	if !ok {
+		return nil
+	}
+
+	q := db.Item.UpdateOneID(itm.ID).
+		SetQuarantinedAt(returnedAt)
+
+	if until != nil {
+		q.SetQuarantineUntil(*until)
+	} else {
+		q.ClearQuarantineUntil()
+	}
+
+	return q.Exec(ctx)
+}
+
+// recordReturnLocation records where a loan was returned and flags it as a transfer
+// when the item is stored outside the location scope of the returning kiosk.
+func recordReturnLocation(ctx context.Context, db *ent.Client, loanID, locationID uuid.UUID, scope []uuid.UUID) error {
+	itm, err := db.Loan.Query().
+		Where(loan.ID(loanID)).
+		QueryItem().
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Loan.UpdateOneID(loanID).
+		SetReturnLocationID(locationID).
+		SetTransfer(!inLocationScope(itm, scope)).
+		Exec(ctx)
+}
+
+// inLocationScope reports whether the item is stored in one of the locations. An
+// empty scope contains every item.
+func inLocationScope(itm *ent.Item, scope []uuid.UUID) bool {
+	if len(scope) == 0 {
+		return true
+	}
+
+	if itm.Edges.Location == nil {
+		return false
+	}
+
+	return slices.Contains(scope, itm.Edges.Location.ID)
+}
+
 // UpdateByGroup updates a loan's details (e.g., extend due date)
 func (r *LoanRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data LoanUpdate) (LoanOut, error) {
 	_, err := r.db.Loan.Update().
@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
 )
@@ -117,11 +119,13 @@ func (r *LocationRepository) GetAll(ctx context.Context, gid uuid.UUID, filter L
 				WHERE
 					items.location_items = locations.id
 					AND items.archived = false
+					AND items.deleted_at IS NULL
 			) as item_count
 		FROM
 			locations
 		WHERE
-			locations.group_locations = $1 {{ FILTER_CHILDREN }}
+			locations.group_locations = $1
+			AND locations.deleted_at IS NULL {{ FILTER_CHILDREN }}
 		ORDER BY
 			locations.name ASC
 `
@@ -231,17 +235,72 @@ func (r *LocationRepository) UpdateByGroup(ctx context.Context, gid, id uuid.UUI
 // delete should only be used after checking that the location is owned by the
 // group. Otherwise, use DeleteByGroup
 func (r *LocationRepository) delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.Location.DeleteOneID(id).Exec(ctx)
+	return r.db.Location.DeleteOneID(id).Exec(withDeleted(ctx))
 }
 
+// DeleteByGroup moves the location to the trash along with the locations nested below
+// it, every item they contain and the items nested below those. They share the same deleted_at, which is how they are
+// restored together.
 func (r *LocationRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID) error {
-	_, err := r.db.Location.Delete().Where(location.ID(id), location.HasGroupWith(group.ID(gid))).Exec(ctx)
+	ids, err := r.SubtreeIDs(ctx, gid, id)
 	if err != nil {
 		return err
 	}
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during location deletion")
+			}
+		}
+	}()
+
+	now := trashTime()
+
+	err = tx.Location.UpdateOneID(id).
+		Where(location.HasGroupWith(group.ID(gid))).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Location.Update().Where(location.IDIn(ids...)).SetDeletedAt(now).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	items, err := tx.Item.Query().Where(item.HasLocationWith(location.IDIn(ids...))).IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Child items may be kept in a location outside of the subtree
+	children, err := itemSubtreeIDs(ctx, tx.Item, items)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Item.Update().Where(item.IDIn(append(items, children...)...)).SetDeletedAt(now).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+
 	r.publishMutationEvent(gid)
+	if r.bus != nil {
+		r.bus.Publish(eventbus.EventItemMutation, eventbus.GroupMutationEvent{GID: gid})
+	}
 
-	return err
+	return nil
 }
 
 type TreeItem struct {
@@ -323,6 +382,49 @@ func (r *LocationRepository) PathForLoc(ctx context.Context, gid, locID uuid.UUI
 	return locations, nil
 }
 
+// SubtreeIDs returns the ID of the location and of every location nested below it.
+func (r *LocationRepository) SubtreeIDs(ctx context.Context, gid, locID uuid.UUID) ([]uuid.UUID, error) {
+	// UNION rather than UNION ALL stops at locations already visited, which keeps
+	// a cycle in the parents from recursing forever without capping the depth
+	query := `WITH RECURSIVE location_subtree AS (
+		SELECT id
+		FROM locations
+		WHERE id = $1
+		AND group_locations = $2
+
+		UNION
+
+		SELECT loc.id
+		FROM locations loc
+		JOIN location_subtree ls ON loc.location_children = ls.id
+	  )
+
+	  SELECT id
+	  FROM location_subtree`
+
+	rows, err := r.db.Sql().QueryContext(ctx, query, locID, gid)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var ids []uuid.UUID
+
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
 func (r *LocationRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQuery) ([]TreeItem, error) {
 	query := `
 		WITH recursive location_tree(id, NAME, parent_id, level, node_type) AS
@@ -335,6 +437,7 @@ func (r *LocationRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQue
 			FROM    locations
 			WHERE   location_children IS NULL
 			AND     group_locations = $1
+			AND     deleted_at IS NULL
 
 			UNION ALL
 			SELECT  c.id,
@@ -345,7 +448,8 @@ func (r *LocationRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQue
 			FROM   locations c
 			JOIN   location_tree p
 			ON     c.location_children = p.id
-			WHERE  level < 10 -- prevent infinite loop & excessive recursion
+			WHERE  c.deleted_at IS NULL
+			AND    level < 10 -- prevent infinite loop & excessive recursion
 		){{ WITH_ITEMS }}
 
 		SELECT   id,
@@ -374,6 +478,7 @@ func (r *LocationRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQue
 					'item' AS node_type
 			FROM    items
 			WHERE   item_children IS NULL
+			AND     deleted_at IS NULL
 			AND     location_items IN (SELECT id FROM location_tree)
 
 			UNION ALL
@@ -387,6 +492,7 @@ func (r *LocationRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQue
 			JOIN    item_tree p
 			ON      c.item_children = p.id
 			WHERE   c.item_children IS NOT NULL
+			AND     c.deleted_at IS NULL
 			AND     level < 10 -- prevent infinite loop & excessive recursion
 		)`
 
@@ -176,6 +176,33 @@ func TestLocationRepository_PathForLoc(t *testing.T) {
 	}
 }
 
+func TestLocationRepository_SubtreeIDs(t *testing.T) {
+	locs := useLocations(t, 4)
+
+	// Set relations 0 -> 1 -> 2, leaving 3 on its own
+	for i := 0; i < 2; i++ {
+		_, err := tRepos.Locations.UpdateByGroup(context.Background(), tGroup.ID, locs[i].ID, LocationUpdate{
+			ID:          locs[i].ID,
+			ParentID:    locs[i+1].ID,
+			Name:        locs[i].Name,
+			Description: locs[i].Description,
+		})
+		require.NoError(t, err)
+	}
+
+	ids, err := tRepos.Locations.SubtreeIDs(context.Background(), tGroup.ID, locs[1].ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{locs[0].ID, locs[1].ID}, ids)
+
+	ids, err = tRepos.Locations.SubtreeIDs(context.Background(), tGroup.ID, locs[2].ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{locs[0].ID, locs[1].ID, locs[2].ID}, ids)
+
+	ids, err = tRepos.Locations.SubtreeIDs(context.Background(), uuid.New(), locs[2].ID)
+	require.NoError(t, err)
+	assert.Empty(t, ids, "locations of other groups are never returned")
+}
+
 func TestConvertLocationsToTree(t *testing.T) {
 	uuid1, uuid2, uuid3, uuid4 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
 
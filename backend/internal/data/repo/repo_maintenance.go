@@ -48,6 +48,7 @@ func (r *MaintenanceEntryRepository) GetAllMaintenance(ctx context.Context, grou
 	query := r.db.MaintenanceEntry.Query().Where(
 		maintenanceentry.HasItemWith(
 			item.HasGroupWith(group.IDEQ(groupID)),
+			item.DeletedAtIsNil(),
 		),
 	)
 
@@ -0,0 +1,405 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printjob"
+)
+
+// PrintJobRepository manages the queue of print jobs. Jobs are claimed by the print
+// queue one at a time per printer, and every change of their status is published on
+// the event bus.
+type PrintJobRepository struct {
+	db  *ent.Client
+	bus *eventbus.EventBus
+}
+
+var (
+	ErrPrintJobNotCancellable = errors.New("only queued and failed print jobs can be cancelled")
+	ErrPrintJobNotRetryable   = errors.New("only failed and cancelled print jobs can be retried")
+)
+
+type (
+	PrintJobCreate struct {
+		PrinterID uuid.UUID
+		Name      string
+		Labels    int
+		// Payload is what the printer prints, encoded by the print queue
+		Payload     string
+		MaxAttempts int
+		CreatedBy   *uuid.UUID
+	}
+
+	PrintJobPrinter struct {
+		ID   uuid.UUID `json:"id"`
+		Name string    `json:"name"`
+	}
+
+	PrintJobOut struct {
+		ID          uuid.UUID       `json:"id"`
+		Printer     PrintJobPrinter `json:"printer"`
+		Name        string          `json:"name"`
+		Status      string          `json:"status"`
+		Labels      int             `json:"labels"`
+		Attempts    int             `json:"attempts"`
+		MaxAttempts int             `json:"maxAttempts"`
+		Error       string          `json:"error"`
+		// RunAt is when the job is printed next while it is queued
+		RunAt      time.Time  `json:"runAt"`
+		FinishedAt *time.Time `json:"finishedAt,omitempty" extensions:"x-nullable"`
+		CreatedBy  *uuid.UUID `json:"createdBy,omitempty"  extensions:"x-nullable"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		UpdatedAt  time.Time  `json:"updatedAt"`
+	}
+
+	PrintJobQuery struct {
+		Page      int       `json:"page"      schema:"page"`
+		PageSize  int       `json:"pageSize"  schema:"pageSize"  validate:"max=100"`
+		PrinterID uuid.UUID `json:"printerId" schema:"printerId"`
+		Status    string    `json:"status"    schema:"status"    validate:"omitempty,oneof=queued printing done failed cancelled"`
+	}
+
+	// PrintJobWork is a claimed job with what printing it takes.
+	PrintJobWork struct {
+		ID          uuid.UUID
+		GID         uuid.UUID
+		Name        string
+		Payload     string
+		Attempts    int
+		MaxAttempts int
+		Printer     PrinterOut
+	}
+)
+
+var (
+	mapPrintJobOutErr  = mapTErrFunc(mapPrintJobOut)
+	mapPrintJobsOutErr = mapTEachErrFunc(mapPrintJobOut)
+)
+
+func mapPrintJobOut(j *ent.PrintJob) PrintJobOut {
+	out := PrintJobOut{
+		ID:          j.ID,
+		Printer:     PrintJobPrinter{ID: j.PrinterID},
+		Name:        j.Name,
+		Status:      j.Status.String(),
+		Labels:      j.Labels,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		Error:       j.Error,
+		RunAt:       j.RunAt,
+		FinishedAt:  j.FinishedAt,
+		CreatedBy:   j.CreatedBy,
+		CreatedAt:   j.CreatedAt,
+		UpdatedAt:   j.UpdatedAt,
+	}
+
+	if j.Edges.Printer != nil {
+		out.Printer.Name = j.Edges.Printer.Name
+	}
+
+	return out
+}
+
+func (r *PrintJobRepository) publish(gid, id uuid.UUID, status printjob.Status) {
+	if r.bus != nil {
+		r.bus.Publish(eventbus.EventPrintJobMutation, eventbus.PrintJobEvent{GID: gid, ID: id, Status: status.String()})
+	}
+}
+
+// GetAll returns the group's print jobs, newest first.
+func (r *PrintJobRepository) GetAll(ctx context.Context, gid uuid.UUID, q PrintJobQuery) (PaginationResult[PrintJobOut], error) {
+	qb := r.db.PrintJob.Query().Where(printjob.GroupID(gid))
+
+	if q.PrinterID != uuid.Nil {
+		qb = qb.Where(printjob.PrinterID(q.PrinterID))
+	}
+	if q.Status != "" {
+		qb = qb.Where(printjob.StatusEQ(printjob.Status(q.Status)))
+	}
+
+	count, err := qb.Count(ctx)
+	if err != nil {
+		return PaginationResult[PrintJobOut]{}, err
+	}
+
+	if q.PageSize <= 0 {
+		q.PageSize = 50
+	}
+	q.PageSize = min(q.PageSize, 100)
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+
+	items, err := mapPrintJobsOutErr(qb.
+		WithPrinter().
+		Order(ent.Desc(printjob.FieldCreatedAt)).
+		Offset(calculateOffset(q.Page, q.PageSize)).
+		Limit(q.PageSize).
+		All(ctx),
+	)
+	if err != nil {
+		return PaginationResult[PrintJobOut]{}, err
+	}
+
+	return PaginationResult[PrintJobOut]{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Total:    count,
+		Items:    items,
+	}, nil
+}
+
+func (r *PrintJobRepository) GetOne(ctx context.Context, gid, id uuid.UUID) (PrintJobOut, error) {
+	return mapPrintJobOutErr(r.db.PrintJob.Query().
+		Where(printjob.ID(id), printjob.GroupID(gid)).
+		WithPrinter().
+		Only(ctx),
+	)
+}
+
+// Create queues a job for the printer, which has to be the group's.
+func (r *PrintJobRepository) Create(ctx context.Context, gid uuid.UUID, data PrintJobCreate) (PrintJobOut, error) {
+	p, err := (&PrinterRepository{r.db}).GetOne(ctx, gid, data.PrinterID)
+	if err != nil {
+		return PrintJobOut{}, err
+	}
+
+	q := r.db.PrintJob.Create().
+		SetGroupID(gid).
+		SetPrinterID(p.ID).
+		SetName(truncate(data.Name, 255)).
+		SetLabels(data.Labels).
+		SetPayload(data.Payload).
+		SetNillableCreatedBy(data.CreatedBy)
+	if data.MaxAttempts > 0 {
+		q.SetMaxAttempts(data.MaxAttempts)
+	}
+
+	job, err := q.Save(ctx)
+	if err != nil {
+		return PrintJobOut{}, err
+	}
+
+	r.publish(gid, job.ID, job.Status)
+
+	out := mapPrintJobOut(job)
+	out.Printer.Name = p.Name
+	return out, nil
+}
+
+// Cancel cancels a queued or failed job. Jobs that are printing can't be called back.
+func (r *PrintJobRepository) Cancel(ctx context.Context, gid, id uuid.UUID) (PrintJobOut, error) {
+	n, err := r.db.PrintJob.Update().
+		Where(
+			printjob.ID(id),
+			printjob.GroupID(gid),
+			printjob.StatusIn(printjob.StatusQueued, printjob.StatusFailed),
+		).
+		SetStatus(printjob.StatusCancelled).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return PrintJobOut{}, err
+	}
+
+	if n == 0 {
+		if _, err := r.GetOne(ctx, gid, id); err != nil {
+			return PrintJobOut{}, err
+		}
+		return PrintJobOut{}, ErrPrintJobNotCancellable
+	}
+
+	r.publish(gid, id, printjob.StatusCancelled)
+	return r.GetOne(ctx, gid, id)
+}
+
+// Retry queues a failed or cancelled job again, with all its attempts.
+func (r *PrintJobRepository) Retry(ctx context.Context, gid, id uuid.UUID) (PrintJobOut, error) {
+	n, err := r.db.PrintJob.Update().
+		Where(
+			printjob.ID(id),
+			printjob.GroupID(gid),
+			printjob.StatusIn(printjob.StatusFailed, printjob.StatusCancelled),
+		).
+		SetStatus(printjob.StatusQueued).
+		SetAttempts(0).
+		SetRunAt(time.Now()).
+		ClearError().
+		ClearFinishedAt().
+		Save(ctx)
+	if err != nil {
+		return PrintJobOut{}, err
+	}
+
+	if n == 0 {
+		if _, err := r.GetOne(ctx, gid, id); err != nil {
+			return PrintJobOut{}, err
+		}
+		return PrintJobOut{}, ErrPrintJobNotRetryable
+	}
+
+	r.publish(gid, id, printjob.StatusQueued)
+	return r.GetOne(ctx, gid, id)
+}
+
+// Claim marks the next due job as printing and returns it, or nil when no job is due.
+// The status only changes from queued, so a job is claimed once even with several
+// servers sharing the database. Printers print one job at a time, the jobs of a
+// printer that is still printing are skipped.
+func (r *PrintJobRepository) Claim(ctx context.Context) (*PrintJobWork, error) {
+	idle := printjob.Not(printjob.HasPrinterWith(
+		printer.HasJobsWith(printjob.StatusEQ(printjob.StatusPrinting)),
+	))
+
+	for {
+		job, err := r.db.PrintJob.Query().
+			Where(
+				printjob.StatusEQ(printjob.StatusQueued),
+				printjob.RunAtLTE(time.Now()),
+				idle,
+			).
+			WithPrinter().
+			Order(ent.Asc(printjob.FieldRunAt)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		n, err := r.db.PrintJob.Update().
+			Where(printjob.ID(job.ID), printjob.StatusEQ(printjob.StatusQueued), idle).
+			SetStatus(printjob.StatusPrinting).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			// Claimed, cancelled or its printer busy in the meantime
+			continue
+		}
+
+		r.publish(job.GroupID, job.ID, printjob.StatusPrinting)
+
+		return &PrintJobWork{
+			ID:          job.ID,
+			GID:         job.GroupID,
+			Name:        job.Name,
+			Payload:     job.Payload,
+			Attempts:    job.Attempts + 1,
+			MaxAttempts: job.MaxAttempts,
+			Printer:     mapPrinterOut(job.Edges.Printer),
+		}, nil
+	}
+}
+
+// Done marks the claimed job as printed.
+func (r *PrintJobRepository) Done(ctx context.Context, job *PrintJobWork) error {
+	err := r.db.PrintJob.UpdateOneID(job.ID).
+		SetStatus(printjob.StatusDone).
+		SetFinishedAt(time.Now()).
+		ClearError().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.publish(job.GID, job.ID, printjob.StatusDone)
+	return nil
+}
+
+// Fail records the error of the claimed job. It is queued again for retryAt, or failed
+// for good when retryAt is nil.
+func (r *PrintJobRepository) Fail(ctx context.Context, job *PrintJobWork, msg string, retryAt *time.Time) error {
+	q := r.db.PrintJob.UpdateOneID(job.ID).
+		SetError(truncate(msg, 1000))
+
+	status := printjob.StatusFailed
+	if retryAt != nil {
+		status = printjob.StatusQueued
+		q.SetRunAt(*retryAt)
+	} else {
+		q.SetFinishedAt(time.Now())
+	}
+
+	if err := q.SetStatus(status).Exec(ctx); err != nil {
+		return err
+	}
+
+	r.publish(job.GID, job.ID, status)
+	return nil
+}
+
+// RequeueStale queues the jobs again that started printing before the time and never
+// finished, as the server printing them stopped. Jobs that used up their attempts fail
+// instead. It returns the number of jobs requeued or failed.
+func (r *PrintJobRepository) RequeueStale(ctx context.Context, before time.Time) (int, error) {
+	stale, err := r.db.PrintJob.Query().
+		Where(
+			printjob.StatusEQ(printjob.StatusPrinting),
+			printjob.UpdatedAtLT(before),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, job := range stale {
+		// Only while still printing, the job may have finished in the meantime
+		q := r.db.PrintJob.Update().
+			Where(
+				printjob.ID(job.ID),
+				printjob.StatusEQ(printjob.StatusPrinting),
+				printjob.UpdatedAtLT(before),
+			)
+
+		status := printjob.StatusQueued
+		if job.Attempts >= job.MaxAttempts {
+			status = printjob.StatusFailed
+			q.SetError("printing was interrupted").SetFinishedAt(time.Now())
+		} else {
+			q.SetRunAt(time.Now())
+		}
+
+		n, err := q.SetStatus(status).Save(ctx)
+		if err != nil {
+			return count, err
+		}
+		if n == 0 {
+			continue
+		}
+
+		r.publish(job.GroupID, job.ID, status)
+		count++
+	}
+
+	return count, nil
+}
+
+// Purge deletes the jobs that finished before the time.
+func (r *PrintJobRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	return r.db.PrintJob.Delete().
+		Where(
+			printjob.StatusIn(printjob.StatusDone, printjob.StatusFailed, printjob.StatusCancelled),
+			printjob.FinishedAtLT(before),
+		).
+		Exec(ctx)
+}
+
+// truncate shortens s to at most n characters.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
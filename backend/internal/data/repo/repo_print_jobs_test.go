@@ -0,0 +1,204 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+)
+
+func TestPrinterRepository_Address(t *testing.T) {
+	ctx := context.Background()
+
+	invalid := []PrinterCreate{
+		{Name: fk.Str(10), Kind: PrinterKindRaw},
+		{Name: fk.Str(10), Kind: PrinterKindRaw, Address: "http://192.168.1.20"},
+		{Name: fk.Str(10), Kind: PrinterKindIPP, Address: "192.168.1.20"},
+		{Name: fk.Str(10), Kind: PrinterKindIPP, Address: "ftp://192.168.1.20/printer"},
+		{Name: fk.Str(10), Kind: PrinterKindCommand, Address: "office; rm -rf"},
+	}
+	for _, p := range invalid {
+		_, err := tRepos.Printers.Create(ctx, tGroup.ID, p)
+		require.ErrorIs(t, err, ErrPrinterAddress, p.Address)
+	}
+
+	p, err := tRepos.Printers.Create(ctx, tGroup.ID, PrinterCreate{
+		Name:    fk.Str(10),
+		Kind:    PrinterKindIPP,
+		Address: "ipp://192.168.1.20/ipp/print",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Printers.Delete(context.Background(), tGroup.ID, p.ID)
+	})
+
+	assert.Equal(t, "zpl", p.Language)
+	assert.Equal(t, 203, p.DPI)
+
+	updated, err := tRepos.Printers.Update(ctx, tGroup.ID, PrinterUpdate{
+		ID:       p.ID,
+		Name:     p.Name,
+		Kind:     PrinterKindRaw,
+		Address:  "192.168.1.21:9100",
+		Language: "epl",
+		DPI:      300,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "epl", updated.Language)
+	assert.Equal(t, 300, updated.DPI)
+}
+
+func TestPrintJobRepository(t *testing.T) {
+	ctx := context.Background()
+
+	p, err := tRepos.Printers.Create(ctx, tGroup.ID, PrinterCreate{
+		Name:    fk.Str(10),
+		Kind:    PrinterKindRaw,
+		Address: "192.168.1.20",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Printers.Delete(context.Background(), tGroup.ID, p.ID)
+	})
+
+	job, err := tRepos.PrintJobs.Create(ctx, tGroup.ID, PrintJobCreate{
+		PrinterID:   p.ID,
+		Name:        "Drill",
+		Labels:      1,
+		Payload:     `{"labels":[]}`,
+		MaxAttempts: 2,
+		CreatedBy:   &tUser.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "queued", job.Status)
+	assert.Equal(t, p.Name, job.Printer.Name)
+
+	// Printing jobs can't be cancelled
+	work, err := tRepos.PrintJobs.Claim(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, work)
+	assert.Equal(t, job.ID, work.ID)
+	assert.Equal(t, 1, work.Attempts)
+	assert.Equal(t, "192.168.1.20", work.Printer.Address)
+
+	_, err = tRepos.PrintJobs.Cancel(ctx, tGroup.ID, job.ID)
+	require.ErrorIs(t, err, ErrPrintJobNotCancellable)
+
+	// A job queued for later isn't claimed before then
+	retryAt := time.Now().Add(time.Hour)
+	require.NoError(t, tRepos.PrintJobs.Fail(ctx, work, "connection refused", &retryAt))
+
+	next, err := tRepos.PrintJobs.Claim(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+
+	got, err := tRepos.PrintJobs.GetOne(ctx, tGroup.ID, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "queued", got.Status)
+	assert.Equal(t, "connection refused", got.Error)
+
+	require.NoError(t, tRepos.PrintJobs.Fail(ctx, work, "connection refused", nil))
+
+	_, err = tRepos.PrintJobs.Cancel(ctx, tGroup.ID, job.ID)
+	require.NoError(t, err)
+
+	retried, err := tRepos.PrintJobs.Retry(ctx, tGroup.ID, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "queued", retried.Status)
+	assert.Equal(t, 0, retried.Attempts)
+	assert.Empty(t, retried.Error)
+
+	_, err = tRepos.PrintJobs.Retry(ctx, tGroup.ID, job.ID)
+	require.ErrorIs(t, err, ErrPrintJobNotRetryable)
+
+	// Jobs of a stopped server are queued again
+	work, err = tRepos.PrintJobs.Claim(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, work)
+
+	n, err := tRepos.PrintJobs.RequeueStale(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	work, err = tRepos.PrintJobs.Claim(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, work)
+	require.NoError(t, tRepos.PrintJobs.Done(ctx, work))
+
+	jobs, err := tRepos.PrintJobs.GetAll(ctx, tGroup.ID, PrintJobQuery{PrinterID: p.ID, Status: "done"})
+	require.NoError(t, err)
+	require.Len(t, jobs.Items, 1)
+	assert.NotNil(t, jobs.Items[0].FinishedAt)
+	assert.Equal(t, 2, jobs.Items[0].Attempts)
+
+	jobs, err = tRepos.PrintJobs.GetAll(ctx, tGroup.ID, PrintJobQuery{PageSize: 1000000})
+	require.NoError(t, err)
+	assert.Equal(t, 100, jobs.PageSize)
+
+	n, err = tRepos.PrintJobs.Purge(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestPrintJobRepository_RequeueStaleAttempts(t *testing.T) {
+	ctx := context.Background()
+
+	p, err := tRepos.Printers.Create(ctx, tGroup.ID, PrinterCreate{
+		Name:    fk.Str(10),
+		Kind:    PrinterKindRaw,
+		Address: "192.168.1.21",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Printers.Delete(context.Background(), tGroup.ID, p.ID)
+	})
+
+	job, err := tRepos.PrintJobs.Create(ctx, tGroup.ID, PrintJobCreate{
+		PrinterID:   p.ID,
+		Name:        "Ladder",
+		Labels:      1,
+		Payload:     `{"labels":[]}`,
+		MaxAttempts: 1,
+	})
+	require.NoError(t, err)
+
+	events := make(chan eventbus.PrintJobEvent, 10)
+	tbus.Subscribe(eventbus.EventPrintJobMutation, func(data any) {
+		if e, ok := data.(eventbus.PrintJobEvent); ok && e.ID == job.ID {
+			events <- e
+		}
+	})
+
+	work, err := tRepos.PrintJobs.Claim(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, work)
+
+	// An interrupted job without attempts left fails rather than printing once more
+	n, err := tRepos.PrintJobs.RequeueStale(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	// Events arrive in order, the job's creation and claim come first
+	timeout := time.After(5 * time.Second)
+	for status := ""; status != "failed"; {
+		select {
+		case e := <-events:
+			status = e.Status
+		case <-timeout:
+			t.Fatal("no event for the interrupted job")
+		}
+	}
+
+	got, err := tRepos.PrintJobs.GetOne(ctx, tGroup.ID, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "failed", got.Status)
+	assert.NotEmpty(t, got.Error)
+	assert.NotNil(t, got.FinishedAt)
+
+	next, err := tRepos.PrintJobs.Claim(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+}
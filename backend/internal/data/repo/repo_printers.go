@@ -0,0 +1,184 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/printer"
+)
+
+// PrinterRepository manages the group's named printers, which print jobs are queued for.
+type PrinterRepository struct {
+	db *ent.Client
+}
+
+// The ways a printer is reached.
+const (
+	// PrinterKindCommand prints with the server's print command, handing it the address
+	// as the printer, e.g. a CUPS queue
+	PrinterKindCommand = "command"
+	// PrinterKindRaw sends ZPL or EPL to port 9100 of the address
+	PrinterKindRaw = "raw"
+	// PrinterKindIPP sends PDF documents to the ipp:// URL of the address
+	PrinterKindIPP = "ipp"
+)
+
+// ErrPrinterAddress is returned for addresses that don't suit the kind of printer.
+var ErrPrinterAddress = errors.New("invalid printer address: raw printers need a host, IPP printers an ipp:// URL and print command printers a name without spaces")
+
+type (
+	PrinterCreate struct {
+		Name        string `json:"name"        validate:"required,min=1,max=255"`
+		Description string `json:"description" validate:"max=1000"`
+		Kind        string `json:"kind"        validate:"required,oneof=command raw ipp"`
+		Address     string `json:"address"     validate:"max=1024"`
+		// Language is the printer language of raw printers
+		Language string `json:"language" validate:"omitempty,oneof=zpl epl"`
+		// DPI is the resolution of raw printers, 203 when 0
+		DPI int `json:"dpi" validate:"min=0,max=1200"`
+	}
+
+	PrinterUpdate struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"        validate:"required,min=1,max=255"`
+		Description string    `json:"description" validate:"max=1000"`
+		Kind        string    `json:"kind"        validate:"required,oneof=command raw ipp"`
+		Address     string    `json:"address"     validate:"max=1024"`
+		Language    string    `json:"language"    validate:"omitempty,oneof=zpl epl"`
+		DPI         int       `json:"dpi"         validate:"min=0,max=1200"`
+	}
+
+	PrinterOut struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		Kind        string    `json:"kind"`
+		Address     string    `json:"address"`
+		Language    string    `json:"language"`
+		DPI         int       `json:"dpi"`
+		CreatedAt   time.Time `json:"createdAt"`
+		UpdatedAt   time.Time `json:"updatedAt"`
+	}
+)
+
+var (
+	mapPrinterOutErr  = mapTErrFunc(mapPrinterOut)
+	mapPrintersOutErr = mapTEachErrFunc(mapPrinterOut)
+)
+
+func mapPrinterOut(p *ent.Printer) PrinterOut {
+	return PrinterOut{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Kind:        p.Kind.String(),
+		Address:     p.Address,
+		Language:    p.Language.String(),
+		DPI:         p.Dpi,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+// validatePrinterAddress checks the address against the kind of printer. Addresses of
+// print command printers become a single argument of the command, so they can't have
+// spaces.
+func validatePrinterAddress(kind, address string) error {
+	switch kind {
+	case PrinterKindRaw:
+		if address == "" || strings.Contains(address, "://") || strings.ContainsAny(address, " \t\n") {
+			return ErrPrinterAddress
+		}
+	case PrinterKindIPP:
+		u, err := url.Parse(address)
+		if err != nil || u.Host == "" {
+			return ErrPrinterAddress
+		}
+		switch u.Scheme {
+		case "ipp", "ipps", "http", "https":
+		default:
+			return ErrPrinterAddress
+		}
+	case PrinterKindCommand:
+		if strings.ContainsAny(address, " \t\n") {
+			return ErrPrinterAddress
+		}
+	}
+	return nil
+}
+
+func (r *PrinterRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]PrinterOut, error) {
+	return mapPrintersOutErr(r.db.Printer.Query().
+		Where(printer.GroupID(gid)).
+		Order(ent.Asc(printer.FieldName)).
+		All(ctx),
+	)
+}
+
+func (r *PrinterRepository) GetOne(ctx context.Context, gid, id uuid.UUID) (PrinterOut, error) {
+	return mapPrinterOutErr(r.db.Printer.Query().
+		Where(printer.ID(id), printer.GroupID(gid)).
+		Only(ctx),
+	)
+}
+
+func (r *PrinterRepository) Create(ctx context.Context, gid uuid.UUID, data PrinterCreate) (PrinterOut, error) {
+	if err := validatePrinterAddress(data.Kind, data.Address); err != nil {
+		return PrinterOut{}, err
+	}
+
+	q := r.db.Printer.Create().
+		SetGroupID(gid).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetKind(printer.Kind(data.Kind)).
+		SetAddress(data.Address)
+	if data.Language != "" {
+		q.SetLanguage(printer.Language(data.Language))
+	}
+	if data.DPI > 0 {
+		q.SetDpi(data.DPI)
+	}
+
+	return mapPrinterOutErr(q.Save(ctx))
+}
+
+func (r *PrinterRepository) Update(ctx context.Context, gid uuid.UUID, data PrinterUpdate) (PrinterOut, error) {
+	if err := validatePrinterAddress(data.Kind, data.Address); err != nil {
+		return PrinterOut{}, err
+	}
+
+	if _, err := r.GetOne(ctx, gid, data.ID); err != nil {
+		return PrinterOut{}, err
+	}
+
+	q := r.db.Printer.UpdateOneID(data.ID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetKind(printer.Kind(data.Kind)).
+		SetAddress(data.Address).
+		SetLanguage(printer.DefaultLanguage).
+		SetDpi(printer.DefaultDpi)
+	if data.Language != "" {
+		q.SetLanguage(printer.Language(data.Language))
+	}
+	if data.DPI > 0 {
+		q.SetDpi(data.DPI)
+	}
+
+	return mapPrinterOutErr(q.Save(ctx))
+}
+
+// Delete deletes the printer with its print jobs.
+func (r *PrinterRepository) Delete(ctx context.Context, gid, id uuid.UUID) error {
+	if _, err := r.GetOne(ctx, gid, id); err != nil {
+		return err
+	}
+
+	return r.db.Printer.DeleteOneID(id).Exec(ctx)
+}
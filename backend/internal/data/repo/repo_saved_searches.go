@@ -0,0 +1,204 @@
+package repo
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
+)
+
+// SavedSearchRepository is a repository for stored item queries. A saved search belongs
+// to the user who created it and, when shared, is visible to the rest of the group.
+type SavedSearchRepository struct {
+	db *ent.Client
+}
+
+type (
+	SavedSearchCreate struct {
+		Name        string    `json:"name"        validate:"required,min=1,max=255"`
+		Description string    `json:"description" validate:"max=1000"`
+		Query       ItemQuery `json:"query"`
+		Shared      bool      `json:"shared"`
+	}
+
+	SavedSearchUpdate struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"        validate:"required,min=1,max=255"`
+		Description string    `json:"description" validate:"max=1000"`
+		Query       ItemQuery `json:"query"`
+		Shared      bool      `json:"shared"`
+	}
+
+	SavedSearchOut struct {
+		ID          uuid.UUID `json:"id"`
+		UserID      uuid.UUID `json:"userId"`
+		UserName    string    `json:"userName"`
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		Query       ItemQuery `json:"query"`
+		Shared      bool      `json:"shared"`
+		CreatedAt   time.Time `json:"createdAt"`
+		UpdatedAt   time.Time `json:"updatedAt"`
+	}
+)
+
+var (
+	mapSavedSearchOutErr   = mapTErrFunc(mapSavedSearchOut)
+	mapSavedSearchesOutErr = mapTEachErrFunc(mapSavedSearchOut)
+)
+
+func mapSavedSearchOut(s *ent.SavedSearch) SavedSearchOut {
+	out := SavedSearchOut{
+		ID:          s.ID,
+		UserID:      s.UserID,
+		Name:        s.Name,
+		Description: s.Description,
+		Shared:      s.Shared,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+
+	// The query is only ever written by this repository, so it is always valid JSON
+	_ = json.Unmarshal([]byte(s.Query), &out.Query)
+
+	if s.Edges.User != nil {
+		out.UserName = s.Edges.User.Name
+	}
+
+	return out
+}
+
+// visibleTo matches the saved searches of the group that the user owns or that are shared.
+func visibleTo(gid, uid uuid.UUID) predicate.SavedSearch {
+	return savedsearch.And(
+		savedsearch.GroupID(gid),
+		savedsearch.Or(
+			savedsearch.UserID(uid),
+			savedsearch.Shared(true),
+		),
+	)
+}
+
+// GetAll returns the user's own saved searches followed by the ones shared with the group.
+func (r *SavedSearchRepository) GetAll(ctx context.Context, gid, uid uuid.UUID) ([]SavedSearchOut, error) {
+	out, err := mapSavedSearchesOutErr(r.db.SavedSearch.Query().
+		Where(visibleTo(gid, uid)).
+		WithUser().
+		Order(ent.Asc(savedsearch.FieldName)).
+		All(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keeps the order by name within the own and the shared searches
+	slices.SortStableFunc(out, func(a, b SavedSearchOut) int {
+		return cmp.Compare(ownerRank(a, uid), ownerRank(b, uid))
+	})
+
+	return out, nil
+}
+
+func ownerRank(s SavedSearchOut, uid uuid.UUID) int {
+	if s.UserID == uid {
+		return 0
+	}
+	return 1
+}
+
+// GetOne returns a saved search the user owns or that is shared with the group.
+func (r *SavedSearchRepository) GetOne(ctx context.Context, gid, uid, id uuid.UUID) (SavedSearchOut, error) {
+	return mapSavedSearchOutErr(r.db.SavedSearch.Query().
+		Where(savedsearch.ID(id), visibleTo(gid, uid)).
+		WithUser().
+		Only(ctx),
+	)
+}
+
+// GetShared returns a saved search only if it is shared with the group, as required
+// for exports and reports.
+func (r *SavedSearchRepository) GetShared(ctx context.Context, gid, id uuid.UUID) (SavedSearchOut, error) {
+	return mapSavedSearchOutErr(r.db.SavedSearch.Query().
+		Where(
+			savedsearch.ID(id),
+			savedsearch.GroupID(gid),
+			savedsearch.Shared(true),
+		).
+		WithUser().
+		Only(ctx),
+	)
+}
+
+func (r *SavedSearchRepository) Create(ctx context.Context, gid, uid uuid.UUID, data SavedSearchCreate) (SavedSearchOut, error) {
+	query, err := json.Marshal(data.Query)
+	if err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	s, err := r.db.SavedSearch.Create().
+		SetGroupID(gid).
+		SetUserID(uid).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetQuery(string(query)).
+		SetShared(data.Shared).
+		Save(ctx)
+	if err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	return r.GetOne(ctx, gid, uid, s.ID)
+}
+
+// Update changes a saved search. Only the owner can update it, even when it is shared.
+func (r *SavedSearchRepository) Update(ctx context.Context, gid, uid uuid.UUID, data SavedSearchUpdate) (SavedSearchOut, error) {
+	query, err := json.Marshal(data.Query)
+	if err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	_, err = r.db.SavedSearch.Query().
+		Where(
+			savedsearch.ID(data.ID),
+			savedsearch.GroupID(gid),
+			savedsearch.UserID(uid),
+		).
+		Only(ctx)
+	if err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	err = r.db.SavedSearch.UpdateOneID(data.ID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetQuery(string(query)).
+		SetShared(data.Shared).
+		Exec(ctx)
+	if err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	return r.GetOne(ctx, gid, uid, data.ID)
+}
+
+// Delete removes a saved search owned by the user.
+func (r *SavedSearchRepository) Delete(ctx context.Context, gid, uid, id uuid.UUID) error {
+	_, err := r.db.SavedSearch.Query().
+		Where(
+			savedsearch.ID(id),
+			savedsearch.GroupID(gid),
+			savedsearch.UserID(uid),
+		).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.db.SavedSearch.DeleteOneID(id).Exec(ctx)
+}
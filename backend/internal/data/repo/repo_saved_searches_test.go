@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+)
+
+func useSavedSearch(t *testing.T, uid uuid.UUID, data SavedSearchCreate) SavedSearchOut {
+	t.Helper()
+
+	s, err := tRepos.SavedSearches.Create(context.Background(), tGroup.ID, uid, data)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.SavedSearches.Delete(context.Background(), tGroup.ID, uid, s.ID)
+	})
+
+	return s
+}
+
+func TestSavedSearchRepository_Visibility(t *testing.T) {
+	ctx := context.Background()
+
+	other, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Users.Delete(context.Background(), other.ID)
+	})
+
+	mine := useSavedSearch(t, tUser.ID, SavedSearchCreate{
+		Name: "zz " + fk.Str(10),
+		Query: ItemQuery{
+			Search:           "is:insured",
+			OnlyWithoutPhoto: true,
+			Fields:           []FieldQuery{{Name: "Room", Value: "Lab B"}},
+		},
+	})
+	shared := useSavedSearch(t, other.ID, SavedSearchCreate{Name: "aa " + fk.Str(10), Shared: true})
+	private := useSavedSearch(t, other.ID, SavedSearchCreate{Name: fk.Str(10)})
+
+	assert.Equal(t, "is:insured", mine.Query.Search, "the query round trips through storage")
+	assert.True(t, mine.Query.OnlyWithoutPhoto)
+	assert.Equal(t, []FieldQuery{{Name: "Room", Value: "Lab B"}}, mine.Query.Fields)
+
+	all, err := tRepos.SavedSearches.GetAll(ctx, tGroup.ID, tUser.ID)
+	require.NoError(t, err)
+	ids := mapEach(all, func(s SavedSearchOut) uuid.UUID { return s.ID })
+	assert.ElementsMatch(t, []uuid.UUID{mine.ID, shared.ID}, ids)
+	assert.Less(t, slices.Index(ids, mine.ID), slices.Index(ids, shared.ID), "own searches come first")
+
+	_, err = tRepos.SavedSearches.GetOne(ctx, tGroup.ID, tUser.ID, private.ID)
+	assert.True(t, ent.IsNotFound(err), "private searches of other users are hidden")
+
+	_, err = tRepos.SavedSearches.GetShared(ctx, tGroup.ID, mine.ID)
+	assert.True(t, ent.IsNotFound(err), "only shared searches can be exported")
+
+	got, err := tRepos.SavedSearches.GetShared(ctx, tGroup.ID, shared.ID)
+	require.NoError(t, err)
+	assert.Equal(t, other.Name, got.UserName)
+
+	// Shared searches can be run by the group but only changed by their owner
+	_, err = tRepos.SavedSearches.Update(ctx, tGroup.ID, tUser.ID, SavedSearchUpdate{ID: shared.ID, Name: fk.Str(10)})
+	assert.True(t, ent.IsNotFound(err))
+
+	err = tRepos.SavedSearches.Delete(ctx, tGroup.ID, tUser.ID, shared.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	updated, err := tRepos.SavedSearches.Update(ctx, tGroup.ID, tUser.ID, SavedSearchUpdate{
+		ID:     mine.ID,
+		Name:   "Insured without photos",
+		Shared: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Insured without photos", updated.Name)
+	assert.True(t, updated.Shared)
+	assert.Empty(t, updated.Query.Search)
+}
+
+func TestItemsRepository_GetAllByQuery(t *testing.T) {
+	ctx := context.Background()
+	locs := useLocations(t, 2)
+
+	for _, loc := range locs {
+		itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{Name: fk.Str(10), LocationID: loc.ID})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		})
+	}
+
+	q := ItemQuery{Search: `loc:"` + locs[1].Name + `"`}
+	require.NoError(t, q.ParseSearch())
+
+	items, err := tRepos.Items.GetAllByQuery(ctx, tGroup.ID, q)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, locs[1].ID, items[0].Location.ID)
+}
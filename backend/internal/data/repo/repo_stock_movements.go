@@ -0,0 +1,186 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+)
+
+// StockMovementRepository reads the stock ledger written by stockHook.
+type StockMovementRepository struct {
+	db *ent.Client
+}
+
+// StockReason is why the quantity of an item changed.
+type StockReason string
+
+const (
+	StockReasonPurchase   StockReason = "purchase"
+	StockReasonIssue      StockReason = "issue"
+	StockReasonLoss       StockReason = "loss"
+	StockReasonAdjustment StockReason = "adjustment"
+	// StockReasonCountCorrection is used when a physical count differs from the recorded quantity
+	StockReasonCountCorrection StockReason = "count_correction"
+)
+
+type (
+	StockMovementOut struct {
+		ID        uuid.UUID   `json:"id"`
+		ItemID    uuid.UUID   `json:"itemId"`
+		Delta     int         `json:"delta"`
+		Quantity  int         `json:"quantity"`
+		Reason    StockReason `json:"reason"`
+		ActorID   *uuid.UUID  `json:"actorId,omitempty" extensions:"x-nullable"`
+		ActorName string      `json:"actorName"`
+		Note      string      `json:"note"`
+		CreatedAt time.Time   `json:"createdAt"`
+	}
+
+	// StockLevel is the quantity of an item at a point in time.
+	StockLevel struct {
+		At       time.Time `json:"at"`
+		Quantity int       `json:"quantity"`
+	}
+)
+
+type stockMovementKey struct{}
+
+// stockMovement is why the quantity changes made with a context are made.
+type stockMovement struct {
+	reason StockReason
+	note   string
+}
+
+// WithStockMovement records the quantity changes made with the returned context under
+// the reason and note. Changes without a reason are adjustments.
+func WithStockMovement(ctx context.Context, reason StockReason, note string) context.Context {
+	return context.WithValue(ctx, stockMovementKey{}, stockMovement{reason: reason, note: note})
+}
+
+func stockMovementFrom(ctx context.Context) stockMovement {
+	m, _ := ctx.Value(stockMovementKey{}).(stockMovement)
+	if m.reason == "" {
+		m.reason = StockReasonAdjustment
+	}
+	return m
+}
+
+func (m stockMovement) write(ctx context.Context, client *ent.Client, gid, itemID uuid.UUID, delta, quantity int) error {
+	if gid == uuid.Nil {
+		return nil
+	}
+
+	q := client.StockMovement.Create().
+		SetGroupID(gid).
+		SetItemID(itemID).
+		SetDelta(delta).
+		SetQuantity(quantity).
+		SetReason(stockmovement.Reason(m.reason)).
+		SetNote(m.note)
+
+	if actor := auditActorFrom(ctx); actor.UserID != uuid.Nil {
+		q.SetActorID(actor.UserID)
+	}
+
+	return q.Exec(ctx)
+}
+
+func mapStockMovementOut(m *ent.StockMovement) StockMovementOut {
+	return StockMovementOut{
+		ID:        m.ID,
+		ItemID:    m.ItemID,
+		Delta:     m.Delta,
+		Quantity:  m.Quantity,
+		Reason:    StockReason(m.Reason),
+		ActorID:   m.ActorID,
+		Note:      m.Note,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// GetByItem returns the stock movements of an item, newest first.
+func (r *StockMovementRepository) GetByItem(ctx context.Context, gid, itemID uuid.UUID) ([]StockMovementOut, error) {
+	movements, err := r.db.StockMovement.Query().
+		Where(
+			stockmovement.GroupID(gid),
+			stockmovement.ItemID(itemID),
+		).
+		Order(ent.Desc(stockmovement.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(movements))
+	for _, m := range movements {
+		if m.ActorID != nil {
+			ids = append(ids, *m.ActorID)
+		}
+	}
+
+	names, err := userNames(ctx, r.db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]StockMovementOut, len(movements))
+	for i, m := range movements {
+		out[i] = mapStockMovementOut(m)
+		if m.ActorID != nil {
+			out[i].ActorName = names[*m.ActorID]
+		}
+	}
+
+	return out, nil
+}
+
+// LevelAt returns the quantity of an item at the given time. Items changed before the
+// ledger existed have no movements for that time, their level is worked back from
+// the first movement after it, or is the current quantity when there is none.
+func (r *StockMovementRepository) LevelAt(ctx context.Context, gid, itemID uuid.UUID, at time.Time) (StockLevel, error) {
+	itm, err := r.db.Item.Query().
+		Where(item.ID(itemID), item.HasGroupWith(group.ID(gid))).
+		Only(ctx)
+	if err != nil {
+		return StockLevel{}, err
+	}
+
+	level := StockLevel{At: at}
+	if at.Before(itm.CreatedAt) {
+		return level, nil
+	}
+
+	byItem := stockmovement.And(stockmovement.GroupID(gid), stockmovement.ItemID(itemID))
+
+	last, err := r.db.StockMovement.Query().
+		Where(byItem, stockmovement.CreatedAtLTE(at)).
+		Order(ent.Desc(stockmovement.FieldCreatedAt)).
+		First(ctx)
+	switch {
+	case err == nil:
+		level.Quantity = last.Quantity
+		return level, nil
+	case !ent.IsNotFound(err):
+		return StockLevel{}, err
+	}
+
+	next, err := r.db.StockMovement.Query().
+		Where(byItem, stockmovement.CreatedAtGT(at)).
+		Order(ent.Asc(stockmovement.FieldCreatedAt)).
+		First(ctx)
+	switch {
+	case err == nil:
+		level.Quantity = next.Quantity - next.Delta
+	case ent.IsNotFound(err):
+		level.Quantity = itm.Quantity
+	default:
+		return StockLevel{}, err
+	}
+
+	return level, nil
+}
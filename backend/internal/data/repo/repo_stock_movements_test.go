@@ -0,0 +1,88 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStockHook_RecordsMovements(t *testing.T) {
+	ctx := WithAuditActor(context.Background(), AuditActor{
+		GroupID: tGroup.ID,
+		UserID:  tUser.ID,
+		Source:  AuditSourceAPI,
+	})
+	loc := useLocations(t, 1)[0]
+
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{Name: fk.Str(10), LocationID: loc.ID, Quantity: 10})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+	})
+
+	quantity := 25
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{
+		Quantity:       &quantity,
+		QuantityReason: StockReasonPurchase,
+		QuantityNote:   "restocked",
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.Issue(ctx, tGroup.ID, itm.ID, ItemIssue{Quantity: 5, Note: "workshop"})
+	require.NoError(t, err)
+
+	// Updates that leave the quantity alone are not movements
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{Quantity: &[]int{20}[0]})
+	require.NoError(t, err)
+
+	movements, err := tRepos.StockMovements.GetByItem(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.Len(t, movements, 3)
+
+	issued, purchased, created := movements[0], movements[1], movements[2]
+
+	assert.Equal(t, 10, created.Delta)
+	assert.Equal(t, StockReasonAdjustment, created.Reason)
+
+	assert.Equal(t, 15, purchased.Delta)
+	assert.Equal(t, 25, purchased.Quantity)
+	assert.Equal(t, StockReasonPurchase, purchased.Reason)
+	assert.Equal(t, "restocked", purchased.Note)
+
+	assert.Equal(t, -5, issued.Delta)
+	assert.Equal(t, 20, issued.Quantity)
+	assert.Equal(t, StockReasonIssue, issued.Reason)
+	assert.Equal(t, "workshop", issued.Note)
+	require.NotNil(t, issued.ActorID)
+	assert.Equal(t, tUser.ID, *issued.ActorID)
+	assert.Equal(t, tUser.Name, issued.ActorName)
+}
+
+func TestStockMovementRepository_LevelAt(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+	setStock(t, itm, 8, 0)
+	setStock(t, itm, 3, 0)
+
+	movements, err := tRepos.StockMovements.GetByItem(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, movements)
+
+	for _, m := range movements {
+		level, err := tRepos.StockMovements.LevelAt(ctx, tGroup.ID, itm.ID, m.CreatedAt)
+		require.NoError(t, err)
+		assert.Equal(t, m.Quantity, level.Quantity)
+	}
+
+	level, err := tRepos.StockMovements.LevelAt(ctx, tGroup.ID, itm.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 3, level.Quantity)
+
+	// Items don't have stock before they exist
+	level, err = tRepos.StockMovements.LevelAt(ctx, tGroup.ID, itm.ID, itm.CreatedAt.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, level.Quantity)
+}
@@ -0,0 +1,874 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakeentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stocktakesession"
+)
+
+// StocktakeRepository is a repository for physical stocktakes. A stocktake covers a
+// location and every location nested below it: the items in them when it starts are
+// expected, staff scan the items they find and closing it reports the discrepancies.
+type StocktakeRepository struct {
+	db          *ent.Client
+	bus         *eventbus.EventBus
+	locations   *LocationRepository
+	identifiers *ItemIdentifierRepository
+}
+
+type StocktakeStatus string
+
+const (
+	StocktakeStatusOpen   StocktakeStatus = "open"
+	StocktakeStatusClosed StocktakeStatus = "closed"
+)
+
+// StocktakeScanResult is what a scan found, see StocktakeRepository.Scan.
+type StocktakeScanResult string
+
+const (
+	// StocktakeScanFound is an expected item scanned where it is recorded to be
+	StocktakeScanFound StocktakeScanResult = "found"
+	// StocktakeScanMisplaced is an expected item scanned in another of the locations
+	StocktakeScanMisplaced StocktakeScanResult = "misplaced"
+	// StocktakeScanUnexpected is an item recorded outside of the locations
+	StocktakeScanUnexpected StocktakeScanResult = "unexpected"
+	// StocktakeScanUnknown is a value that matches no item
+	StocktakeScanUnknown StocktakeScanResult = "unknown"
+)
+
+// stocktakeLostReason is the reason recorded for items marked lost when a stocktake closes.
+const stocktakeLostReason = "Missing in stocktake %q"
+
+var (
+	// ErrStocktakeClosed is returned when scanning into or closing a closed stocktake.
+	ErrStocktakeClosed = errors.New("stocktake is closed")
+	// ErrAmbiguousScan is returned for scanned values that match several items, another
+	// of the item's identifiers has to be scanned instead.
+	ErrAmbiguousScan = errors.New("scanned value matches several items")
+	// ErrLocationOutsideStocktake is returned for scans in a location the stocktake
+	// doesn't cover.
+	ErrLocationOutsideStocktake = errors.New("location is not part of the stocktake")
+)
+
+type (
+	StocktakeCreate struct {
+		Name       string    `json:"name"       validate:"required,min=1,max=255"`
+		Notes      string    `json:"notes"      validate:"max=1000"`
+		LocationID uuid.UUID `json:"locationId" validate:"required"`
+	}
+
+	StocktakeScan struct {
+		Value string `json:"value" validate:"required,max=255"`
+		// LocationID is the room being walked, items found without one are taken to be
+		// where they are recorded
+		LocationID uuid.UUID `json:"locationId" extensions:"x-nullable"`
+	}
+
+	StocktakeClose struct {
+		// MoveMisplaced moves the misplaced items to the location they were scanned in
+		MoveMisplaced bool `json:"moveMisplaced"`
+		// MarkMissingLost marks the missing items lost, items on loan are excused
+		MarkMissingLost bool `json:"markMissingLost"`
+	}
+
+	// StocktakeProgress counts the items of a stocktake.
+	StocktakeProgress struct {
+		Expected   int `json:"expected"`
+		Found      int `json:"found"`
+		Unexpected int `json:"unexpected"`
+		Unknown    int `json:"unknown"`
+	}
+
+	StocktakeSummary struct {
+		ID            uuid.UUID         `json:"id"`
+		Name          string            `json:"name"`
+		Notes         string            `json:"notes"`
+		Location      LocationSummary   `json:"location"`
+		Status        StocktakeStatus   `json:"status"`
+		StartedBy     *uuid.UUID        `json:"startedBy"     extensions:"x-nullable"`
+		StartedByName string            `json:"startedByName"`
+		ClosedAt      *time.Time        `json:"closedAt"      extensions:"x-nullable"`
+		ClosedBy      *uuid.UUID        `json:"closedBy"      extensions:"x-nullable"`
+		ClosedByName  string            `json:"closedByName"`
+		CreatedAt     time.Time         `json:"createdAt"`
+		Progress      StocktakeProgress `json:"progress"`
+	}
+
+	StocktakeEntryOut struct {
+		ID               uuid.UUID        `json:"id"`
+		ItemID           *uuid.UUID       `json:"itemId"                     extensions:"x-nullable"`
+		Item             *ItemSummary     `json:"item,omitempty"             extensions:"x-nullable,x-omitempty"`
+		Value            string           `json:"value"`
+		Expected         bool             `json:"expected"`
+		ExpectedLocation *LocationSummary `json:"expectedLocation,omitempty" extensions:"x-nullable,x-omitempty"`
+		FoundLocation    *LocationSummary `json:"foundLocation,omitempty"    extensions:"x-nullable,x-omitempty"`
+		FoundAt          *time.Time       `json:"foundAt"                    extensions:"x-nullable"`
+		OnLoan           bool             `json:"onLoan"`
+	}
+
+	StocktakeScanOut struct {
+		Result   StocktakeScanResult `json:"result"`
+		Entry    StocktakeEntryOut   `json:"entry"`
+		Progress StocktakeProgress   `json:"progress"`
+	}
+
+	// StocktakeReport sorts the items of a stocktake by what was found. Items that were
+	// missing because they are on loan are excused rather than missing.
+	StocktakeReport struct {
+		Stocktake StocktakeSummary    `json:"stocktake"`
+		Found     []StocktakeEntryOut `json:"found"`
+		Missing   []StocktakeEntryOut `json:"missing"`
+		Misplaced []StocktakeEntryOut `json:"misplaced"`
+		OnLoan    []StocktakeEntryOut `json:"onLoan"`
+		Unknown   []StocktakeEntryOut `json:"unknown"`
+
+		// Set when closing with StocktakeClose
+		Moved      int `json:"moved"`
+		MarkedLost int `json:"markedLost"`
+	}
+)
+
+// misplaced reports whether the item was scanned somewhere else than it is recorded to be.
+func (e StocktakeEntryOut) misplaced() bool {
+	return e.FoundAt != nil && e.FoundLocation != nil &&
+		(e.ExpectedLocation == nil || e.FoundLocation.ID != e.ExpectedLocation.ID)
+}
+
+func (r *StocktakeRepository) publishMutationEvent(gid uuid.UUID) {
+	if r.bus != nil {
+		r.bus.Publish(eventbus.EventItemMutation, eventbus.GroupMutationEvent{GID: gid})
+	}
+}
+
+func mapStocktakeSummary(s *ent.StocktakeSession) StocktakeSummary {
+	out := StocktakeSummary{
+		ID:        s.ID,
+		Name:      s.Name,
+		Notes:     s.Notes,
+		Status:    StocktakeStatus(s.Status),
+		StartedBy: s.StartedBy,
+		ClosedAt:  s.ClosedAt,
+		ClosedBy:  s.ClosedBy,
+		CreatedAt: s.CreatedAt,
+	}
+	if s.Edges.Location != nil {
+		out.Location = mapLocationSummary(s.Edges.Location)
+	}
+	return out
+}
+
+// withDetails adds the names of the users and the progress to the stocktakes.
+func (r *StocktakeRepository) withDetails(ctx context.Context, sessions []*ent.StocktakeSession) ([]StocktakeSummary, error) {
+	ids := make([]uuid.UUID, 0, len(sessions))
+	userIDs := make([]uuid.UUID, 0, len(sessions))
+	for _, s := range sessions {
+		ids = append(ids, s.ID)
+		if s.StartedBy != nil {
+			userIDs = append(userIDs, *s.StartedBy)
+		}
+		if s.ClosedBy != nil {
+			userIDs = append(userIDs, *s.ClosedBy)
+		}
+	}
+
+	names, err := userNames(ctx, r.db, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := r.db.StocktakeEntry.Query().
+		Where(stocktakeentry.SessionIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make(map[uuid.UUID]StocktakeProgress, len(sessions))
+	for _, e := range entries {
+		p := progress[e.SessionID]
+		switch {
+		case e.ItemID == nil:
+			p.Unknown++
+		case e.Expected:
+			p.Expected++
+			if e.FoundAt != nil {
+				p.Found++
+			}
+		default:
+			p.Unexpected++
+		}
+		progress[e.SessionID] = p
+	}
+
+	out := make([]StocktakeSummary, len(sessions))
+	for i, s := range sessions {
+		out[i] = mapStocktakeSummary(s)
+		out[i].Progress = progress[s.ID]
+		if s.StartedBy != nil {
+			out[i].StartedByName = names[*s.StartedBy]
+		}
+		if s.ClosedBy != nil {
+			out[i].ClosedByName = names[*s.ClosedBy]
+		}
+	}
+
+	return out, nil
+}
+
+func (r *StocktakeRepository) getSession(ctx context.Context, gid, id uuid.UUID) (*ent.StocktakeSession, error) {
+	return r.db.StocktakeSession.Query().
+		Where(
+			stocktakesession.ID(id),
+			stocktakesession.GroupID(gid),
+		).
+		WithLocation().
+		Only(ctx)
+}
+
+// GetAll returns the stocktakes of the group, newest first.
+func (r *StocktakeRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]StocktakeSummary, error) {
+	sessions, err := r.db.StocktakeSession.Query().
+		Where(stocktakesession.GroupID(gid)).
+		WithLocation().
+		Order(ent.Desc(stocktakesession.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withDetails(ctx, sessions)
+}
+
+// GetOne returns the stocktake with its progress.
+func (r *StocktakeRepository) GetOne(ctx context.Context, gid, id uuid.UUID) (StocktakeSummary, error) {
+	s, err := r.getSession(ctx, gid, id)
+	if err != nil {
+		return StocktakeSummary{}, err
+	}
+
+	out, err := r.withDetails(ctx, []*ent.StocktakeSession{s})
+	if err != nil {
+		return StocktakeSummary{}, err
+	}
+
+	return out[0], nil
+}
+
+// stocktakeEntryBatch is the number of expected entries inserted by one statement. With
+// the columns of an entry it stays below the 999 parameters of older SQLite versions.
+const stocktakeEntryBatch = 50
+
+// Create starts a stocktake of the location and the locations nested below it. The
+// unarchived items in service in them are expected to be found.
+func (r *StocktakeRepository) Create(ctx context.Context, gid, userID uuid.UUID, data StocktakeCreate) (StocktakeSummary, error) {
+	scope, err := r.locations.SubtreeIDs(ctx, gid, data.LocationID)
+	if err != nil {
+		return StocktakeSummary{}, err
+	}
+	if len(scope) == 0 {
+		return StocktakeSummary{}, &ent.NotFoundError{}
+	}
+
+	items, err := r.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(gid)),
+			item.HasLocationWith(location.IDIn(scope...)),
+			item.Archived(false),
+			item.StatusEQ(item.StatusInService),
+		).
+		WithLocation().
+		All(ctx)
+	if err != nil {
+		return StocktakeSummary{}, err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return StocktakeSummary{}, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	q := tx.StocktakeSession.Create().
+		SetGroupID(gid).
+		SetName(data.Name).
+		SetNotes(data.Notes).
+		SetLocationID(data.LocationID)
+	if userID != uuid.Nil {
+		q.SetStartedBy(userID)
+	}
+
+	s, err := q.Save(ctx)
+	if err != nil {
+		return StocktakeSummary{}, err
+	}
+
+	// Inserted in batches, a single statement for a large location would exceed the
+	// number of parameters the databases allow
+	for batch := range slices.Chunk(items, stocktakeEntryBatch) {
+		entries := make([]*ent.StocktakeEntryCreate, len(batch))
+		for i, it := range batch {
+			entries[i] = tx.StocktakeEntry.Create().
+				SetSessionID(s.ID).
+				SetItemID(it.ID).
+				SetExpected(true).
+				SetExpectedLocationID(it.Edges.Location.ID)
+		}
+
+		if err := tx.StocktakeEntry.CreateBulk(entries...).Exec(ctx); err != nil {
+			return StocktakeSummary{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return StocktakeSummary{}, err
+	}
+	committed = true
+
+	return r.GetOne(ctx, gid, s.ID)
+}
+
+// Delete removes the stocktake with its scans.
+func (r *StocktakeRepository) Delete(ctx context.Context, gid, id uuid.UUID) error {
+	n, err := r.db.StocktakeSession.Delete().
+		Where(
+			stocktakesession.ID(id),
+			stocktakesession.GroupID(gid),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+}
+
+// Scan records the asset ID or identifier scanned during the stocktake. Scanning an
+// item again updates where it was found. Values that match no item are kept so that
+// the report lists them, values that match several items are rejected with
+// ErrAmbiguousScan.
+func (r *StocktakeRepository) Scan(ctx context.Context, gid, id, userID uuid.UUID, data StocktakeScan) (StocktakeScanOut, error) {
+	s, err := r.getSession(ctx, gid, id)
+	if err != nil {
+		return StocktakeScanOut{}, err
+	}
+	if StocktakeStatus(s.Status) != StocktakeStatusOpen {
+		return StocktakeScanOut{}, ErrStocktakeClosed
+	}
+
+	if data.LocationID != uuid.Nil {
+		scope, err := r.locations.SubtreeIDs(ctx, gid, s.LocationID)
+		if err != nil {
+			return StocktakeScanOut{}, err
+		}
+		if !slices.Contains(scope, data.LocationID) {
+			return StocktakeScanOut{}, ErrLocationOutsideStocktake
+		}
+	}
+
+	value := strings.TrimSpace(data.Value)
+
+	matches, err := r.identifiers.Lookup(ctx, gid, value)
+	if err != nil {
+		return StocktakeScanOut{}, err
+	}
+
+	// An item can match by its asset ID and one of its identifiers at once
+	var found *ItemSummary
+	for _, m := range matches {
+		if found != nil && found.ID != m.Item.ID {
+			return StocktakeScanOut{}, fmt.Errorf("%w: %q", ErrAmbiguousScan, value)
+		}
+		found = &m.Item
+	}
+
+	now := time.Now()
+
+	var entryID uuid.UUID
+	var result StocktakeScanResult
+
+	switch {
+	case found == nil:
+		result = StocktakeScanUnknown
+
+		existing, err := r.db.StocktakeEntry.Query().
+			Where(
+				stocktakeentry.SessionID(s.ID),
+				stocktakeentry.ItemIDIsNil(),
+				stocktakeentry.ValueEqualFold(value),
+			).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return StocktakeScanOut{}, err
+		}
+
+		if existing != nil {
+			entryID = existing.ID
+			break
+		}
+
+		q := r.db.StocktakeEntry.Create().
+			SetSessionID(s.ID).
+			SetValue(value).
+			SetFoundAt(now).
+			SetNillableFoundLocationID(nilUUID(data.LocationID))
+		if userID != uuid.Nil {
+			q.SetFoundBy(userID)
+		}
+
+		e, err := q.Save(ctx)
+		if err != nil {
+			return StocktakeScanOut{}, err
+		}
+		entryID = e.ID
+	default:
+		entryOf := func() (*ent.StocktakeEntry, error) {
+			e, err := r.db.StocktakeEntry.Query().
+				Where(
+					stocktakeentry.SessionID(s.ID),
+					stocktakeentry.ItemID(found.ID),
+				).
+				Only(ctx)
+			if ent.IsNotFound(err) {
+				return nil, nil
+			}
+			return e, err
+		}
+
+		existing, err := entryOf()
+		if err != nil {
+			return StocktakeScanOut{}, err
+		}
+
+		var recorded *uuid.UUID
+		if found.Location != nil {
+			recorded = &found.Location.ID
+		}
+
+		if existing == nil {
+			// Items recorded outside of the stocktake were found somewhere in it, so they
+			// are misplaced even without the room they were found in
+			where := data.LocationID
+			if where == uuid.Nil {
+				where = s.LocationID
+			}
+
+			e, err := r.db.StocktakeEntry.Create().
+				SetSessionID(s.ID).
+				SetItemID(found.ID).
+				SetExpected(false).
+				SetNillableExpectedLocationID(recorded).
+				SetValue(value).
+				SetFoundAt(now).
+				SetFoundLocationID(where).
+				SetNillableFoundBy(nilUUID(userID)).
+				Save(ctx)
+			switch {
+			case err == nil:
+				entryID = e.ID
+				result = StocktakeScanUnexpected
+			case ent.IsConstraintError(err):
+				// The item was scanned concurrently, its entry is updated instead
+				existing, err = entryOf()
+				if err == nil && existing == nil {
+					err = &ent.NotFoundError{}
+				}
+				if err != nil {
+					return StocktakeScanOut{}, err
+				}
+			default:
+				return StocktakeScanOut{}, err
+			}
+
+			if existing == nil {
+				break
+			}
+		}
+
+		where := data.LocationID
+		if where == uuid.Nil && existing.ExpectedLocationID != nil {
+			where = *existing.ExpectedLocationID
+		}
+
+		err = existing.Update().
+			SetValue(value).
+			SetFoundAt(now).
+			SetNillableFoundLocationID(nilUUID(where)).
+			SetNillableFoundBy(nilUUID(userID)).
+			Exec(ctx)
+		if err != nil {
+			return StocktakeScanOut{}, err
+		}
+
+		entryID = existing.ID
+		switch {
+		case !existing.Expected:
+			result = StocktakeScanUnexpected
+		case existing.ExpectedLocationID != nil && where != *existing.ExpectedLocationID:
+			result = StocktakeScanMisplaced
+		default:
+			result = StocktakeScanFound
+		}
+	}
+
+	entries, err := r.entries(ctx, gid, s.ID, stocktakeentry.ID(entryID))
+	if err != nil {
+		return StocktakeScanOut{}, err
+	}
+
+	summary, err := r.withDetails(ctx, []*ent.StocktakeSession{s})
+	if err != nil {
+		return StocktakeScanOut{}, err
+	}
+
+	return StocktakeScanOut{
+		Result:   result,
+		Entry:    entries[0],
+		Progress: summary[0].Progress,
+	}, nil
+}
+
+func nilUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+// entries returns the entries of the stocktake with their items and locations.
+func (r *StocktakeRepository) entries(ctx context.Context, gid, id uuid.UUID, where ...predicate.StocktakeEntry) ([]StocktakeEntryOut, error) {
+	entries, err := r.db.StocktakeEntry.Query().
+		Where(stocktakeentry.SessionID(id)).
+		Where(where...).
+		WithItem(func(q *ent.ItemQuery) {
+			q.WithLocation().WithLabel()
+		}).
+		Order(ent.Asc(stocktakeentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(entries))
+	for _, e := range entries {
+		if e.ExpectedLocationID != nil {
+			ids = append(ids, *e.ExpectedLocationID)
+		}
+		if e.FoundLocationID != nil {
+			ids = append(ids, *e.FoundLocationID)
+		}
+	}
+
+	locations, err := r.db.Location.Query().
+		Where(
+			location.IDIn(ids...),
+			location.HasGroupWith(group.ID(gid)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]LocationSummary, len(locations))
+	for _, l := range locations {
+		byID[l.ID] = mapLocationSummary(l)
+	}
+
+	lookup := func(id *uuid.UUID) *LocationSummary {
+		if id == nil {
+			return nil
+		}
+		if l, ok := byID[*id]; ok {
+			return &l
+		}
+		return nil
+	}
+
+	out := make([]StocktakeEntryOut, len(entries))
+	for i, e := range entries {
+		out[i] = StocktakeEntryOut{
+			ID:               e.ID,
+			ItemID:           e.ItemID,
+			Value:            e.Value,
+			Expected:         e.Expected,
+			ExpectedLocation: lookup(e.ExpectedLocationID),
+			FoundLocation:    lookup(e.FoundLocationID),
+			FoundAt:          e.FoundAt,
+			OnLoan:           e.OnLoan,
+		}
+		if e.Edges.Item != nil {
+			summary := mapItemSummary(e.Edges.Item)
+			out[i].Item = &summary
+		}
+	}
+
+	return out, nil
+}
+
+// onLoan returns which of the items are on loan.
+func onLoan(ctx context.Context, db *ent.Client, gid uuid.UUID, ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+	loans, err := db.Loan.Query().
+		Where(
+			loan.HasGroupWith(group.ID(gid)),
+			loan.HasItemWith(item.IDIn(ids...)),
+			loan.ReturnedAtIsNil(),
+		).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uuid.UUID]bool, len(loans))
+	for _, l := range loans {
+		if l.Edges.Item != nil {
+			out[l.Edges.Item.ID] = true
+		}
+	}
+
+	return out, nil
+}
+
+// Report sorts the items of the stocktake by what was found. While the stocktake is
+// open the items on loan are looked up as they are now, closing it keeps them as they
+// were when it closed.
+func (r *StocktakeRepository) Report(ctx context.Context, gid, id uuid.UUID) (StocktakeReport, error) {
+	s, err := r.getSession(ctx, gid, id)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	summary, err := r.withDetails(ctx, []*ent.StocktakeSession{s})
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	entries, err := r.entries(ctx, gid, id)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	if StocktakeStatus(s.Status) == StocktakeStatusOpen {
+		var missing []uuid.UUID
+		for _, e := range entries {
+			if e.Expected && e.FoundAt == nil && e.ItemID != nil {
+				missing = append(missing, *e.ItemID)
+			}
+		}
+
+		loaned, err := onLoan(ctx, r.db, gid, missing)
+		if err != nil {
+			return StocktakeReport{}, err
+		}
+
+		for i, e := range entries {
+			if e.ItemID != nil && e.FoundAt == nil {
+				entries[i].OnLoan = loaned[*e.ItemID]
+			}
+		}
+	}
+
+	report := StocktakeReport{
+		Stocktake: summary[0],
+		Found:     []StocktakeEntryOut{},
+		Missing:   []StocktakeEntryOut{},
+		Misplaced: []StocktakeEntryOut{},
+		OnLoan:    []StocktakeEntryOut{},
+		Unknown:   []StocktakeEntryOut{},
+	}
+
+	for _, e := range entries {
+		switch {
+		case e.ItemID == nil:
+			report.Unknown = append(report.Unknown, e)
+		case e.FoundAt == nil && e.OnLoan:
+			report.OnLoan = append(report.OnLoan, e)
+		case e.FoundAt == nil:
+			report.Missing = append(report.Missing, e)
+		case e.misplaced():
+			report.Misplaced = append(report.Misplaced, e)
+		default:
+			report.Found = append(report.Found, e)
+		}
+	}
+
+	return report, nil
+}
+
+// Close ends the stocktake and returns its report. Missing items on loan are excused.
+// With the options misplaced items are moved to where they were scanned and missing
+// items marked lost, items that can't be lost from their current status are left as
+// they are.
+func (r *StocktakeRepository) Close(ctx context.Context, gid, id, userID uuid.UUID, data StocktakeClose) (StocktakeReport, error) {
+	s, err := r.getSession(ctx, gid, id)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// The status is part of the condition, so that a stocktake is closed only once
+	q := tx.StocktakeSession.Update().
+		Where(
+			stocktakesession.ID(id),
+			stocktakesession.StatusEQ(stocktakesession.StatusOpen),
+		).
+		SetStatus(stocktakesession.StatusClosed).
+		SetClosedAt(time.Now())
+	if userID != uuid.Nil {
+		q.SetClosedBy(userID)
+	}
+
+	n, err := q.Save(ctx)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+	if n == 0 {
+		return StocktakeReport{}, ErrStocktakeClosed
+	}
+
+	missing, err := tx.StocktakeEntry.Query().
+		Where(
+			stocktakeentry.SessionID(id),
+			stocktakeentry.Expected(true),
+			stocktakeentry.FoundAtIsNil(),
+			stocktakeentry.ItemIDNotNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	ids := make([]uuid.UUID, len(missing))
+	for i, e := range missing {
+		ids[i] = *e.ItemID
+	}
+
+	loaned, err := onLoan(ctx, tx.Client(), gid, ids)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	var excused []uuid.UUID
+	for itemID := range loaned {
+		excused = append(excused, itemID)
+	}
+
+	err = tx.StocktakeEntry.Update().
+		Where(
+			stocktakeentry.SessionID(id),
+			stocktakeentry.ItemIDIn(excused...),
+			stocktakeentry.FoundAtIsNil(),
+		).
+		SetOnLoan(true).
+		Exec(ctx)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	moved := 0
+	if data.MoveMisplaced {
+		found, err := tx.StocktakeEntry.Query().
+			Where(
+				stocktakeentry.SessionID(id),
+				stocktakeentry.FoundAtNotNil(),
+				stocktakeentry.FoundLocationIDNotNil(),
+				stocktakeentry.ItemIDNotNil(),
+			).
+			All(ctx)
+		if err != nil {
+			return StocktakeReport{}, err
+		}
+
+		for _, e := range found {
+			if e.ExpectedLocationID != nil && *e.ExpectedLocationID == *e.FoundLocationID {
+				continue
+			}
+
+			n, err := tx.Item.Update().
+				Where(
+					item.ID(*e.ItemID),
+					item.HasGroupWith(group.ID(gid)),
+				).
+				SetLocationID(*e.FoundLocationID).
+				Save(ctx)
+			if err != nil {
+				return StocktakeReport{}, err
+			}
+			moved += n
+		}
+	}
+
+	lost := 0
+	if data.MarkMissingLost {
+		for _, e := range missing {
+			if loaned[*e.ItemID] {
+				continue
+			}
+
+			err := transitionStatus(ctx, tx, gid, *e.ItemID, ItemStatusTransition{
+				Status: ItemStatusLost,
+				Reason: fmt.Sprintf(stocktakeLostReason, s.Name),
+			})
+			switch {
+			case err == nil:
+				lost++
+			case errors.Is(err, ErrInvalidStatusTransition), ent.IsNotFound(err):
+				// Found or written off some other way since the stocktake started
+			default:
+				return StocktakeReport{}, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return StocktakeReport{}, err
+	}
+	committed = true
+
+	if moved > 0 || lost > 0 {
+		r.publishMutationEvent(gid)
+	}
+
+	report, err := r.Report(ctx, gid, id)
+	if err != nil {
+		return StocktakeReport{}, err
+	}
+
+	report.Moved = moved
+	report.MarkedLost = lost
+	return report, nil
+}
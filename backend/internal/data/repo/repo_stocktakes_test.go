@@ -0,0 +1,153 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStocktakeRepository(t *testing.T) {
+	ctx := context.Background()
+	locs := useLocations(t, 2)
+	room, elsewhere := locs[0], locs[1]
+
+	shelf, err := tRepos.Locations.Create(ctx, tGroup.ID, LocationCreate{Name: fk.Str(10), ParentID: room.ID})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Locations.delete(context.Background(), shelf.ID)
+	})
+
+	useTagged := func(locationID uuid.UUID) (ItemOut, string) {
+		itm := itemFactory()
+		itm.LocationID = locationID
+
+		out, err := tRepos.Items.Create(ctx, tGroup.ID, itm)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = tRepos.Items.Delete(context.Background(), out.ID)
+		})
+
+		tag := "ST-" + fk.Str(8)
+		err = tRepos.Identifiers.SetForItem(ctx, tGroup.ID, out.ID, []ItemIdentifierCreate{
+			{Type: IdentifierTypeLegacyTag, Value: tag},
+		})
+		require.NoError(t, err)
+
+		return out, tag
+	}
+
+	found, foundTag := useTagged(room.ID)
+	moved, movedTag := useTagged(shelf.ID)
+	missing, _ := useTagged(shelf.ID)
+	loaned, _ := useTagged(room.ID)
+	stray, strayTag := useTagged(elsewhere.ID)
+
+	st, err := tRepos.Stocktakes.Create(ctx, tGroup.ID, tUser.ID, StocktakeCreate{
+		Name:       "Autumn term",
+		LocationID: room.ID,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Stocktakes.Delete(context.Background(), tGroup.ID, st.ID)
+	})
+
+	assert.Equal(t, StocktakeStatusOpen, st.Status)
+	assert.Equal(t, 4, st.Progress.Expected, "items of nested locations are expected")
+	assert.Equal(t, tUser.Name, st.StartedByName)
+
+	scan := func(value string, locationID uuid.UUID) StocktakeScanOut {
+		out, err := tRepos.Stocktakes.Scan(ctx, tGroup.ID, st.ID, tUser.ID, StocktakeScan{Value: value, LocationID: locationID})
+		require.NoError(t, err)
+		return out
+	}
+
+	assert.Equal(t, StocktakeScanFound, scan(foundTag, uuid.Nil).Result)
+	assert.Equal(t, StocktakeScanMisplaced, scan(movedTag, room.ID).Result)
+	assert.Equal(t, StocktakeScanUnexpected, scan(strayTag, shelf.ID).Result)
+	assert.Equal(t, StocktakeScanUnknown, scan("no-such-tag", uuid.Nil).Result)
+
+	// Scanning again counts once
+	out := scan(foundTag, room.ID)
+	assert.Equal(t, StocktakeScanFound, out.Result)
+	assert.Equal(t, StocktakeProgress{Expected: 4, Found: 2, Unexpected: 1, Unknown: 1}, out.Progress)
+
+	_, err = tRepos.Stocktakes.Scan(ctx, tGroup.ID, st.ID, tUser.ID, StocktakeScan{Value: foundTag, LocationID: elsewhere.ID})
+	require.ErrorIs(t, err, ErrLocationOutsideStocktake)
+
+	b := useBorrower(t, borrowerFactory())
+	l, err := tRepos.Loans.Create(ctx, tGroup.ID, tUser.ID, LoanCreate{ItemID: loaned.ID, BorrowerID: b.ID, DueAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Loans.DeleteByGroup(context.Background(), tGroup.ID, l.ID)
+	})
+
+	ids := func(entries []StocktakeEntryOut) []uuid.UUID {
+		out := make([]uuid.UUID, len(entries))
+		for i, e := range entries {
+			out[i] = *e.ItemID
+		}
+		return out
+	}
+
+	report, err := tRepos.Stocktakes.Report(ctx, tGroup.ID, st.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{found.ID}, ids(report.Found))
+	assert.ElementsMatch(t, []uuid.UUID{moved.ID, stray.ID}, ids(report.Misplaced))
+	assert.Equal(t, []uuid.UUID{missing.ID}, ids(report.Missing))
+	assert.Equal(t, []uuid.UUID{loaned.ID}, ids(report.OnLoan), "items on loan are excused")
+	require.Len(t, report.Unknown, 1)
+	assert.Equal(t, "no-such-tag", report.Unknown[0].Value)
+
+	report, err = tRepos.Stocktakes.Close(ctx, tGroup.ID, st.ID, tUser.ID, StocktakeClose{
+		MoveMisplaced:   true,
+		MarkMissingLost: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StocktakeStatusClosed, report.Stocktake.Status)
+	assert.NotNil(t, report.Stocktake.ClosedAt)
+	assert.Equal(t, 2, report.Moved)
+	assert.Equal(t, 1, report.MarkedLost)
+	assert.Equal(t, []uuid.UUID{loaned.ID}, ids(report.OnLoan))
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, moved.ID)
+	require.NoError(t, err)
+	assert.Equal(t, room.ID, got.Location.ID)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, stray.ID)
+	require.NoError(t, err)
+	assert.Equal(t, shelf.ID, got.Location.ID)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, missing.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ItemStatusLost, got.Status)
+
+	got, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, loaned.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ItemStatusInService, got.Status)
+
+	_, err = tRepos.Stocktakes.Scan(ctx, tGroup.ID, st.ID, tUser.ID, StocktakeScan{Value: foundTag})
+	require.ErrorIs(t, err, ErrStocktakeClosed)
+
+	_, err = tRepos.Stocktakes.Close(ctx, tGroup.ID, st.ID, tUser.ID, StocktakeClose{})
+	require.ErrorIs(t, err, ErrStocktakeClosed)
+}
+
+func TestStocktakeRepository_CreateLarge(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 2*stocktakeEntryBatch+1)
+
+	st, err := tRepos.Stocktakes.Create(ctx, tGroup.ID, tUser.ID, StocktakeCreate{
+		Name:       fk.Str(10),
+		LocationID: items[0].Location.ID,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.Stocktakes.Delete(context.Background(), tGroup.ID, st.ID)
+	})
+
+	assert.Equal(t, len(items), st.Progress.Expected, "every batch of entries is inserted")
+}
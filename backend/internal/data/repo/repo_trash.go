@@ -0,0 +1,315 @@
+package repo
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+)
+
+// TrashRepository lists, restores and purges the items and locations that were
+// deleted. Everywhere else they are hidden by softDeleteInterceptor.
+type TrashRepository struct {
+	db          *ent.Client
+	bus         *eventbus.EventBus
+	attachments *AttachmentRepo
+	locations   *LocationRepository
+}
+
+// ErrTrashParentDeleted is returned when restoring an entity whose location or parent
+// item is still in the trash. The parent has to be restored first.
+var ErrTrashParentDeleted = errors.New("the parent location or item is in the trash")
+
+type TrashEntry struct {
+	ID          uuid.UUID `json:"id"`
+	Type        ItemType  `json:"type"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	DeletedAt   time.Time `json:"deletedAt"`
+}
+
+func (r *TrashRepository) publishMutationEvents(gid uuid.UUID) {
+	if r.bus != nil {
+		r.bus.Publish(eventbus.EventItemMutation, eventbus.GroupMutationEvent{GID: gid})
+		r.bus.Publish(eventbus.EventLocationMutation, eventbus.GroupMutationEvent{GID: gid})
+	}
+}
+
+// GetAll returns the trashed items and locations of the group, most recently deleted first.
+func (r *TrashRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]TrashEntry, error) {
+	ctx = withDeleted(ctx)
+
+	items, err := r.db.Item.Query().
+		Where(item.HasGroupWith(group.ID(gid)), item.DeletedAtNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := r.db.Location.Query().
+		Where(location.HasGroupWith(group.ID(gid)), location.DeletedAtNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]TrashEntry, 0, len(items)+len(locations))
+	for _, itm := range items {
+		out = append(out, TrashEntry{
+			ID:          itm.ID,
+			Type:        ItemTypeItem,
+			Name:        itm.Name,
+			Description: itm.Description,
+			DeletedAt:   *itm.DeletedAt,
+		})
+	}
+	for _, loc := range locations {
+		out = append(out, TrashEntry{
+			ID:          loc.ID,
+			Type:        ItemTypeLocation,
+			Name:        loc.Name,
+			Description: loc.Description,
+			DeletedAt:   *loc.DeletedAt,
+		})
+	}
+
+	slices.SortFunc(out, func(a, b TrashEntry) int {
+		return cmp.Or(b.DeletedAt.Compare(a.DeletedAt), cmp.Compare(a.Name, b.Name))
+	})
+
+	return out, nil
+}
+
+// RestoreItem takes an item out of the trash, along with the items nested below it
+// that were deleted with it.
+func (r *TrashRepository) RestoreItem(ctx context.Context, gid, id uuid.UUID) error {
+	ctx = withDeleted(ctx)
+
+	itm, err := r.db.Item.Query().
+		Where(
+			item.ID(id),
+			item.HasGroupWith(group.ID(gid)),
+			item.DeletedAtNotNil(),
+		).
+		WithLocation().
+		WithParent().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if itm.Edges.Location != nil && itm.Edges.Location.DeletedAt != nil {
+		return ErrTrashParentDeleted
+	}
+	if itm.Edges.Parent != nil && itm.Edges.Parent.DeletedAt != nil {
+		return ErrTrashParentDeleted
+	}
+
+	children, err := itemSubtreeIDs(ctx, r.db.Item, []uuid.UUID{id})
+	if err != nil {
+		return err
+	}
+
+	err = r.db.Item.Update().
+		Where(item.IDIn(append(children, id)...), item.DeletedAt(*itm.DeletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.publishMutationEvents(gid)
+	return nil
+}
+
+// RestoreLocation takes a location out of the trash, along with the nested locations
+// and items that were deleted with it.
+func (r *TrashRepository) RestoreLocation(ctx context.Context, gid, id uuid.UUID) error {
+	ctx = withDeleted(ctx)
+
+	loc, err := r.db.Location.Query().
+		Where(
+			location.ID(id),
+			location.HasGroupWith(group.ID(gid)),
+			location.DeletedAtNotNil(),
+		).
+		WithParent().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if loc.Edges.Parent != nil && loc.Edges.Parent.DeletedAt != nil {
+		return ErrTrashParentDeleted
+	}
+
+	ids, err := r.locations.SubtreeIDs(ctx, gid, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during location restore")
+			}
+		}
+	}()
+
+	err = tx.Location.Update().
+		Where(location.IDIn(ids...), location.DeletedAt(*loc.DeletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	items, err := tx.Item.Query().Where(item.HasLocationWith(location.IDIn(ids...))).IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	children, err := itemSubtreeIDs(ctx, tx.Item, items)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Item.Update().
+		Where(item.IDIn(append(items, children...)...), item.DeletedAt(*loc.DeletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+
+	r.publishMutationEvents(gid)
+	return nil
+}
+
+// Purge permanently deletes the items and locations of every group that were moved
+// to the trash before the given time, along with their attachments. It returns the
+// number of deleted entities.
+func (r *TrashRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	ctx = withDeleted(ctx)
+
+	locIDs, err := r.db.Location.Query().
+		Where(location.DeletedAtLT(before)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// The items of purged locations would be removed by the database anyway, but
+	// deleting them here also removes their attachments and records them in the audit log
+	itemIDs, err := r.db.Item.Query().
+		Where(item.Or(
+			item.DeletedAtLT(before),
+			item.HasLocationWith(location.IDIn(locIDs...)),
+		)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(locIDs) == 0 && len(itemIDs) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during trash purge")
+			}
+		}
+	}()
+
+	paths, err := purgeItems(ctx, tx, itemIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	locs, err := tx.Location.Delete().Where(location.IDIn(locIDs...)).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	committed = true
+
+	if len(paths) > 0 {
+		r.attachments.deleteUnreferencedFiles(ctx, paths)
+	}
+
+	return len(itemIDs) + locs, nil
+}
+
+// purgeItems deletes the items along with their attachments and the thumbnails of those.
+// It returns the paths of the attachment files, which are removed once the transaction
+// commits.
+func purgeItems(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	atts, err := tx.Attachment.Query().
+		Where(attachment.HasItemWith(item.IDIn(ids...))).
+		WithThumbnail().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		paths  []string
+		thumbs []uuid.UUID
+	)
+	for _, att := range atts {
+		paths = append(paths, att.Path)
+		if att.Edges.Thumbnail != nil {
+			paths = append(paths, att.Edges.Thumbnail.Path)
+			thumbs = append(thumbs, att.Edges.Thumbnail.ID)
+		}
+	}
+
+	_, err = tx.Attachment.Delete().Where(attachment.HasItemWith(item.IDIn(ids...))).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(thumbs) > 0 {
+		_, err = tx.Attachment.Delete().Where(attachment.IDIn(thumbs...)).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Item.Delete().Where(item.IDIn(ids...)).Exec(ctx)
+	return paths, err
+}
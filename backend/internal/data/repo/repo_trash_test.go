@@ -0,0 +1,221 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+)
+
+func trashContains(t *testing.T, id uuid.UUID) bool {
+	entries, err := tRepos.Trash.GetAll(context.Background(), tGroup.ID)
+	require.NoError(t, err)
+
+	for _, e := range entries {
+		if e.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTrashRepository_RestoreItem(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	err := tRepos.Items.DeleteByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+
+	// Trashed items are hidden from queries and cannot be changed
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.Error(t, err)
+
+	all, err := tRepos.Items.GetAll(ctx, tGroup.ID)
+	require.NoError(t, err)
+	for _, i := range all {
+		assert.NotEqual(t, itm.ID, i.ID)
+	}
+
+	quantity := itm.Quantity + 5
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{Quantity: &quantity})
+	require.True(t, ent.IsNotFound(err), "patching a trashed item: %v", err)
+
+	assert.True(t, trashContains(t, itm.ID))
+
+	err = tRepos.Trash.RestoreItem(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, itm.Quantity, got.Quantity, "the trashed item was not patched")
+	assert.False(t, trashContains(t, itm.ID))
+
+	// Restoring an item that is not in the trash fails
+	err = tRepos.Trash.RestoreItem(ctx, tGroup.ID, itm.ID)
+	require.Error(t, err)
+}
+
+func TestTrashRepository_RestoreLocation(t *testing.T) {
+	ctx := context.Background()
+
+	parent, err := tRepos.Locations.Create(ctx, tGroup.ID, LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+	child, err := tRepos.Locations.Create(ctx, tGroup.ID, LocationCreate{Name: fk.Str(10), ParentID: parent.ID})
+	require.NoError(t, err)
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{Name: fk.Str(10), LocationID: child.ID})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		_ = tRepos.Locations.delete(context.Background(), child.ID)
+		_ = tRepos.Locations.delete(context.Background(), parent.ID)
+	})
+
+	err = tRepos.Locations.DeleteByGroup(ctx, tGroup.ID, parent.ID)
+	require.NoError(t, err)
+
+	// The nested location and its items go to the trash with the parent
+	_, err = tRepos.Locations.GetOneByGroup(ctx, tGroup.ID, child.ID)
+	require.Error(t, err)
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.Error(t, err)
+
+	locs, err := tRepos.Locations.GetAll(ctx, tGroup.ID, LocationQuery{})
+	require.NoError(t, err)
+	for _, l := range locs {
+		assert.NotEqual(t, parent.ID, l.ID)
+		assert.NotEqual(t, child.ID, l.ID)
+	}
+
+	// The item cannot be restored into a trashed location
+	err = tRepos.Trash.RestoreItem(ctx, tGroup.ID, itm.ID)
+	require.ErrorIs(t, err, ErrTrashParentDeleted)
+	err = tRepos.Trash.RestoreLocation(ctx, tGroup.ID, child.ID)
+	require.ErrorIs(t, err, ErrTrashParentDeleted)
+
+	err = tRepos.Trash.RestoreLocation(ctx, tGroup.ID, parent.ID)
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+	assert.Equal(t, child.ID, got.Location.ID)
+
+	_, err = tRepos.Locations.GetOneByGroup(ctx, tGroup.ID, child.ID)
+	require.NoError(t, err)
+}
+
+func TestTrashRepository_RestoreItemChildren(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 3)
+	parent, child, grandchild := items[0], items[1], items[2]
+
+	_, err := tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{ID: child.ID, Name: child.Name, LocationID: child.Location.ID, ParentID: parent.ID})
+	require.NoError(t, err)
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{ID: grandchild.ID, Name: grandchild.Name, LocationID: grandchild.Location.ID, ParentID: child.ID})
+	require.NoError(t, err)
+
+	err = tRepos.Items.DeleteByGroup(ctx, tGroup.ID, parent.ID)
+	require.NoError(t, err)
+
+	// The nested items go to the trash with their parent
+	for _, itm := range items {
+		_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+		require.Error(t, err)
+	}
+
+	err = tRepos.Trash.RestoreItem(ctx, tGroup.ID, child.ID)
+	require.ErrorIs(t, err, ErrTrashParentDeleted)
+
+	err = tRepos.Trash.RestoreItem(ctx, tGroup.ID, parent.ID)
+	require.NoError(t, err)
+
+	for _, itm := range items {
+		_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+		require.NoError(t, err)
+	}
+}
+
+func TestTrashRepository_DeleteDeepLocation(t *testing.T) {
+	ctx := context.Background()
+
+	// Nested deeper than the location tree is displayed
+	var locs []LocationOut
+	parentID := uuid.Nil
+	for range 12 {
+		loc, err := tRepos.Locations.Create(ctx, tGroup.ID, LocationCreate{Name: fk.Str(10), ParentID: parentID})
+		require.NoError(t, err)
+		locs = append(locs, loc)
+		parentID = loc.ID
+	}
+
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{Name: fk.Str(10), LocationID: parentID})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		for i := len(locs) - 1; i >= 0; i-- {
+			_ = tRepos.Locations.delete(context.Background(), locs[i].ID)
+		}
+	})
+
+	err = tRepos.Locations.DeleteByGroup(ctx, tGroup.ID, locs[0].ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Locations.GetOneByGroup(ctx, tGroup.ID, parentID)
+	require.Error(t, err)
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.Error(t, err)
+
+	err = tRepos.Trash.RestoreLocation(ctx, tGroup.ID, locs[0].ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+}
+
+func TestItemsRepository_DeleteTrashed(t *testing.T) {
+	ctx := context.Background()
+	itm := useItems(t, 1)[0]
+
+	err := tRepos.Items.DeleteByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+
+	// A trashed item can still be deleted permanently
+	err = tRepos.Items.Delete(ctx, itm.ID)
+	require.NoError(t, err)
+	assert.False(t, trashContains(t, itm.ID))
+}
+
+func TestTrashRepository_Purge(t *testing.T) {
+	ctx := context.Background()
+	items := useItems(t, 2)
+
+	for _, itm := range items {
+		err := tRepos.Items.DeleteByGroup(ctx, tGroup.ID, itm.ID)
+		require.NoError(t, err)
+	}
+
+	// Entities deleted after the cutoff are kept
+	_, err := tRepos.Trash.Purge(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.True(t, trashContains(t, items[0].ID))
+
+	n, err := tRepos.Trash.Purge(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, n, 2)
+
+	for _, itm := range items {
+		assert.False(t, trashContains(t, itm.ID))
+	}
+
+	// The deletion is still recorded in the item's history
+	history, err := tRepos.Audit.GetByEntity(ctx, tGroup.ID, AuditEntityItem, items[0].ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, history)
+	assert.Equal(t, "delete", history[0].Action)
+	assert.Contains(t, history[1].Changes, "deleted_at")
+}
@@ -9,36 +9,72 @@ import (
 
 // AllRepos is a container for all the repository interfaces
 type AllRepos struct {
-	Users         *UserRepository
-	AuthTokens    *TokenRepository
-	Groups        *GroupRepository
-	Locations     *LocationRepository
-	Labels        *LabelRepository
-	Items         *ItemsRepository
-	ItemTemplates *ItemTemplatesRepository
-	Attachments   *AttachmentRepo
-	MaintEntry    *MaintenanceEntryRepository
-	Notifiers     *NotifierRepository
-	Borrowers     *BorrowerRepository
-	Loans         *LoanRepository
-	KioskSessions *KioskSessionRepository
+	Users            *UserRepository
+	AuthTokens       *TokenRepository
+	Groups           *GroupRepository
+	Locations        *LocationRepository
+	Labels           *LabelRepository
+	Items            *ItemsRepository
+	ItemTemplates    *ItemTemplatesRepository
+	Attachments      *AttachmentRepo
+	MaintEntry       *MaintenanceEntryRepository
+	Notifiers        *NotifierRepository
+	Borrowers        *BorrowerRepository
+	Loans            *LoanRepository
+	KioskSessions    *KioskSessionRepository
+	KioskSync        *KioskSyncRepository
+	SavedSearches    *SavedSearchRepository
+	Audit            *AuditRepository
+	Trash            *TrashRepository
+	FieldDefinitions *FieldDefinitionRepository
+	StockMovements   *StockMovementRepository
+	Identifiers      *ItemIdentifierRepository
+	Stocktakes       *StocktakeRepository
+	LabelTemplates   *LabelTemplateRepository
+	Printers         *PrinterRepository
+	PrintJobs        *PrintJobRepository
 }
 
 func New(db *ent.Client, bus *eventbus.EventBus, storage config.Storage, pubSubConn string, thumbnail config.Thumbnail) *AllRepos {
 	attachments := &AttachmentRepo{db, storage, pubSubConn, thumbnail}
+
+	// Trashed items and locations are hidden everywhere except in the trash
+	db.Intercept(softDeleteInterceptor())
+	db.Use(softDeleteHook())
+
+	// Record changes to the audited entities, whichever repository makes them
+	db.Use(auditHook())
+
+	// Record every change to the quantity of an item in the stock ledger
+	db.Item.Use(stockHook())
+
+	locations := &LocationRepository{db, bus}
+	identifiers := &ItemIdentifierRepository{db}
+
 	return &AllRepos{
-		Users:         &UserRepository{db},
-		AuthTokens:    &TokenRepository{db},
-		Groups:        NewGroupRepository(db),
-		Locations:     &LocationRepository{db, bus},
-		Labels:        &LabelRepository{db, bus},
-		Items:         &ItemsRepository{db, bus, attachments},
-		ItemTemplates: &ItemTemplatesRepository{db, bus},
-		Attachments:   attachments,
-		MaintEntry:    &MaintenanceEntryRepository{db},
-		Notifiers:     NewNotifierRepository(db),
-		Borrowers:     &BorrowerRepository{db, bus},
-		Loans:         &LoanRepository{db, bus},
-		KioskSessions: &KioskSessionRepository{db},
+		Users:            &UserRepository{db},
+		AuthTokens:       &TokenRepository{db},
+		Groups:           NewGroupRepository(db),
+		Locations:        locations,
+		Labels:           &LabelRepository{db, bus},
+		Items:            &ItemsRepository{db, bus, attachments, &itemSearchIndex{db: db}},
+		ItemTemplates:    &ItemTemplatesRepository{db, bus},
+		Attachments:      attachments,
+		MaintEntry:       &MaintenanceEntryRepository{db},
+		Notifiers:        NewNotifierRepository(db),
+		Borrowers:        &BorrowerRepository{db, bus},
+		Loans:            &LoanRepository{db, bus},
+		KioskSessions:    &KioskSessionRepository{db},
+		KioskSync:        &KioskSyncRepository{db},
+		SavedSearches:    &SavedSearchRepository{db},
+		Audit:            &AuditRepository{db},
+		Trash:            &TrashRepository{db, bus, attachments, locations},
+		FieldDefinitions: &FieldDefinitionRepository{db},
+		StockMovements:   &StockMovementRepository{db},
+		Identifiers:      identifiers,
+		Stocktakes:       &StocktakeRepository{db, bus, locations, identifiers},
+		LabelTemplates:   &LabelTemplateRepository{db},
+		Printers:         &PrinterRepository{db},
+		PrintJobs:        &PrintJobRepository{db, bus},
 	}
 }
@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/location"
+)
+
+type withDeletedKey struct{}
+
+// withDeleted returns a context whose queries and mutations also see items and
+// locations in the trash. It is only used by the trash itself.
+func withDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withDeletedKey{}, true)
+}
+
+func includesDeleted(ctx context.Context) bool {
+	v, _ := ctx.Value(withDeletedKey{}).(bool)
+	return v
+}
+
+// trashTime is the deleted_at of entities moved to the trash. It is truncated to what
+// every database stores, so the entities trashed together can be found by their time.
+func trashTime() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// softDeleteInterceptor excludes trashed items and locations from every query,
+// including eager loaded edges.
+func softDeleteInterceptor() ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if includesDeleted(ctx) {
+			return nil
+		}
+
+		switch q := q.(type) {
+		case *ent.ItemQuery:
+			q.Where(item.DeletedAtIsNil())
+		case *ent.LocationQuery:
+			q.Where(location.DeletedAtIsNil())
+		}
+
+		return nil
+	})
+}
+
+// softDeleteHook keeps updates and deletes from touching trashed items and locations,
+// so a trashed entity can only be changed by restoring it first.
+func softDeleteHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpCreate) || includesDeleted(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			switch m := m.(type) {
+			case *ent.ItemMutation:
+				m.Where(item.DeletedAtIsNil())
+			case *ent.LocationMutation:
+				m.Where(location.DeletedAtIsNil())
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// trashItem moves the item of the group to the trash along with the items nested below
+// it, all at the time now so that they are restored together. It returns the IDs of
+// the nested items.
+func trashItem(ctx context.Context, tx *ent.Tx, gid, id uuid.UUID, now time.Time) ([]uuid.UUID, error) {
+	err := tx.Item.UpdateOneID(id).
+		Where(item.HasGroupWith(group.ID(gid))).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := itemSubtreeIDs(ctx, tx.Item, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) > 0 {
+		err = tx.Item.Update().Where(item.IDIn(ids...)).SetDeletedAt(now).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// itemSubtreeIDs returns the IDs of the items nested below the roots, at any depth.
+// Without withDeleted, items already in the trash and their children are left out.
+func itemSubtreeIDs(ctx context.Context, items *ent.ItemClient, roots []uuid.UUID) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]struct{}, len(roots))
+	for _, id := range roots {
+		seen[id] = struct{}{}
+	}
+
+	var ids []uuid.UUID
+	parents := roots
+	for len(parents) > 0 {
+		children, err := items.Query().
+			Where(item.HasParentWith(item.IDIn(parents...))).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The seen check keeps a cycle in the parents from looping forever
+		start := len(ids)
+		for _, id := range children {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+		parents = ids[start:]
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,156 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/hook"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/item"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// stockLevel is the quantity of an item together with its group.
+type stockLevel struct {
+	group    uuid.UUID
+	quantity int
+}
+
+// errStockNoTx is returned for quantity changes made outside of a transaction, whose
+// ledger rows could fail to be written after the quantity is saved.
+var errStockNoTx = errors.New("stock: quantity changes must be made in a transaction")
+
+// stockHook records every change to the quantity of an item in the stock ledger, in
+// the transaction of the change. Callers have to open it, the hook can't move the
+// mutation into one. Quantities are read before and after the mutation so that
+// updates of many items are recorded per item, the rows are locked in between on
+// Postgres. SQLite fails one of two overlapping writes with a busy error instead.
+func stockHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ItemFunc(func(ctx context.Context, m *ent.ItemMutation) (ent.Value, error) {
+			_, set := m.Quantity()
+			added, isAdd := m.AddedQuantity()
+			// Created items start with the default quantity when none is set
+			if !set && !isAdd && !m.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+
+			if _, err := m.Tx(); err != nil {
+				return nil, errStockNoTx
+			}
+
+			client := m.Client()
+
+			var before map[uuid.UUID]stockLevel
+			if !m.Op().Is(ent.OpCreate) {
+				ids, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				before, err = stockLevels(ctx, client, ids, true)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			movement := stockMovementFrom(ctx)
+
+			if m.Op().Is(ent.OpCreate) {
+				itm, ok := v.(*ent.Item)
+				if !ok || itm.Quantity == 0 {
+					return v, nil
+				}
+
+				gid, ok := m.GroupID()
+				if !ok {
+					gid = auditActorFrom(ctx).GroupID
+				}
+
+				return v, movement.write(ctx, client, gid, itm.ID, itm.Quantity, itm.Quantity)
+			}
+
+			ids := make([]uuid.UUID, 0, len(before))
+			for id := range before {
+				ids = append(ids, id)
+			}
+
+			after, err := stockLevels(ctx, client, ids, false)
+			if err != nil {
+				return v, err
+			}
+
+			for id, old := range before {
+				now, ok := after[id]
+				if !ok {
+					continue
+				}
+
+				// Additions are recorded as they were made, so that concurrent issues
+				// of the same item each record their own delta
+				delta := now.quantity - old.quantity
+				if isAdd && !set {
+					delta = added
+				}
+
+				if delta == 0 {
+					continue
+				}
+
+				if err := movement.write(ctx, client, old.group, id, delta, now.quantity); err != nil {
+					return v, err
+				}
+			}
+
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// stockLevels reads the quantities of the items, locking their rows until the end of
+// the transaction when lock is set.
+func stockLevels(ctx context.Context, client *ent.Client, ids []uuid.UUID, lock bool) (map[uuid.UUID]stockLevel, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	where := []predicate.Item{item.IDIn(ids...)}
+	if lock {
+		where = append(where, func(s *sql.Selector) {
+			// SQLite has no row locks, see stockHook
+			if s.Dialect() != dialect.SQLite {
+				s.ForUpdate()
+			}
+		})
+	}
+
+	items, err := client.Item.Query().
+		Where(where...).
+		Select(item.FieldID, item.FieldQuantity).
+		WithGroup(func(q *ent.GroupQuery) {
+			q.Select(group.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uuid.UUID]stockLevel, len(items))
+	for _, itm := range items {
+		if itm.Edges.Group == nil {
+			continue
+		}
+		out[itm.ID] = stockLevel{group: itm.Edges.Group.ID, quantity: itm.Quantity}
+	}
+
+	return out, nil
+}
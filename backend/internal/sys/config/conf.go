@@ -31,6 +31,9 @@ type Config struct {
 	LabelMaker LabelMakerConf `yaml:"labelmaker"`
 	Thumbnail  Thumbnail      `yaml:"thumbnail"`
 	Barcode    BarcodeAPIConf `yaml:"barcode"`
+	Borrowers  BorrowerConf   `yaml:"borrowers"`
+	Kiosk      KioskConf      `yaml:"kiosk"`
+	Trash      TrashConf      `yaml:"trash"`
 }
 
 type Options struct {
@@ -71,6 +74,9 @@ type LabelMakerConf struct {
 	Margin                int64          `yaml:"margin"    conf:"default:32"`
 	FontSize              float64        `yaml:"font_size" conf:"default:32.0"`
 	PrintCommand          *string        `yaml:"string"`
+	PrintAddress          *string        `yaml:"print_address"` // takes the place of PrintCommand when both are set
+	PrintLanguage         string         `yaml:"print_language" conf:"default:zpl"`
+	PrinterDPI            int64          `yaml:"printer_dpi"    conf:"default:203"`
 	AdditionalInformation *string        `yaml:"string"`
 	DynamicLength         bool           `yaml:"bool"      conf:"default:true"`
 	LabelServiceUrl       *string        `yaml:"label_service_url"`
@@ -101,6 +107,25 @@ type BarcodeAPIConf struct {
 	TokenBarcodespider string `yaml:"token_barcodespider"`
 }
 
+// BorrowerConf controls how borrowers that register themselves at a kiosk are verified
+// before they are allowed to check out equipment.
+type BorrowerConf struct {
+	RequireEmailVerification bool          `yaml:"require_email_verification" conf:"default:false"`
+	RequireApproval          bool          `yaml:"require_approval"           conf:"default:false"`
+	VerificationExpiry       time.Duration `yaml:"verification_expiry"        conf:"default:48h"`
+}
+
+// KioskConf controls how the server monitors kiosk devices through their heartbeats.
+type KioskConf struct {
+	IdleTimeout  time.Duration `yaml:"idle_timeout"  conf:"default:5m"`
+	OfflineAfter time.Duration `yaml:"offline_after" conf:"default:15m"`
+}
+
+// TrashConf controls how long deleted items and locations can be restored before they are purged.
+type TrashConf struct {
+	RetentionDays int `yaml:"retention_days" conf:"default:30"`
+}
+
 // New parses the CLI/Config file and returns a Config struct. If the file argument is an empty string, the
 // file is not read. If the file is not empty, the file is read and the Config struct is returned.
 func New(buildstr string, description string) (*Config, error) {
@@ -0,0 +1,456 @@
+package labelmaker
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// Symbology is the kind of code printed on a label.
+type Symbology string
+
+const (
+	SymbologyQR         Symbology = "qr"
+	SymbologyDataMatrix Symbology = "datamatrix"
+	SymbologyCode128    Symbology = "code128"
+	SymbologyNone       Symbology = "none"
+)
+
+// square reports whether the code is drawn as a square next to the text, rather than
+// as a strip below it.
+func (s Symbology) square() bool {
+	return s == "" || s == SymbologyQR || s == SymbologyDataMatrix
+}
+
+// renderSquareCode draws a QR or Data Matrix code of the data in a square of the size.
+func renderSquareCode(s Symbology, data string, size int) (image.Image, error) {
+	if s == SymbologyDataMatrix {
+		modules, err := encodeDataMatrix(data)
+		if err != nil {
+			return nil, err
+		}
+		return renderMatrix(modules, size)
+	}
+
+	qr, err := qrcode.New(data, qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	qr.DisableBorder = true
+	return qr.Image(size), nil
+}
+
+// renderMatrix draws the modules of a 2D code, scaled by a whole number to fit the size
+// so that all modules are equally wide, centered in the square.
+func renderMatrix(modules [][]bool, size int) (image.Image, error) {
+	n := len(modules)
+	scale := size / n
+	if scale < 1 {
+		return nil, fmt.Errorf("label too small for a %dx%d code", n, n)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+
+	offset := (size - n*scale) / 2
+	for y, row := range modules {
+		for x, dark := range row {
+			if !dark {
+				continue
+			}
+			r := image.Rect(offset+x*scale, offset+y*scale, offset+(x+1)*scale, offset+(y+1)*scale)
+			draw.Draw(img, r, &image.Uniform{C: color.Black}, image.Point{}, draw.Src)
+		}
+	}
+
+	return img, nil
+}
+
+// renderCode128 draws a Code 128 barcode of the data filling the height, with bars of
+// whole pixels as wide as fit the width.
+func renderCode128(data string, width, height int) (image.Image, error) {
+	widths, err := encodeCode128(data)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+
+	scale := width / total
+	if scale < 1 {
+		return nil, fmt.Errorf("%q is too long for a Code 128 barcode on this label", data)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, total*scale, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+
+	x := 0
+	for i, w := range widths {
+		// Bars and spaces alternate, starting with a bar
+		if i%2 == 0 {
+			draw.Draw(img, image.Rect(x, 0, x+w*scale, height), &image.Uniform{C: color.Black}, image.Point{}, draw.Src)
+		}
+		x += w * scale
+	}
+
+	return img, nil
+}
+
+// code128Patterns are the bar and space widths of the Code 128 symbols by value, the
+// last one being the stop pattern.
+var code128Patterns = [...]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+const (
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+)
+
+// code128Values encodes the data as Code 128 symbol values, in code set C when it is
+// an even number of digits and code set B otherwise, with the start and check symbols.
+func code128Values(data string) ([]int, error) {
+	if data == "" {
+		return nil, fmt.Errorf("no data to encode")
+	}
+
+	digits := len(data)%2 == 0
+	for _, c := range data {
+		if c < '0' || c > '9' {
+			digits = false
+		}
+		if c < ' ' || c > '~' {
+			return nil, fmt.Errorf("code 128 can't encode %q", c)
+		}
+	}
+
+	var values []int
+	if digits {
+		values = append(values, code128StartC)
+		for i := 0; i < len(data); i += 2 {
+			values = append(values, int(data[i]-'0')*10+int(data[i+1]-'0'))
+		}
+	} else {
+		values = append(values, code128StartB)
+		for i := 0; i < len(data); i++ {
+			values = append(values, int(data[i])-' ')
+		}
+	}
+
+	check := values[0]
+	for i, v := range values[1:] {
+		check += (i + 1) * v
+	}
+
+	return append(values, check%103, code128Stop), nil
+}
+
+// encodeCode128 returns the widths of the alternating bars and spaces of the data's
+// barcode, in modules.
+func encodeCode128(data string) ([]int, error) {
+	values, err := code128Values(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var widths []int
+	for _, v := range values {
+		for _, w := range code128Patterns[v] {
+			widths = append(widths, int(w-'0'))
+		}
+	}
+
+	return widths, nil
+}
+
+// dataMatrixSize is an ECC 200 square symbol size with a single error correction block.
+type dataMatrixSize struct {
+	size       int // modules per side
+	regions    int // data regions per side
+	dataWords  int
+	checkWords int
+}
+
+var dataMatrixSizes = []dataMatrixSize{
+	{10, 1, 3, 5},
+	{12, 1, 5, 7},
+	{14, 1, 8, 10},
+	{16, 1, 12, 12},
+	{18, 1, 18, 14},
+	{20, 1, 22, 18},
+	{22, 1, 30, 20},
+	{24, 1, 36, 24},
+	{26, 1, 44, 28},
+	{32, 2, 62, 36},
+	{36, 2, 86, 42},
+	{40, 2, 114, 48},
+	{44, 2, 144, 56},
+	{48, 2, 174, 68},
+}
+
+// encodeDataMatrix returns the modules of the smallest square ECC 200 Data Matrix that
+// holds the data, true for dark modules.
+func encodeDataMatrix(data string) ([][]bool, error) {
+	words := dataMatrixASCII(data)
+
+	var sz dataMatrixSize
+	for _, s := range dataMatrixSizes {
+		if len(words) <= s.dataWords {
+			sz = s
+			break
+		}
+	}
+	if sz.size == 0 {
+		return nil, fmt.Errorf("data too long for a Data Matrix code")
+	}
+
+	// Pad the data, the pads after the first being scrambled by their position
+	encoded := len(words)
+	for i := encoded; i < sz.dataWords; i++ {
+		pad := 129
+		if i > encoded {
+			pad = 129 + (149*(i+1))%253 + 1
+			if pad > 254 {
+				pad -= 254
+			}
+		}
+		words = append(words, byte(pad))
+	}
+
+	words = append(words, reedSolomon(words, sz.checkWords)...)
+
+	regionSize := (sz.size - 2*sz.regions) / sz.regions
+	mapping := dataMatrixPlacement(regionSize*sz.regions, regionSize*sz.regions, words)
+
+	modules := make([][]bool, sz.size)
+	for i := range modules {
+		modules[i] = make([]bool, sz.size)
+	}
+
+	// Each data region is framed by a solid L on its left and bottom edges and a
+	// clock track of alternating modules on its top and right edges
+	block := regionSize + 2
+	for y := 0; y < sz.size; y++ {
+		for x := 0; x < sz.size; x++ {
+			ly, lx := y%block, x%block
+			switch {
+			case lx == 0 || ly == block-1:
+				modules[y][x] = true
+			case ly == 0:
+				modules[y][x] = lx%2 == 0
+			case lx == block-1:
+				modules[y][x] = ly%2 == 1
+			default:
+				modules[y][x] = mapping[(y/block)*regionSize+ly-1][(x/block)*regionSize+lx-1]
+			}
+		}
+	}
+
+	return modules, nil
+}
+
+// dataMatrixASCII encodes the data in ASCII mode, pairs of digits sharing a codeword.
+func dataMatrixASCII(data string) []byte {
+	b := []byte(data)
+	words := make([]byte, 0, len(b))
+
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case isDigit(c) && i+1 < len(b) && isDigit(b[i+1]):
+			words = append(words, 130+(c-'0')*10+(b[i+1]-'0'))
+			i++
+		case c >= 128:
+			// Upper shift
+			words = append(words, 235, c-127)
+		default:
+			words = append(words, c+1)
+		}
+	}
+
+	return words
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// reedSolomon returns the error correction codewords of the data in the Galois field
+// of 256 elements with the prime polynomial 301 that Data Matrix uses.
+func reedSolomon(data []byte, n int) []byte {
+	exp := make([]byte, 512)
+	log := make([]int, 256)
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(x)
+		log[x] = i
+		x <<= 1
+		if x >= 256 {
+			x ^= 301
+		}
+	}
+	for i := 255; i < 512; i++ {
+		exp[i] = exp[i-255]
+	}
+
+	mul := func(a, b byte) byte {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return exp[log[a]+log[b]]
+	}
+
+	// The generator polynomial (x + a^1)(x + a^2)...(x + a^n), highest degree first
+	gen := []byte{1}
+	for i := 1; i <= n; i++ {
+		next := make([]byte, len(gen)+1)
+		for j, c := range gen {
+			next[j] ^= c
+			next[j+1] ^= mul(c, exp[i])
+		}
+		gen = next
+	}
+
+	ecc := make([]byte, n)
+	for _, d := range data {
+		m := d ^ ecc[0]
+		copy(ecc, ecc[1:])
+		ecc[n-1] = 0
+		for i := range ecc {
+			ecc[i] ^= mul(m, gen[i+1])
+		}
+	}
+
+	return ecc
+}
+
+// dataMatrixPlacement places the bits of the codewords in the mapping matrix of the
+// data regions, following the diagonal pattern of ISO/IEC 16022 Annex F.
+func dataMatrixPlacement(nrow, ncol int, words []byte) [][]bool {
+	// Cells hold 0 while empty, else 10 times the codeword number plus the bit number
+	cells := make([][]int, nrow)
+	for i := range cells {
+		cells[i] = make([]int, ncol)
+	}
+
+	module := func(row, col, chr, bit int) {
+		if row < 0 {
+			row += nrow
+			col += 4 - ((nrow + 4) % 8)
+		}
+		if col < 0 {
+			col += ncol
+			row += 4 - ((ncol + 4) % 8)
+		}
+		cells[row][col] = 10*chr + bit
+	}
+
+	utah := func(row, col, chr int) {
+		module(row-2, col-2, chr, 1)
+		module(row-2, col-1, chr, 2)
+		module(row-1, col-2, chr, 3)
+		module(row-1, col-1, chr, 4)
+		module(row-1, col, chr, 5)
+		module(row, col-2, chr, 6)
+		module(row, col-1, chr, 7)
+		module(row, col, chr, 8)
+	}
+
+	corner := func(chr int, positions [8][2]int) {
+		for i, p := range positions {
+			module(p[0], p[1], chr, i+1)
+		}
+	}
+
+	chr := 1
+	row, col := 4, 0
+	for {
+		switch {
+		case row == nrow && col == 0:
+			corner(chr, [8][2]int{{nrow - 1, 0}, {nrow - 1, 1}, {nrow - 1, 2}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}, {2, ncol - 1}, {3, ncol - 1}})
+			chr++
+		case row == nrow-2 && col == 0 && ncol%4 != 0:
+			corner(chr, [8][2]int{{nrow - 3, 0}, {nrow - 2, 0}, {nrow - 1, 0}, {0, ncol - 4}, {0, ncol - 3}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}})
+			chr++
+		case row == nrow-2 && col == 0 && ncol%8 == 4:
+			corner(chr, [8][2]int{{nrow - 3, 0}, {nrow - 2, 0}, {nrow - 1, 0}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}, {2, ncol - 1}, {3, ncol - 1}})
+			chr++
+		case row == nrow+4 && col == 2 && ncol%8 == 0:
+			corner(chr, [8][2]int{{nrow - 1, 0}, {nrow - 1, ncol - 1}, {0, ncol - 3}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 3}, {1, ncol - 2}, {1, ncol - 1}})
+			chr++
+		}
+
+		// Sweep up and to the right
+		for {
+			if row >= 0 && row < nrow && col >= 0 && col < ncol && cells[row][col] == 0 {
+				utah(row, col, chr)
+				chr++
+			}
+			row -= 2
+			col += 2
+			if row < 0 || col >= ncol {
+				break
+			}
+		}
+		row++
+		col += 3
+
+		// Sweep down and to the left
+		for {
+			if row >= 0 && row < nrow && col >= 0 && col < ncol && cells[row][col] == 0 {
+				utah(row, col, chr)
+				chr++
+			}
+			row += 2
+			col -= 2
+			if row >= nrow || col < 0 {
+				break
+			}
+		}
+		row += 3
+		col++
+
+		if row >= nrow && col >= ncol {
+			break
+		}
+	}
+
+	out := make([][]bool, nrow)
+	for r := range out {
+		out[r] = make([]bool, ncol)
+		for c := range out[r] {
+			v := cells[r][c]
+			if v == 0 {
+				continue
+			}
+			word, bit := words[v/10-1], v%10
+			out[r][c] = word>>(8-bit)&1 == 1
+		}
+	}
+
+	// Symbols whose mapping matrix leaves the bottom right corner empty fill it with a
+	// fixed pattern
+	if cells[nrow-1][ncol-1] == 0 {
+		out[nrow-1][ncol-1] = true
+		out[nrow-2][ncol-2] = true
+	}
+
+	return out
+}
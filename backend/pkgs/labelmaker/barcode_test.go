@@ -0,0 +1,291 @@
+package labelmaker
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCode128(t *testing.T) {
+	for v, p := range code128Patterns[:code128Stop] {
+		sum := 0
+		for _, w := range p {
+			sum += int(w - '0')
+		}
+		assert.Equal(t, 11, sum, "pattern %d", v)
+	}
+
+	values, err := code128Values("PJJ123C")
+	require.NoError(t, err)
+	assert.Equal(t, []int{code128StartB, 48, 42, 42, 17, 18, 19, 35, 55, code128Stop}, values)
+
+	// Even runs of digits pack two to a symbol
+	values, err = code128Values("004217")
+	require.NoError(t, err)
+	assert.Equal(t, []int{code128StartC, 0, 42, 17, (105 + 42*2 + 17*3) % 103, code128Stop}, values)
+
+	_, err = code128Values("tab\there")
+	require.Error(t, err)
+
+	widths, err := encodeCode128("HB-0001")
+	require.NoError(t, err)
+	sum := 0
+	for _, w := range widths {
+		sum += w
+	}
+	assert.Equal(t, 11*(len("HB-0001")+2)+13, sum)
+}
+
+func TestDataMatrix(t *testing.T) {
+	// The example of ISO/IEC 16022
+	words := dataMatrixASCII("123456")
+	assert.Equal(t, []byte{142, 164, 186}, words)
+	assert.Equal(t, []byte{114, 25, 5, 88, 102}, reedSolomon(words, 5))
+
+	tests := []struct {
+		data string
+		size int
+	}{
+		{"123456", 10},
+		{"HB-0001", 12},
+		{"https://homebox.example.com/item/0b6a3c1e-0d4b-4b1e-9f3a-2f0a7c7b1d2e", 36},
+	}
+
+	for _, tc := range tests {
+		modules, err := encodeDataMatrix(tc.data)
+		require.NoError(t, err)
+		require.Len(t, modules, tc.size, tc.data)
+
+		n := tc.size
+		for i := 0; i < n; i++ {
+			assert.True(t, modules[i][0], "solid left edge")
+			assert.True(t, modules[n-1][i], "solid bottom edge")
+			assert.Equal(t, i%2 == 0, modules[0][i], "alternating top edge")
+			assert.Equal(t, i%2 == 1, modules[i][n-1], "alternating right edge")
+		}
+	}
+
+	// Reading the ISO example back gives its codewords
+	modules, err := encodeDataMatrix("123456")
+	require.NoError(t, err)
+	words = readDataMatrix(t, modules)
+	assert.Equal(t, []byte{142, 164, 186}, words)
+
+	for _, data := range []string{
+		"HB-0001",
+		"0042",
+		"Caf\xe9 table",
+		strings.Repeat("HB-0001 ", 10),
+		"https://homebox.example.com/item/0b6a3c1e-0d4b-4b1e-9f3a-2f0a7c7b1d2e",
+	} {
+		modules, err := encodeDataMatrix(data)
+		require.NoError(t, err)
+		assert.Equal(t, data, decodeDataMatrixASCII(t, readDataMatrix(t, modules)))
+	}
+
+	_, err = encodeDataMatrix(strings.Repeat("x", 200))
+	require.Error(t, err)
+}
+
+func TestGenerateLabel_Symbologies(t *testing.T) {
+	for _, s := range []Symbology{SymbologyQR, SymbologyDataMatrix, SymbologyCode128, SymbologyNone} {
+		t.Run(string(s), func(t *testing.T) {
+			params := NewGenerateParams(526, 200, 32, 32, 32, "", "", "https://homebox.example.com/a/HB-0001", true, nil)
+			params.Symbology = s
+			params.CodeText = "HB-0001"
+			params.Lines = []TextLine{
+				{Text: "HB-0001", FontSize: 32, Bold: true},
+				{Text: "Cordless Drill", FontSize: 24},
+				{Text: "Workshop / Shelf 2", FontSize: 18},
+			}
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, GenerateLabel(buf, &params, nil))
+
+			img, err := png.Decode(buf)
+			require.NoError(t, err)
+			assert.Equal(t, 526, img.Bounds().Dx())
+		})
+	}
+
+	params := NewGenerateParams(526, 200, 32, 32, 32, "Title", "", "https://homebox.example.com/item/0b6a3c1e-0d4b-4b1e-9f3a-2f0a7c7b1d2e", true, nil)
+	params.Symbology = SymbologyCode128
+	err := GenerateLabel(&bytes.Buffer{}, &params, nil)
+	require.Error(t, err, "long URLs don't fit a Code 128 barcode")
+
+	params.Symbology = "ean13"
+	require.Error(t, GenerateLabel(&bytes.Buffer{}, &params, nil))
+}
+
+// readDataMatrix reads the codewords of a square ECC 200 symbol back out of its modules,
+// walking the mapping matrix the way a reader does rather than the way the encoder
+// places the bits.
+func readDataMatrix(t *testing.T, modules [][]bool) []byte {
+	t.Helper()
+
+	var sz dataMatrixSize
+	for _, s := range dataMatrixSizes {
+		if s.size == len(modules) {
+			sz = s
+		}
+	}
+	require.NotZero(t, sz.size, "unknown symbol size %d", len(modules))
+
+	// Drop the finder pattern and clock tracks of each region
+	block := sz.size / sz.regions
+	n := (block - 2) * sz.regions
+	mapping := make([][]bool, 0, n)
+	for y := range modules {
+		if y%block == 0 || y%block == block-1 {
+			continue
+		}
+		row := make([]bool, 0, n)
+		for x := range modules[y] {
+			if x%block != 0 && x%block != block-1 {
+				row = append(row, modules[y][x])
+			}
+		}
+		mapping = append(mapping, row)
+	}
+
+	read := make([][]bool, n)
+	for i := range read {
+		read[i] = make([]bool, n)
+	}
+
+	bit := func(row, col int) byte {
+		if row < 0 {
+			row += n
+			col += 4 - ((n + 4) % 8)
+		}
+		if col < 0 {
+			col += n
+			row += 4 - ((n + 4) % 8)
+		}
+		read[row][col] = true
+		if mapping[row][col] {
+			return 1
+		}
+		return 0
+	}
+
+	word := func(cells ...[2]int) byte {
+		var w byte
+		for _, c := range cells {
+			w = w<<1 | bit(c[0], c[1])
+		}
+		return w
+	}
+
+	utah := func(r, c int) byte {
+		return word([2]int{r - 2, c - 2}, [2]int{r - 2, c - 1}, [2]int{r - 1, c - 2}, [2]int{r - 1, c - 1},
+			[2]int{r - 1, c}, [2]int{r, c - 2}, [2]int{r, c - 1}, [2]int{r, c})
+	}
+
+	var words []byte
+	row, col := 4, 0
+	for row < n || col < n {
+		switch {
+		case row == n && col == 0:
+			words = append(words, word([2]int{n - 1, 0}, [2]int{n - 1, 1}, [2]int{n - 1, 2}, [2]int{0, n - 2},
+				[2]int{0, n - 1}, [2]int{1, n - 1}, [2]int{2, n - 1}, [2]int{3, n - 1}))
+		case row == n-2 && col == 0 && n%4 != 0:
+			words = append(words, word([2]int{n - 3, 0}, [2]int{n - 2, 0}, [2]int{n - 1, 0}, [2]int{0, n - 4},
+				[2]int{0, n - 3}, [2]int{0, n - 2}, [2]int{0, n - 1}, [2]int{1, n - 1}))
+		case row == n+4 && col == 2 && n%8 == 0:
+			words = append(words, word([2]int{n - 1, 0}, [2]int{n - 1, n - 1}, [2]int{0, n - 3}, [2]int{0, n - 2},
+				[2]int{0, n - 1}, [2]int{1, n - 3}, [2]int{1, n - 2}, [2]int{1, n - 1}))
+		case row == n-2 && col == 0 && n%8 == 4:
+			words = append(words, word([2]int{n - 3, 0}, [2]int{n - 2, 0}, [2]int{n - 1, 0}, [2]int{0, n - 2},
+				[2]int{0, n - 1}, [2]int{1, n - 1}, [2]int{2, n - 1}, [2]int{3, n - 1}))
+		}
+
+		for ; row >= 0 && col < n; row, col = row-2, col+2 {
+			if row < n && col >= 0 && !read[row][col] {
+				words = append(words, utah(row, col))
+			}
+		}
+		row, col = row+1, col+3
+
+		for ; row < n && col >= 0; row, col = row+2, col-2 {
+			if row >= 0 && col < n && !read[row][col] {
+				words = append(words, utah(row, col))
+			}
+		}
+		row, col = row+3, col+1
+	}
+
+	require.Len(t, words, sz.dataWords+sz.checkWords)
+
+	// A valid codeword sequence is a multiple of the generator polynomial, so it
+	// vanishes at each of the roots a^1 to a^n
+	exp := make([]int, 255)
+	x := 1
+	for i := range exp {
+		exp[i] = x
+		x <<= 1
+		if x >= 256 {
+			x ^= 301
+		}
+	}
+	mul := func(a, b int) int {
+		p := 0
+		for ; b > 0; b >>= 1 {
+			if b&1 == 1 {
+				p ^= a
+			}
+			a <<= 1
+			if a >= 256 {
+				a ^= 301
+			}
+		}
+		return p
+	}
+	for i := 1; i <= sz.checkWords; i++ {
+		s := 0
+		for _, w := range words {
+			s = mul(s, exp[i]) ^ int(w)
+		}
+		assert.Zero(t, s, "syndrome %d", i)
+	}
+
+	return words[:sz.dataWords]
+}
+
+// decodeDataMatrixASCII decodes ASCII mode codewords, checking the scrambled pads that
+// follow the data.
+func decodeDataMatrixASCII(t *testing.T, words []byte) string {
+	t.Helper()
+
+	var out []byte
+	for i := 0; i < len(words); i++ {
+		w := int(words[i])
+		switch {
+		case w == 129:
+			for j := i + 1; j < len(words); j++ {
+				pad := int(words[j]) - ((149*(j+1))%253 + 1)
+				if pad < 1 {
+					pad += 254
+				}
+				assert.Equal(t, 129, pad, "pad %d", j)
+			}
+			return string(out)
+		case w <= 128:
+			out = append(out, byte(w-1))
+		case w <= 229:
+			out = append(out, fmt.Sprintf("%02d", w-130)...)
+		case w == 235:
+			i++
+			out = append(out, words[i]+127)
+		default:
+			t.Fatalf("unexpected codeword %d", w)
+		}
+	}
+
+	return string(out)
+}
@@ -0,0 +1,139 @@
+package labelmaker
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// IPP value tags of the attributes of a Print-Job request.
+const (
+	ippTagOperation     = 0x01
+	ippTagEnd           = 0x03
+	ippTagName          = 0x42
+	ippTagURI           = 0x45
+	ippTagCharset       = 0x47
+	ippTagLanguage      = 0x48
+	ippTagMimeMediaType = 0x49
+)
+
+const ippOperationPrintJob = 0x0002
+
+// ippStatuses are the descriptions of the common error statuses of IPP printers.
+var ippStatuses = map[uint16]string{
+	0x0400: "bad request",
+	0x0401: "forbidden",
+	0x0402: "not authenticated",
+	0x0403: "not authorized",
+	0x0406: "printer not found",
+	0x040A: "document format not supported",
+	0x0500: "internal error",
+	0x0506: "not accepting jobs",
+	0x0507: "busy",
+}
+
+// ippRequest encodes the header and operation attributes of an IPP/1.1 Print-Job
+// request, after which the document follows.
+func ippRequest(printerURI, jobName, format string) []byte {
+	buf := &bytes.Buffer{}
+
+	// Version 1.1, the operation and the request ID
+	buf.Write([]byte{1, 1})
+	_ = binary.Write(buf, binary.BigEndian, uint16(ippOperationPrintJob))
+	_ = binary.Write(buf, binary.BigEndian, uint32(1))
+
+	attr := func(tag byte, name, value string) {
+		buf.WriteByte(tag)
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(name)))
+		buf.WriteString(name)
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(value)))
+		buf.WriteString(value)
+	}
+
+	// The charset, language and printer come first and in this order
+	buf.WriteByte(ippTagOperation)
+	attr(ippTagCharset, "attributes-charset", "utf-8")
+	attr(ippTagLanguage, "attributes-natural-language", "en")
+	attr(ippTagURI, "printer-uri", printerURI)
+	attr(ippTagName, "requesting-user-name", "homebox")
+	if jobName != "" {
+		attr(ippTagName, "job-name", jobName)
+	}
+	attr(ippTagMimeMediaType, "document-format", format)
+	buf.WriteByte(ippTagEnd)
+
+	return buf.Bytes()
+}
+
+// SendIPP sends the document to the printer at the ipp://, ipps:// or http(s):// URL as
+// a Print-Job request.
+func SendIPP(ctx context.Context, printerURL, jobName, format string, document []byte) error {
+	u, err := url.Parse(printerURL)
+	if err != nil {
+		return fmt.Errorf("invalid printer URL: %w", err)
+	}
+
+	// IPP is HTTP on port 631 unless the URL has another
+	endpoint := *u
+	switch u.Scheme {
+	case "ipp":
+		endpoint.Scheme = "http"
+	case "ipps":
+		endpoint.Scheme = "https"
+	case "http", "https":
+	default:
+		return fmt.Errorf("invalid printer URL %q, expected ipp://", printerURL)
+	}
+	if u.Port() == "" && (u.Scheme == "ipp" || u.Scheme == "ipps") {
+		endpoint.Host = net.JoinHostPort(u.Hostname(), "631")
+	}
+
+	printerURI := *u
+	if u.Scheme == "http" {
+		printerURI.Scheme = "ipp"
+	} else if u.Scheme == "https" {
+		printerURI.Scheme = "ipps"
+	}
+
+	body := append(ippRequest(printerURI.String(), jobName, format), document...)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/ipp")
+
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send job to printer %s: %w", u.Host, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("printer %s returned HTTP status %d", u.Host, resp.StatusCode)
+	}
+
+	// The version and status open the response, statuses below 0x0100 are successful
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(resp.Body, header); err != nil {
+		return fmt.Errorf("invalid response from printer %s: %w", u.Host, err)
+	}
+
+	status := binary.BigEndian.Uint16(header[2:4])
+	if status >= 0x0100 {
+		desc, ok := ippStatuses[status]
+		if !ok {
+			desc = fmt.Sprintf("status 0x%04x", status)
+		}
+		return fmt.Errorf("printer %s refused the job: %s", u.Host, desc)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@
 package labelmaker
 
 import (
+	"context"
 	"fmt"
 	"image"
 	"image/color"
@@ -20,7 +21,6 @@ import (
 
 	"github.com/golang/freetype"
 	"github.com/golang/freetype/truetype"
-	"github.com/skip2/go-qrcode"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
 	"golang.org/x/image/font"
 	"golang.org/x/image/font/gofont/gobold"
@@ -48,6 +48,39 @@ type GenerateParameters struct {
 	Dpi                   float64
 	URL                   string
 	DynamicLength         bool
+	// Symbology is the kind of code printed on the label, a QR code when empty
+	Symbology Symbology
+	// CodeText is what the code encodes instead of the URL when set, as Code 128
+	// barcodes are too wide for most URLs
+	CodeText string
+	// Lines replace the title and description when set, as label templates print
+	// their own choice of fields
+	Lines []TextLine
+}
+
+// TextLine is a block of text on a label, wrapped to the label's width.
+type TextLine struct {
+	Text     string
+	FontSize float64
+	Bold     bool
+}
+
+// textLines returns the blocks of text printed on the label, by default the title
+// followed by the description and the additional information.
+func (p *GenerateParameters) textLines() []TextLine {
+	if len(p.Lines) > 0 {
+		return p.Lines
+	}
+
+	bodyText := p.DescriptionText
+	if p.AdditionalInformation != nil {
+		bodyText = bodyText + "\n" + *p.AdditionalInformation
+	}
+
+	return []TextLine{
+		{Text: p.TitleText, FontSize: p.TitleFontSize, Bold: true},
+		{Text: bodyText, FontSize: p.DescriptionFontSize},
+	}
 }
 
 func (p *GenerateParameters) Validate() error {
@@ -63,6 +96,11 @@ func (p *GenerateParameters) Validate() error {
 	if p.ComponentPadding < 0 {
 		return fmt.Errorf("invalid component padding")
 	}
+	switch p.Symbology {
+	case "", SymbologyQR, SymbologyDataMatrix, SymbologyCode128, SymbologyNone:
+	default:
+		return fmt.Errorf("invalid symbology %q", p.Symbology)
+	}
 	return nil
 }
 
@@ -189,136 +227,217 @@ func loadFont(cfg *config.Config, fontType FontType) (*truetype.Font, error) {
 	return font, nil
 }
 
-func GenerateLabel(w io.Writer, params *GenerateParameters, cfg *config.Config) error {
-	if err := params.Validate(); err != nil {
-		return err
-	}
+// labelLayout is where the parts of a label go, shared by the image and the printer
+// language renderers.
+type labelLayout struct {
+	Width  int
+	Height int
+	// CodeText is what the code encodes
+	CodeText string
+	// Code is the square of a QR or Data Matrix code, empty without one
+	Code image.Rectangle
+	// Barcode is the strip of a Code 128 barcode, empty without one, with bars of
+	// multiples of ModuleWidth
+	Barcode     image.Rectangle
+	ModuleWidth int
+	Lines       []TextLine
+	Text        []placedText
+	fonts       []*truetype.Font
+}
 
-	// If LabelServiceUrl is configured, fetch the label from the URL instead of generating it
-	if cfg != nil && cfg.LabelMaker.LabelServiceUrl != nil && *cfg.LabelMaker.LabelServiceUrl != "" {
-		log.Printf("LabelServiceUrl configured: %s", *cfg.LabelMaker.LabelServiceUrl)
+// placedText is a line of text of one of the label's blocks, starting at the point on
+// its baseline and wrapped to the width.
+type placedText struct {
+	Block       int
+	Text        string
+	Pt          image.Point
+	Width       int
+	LineSpacing int
+}
 
-		return fetchLabelFromURL(w, *cfg.LabelMaker.LabelServiceUrl, params, cfg)
+// layoutLabel lays out the label, wrapping its text with the label maker's fonts.
+func layoutLabel(params *GenerateParameters, cfg *config.Config) (labelLayout, error) {
+	layout := labelLayout{
+		Width:    params.Width,
+		CodeText: params.URL,
+		Lines:    params.textLines(),
 	}
-
-	bodyText := params.DescriptionText
-	if params.AdditionalInformation != nil {
-		bodyText = bodyText + "\n" + *params.AdditionalInformation
+	if params.CodeText != "" {
+		layout.CodeText = params.CodeText
 	}
 
-	// Create QR code
-	qr, err := qrcode.New(params.URL, qrcode.Medium)
-	if err != nil {
-		return err
+	// QR and Data Matrix codes sit left of the text, Code 128 barcodes below it
+	codeSize := 0
+	if params.Symbology.square() {
+		codeSize = params.QrSize
+		layout.Code = image.Rect(params.Margin, params.Margin, codeSize+params.Margin, codeSize+params.Margin)
 	}
-	qr.DisableBorder = true
-	qrImage := qr.Image(params.QrSize)
 
 	regularFont, err := loadFont(cfg, FontTypeRegular)
 	if err != nil {
-		return err
+		return layout, err
 	}
 
 	boldFont, err := loadFont(cfg, FontTypeBold)
 	if err != nil {
-		return err
+		return layout, err
 	}
 
-	regularFace := truetype.NewFace(regularFont, &truetype.Options{
-		Size: params.DescriptionFontSize,
-		DPI:  params.Dpi,
-	})
-	boldFace := truetype.NewFace(boldFont, &truetype.Options{
-		Size: params.TitleFontSize,
-		DPI:  params.Dpi,
-	})
+	layout.fonts = make([]*truetype.Font, len(layout.Lines))
+	for i, line := range layout.Lines {
+		layout.fonts[i] = regularFont
+		if line.Bold {
+			layout.fonts[i] = boldFont
+		}
+	}
 
 	// Calculate text area dimensions
 	maxWidth := params.Width - (params.Margin * 2) - params.ComponentPadding
+	textXRight := params.Margin
+	if codeSize > 0 {
+		textXRight += params.ComponentPadding + codeSize
+	}
 
-	// Create temporary contexts for text measurement
+	// Lay out the text, the lines of each block next to the code until they no longer
+	// fit its height and below it from there on
 	tmpImg := image.NewRGBA(image.Rect(0, 0, 1, 1))
-	boldContext := createContext(boldFont, params.TitleFontSize, tmpImg, params.Dpi)
-	regularContext := createContext(regularFont, params.DescriptionFontSize, tmpImg, params.Dpi)
 
-	// Calculate total height needed
 	totalHeight := params.Margin
-	titleLineSpacing := boldContext.PointToFixed(params.TitleFontSize).Round()
-
-	titleLines, _ := wrapText(params.TitleText, boldFace, maxWidth-params.QrSize, -1, titleLineSpacing, boldContext)
-	titleHeight := titleLineSpacing * len(titleLines)
-	totalHeight += titleHeight
-
-	totalHeight += params.ComponentPadding / 4
-
-	regularLineSpacing := regularContext.PointToFixed(params.DescriptionFontSize).Round()
-	descriptionLinesRight, descriptionRemaining := wrapText(bodyText, regularFace, maxWidth-params.QrSize, params.QrSize-titleHeight, regularLineSpacing, regularContext)
-	totalHeight += regularLineSpacing * len(descriptionLinesRight)
-
-	var textYBottomText int
-	var descriptionLinesBottom []string
-	hasBottomText := descriptionRemaining != ""
-	if hasBottomText {
-		totalHeight = max(params.Margin+params.QrSize+params.ComponentPadding/2, totalHeight)
-		textYBottomText = totalHeight
-		descriptionLinesBottom, _ = wrapText(descriptionRemaining, regularFace, maxWidth, -1, regularLineSpacing, regularContext)
-		totalHeight += regularLineSpacing * len(descriptionLinesBottom)
+	textY := params.Margin - 8
+	heightRight := 0
+	hasBottomText := false
+	textYBottomText := 0
+
+	for i, line := range layout.Lines {
+		face := truetype.NewFace(layout.fonts[i], &truetype.Options{Size: line.FontSize, DPI: params.Dpi})
+		ctx := createContext(layout.fonts[i], line.FontSize, tmpImg, params.Dpi)
+		lineSpacing := ctx.PointToFixed(line.FontSize).Round()
+
+		if i > 0 {
+			totalHeight += params.ComponentPadding / 4
+			textY += params.ComponentPadding / 4
+		}
+
+		text := line.Text
+		if !hasBottomText {
+			// The first block always fits next to the code
+			maxHeight := -1
+			if codeSize > 0 && i > 0 {
+				maxHeight = max(0, codeSize-heightRight)
+			}
+
+			var right []string
+			right, text = wrapText(text, face, maxWidth-codeSize, maxHeight, lineSpacing, ctx)
+			for _, l := range right {
+				layout.Text = append(layout.Text, placedText{i, l, image.Pt(textXRight, textY+lineSpacing), maxWidth - codeSize, lineSpacing})
+				textY += lineSpacing
+			}
+			totalHeight += lineSpacing * len(right)
+			heightRight += lineSpacing * len(right)
+
+			if text == "" {
+				continue
+			}
+
+			hasBottomText = true
+			totalHeight = max(params.Margin+codeSize+params.ComponentPadding/2, totalHeight)
+			textYBottomText = totalHeight
+		}
+
+		bottom, _ := wrapText(text, face, maxWidth, -1, lineSpacing, ctx)
+		for _, l := range bottom {
+			layout.Text = append(layout.Text, placedText{i, l, image.Pt(params.Margin, textYBottomText+lineSpacing), maxWidth, lineSpacing})
+			textYBottomText += lineSpacing
+		}
+		totalHeight = textYBottomText
+	}
+
+	barHeight := 0
+	if params.Symbology == SymbologyCode128 {
+		widths, err := encodeCode128(layout.CodeText)
+		if err != nil {
+			return layout, err
+		}
+
+		modules := 0
+		for _, w := range widths {
+			modules += w
+		}
+
+		layout.ModuleWidth = (params.Width - params.Margin*2) / modules
+		if layout.ModuleWidth < 1 {
+			return layout, fmt.Errorf("%q is too long for a Code 128 barcode on this label", layout.CodeText)
+		}
+
+		barHeight = max(params.QrSize/2, 1)
+		totalHeight += params.ComponentPadding/2 + barHeight
+		layout.Barcode = image.Rect(0, 0, modules*layout.ModuleWidth, barHeight)
+	}
+
+	if hasBottomText || barHeight > 0 {
 		totalHeight += params.Margin
 	}
 
-	var requiredHeight int
 	if params.DynamicLength {
-		requiredHeight = max(totalHeight, params.QrSize+(params.Margin*2))
+		layout.Height = max(totalHeight, params.QrSize+(params.Margin*2))
 	} else {
-		requiredHeight = params.Height
+		layout.Height = params.Height
 	}
 
-	// Create the actual image with calculated height
-	bounds := image.Rect(0, 0, params.Width, requiredHeight)
-	img := image.NewRGBA(bounds)
-	draw.Draw(img, bounds, &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	if barHeight > 0 {
+		layout.Barcode = layout.Barcode.Add(image.Pt(params.Margin, layout.Height-params.Margin-barHeight))
+	}
 
-	// Draw QR code onto the image
-	draw.Draw(img,
-		image.Rect(params.Margin, params.Margin, params.QrSize+params.Margin, params.QrSize+params.Margin),
-		qrImage,
-		image.Point{},
-		draw.Over)
+	return layout, nil
+}
 
-	// Create final drawing contexts
-	boldContext = createContext(boldFont, params.TitleFontSize, img, params.Dpi)
-	regularContext = createContext(regularFont, params.DescriptionFontSize, img, params.Dpi)
+func GenerateLabel(w io.Writer, params *GenerateParameters, cfg *config.Config) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
 
-	textXRight := params.Margin + params.ComponentPadding + params.QrSize
-	textY := params.Margin - 8
+	// If LabelServiceUrl is configured, fetch the label from the URL instead of generating it
+	if cfg != nil && cfg.LabelMaker.LabelServiceUrl != nil && *cfg.LabelMaker.LabelServiceUrl != "" {
+		log.Printf("LabelServiceUrl configured: %s", *cfg.LabelMaker.LabelServiceUrl)
+
+		return fetchLabelFromURL(w, *cfg.LabelMaker.LabelServiceUrl, params, cfg)
+	}
+
+	layout, err := layoutLabel(params, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Create the actual image with calculated height
+	bounds := image.Rect(0, 0, layout.Width, layout.Height)
+	img := image.NewRGBA(bounds)
+	draw.Draw(img, bounds, &image.Uniform{C: color.White}, image.Point{}, draw.Src)
 
-	// Draw title
-	for _, line := range titleLines {
-		pt := freetype.Pt(textXRight, textY+titleLineSpacing)
-		if _, err = boldContext.DrawString(line, pt); err != nil {
+	// Draw the code onto the image
+	if !layout.Code.Empty() {
+		code, err := renderSquareCode(params.Symbology, layout.CodeText, layout.Code.Dx())
+		if err != nil {
 			return err
 		}
-		textY += titleLineSpacing
+		draw.Draw(img, layout.Code, code, image.Point{}, draw.Over)
 	}
-
-	// Draw description right from QR Code
-	textY += params.ComponentPadding / 4
-	for _, line := range descriptionLinesRight {
-		pt := freetype.Pt(textXRight, textY+regularLineSpacing)
-		if _, err = regularContext.DrawString(line, pt); err != nil {
+	if !layout.Barcode.Empty() {
+		barcode, err := renderCode128(layout.CodeText, layout.Barcode.Dx(), layout.Barcode.Dy())
+		if err != nil {
 			return err
 		}
-		textY += regularLineSpacing
+		draw.Draw(img, layout.Barcode, barcode, image.Point{}, draw.Over)
 	}
 
-	// Draw description below QR Code
-	if hasBottomText {
-		for _, line := range descriptionLinesBottom {
-			pt := freetype.Pt(params.Margin, textYBottomText+regularLineSpacing)
-			if _, err = regularContext.DrawString(line, pt); err != nil {
-				return err
-			}
-			textYBottomText += regularLineSpacing
+	// Create final drawing contexts
+	contexts := make([]*freetype.Context, len(layout.Lines))
+	for i, line := range layout.Lines {
+		contexts[i] = createContext(layout.fonts[i], line.FontSize, img, params.Dpi)
+	}
+
+	for _, t := range layout.Text {
+		if _, err = contexts[t.Block].DrawString(t.Text, freetype.Pt(t.Pt.X, t.Pt.Y)); err != nil {
+			return err
 		}
 	}
 
@@ -359,6 +478,7 @@ func fetchLabelFromURL(w io.Writer, serviceURL string, params *GenerateParameter
 	query.Set("Dpi", fmt.Sprintf("%f", params.Dpi))
 	query.Set("URL", params.URL)
 	query.Set("DynamicLength", fmt.Sprintf("%t", params.DynamicLength))
+	query.Set("Symbology", string(params.Symbology))
 
 	// Add AdditionalInformation if it exists
 	if params.AdditionalInformation != nil {
@@ -429,8 +549,56 @@ func fetchLabelFromURL(w io.Writer, serviceURL string, params *GenerateParameter
 	return nil
 }
 
+// PrintLabel prints the label, sending it to the configured printer address in its
+// language or otherwise running the print command with the label's PNG. The print
+// command isn't run when a printer address is set.
 func PrintLabel(cfg *config.Config, params *GenerateParameters) error {
-	tmpFile := filepath.Join(os.TempDir(), fmt.Sprintf("label-%d.png", time.Now().UnixNano()))
+	if cfg.LabelMaker.PrintAddress != nil && *cfg.LabelMaker.PrintAddress != "" {
+		return printRaw(context.Background(), cfg, params)
+	}
+
+	return printCommand(context.Background(), cfg, params, "")
+}
+
+// printCommand prints the label with the configured print command, handing it the
+// printer as {{.Printer}}.
+func printCommand(ctx context.Context, cfg *config.Config, params *GenerateParameters, printer string) error {
+	return withTempFile("png", func(f *os.File) error {
+		if err := GenerateLabel(f, params, cfg); err != nil {
+			return err
+		}
+
+		additionalInformation := func() string {
+			if params.AdditionalInformation != nil {
+				return *params.AdditionalInformation
+			}
+			return ""
+		}()
+
+		return runPrintCommand(ctx, cfg, map[string]string{
+			"FileName":              f.Name(),
+			"Printer":               printer,
+			"Width":                 fmt.Sprintf("%d", params.Width),
+			"Height":                fmt.Sprintf("%d", params.Height),
+			"QrSize":                fmt.Sprintf("%d", params.QrSize),
+			"Margin":                fmt.Sprintf("%d", params.Margin),
+			"ComponentPadding":      fmt.Sprintf("%d", params.ComponentPadding),
+			"TitleText":             params.TitleText,
+			"TitleFontSize":         fmt.Sprintf("%f", params.TitleFontSize),
+			"DescriptionText":       params.DescriptionText,
+			"DescriptionFontSize":   fmt.Sprintf("%f", params.DescriptionFontSize),
+			"AdditionalInformation": additionalInformation,
+			"Dpi":                   fmt.Sprintf("%f", params.Dpi),
+			"URL":                   params.URL,
+			"DynamicLength":         fmt.Sprintf("%t", params.DynamicLength),
+			"Symbology":             string(params.Symbology),
+		})
+	})
+}
+
+// withTempFile calls fn with a new temporary file of the extension, removing it after.
+func withTempFile(ext string, fn func(f *os.File) error) error {
+	tmpFile := filepath.Join(os.TempDir(), fmt.Sprintf("label-%d.%s", time.Now().UnixNano(), ext))
 	f, err := os.OpenFile(tmpFile, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
 	if err != nil {
 		return err
@@ -442,39 +610,18 @@ func PrintLabel(cfg *config.Config, params *GenerateParameters) error {
 		}
 	}()
 
-	err = GenerateLabel(f, params, cfg)
-	if err != nil {
-		return err
-	}
+	return fn(f)
+}
 
+// runPrintCommand runs the configured print command with the template variables.
+func runPrintCommand(ctx context.Context, cfg *config.Config, vars map[string]string) error {
 	if cfg.LabelMaker.PrintCommand == nil {
 		return fmt.Errorf("no print command specified")
 	}
 
 	commandTemplate := template.Must(template.New("command").Parse(*cfg.LabelMaker.PrintCommand))
 	builder := &strings.Builder{}
-	additionalInformation := func() string {
-		if params.AdditionalInformation != nil {
-			return *params.AdditionalInformation
-		}
-		return ""
-	}()
-	if err := commandTemplate.Execute(builder, map[string]string{
-		"FileName":              f.Name(),
-		"Width":                 fmt.Sprintf("%d", params.Width),
-		"Height":                fmt.Sprintf("%d", params.Height),
-		"QrSize":                fmt.Sprintf("%d", params.QrSize),
-		"Margin":                fmt.Sprintf("%d", params.Margin),
-		"ComponentPadding":      fmt.Sprintf("%d", params.ComponentPadding),
-		"TitleText":             params.TitleText,
-		"TitleFontSize":         fmt.Sprintf("%f", params.TitleFontSize),
-		"DescriptionText":       params.DescriptionText,
-		"DescriptionFontSize":   fmt.Sprintf("%f", params.DescriptionFontSize),
-		"AdditionalInformation": additionalInformation,
-		"Dpi":                   fmt.Sprintf("%f", params.Dpi),
-		"URL":                   params.URL,
-		"DynamicLength":         fmt.Sprintf("%t", params.DynamicLength),
-	}); err != nil {
+	if err := commandTemplate.Execute(builder, vars); err != nil {
 		return err
 	}
 
@@ -483,10 +630,13 @@ func PrintLabel(cfg *config.Config, params *GenerateParameters) error {
 		return nil
 	}
 
-	command := exec.Command(commandParts[0], commandParts[1:]...)
+	command := exec.CommandContext(ctx, commandParts[0], commandParts[1:]...)
 
-	_, err = command.CombinedOutput()
+	out, err := command.CombinedOutput()
 	if err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
 		return err
 	}
 
@@ -0,0 +1,121 @@
+package labelmaker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+// PrinterKind is how a printer is reached.
+type PrinterKind string
+
+const (
+	// PrinterCommand prints with the configured print command, which is handed the
+	// printer's address as {{.Printer}}, e.g. a CUPS queue for lp -d
+	PrinterCommand PrinterKind = "command"
+	// PrinterRaw sends ZPL or EPL to the printer's port 9100
+	PrinterRaw PrinterKind = "raw"
+	// PrinterIPP sends PDF documents over the Internet Printing Protocol
+	PrinterIPP PrinterKind = "ipp"
+)
+
+// Printer is a named printer that print jobs are sent to.
+type Printer struct {
+	Name string
+	Kind PrinterKind
+	// Address is the host and port of raw printers, the URL of IPP printers and the
+	// printer handed to the print command
+	Address string
+	// Language is the printer language of raw printers, ZPL by default
+	Language Format
+	// DPI is the resolution of raw printers, the configured one when 0
+	DPI int
+}
+
+// PrintJob is what is printed in one go, labels on their own or laid out on sheets.
+type PrintJob struct {
+	Labels []GenerateParameters `json:"labels"`
+	// Sheet lays the labels out on label sheets, for printers of whole pages
+	Sheet *SheetLayout `json:"sheet,omitempty"`
+	Skip  int          `json:"skip,omitempty"`
+}
+
+// Print prints the job. Raw printers print on rolls of labels, so they print the
+// labels of sheets one after the other as well.
+func (p Printer) Print(ctx context.Context, job PrintJob, cfg *config.Config) error {
+	if len(job.Labels) == 0 {
+		return fmt.Errorf("no labels to print")
+	}
+
+	switch p.Kind {
+	case PrinterRaw:
+		return p.printRaw(ctx, job, cfg)
+	case PrinterIPP:
+		buf := &bytes.Buffer{}
+		if err := job.pdf(buf, cfg); err != nil {
+			return err
+		}
+		return SendIPP(ctx, p.Address, p.Name, "application/pdf", buf.Bytes())
+	case PrinterCommand:
+		if job.Sheet == nil {
+			for i := range job.Labels {
+				if err := printCommand(ctx, cfg, &job.Labels[i], p.Address); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		return withTempFile("pdf", func(f *os.File) error {
+			if err := job.pdf(f, cfg); err != nil {
+				return err
+			}
+			return runPrintCommand(ctx, cfg, map[string]string{
+				"FileName": f.Name(),
+				"Printer":  p.Address,
+			})
+		})
+	default:
+		return fmt.Errorf("unknown printer kind %q", p.Kind)
+	}
+}
+
+func (p Printer) printRaw(ctx context.Context, job PrintJob, cfg *config.Config) error {
+	format := p.Language
+	if format == "" {
+		format = FormatZPL
+	}
+	if format != FormatZPL && format != FormatEPL {
+		return fmt.Errorf("unsupported print language %q, expected zpl or epl", format)
+	}
+
+	c := config.Config{}
+	if cfg != nil {
+		c = *cfg
+	}
+	if p.DPI > 0 {
+		c.LabelMaker.PrinterDPI = int64(p.DPI)
+	}
+
+	// One connection for all labels, so that the printer gets the job in one piece
+	buf := &bytes.Buffer{}
+	for i := range job.Labels {
+		if err := GenerateFormat(buf, format, &job.Labels[i], &c); err != nil {
+			return err
+		}
+	}
+
+	return SendRaw(ctx, p.Address, buf.Bytes())
+}
+
+// pdf writes the job as label sheets, or a page for each label without a sheet layout.
+func (job PrintJob) pdf(w io.Writer, cfg *config.Config) error {
+	if job.Sheet != nil {
+		return GenerateSheetPDF(w, job.Labels, *job.Sheet, job.Skip, cfg)
+	}
+	return GenerateLabelsPDF(w, job.Labels, cfg)
+}
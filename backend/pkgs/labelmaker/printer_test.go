@@ -0,0 +1,85 @@
+package labelmaker
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+func TestPrinter_Raw(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = ln.Close() }()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			received <- ""
+			return
+		}
+		b, _ := io.ReadAll(conn)
+		_ = conn.Close()
+		received <- string(b)
+	}()
+
+	printer := Printer{Name: "Zebra", Kind: PrinterRaw, Address: ln.Addr().String(), DPI: 300}
+	job := PrintJob{Labels: []GenerateParameters{zplParams(), zplParams()}}
+	require.NoError(t, printer.Print(context.Background(), job, &config.Config{}))
+
+	// Both labels in one connection, at the printer's resolution
+	zpl := <-received
+	assert.Equal(t, 2, strings.Count(zpl, "^XA"))
+	assert.Contains(t, zpl, "^PW777")
+
+	require.Error(t, printer.Print(context.Background(), PrintJob{}, &config.Config{}))
+}
+
+func TestPrinter_IPP(t *testing.T) {
+	var request []byte
+	status := uint16(0x0000)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/ipp", r.Header.Get("Content-Type"))
+		request, _ = io.ReadAll(r.Body)
+
+		resp := &bytes.Buffer{}
+		resp.Write([]byte{1, 1})
+		_ = binary.Write(resp, binary.BigEndian, status)
+		_ = binary.Write(resp, binary.BigEndian, uint32(1))
+		resp.WriteByte(ippTagEnd)
+		_, _ = w.Write(resp.Bytes())
+	}))
+	defer srv.Close()
+
+	printer := Printer{Name: "Office", Kind: PrinterIPP, Address: srv.URL + "/printers/office"}
+	job := PrintJob{Labels: []GenerateParameters{zplParams(), zplParams()}}
+	require.NoError(t, printer.Print(context.Background(), job, nil))
+
+	// A Print-Job request for the printer's ipp:// URI, followed by a page for each label
+	assert.Equal(t, []byte{1, 1, 0, 2}, request[:4])
+	assert.Contains(t, string(request), "printer-uri")
+	assert.Contains(t, string(request), "ipp://"+strings.TrimPrefix(srv.URL, "http://")+"/printers/office")
+	assert.Contains(t, string(request), "application/pdf")
+
+	pdf := string(request[bytes.Index(request, []byte("%PDF")):])
+	assert.Contains(t, pdf, "/Count 2")
+
+	status = 0x040A
+	err := printer.Print(context.Background(), job, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "document format not supported")
+
+	printer.Address = "ftp://example.com/printer"
+	require.Error(t, printer.Print(context.Background(), job, nil))
+}
@@ -0,0 +1,343 @@
+package labelmaker
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+// Sheet measurements are in PDF points, 72 to the inch.
+const (
+	inch = 72.0
+	mm   = inch / 25.4
+)
+
+// labelDPI is the resolution of common thermal label printers, which the label maker's
+// sizes in pixels are made for.
+const labelDPI = 203.0
+
+// Page sizes in points.
+var (
+	PageLetter = [2]float64{8.5 * inch, 11 * inch}
+	PageA4     = [2]float64{210 * mm, 297 * mm}
+)
+
+// SheetLayout is the grid of labels on a page of a label sheet.
+type SheetLayout struct {
+	PageWidth  float64
+	PageHeight float64
+	Columns    int
+	Rows       int
+	// Margins are the distance of the first label from the top and left page edges
+	MarginTop  float64
+	MarginLeft float64
+	// LabelWidth and LabelHeight are the size of a label, the pitch the distance between
+	// the left or top edges of neighbouring labels
+	LabelWidth  float64
+	LabelHeight float64
+	PitchX      float64
+	PitchY      float64
+}
+
+// SheetLayouts are the layouts of common label sheets by name.
+var SheetLayouts = map[string]SheetLayout{
+	// 30 address labels of 2 5/8" x 1" on US Letter
+	"avery-5160": {
+		PageWidth: PageLetter[0], PageHeight: PageLetter[1], Columns: 3, Rows: 10,
+		MarginTop: 0.5 * inch, MarginLeft: 0.1875 * inch,
+		LabelWidth: 2.625 * inch, LabelHeight: 1 * inch, PitchX: 2.75 * inch, PitchY: 1 * inch,
+	},
+	// 10 shipping labels of 4" x 2" on US Letter
+	"avery-5163": {
+		PageWidth: PageLetter[0], PageHeight: PageLetter[1], Columns: 2, Rows: 5,
+		MarginTop: 0.5 * inch, MarginLeft: 0.15625 * inch,
+		LabelWidth: 4 * inch, LabelHeight: 2 * inch, PitchX: 4.1875 * inch, PitchY: 2 * inch,
+	},
+	// 80 return address labels of 1 3/4" x 1/2" on US Letter
+	"avery-5167": {
+		PageWidth: PageLetter[0], PageHeight: PageLetter[1], Columns: 4, Rows: 20,
+		MarginTop: 0.5 * inch, MarginLeft: 0.3 * inch,
+		LabelWidth: 1.75 * inch, LabelHeight: 0.5 * inch, PitchX: 2.05 * inch, PitchY: 0.5 * inch,
+	},
+	// 21 labels of 63.5 x 38.1 mm on A4
+	"avery-l7160": {
+		PageWidth: PageA4[0], PageHeight: PageA4[1], Columns: 3, Rows: 7,
+		MarginTop: 15.15 * mm, MarginLeft: 7.2 * mm,
+		LabelWidth: 63.5 * mm, LabelHeight: 38.1 * mm, PitchX: 66.04 * mm, PitchY: 38.1 * mm,
+	},
+	// 14 labels of 99.1 x 38.1 mm on A4
+	"avery-l7163": {
+		PageWidth: PageA4[0], PageHeight: PageA4[1], Columns: 2, Rows: 7,
+		MarginTop: 15.15 * mm, MarginLeft: 4.65 * mm,
+		LabelWidth: 99.1 * mm, LabelHeight: 38.1 * mm, PitchX: 101.6 * mm, PitchY: 38.1 * mm,
+	},
+	// 65 mini labels of 38.1 x 21.2 mm on A4
+	"avery-l7651": {
+		PageWidth: PageA4[0], PageHeight: PageA4[1], Columns: 5, Rows: 13,
+		MarginTop: 10.7 * mm, MarginLeft: 4.65 * mm,
+		LabelWidth: 38.1 * mm, LabelHeight: 21.2 * mm, PitchX: 40.64 * mm, PitchY: 21.2 * mm,
+	},
+}
+
+// SheetLayoutNames returns the names of the sheet layouts in order.
+func SheetLayoutNames() []string {
+	names := make([]string, 0, len(SheetLayouts))
+	for name := range SheetLayouts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CustomSheetLayout lays out rows x columns labels on the page, filling it within the
+// margin with the gap between neighbouring labels.
+func CustomSheetLayout(pageWidth, pageHeight float64, rows, columns int, margin, gap float64) SheetLayout {
+	l := SheetLayout{
+		PageWidth:  pageWidth,
+		PageHeight: pageHeight,
+		Columns:    columns,
+		Rows:       rows,
+		MarginTop:  margin,
+		MarginLeft: margin,
+	}
+
+	if columns > 0 && rows > 0 {
+		l.LabelWidth = (pageWidth - 2*margin - float64(columns-1)*gap) / float64(columns)
+		l.LabelHeight = (pageHeight - 2*margin - float64(rows-1)*gap) / float64(rows)
+		l.PitchX = l.LabelWidth + gap
+		l.PitchY = l.LabelHeight + gap
+	}
+
+	return l
+}
+
+// PerPage is the number of labels on a page.
+func (l SheetLayout) PerPage() int {
+	return l.Columns * l.Rows
+}
+
+func (l SheetLayout) Validate() error {
+	switch {
+	case l.Columns <= 0 || l.Rows <= 0:
+		return fmt.Errorf("invalid sheet layout: no rows or columns")
+	case l.LabelWidth <= 0 || l.LabelHeight <= 0:
+		return fmt.Errorf("invalid sheet layout: labels don't fit on the page")
+	case l.MarginLeft+float64(l.Columns-1)*l.PitchX+l.LabelWidth > l.PageWidth+0.5,
+		l.MarginTop+float64(l.Rows-1)*l.PitchY+l.LabelHeight > l.PageHeight+0.5:
+		return fmt.Errorf("invalid sheet layout: labels exceed the page")
+	}
+	return nil
+}
+
+// GenerateSheetPDF writes the labels as a PDF of label sheets in the layout, as many
+// pages as the labels need. The first skip positions of the first page are left empty
+// so that partly used sheets can be printed on. Labels are rendered to the size of
+// the layout's labels, scaling the fonts and spacing of the parameters with them.
+func GenerateSheetPDF(w io.Writer, params []GenerateParameters, layout SheetLayout, skip int, cfg *config.Config) error {
+	if err := layout.Validate(); err != nil {
+		return err
+	}
+	if len(params) == 0 {
+		return fmt.Errorf("no labels to generate")
+	}
+	skip = max(0, skip) % layout.PerPage()
+
+	width := int(math.Round(layout.LabelWidth / inch * labelDPI))
+	height := int(math.Round(layout.LabelHeight / inch * labelDPI))
+
+	var pages []pdfPage
+	for i := range params {
+		p := params[i]
+
+		scale := 1.0
+		if p.Height > 0 {
+			scale = float64(height) / float64(p.Height)
+		}
+
+		p.Width, p.Height = width, height
+		p.Margin = int(float64(p.Margin) * scale)
+		p.ComponentPadding = int(float64(p.ComponentPadding) * scale)
+		p.QrSize = height - 2*p.ComponentPadding
+		p.TitleFontSize *= scale
+		p.DescriptionFontSize *= scale
+		p.Lines = make([]TextLine, len(params[i].Lines))
+		for j, line := range params[i].Lines {
+			line.FontSize *= scale
+			p.Lines[j] = line
+		}
+		p.DynamicLength = false
+
+		buf := &bytes.Buffer{}
+		if err := GenerateLabel(buf, &p, cfg); err != nil {
+			return err
+		}
+
+		img, err := png.Decode(buf)
+		if err != nil {
+			return err
+		}
+
+		pos := skip + i
+		page, cell := pos/layout.PerPage(), pos%layout.PerPage()
+		if page == len(pages) {
+			pages = append(pages, pdfPage{width: layout.PageWidth, height: layout.PageHeight})
+		}
+
+		col, row := cell%layout.Columns, cell/layout.Columns
+		pages[page].images = append(pages[page].images, pdfImage{
+			img: img,
+			x:   layout.MarginLeft + float64(col)*layout.PitchX,
+			// PDF measures from the bottom of the page
+			y: layout.PageHeight - layout.MarginTop - float64(row)*layout.PitchY - layout.LabelHeight,
+			w: layout.LabelWidth,
+			h: layout.LabelHeight,
+		})
+	}
+
+	return writePDF(w, pages)
+}
+
+// GenerateLabelsPDF writes the labels as a PDF with a page of the label's size for each,
+// for printers that take documents rather than images.
+func GenerateLabelsPDF(w io.Writer, params []GenerateParameters, cfg *config.Config) error {
+	if len(params) == 0 {
+		return fmt.Errorf("no labels to generate")
+	}
+
+	pages := make([]pdfPage, len(params))
+	for i := range params {
+		buf := &bytes.Buffer{}
+		if err := GenerateLabel(buf, &params[i], cfg); err != nil {
+			return err
+		}
+
+		img, err := png.Decode(buf)
+		if err != nil {
+			return err
+		}
+
+		b := img.Bounds()
+		width, height := float64(b.Dx())/labelDPI*inch, float64(b.Dy())/labelDPI*inch
+		pages[i] = pdfPage{
+			width:  width,
+			height: height,
+			images: []pdfImage{{img: img, w: width, h: height}},
+		}
+	}
+
+	return writePDF(w, pages)
+}
+
+// pdfImage is an image placed on a PDF page, in points from the bottom left corner.
+type pdfImage struct {
+	img        image.Image
+	x, y, w, h float64
+}
+
+// pdfPage is a page of a PDF in points and the images on it.
+type pdfPage struct {
+	width, height float64
+	images        []pdfImage
+}
+
+// writePDF writes a PDF of the pages. It supports just what labels need, images placed
+// on pages.
+func writePDF(w io.Writer, pages []pdfPage) error {
+	buf := &bytes.Buffer{}
+	offsets := map[int]int{}
+
+	object := func(id int, dict string, stream []byte) {
+		offsets[id] = buf.Len()
+		fmt.Fprintf(buf, "%d 0 obj\n%s\n", id, dict)
+		if stream != nil {
+			buf.WriteString("stream\n")
+			buf.Write(stream)
+			buf.WriteString("\nendstream\n")
+		}
+		buf.WriteString("endobj\n")
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 1 is the catalog and 2 the page tree, the pages and their images follow
+	next := 3
+	kids := make([]string, len(pages))
+
+	for i, page := range pages {
+		pageID, contentID := next, next+1
+		next += 2
+
+		content := &bytes.Buffer{}
+		xobjects := &strings.Builder{}
+
+		for j, im := range page.images {
+			data, err := rgbFlate(im.img)
+			if err != nil {
+				return err
+			}
+
+			b := im.img.Bounds()
+			object(next, fmt.Sprintf(
+				"<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode /Length %d >>",
+				b.Dx(), b.Dy(), len(data)), data)
+
+			fmt.Fprintf(xobjects, " /Im%d %d 0 R", j, next)
+			fmt.Fprintf(content, "q %.2f 0 0 %.2f %.2f %.2f cm /Im%d Do Q\n", im.w, im.h, im.x, im.y, j)
+			next++
+		}
+
+		object(contentID, fmt.Sprintf("<< /Length %d >>", content.Len()), content.Bytes())
+		object(pageID, fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /XObject <<%s >> >> /Contents %d 0 R >>",
+			page.width, page.height, xobjects.String(), contentID), nil)
+
+		kids[i] = fmt.Sprintf("%d 0 R", pageID)
+	}
+
+	object(2, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)), nil)
+	object(1, "<< /Type /Catalog /Pages 2 0 R >>", nil)
+
+	xref := buf.Len()
+	fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f \n", next)
+	for id := 1; id < next; id++ {
+		fmt.Fprintf(buf, "%010d 00000 n \n", offsets[id])
+	}
+	fmt.Fprintf(buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", next, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// rgbFlate returns the pixels of the image as compressed 8 bit RGB.
+func rgbFlate(img image.Image) ([]byte, error) {
+	b := img.Bounds()
+
+	buf := &bytes.Buffer{}
+	zw := zlib.NewWriter(buf)
+
+	row := make([]byte, 0, b.Dx()*3)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		row = row[:0]
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, bl, _ := img.At(x, y).RGBA()
+			row = append(row, byte(r>>8), byte(g>>8), byte(bl>>8))
+		}
+		if _, err := zw.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
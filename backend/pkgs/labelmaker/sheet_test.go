@@ -0,0 +1,56 @@
+package labelmaker
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSheetLayouts(t *testing.T) {
+	for _, name := range SheetLayoutNames() {
+		assert.NoError(t, SheetLayouts[name].Validate(), name)
+	}
+
+	l := CustomSheetLayout(PageA4[0], PageA4[1], 8, 3, 10*mm, 2*mm)
+	require.NoError(t, l.Validate())
+	assert.Equal(t, 24, l.PerPage())
+	assert.InDelta(t, (210-20-4)/3.0*mm, l.LabelWidth, 0.01)
+
+	assert.Error(t, CustomSheetLayout(PageA4[0], PageA4[1], 0, 3, 0, 0).Validate())
+	assert.Error(t, CustomSheetLayout(PageA4[0], PageA4[1], 2, 2, 150*mm, 0).Validate())
+}
+
+func TestGenerateSheetPDF(t *testing.T) {
+	params := make([]GenerateParameters, 31)
+	for i := range params {
+		params[i] = NewGenerateParams(526, 200, 32, 32, 32, "HB-00042", "Chromebook", "https://example.com/a/HB-00042", true, nil)
+	}
+
+	// 30 labels per page, the skipped position moves the last two labels to the second
+	buf := &bytes.Buffer{}
+	err := GenerateSheetPDF(buf, params, SheetLayouts["avery-5160"], 1, nil)
+	require.NoError(t, err)
+
+	pdf := buf.String()
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4"))
+	assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	assert.Contains(t, pdf, "/Count 2")
+	assert.Equal(t, 31, strings.Count(pdf, "/Subtype /Image"))
+
+	// Every object is where the cross-reference table says
+	xref := pdf[strings.LastIndex(pdf, "xref\n"):]
+	lines := strings.Split(xref, "\n")[3:]
+	for id := 1; strings.HasSuffix(lines[id-1], " n "); id++ {
+		offset, err := strconv.Atoi(lines[id-1][:10])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(pdf[offset:], fmt.Sprintf("%d 0 obj", id)), "object %d", id)
+	}
+
+	err = GenerateSheetPDF(&bytes.Buffer{}, nil, SheetLayouts["avery-5160"], 0, nil)
+	require.Error(t, err)
+}
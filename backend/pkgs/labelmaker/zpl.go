@@ -0,0 +1,296 @@
+package labelmaker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/skip2/go-qrcode"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+// Format is the output format of a label.
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	// FormatZPL is the language of Zebra printers
+	FormatZPL Format = "zpl"
+	// FormatEPL is the language of older Zebra and Eltron printers
+	FormatEPL Format = "epl"
+)
+
+// GenerateFormat writes the label in the format, a PNG image or the commands of a label
+// printer language.
+func GenerateFormat(w io.Writer, format Format, params *GenerateParameters, cfg *config.Config) error {
+	switch format {
+	case "", FormatPNG:
+		return GenerateLabel(w, params, cfg)
+	case FormatZPL:
+		return GenerateZPL(w, params, cfg)
+	case FormatEPL:
+		return GenerateEPL(w, params, cfg)
+	default:
+		return fmt.Errorf("unknown label format %q", format)
+	}
+}
+
+// printerScale converts the label maker's pixels to dots of the configured printer, so
+// that labels keep their size on printers of any resolution.
+func printerScale(cfg *config.Config) func(int) int {
+	dpi := labelDPI
+	if cfg != nil && cfg.LabelMaker.PrinterDPI > 0 {
+		dpi = float64(cfg.LabelMaker.PrinterDPI)
+	}
+
+	return func(px int) int {
+		return int(math.Round(float64(px) * dpi / labelDPI))
+	}
+}
+
+// qrModules returns the number of modules per side of the data's QR code.
+func qrModules(data string) (int, error) {
+	qr, err := qrcode.New(data, qrcode.Medium)
+	if err != nil {
+		return 0, err
+	}
+	qr.DisableBorder = true
+	return len(qr.Bitmap()), nil
+}
+
+// zplEscape escapes the characters ZPL reads as commands in field data, for use with
+// ^FH and its default escape character.
+var zplEscape = strings.NewReplacer("_", "_5F", "^", "_5E", "~", "_7E")
+
+// zplBlockEscape escapes field data in field blocks, which read backslashes as well.
+var zplBlockEscape = strings.NewReplacer("_", "_5F", "^", "_5E", "~", "_7E", `\`, `\\`)
+
+// textRuns groups the lines of text into the runs of each block that sit next to the
+// code or below it, trimming the blank lines at their ends.
+func textRuns(text []placedText) [][]placedText {
+	var runs [][]placedText
+	for i := 0; i < len(text); {
+		j := i + 1
+		for j < len(text) && text[j].Block == text[i].Block && text[j].Pt.X == text[i].Pt.X {
+			j++
+		}
+
+		run := text[i:j]
+		for len(run) > 0 && run[0].Text == "" {
+			run = run[1:]
+		}
+		for len(run) > 0 && run[len(run)-1].Text == "" {
+			run = run[:len(run)-1]
+		}
+		if len(run) > 0 {
+			runs = append(runs, run)
+		}
+		i = j
+	}
+	return runs
+}
+
+// GenerateZPL writes the label as ZPL, with the printer's own fonts and codes so that
+// they are printed at its full resolution.
+func GenerateZPL(w io.Writer, params *GenerateParameters, cfg *config.Config) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	layout, err := layoutLabel(params, cfg)
+	if err != nil {
+		return err
+	}
+
+	d := printerScale(cfg)
+	buf := &bytes.Buffer{}
+
+	// UTF-8 text, the label's width and length
+	fmt.Fprintf(buf, "^XA\n^CI28\n^PW%d\n^LL%d\n", d(layout.Width), d(layout.Height))
+
+	if !layout.Code.Empty() {
+		at := layout.Code.Min
+		size := d(layout.Code.Dx())
+
+		if params.Symbology == SymbologyDataMatrix {
+			modules, err := encodeDataMatrix(layout.CodeText)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(buf, "^FO%d,%d^BXN,%d,200^FH^FD%s^FS\n", d(at.X), d(at.Y), max(1, size/len(modules)), zplEscape.Replace(layout.CodeText))
+		} else {
+			n, err := qrModules(layout.CodeText)
+			if err != nil {
+				return err
+			}
+			// Model 2 with medium error correction, magnified up to 10 times
+			fmt.Fprintf(buf, "^FO%d,%d^BQN,2,%d^FH^FDMA,%s^FS\n", d(at.X), d(at.Y), min(10, max(1, size/n)), zplEscape.Replace(layout.CodeText))
+		}
+	}
+
+	if !layout.Barcode.Empty() {
+		at := layout.Barcode.Min
+		fmt.Fprintf(buf, "^FO%d,%d^BY%d^BCN,%d,N,N,N,A^FH^FD%s^FS\n",
+			d(at.X), d(at.Y), max(1, d(layout.ModuleWidth)), d(layout.Barcode.Dy()), zplEscape.Replace(layout.CodeText))
+	}
+
+	// The scalable font 0 is placed by the top of its line rather than the baseline. Each
+	// run of a block's lines is a field block, which keeps the label maker's line breaks
+	// and wraps the lines that come out wider in the printer's font rather than letting
+	// them run off the label
+	for _, run := range textRuns(layout.Text) {
+		lines := make([]string, len(run))
+		for i, t := range run {
+			lines[i] = zplBlockEscape.Replace(t.Text)
+		}
+		t := run[0]
+		fmt.Fprintf(buf, "^FO%d,%d^A0N,%d^FB%d,%d,0,L,0^FH^FD%s^FS\n",
+			d(t.Pt.X), d(t.Pt.Y-t.LineSpacing), d(t.LineSpacing), d(t.Width), len(run), strings.Join(lines, `\&`))
+	}
+
+	buf.WriteString("^XZ\n")
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// eplFonts are the heights of the EPL fonts 1 to 5 in dots, at 203 and at 300 DPI.
+var eplFonts = map[bool][5]int{
+	false: {12, 16, 20, 24, 48},
+	true:  {20, 28, 36, 44, 80},
+}
+
+// eplFont returns the font and multiplier that come closest to the height in dots.
+func eplFont(height int, highRes bool) (font, mult int) {
+	best := math.MaxInt
+	for i, h := range eplFonts[highRes] {
+		for m := 1; m <= 6; m++ {
+			// Ties go to the larger font, as scaled up bitmap fonts look coarse
+			if diff := abs(h*m - height); diff < best || diff == best && m < mult {
+				best, font, mult = diff, i+1, m
+			}
+		}
+	}
+	return font, mult
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+var eplEscape = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// GenerateEPL writes the label as EPL2. EPL's bitmap fonts come in fixed sizes, so
+// text is printed in the closest one, and it has no Data Matrix codes. Nor has it field
+// blocks, so each line is printed where the label maker wrapped it.
+func GenerateEPL(w io.Writer, params *GenerateParameters, cfg *config.Config) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if params.Symbology == SymbologyDataMatrix {
+		return fmt.Errorf("EPL labels can't have Data Matrix codes")
+	}
+
+	layout, err := layoutLabel(params, cfg)
+	if err != nil {
+		return err
+	}
+
+	d := printerScale(cfg)
+	highRes := cfg != nil && cfg.LabelMaker.PrinterDPI >= 300
+	buf := &bytes.Buffer{}
+
+	// Clear the image buffer and set the label's width
+	fmt.Fprintf(buf, "\nN\nq%d\n", d(layout.Width))
+
+	if !layout.Code.Empty() {
+		n, err := qrModules(layout.CodeText)
+		if err != nil {
+			return err
+		}
+		at := layout.Code.Min
+		fmt.Fprintf(buf, "b%d,%d,Q,m2,s%d,eM,\"%s\"\n", d(at.X), d(at.Y), min(99, max(1, d(layout.Code.Dx())/n)), eplEscape.Replace(layout.CodeText))
+	}
+
+	if !layout.Barcode.Empty() {
+		at := layout.Barcode.Min
+		mw := max(1, d(layout.ModuleWidth))
+		fmt.Fprintf(buf, "B%d,%d,0,1,%d,%d,%d,N,\"%s\"\n", d(at.X), d(at.Y), mw, mw, d(layout.Barcode.Dy()), eplEscape.Replace(layout.CodeText))
+	}
+
+	for _, t := range layout.Text {
+		if t.Text == "" {
+			continue
+		}
+		font, mult := eplFont(d(t.LineSpacing), highRes)
+		fmt.Fprintf(buf, "A%d,%d,0,%d,%d,%d,N,\"%s\"\n", d(t.Pt.X), d(t.Pt.Y-t.LineSpacing), font, mult, mult, eplEscape.Replace(t.Text))
+	}
+
+	buf.WriteString("P1\n")
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// printRaw sends the label in the configured printer language to the configured
+// address, as printers listening on port 9100 take it.
+func printRaw(ctx context.Context, cfg *config.Config, params *GenerateParameters) error {
+	format := Format(cfg.LabelMaker.PrintLanguage)
+	if format == "" {
+		format = FormatZPL
+	}
+	if format != FormatZPL && format != FormatEPL {
+		return fmt.Errorf("unsupported print language %q, expected zpl or epl", format)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := GenerateFormat(buf, format, params, cfg); err != nil {
+		return err
+	}
+
+	return SendRaw(ctx, *cfg.LabelMaker.PrintAddress, buf.Bytes())
+}
+
+// SendRaw sends the data to the printer at the address, on port 9100 unless the address
+// has another. The data is sent for 30 seconds at most, or until ctx is done.
+func SendRaw(ctx context.Context, address string, data []byte) error {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "9100")
+	}
+
+	dialer := net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to printer %s: %w", address, err)
+	}
+
+	deadline := time.Now().Add(30 * time.Second)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetWriteDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	// Cancelling the context stops a write the printer doesn't take
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetWriteDeadline(time.Now())
+	})
+	defer stop()
+
+	if _, err := conn.Write(data); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to send label to printer %s: %w", address, err)
+	}
+
+	return conn.Close()
+}
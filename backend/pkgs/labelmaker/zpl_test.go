@@ -0,0 +1,116 @@
+package labelmaker
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+func zplParams() GenerateParameters {
+	return NewGenerateParams(526, 200, 32, 32, 32, "HB-0001", "Cordless ^Drill~", "https://homebox.example.com/a/HB-0001", true, nil)
+}
+
+func TestGenerateZPL(t *testing.T) {
+	params := zplParams()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, GenerateZPL(buf, &params, nil))
+	zpl := buf.String()
+
+	assert.True(t, strings.HasPrefix(zpl, "^XA\n"))
+	assert.True(t, strings.HasSuffix(zpl, "^XZ\n"))
+	assert.Contains(t, zpl, "^PW526\n")
+	assert.Contains(t, zpl, "^BQN,2,")
+	assert.Contains(t, zpl, "^FDMA,https://homebox.example.com/a/HB-0001^FS")
+	assert.Contains(t, zpl, "^FDHB-0001^FS")
+	assert.Contains(t, zpl, "^FDCordless _5EDrill_7E^FS", "field data is escaped")
+
+	// Text is printed in field blocks as wide as the space the label maker wrapped it to
+	params.Lines = []TextLine{{Text: strings.Repeat("wrapped ", 12) + `C:\tools`, FontSize: 32}}
+	buf.Reset()
+	require.NoError(t, GenerateZPL(buf, &params, nil))
+	block := regexp.MustCompile(`\^FB(\d+),(\d+),0,L,0\^FH\^FD([^^]*)\^FS`).FindStringSubmatch(buf.String())
+	require.NotNil(t, block, buf.String())
+	assert.Equal(t, "294", block[1], "the width next to the code")
+	lines := strings.Split(block[3], `\&`)
+	assert.Equal(t, strconv.Itoa(len(lines)), block[2])
+	assert.Greater(t, len(lines), 1)
+	assert.True(t, strings.HasSuffix(block[3], `C:\\tools`), "backslashes are escaped")
+	params = zplParams()
+
+	// Labels keep their size on printers of higher resolution
+	cfg := &config.Config{LabelMaker: config.LabelMakerConf{PrinterDPI: 300}}
+	buf.Reset()
+	require.NoError(t, GenerateZPL(buf, &params, cfg))
+	assert.Contains(t, buf.String(), "^PW777\n")
+
+	params.Symbology = SymbologyCode128
+	params.CodeText = "HB-0001"
+	buf.Reset()
+	require.NoError(t, GenerateZPL(buf, &params, nil))
+	assert.Contains(t, buf.String(), "^BCN,")
+	assert.Contains(t, buf.String(), "^FDHB-0001^FS")
+	assert.NotContains(t, buf.String(), "^BQN")
+
+	params.Symbology = SymbologyDataMatrix
+	params.CodeText = ""
+	buf.Reset()
+	require.NoError(t, GenerateZPL(buf, &params, nil))
+	assert.Contains(t, buf.String(), ",200^FH^FDhttps://homebox.example.com/a/HB-0001^FS")
+}
+
+func TestGenerateEPL(t *testing.T) {
+	params := zplParams()
+	params.Lines = []TextLine{{Text: `Say "hi"`, FontSize: 48}}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, GenerateEPL(buf, &params, nil))
+	epl := buf.String()
+
+	assert.True(t, strings.HasPrefix(epl, "\nN\nq526\n"))
+	assert.True(t, strings.HasSuffix(epl, "P1\n"))
+	assert.Contains(t, epl, ",Q,m2,")
+	assert.Contains(t, epl, `,0,5,1,1,N,"Say \"hi\""`)
+
+	params.Symbology = SymbologyDataMatrix
+	require.Error(t, GenerateEPL(buf, &params, nil))
+}
+
+func TestPrintLabel_Raw(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = ln.Close() }()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			received <- ""
+			return
+		}
+		b, _ := io.ReadAll(conn)
+		_ = conn.Close()
+		received <- string(b)
+	}()
+
+	address := ln.Addr().String()
+	cfg := &config.Config{LabelMaker: config.LabelMakerConf{PrintAddress: &address, PrintLanguage: "zpl"}}
+
+	params := zplParams()
+	require.NoError(t, PrintLabel(cfg, &params))
+
+	zpl := <-received
+	assert.True(t, strings.HasPrefix(zpl, "^XA"))
+	assert.True(t, strings.HasSuffix(zpl, "^XZ\n"))
+
+	cfg.LabelMaker.PrintLanguage = "pdf"
+	require.Error(t, PrintLabel(cfg, &params))
+}
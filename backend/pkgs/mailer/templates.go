@@ -9,6 +9,9 @@ import (
 //go:embed templates/welcome.html
 var templatesWelcome string
 
+//go:embed templates/borrower_verification.html
+var templatesBorrowerVerification string
+
 type TemplateDefaults struct {
 	CompanyName        string
 	CompanyAddress     string
@@ -59,3 +62,13 @@ func render(tpl string, data TemplateProps) (string, error) {
 func RenderWelcome() (string, error) {
 	return render(templatesWelcome, DefaultTemplateData())
 }
+
+// RenderBorrowerVerification renders the email sent to self-registered borrowers
+// asking them to confirm their email address via verifyURL.
+func RenderBorrowerVerification(name, verifyURL string) (string, error) {
+	data := DefaultTemplateData()
+	data.Set("name", name)
+	data.Set("verifyURL", verifyURL)
+
+	return render(templatesBorrowerVerification, data)
+}
@@ -0,0 +1,18 @@
+package mailer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RenderBorrowerVerification(t *testing.T) {
+	t.Parallel()
+
+	body, err := RenderBorrowerVerification("Jane <Doe>", "https://example.com/api/v1/borrowers/verify?token=ABC")
+	require.NoError(t, err)
+
+	assert.Contains(t, body, "Hi Jane &lt;Doe&gt;,")
+	assert.Contains(t, body, `href="https://example.com/api/v1/borrowers/verify?token=ABC"`)
+}
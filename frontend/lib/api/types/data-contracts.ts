@@ -19,6 +19,68 @@ export enum UserRole {
 
 export enum TemplatefieldType {
   TypeText = "text",
+  TypeNumber = "number",
+  TypeDecimal = "decimal",
+  TypeBoolean = "boolean",
+  TypeTime = "time",
+  TypeSelect = "select",
+  TypeMultiselect = "multiselect",
+  TypeURL = "url",
+}
+
+export enum StocktakesessionStatus {
+  DefaultStatus = "open",
+  StatusOpen = "open",
+  StatusClosed = "closed",
+}
+
+export enum StockmovementReason {
+  DefaultReason = "adjustment",
+  ReasonPurchase = "purchase",
+  ReasonIssue = "issue",
+  ReasonLoss = "loss",
+  ReasonAdjustment = "adjustment",
+  ReasonCountCorrection = "count_correction",
+}
+
+export enum KioskSyncStatus {
+  KioskSyncApplied = "applied",
+  KioskSyncDuplicate = "duplicate",
+  KioskSyncConflict = "conflict",
+  KioskSyncRejected = "rejected",
+  KioskSyncFailed = "failed",
+}
+
+export enum KioskSyncActionType {
+  KioskSyncActionCheckout = "checkout",
+  KioskSyncActionReturn = "return",
+  KioskSyncActionRegisterBorrower = "register_borrower",
+}
+
+export enum TemplateConflict {
+  TemplateConflictSkip = "skip",
+  TemplateConflictRename = "rename",
+  TemplateConflictOverwrite = "overwrite",
+}
+
+export enum StocktakeStatus {
+  StocktakeStatusOpen = "open",
+  StocktakeStatusClosed = "closed",
+}
+
+export enum StocktakeScanResult {
+  StocktakeScanFound = "found",
+  StocktakeScanMisplaced = "misplaced",
+  StocktakeScanUnexpected = "unexpected",
+  StocktakeScanUnknown = "unknown",
+}
+
+export enum StockReason {
+  StockReasonPurchase = "purchase",
+  StockReasonIssue = "issue",
+  StockReasonLoss = "loss",
+  StockReasonAdjustment = "adjustment",
+  StockReasonCountCorrection = "count_correction",
 }
 
 export enum MaintenanceFilterStatus {
@@ -32,20 +94,190 @@ export enum ItemType {
   ItemTypeItem = "item",
 }
 
+export enum ItemStatus {
+  ItemStatusInService = "in_service",
+  ItemStatusInRepair = "in_repair",
+  ItemStatusLost = "lost",
+  ItemStatusStolen = "stolen",
+  ItemStatusRetired = "retired",
+  ItemStatusDisposed = "disposed",
+}
+
+export enum ItemBulkStatus {
+  ItemBulkStatusUpdated = "updated",
+  ItemBulkStatusDeleted = "deleted",
+  ItemBulkStatusNotFound = "not_found",
+  ItemBulkStatusFailed = "failed",
+  ItemBulkStatusRolledBack = "rolled_back",
+}
+
+export enum IdentifierType {
+  IdentifierTypeUPC = "upc",
+  IdentifierTypeEAN = "ean",
+  IdentifierTypeISBN = "isbn",
+  IdentifierTypeLegacyTag = "legacy_tag",
+  IdentifierTypeNFC = "nfc",
+  IdentifierTypeRFID = "rfid",
+  IdentifierTypeOther = "other",
+  IdentifierTypeAssetID = "asset_id",
+}
+
+export enum FieldOp {
+  FieldOpEq = "eq",
+  FieldOpNe = "ne",
+  FieldOpLt = "lt",
+  FieldOpGt = "gt",
+  FieldOpBetween = "between",
+  FieldOpContains = "contains",
+  FieldOpExists = "exists",
+}
+
+export enum CheckDigit {
+  CheckDigitNone = "none",
+  CheckDigitLuhn = "luhn",
+  CheckDigitMod11 = "mod11",
+}
+
+export enum PrintjobStatus {
+  DefaultStatus = "queued",
+  StatusQueued = "queued",
+  StatusPrinting = "printing",
+  StatusDone = "done",
+  StatusFailed = "failed",
+  StatusCancelled = "cancelled",
+}
+
+export enum PrinterLanguage {
+  DefaultLanguage = "zpl",
+  LanguageZpl = "zpl",
+  LanguageEpl = "epl",
+}
+
+export enum PrinterKind {
+  DefaultKind = "raw",
+  KindCommand = "command",
+  KindRaw = "raw",
+  KindIpp = "ipp",
+}
+
+export enum LabeltemplateSymbology {
+  DefaultSymbology = "qr",
+  SymbologyQr = "qr",
+  SymbologyDatamatrix = "datamatrix",
+  SymbologyCode128 = "code128",
+  SymbologyNone = "none",
+}
+
+export enum KiosksyncactionStatus {
+  DefaultStatus = "pending",
+  StatusPending = "pending",
+  StatusApplied = "applied",
+}
+
+export enum KiosksyncactionAction {
+  ActionCheckout = "checkout",
+  ActionReturn = "return",
+  ActionRegisterBorrower = "register_borrower",
+}
+
+export enum ItemstatuschangeToStatus {
+  ToStatusInService = "in_service",
+  ToStatusInRepair = "in_repair",
+  ToStatusLost = "lost",
+  ToStatusStolen = "stolen",
+  ToStatusRetired = "retired",
+  ToStatusDisposed = "disposed",
+}
+
+export enum ItemstatuschangeFromStatus {
+  FromStatusInService = "in_service",
+  FromStatusInRepair = "in_repair",
+  FromStatusLost = "lost",
+  FromStatusStolen = "stolen",
+  FromStatusRetired = "retired",
+  FromStatusDisposed = "disposed",
+}
+
+export enum ItemidentifierType {
+  DefaultType = "other",
+  TypeUpc = "upc",
+  TypeEan = "ean",
+  TypeIsbn = "isbn",
+  TypeLegacyTag = "legacy_tag",
+  TypeNfc = "nfc",
+  TypeRfid = "rfid",
+  TypeOther = "other",
+}
+
 export enum ItemfieldType {
   TypeText = "text",
   TypeNumber = "number",
+  TypeDecimal = "decimal",
+  TypeBoolean = "boolean",
+  TypeTime = "time",
+  TypeSelect = "select",
+  TypeMultiselect = "multiselect",
+  TypeURL = "url",
+}
+
+export enum ItemStatus {
+  DefaultStatus = "in_service",
+  StatusInService = "in_service",
+  StatusInRepair = "in_repair",
+  StatusLost = "lost",
+  StatusStolen = "stolen",
+  StatusRetired = "retired",
+  StatusDisposed = "disposed",
+}
+
+export enum GroupAssetIDCheckDigit {
+  DefaultAssetIDCheckDigit = "none",
+  AssetIDCheckDigitNone = "none",
+  AssetIDCheckDigitLuhn = "luhn",
+  AssetIDCheckDigitMod11 = "mod11",
+}
+
+export enum FielddefinitionType {
+  TypeText = "text",
+  TypeNumber = "number",
+  TypeDecimal = "decimal",
   TypeBoolean = "boolean",
   TypeTime = "time",
+  TypeSelect = "select",
+  TypeMultiselect = "multiselect",
+  TypeURL = "url",
+}
+
+export enum BorrowerVerificationStatus {
+  DefaultVerificationStatus = "verified",
+  VerificationStatusVerified = "verified",
+  VerificationStatusPendingEmail = "pending_email",
+  VerificationStatusPendingApproval = "pending_approval",
+  VerificationStatusRejected = "rejected",
 }
 
 export enum AuthrolesRole {
   DefaultRole = "user",
   RoleAdmin = "admin",
   RoleUser = "user",
+  RoleKiosk = "kiosk",
   RoleAttachments = "attachments",
 }
 
+export enum AuditentrySource {
+  DefaultSource = "system",
+  SourceAPI = "api",
+  SourceKiosk = "kiosk",
+  SourceImport = "import",
+  SourceSystem = "system",
+}
+
+export enum AuditentryAction {
+  ActionCreate = "create",
+  ActionUpdate = "update",
+  ActionDelete = "delete",
+}
+
 export enum AttachmentType {
   DefaultType = "attachment",
   TypePhoto = "photo",
@@ -95,6 +327,39 @@ export interface EntAttachmentEdges {
   thumbnail: EntAttachment;
 }
 
+export interface EntAuditEntry {
+  /** Action holds the value of the "action" field. */
+  action: AuditentryAction;
+  /** ActorID holds the value of the "actor_id" field. */
+  actor_id: string;
+  /** JSON encoded map of field name to old and new value */
+  changes: string;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the AuditEntryQuery when eager-loading is set.
+   */
+  edges: EntAuditEntryEdges;
+  /** EntityID holds the value of the "entity_id" field. */
+  entity_id: string;
+  /** EntityType holds the value of the "entity_type" field. */
+  entity_type: string;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** Source holds the value of the "source" field. */
+  source: AuditentrySource;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntAuditEntryEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+}
+
 export interface EntAuthRoles {
   /**
    * Edges holds the relations/edges for other nodes in the graph.
@@ -137,7 +402,89 @@ export interface EntAuthTokensEdges {
   user: EntUser;
 }
 
+export interface EntBorrower {
+  /** When a staff member approved the borrower */
+  approved_at: string;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the BorrowerQuery when eager-loading is set.
+   */
+  edges: EntBorrowerEdges;
+  /** Contact email address */
+  email: string;
+  /** When the borrower confirmed their email address */
+  email_verified_at: string;
+  /** ID of the ent. */
+  id: string;
+  /** Whether borrower can currently check out equipment */
+  is_active: boolean;
+  /** Full name of the borrower */
+  name: string;
+  /** Additional notes about the borrower */
+  notes: string;
+  /** Company or startup name */
+  organization: string;
+  /** Contact phone number */
+  phone: string;
+  /** Whether borrower registered themselves via kiosk self-service */
+  self_registered: boolean;
+  /** University student ID */
+  student_id: string;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+  /** When the email confirmation token expires */
+  verification_expires_at: string;
+  /** Verification state of self-registered borrowers (only verified borrowers can check out) */
+  verification_status: BorrowerVerificationStatus;
+}
+
+export interface EntBorrowerEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** Loans holds the value of the loans edge. */
+  loans: EntLoan[];
+}
+
+export interface EntFieldDefinition {
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /** Description holds the value of the "description" field. */
+  description: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the FieldDefinitionQuery when eager-loading is set.
+   */
+  edges: EntFieldDefinitionEdges;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** Name holds the value of the "name" field. */
+  name: string;
+  /** Allowed values of select and multiselect fields */
+  options: string[];
+  /** Type holds the value of the "type" field. */
+  type: FielddefinitionType;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntFieldDefinitionEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+}
+
 export interface EntGroup {
+  /** Check digit appended to asset IDs so that mis-scans are rejected */
+  asset_id_check_digit: GroupAssetIDCheckDigit;
+  /** Prefix of the asset IDs, e.g. HB- */
+  asset_id_prefix: string;
+  /** Highest asset ID when check digits were turned on, labels of those may lack one */
+  asset_id_unchecked_max: number;
+  /** Digits the asset IDs are zero padded to (0 = 000-000) */
+  asset_id_width: number;
   /** CreatedAt holds the value of the "created_at" field. */
   created_at: string;
   /** Currency holds the value of the "currency" field. */
@@ -156,18 +503,44 @@ export interface EntGroup {
 }
 
 export interface EntGroupEdges {
+  /** AuditEntries holds the value of the audit_entries edge. */
+  audit_entries: EntAuditEntry[];
+  /** Borrowers holds the value of the borrowers edge. */
+  borrowers: EntBorrower[];
+  /** FieldDefinitions holds the value of the field_definitions edge. */
+  field_definitions: EntFieldDefinition[];
   /** InvitationTokens holds the value of the invitation_tokens edge. */
   invitation_tokens: EntGroupInvitationToken[];
+  /** ItemIdentifiers holds the value of the item_identifiers edge. */
+  item_identifiers: EntItemIdentifier[];
+  /** ItemStatusChanges holds the value of the item_status_changes edge. */
+  item_status_changes: EntItemStatusChange[];
   /** ItemTemplates holds the value of the item_templates edge. */
   item_templates: EntItemTemplate[];
   /** Items holds the value of the items edge. */
   items: EntItem[];
+  /** KioskSyncActions holds the value of the kiosk_sync_actions edge. */
+  kiosk_sync_actions: EntKioskSyncAction[];
+  /** LabelTemplates holds the value of the label_templates edge. */
+  label_templates: EntLabelTemplate[];
   /** Labels holds the value of the labels edge. */
   labels: EntLabel[];
+  /** Loans holds the value of the loans edge. */
+  loans: EntLoan[];
   /** Locations holds the value of the locations edge. */
   locations: EntLocation[];
   /** Notifiers holds the value of the notifiers edge. */
   notifiers: EntNotifier[];
+  /** PrintJobs holds the value of the print_jobs edge. */
+  print_jobs: EntPrintJob[];
+  /** Printers holds the value of the printers edge. */
+  printers: EntPrinter[];
+  /** SavedSearches holds the value of the saved_searches edge. */
+  saved_searches: EntSavedSearch[];
+  /** StockMovements holds the value of the stock_movements edge. */
+  stock_movements: EntStockMovement[];
+  /** StocktakeSessions holds the value of the stocktake_sessions edge. */
+  stocktake_sessions: EntStocktakeSession[];
   /** Users holds the value of the users edge. */
   users: EntUser[];
 }
@@ -202,8 +575,12 @@ export interface EntItem {
   archived: boolean;
   /** AssetID holds the value of the "asset_id" field. */
   asset_id: number;
+  /** Prefix of the series the asset ID is numbered in, empty for the group's */
+  asset_id_prefix: string;
   /** CreatedAt holds the value of the "created_at" field. */
   created_at: string;
+  /** DeletedAt holds the value of the "deleted_at" field. */
+  deleted_at: string;
   /** Description holds the value of the "description" field. */
   description: string;
   /**
@@ -221,6 +598,8 @@ export interface EntItem {
   lifetime_warranty: boolean;
   /** Manufacturer holds the value of the "manufacturer" field. */
   manufacturer: string;
+  /** Quantity below which the item is low on stock (0 = not tracked) */
+  min_stock: number;
   /** ModelNumber holds the value of the "model_number" field. */
   model_number: string;
   /** Name holds the value of the "name" field. */
@@ -235,6 +614,12 @@ export interface EntItem {
   purchase_time: string;
   /** Quantity holds the value of the "quantity" field. */
   quantity: number;
+  /** When the quarantine ends on its own (null = until staff sign off) */
+  quarantine_until: string;
+  /** When the item was returned into quarantine (null = not quarantined) */
+  quarantined_at: string;
+  /** Quantity to order when the item is low on stock */
+  reorder_quantity: number;
   /** SerialNumber holds the value of the "serial_number" field. */
   serial_number: string;
   /** SoldNotes holds the value of the "sold_notes" field. */
@@ -245,6 +630,8 @@ export interface EntItem {
   sold_time: string;
   /** SoldTo holds the value of the "sold_to" field. */
   sold_to: string;
+  /** Lifecycle status, only changed through the allowed transitions */
+  status: ItemStatus;
   /** SyncChildItemsLocations holds the value of the "sync_child_items_locations" field. */
   sync_child_items_locations: boolean;
   /** UpdatedAt holds the value of the "updated_at" field. */
@@ -264,14 +651,24 @@ export interface EntItemEdges {
   fields: EntItemField[];
   /** Group holds the value of the group edge. */
   group: EntGroup;
+  /** Identifiers holds the value of the identifiers edge. */
+  identifiers: EntItemIdentifier[];
   /** Label holds the value of the label edge. */
   label: EntLabel[];
+  /** Loans holds the value of the loans edge. */
+  loans: EntLoan[];
   /** Location holds the value of the location edge. */
   location: EntLocation;
   /** MaintenanceEntries holds the value of the maintenance_entries edge. */
   maintenance_entries: EntMaintenanceEntry[];
   /** Parent holds the value of the parent edge. */
   parent: EntItem;
+  /** StatusChanges holds the value of the status_changes edge. */
+  status_changes: EntItemStatusChange[];
+  /** StockMovements holds the value of the stock_movements edge. */
+  stock_movements: EntStockMovement[];
+  /** StocktakeEntries holds the value of the stocktake_entries edge. */
+  stocktake_entries: EntStocktakeEntry[];
 }
 
 export interface EntItemField {
@@ -290,9 +687,9 @@ export interface EntItemField {
   id: string;
   /** Name holds the value of the "name" field. */
   name: string;
-  /** NumberValue holds the value of the "number_value" field. */
+  /** Value of number and decimal fields */
   number_value: number;
-  /** TextValue holds the value of the "text_value" field. */
+  /** Value of text, select and url fields, and the semicolon separated values of multiselect fields */
   text_value: string;
   /** TimeValue holds the value of the "time_value" field. */
   time_value: string;
@@ -307,6 +704,70 @@ export interface EntItemFieldEdges {
   item: EntItem;
 }
 
+export interface EntItemIdentifier {
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the ItemIdentifierQuery when eager-loading is set.
+   */
+  edges: EntItemIdentifierEdges;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** ItemID holds the value of the "item_id" field. */
+  item_id: string;
+  /** Value in lower case, identifiers are unique ignoring case */
+  normalized_value: string;
+  /** Type holds the value of the "type" field. */
+  type: ItemidentifierType;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+  /** Value holds the value of the "value" field. */
+  value: string;
+}
+
+export interface EntItemIdentifierEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** Item holds the value of the item edge. */
+  item: EntItem;
+}
+
+export interface EntItemStatusChange {
+  /** ActorID holds the value of the "actor_id" field. */
+  actor_id: string;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the ItemStatusChangeQuery when eager-loading is set.
+   */
+  edges: EntItemStatusChangeEdges;
+  /** FromStatus holds the value of the "from_status" field. */
+  from_status: ItemstatuschangeFromStatus;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** ItemID holds the value of the "item_id" field. */
+  item_id: string;
+  /** Reason holds the value of the "reason" field. */
+  reason: string;
+  /** ToStatus holds the value of the "to_status" field. */
+  to_status: ItemstatuschangeToStatus;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntItemStatusChangeEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** Item holds the value of the item edge. */
+  item: EntItem;
+}
+
 export interface EntItemTemplate {
   /** CreatedAt holds the value of the "created_at" field. */
   created_at: string;
@@ -360,7 +821,77 @@ export interface EntItemTemplateEdges {
   location: EntLocation;
 }
 
+export interface EntKioskSession {
+  /** AppVersion holds the value of the "app_version" field. */
+  app_version: string;
+  /** BatteryCharging holds the value of the "battery_charging" field. */
+  battery_charging: boolean;
+  /** Battery charge in percent (null = unknown or no battery) */
+  battery_level: number;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the KioskSessionQuery when eager-loading is set.
+   */
+  edges: EntKioskSessionEdges;
+  /** ID of the ent. */
+  id: string;
+  /** Whether kiosk mode is currently active */
+  is_active: boolean;
+  /** When someone last interacted with the kiosk device, as reported by its heartbeat */
+  last_activity_at: string;
+  /** When the kiosk device last sent a heartbeat */
+  last_seen_at: string;
+  /** Network connection reported by the device, e.g. wifi, ethernet or cellular */
+  network_type: string;
+  /** When staff were alerted that the kiosk went silent (cleared on the next heartbeat) */
+  offline_alerted_at: string;
+  /** When the temporary admin unlock expires (null = locked) */
+  unlocked_until: string;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntKioskSessionEdges {
+  /** Location holds the value of the location edge. */
+  location: EntLocation;
+  /** User holds the value of the user edge. */
+  user: EntUser;
+}
+
+export interface EntKioskSyncAction {
+  /** Action holds the value of the "action" field. */
+  action: KiosksyncactionAction;
+  /** When the action happened on the kiosk */
+  client_timestamp: string;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the KioskSyncActionQuery when eager-loading is set.
+   */
+  edges: EntKioskSyncActionEdges;
+  /** ID of the ent. */
+  id: string;
+  /** Client generated key identifying the queued action */
+  idempotency_key: string;
+  /** ID of the loan or borrower the action created or updated */
+  result_id: string;
+  /** pending while the action is being applied, applied once it succeeded */
+  status: KiosksyncactionStatus;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntKioskSyncActionEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+}
+
 export interface EntLabel {
+  /** Items created with the label are numbered in their own series under this prefix */
+  asset_id_prefix: string;
   /** Color holds the value of the "color" field. */
   color: string;
   /** CreatedAt holds the value of the "created_at" field. */
@@ -376,6 +907,10 @@ export interface EntLabel {
   id: string;
   /** Name holds the value of the "name" field. */
   name: string;
+  /** How long returned items stay in quarantine (0 = until staff sign off) */
+  quarantine_minutes: number;
+  /** Whether returned items with this label must be inspected before they can be lent again */
+  quarantine_on_return: boolean;
   /** UpdatedAt holds the value of the "updated_at" field. */
   updated_at: string;
 }
@@ -387,9 +922,85 @@ export interface EntLabelEdges {
   items: EntItem[];
 }
 
+export interface EntLabelTemplate {
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /** Description holds the value of the "description" field. */
+  description: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the LabelTemplateQuery when eager-loading is set.
+   */
+  edges: EntLabelTemplateEdges;
+  /** JSON encoded list of the printed fields */
+  fields: string;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** Name holds the value of the "name" field. */
+  name: string;
+  /** Symbology holds the value of the "symbology" field. */
+  symbology: LabeltemplateSymbology;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntLabelTemplateEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+}
+
+export interface EntLoan {
+  /** When the item was checked out */
+  checked_out_at: string;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /** When the item is expected to be returned */
+  due_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the LoanQuery when eager-loading is set.
+   */
+  edges: EntLoanEdges;
+  /** ID of the ent. */
+  id: string;
+  /** Whether this loan was created/returned via kiosk self-service */
+  kiosk_action: boolean;
+  /** Notes made at checkout */
+  notes: string;
+  /** Number of items borrowed (for items with quantity > 1) */
+  quantity: number;
+  /** Notes made at return (e.g., condition) */
+  return_notes: string;
+  /** When the item was actually returned (null = still on loan) */
+  returned_at: string;
+  /** Whether the item was returned at a kiosk outside its home location */
+  transfer: boolean;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntLoanEdges {
+  /** Borrower holds the value of the borrower edge. */
+  borrower: EntBorrower;
+  /** CheckedOutBy holds the value of the checked_out_by edge. */
+  checked_out_by: EntUser;
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** Item holds the value of the item edge. */
+  item: EntItem;
+  /** ReturnLocation holds the value of the return_location edge. */
+  return_location: EntLocation;
+  /** ReturnedBy holds the value of the returned_by edge. */
+  returned_by: EntUser;
+}
+
 export interface EntLocation {
   /** CreatedAt holds the value of the "created_at" field. */
   created_at: string;
+  /** DeletedAt holds the value of the "deleted_at" field. */
+  deleted_at: string;
   /** Description holds the value of the "description" field. */
   description: string;
   /**
@@ -412,8 +1023,14 @@ export interface EntLocationEdges {
   group: EntGroup;
   /** Items holds the value of the items edge. */
   items: EntItem[];
+  /** KioskSessions holds the value of the kiosk_sessions edge. */
+  kiosk_sessions: EntKioskSession[];
   /** Parent holds the value of the parent edge. */
   parent: EntLocation;
+  /** ReturnedLoans holds the value of the returned_loans edge. */
+  returned_loans: EntLoan[];
+  /** StocktakeSessions holds the value of the stocktake_sessions edge. */
+  stocktake_sessions: EntStocktakeSession[];
 }
 
 export interface EntMaintenanceEntry {
@@ -476,31 +1093,257 @@ export interface EntNotifierEdges {
   user: EntUser;
 }
 
-export interface EntTemplateField {
+export interface EntPrintJob {
+  /** Attempts holds the value of the "attempts" field. */
+  attempts: number;
   /** CreatedAt holds the value of the "created_at" field. */
   created_at: string;
-  /** Description holds the value of the "description" field. */
-  description: string;
+  /** CreatedBy holds the value of the "created_by" field. */
+  created_by: string;
   /**
    * Edges holds the relations/edges for other nodes in the graph.
-   * The values are being populated by the TemplateFieldQuery when eager-loading is set.
+   * The values are being populated by the PrintJobQuery when eager-loading is set.
    */
-  edges: EntTemplateFieldEdges;
+  edges: EntPrintJobEdges;
+  /** Error holds the value of the "error" field. */
+  error: string;
+  /** FinishedAt holds the value of the "finished_at" field. */
+  finished_at: string;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
   /** ID of the ent. */
   id: string;
+  /** Labels holds the value of the "labels" field. */
+  labels: number;
+  /** MaxAttempts holds the value of the "max_attempts" field. */
+  max_attempts: number;
   /** Name holds the value of the "name" field. */
   name: string;
-  /** TextValue holds the value of the "text_value" field. */
-  text_value: string;
-  /** Type holds the value of the "type" field. */
-  type: TemplatefieldType;
+  /** JSON encoded labels and sheet layout */
+  payload: string;
+  /** PrinterID holds the value of the "printer_id" field. */
+  printer_id: string;
+  /** Time of the next attempt */
+  run_at: string;
+  /** Status holds the value of the "status" field. */
+  status: PrintjobStatus;
   /** UpdatedAt holds the value of the "updated_at" field. */
   updated_at: string;
 }
 
-export interface EntTemplateFieldEdges {
-  /** ItemTemplate holds the value of the item_template edge. */
-  item_template: EntItemTemplate;
+export interface EntPrintJobEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** Printer holds the value of the printer edge. */
+  printer: EntPrinter;
+}
+
+export interface EntPrinter {
+  /** Host and port of raw printers, URL of IPP printers, printer name for the print command */
+  address: string;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /** Description holds the value of the "description" field. */
+  description: string;
+  /** Dpi holds the value of the "dpi" field. */
+  dpi: number;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the PrinterQuery when eager-loading is set.
+   */
+  edges: EntPrinterEdges;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** Kind holds the value of the "kind" field. */
+  kind: PrinterKind;
+  /** Printer language of raw printers */
+  language: PrinterLanguage;
+  /** Name holds the value of the "name" field. */
+  name: string;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntPrinterEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** Jobs holds the value of the jobs edge. */
+  jobs: EntPrintJob[];
+}
+
+export interface EntSavedSearch {
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /** Description holds the value of the "description" field. */
+  description: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the SavedSearchQuery when eager-loading is set.
+   */
+  edges: EntSavedSearchEdges;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** Name holds the value of the "name" field. */
+  name: string;
+  /** JSON encoded item query */
+  query: string;
+  /** Shared holds the value of the "shared" field. */
+  shared: boolean;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+  /** UserID holds the value of the "user_id" field. */
+  user_id: string;
+}
+
+export interface EntSavedSearchEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** User holds the value of the user edge. */
+  user: EntUser;
+}
+
+export interface EntStockMovement {
+  /** ActorID holds the value of the "actor_id" field. */
+  actor_id: string;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /** Delta holds the value of the "delta" field. */
+  delta: number;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the StockMovementQuery when eager-loading is set.
+   */
+  edges: EntStockMovementEdges;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** ItemID holds the value of the "item_id" field. */
+  item_id: string;
+  /** Note holds the value of the "note" field. */
+  note: string;
+  /** Quantity of the item after the movement */
+  quantity: number;
+  /** Reason holds the value of the "reason" field. */
+  reason: StockmovementReason;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntStockMovementEdges {
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** Item holds the value of the item edge. */
+  item: EntItem;
+}
+
+export interface EntStocktakeEntry {
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the StocktakeEntryQuery when eager-loading is set.
+   */
+  edges: EntStocktakeEntryEdges;
+  /** Whether the item was in the session's locations when it started */
+  expected: boolean;
+  /** Where the item was recorded to be */
+  expected_location_id: string;
+  /** FoundAt holds the value of the "found_at" field. */
+  found_at: string;
+  /** FoundBy holds the value of the "found_by" field. */
+  found_by: string;
+  /** Where the item was scanned */
+  found_location_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** ItemID holds the value of the "item_id" field. */
+  item_id: string;
+  /** Whether the item was missing because it was on loan, set when the session closes */
+  on_loan: boolean;
+  /** SessionID holds the value of the "session_id" field. */
+  session_id: string;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+  /** The scanned asset ID or identifier, empty for items not found yet */
+  value: string;
+}
+
+export interface EntStocktakeEntryEdges {
+  /** Item holds the value of the item edge. */
+  item: EntItem;
+  /** Session holds the value of the session edge. */
+  session: EntStocktakeSession;
+}
+
+export interface EntStocktakeSession {
+  /** ClosedAt holds the value of the "closed_at" field. */
+  closed_at: string;
+  /** ClosedBy holds the value of the "closed_by" field. */
+  closed_by: string;
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the StocktakeSessionQuery when eager-loading is set.
+   */
+  edges: EntStocktakeSessionEdges;
+  /** GroupID holds the value of the "group_id" field. */
+  group_id: string;
+  /** ID of the ent. */
+  id: string;
+  /** LocationID holds the value of the "location_id" field. */
+  location_id: string;
+  /** Name holds the value of the "name" field. */
+  name: string;
+  /** Notes holds the value of the "notes" field. */
+  notes: string;
+  /** StartedBy holds the value of the "started_by" field. */
+  started_by: string;
+  /** Status holds the value of the "status" field. */
+  status: StocktakesessionStatus;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntStocktakeSessionEdges {
+  /** Entries holds the value of the entries edge. */
+  entries: EntStocktakeEntry[];
+  /** Group holds the value of the group edge. */
+  group: EntGroup;
+  /** Location holds the value of the location edge. */
+  location: EntLocation;
+}
+
+export interface EntTemplateField {
+  /** CreatedAt holds the value of the "created_at" field. */
+  created_at: string;
+  /** Description holds the value of the "description" field. */
+  description: string;
+  /**
+   * Edges holds the relations/edges for other nodes in the graph.
+   * The values are being populated by the TemplateFieldQuery when eager-loading is set.
+   */
+  edges: EntTemplateFieldEdges;
+  /** ID of the ent. */
+  id: string;
+  /** Name holds the value of the "name" field. */
+  name: string;
+  /** Default value in the text form used by CSV imports, converted to the field's type */
+  text_value: string;
+  /** Type holds the value of the "type" field. */
+  type: TemplatefieldType;
+  /** UpdatedAt holds the value of the "updated_at" field. */
+  updated_at: string;
+}
+
+export interface EntTemplateFieldEdges {
+  /** ItemTemplate holds the value of the item_template edge. */
+  item_template: EntItemTemplate;
 }
 
 export interface EntUser {
@@ -536,10 +1379,48 @@ export interface EntUser {
 export interface EntUserEdges {
   /** AuthTokens holds the value of the auth_tokens edge. */
   auth_tokens: EntAuthTokens[];
+  /** Checkouts holds the value of the checkouts edge. */
+  checkouts: EntLoan[];
   /** Group holds the value of the group edge. */
   group: EntGroup;
+  /** KioskSession holds the value of the kiosk_session edge. */
+  kiosk_session: EntKioskSession;
   /** Notifiers holds the value of the notifiers edge. */
   notifiers: EntNotifier[];
+  /** Returns holds the value of the returns edge. */
+  returns: EntLoan[];
+  /** SavedSearches holds the value of the saved_searches edge. */
+  saved_searches: EntSavedSearch[];
+}
+
+export interface AssetIDFormat {
+  checkDigit: "none" | "luhn" | "mod11";
+  /** @maxLength 32 */
+  prefix: string;
+  /**
+   * @min 0
+   * @max 12
+   */
+  width: number;
+}
+
+export interface AuditChange {
+  added: string[];
+  new: any;
+  old: any;
+  removed: string[];
+}
+
+export interface AuditEntryOut {
+  action: string;
+  actorId?: string | null;
+  actorName: string;
+  changes: Record<string, AuditChange>;
+  createdAt: Date | string;
+  entityId: string;
+  entityType: string;
+  id: string;
+  source: string;
 }
 
 export interface BarcodeProduct {
@@ -555,6 +1436,77 @@ export interface BarcodeProduct {
   search_engine_name: string;
 }
 
+export interface BorrowerCreate {
+  /** @maxLength 255 */
+  email: string;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  /** @maxLength 1000 */
+  notes: string;
+  /** @maxLength 255 */
+  organization: string;
+  /** @maxLength 50 */
+  phone: string;
+  /** @maxLength 100 */
+  studentId: string;
+}
+
+export interface BorrowerOut {
+  activeLoans: number;
+  approvedAt: string;
+  createdAt: Date | string;
+  email: string;
+  emailVerifiedAt: string;
+  id: string;
+  isActive: boolean;
+  name: string;
+  notes: string;
+  organization: string;
+  phone: string;
+  selfRegistered: boolean;
+  studentId: string;
+  totalLoans: number;
+  updatedAt: Date | string;
+  verificationStatus: string;
+}
+
+export interface BorrowerSummary {
+  createdAt: Date | string;
+  email: string;
+  id: string;
+  isActive: boolean;
+  name: string;
+  organization: string;
+  phone: string;
+  selfRegistered: boolean;
+  studentId: string;
+  updatedAt: Date | string;
+  verificationStatus: string;
+}
+
+export interface BorrowerUpdate {
+  /** @maxLength 255 */
+  email: string;
+  id: string;
+  isActive: boolean;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  /** @maxLength 1000 */
+  notes: string;
+  /** @maxLength 255 */
+  organization: string;
+  /** @maxLength 50 */
+  phone: string;
+  /** @maxLength 100 */
+  studentId: string;
+}
+
 export interface DuplicateOptions {
   copyAttachments: boolean;
   copyCustomFields: boolean;
@@ -562,7 +1514,51 @@ export interface DuplicateOptions {
   copyPrefix: string;
 }
 
+export interface FieldDefinitionCreate {
+  /** @maxLength 1000 */
+  description: string;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  options: string[];
+  type: "text" | "number" | "decimal" | "boolean" | "time" | "select" | "multiselect" | "url";
+}
+
+export interface FieldDefinitionOut {
+  createdAt: Date | string;
+  description: string;
+  id: string;
+  name: string;
+  options: string[];
+  type: string;
+  updatedAt: Date | string;
+}
+
+export interface FieldDefinitionUpdate {
+  /** @maxLength 1000 */
+  description: string;
+  id: string;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  options: string[];
+  type: "text" | "number" | "decimal" | "boolean" | "time" | "select" | "multiselect" | "url";
+}
+
+export interface FieldQuery {
+  name: string;
+  op: FieldOp;
+  /** To is the upper bound of FieldOpBetween filters, Value the lower one */
+  to: string;
+  value: string;
+}
+
 export interface Group {
+  assetIdFormat: AssetIDFormat;
   createdAt: Date | string;
   currency: string;
   id: string;
@@ -571,6 +1567,8 @@ export interface Group {
 }
 
 export interface GroupStatistics {
+  /** ItemsByStatus counts the unarchived items in each lifecycle status */
+  itemsByStatus: ItemStatusCount[];
   totalItemPrice: number;
   totalItems: number;
   totalLabels: number;
@@ -584,6 +1582,12 @@ export interface GroupUpdate {
   name: string;
 }
 
+export interface IdentifierMatch {
+  item: ItemSummary;
+  type: IdentifierType;
+  value: string;
+}
+
 export interface ItemAttachment {
   createdAt: Date | string;
   id: string;
@@ -602,6 +1606,40 @@ export interface ItemAttachmentUpdate {
   type: string;
 }
 
+export interface ItemBulkField {
+  /** @maxLength 255 */
+  name: string;
+  /** @maxLength 500 */
+  value: string;
+}
+
+export interface ItemBulkOperations {
+  addLabelIds: string[];
+  archived?: boolean | null;
+  delete: boolean;
+  field?: ItemBulkField | null;
+  locationId?: string | null;
+  removeLabelIds: string[];
+}
+
+export interface ItemBulkOut {
+  /** Committed is false when any item failed, in which case nothing was changed */
+  committed: boolean;
+  results: ItemBulkResult[];
+}
+
+export interface ItemBulkRequest {
+  ids: string[];
+  operations: ItemBulkOperations;
+  query?: ItemQuery | null;
+}
+
+export interface ItemBulkResult {
+  error: string;
+  id: string;
+  status: ItemBulkStatus;
+}
+
 export interface ItemCreate {
   /** @maxLength 1000 */
   description: string;
@@ -622,19 +1660,49 @@ export interface ItemField {
   id: string;
   name: string;
   numberValue: number;
+  selectValues: string[];
   textValue: string;
+  timeValue: string;
   type: string;
 }
 
+export interface ItemIdentifierCreate {
+  type: "upc" | "ean" | "isbn" | "legacy_tag" | "nfc" | "rfid" | "other";
+  /** @maxLength 255 */
+  value: string;
+}
+
+export interface ItemIdentifierOut {
+  createdAt: Date | string;
+  id: string;
+  type: IdentifierType;
+  value: string;
+}
+
+export interface ItemIssue {
+  /** @maxLength 1000 */
+  note: string;
+  /** @min 1 */
+  quantity: number;
+}
+
 export interface ItemOut {
   archived: boolean;
   /** @example "0" */
   assetId: string;
+  /**
+   * AssetIDPrefix is the prefix of the label series the item is numbered in, empty
+   * for the group's series
+   */
+  assetIdPrefix: string;
+  /** AssetTag is the asset ID written in the group's format, e.g. HB-LAP-0042 */
+  assetTag: string;
   attachments: ItemAttachment[];
   createdAt: Date | string;
   description: string;
   fields: ItemField[];
   id: string;
+  identifiers: ItemIdentifierOut[];
   imageId?: string | null;
   insured: boolean;
   labels: LabelSummary[];
@@ -642,7 +1710,11 @@ export interface ItemOut {
   lifetimeWarranty: boolean;
   /** Edges */
   location?: LocationSummary | null;
+  /** LowStock is set when the quantity has dropped below MinStock */
+  lowStock: boolean;
   manufacturer: string;
+  /** Consumable stock */
+  minStock: number;
   modelNumber: string;
   name: string;
   /** Extras */
@@ -653,12 +1725,19 @@ export interface ItemOut {
   /** Purchase */
   purchaseTime: Date | string;
   quantity: number;
+  quarantineUntil?: string | null;
+  /** Post-return quarantine */
+  quarantined: boolean;
+  quarantinedAt?: string | null;
+  reorderQuantity: number;
   serialNumber: string;
   soldNotes: string;
   soldPrice: number;
   /** Sold */
   soldTime: Date | string;
   soldTo: string;
+  /** Status is the lifecycle status, see ItemStatusTransitions */
+  status: ItemStatus;
   syncChildItemsLocations: boolean;
   thumbnailId?: string | null;
   updatedAt: Date | string;
@@ -671,6 +1750,10 @@ export interface ItemPatch {
   labelIds?: string[] | null;
   locationId?: string | null;
   quantity?: number | null;
+  /** @maxLength 1000 */
+  quantityNote: string;
+  /** Why the quantity changed, recorded in the stock ledger */
+  quantityReason: "purchase" | "issue" | "loss" | "adjustment" | "count_correction";
 }
 
 export interface ItemPath {
@@ -679,6 +1762,44 @@ export interface ItemPath {
   type: ItemType;
 }
 
+export interface ItemQuery {
+  assetId: number;
+  fields: FieldQuery[];
+  includeArchived: boolean;
+  labelIds: string[];
+  locationIds: string[];
+  negateLabels: boolean;
+  onlyWithPhoto: boolean;
+  onlyWithoutPhoto: boolean;
+  orderBy: string;
+  parentIds: string[];
+  search: string;
+  sortBy: string;
+  /** Statuses limits the items to these lifecycle statuses, any status when empty */
+  statuses: ItemStatus[];
+}
+
+export interface ItemStatusChangeOut {
+  actorId?: string | null;
+  actorName: string;
+  createdAt: Date | string;
+  from: ItemStatus;
+  id: string;
+  reason: string;
+  to: ItemStatus;
+}
+
+export interface ItemStatusCount {
+  status: ItemStatus;
+  total: number;
+}
+
+export interface ItemStatusTransition {
+  /** @maxLength 1000 */
+  reason: string;
+  status: "in_service" | "in_repair" | "lost" | "stolen" | "retired" | "disposed";
+}
+
 export interface ItemSummary {
   archived: boolean;
   /** @example "0" */
@@ -691,11 +1812,22 @@ export interface ItemSummary {
   labels: LabelSummary[];
   /** Edges */
   location?: LocationSummary | null;
+  /** LowStock is set when the quantity has dropped below MinStock */
+  lowStock: boolean;
+  /** Consumable stock */
+  minStock: number;
   name: string;
   purchasePrice: number;
   quantity: number;
+  quarantineUntil?: string | null;
+  /** Post-return quarantine */
+  quarantined: boolean;
+  quarantinedAt?: string | null;
+  reorderQuantity: number;
   /** Sale details */
   soldTime: Date | string;
+  /** Status is the lifecycle status, see ItemStatusTransitions */
+  status: ItemStatus;
   thumbnailId?: string | null;
   updatedAt: Date | string;
 }
@@ -735,6 +1867,28 @@ export interface ItemTemplateCreate {
   notes: string;
 }
 
+export interface ItemTemplateExport {
+  templates: PortableItemTemplate[];
+  version: number;
+}
+
+export interface ItemTemplateImport {
+  conflict: "skip" | "rename" | "overwrite";
+  templates: PortableItemTemplate[];
+  version: number;
+}
+
+export interface ItemTemplateImportResult {
+  created: ItemTemplateSummary[];
+  skipped: string[];
+  updated: ItemTemplateSummary[];
+  /**
+   * Warnings name the locations and labels that don't exist in the group, the
+   * templates are imported without them
+   */
+  warnings: string[];
+}
+
 export interface ItemTemplateOut {
   createdAt: Date | string;
   defaultDescription: string;
@@ -820,6 +1974,11 @@ export interface ItemUpdate {
   /** Edges */
   locationId: string;
   manufacturer: string;
+  /**
+   * Consumable stock, see ItemSummary.LowStock
+   * @min 0
+   */
+  minStock: number;
   modelNumber: string;
   /**
    * @minLength 1
@@ -835,6 +1994,12 @@ export interface ItemUpdate {
   /** Purchase */
   purchaseTime: Date | string;
   quantity: number;
+  /** @maxLength 1000 */
+  quantityNote: string;
+  /** Why the quantity changed, recorded in the stock ledger */
+  quantityReason: "purchase" | "issue" | "loss" | "adjustment" | "count_correction";
+  /** @min 0 */
+  reorderQuantity: number;
   /** Identifications */
   serialNumber: string;
   soldNotes: string;
@@ -848,7 +2013,30 @@ export interface ItemUpdate {
   warrantyExpires: Date | string;
 }
 
+export interface KioskHeartbeat {
+  /** @maxLength 64 */
+  appVersion: string;
+  batteryCharging: boolean;
+  /**
+   * @min 0
+   * @max 100
+   */
+  batteryLevel: number;
+  /**
+   * IdleSeconds is how long ago someone last interacted with the device
+   * @min 0
+   */
+  idleSeconds: number;
+  /** @maxLength 32 */
+  networkType: string;
+}
+
 export interface LabelCreate {
+  /**
+   * AssetIDPrefix numbers the items created with the label in their own series
+   * @maxLength 32
+   */
+  assetIdPrefix: string;
   color: string;
   /** @maxLength 1000 */
   description: string;
@@ -857,26 +2045,163 @@ export interface LabelCreate {
    * @maxLength 255
    */
   name: string;
+  /** @min 0 */
+  quarantineMinutes: number;
+  quarantineOnReturn: boolean;
 }
 
 export interface LabelOut {
+  assetIdPrefix: string;
   color: string;
   createdAt: Date | string;
   description: string;
   id: string;
   name: string;
+  quarantineMinutes: number;
+  quarantineOnReturn: boolean;
   updatedAt: Date | string;
 }
 
 export interface LabelSummary {
+  assetIdPrefix: string;
   color: string;
   createdAt: Date | string;
   description: string;
   id: string;
   name: string;
+  quarantineMinutes: number;
+  quarantineOnReturn: boolean;
+  updatedAt: Date | string;
+}
+
+export interface LabelTemplateCreate {
+  /** @maxLength 1000 */
+  description: string;
+  /**
+   * @maxItems 20
+   * @minItems 1
+   */
+  fields: LabelTemplateField[];
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  symbology: "qr" | "datamatrix" | "code128" | "none";
+}
+
+export interface LabelTemplateField {
+  bold: boolean;
+  field: "asset_id" | "name" | "description" | "serial_number" | "model_number" | "manufacturer" | "location" | "location_path" | "custom_field" | "text";
+  /** @maxLength 255 */
+  fieldName: string;
+  /**
+   * FontSize is the size of the line, 0 for the configured size
+   * @min 0
+   * @max 200
+   */
+  fontSize: number;
+  /**
+   * Prefix is printed before the value, e.g. S/N:
+   * @maxLength 255
+   */
+  prefix: string;
+  /** @maxLength 255 */
+  text: string;
+}
+
+export interface LabelTemplateOut {
+  createdAt: Date | string;
+  description: string;
+  fields: LabelTemplateField[];
+  id: string;
+  name: string;
+  symbology: string;
+  updatedAt: Date | string;
+}
+
+export interface LabelTemplateUpdate {
+  /** @maxLength 1000 */
+  description: string;
+  /**
+   * @maxItems 20
+   * @minItems 1
+   */
+  fields: LabelTemplateField[];
+  id: string;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  symbology: "qr" | "datamatrix" | "code128" | "none";
+}
+
+export interface LoanCreate {
+  borrowerId: string;
+  dueAt: string;
+  itemId: string;
+  /** @maxLength 1000 */
+  notes: string;
+  /** @min 1 */
+  quantity: number;
+}
+
+export interface LoanOut {
+  borrowerEmail: string;
+  borrowerId: string;
+  borrowerName: string;
+  borrowerPhone: string;
+  checkedOutAt: string;
+  checkedOutBy: string;
+  createdAt: Date | string;
+  dueAt: string;
+  id: string;
+  isOverdue: boolean;
+  isTransfer: boolean;
+  itemAssetId: number;
+  itemId: string;
+  itemName: string;
+  kioskAction: boolean;
+  notes: string;
+  quantity: number;
+  returnLocationId: string;
+  returnLocationName: string;
+  returnNotes: string;
+  returnedAt: string;
+  returnedBy: string;
+  updatedAt: Date | string;
+}
+
+export interface LoanReturn {
+  id: string;
+  /** @maxLength 1000 */
+  returnNotes: string;
+}
+
+export interface LoanSummary {
+  borrowerId: string;
+  borrowerName: string;
+  checkedOutAt: string;
+  createdAt: Date | string;
+  dueAt: string;
+  id: string;
+  isOverdue: boolean;
+  isTransfer: boolean;
+  itemId: string;
+  itemName: string;
+  quantity: number;
+  returnedAt: string;
   updatedAt: Date | string;
 }
 
+export interface LoanUpdate {
+  dueAt: string;
+  id: string;
+  /** @maxLength 1000 */
+  notes: string;
+}
+
 export interface LocationCreate {
   description: string;
   name: string;
@@ -943,57 +2268,317 @@ export interface MaintenanceEntryUpdate {
   cost: string;
   description: string;
   name: string;
-  scheduledDate: Date | string;
+  scheduledDate: Date | string;
+}
+
+export interface MaintenanceEntryWithDetails {
+  completedDate: Date | string;
+  /** @example "0" */
+  cost: string;
+  description: string;
+  id: string;
+  itemID: string;
+  itemName: string;
+  name: string;
+  scheduledDate: Date | string;
+}
+
+export interface NotifierCreate {
+  isActive: boolean;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  url: string;
+}
+
+export interface NotifierOut {
+  createdAt: Date | string;
+  groupId: string;
+  id: string;
+  isActive: boolean;
+  name: string;
+  updatedAt: Date | string;
+  url: string;
+  userId: string;
+}
+
+export interface NotifierUpdate {
+  isActive: boolean;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  url?: string | null;
+}
+
+export interface PaginationResultAuditEntryOut {
+  items: AuditEntryOut[];
+  page: number;
+  pageSize: number;
+  total: number;
+}
+
+export interface PaginationResultItemSummary {
+  items: ItemSummary[];
+  page: number;
+  pageSize: number;
+  total: number;
+}
+
+export interface PaginationResultPrintJobOut {
+  items: PrintJobOut[];
+  page: number;
+  pageSize: number;
+  total: number;
+}
+
+export interface PortableItemTemplate {
+  /** @maxLength 1000 */
+  defaultDescription: string;
+  defaultInsured: boolean;
+  defaultLabels: string[];
+  defaultLifetimeWarranty: boolean;
+  defaultLocation: string;
+  /** @maxLength 255 */
+  defaultManufacturer: string;
+  /** @maxLength 255 */
+  defaultModelNumber: string;
+  /** @maxLength 255 */
+  defaultName: string;
+  defaultQuantity: number;
+  /** @maxLength 1000 */
+  defaultWarrantyDetails: string;
+  /** @maxLength 1000 */
+  description: string;
+  fields: PortableTemplateField[];
+  includePurchaseFields: boolean;
+  includeSoldFields: boolean;
+  includeWarrantyFields: boolean;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  /** @maxLength 1000 */
+  notes: string;
+}
+
+export interface PortableTemplateField {
+  name: string;
+  textValue: string;
+  type: string;
+}
+
+export interface PrintJobOut {
+  attempts: number;
+  createdAt: Date | string;
+  createdBy?: string | null;
+  error: string;
+  finishedAt?: string | null;
+  id: string;
+  labels: number;
+  maxAttempts: number;
+  name: string;
+  printer: PrintJobPrinter;
+  /** RunAt is when the job is printed next while it is queued */
+  runAt: string;
+  status: string;
+  updatedAt: Date | string;
+}
+
+export interface PrintJobPrinter {
+  id: string;
+  name: string;
+}
+
+export interface PrinterCreate {
+  /** @maxLength 1024 */
+  address: string;
+  /** @maxLength 1000 */
+  description: string;
+  /**
+   * DPI is the resolution of raw printers, 203 when 0
+   * @min 0
+   * @max 1200
+   */
+  dpi: number;
+  kind: "command" | "raw" | "ipp";
+  /** Language is the printer language of raw printers */
+  language: "zpl" | "epl";
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+}
+
+export interface PrinterOut {
+  address: string;
+  createdAt: Date | string;
+  description: string;
+  dpi: number;
+  id: string;
+  kind: string;
+  language: string;
+  name: string;
+  updatedAt: Date | string;
+}
+
+export interface PrinterUpdate {
+  /** @maxLength 1024 */
+  address: string;
+  /** @maxLength 1000 */
+  description: string;
+  /**
+   * @min 0
+   * @max 1200
+   */
+  dpi: number;
+  id: string;
+  kind: "command" | "raw" | "ipp";
+  language: "zpl" | "epl";
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+}
+
+export interface SavedSearchCreate {
+  /** @maxLength 1000 */
+  description: string;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  query: ItemQuery;
+  shared: boolean;
 }
 
-export interface MaintenanceEntryWithDetails {
-  completedDate: Date | string;
-  /** @example "0" */
-  cost: string;
+export interface SavedSearchOut {
+  createdAt: Date | string;
   description: string;
   id: string;
-  itemID: string;
-  itemName: string;
   name: string;
-  scheduledDate: Date | string;
+  query: ItemQuery;
+  shared: boolean;
+  updatedAt: Date | string;
+  userId: string;
+  userName: string;
 }
 
-export interface NotifierCreate {
-  isActive: boolean;
+export interface SavedSearchUpdate {
+  /** @maxLength 1000 */
+  description: string;
+  id: string;
   /**
    * @minLength 1
    * @maxLength 255
    */
   name: string;
-  url: string;
+  query: ItemQuery;
+  shared: boolean;
 }
 
-export interface NotifierOut {
+export interface StockLevel {
+  at: string;
+  quantity: number;
+}
+
+export interface StockMovementOut {
+  actorId?: string | null;
+  actorName: string;
   createdAt: Date | string;
-  groupId: string;
+  delta: number;
   id: string;
-  isActive: boolean;
-  name: string;
-  updatedAt: Date | string;
-  url: string;
-  userId: string;
+  itemId: string;
+  note: string;
+  quantity: number;
+  reason: StockReason;
 }
 
-export interface NotifierUpdate {
-  isActive: boolean;
+export interface StocktakeClose {
+  /** MarkMissingLost marks the missing items lost, items on loan are excused */
+  markMissingLost: boolean;
+  /** MoveMisplaced moves the misplaced items to the location they were scanned in */
+  moveMisplaced: boolean;
+}
+
+export interface StocktakeCreate {
+  locationId: string;
   /**
    * @minLength 1
    * @maxLength 255
    */
   name: string;
-  url?: string | null;
+  /** @maxLength 1000 */
+  notes: string;
 }
 
-export interface PaginationResultItemSummary {
-  items: ItemSummary[];
-  page: number;
-  pageSize: number;
-  total: number;
+export interface StocktakeEntryOut {
+  expected: boolean;
+  expectedLocation?: LocationSummary | null;
+  foundAt?: string | null;
+  foundLocation?: LocationSummary | null;
+  id: string;
+  item?: ItemSummary | null;
+  itemId?: string | null;
+  onLoan: boolean;
+  value: string;
+}
+
+export interface StocktakeProgress {
+  expected: number;
+  found: number;
+  unexpected: number;
+  unknown: number;
+}
+
+export interface StocktakeReport {
+  found: StocktakeEntryOut[];
+  markedLost: number;
+  misplaced: StocktakeEntryOut[];
+  missing: StocktakeEntryOut[];
+  /** Set when closing with StocktakeClose */
+  moved: number;
+  onLoan: StocktakeEntryOut[];
+  stocktake: StocktakeSummary;
+  unknown: StocktakeEntryOut[];
+}
+
+export interface StocktakeScan {
+  /**
+   * LocationID is the room being walked, items found without one are taken to be
+   * where they are recorded
+   */
+  locationId?: string | null;
+  /** @maxLength 255 */
+  value: string;
+}
+
+export interface StocktakeScanOut {
+  entry: StocktakeEntryOut;
+  progress: StocktakeProgress;
+  result: StocktakeScanResult;
+}
+
+export interface StocktakeSummary {
+  closedAt?: string | null;
+  closedBy?: string | null;
+  closedByName: string;
+  createdAt: Date | string;
+  id: string;
+  location: LocationSummary;
+  name: string;
+  notes: string;
+  progress: StocktakeProgress;
+  startedBy?: string | null;
+  startedByName: string;
+  status: StocktakeStatus;
 }
 
 export interface TemplateField {
@@ -1019,6 +2604,14 @@ export interface TotalsByOrganizer {
   total: number;
 }
 
+export interface TrashEntry {
+  deletedAt: string;
+  description: string;
+  id: string;
+  name: string;
+  type: ItemType;
+}
+
 export interface TreeItem {
   children: TreeItem[];
   id: string;
@@ -1057,6 +2650,55 @@ export interface ValueOverTimeEntry {
   value: number;
 }
 
+export interface KioskSyncAction {
+  borrower: BorrowerCreate;
+  checkout: KioskSyncCheckout;
+  clientTimestamp: string;
+  /**
+   * Key is generated by the kiosk and identifies the action across retries
+   * @maxLength 255
+   */
+  key: string;
+  return: KioskSyncReturn;
+  type: "checkout" | "return" | "register_borrower";
+}
+
+export interface KioskSyncCheckout {
+  /** Either BorrowerID or BorrowerKey, the key of an earlier register_borrower action */
+  borrowerId: string;
+  /** @maxLength 255 */
+  borrowerKey: string;
+  dueAt: string;
+  itemId: string;
+  /** @maxLength 1000 */
+  notes: string;
+  /** @min 0 */
+  quantity: number;
+}
+
+export interface KioskSyncRequest {
+  /** @maxItems 500 */
+  actions: KioskSyncAction[];
+}
+
+export interface KioskSyncResult {
+  key: string;
+  message: string;
+  reason: string;
+  resultId: string;
+  status: KioskSyncStatus;
+}
+
+export interface KioskSyncReturn {
+  /** @maxLength 255 */
+  checkoutKey: string;
+  itemId: string;
+  /** One of LoanID, CheckoutKey (the key of an earlier checkout action) or ItemID */
+  loanId: string;
+  /** @maxLength 1000 */
+  returnNotes: string;
+}
+
 export interface Latest {
   date: Date | string;
   version: string;
@@ -1086,6 +2728,11 @@ export interface ActionAmountResult {
   completed: number;
 }
 
+export interface BorrowerVerifyResponse {
+  message: string;
+  verificationStatus: string;
+}
+
 export interface Build {
   buildTime: string;
   commit: string;
@@ -1112,6 +2759,11 @@ export interface GroupInvitationCreate {
   uses: number;
 }
 
+export interface InspectionSignOff {
+  /** @maxLength 1000 */
+  notes: string;
+}
+
 export interface ItemAttachmentToken {
   token: string;
 }
@@ -1129,6 +2781,123 @@ export interface ItemTemplateCreateItemRequest {
   quantity: number;
 }
 
+export interface ItemTemplateCreateItemsRequest {
+  /**
+   * Count defaults to the number of serial numbers
+   * @min 1
+   * @max 500
+   */
+  count: number;
+  /** @maxLength 1000 */
+  description: string;
+  labelIds: string[];
+  locationId: string;
+  /**
+   * @minLength 1
+   * @maxLength 255
+   */
+  name: string;
+  quantity: number;
+  /**
+   * SerialNumbers is a pasted list of one serial number per unit, separated by new lines,
+   * commas or semicolons
+   */
+  serialNumbers: string;
+}
+
+export interface ItemTemplateCreateItemsResponse {
+  /**
+   * ItemIDs are the new items in the order of the serial numbers, their labels are
+   * printed with POST /v1/labelmaker/sheet
+   */
+  itemIds: string[];
+}
+
+export interface KioskDevice {
+  appVersion: string;
+  batteryCharging: boolean;
+  batteryLevel: number;
+  createdAt: Date | string;
+  groupId: string;
+  id: string;
+  isActive: boolean;
+  isOnline: boolean;
+  isUnlocked: boolean;
+  lastActivityAt: string;
+  /** Device health, as reported by the most recent heartbeat */
+  lastSeenAt: string;
+  /** Location the kiosk is bound to (nil = whole group) */
+  locationId: string;
+  locationName: string;
+  networkType: string;
+  offlineAlertedAt: string;
+  unlockedUntil: string;
+  updatedAt: Date | string;
+  userId: string;
+  userName: string;
+}
+
+export interface KioskLocationRequest {
+  locationId: string;
+}
+
+export interface KioskStatusResponse {
+  isActive: boolean;
+  isUnlocked: boolean;
+  locationId: string;
+  locationName: string;
+  unlockedUntil: string;
+}
+
+export interface KioskUnlockRequest {
+  durationMinutes: number;
+  password: string;
+}
+
+export interface LabelSheetCustomLayout {
+  /**
+   * @min 1
+   * @max 20
+   */
+  columns: number;
+  /** @min 0 */
+  gapMm: number;
+  /** @min 0 */
+  marginMm: number;
+  pageSize: "letter" | "a4";
+  /**
+   * @min 1
+   * @max 50
+   */
+  rows: number;
+}
+
+export interface LabelSheetRequest {
+  custom: LabelSheetCustomLayout;
+  /** IncludeItems also prints the labels of the items in those locations */
+  includeItems: boolean;
+  /** ItemIDs prints the labels of these items */
+  itemIds: string[];
+  /** Layout is the name of a sheet layout, or custom for the Custom layout */
+  layout: string;
+  /** LocationID prints the labels of the location and the locations nested below it */
+  locationId?: string | null;
+  /**
+   * PrinterID queues the labels for the printer instead of returning the PDF. Raw
+   * printers print them one after the other, the others on sheets of the layout.
+   */
+  printerId?: string | null;
+  /** SavedSearchID prints the labels of the items the saved search finds */
+  savedSearchId?: string | null;
+  /**
+   * Skip leaves the first positions of the first sheet empty, for partly used sheets
+   * @min 0
+   */
+  skip: number;
+  /** TemplateID lays the labels out with the label template */
+  templateId?: string | null;
+}
+
 export interface LoginForm {
   /** @example "admin" */
   password: string;
@@ -1158,130 +2927,3 @@ export interface ValidateErrorResponse {
   error: string;
   fields: string;
 }
-
-// ============================================
-// Borrower Types (Equipment Lending Feature)
-// ============================================
-
-export interface BorrowerCreate {
-  /** @minLength 1 @maxLength 255 */
-  name: string;
-  /** @maxLength 255 */
-  email: string;
-  /** @maxLength 50 */
-  phone?: string;
-  /** @maxLength 255 */
-  organization?: string;
-  /** @maxLength 100 */
-  studentId?: string;
-  /** @maxLength 1000 */
-  notes?: string;
-}
-
-export interface BorrowerUpdate {
-  id: string;
-  /** @minLength 1 @maxLength 255 */
-  name: string;
-  /** @maxLength 255 */
-  email: string;
-  /** @maxLength 50 */
-  phone?: string;
-  /** @maxLength 255 */
-  organization?: string;
-  /** @maxLength 100 */
-  studentId?: string;
-  /** @maxLength 1000 */
-  notes?: string;
-  isActive: boolean;
-}
-
-export interface BorrowerSummary {
-  id: string;
-  name: string;
-  email: string;
-  phone: string;
-  organization: string;
-  studentId: string;
-  isActive: boolean;
-  createdAt: Date | string;
-  updatedAt: Date | string;
-}
-
-export interface BorrowerOut {
-  id: string;
-  name: string;
-  email: string;
-  phone: string;
-  organization: string;
-  studentId: string;
-  isActive: boolean;
-  notes: string;
-  activeLoans: number;
-  totalLoans: number;
-  createdAt: Date | string;
-  updatedAt: Date | string;
-}
-
-// ============================================
-// Loan Types (Equipment Lending Feature)
-// ============================================
-
-export interface LoanCreate {
-  itemId: string;
-  borrowerId: string;
-  dueAt: Date | string;
-  /** @maxLength 1000 */
-  notes?: string;
-  /** @min 1 */
-  quantity?: number;
-}
-
-export interface LoanUpdate {
-  id: string;
-  dueAt: Date | string;
-  /** @maxLength 1000 */
-  notes?: string;
-}
-
-export interface LoanReturn {
-  id: string;
-  /** @maxLength 1000 */
-  returnNotes?: string;
-}
-
-export interface LoanSummary {
-  id: string;
-  checkedOutAt: Date | string;
-  dueAt: Date | string;
-  returnedAt?: Date | string | null;
-  quantity: number;
-  isOverdue: boolean;
-  itemId: string;
-  itemName: string;
-  borrowerId: string;
-  borrowerName: string;
-  createdAt: Date | string;
-  updatedAt: Date | string;
-}
-
-export interface LoanOut {
-  id: string;
-  checkedOutAt: Date | string;
-  dueAt: Date | string;
-  returnedAt?: Date | string | null;
-  quantity: number;
-  isOverdue: boolean;
-  itemId: string;
-  itemName: string;
-  borrowerId: string;
-  borrowerName: string;
-  notes: string;
-  returnNotes: string;
-  itemAssetId: number;
-  borrowerEmail: string;
-  borrowerPhone: string;
-  checkedOutBy?: string | null;
-  returnedBy?: string | null;
-  createdAt: Date | string;
-  updatedAt: Date | string;
-}